@@ -183,8 +183,8 @@ func CheckAppProtocolAnnotation(c *ServiceChecker) (string, string, string) {
 		return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("AppProtocol annotation is in invalid format in service %s/%s", c.namespace, c.name)
 	}
 	for _, protocol := range portToProtocols {
-		if protocol != annotations.ProtocolHTTP && protocol != annotations.ProtocolHTTPS && protocol != annotations.ProtocolHTTP2 {
-			return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("Invalid port application protocol in service %s/%s: %v, must be one of [`HTTP`,`HTTPS`,`HTTP2`]", c.namespace, c.name, protocol)
+		if protocol != annotations.ProtocolHTTP && protocol != annotations.ProtocolHTTPS && protocol != annotations.ProtocolHTTP2 && protocol != annotations.ProtocolGRPC {
+			return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("Invalid port application protocol in service %s/%s: %v, must be one of [`HTTP`,`HTTPS`,`HTTP2`,`GRPC`]", c.namespace, c.name, protocol)
 		}
 	}
 	return AppProtocolAnnotationCheck, report.Passed, fmt.Sprintf("AppProtocol annotation is valid in service %s/%s", c.namespace, c.name)
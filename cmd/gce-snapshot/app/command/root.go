@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/cmd/gce-snapshot/app/snapshot"
+	"k8s.io/ingress-gce/pkg/utils/inventory"
+	"k8s.io/klog/v2"
+)
+
+var (
+	kubeconfig    string
+	kubecontext   string
+	project       string
+	region        string
+	clusterUID    string
+	firewallName  string
+	kubeSystemUID string
+	file          string
+	dryRun        bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "gce-snapshot",
+	Short: "gce-snapshot saves and restores the configuration of the GCE resources of the ingress-gce controllers of a cluster.",
+}
+
+var saveCmd = &cobra.Command{
+	Use:   "save",
+	Short: "Save the configuration of the GCE resources owned by a cluster to a file.",
+	Long: `save writes the URL maps, target proxies, forwarding rules, backend services, health
+checks, NEGs with their endpoints, SSL certificates, firewalls and addresses created
+for a cluster to a versioned JSON file.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		logger := klog.TODO()
+		if project == "" || file == "" {
+			return fmt.Errorf("--project and --file are required")
+		}
+		cluster := inventory.Cluster{
+			UID:           clusterUID,
+			FirewallName:  firewallName,
+			KubeSystemUID: types.UID(kubeSystemUID),
+		}
+		// The identifiers are only read from the cluster if none is set,
+		// so that a snapshot can be taken with the cluster unreachable.
+		if cluster.UID == "" && cluster.KubeSystemUID == "" {
+			client, err := newClientSet(kubecontext, kubeconfig)
+			if err != nil {
+				return fmt.Errorf("error connecting to Kubernetes: %w", err)
+			}
+			if err := inventory.LookupCluster(ctx, client, &cluster); err != nil {
+				return err
+			}
+		}
+		gceCloud, err := newCloud(ctx, project, region)
+		if err != nil {
+			return fmt.Errorf("error connecting to GCE: %w", err)
+		}
+		s, err := snapshot.Save(ctx, gceCloud, cluster, region, logger)
+		if err != nil {
+			return err
+		}
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		if err := snapshot.Write(f, s); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Printf("Saved %d resources of project %s to %s\n", len(s.Resources), s.Project, file)
+		return nil
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Recreate the addresses and SSL certificates of a snapshot before the controllers resume.",
+	Long: `restore recreates the addresses and managed SSL certificates of a snapshot which no
+longer exist, so that the load balancers recreated by the controllers keep their external
+IPs and certificates. Other resources are recreated by the controllers. Ephemeral
+forwarding rule IPs and NEG endpoints are saved but not restored: the recreated forwarding
+rules get new IPs, and the endpoints are attached again by the NEG controller. Resources are
+only created with --dry-run=false.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		logger := klog.TODO()
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		s, err := snapshot.Read(f)
+		if err != nil {
+			return err
+		}
+		if project == "" {
+			project = s.Project
+		}
+		gceCloud, err := newCloud(ctx, project, s.Region)
+		if err != nil {
+			return fmt.Errorf("error connecting to GCE: %w", err)
+		}
+		results := snapshot.Restore(ctx, gceCloud, s, dryRun, logger)
+		failed, err := printResults(os.Stdout, results, dryRun)
+		if err != nil {
+			return err
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to restore %d resources", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&project, "project", "", "GCP project of the cluster, defaults to the project of the snapshot on restore")
+	rootCmd.PersistentFlags().StringVarP(&file, "file", "f", "", "path to the snapshot file")
+
+	saveCmd.Flags().StringVarP(&kubeconfig, "kubeconfig", "k", "", "path to the kubeconfig file for Kubernetes config")
+	saveCmd.Flags().StringVarP(&kubecontext, "context", "c", "", "context to use for Kubernetes config")
+	saveCmd.Flags().StringVar(&region, "region", "", "also save regional resources of this region")
+	saveCmd.Flags().StringVar(&clusterUID, "cluster-uid", "", "cluster UID embedded in v1 resource names, read from the kube-system/ingress-uid config map if neither identifier is set")
+	saveCmd.Flags().StringVar(&firewallName, "firewall-name", "", "name embedded in the L7 firewall rule, read from the kube-system/ingress-uid config map if neither identifier is set")
+	saveCmd.Flags().StringVar(&kubeSystemUID, "kube-system-uid", "", "UID of the kube-system namespace whose hash is embedded in v2 resource names, read from the cluster if neither identifier is set")
+
+	restoreCmd.Flags().BoolVar(&dryRun, "dry-run", true, "only report the resources to recreate, do not create them")
+
+	rootCmd.AddCommand(saveCmd, restoreCmd)
+}
+
+// newCloud returns a GCE cloud of the project using the application default
+// credentials.
+func newCloud(ctx context.Context, project, region string) (*gce.Cloud, error) {
+	tokenSource, err := google.DefaultTokenSource(ctx, compute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	return gce.CreateGCECloud(&gce.CloudConfig{
+		ProjectID:   project,
+		Region:      region,
+		TokenSource: tokenSource,
+	})
+}
+
+// printResults writes a table of the restore results and returns the number
+// of failures.
+func printResults(out io.Writer, results []*snapshot.Result, dryRun bool) (int, error) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tLOCATION\tNAME\tSTATUS\tREASON")
+	counts := make(map[snapshot.Status]int)
+	for _, r := range results {
+		counts[r.Status]++
+		location := "global"
+		if r.Resource.Region != "" {
+			location = r.Resource.Region
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Resource.Type, location, r.Resource.Name, r.Status, r.Reason)
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	failed := counts[snapshot.StatusFailed]
+	_, err := fmt.Fprintf(out, "\n%d created, %d pending, %d existing, %d skipped, %d failed\n",
+		counts[snapshot.StatusCreated], counts[snapshot.StatusPending], counts[snapshot.StatusExists], counts[snapshot.StatusSkipped], failed)
+	if err == nil && dryRun && counts[snapshot.StatusPending] > 0 {
+		_, err = fmt.Fprintln(out, "Dry run: re-run with --dry-run=false to create the pending resources.")
+	}
+	return failed, err
+}
+
+// Execute is the primary entrypoint for this CLI
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
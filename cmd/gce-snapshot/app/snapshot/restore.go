@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/inventory"
+	"k8s.io/klog/v2"
+)
+
+// Status is the outcome of the restore of a resource.
+type Status string
+
+const (
+	// StatusCreated marks resources recreated from the snapshot.
+	StatusCreated Status = "Created"
+	// StatusPending marks resources which would be recreated without dry run.
+	StatusPending Status = "Pending"
+	// StatusExists marks resources which were not deleted.
+	StatusExists Status = "Exists"
+	// StatusSkipped marks resources which cannot be recreated from the
+	// snapshot.
+	StatusSkipped Status = "Skipped"
+	// StatusFailed marks resources whose recreation failed, and addresses
+	// which exist with another IP than in the snapshot.
+	StatusFailed Status = "Failed"
+)
+
+// Result is the outcome of the restore of a resource.
+type Result struct {
+	Resource *Resource
+	Status   Status
+	// Reason explains Status.
+	Reason string
+}
+
+// restorers recreate the resources whose identity the controllers cannot
+// recreate: the IP of addresses and the domains of managed certificates.
+// Other resources are recreated by the controllers once they resume.
+var restorers = map[inventory.ResourceType]func(ctx context.Context, gceCloud *gce.Cloud, r *Resource, dryRun bool, logger klog.Logger) (Status, string, error){
+	inventory.Address:        restoreAddress,
+	inventory.SslCertificate: restoreSslCertificate,
+}
+
+// Restore recreates the addresses and the SSL certificates of the snapshot
+// which no longer exist, in gceCloud's project. The addresses are restored
+// first since they are used by the forwarding rules. Only results are
+// reported if dryRun is set. Restore continues past failures.
+func Restore(ctx context.Context, gceCloud *gce.Cloud, s *Snapshot, dryRun bool, logger klog.Logger) []*Result {
+	var results []*Result
+	for _, typ := range []inventory.ResourceType{inventory.Address, inventory.SslCertificate} {
+		for _, r := range s.Resources {
+			if r.Type != typ {
+				continue
+			}
+			status, reason, err := restorers[typ](ctx, gceCloud, r, dryRun, logger)
+			if err != nil {
+				logger.Error(err, "Failed to restore resource", "type", r.Type, "key", r.Key())
+				status, reason = StatusFailed, err.Error()
+			}
+			results = append(results, &Result{Resource: r, Status: status, Reason: reason})
+		}
+	}
+	return results
+}
+
+func restoreAddress(ctx context.Context, gceCloud *gce.Cloud, r *Resource, dryRun bool, logger klog.Logger) (Status, string, error) {
+	var addr composite.Address
+	if err := json.Unmarshal(r.Object, &addr); err != nil {
+		return "", "", fmt.Errorf("failed to decode address: %w", err)
+	}
+	existing, err := composite.GetAddress(ctx, gceCloud, r.Key(), meta.VersionGA, logger)
+	if err == nil {
+		if existing.Address != addr.Address {
+			return StatusFailed, fmt.Sprintf("reserved with IP %s instead of %s", existing.Address, addr.Address), nil
+		}
+		return StatusExists, fmt.Sprintf("reserved with IP %s", existing.Address), nil
+	}
+	if !utils.IsNotFoundError(err) {
+		return "", "", err
+	}
+	if dryRun {
+		return StatusPending, fmt.Sprintf("IP %s would be reserved", addr.Address), nil
+	}
+	// Output only fields are rejected on insert.
+	restored := &composite.Address{
+		Version:     meta.VersionGA,
+		Name:        addr.Name,
+		Address:     addr.Address,
+		AddressType: addr.AddressType,
+		Description: addr.Description,
+		IpVersion:   addr.IpVersion,
+		Labels:      addr.Labels,
+		Network:     addr.Network,
+		NetworkTier: addr.NetworkTier,
+		Purpose:     addr.Purpose,
+		Subnetwork:  addr.Subnetwork,
+	}
+	if err := composite.CreateAddress(ctx, gceCloud, r.Key(), restored, logger); err != nil {
+		return "", "", err
+	}
+	return StatusCreated, fmt.Sprintf("IP %s reserved", addr.Address), nil
+}
+
+func restoreSslCertificate(ctx context.Context, gceCloud *gce.Cloud, r *Resource, dryRun bool, logger klog.Logger) (Status, string, error) {
+	var cert composite.SslCertificate
+	if err := json.Unmarshal(r.Object, &cert); err != nil {
+		return "", "", fmt.Errorf("failed to decode SSL certificate: %w", err)
+	}
+	_, err := composite.GetSslCertificate(ctx, gceCloud, r.Key(), meta.VersionGA, logger)
+	if err == nil {
+		return StatusExists, "", nil
+	}
+	if !utils.IsNotFoundError(err) {
+		return "", "", err
+	}
+	if cert.Managed == nil || len(cert.Managed.Domains) == 0 {
+		// The API never returns private keys. Self managed certificates
+		// are recreated by the controller from their TLS secret.
+		return StatusSkipped, "private key is not part of the snapshot", nil
+	}
+	if dryRun {
+		return StatusPending, fmt.Sprintf("managed certificate for %v would be created", cert.Managed.Domains), nil
+	}
+	restored := &composite.SslCertificate{
+		Version:     meta.VersionGA,
+		Name:        cert.Name,
+		Description: cert.Description,
+		Type:        cert.Type,
+		Managed:     &composite.SslCertificateManagedSslCertificate{Domains: cert.Managed.Domains},
+	}
+	if err := composite.CreateSslCertificate(ctx, gceCloud, r.Key(), restored, logger); err != nil {
+		return "", "", err
+	}
+	return StatusCreated, fmt.Sprintf("managed certificate for %v created", cert.Managed.Domains), nil
+}
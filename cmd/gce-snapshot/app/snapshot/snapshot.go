@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot exports the GCE resources created by the ingress-gce
+// controllers of a cluster into a versioned file, and recreates the ones
+// which the controllers cannot recreate identically from it.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/inventory"
+	"k8s.io/klog/v2"
+)
+
+// CurrentVersion is the version of the snapshot format written by Save.
+// Read rejects snapshots of any other version.
+const CurrentVersion = "v1"
+
+// Snapshot is the configuration of the GCE resources of a cluster.
+type Snapshot struct {
+	Version string `json:"version"`
+	Project string `json:"project"`
+	// Region is the region whose regional resources are included, if any.
+	Region        string    `json:"region,omitempty"`
+	ClusterUID    string    `json:"clusterUID,omitempty"`
+	KubeSystemUID string    `json:"kubeSystemUID,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// Resources are in the order returned by inventory.List: users of a
+	// resource come before the resource.
+	Resources []*Resource `json:"resources"`
+}
+
+// Resource is the configuration of a GCE resource.
+type Resource struct {
+	Type   inventory.ResourceType `json:"type"`
+	Name   string                 `json:"name"`
+	Region string                 `json:"region,omitempty"`
+	Zone   string                 `json:"zone,omitempty"`
+	// Owner is the Kubernetes object named in the description of the
+	// resource, if any.
+	Owner string `json:"owner,omitempty"`
+	// Object is the GA composite type of the resource, or the compute type
+	// for firewalls which have no composite type.
+	Object json.RawMessage `json:"object"`
+	// Endpoints are the endpoints of a network endpoint group at the time
+	// of the snapshot.
+	Endpoints []*composite.NetworkEndpoint `json:"endpoints,omitempty"`
+}
+
+// Key returns the key of the resource.
+func (r *Resource) Key() *meta.Key {
+	return &meta.Key{Name: r.Name, Region: r.Region, Zone: r.Zone}
+}
+
+// getters return the configuration of a resource of each type.
+var getters = map[inventory.ResourceType]func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error){
+	inventory.ForwardingRule: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetForwardingRule(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.TargetHttpsProxy: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetTargetHttpsProxy(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.TargetHttpProxy: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetTargetHttpProxy(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.UrlMap: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetUrlMap(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.BackendService: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetBackendService(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.HealthCheck: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetHealthCheck(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.NetworkEndpointGroup: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetNetworkEndpointGroup(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.SslCertificate: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetSslCertificate(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+	inventory.Firewall: func(_ context.Context, gceCloud *gce.Cloud, key *meta.Key, _ klog.Logger) (interface{}, error) {
+		return gceCloud.GetFirewall(key.Name)
+	},
+	inventory.Address: func(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, logger klog.Logger) (interface{}, error) {
+		return composite.GetAddress(ctx, gceCloud, key, meta.VersionGA, logger)
+	},
+}
+
+// Save returns the configuration of the GCE resources owned by the cluster,
+// global and, if region is set, regional. Resources deleted while the
+// snapshot is taken are left out.
+func Save(ctx context.Context, gceCloud *gce.Cloud, cluster inventory.Cluster, region string, logger klog.Logger) (*Snapshot, error) {
+	resources, err := inventory.List(ctx, gceCloud.Compute(), cluster, region, logger)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{
+		Version:       CurrentVersion,
+		Project:       gceCloud.ProjectID(),
+		Region:        region,
+		ClusterUID:    cluster.UID,
+		KubeSystemUID: string(cluster.KubeSystemUID),
+		CreatedAt:     time.Now().UTC(),
+	}
+	for _, r := range resources {
+		res, err := saveResource(ctx, gceCloud, r, logger)
+		if utils.IsNotFoundError(err) {
+			logger.Info("Resource was deleted during the snapshot", "type", r.Type, "key", r.Key)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", r.Type, r.Key, err)
+		}
+		s.Resources = append(s.Resources, res)
+	}
+	return s, nil
+}
+
+func saveResource(ctx context.Context, gceCloud *gce.Cloud, r *inventory.Resource, logger klog.Logger) (*Resource, error) {
+	obj, err := getters[r.Type](ctx, gceCloud, r.Key, logger)
+	if err != nil {
+		return nil, err
+	}
+	res := &Resource{Type: r.Type, Name: r.Key.Name, Region: r.Key.Region, Zone: r.Key.Zone}
+	if r.Owner != nil {
+		res.Owner = r.Owner.String()
+	}
+	if res.Object, err = json.Marshal(obj); err != nil {
+		return nil, err
+	}
+	if r.Type == inventory.NetworkEndpointGroup {
+		endpoints, err := composite.ListNetworkEndpoints(ctx, gceCloud, r.Key, meta.VersionGA, &composite.NetworkEndpointGroupsListEndpointsRequest{HealthStatus: "SKIP"}, logger)
+		if err != nil {
+			return nil, err
+		}
+		for _, ep := range endpoints {
+			res.Endpoints = append(res.Endpoints, ep.NetworkEndpoint)
+		}
+	}
+	return res, nil
+}
+
+// Write writes the snapshot as indented JSON.
+func Write(w io.Writer, s *Snapshot) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Read reads a snapshot written by Write.
+func Read(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if s.Version != CurrentVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %q, want %q", s.Version, CurrentVersion)
+	}
+	return &s, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils/inventory"
+	"k8s.io/klog/v2"
+)
+
+const (
+	testZone = "us-central1-b"
+	// The names carry the v1 cluster UID suffix of testCluster.
+	addressName = "k8s-fw-default-web--uid1"
+	managedName = "k8s-ssl-default-managed--uid1"
+	selfMgdName = "k8s-ssl-default-self--uid1"
+	negName     = "k8s1-uid1-default-web-80-abcdef12"
+)
+
+var testCluster = inventory.Cluster{UID: "uid1"}
+
+func newTestCloud(t *testing.T) *gce.Cloud {
+	t.Helper()
+	ctx := context.Background()
+	gceCloud := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	mockGCE := gceCloud.Compute().(*cloud.MockGCE)
+	mockGCE.MockNetworkEndpointGroups.ListNetworkEndpointsHook = func(_ context.Context, _ *meta.Key, _ *compute.NetworkEndpointGroupsListEndpointsRequest, _ *filter.F, _ *cloud.MockNetworkEndpointGroups, _ ...cloud.Option) ([]*compute.NetworkEndpointWithHealthStatus, error) {
+		return []*compute.NetworkEndpointWithHealthStatus{{NetworkEndpoint: &compute.NetworkEndpoint{IpAddress: "10.0.0.1", Port: 8080, Instance: "node-1"}}}, nil
+	}
+	for _, addr := range []*compute.Address{
+		{Name: addressName, Address: "35.1.2.3", Status: "IN_USE", Users: []string{"fr"}},
+		{Name: "user-address", Address: "35.1.2.4"},
+	} {
+		must(t, mockGCE.GlobalAddresses().Insert(ctx, meta.GlobalKey(addr.Name), addr))
+	}
+	for _, cert := range []*compute.SslCertificate{
+		{Name: managedName, Type: "MANAGED", Managed: &compute.SslCertificateManagedSslCertificate{Domains: []string{"example.com"}, Status: "ACTIVE"}},
+		{Name: selfMgdName, Type: "SELF_MANAGED", Certificate: "cert"},
+	} {
+		must(t, mockGCE.SslCertificates().Insert(ctx, meta.GlobalKey(cert.Name), cert))
+	}
+	must(t, mockGCE.NetworkEndpointGroups().Insert(ctx, meta.ZonalKey(negName, testZone), &compute.NetworkEndpointGroup{Name: negName}))
+	return gceCloud
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSaveAndRead(t *testing.T) {
+	gceCloud := newTestCloud(t)
+	s, err := Save(context.Background(), gceCloud, testCluster, "", klog.TODO())
+	if err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+	var got []string
+	for _, r := range s.Resources {
+		got = append(got, string(r.Type)+"/"+r.Name)
+	}
+	want := []string{
+		"NetworkEndpointGroup/" + negName,
+		"SslCertificate/" + managedName,
+		"SslCertificate/" + selfMgdName,
+		"Address/" + addressName,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Save() resources mismatch (-want +got):\n%s", diff)
+	}
+	wantEndpoints := []*composite.NetworkEndpoint{{IpAddress: "10.0.0.1", Port: 8080, Instance: "node-1"}}
+	if diff := cmp.Diff(wantEndpoints, s.Resources[0].Endpoints); diff != "" {
+		t.Errorf("NEG endpoints mismatch (-want +got):\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, s); err != nil {
+		t.Fatalf("Write() = %v", err)
+	}
+	read, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Read() = %v", err)
+	}
+	// Objects are indented by Write, compare the written snapshots.
+	var rewritten bytes.Buffer
+	if err := Write(&rewritten, read); err != nil {
+		t.Fatalf("Write() = %v", err)
+	}
+	if diff := cmp.Diff(buf.String(), rewritten.String()); diff != "" {
+		t.Errorf("Read() mismatch (-want +got):\n%s", diff)
+	}
+
+	old := strings.Replace(buf.String(), `"version": "v1"`, `"version": "v0"`, 1)
+	if _, err := Read(strings.NewReader(old)); err == nil {
+		t.Errorf("Read() of a v0 snapshot = nil, want error")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	gceCloud := newTestCloud(t)
+	logger := klog.TODO()
+	s, err := Save(context.Background(), gceCloud, testCluster, "", logger)
+	if err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+	// Simulate the accidental cleanup of the project.
+	ctx := context.Background()
+	mockGCE := gceCloud.Compute().(*cloud.MockGCE)
+	must(t, mockGCE.GlobalAddresses().Delete(ctx, meta.GlobalKey(addressName)))
+	must(t, mockGCE.SslCertificates().Delete(ctx, meta.GlobalKey(managedName)))
+	must(t, mockGCE.SslCertificates().Delete(ctx, meta.GlobalKey(selfMgdName)))
+
+	statuses := func(results []*Result) map[string]Status {
+		m := make(map[string]Status)
+		for _, r := range results {
+			m[r.Resource.Name] = r.Status
+		}
+		return m
+	}
+
+	dryRunResults := Restore(context.Background(), gceCloud, s, true, logger)
+	wantDryRun := map[string]Status{addressName: StatusPending, managedName: StatusPending, selfMgdName: StatusSkipped}
+	if diff := cmp.Diff(wantDryRun, statuses(dryRunResults)); diff != "" {
+		t.Errorf("Restore(dryRun) mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := composite.GetAddress(context.Background(), gceCloud, meta.GlobalKey(addressName), meta.VersionGA, logger); err == nil {
+		t.Errorf("Restore(dryRun) created address %s", addressName)
+	}
+
+	results := Restore(context.Background(), gceCloud, s, false, logger)
+	want := map[string]Status{addressName: StatusCreated, managedName: StatusCreated, selfMgdName: StatusSkipped}
+	if diff := cmp.Diff(want, statuses(results)); diff != "" {
+		t.Errorf("Restore() mismatch (-want +got):\n%s", diff)
+	}
+	addr, err := composite.GetAddress(context.Background(), gceCloud, meta.GlobalKey(addressName), meta.VersionGA, logger)
+	if err != nil {
+		t.Fatalf("GetAddress(%s) = %v", addressName, err)
+	}
+	if addr.Address != "35.1.2.3" || len(addr.Users) != 0 {
+		t.Errorf("Restored address = %+v, want IP 35.1.2.3 without users", addr)
+	}
+	cert, err := composite.GetSslCertificate(context.Background(), gceCloud, meta.GlobalKey(managedName), meta.VersionGA, logger)
+	if err != nil {
+		t.Fatalf("GetSslCertificate(%s) = %v", managedName, err)
+	}
+	if diff := cmp.Diff([]string{"example.com"}, cert.Managed.Domains); diff != "" || cert.Managed.Status != "" {
+		t.Errorf("Restored certificate = %+v, want a new managed certificate for example.com", cert.Managed)
+	}
+
+	again := Restore(context.Background(), gceCloud, s, false, logger)
+	wantAgain := map[string]Status{addressName: StatusExists, managedName: StatusExists, selfMgdName: StatusSkipped}
+	if diff := cmp.Diff(wantAgain, statuses(again)); diff != "" {
+		t.Errorf("second Restore() mismatch (-want +got):\n%s", diff)
+	}
+
+	// The address was reserved again with another IP.
+	must(t, mockGCE.GlobalAddresses().Delete(ctx, meta.GlobalKey(addressName)))
+	must(t, mockGCE.GlobalAddresses().Insert(ctx, meta.GlobalKey(addressName), &compute.Address{Name: addressName, Address: "35.9.9.9"}))
+	changed := Restore(context.Background(), gceCloud, s, false, logger)
+	wantChanged := map[string]Status{addressName: StatusFailed, managedName: StatusExists, selfMgdName: StatusSkipped}
+	if diff := cmp.Diff(wantChanged, statuses(changed)); diff != "" {
+		t.Errorf("Restore() of an address with another IP mismatch (-want +got):\n%s", diff)
+	}
+}
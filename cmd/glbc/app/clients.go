@@ -26,6 +26,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	cloudprovider "k8s.io/cloud-provider"
@@ -49,7 +50,7 @@ const (
 
 // createAndValidateGCEClient creates a GCE cloud provider, configures rate limiting,
 // and validates connectivity by listing backend services.
-func createAndValidateGCEClient(configReader func() io.Reader, logger klog.Logger) (*gce.Cloud, error) {
+func createAndValidateGCEClient(configReader func() io.Reader, rl cloud.RateLimiter, logger klog.Logger) (*gce.Cloud, error) {
 	provider, err := cloudprovider.GetCloudProvider("gce", configReader())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cloud provider: %w", err)
@@ -58,10 +59,6 @@ func createAndValidateGCEClient(configReader func() io.Reader, logger klog.Logge
 	cloud := provider.(*gce.Cloud)
 
 	// Configure GCE rate limiting
-	rl, err := ratelimit.NewGCERateLimiter(flags.F.GCERateLimit.Values(), flags.F.GCEOperationPollInterval, logger)
-	if err != nil {
-		klog.Fatalf("Error configuring rate limiting: %v", err)
-	}
 	cloud.SetRateLimiter(rl)
 
 	// If this controller is scheduled on a node without compute/rw
@@ -148,10 +145,11 @@ func NewGCEClient(logger klog.Logger) *gce.Cloud {
 // GCEClientForConfigReaderMT returns a client to the GCE environment, retrying
 // up to maxAttempts times before returning an error. This prevents workers
 // from getting stuck in infinite retry loops in multi-tenant environments.
-func GCEClientForConfigReaderMT(configReader func() io.Reader, logger klog.Logger, maxAttempts int) (*gce.Cloud, error) {
+// The client uses the given rate limiter, which may be shared by the clients of a tenant.
+func GCEClientForConfigReaderMT(configReader func() io.Reader, rl cloud.RateLimiter, logger klog.Logger, maxAttempts int) (*gce.Cloud, error) {
 	var lastErr error
 	for i := 0; i < maxAttempts; i++ {
-		cloud, err := createAndValidateGCEClient(configReader, logger)
+		cloud, err := createAndValidateGCEClient(configReader, rl, logger)
 		if err == nil {
 			return cloud, nil
 		}
@@ -171,8 +169,12 @@ func GCEClientForConfigReader(configReader func() io.Reader, logger klog.Logger)
 	// an oauth token. If this fails, the token provider assumes it's not on GCE.
 	// No errors are thrown. So we need to keep retrying till it works because
 	// we know we're on GCE.
+	rl, err := ratelimit.NewGCERateLimiter(flags.F.GCERateLimit.Values(), flags.F.GCEOperationPollInterval, logger)
+	if err != nil {
+		klog.Fatalf("Error configuring rate limiting: %v", err)
+	}
 	for {
-		cloud, err := createAndValidateGCEClient(configReader, logger)
+		cloud, err := createAndValidateGCEClient(configReader, rl, logger)
 		if err == nil {
 			return cloud
 		}
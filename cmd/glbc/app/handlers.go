@@ -17,29 +17,35 @@ limitations under the License.
 package app
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"html/template"
 	"net/http"
 	"os"
 	"os/signal"
+	"sort"
 	"strings"
 	"syscall"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/klog/v2"
 
+	"k8s.io/ingress-gce/pkg/debug"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/systemhealth"
 	"k8s.io/ingress-gce/pkg/version"
 )
 
-// RunHTTPServer starts an HTTP server. `healthChecker` returns a mapping of component/controller
-// name to the result of its healthcheck.
-func RunHTTPServer(healthChecker func() systemhealth.HealthCheckResults, logger klog.Logger) {
-	http.HandleFunc("/healthz", healthCheckHandler(healthChecker, logger))
+// RunHTTPServer starts an HTTP server. `health` reports the health of the components/controllers,
+// which is served on /healthz, /readyz and /livez. `debugHandler` serves the per-object debug endpoints.
+func RunHTTPServer(health *systemhealth.SystemHealth, debugHandler http.Handler, logger klog.Logger) {
+	http.HandleFunc("/healthz", healthCheckHandler(health.Check, logger))
+	http.HandleFunc("/readyz", probeHandler(health.Check, systemhealth.Report.Ready, logger))
+	http.HandleFunc("/livez", probeHandler(health.Check, systemhealth.Report.Live, logger))
 	http.HandleFunc("/flag", flagHandler)
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle(debug.PathPrefix, debugHandler)
 
 	logger.V(0).Info("Running http server", "port", flags.F.HealthzPort)
 	klog.Fatal(http.ListenAndServe(fmt.Sprintf(":%v", flags.F.HealthzPort), nil))
@@ -56,25 +62,40 @@ func RunSIGTERMHandler(closeStopCh func(), logger klog.Logger) {
 	closeStopCh()
 }
 
-func healthCheckHandler(checker func() systemhealth.HealthCheckResults, logger klog.Logger) http.HandlerFunc {
+// healthCheckHandler fails if any component is unhealthy. It writes the
+// report as JSON if the verbose query parameter is set.
+func healthCheckHandler(checker func() systemhealth.Report, logger klog.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var hasErr bool
+		report := checker()
+		code := http.StatusOK
+		if report.State == systemhealth.StateUnhealthy {
+			code = http.StatusInternalServerError
+		}
+		if r.URL.Query().Has("verbose") {
+			writeReport(w, code, report, logger)
+			return
+		}
+
 		var s strings.Builder
-		for component, result := range checker() {
-			status := "OK"
-			if result != nil {
-				hasErr = true
-				status = fmt.Sprintf("err: %v", result)
+		components := make([]string, 0, len(report.Components))
+		for component := range report.Components {
+			components = append(components, component)
+		}
+		sort.Strings(components)
+		for _, component := range components {
+			status := report.Components[component]
+			switch status.State {
+			case systemhealth.StateHealthy:
+				s.WriteString(fmt.Sprintf("%v: OK\n", component))
+			case systemhealth.StateUnhealthy:
+				s.WriteString(fmt.Sprintf("%v: err: %v\n", component, status.Message))
+			default:
+				s.WriteString(fmt.Sprintf("%v: %v: %v\n", component, status.State, status.Message))
 			}
-			s.WriteString(fmt.Sprintf("%v: %v\n", component, status))
 		}
 
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-		if hasErr {
-			w.WriteHeader(500)
-		} else {
-			w.WriteHeader(200)
-		}
+		w.WriteHeader(code)
 
 		if s.Len() == 0 {
 			_, err := w.Write([]byte("OK - no running controllers"))
@@ -88,8 +109,34 @@ func healthCheckHandler(checker func() systemhealth.HealthCheckResults, logger k
 		if err != nil {
 			logger.Error(err, "Error writing bytes")
 		}
+	}
+}
+
+// probeHandler writes the report as JSON, and fails if pass returns false
+// for it.
+func probeHandler(checker func() systemhealth.Report, pass func(systemhealth.Report) bool, logger klog.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := checker()
+		code := http.StatusOK
+		if !pass(report) {
+			code = http.StatusInternalServerError
+		}
+		writeReport(w, code, report, logger)
+	}
+}
+
+func writeReport(w http.ResponseWriter, code int, report systemhealth.Report, logger klog.Logger) {
+	body, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.Error(err, "Error marshaling health report")
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(body); err != nil {
+		logger.Error(err, "Error writing bytes")
+	}
 }
 
 func flagHandler(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/ingress-gce/pkg/systemhealth"
+	"k8s.io/klog/v2"
+)
+
+func TestHealthHandlers(t *testing.T) {
+	sh := systemhealth.NewSystemHealth(klog.TODO())
+	sh.AddHealthCheck("ingress", func() error { return nil })
+	sh.AddHealthCheck("neg-controller", func() error { return errors.New("stuck") })
+
+	for _, tc := range []struct {
+		desc     string
+		handler  http.HandlerFunc
+		url      string
+		wantCode int
+		wantJSON bool
+	}{
+		{
+			desc:     "healthz fails on an unhealthy component",
+			handler:  healthCheckHandler(sh.Check, klog.TODO()),
+			url:      "/healthz",
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			desc:     "verbose healthz",
+			handler:  healthCheckHandler(sh.Check, klog.TODO()),
+			url:      "/healthz?verbose",
+			wantCode: http.StatusInternalServerError,
+			wantJSON: true,
+		},
+		{
+			desc:     "readyz fails on an unhealthy component",
+			handler:  probeHandler(sh.Check, systemhealth.Report.Ready, klog.TODO()),
+			url:      "/readyz",
+			wantCode: http.StatusInternalServerError,
+			wantJSON: true,
+		},
+		{
+			desc:     "livez passes while a component is healthy",
+			handler:  probeHandler(sh.Check, systemhealth.Report.Live, klog.TODO()),
+			url:      "/livez",
+			wantCode: http.StatusOK,
+			wantJSON: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tc.handler(rec, httptest.NewRequest(http.MethodGet, tc.url, nil))
+			if rec.Code != tc.wantCode {
+				t.Errorf("GET %s returned %d, want %d", tc.url, rec.Code, tc.wantCode)
+			}
+			if !tc.wantJSON {
+				return
+			}
+			var report systemhealth.Report
+			if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+				t.Fatalf("GET %s returned %q, want a JSON report: %v", tc.url, rec.Body.String(), err)
+			}
+			if got := report.Components["neg-controller"]; got.State != systemhealth.StateUnhealthy || got.Message != "stuck" {
+				t.Errorf("GET %s returned neg-controller status %+v, want unhealthy with message %q", tc.url, got, "stuck")
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4lbconfig"
 	l4lbconfigclient "k8s.io/ingress-gce/pkg/l4lbconfig/client/clientset/versioned"
 	multiprojectgce "k8s.io/ingress-gce/pkg/multiproject/common/gce"
+	multiprojectlb "k8s.io/ingress-gce/pkg/multiproject/lb"
 	multiprojectstart "k8s.io/ingress-gce/pkg/multiproject/start"
 	"k8s.io/ingress-gce/pkg/network"
 	providerconfigclient "k8s.io/ingress-gce/pkg/providerconfig/client/clientset/versioned"
@@ -53,6 +54,7 @@ import (
 	"k8s.io/ingress-gce/pkg/svcneg"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 	"k8s.io/ingress-gce/pkg/systemhealth"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/klog/v2"
 
@@ -61,12 +63,14 @@ import (
 	ingctx "k8s.io/ingress-gce/pkg/context"
 	"k8s.io/ingress-gce/pkg/controller"
 	"k8s.io/ingress-gce/pkg/crd"
+	"k8s.io/ingress-gce/pkg/debug"
 	"k8s.io/ingress-gce/pkg/firewalls"
 	"k8s.io/ingress-gce/pkg/flags"
 	_ "k8s.io/ingress-gce/pkg/klog"
 	"k8s.io/ingress-gce/pkg/neg"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	syncMetrics "k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
+	"k8s.io/ingress-gce/pkg/neg/shard"
 	"k8s.io/ingress-gce/pkg/neg/syncers/labels"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/utils/zonegetter"
@@ -74,6 +78,10 @@ import (
 )
 
 const negLockName = "ingress-gce-neg-lock"
+
+// negShardLockPrefix prefixes the names of the leases of the NEG controller
+// shards, see --neg-shards.
+const negShardLockPrefix = "ingress-gce-neg-shard"
 const l4LockName = "l4-lb-controller-gce-lock"
 
 func main() {
@@ -190,7 +198,7 @@ func main() {
 	}
 
 	var l4LBConfigClient l4lbconfigclient.Interface
-	if flags.F.ManageL4LBLogging && (flags.F.RunL4Controller || flags.F.RunL4NetLBController) {
+	if (flags.F.ManageL4LBLogging || flags.F.ManageL4LBConnectionTracking) && (flags.F.RunL4Controller || flags.F.RunL4NetLBController) {
 		l4LBConfigCRDMeta := l4lbconfig.CRDMeta()
 		klog.V(0).Info("Ensuring L4LBConfig CRD exists")
 		if _, err := crdHandler.EnsureCRD(l4LBConfigCRDMeta, true); err != nil {
@@ -237,8 +245,9 @@ func main() {
 	stopCh := make(chan struct{})
 
 	rOption := runOption{
-		wg:     &sync.WaitGroup{},
-		stopCh: stopCh,
+		wg:            &sync.WaitGroup{},
+		stopCh:        stopCh,
+		debugRegistry: debug.NewRegistry(rootLogger),
 		// This ensures that stopCh is only closed once.
 		closeStopCh: func() {
 			once.Do(func() { close(stopCh) })
@@ -247,7 +256,21 @@ func main() {
 	go app.RunSIGTERMHandler(rOption.closeStopCh, rootLogger)
 
 	systemHealth := systemhealth.NewSystemHealth(rootLogger)
-	go app.RunHTTPServer(systemHealth.HealthCheck, rootLogger)
+	go app.RunHTTPServer(systemHealth, rOption.debugRegistry, rootLogger)
+
+	if flags.F.EnableTracing {
+		shutdownTracing, err := tracing.Init(context.Background(), flags.F.TracingOTLPEndpoint, flags.F.TracingSampleRatio, rootLogger)
+		if err != nil {
+			klog.Fatalf("Failed to initialize tracing: %v", err)
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				rootLogger.Error(err, "Failed to flush traces")
+			}
+		}()
+	}
 
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -270,6 +293,17 @@ func main() {
 			syncerMetrics := syncMetrics.NewNegMetricsCollector(flags.F.NegMetricsExportInterval, rootLogger)
 			go syncerMetrics.Run(stopCh)
 
+			var lbConfig *multiprojectlb.Config
+			if flags.F.EnableMultiProjectLBControllers {
+				lbConfig = &multiprojectlb.Config{
+					BackendConfigClient:     backendConfigClient,
+					FrontendConfigClient:    frontendConfigClient,
+					SAClient:                svcAttachmentClient,
+					L4LBConfigClient:        l4LBConfigClient,
+					ControllerContextConfig: newControllerContextConfig(app.DefaultBackendServicePort(kubeClient, rootLogger)),
+				}
+			}
+
 			if flags.F.LeaderElection.LeaderElect {
 				err := multiprojectstart.StartWithLeaderElection(
 					ctx,
@@ -287,6 +321,7 @@ func main() {
 					namer,
 					stopCh,
 					syncerMetrics,
+					lbConfig,
 				)
 				if err != nil {
 					rootLogger.Error(err, "Failed to start multi-project syncer with leader election")
@@ -306,6 +341,7 @@ func main() {
 					namer,
 					stopCh,
 					syncerMetrics,
+					lbConfig,
 				)
 			}
 		}, rOption.wg)
@@ -330,36 +366,7 @@ func main() {
 	}
 
 	defaultBackendServicePort := app.DefaultBackendServicePort(kubeClient, rootLogger)
-	ctxConfig := ingctx.ControllerContextConfig{
-		Namespace:                            flags.F.WatchNamespace,
-		ResyncPeriod:                         flags.F.ResyncPeriod,
-		NumL4Workers:                         flags.F.NumL4Workers,
-		NumL4NetLBWorkers:                    flags.F.NumL4NetLBWorkers,
-		DefaultBackendSvcPort:                defaultBackendServicePort,
-		HealthCheckPath:                      flags.F.HealthCheckPath,
-		MaxIGSize:                            flags.F.MaxIGSize,
-		RunL4ILBController:                   flags.F.RunL4Controller,
-		RunL4NetLBController:                 flags.F.RunL4NetLBController,
-		RunL4StandaloneNEGController:         flags.F.RunL4StandaloneNEGController,
-		EnableL4ILBDualStack:                 flags.F.EnableL4ILBDualStack,
-		EnableL4NetLBDualStack:               flags.F.EnableL4NetLBDualStack,
-		EnableL4StrongSessionAffinity:        flags.F.EnableL4StrongSessionAffinity,
-		EnableMultinetworking:                flags.F.EnableMultiNetworking,
-		EnableIngressRegionalExternal:        flags.F.EnableIngressRegionalExternal,
-		EnableWeightedL4ILB:                  flags.F.EnableWeightedL4ILB,
-		EnableWeightedL4NetLB:                flags.F.EnableWeightedL4NetLB,
-		DisableL4LBFirewall:                  flags.F.DisableL4LBFirewall,
-		EnableL4NetLBNEGs:                    flags.F.EnableL4NetLBNEG,
-		EnableL4NetLBNEGsDefault:             flags.F.EnableL4NetLBNEGDefault,
-		EnableL4ILBMixedProtocol:             flags.F.EnableL4ILBMixedProtocol,
-		EnableL4NetLBMixedProtocol:           flags.F.EnableL4NetLBMixedProtocol,
-		EnableL3ForNetLBMixedProtocol:        flags.F.EnableL3ForwardingRuleForNetLBMixedProtocol,
-		EnableL4DenyFirewalls:                flags.F.EnableL4DenyFirewall,
-		EnableL4DenyFirewallsRollbackCleanup: flags.F.EnableL4DenyFirewallRollbackCleanup,
-		EnableL4ILBZonalAffinity:             flags.F.EnableL4ILBZonalAffinity,
-		ReadOnlyMode:                         flags.F.ReadOnlyMode,
-		EnableL4NetLBRBSByDefault:            flags.F.EnableL4NetLBRBSByDefault,
-	}
+	ctxConfig := newControllerContextConfig(defaultBackendServicePort)
 	ctx, err := ingctx.NewControllerContext(kubeClient, backendConfigClient, frontendConfigClient, firewallCRClient, svcNegClient, svcAttachmentClient, networkClient, nodeTopologyClient, l4LBConfigClient, eventRecorderKubeClient, cloud, namer, kubeSystemUID, ctxConfig, rootLogger)
 	if err != nil {
 		klog.Fatalf("unable to set up controller context: %v", err)
@@ -422,6 +429,18 @@ func main() {
 			leaderelection.RunOrDie(leCtx, *negRunner)
 			logger.Info("NEG Controller exited.")
 		}
+		if flags.F.NEGShards > 1 {
+			runNEG = func() {
+				logger := rootLogger.WithName("NEGController")
+				logger.Info("Start running sharded NEG controller",
+					"NEG controller", flags.F.EnableNEGController,
+					"shards", flags.F.NEGShards,
+				)
+				if err := runShardedNEGController(ctx, systemHealth, rOption, leOption, logger); err != nil {
+					klog.Fatalf("failed to run sharded NEG controller: %s", err)
+				}
+			}
+		}
 		runIngress = func() {
 			logger := rootLogger.WithName("Ingress controller")
 			logger.Info("Start running Ingress leader election",
@@ -484,6 +503,8 @@ type runOption struct {
 	stopCh      chan struct{}
 	wg          *sync.WaitGroup
 	closeStopCh func()
+	// debugRegistry serves per-object controller state on /debug/.
+	debugRegistry *debug.Registry
 }
 
 type leaderElectionOption struct {
@@ -506,6 +527,7 @@ func makeNEGRunnerWithLeaderElection(
 	logger klog.Logger,
 ) (*leaderelection.LeaderElectionConfig, error) {
 	return makeRunnerWithLeaderElection(
+		systemHealth,
 		leOption,
 		negLockName,
 		func(context.Context) {
@@ -530,6 +552,7 @@ func makeIngressRunnerWithLeaderElection(
 	logger klog.Logger,
 ) (*leaderelection.LeaderElectionConfig, error) {
 	return makeRunnerWithLeaderElection(
+		systemHealth,
 		leOption,
 		flags.F.LeaderElection.LockObjectName,
 		func(context.Context) {
@@ -552,6 +575,7 @@ func makeL4RunnerWithLeaderElection(
 ) (*leaderelection.LeaderElectionConfig, error) {
 	lockLogger := logger.WithValues("lock", l4LockName)
 	return makeRunnerWithLeaderElection(
+		systemHealth,
 		leOption,
 		l4LockName,
 		func(context.Context) {
@@ -567,8 +591,10 @@ func makeL4RunnerWithLeaderElection(
 }
 
 // makeRunnerWithLeaderElection creates a LeaderElectionConfig with the provided options and callbacks.
-// It will create a new resource lock associated with the configuration.
+// It will create a new resource lock associated with the configuration, whose
+// status is reported to systemHealth.
 func makeRunnerWithLeaderElection(
+	systemHealth *systemhealth.SystemHealth,
 	leOption leaderElectionOption,
 	leaderElectionLockName string,
 	onStartedLeading func(context.Context),
@@ -586,6 +612,7 @@ func makeRunnerWithLeaderElection(
 	if err != nil {
 		return nil, fmt.Errorf("couldn't create resource lock: %v", err)
 	}
+	systemHealth.SetLeading(leaderElectionLockName, false)
 
 	return &leaderelection.LeaderElectionConfig{
 		Lock:            rl,
@@ -594,8 +621,14 @@ func makeRunnerWithLeaderElection(
 		RetryPeriod:     flags.F.LeaderElection.RetryPeriod.Duration,
 		ReleaseOnCancel: true, // release the lease when context (tied to stopCh) is canceled to speed failover
 		Callbacks: leaderelection.LeaderCallbacks{
-			OnStartedLeading: onStartedLeading,
-			OnStoppedLeading: onStoppedLeading,
+			OnStartedLeading: func(ctx context.Context) {
+				systemHealth.SetLeading(leaderElectionLockName, true)
+				onStartedLeading(ctx)
+			},
+			OnStoppedLeading: func() {
+				systemHealth.SetLeading(leaderElectionLockName, false)
+				onStoppedLeading()
+			},
 		},
 	}, nil
 }
@@ -603,7 +636,8 @@ func makeRunnerWithLeaderElection(
 func runIngressControllers(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, option runOption, leOption leaderElectionOption, logger klog.Logger) {
 	if flags.F.RunIngressController {
 		lbc := controller.NewLoadBalancerController(ctx, option.stopCh, logger)
-		systemHealth.AddHealthCheck("ingress", lbc.SystemHealth)
+		systemHealth.AddStatusCheck("ingress", lbc.HealthStatus)
+		option.debugRegistry.AddProvider("ingress", lbc.DebugInfo)
 		runWithWg(lbc.Run, option.wg)
 		logger.V(0).Info("ingress controller started")
 
@@ -616,6 +650,12 @@ func runIngressControllers(ctx *ingctx.ControllerContext, systemHealth *systemhe
 		}
 		runWithWg(fwc.Run, option.wg)
 		logger.V(0).Info("firewall controller started")
+
+		if flags.F.FirewallAuditPeriod > 0 {
+			fwAuditor := firewalls.NewFirewallAuditor(ctx, fwc, false, flags.F.EnableFirewallAuditRepair, flags.F.FirewallAuditPeriod, option.stopCh, logger)
+			runWithWg(fwAuditor.Run, option.wg)
+			logger.V(0).Info("L7 firewall auditor started")
+		}
 	}
 
 	ctx.Start(option.stopCh)
@@ -632,7 +672,8 @@ func runL4Controllers(ctx *ingctx.ControllerContext, systemHealth *systemhealth.
 
 	if flags.F.RunL4Controller {
 		l4Controller := controllers.NewILBController(ctx, option.stopCh, logger)
-		systemHealth.AddHealthCheck(controllers.L4ILBControllerName, l4Controller.SystemHealth)
+		systemHealth.AddStatusCheck(controllers.L4ILBControllerName, l4Controller.SystemHealth)
+		option.debugRegistry.AddProvider("l4", l4Controller.DebugInfo)
 		runWithWg(l4Controller.Run, option.wg)
 		logger.V(0).Info("L4 controller started")
 	}
@@ -653,6 +694,10 @@ func runL4Controllers(ctx *ingctx.ControllerContext, systemHealth *systemhealth.
 			ReadOnlyMode:             flags.F.ReadOnlyMode,
 			StopCh:                   option.stopCh,
 		}
+		if flags.F.RunIngressController && flags.F.IGNamedPortGCPeriod > 0 {
+			igControllerParams.NeededNamedPorts = controller.IGNamedPorts(ctx)
+			igControllerParams.NamedPortGCPeriod = flags.F.IGNamedPortGCPeriod
+		}
 		igController := instancegroups.NewController(igControllerParams, logger)
 		runWithWg(igController.Run, option.wg)
 	}
@@ -660,12 +705,19 @@ func runL4Controllers(ctx *ingctx.ControllerContext, systemHealth *systemhealth.
 	// The L4NetLbController will be run when RbsMode flag is Set
 	if flags.F.RunL4NetLBController {
 		l4netlbController := controllers.NewL4NetLBController(ctx, option.stopCh, logger)
-		systemHealth.AddHealthCheck(controllers.L4NetLBControllerName, l4netlbController.SystemHealth)
+		systemHealth.AddStatusCheck(controllers.L4NetLBControllerName, l4netlbController.SystemHealth)
+		option.debugRegistry.AddProvider("l4", l4netlbController.DebugInfo)
 
 		runWithWg(l4netlbController.Run, option.wg)
 		logger.V(0).Info("L4NetLB controller started")
 	}
 
+	if flags.F.FirewallAuditPeriod > 0 && (flags.F.RunL4Controller || flags.F.RunL4NetLBController) {
+		fwAuditor := firewalls.NewFirewallAuditor(ctx, nil, true, flags.F.EnableFirewallAuditRepair, flags.F.FirewallAuditPeriod, option.stopCh, logger)
+		runWithWg(fwAuditor.Run, option.wg)
+		logger.V(0).Info("L4 firewall auditor started")
+	}
+
 	if flags.F.RunL4StandaloneNEGController {
 		standaloneNEGLBController := controllers.NewStandaloneNEGLBController(ctx, option.stopCh, logger)
 		runWithWg(standaloneNEGLBController.Run, option.wg)
@@ -675,16 +727,52 @@ func runL4Controllers(ctx *ingctx.ControllerContext, systemHealth *systemhealth.
 	ctx.Start(option.stopCh)
 }
 
+// runShardedNEGController runs the NEG controller for the shards whose leases
+// are held by this replica, rather than for all Services once elected leader.
+func runShardedNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, option runOption, leOption leaderElectionOption, logger klog.Logger) error {
+	sharder, err := shard.NewSharder(leOption.client, shard.Config{
+		Shards:          flags.F.NEGShards,
+		Namespace:       flags.F.LeaderElection.LockObjectNamespace,
+		LeaseNamePrefix: negShardLockPrefix,
+		Identity:        leOption.id,
+		LeaseDuration:   flags.F.LeaderElection.LeaseDuration.Duration,
+		RenewDeadline:   flags.F.LeaderElection.RenewDeadline.Duration,
+		RetryPeriod:     flags.F.LeaderElection.RetryPeriod.Duration,
+		// Wait for the services of acquired shards to be processed before
+		// garbage collecting their NEGs.
+		GCDelay: flags.F.NegGCPeriod,
+	}, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create NEG sharder: %w", err)
+	}
+	// Use a cancelable context so the leases are released on shutdown.
+	shardCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-option.stopCh
+		cancel()
+	}()
+	runWithWg(func() { sharder.Run(shardCtx) }, option.wg)
+	return startNEGController(ctx, systemHealth, option, sharder, logger)
+}
+
 func runNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, option runOption, logger klog.Logger) error {
 	lockLogger := logger.WithValues("lockName", negLockName)
 	lockLogger.Info("Attempting to grab lock", "lockName", negLockName)
 	go collectLockAvailabilityMetrics(negLockName, flags.F.GKEClusterType, option.stopCh, logger)
 
+	return startNEGController(ctx, systemHealth, option, negtypes.NoSharding{}, logger)
+}
+
+// startNEGController starts the NEG controller for the Services of the shards
+// owned by shardOwner. The sharded NEG controller does not use negLockName, so
+// it does not report its availability.
+func startNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, option runOption, shardOwner negtypes.ShardOwner, logger klog.Logger) error {
 	if flags.F.EnableNEGController {
-		negController, err := createNEGController(ctx, systemHealth, option.stopCh, logger)
+		negController, err := createNEGController(ctx, systemHealth, shardOwner, option.stopCh, logger)
 		if err != nil {
 			return fmt.Errorf("failed to create NEG controller: %w", err)
 		}
+		option.debugRegistry.AddProvider("neg", negController.DebugInfo)
 		go runWithWg(negController.Run, option.wg)
 		logger.V(0).Info("negController started")
 	}
@@ -698,7 +786,7 @@ func runNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.
 	return nil
 }
 
-func createNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, stopCh <-chan struct{}, logger klog.Logger) (*neg.Controller, error) {
+func createNEGController(ctx *ingctx.ControllerContext, systemHealth *systemhealth.SystemHealth, shardOwner negtypes.ShardOwner, stopCh <-chan struct{}, logger klog.Logger) (*neg.Controller, error) {
 	zoneGetter := ctx.ZoneGetter
 
 	// In NonGCP mode, use the zone specified in gce.conf directly.
@@ -764,6 +852,7 @@ func createNEGController(ctx *ingctx.ControllerContext, systemHealth *systemheal
 		flags.F.ReadOnlyMode,
 		flags.F.EnableNEGsForIngress,
 		flags.F.EnableL4NEGLocalIncludeDrainNodes,
+		shardOwner,
 		stopCh,
 		logger,
 		negMetrics,
@@ -773,7 +862,7 @@ func createNEGController(ctx *ingctx.ControllerContext, systemHealth *systemheal
 		return nil, fmt.Errorf("failed to create NEG controller: %w", err)
 	}
 
-	systemHealth.AddHealthCheck("neg-controller", negController.IsHealthy)
+	systemHealth.AddStatusCheck("neg-controller", negController.HealthStatus)
 	return negController, nil
 }
 
@@ -823,3 +912,40 @@ func waitWithTimeout(wg *sync.WaitGroup, logger klog.Logger) {
 		return
 	}
 }
+
+// newControllerContextConfig returns the ControllerContextConfig built from flags.
+func newControllerContextConfig(defaultBackendServicePort utils.ServicePort) ingctx.ControllerContextConfig {
+	return ingctx.ControllerContextConfig{
+		Namespace:                            flags.F.WatchNamespace,
+		ResyncPeriod:                         flags.F.ResyncPeriod,
+		NumL4Workers:                         flags.F.NumL4Workers,
+		NumL4NetLBWorkers:                    flags.F.NumL4NetLBWorkers,
+		DefaultBackendSvcPort:                defaultBackendServicePort,
+		HealthCheckPath:                      flags.F.HealthCheckPath,
+		MaxIGSize:                            flags.F.MaxIGSize,
+		EnableMultipleIGs:                    flags.F.EnableMultipleIGs,
+		IGAdoptionNamePrefixes:               utils.SplitAnnotation(flags.F.IGAdoptionNamePrefixes),
+		IGAdoptionLabel:                      flags.F.IGAdoptionLabel,
+		RunL4ILBController:                   flags.F.RunL4Controller,
+		RunL4NetLBController:                 flags.F.RunL4NetLBController,
+		RunL4StandaloneNEGController:         flags.F.RunL4StandaloneNEGController,
+		EnableL4ILBDualStack:                 flags.F.EnableL4ILBDualStack,
+		EnableL4NetLBDualStack:               flags.F.EnableL4NetLBDualStack,
+		EnableL4StrongSessionAffinity:        flags.F.EnableL4StrongSessionAffinity,
+		EnableMultinetworking:                flags.F.EnableMultiNetworking,
+		EnableIngressRegionalExternal:        flags.F.EnableIngressRegionalExternal,
+		EnableWeightedL4ILB:                  flags.F.EnableWeightedL4ILB,
+		EnableWeightedL4NetLB:                flags.F.EnableWeightedL4NetLB,
+		DisableL4LBFirewall:                  flags.F.DisableL4LBFirewall,
+		EnableL4NetLBNEGs:                    flags.F.EnableL4NetLBNEG,
+		EnableL4NetLBNEGsDefault:             flags.F.EnableL4NetLBNEGDefault,
+		EnableL4ILBMixedProtocol:             flags.F.EnableL4ILBMixedProtocol,
+		EnableL4NetLBMixedProtocol:           flags.F.EnableL4NetLBMixedProtocol,
+		EnableL3ForNetLBMixedProtocol:        flags.F.EnableL3ForwardingRuleForNetLBMixedProtocol,
+		EnableL4DenyFirewalls:                flags.F.EnableL4DenyFirewall,
+		EnableL4DenyFirewallsRollbackCleanup: flags.F.EnableL4DenyFirewallRollbackCleanup,
+		EnableL4ILBZonalAffinity:             flags.F.EnableL4ILBZonalAffinity,
+		ReadOnlyMode:                         flags.F.ReadOnlyMode,
+		EnableL4NetLBRBSByDefault:            flags.F.EnableL4NetLBRBSByDefault,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/systemhealth"
 	"k8s.io/klog/v2"
 )
@@ -37,24 +38,26 @@ func TestOnStoppedLeadingClosesRootStop(t *testing.T) {
 		wg:          &sync.WaitGroup{},
 		closeStopCh: func() {},
 	}
+	sh := systemhealth.NewSystemHealth(klog.TODO())
 
 	cases := []struct {
 		name        string
+		lock        string
 		buildRunner func(runOption) (*leaderelection.LeaderElectionConfig, error)
 	}{
 		{
 			name: "neg_elector",
+			lock: negLockName,
 			buildRunner: func(ro runOption) (*leaderelection.LeaderElectionConfig, error) {
 				var ctx *ingctx.ControllerContext
-				var sh *systemhealth.SystemHealth
 				return makeNEGRunnerWithLeaderElection(ctx, sh, ro, le, klog.TODO())
 			},
 		},
 		{
 			name: "ingress_elector",
+			lock: flags.F.LeaderElection.LockObjectName,
 			buildRunner: func(ro runOption) (*leaderelection.LeaderElectionConfig, error) {
 				var ctx *ingctx.ControllerContext
-				var sh *systemhealth.SystemHealth
 				return makeIngressRunnerWithLeaderElection(ctx, sh, ro, le, klog.TODO())
 			},
 		},
@@ -85,6 +88,9 @@ func TestOnStoppedLeadingClosesRootStop(t *testing.T) {
 
 			// Simulate loss of leadership.
 			cfg.Callbacks.OnStoppedLeading()
+			if leading, ok := sh.Check().Leading[tc.lock]; !ok || leading {
+				t.Errorf("Leading[%q] = %v, %v, want false, true", tc.lock, leading, ok)
+			}
 
 			select {
 			case <-closed:
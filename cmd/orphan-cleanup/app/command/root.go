@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/ingress-gce/pkg/e2e"
+	"k8s.io/ingress-gce/pkg/utils/inventory"
+	"k8s.io/klog/v2"
+)
+
+var (
+	kubeconfig     string
+	kubecontext    string
+	project        string
+	region         string
+	clusterUID     string
+	firewallName   string
+	kubeSystemUID  string
+	clusterDeleted bool
+	dryRun         bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "orphan-cleanup",
+	Short: "orphan-cleanup lists and deletes GCE resources leaked by the ingress-gce controllers of a cluster.",
+	Long: `orphan-cleanup lists the URL maps, target proxies, forwarding rules, backend services,
+health checks, NEGs, SSL certificates, firewalls and addresses created for a cluster, and
+reports the ones whose Ingress or Service no longer exists. Orphaned resources are only
+deleted with --dry-run=false.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		logger := klog.TODO()
+		if project == "" {
+			return fmt.Errorf("--project is required")
+		}
+
+		cluster := inventory.Cluster{
+			UID:           clusterUID,
+			FirewallName:  firewallName,
+			KubeSystemUID: types.UID(kubeSystemUID),
+		}
+		var client kubernetes.Interface
+		if !clusterDeleted {
+			var err error
+			if client, err = newClientSet(kubecontext, kubeconfig); err != nil {
+				return fmt.Errorf("error connecting to Kubernetes: %w", err)
+			}
+			if err := inventory.LookupCluster(ctx, client, &cluster); err != nil {
+				return err
+			}
+		}
+
+		gceCloud, err := e2e.NewCloud(project, "")
+		if err != nil {
+			return fmt.Errorf("error connecting to GCE: %w", err)
+		}
+		resources, err := inventory.Inventory(ctx, gceCloud, client, inventory.Options{
+			Cluster:        cluster,
+			Region:         region,
+			ClusterDeleted: clusterDeleted,
+		}, logger)
+		if err != nil {
+			return err
+		}
+
+		var errs map[*inventory.Resource]error
+		if !dryRun {
+			errs = inventory.Cleanup(ctx, gceCloud, resources, logger)
+		}
+		if err := printResources(os.Stdout, resources, errs, dryRun); err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to delete %d orphaned resources", len(errs))
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&kubeconfig, "kubeconfig", "k", "", "path to the kubeconfig file for Kubernetes config")
+	rootCmd.PersistentFlags().StringVarP(&kubecontext, "context", "c", "", "context to use for Kubernetes config")
+	rootCmd.PersistentFlags().StringVar(&project, "project", "", "GCP project of the cluster")
+	rootCmd.PersistentFlags().StringVar(&region, "region", "", "also inventory regional resources of this region")
+	rootCmd.PersistentFlags().StringVar(&clusterUID, "cluster-uid", "", "cluster UID embedded in v1 resource names, read from the kube-system/ingress-uid config map if unset")
+	rootCmd.PersistentFlags().StringVar(&firewallName, "firewall-name", "", "name embedded in the L7 firewall rule, read from the kube-system/ingress-uid config map if unset")
+	rootCmd.PersistentFlags().StringVar(&kubeSystemUID, "kube-system-uid", "", "UID of the kube-system namespace whose hash is embedded in v2 resource names, read from the cluster if unset")
+	rootCmd.PersistentFlags().BoolVar(&clusterDeleted, "cluster-deleted", false, "the cluster no longer exists: do not connect to Kubernetes and treat every resource of the cluster as orphaned")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", true, "only report orphaned resources, do not delete them")
+}
+
+// printResources writes a table of the inventoried resources. errs holds the
+// deletion errors, it is nil in dry run mode.
+func printResources(out io.Writer, resources []*inventory.Resource, errs map[*inventory.Resource]error, dryRun bool) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tLOCATION\tNAME\tSTATUS\tREASON")
+	counts := make(map[inventory.Status]int)
+	for _, r := range resources {
+		counts[r.Status]++
+		reason := r.Reason
+		if r.Status == inventory.StatusOrphaned && !dryRun {
+			if err, ok := errs[r]; ok {
+				reason = fmt.Sprintf("%s; delete failed: %v", reason, err)
+			} else {
+				reason = fmt.Sprintf("%s; deleted", reason)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Type, r.Location(), r.Key.Name, r.Status, reason)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%d in use, %d orphaned, %d unknown\n", counts[inventory.StatusInUse], counts[inventory.StatusOrphaned], counts[inventory.StatusUnknown])
+	if err == nil && dryRun && counts[inventory.StatusOrphaned] > 0 {
+		_, err = fmt.Fprintln(out, "Dry run: re-run with --dry-run=false to delete the orphaned resources.")
+	}
+	return err
+}
+
+// Execute is the primary entrypoint for this CLI
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -55,6 +55,8 @@ const (
 	ProtocolHTTPS AppProtocol = "HTTPS"
 	// ProtocolHTTP2 protocol for a service
 	ProtocolHTTP2 AppProtocol = "HTTP2"
+	// ProtocolGRPC protocol for a service
+	ProtocolGRPC AppProtocol = "GRPC"
 )
 
 // THCAnnotation is the format of the annotation associated with the THCAnnotationKey key.
@@ -97,7 +99,7 @@ func (svc *Service) ApplicationProtocols() (map[string]AppProtocol, error) {
 	for _, proto := range portToProtos {
 		switch proto {
 		case ProtocolHTTP, ProtocolHTTPS:
-		case ProtocolHTTP2:
+		case ProtocolHTTP2, ProtocolGRPC:
 		default:
 			return nil, fmt.Errorf("invalid port application protocol: %v", proto)
 		}
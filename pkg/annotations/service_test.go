@@ -75,6 +75,16 @@ func TestService(t *testing.T) {
 			},
 			appProtocols: map[string]AppProtocol{"443": "HTTP2"},
 		},
+		{
+			svc: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceApplicationProtocolKey: `{"50051": "GRPC"}`,
+					},
+				},
+			},
+			appProtocols: map[string]AppProtocol{"50051": "GRPC"},
+		},
 		{
 			svc: &v1.Service{
 				ObjectMeta: metav1.ObjectMeta{
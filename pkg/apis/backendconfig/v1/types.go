@@ -230,6 +230,9 @@ type HealthCheckConfig struct {
 	// RequestPath is a health check parameter. See
 	// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
 	RequestPath *string `json:"requestPath,omitempty"`
+	// GRPCServiceName is the gRPC service name checked by GRPC health checks.
+	// See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
+	GRPCServiceName *string `json:"grpcServiceName,omitempty"`
 }
 
 // LogConfig contains configuration for logging.
@@ -400,6 +400,11 @@ func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GRPCServiceName != nil {
+		in, out := &in.GRPCServiceName, &out.GRPCServiceName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
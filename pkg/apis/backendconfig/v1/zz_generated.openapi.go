@@ -486,6 +486,13 @@ func schema_pkg_apis_backendconfig_v1_HealthCheckConfig(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"grpcServiceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPCServiceName is the gRPC service name checked by GRPC health checks. See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -41,6 +41,10 @@ type L4LBConfigSpec struct {
 	// +k8s:validation:cel[0]:message="optionalFields can only be set when optionalMode is 'CUSTOM', and must be set when optionalMode is 'CUSTOM'"
 	// +optional
 	Logging *LoggingConfig `json:"logging,omitempty"`
+
+	// ConnectionTracking defines the connection tracking policy of the L4 Load Balancer backend service.
+	// +optional
+	ConnectionTracking *ConnectionTrackingConfig `json:"connectionTracking,omitempty"`
 }
 
 // L4LBConfigStatus defines the observed state of L4LBConfig
@@ -93,3 +97,45 @@ const (
 	LoggingOptionalModeExcludeAllOptional = LoggingOptionalMode("EXCLUDE_ALL_OPTIONAL")
 	LoggingOptionalModeCustom             = LoggingOptionalMode("CUSTOM")
 )
+
+// ConnectionTrackingConfig defines the connection tracking policy of the backend service.
+// +k8s:openapi-gen=true
+type ConnectionTrackingConfig struct {
+	// TrackingMode defines the key used for tracking connections.
+	// Options: PER_CONNECTION, PER_SESSION.
+	// PER_SESSION requires the Service to use ClientIP session affinity.
+	// +optional
+	TrackingMode ConnectionTrackingMode `json:"trackingMode,omitempty"`
+
+	// ConnectionPersistenceOnUnhealthyBackends defines the behavior of existing connections
+	// when their backend becomes unhealthy.
+	// Options: DEFAULT_FOR_PROTOCOL, NEVER_PERSIST, ALWAYS_PERSIST.
+	// +optional
+	ConnectionPersistenceOnUnhealthyBackends ConnectionPersistenceMode `json:"connectionPersistenceOnUnhealthyBackends,omitempty"`
+
+	// IdleTimeoutSec is the idle timeout of tracked connections, from 60 to 57600 seconds.
+	// If unset, the timeout of ClientIP session affinity is used for PER_SESSION tracking.
+	// +k8s:validation:maximum=57600
+	// +k8s:validation:minimum=60
+	// +optional
+	IdleTimeoutSec *int32 `json:"idleTimeoutSec,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// +enum
+type ConnectionTrackingMode string
+
+const (
+	ConnectionTrackingModePerConnection = ConnectionTrackingMode("PER_CONNECTION")
+	ConnectionTrackingModePerSession    = ConnectionTrackingMode("PER_SESSION")
+)
+
+// +k8s:openapi-gen=true
+// +enum
+type ConnectionPersistenceMode string
+
+const (
+	ConnectionPersistenceModeDefaultForProtocol = ConnectionPersistenceMode("DEFAULT_FOR_PROTOCOL")
+	ConnectionPersistenceModeNeverPersist       = ConnectionPersistenceMode("NEVER_PERSIST")
+	ConnectionPersistenceModeAlwaysPersist      = ConnectionPersistenceMode("ALWAYS_PERSIST")
+)
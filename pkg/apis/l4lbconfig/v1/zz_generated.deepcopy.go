@@ -25,6 +25,27 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionTrackingConfig) DeepCopyInto(out *ConnectionTrackingConfig) {
+	*out = *in
+	if in.IdleTimeoutSec != nil {
+		in, out := &in.IdleTimeoutSec, &out.IdleTimeoutSec
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTrackingConfig.
+func (in *ConnectionTrackingConfig) DeepCopy() *ConnectionTrackingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionTrackingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *L4LBConfig) DeepCopyInto(out *L4LBConfig) {
 	*out = *in
@@ -94,6 +115,11 @@ func (in *L4LBConfigSpec) DeepCopyInto(out *L4LBConfigSpec) {
 		*out = new(LoggingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConnectionTracking != nil {
+		in, out := &in.ConnectionTracking, &out.ConnectionTracking
+		*out = new(ConnectionTrackingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
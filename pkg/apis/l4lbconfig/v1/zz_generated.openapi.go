@@ -29,10 +29,49 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfig":       schema_pkg_apis_l4lbconfig_v1_L4LBConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfigSpec":   schema_pkg_apis_l4lbconfig_v1_L4LBConfigSpec(ref),
-		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfigStatus": schema_pkg_apis_l4lbconfig_v1_L4LBConfigStatus(ref),
-		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.LoggingConfig":    schema_pkg_apis_l4lbconfig_v1_LoggingConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.ConnectionTrackingConfig": schema_pkg_apis_l4lbconfig_v1_ConnectionTrackingConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfig":               schema_pkg_apis_l4lbconfig_v1_L4LBConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfigSpec":           schema_pkg_apis_l4lbconfig_v1_L4LBConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.L4LBConfigStatus":         schema_pkg_apis_l4lbconfig_v1_L4LBConfigStatus(ref),
+		"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.LoggingConfig":            schema_pkg_apis_l4lbconfig_v1_LoggingConfig(ref),
+	}
+}
+
+func schema_pkg_apis_l4lbconfig_v1_ConnectionTrackingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectionTrackingConfig defines the connection tracking policy of the backend service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trackingMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrackingMode defines the key used for tracking connections. Options: PER_CONNECTION, PER_SESSION. PER_SESSION requires the Service to use ClientIP session affinity.\n\nPossible enum values:\n - `\"PER_CONNECTION\"`\n - `\"PER_SESSION\"`",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"PER_CONNECTION", "PER_SESSION"},
+						},
+					},
+					"connectionPersistenceOnUnhealthyBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionPersistenceOnUnhealthyBackends defines the behavior of existing connections when their backend becomes unhealthy. Options: DEFAULT_FOR_PROTOCOL, NEVER_PERSIST, ALWAYS_PERSIST.\n\nPossible enum values:\n - `\"ALWAYS_PERSIST\"`\n - `\"DEFAULT_FOR_PROTOCOL\"`\n - `\"NEVER_PERSIST\"`",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"ALWAYS_PERSIST", "DEFAULT_FOR_PROTOCOL", "NEVER_PERSIST"},
+						},
+					},
+					"idleTimeoutSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeoutSec is the idle timeout of tracked connections, from 60 to 57600 seconds. If unset, the timeout of ClientIP session affinity is used for PER_SESSION tracking.",
+							Minimum:     ptr.To[float64](60),
+							Maximum:     ptr.To[float64](57600),
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -101,11 +140,17 @@ func schema_pkg_apis_l4lbconfig_v1_L4LBConfigSpec(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.LoggingConfig"),
 						},
 					},
+					"connectionTracking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionTracking defines the connection tracking policy of the L4 Load Balancer backend service.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.ConnectionTrackingConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.LoggingConfig"},
+			"k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.ConnectionTrackingConfig", "k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1.LoggingConfig"},
 	}
 }
 
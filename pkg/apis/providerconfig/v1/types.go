@@ -56,6 +56,13 @@ type ProviderConfigSpec struct {
 	// PrincipalInfo contains information about the principal entity associated with this configuration.
 	// This field is optional.
 	PrincipalInfo *PrincipalInfo `json:"principalInfo"`
+	// GCERateLimits are the rate limiting specs of the Compute API calls made for the
+	// ProviderConfig, in the format of the --gce-ratelimit flag. A spec replaces the
+	// flag spec of the same operation and type; other flag specs still apply.
+	//
+	// +listType=atomic
+	// +optional
+	GCERateLimits []string `json:"gceRateLimits,omitempty"`
 }
 
 // ProviderNetworkConfig specifies the network configuration for the provider config.
@@ -85,6 +92,7 @@ type ProviderConfigSecondaryRange struct {
 }
 
 // ProviderConfigStatus defines the current state of ProviderConfig.
+// +k8s:openapi-gen=true
 type ProviderConfigStatus struct {
 	// Conditions describe the current conditions of the ProviderConfig.
 	//
@@ -92,6 +100,40 @@ type ProviderConfigStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Controllers describe the state of the per-project controllers of the ProviderConfig.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Controllers []ProviderConfigControllerStatus `json:"controllers,omitempty"`
+	// ManagedResources counts the resources managed for the ProviderConfig.
+	//
+	// +optional
+	ManagedResources *ProviderConfigManagedResources `json:"managedResources,omitempty"`
+}
+
+// ProviderConfigControllerStatus describes the state of a controller running for the ProviderConfig.
+// +k8s:openapi-gen=true
+type ProviderConfigControllerStatus struct {
+	// Name of the controller.
+	Name string `json:"name"`
+	// Running is true if the controller is running for the ProviderConfig.
+	Running bool `json:"running"`
+	// InformersSynced is true if the informer caches used by the controller are synced.
+	InformersSynced bool `json:"informersSynced"`
+	// LastSyncTime is the last time the controller was observed running with synced informer caches.
+	//
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// ProviderConfigManagedResources counts the resources managed for the ProviderConfig.
+// +k8s:openapi-gen=true
+type ProviderConfigManagedResources struct {
+	// NEGs is the number of ServiceNetworkEndpointGroups of the ProviderConfig.
+	NEGs int32 `json:"negs"`
+	// Services is the number of Services of the ProviderConfig.
+	Services int32 `json:"services"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -109,4 +151,9 @@ type PrincipalInfo struct {
 	// Usecases include: adding tags to the metrics and logs, naming GCP resources.
 	// +kubebuilder:validation:MinLength=1
 	ID string `json:"id"`
+	// ServiceAccount is the email of a GCP service account impersonated for the
+	// Compute API calls made for the ProviderConfig. The controller identity needs
+	// the roles/iam.serviceAccountTokenCreator role on it.
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
 }
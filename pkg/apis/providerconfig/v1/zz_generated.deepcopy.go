@@ -70,6 +70,26 @@ func (in *ProviderConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigControllerStatus) DeepCopyInto(out *ProviderConfigControllerStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigControllerStatus.
+func (in *ProviderConfigControllerStatus) DeepCopy() *ProviderConfigControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
 	*out = *in
@@ -103,6 +123,22 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigManagedResources) DeepCopyInto(out *ProviderConfigManagedResources) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigManagedResources.
+func (in *ProviderConfigManagedResources) DeepCopy() *ProviderConfigManagedResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigManagedResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfigSecondaryRange) DeepCopyInto(out *ProviderConfigSecondaryRange) {
 	*out = *in
@@ -128,6 +164,11 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(PrincipalInfo)
 		**out = **in
 	}
+	if in.GCERateLimits != nil {
+		in, out := &in.GCERateLimits, &out.GCERateLimits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -151,6 +192,18 @@ func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ProviderConfigControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = new(ProviderConfigManagedResources)
+		**out = **in
+	}
 	return
 }
 
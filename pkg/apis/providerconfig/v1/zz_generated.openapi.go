@@ -28,8 +28,11 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfig":     schema_pkg_apis_providerconfig_v1_ProviderConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigSpec": schema_pkg_apis_providerconfig_v1_ProviderConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfig":                 schema_pkg_apis_providerconfig_v1_ProviderConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigControllerStatus": schema_pkg_apis_providerconfig_v1_ProviderConfigControllerStatus(ref),
+		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigManagedResources": schema_pkg_apis_providerconfig_v1_ProviderConfigManagedResources(ref),
+		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigSpec":             schema_pkg_apis_providerconfig_v1_ProviderConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigStatus":           schema_pkg_apis_providerconfig_v1_ProviderConfigStatus(ref),
 	}
 }
 
@@ -80,6 +83,82 @@ func schema_pkg_apis_providerconfig_v1_ProviderConfig(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_providerconfig_v1_ProviderConfigControllerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfigControllerStatus describes the state of a controller running for the ProviderConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the controller.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"running": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Running is true if the controller is running for the ProviderConfig.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"informersSynced": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InformersSynced is true if the informer caches used by the controller are synced.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the controller was observed running with synced informer caches.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "running", "informersSynced"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_providerconfig_v1_ProviderConfigManagedResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfigManagedResources counts the resources managed for the ProviderConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"negs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NEGs is the number of ServiceNetworkEndpointGroups of the ProviderConfig.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the number of Services of the ProviderConfig.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"negs", "services"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_providerconfig_v1_ProviderConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -123,6 +202,26 @@ func schema_pkg_apis_providerconfig_v1_ProviderConfigSpec(ref common.ReferenceCa
 							Ref:         ref("k8s.io/ingress-gce/pkg/apis/providerconfig/v1.PrincipalInfo"),
 						},
 					},
+					"gceRateLimits": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "GCERateLimits are the rate limiting specs of the Compute API calls made for the ProviderConfig, in the format of the --gce-ratelimit flag. A spec replaces the flag spec of the same operation and type; other flag specs still apply.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"projectNumber", "projectID", "pscConnectionID", "networkConfig", "principalInfo"},
 			},
@@ -131,3 +230,68 @@ func schema_pkg_apis_providerconfig_v1_ProviderConfigSpec(ref common.ReferenceCa
 			"k8s.io/ingress-gce/pkg/apis/providerconfig/v1.PrincipalInfo", "k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderNetworkConfig"},
 	}
 }
+
+func schema_pkg_apis_providerconfig_v1_ProviderConfigStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfigStatus defines the current state of ProviderConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions describe the current conditions of the ProviderConfig.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"controllers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Controllers describe the state of the per-project controllers of the ProviderConfig.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigControllerStatus"),
+									},
+								},
+							},
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources counts the resources managed for the ProviderConfig.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigManagedResources"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigControllerStatus", "k8s.io/ingress-gce/pkg/apis/providerconfig/v1.ProviderConfigManagedResources"},
+	}
+}
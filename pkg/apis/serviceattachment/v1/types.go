@@ -46,7 +46,7 @@ type ServiceAttachmentSpec struct {
 	NATSubnets []string `json:"natSubnets,omitempty"`
 
 	// ResourceRef is the reference to the K8s resource that created the forwarding rule
-	// Only Services can be used as a reference
+	// Services and internal (gce-internal) Ingresses can be used as a reference
 	// +required
 	ResourceRef corev1.TypedLocalObjectReference `json:"resourceRef,omitempty"`
 
@@ -71,6 +71,68 @@ type ServiceAttachmentSpec struct {
 	// +optional
 	// +listType=atomic
 	ConsumerRejectList []string `json:"consumerRejectList,omitempty"`
+
+	// ConsumerApprovals are the decisions on consumer projects connecting to a
+	// ServiceAttachment with the ACCEPT_MANUAL connection preference. Accepted
+	// projects are added to the consumer accept list and rejected projects to the
+	// consumer reject list, unless the project is already in ConsumerAllowList or
+	// ConsumerRejectList.
+	// +optional
+	// +listType=atomic
+	ConsumerApprovals []ConsumerApproval `json:"consumerApprovals,omitempty"`
+
+	// IPFamilies selects the forwarding rules of a dual-stack resource to publish.
+	// A Service Attachment is created for each listed family, IPv4 and IPv6.
+	// Defaults to IPv4 when empty.
+	// +optional
+	// +listType=atomic
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
+
+	// DomainNames are the DNS domain names used by consumers in Cloud DNS
+	// integration, e.g. "p.mycompany.com.". When not set, the domain names
+	// of an existing GCE Service Attachment are kept, an empty list clears
+	// them.
+	// +optional
+	// +listType=atomic
+	DomainNames *[]string `json:"domainNames,omitempty"`
+
+	// PropagatedConnectionLimit is the number of consumer spokes that connected
+	// Private Service Connect endpoints can be propagated to through Network
+	// Connectivity Center. When not set, the limit of an existing GCE Service
+	// Attachment is kept.
+	// +optional
+	PropagatedConnectionLimit *int64 `json:"propagatedConnectionLimit,omitempty"`
+}
+
+// ConsumerApprovalDecision is the decision on the connections of a consumer project
+type ConsumerApprovalDecision string
+
+const (
+	// ConsumerApprovalAccept accepts the connections of the consumer project
+	ConsumerApprovalAccept ConsumerApprovalDecision = "Accept"
+	// ConsumerApprovalReject rejects the connections of the consumer project
+	ConsumerApprovalReject ConsumerApprovalDecision = "Reject"
+)
+
+// ConsumerApproval is the decision on the connections of a consumer project
+// +k8s:openapi-gen=true
+type ConsumerApproval struct {
+	// Project is the project id or number of the consumer, as reported in
+	// ServiceAttachmentStatus.ConsumerForwardingRules
+	// +required
+	Project string `json:"project,omitempty"`
+
+	// Decision is either Accept or Reject
+	// +required
+	Decision ConsumerApprovalDecision `json:"decision,omitempty"`
+
+	// ConnectionLimit is the connection limit for an accepted Consumer project
+	// +optional
+	ConnectionLimit int64 `json:"connectionLimit,omitempty"`
+
+	// Reason records why the decision was made
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // ConsumerProject is the consumer project and project level configuration
@@ -119,6 +181,26 @@ type ServiceAttachmentStatus struct {
 	// +optional
 	ForwardingRuleURL string `json:"forwardingRuleURL,omitempty"`
 
+	// IPv6ServiceAttachmentURL is the URL for the GCE Service Attachment resource
+	// of the IPv6 forwarding rule
+	// +optional
+	IPv6ServiceAttachmentURL string `json:"ipv6ServiceAttachmentURL,omitempty"`
+
+	// IPv6ForwardingRuleURL is the URL to the IPv6 GCE Forwarding Rule resource the
+	// IPv6 Service Attachment points to
+	// +optional
+	IPv6ForwardingRuleURL string `json:"ipv6ForwardingRuleURL,omitempty"`
+
+	// PSCServiceAttachmentID is the 128-bit global unique id of the GCE Service
+	// Attachment, in decimal
+	// +optional
+	PSCServiceAttachmentID string `json:"pscServiceAttachmentID,omitempty"`
+
+	// IPv6PSCServiceAttachmentID is the 128-bit global unique id of the IPv6 GCE
+	// Service Attachment, in decimal
+	// +optional
+	IPv6PSCServiceAttachmentID string `json:"ipv6PSCServiceAttachmentID,omitempty"`
+
 	// Consumer Forwarding Rules using ts Service Attachment
 	// +listType=atomic
 	// +optional
@@ -137,6 +219,14 @@ type ConsumerForwardingRule struct {
 
 	// Status of consumer forwarding rule
 	Status string `json:"status,omitempty"`
+
+	// Project of the consumer forwarding rule
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// PSCConnectionID is the PSC connection id of the consumer forwarding rule
+	// +optional
+	PSCConnectionID string `json:"pscConnectionID,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
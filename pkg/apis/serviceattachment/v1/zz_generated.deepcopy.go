@@ -22,9 +22,26 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsumerApproval) DeepCopyInto(out *ConsumerApproval) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerApproval.
+func (in *ConsumerApproval) DeepCopy() *ConsumerApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsumerApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsumerForwardingRule) DeepCopyInto(out *ConsumerForwardingRule) {
 	*out = *in
@@ -154,6 +171,30 @@ func (in *ServiceAttachmentSpec) DeepCopyInto(out *ServiceAttachmentSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConsumerApprovals != nil {
+		in, out := &in.ConsumerApprovals, &out.ConsumerApprovals
+		*out = make([]ConsumerApproval, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.PropagatedConnectionLimit != nil {
+		in, out := &in.PropagatedConnectionLimit, &out.PropagatedConnectionLimit
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
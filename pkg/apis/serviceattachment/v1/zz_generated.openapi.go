@@ -28,6 +28,7 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerApproval":        schema_pkg_apis_serviceattachment_v1_ConsumerApproval(ref),
 		"k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerForwardingRule":  schema_pkg_apis_serviceattachment_v1_ConsumerForwardingRule(ref),
 		"k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerProject":         schema_pkg_apis_serviceattachment_v1_ConsumerProject(ref),
 		"k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ServiceAttachment":       schema_pkg_apis_serviceattachment_v1_ServiceAttachment(ref),
@@ -36,6 +37,48 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_serviceattachment_v1_ConsumerApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsumerApproval is the decision on the connections of a consumer project",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project is the project id or number of the consumer, as reported in ServiceAttachmentStatus.ConsumerForwardingRules",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"decision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decision is either Accept or Reject",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectionLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionLimit is the connection limit for an accepted Consumer project",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason records why the decision was made",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"project", "decision"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_serviceattachment_v1_ConsumerForwardingRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -57,6 +100,20 @@ func schema_pkg_apis_serviceattachment_v1_ConsumerForwardingRule(ref common.Refe
 							Format:      "",
 						},
 					},
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project of the consumer forwarding rule",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pscConnectionID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PSCConnectionID is the PSC connection id of the consumer forwarding rule",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -214,7 +271,7 @@ func schema_pkg_apis_serviceattachment_v1_ServiceAttachmentSpec(ref common.Refer
 					},
 					"resourceRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceRef is the reference to the K8s resource that created the forwarding rule Only Services can be used as a reference",
+							Description: "ResourceRef is the reference to the K8s resource that created the forwarding rule Services and internal (gce-internal) Ingresses can be used as a reference",
 							Default:     map[string]interface{}{},
 							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
@@ -272,12 +329,78 @@ func schema_pkg_apis_serviceattachment_v1_ServiceAttachmentSpec(ref common.Refer
 							},
 						},
 					},
+					"consumerApprovals": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsumerApprovals are the decisions on consumer projects connecting to a ServiceAttachment with the ACCEPT_MANUAL connection preference. Accepted projects are added to the consumer accept list and rejected projects to the consumer reject list, unless the project is already in ConsumerAllowList or ConsumerRejectList.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerApproval"),
+									},
+								},
+							},
+						},
+					},
+					"ipFamilies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamilies selects the forwarding rules of a dual-stack resource to publish. A Service Attachment is created for each listed family, IPv4 and IPv6. Defaults to IPv4 when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"domainNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainNames are the DNS domain names used by consumers in Cloud DNS integration, e.g. \"p.mycompany.com.\". When not set, the domain names of an existing GCE Service Attachment are kept, an empty list clears them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"propagatedConnectionLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropagatedConnectionLimit is the number of consumer spokes that connected Private Service Connect endpoints can be propagated to through Network Connectivity Center. When not set, the limit of an existing GCE Service Attachment is kept.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"connectionPreference", "natSubnets", "resourceRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerProject"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerApproval", "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1.ConsumerProject"},
 	}
 }
 
@@ -302,6 +425,34 @@ func schema_pkg_apis_serviceattachment_v1_ServiceAttachmentStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"ipv6ServiceAttachmentURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6ServiceAttachmentURL is the URL for the GCE Service Attachment resource of the IPv6 forwarding rule",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6ForwardingRuleURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6ForwardingRuleURL is the URL to the IPv6 GCE Forwarding Rule resource the IPv6 Service Attachment points to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pscServiceAttachmentID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PSCServiceAttachmentID is the 128-bit global unique id of the GCE Service Attachment, in decimal",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6PSCServiceAttachmentID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6PSCServiceAttachmentID is the 128-bit global unique id of the IPv6 GCE Service Attachment, in decimal",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"consumerForwardingRules": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
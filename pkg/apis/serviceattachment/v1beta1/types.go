@@ -71,6 +71,21 @@ type ServiceAttachmentSpec struct {
 	// +optional
 	// +listType=atomic
 	ConsumerRejectList []string `json:"consumerRejectList,omitempty"`
+
+	// DomainNames are the DNS domain names used by consumers in Cloud DNS
+	// integration, e.g. "p.mycompany.com.". When not set, the domain names
+	// of an existing GCE Service Attachment are kept, an empty list clears
+	// them.
+	// +optional
+	// +listType=atomic
+	DomainNames *[]string `json:"domainNames,omitempty"`
+
+	// PropagatedConnectionLimit is the number of consumer spokes that connected
+	// Private Service Connect endpoints can be propagated to through Network
+	// Connectivity Center. When not set, the limit of an existing GCE Service
+	// Attachment is kept.
+	// +optional
+	PropagatedConnectionLimit *int64 `json:"propagatedConnectionLimit,omitempty"`
 }
 
 // ConsumerProject is the consumer project and project level configuration
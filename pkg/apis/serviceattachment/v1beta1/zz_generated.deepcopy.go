@@ -154,6 +154,20 @@ func (in *ServiceAttachmentSpec) DeepCopyInto(out *ServiceAttachmentSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.PropagatedConnectionLimit != nil {
+		in, out := &in.PropagatedConnectionLimit, &out.PropagatedConnectionLimit
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -272,6 +272,33 @@ func schema_pkg_apis_serviceattachment_v1beta1_ServiceAttachmentSpec(ref common.
 							},
 						},
 					},
+					"domainNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainNames are the DNS domain names used by consumers in Cloud DNS integration, e.g. \"p.mycompany.com.\". When not set, the domain names of an existing GCE Service Attachment are kept, an empty list clears them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"propagatedConnectionLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropagatedConnectionLimit is the number of consumer spokes that connected Private Service Connect endpoints can be propagated to through Network Connectivity Center. When not set, the limit of an existing GCE Service Attachment is kept.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"connectionPreference", "natSubnets", "resourceRef"},
 			},
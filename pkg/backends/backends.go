@@ -14,11 +14,13 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"google.golang.org/api/compute/v1"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/annotations"
 	"k8s.io/ingress-gce/pkg/backends/features"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/utils"
@@ -55,8 +57,18 @@ func ensureDescription(be *composite.BackendService, sp *utils.ServicePort) (nee
 	return true
 }
 
+// backendProtocol returns the BackendService protocol for the ServicePort.
+// GCE rejects the GRPC protocol behind HTTP(S) proxies, so GRPC ports are
+// served over HTTP2 and only their health checks use gRPC.
+func backendProtocol(sp *utils.ServicePort) string {
+	if sp.Protocol == annotations.ProtocolGRPC {
+		return string(annotations.ProtocolHTTP2)
+	}
+	return string(sp.Protocol)
+}
+
 // Create a composite BackendService and returns it.
-func (p *Pool) Create(sp utils.ServicePort, hcLink string, beLogger klog.Logger) (*composite.BackendService, error) {
+func (p *Pool) Create(ctx context.Context, sp utils.ServicePort, hcLink string, beLogger klog.Logger) (*composite.BackendService, error) {
 	name := sp.BackendName()
 	namedPort := &compute.NamedPort{
 		Name: p.namer.NamedPort(sp.NodePort),
@@ -67,7 +79,7 @@ func (p *Pool) Create(sp utils.ServicePort, hcLink string, beLogger klog.Logger)
 	be := &composite.BackendService{
 		Version:      version,
 		Name:         name,
-		Protocol:     string(sp.Protocol),
+		Protocol:     backendProtocol(&sp),
 		Port:         namedPort.Port,
 		PortName:     namedPort.Name,
 		HealthChecks: []string{hcLink},
@@ -93,17 +105,17 @@ func (p *Pool) Create(sp utils.ServicePort, hcLink string, beLogger klog.Logger)
 		return nil, err
 	}
 
-	if err := composite.CreateBackendService(p.cloud, key, be, beLogger); err != nil {
+	if err := composite.CreateBackendService(ctx, p.cloud, key, be, beLogger); err != nil {
 		return nil, err
 	}
 	// Note: We need to perform a GCE call to re-fetch the object we just created
 	// so that the "Fingerprint" field is filled in. This is needed to update the
 	// object without error.
-	return p.Get(name, version, scope, beLogger)
+	return p.Get(ctx, name, version, scope, beLogger)
 }
 
 // Update a BackendService given the composite type.
-func (p *Pool) Update(be *composite.BackendService, beLogger klog.Logger) error {
+func (p *Pool) Update(ctx context.Context, be *composite.BackendService, beLogger klog.Logger) error {
 	// Ensure the backend service has the proper version before updating.
 	be.Version = features.VersionFromDescription(be.Description)
 	scope, err := composite.ScopeFromSelfLink(be.SelfLink)
@@ -115,19 +127,19 @@ func (p *Pool) Update(be *composite.BackendService, beLogger klog.Logger) error
 	if err != nil {
 		return err
 	}
-	if err := composite.UpdateBackendService(p.cloud, key, be, beLogger); err != nil {
+	if err := composite.UpdateBackendService(ctx, p.cloud, key, be, beLogger); err != nil {
 		return err
 	}
 	return nil
 }
 
 // Get a composite BackendService given a required version.
-func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (*composite.BackendService, error) {
+func (p *Pool) Get(ctx context.Context, name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (*composite.BackendService, error) {
 	key, err := composite.CreateKey(p.cloud, name, scope)
 	if err != nil {
 		return nil, err
 	}
-	be, err := composite.GetBackendService(p.cloud, key, version, beLogger)
+	be, err := composite.GetBackendService(ctx, p.cloud, key, version, beLogger)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +149,7 @@ func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogg
 	versionRequired := features.VersionFromDescription(be.Description)
 
 	if features.IsLowerVersion(versionRequired, version) {
-		be, err = composite.GetBackendService(p.cloud, key, versionRequired, beLogger)
+		be, err = composite.GetBackendService(ctx, p.cloud, key, versionRequired, beLogger)
 		if err != nil {
 			return nil, err
 		}
@@ -146,7 +158,7 @@ func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogg
 }
 
 // Delete a BackendService given its name.
-func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) error {
+func (p *Pool) Delete(ctx context.Context, name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) error {
 	beLogger.Info("Deleting backend service")
 
 	key, err := composite.CreateKey(p.cloud, name, scope)
@@ -154,7 +166,7 @@ func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beL
 		return err
 	}
 	beLogger = beLogger.WithValues("backendKey", key)
-	err = composite.DeleteBackendService(p.cloud, key, version, beLogger)
+	err = composite.DeleteBackendService(ctx, p.cloud, key, version, beLogger)
 	if err != nil {
 		if utils.IsHTTPErrorCode(err, http.StatusNotFound) || utils.IsInUsedByError(err) {
 			// key also contains region information.
@@ -170,8 +182,8 @@ func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beL
 
 // Health checks the health of a BackendService given its name.
 // Returns ("HEALTHY", nil) if healthy, otherwise ("Unknown", err)
-func (p *Pool) Health(name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (string, error) {
-	be, err := p.Get(name, version, scope, beLogger)
+func (p *Pool) Health(ctx context.Context, name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (string, error) {
+	be, err := p.Get(ctx, name, version, scope, beLogger)
 	if err != nil {
 		return "Unknown", fmt.Errorf("error getting backend service %s: %w", name, err)
 	}
@@ -213,13 +225,13 @@ func (p *Pool) Health(name string, version meta.Version, scope meta.KeyType, beL
 }
 
 // List BackendService names that are managed by this pool.
-func (p *Pool) List(key *meta.Key, version meta.Version, beLogger klog.Logger) ([]*composite.BackendService, error) {
+func (p *Pool) List(ctx context.Context, key *meta.Key, version meta.Version, beLogger klog.Logger) ([]*composite.BackendService, error) {
 	// TODO: for consistency with the rest of this sub-package this method
 	// should return a list of backend ports.
 	var backends []*composite.BackendService
 	var err error
 
-	backends, err = composite.ListBackendServices(p.cloud, key, version, beLogger, filter.None)
+	backends, err = composite.ListBackendServices(ctx, p.cloud, key, version, beLogger, filter.None)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +253,7 @@ func (p *Pool) List(key *meta.Key, version meta.Version, beLogger klog.Logger) (
 }
 
 // AddSignedURLKey adds a SignedUrlKey to a BackendService
-func (p *Pool) AddSignedURLKey(be *composite.BackendService, signedurlkey *composite.SignedUrlKey, urlKeyLogger klog.Logger) error {
+func (p *Pool) AddSignedURLKey(ctx context.Context, be *composite.BackendService, signedurlkey *composite.SignedUrlKey, urlKeyLogger klog.Logger) error {
 	urlKeyLogger.Info("Adding SignedUrlKey")
 
 	scope, err := composite.ScopeFromSelfLink(be.SelfLink)
@@ -253,14 +265,14 @@ func (p *Pool) AddSignedURLKey(be *composite.BackendService, signedurlkey *compo
 	if err != nil {
 		return err
 	}
-	if err := composite.AddSignedUrlKey(p.cloud, key, be, signedurlkey, urlKeyLogger); err != nil {
+	if err := composite.AddSignedUrlKey(ctx, p.cloud, key, be, signedurlkey, urlKeyLogger); err != nil {
 		return err
 	}
 	return nil
 }
 
 // DeleteSignedURLKey deletes a SignedUrlKey from BackendService
-func (p *Pool) DeleteSignedURLKey(be *composite.BackendService, keyName string, urlKeyLogger klog.Logger) error {
+func (p *Pool) DeleteSignedURLKey(ctx context.Context, be *composite.BackendService, keyName string, urlKeyLogger klog.Logger) error {
 	urlKeyLogger.Info("Deleting SignedUrlKey")
 
 	scope, err := composite.ScopeFromSelfLink(be.SelfLink)
@@ -272,7 +284,7 @@ func (p *Pool) DeleteSignedURLKey(be *composite.BackendService, keyName string,
 	if err != nil {
 		return err
 	}
-	if err := composite.DeleteSignedUrlKey(p.cloud, key, be, keyName, urlKeyLogger); err != nil {
+	if err := composite.DeleteSignedUrlKey(ctx, p.cloud, key, be, keyName, urlKeyLogger); err != nil {
 		return err
 	}
 	return nil
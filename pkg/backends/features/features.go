@@ -63,7 +63,7 @@ func SetDescription(desc *utils.Description, sp *utils.ServicePort) {
 // given ServicePort that required non-GA API.
 func featuresFromServicePort(sp *utils.ServicePort) []string {
 	features := []string{}
-	if sp.Protocol == annotations.ProtocolHTTP2 {
+	if sp.Protocol == annotations.ProtocolHTTP2 || sp.Protocol == annotations.ProtocolGRPC {
 		features = append(features, FeatureHTTP2)
 	}
 	if sp.BackendConfig != nil && sp.BackendConfig.Spec.SecurityPolicy != nil {
@@ -47,6 +47,11 @@ var (
 		Protocol: annotations.ProtocolHTTP2,
 	}
 
+	svcPortWithGRPC = utils.ServicePort{
+		ID:       fakeSvcPortID,
+		Protocol: annotations.ProtocolGRPC,
+	}
+
 	svcPortWithSecurityPolicy = utils.ServicePort{
 		ID: fakeSvcPortID,
 		BackendConfig: &backendconfigv1.BackendConfig{
@@ -92,6 +97,11 @@ func TestFeaturesFromServicePort(t *testing.T) {
 			svcPort:          svcPortWithHTTP2,
 			expectedFeatures: []string{"HTTP2"},
 		},
+		{
+			desc:             "GRPC",
+			svcPort:          svcPortWithGRPC,
+			expectedFeatures: []string{"HTTP2"},
+		},
 		{
 			desc:             "SecurityPolicy",
 			svcPort:          svcPortWithSecurityPolicy,
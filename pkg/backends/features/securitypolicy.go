@@ -17,6 +17,7 @@ limitations under the License.
 package features
 
 import (
+	"context"
 	"fmt"
 
 	"k8s.io/klog/v2"
@@ -30,7 +31,7 @@ import (
 
 // EnsureSecurityPolicy ensures the security policy link on backend service.
 // TODO(mrhohn): Emit event when attach/detach security policy to backend service.
-func EnsureSecurityPolicy(cloud *gce.Cloud, sp utils.ServicePort, be *composite.BackendService, logger klog.Logger) error {
+func EnsureSecurityPolicy(ctx context.Context, cloud *gce.Cloud, sp utils.ServicePort, be *composite.BackendService, logger klog.Logger) error {
 	// It is too dangerous to remove user's security policy that may have been
 	// configured via the UI or gcloud directly rather than via Kubernetes.
 	// Treat nil security policy -> ignored
@@ -63,7 +64,7 @@ func EnsureSecurityPolicy(cloud *gce.Cloud, sp utils.ServicePort, be *composite.
 
 	if desiredPolicyName != "" {
 		logger.V(2).Info(fmt.Sprintf("Set security policy in backend service from %q to %q", existingPolicyName, desiredPolicyName), "backendName", be.Name, "serviceKey", sp.ID.Service.String(), "servicePort", sp.ID.Port.String())
-		if err := composite.SetSecurityPolicy(cloud, be, desiredPolicyName, logger); err != nil {
+		if err := composite.SetSecurityPolicy(ctx, cloud, be, desiredPolicyName, logger); err != nil {
 			err := fmt.Errorf("failed to set security policy from %q to %q for backend service %s (%s:%s): %v", existingPolicyName, desiredPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
 			logger.Error(err, "SetSecurityPolicy()")
 			return err
@@ -72,7 +73,7 @@ func EnsureSecurityPolicy(cloud *gce.Cloud, sp utils.ServicePort, be *composite.
 		return nil
 	}
 	logger.V(2).Info("Removing security policy in backend service", "backendName", be.Name, "serviceKey", sp.ID.Service.String(), "servicePort", sp.ID.Port.String(), "existingPolicyName", existingPolicyName)
-	if err := composite.SetSecurityPolicy(cloud, be, desiredPolicyName, logger); err != nil {
+	if err := composite.SetSecurityPolicy(ctx, cloud, be, desiredPolicyName, logger); err != nil {
 		err := fmt.Errorf("failed to remove security policy %q for backend service %s (%s:%s): %v", existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
 		logger.Error(err, "SetSecurityPolicy()")
 		return err
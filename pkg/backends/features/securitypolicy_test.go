@@ -151,7 +151,7 @@ func TestEnsureSecurityPolicy(t *testing.T) {
 
 			(fakeGCE.Compute().(*cloud.MockGCE)).MockBackendServices.SetSecurityPolicyHook = setSecurityPolicyHook
 
-			err := EnsureSecurityPolicy(fakeGCE, utils.ServicePort{BackendConfig: tc.desiredConfig}, tc.currentBackendService, klog.TODO())
+			err := EnsureSecurityPolicy(context.Background(), fakeGCE, utils.ServicePort{BackendConfig: tc.desiredConfig}, tc.currentBackendService, klog.TODO())
 			if !tc.expectError && err != nil {
 				t.Errorf("EnsureSecurityPolicy()=%v, want nil", err)
 			}
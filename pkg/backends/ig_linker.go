@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -82,7 +83,7 @@ func NewInstanceGroupLinker(instancePool instancegroups.Manager, backendPool *Po
 }
 
 // Link implements Link.
-func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) error {
+func (igl *instanceGroupLinker) Link(ctx context.Context, sp utils.ServicePort, groups []GroupKey) error {
 	var igLinks []string
 	for _, group := range groups {
 		ig, err := igl.instancePool.Get(sp.IGName(), group.Zone)
@@ -90,6 +91,14 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 			return fmt.Errorf("error retrieving IG for linking with backend %+v: %w", sp, err)
 		}
 		igLinks = append(igLinks, ig.SelfLink)
+
+		additional, err := igl.instancePool.AdditionalInstanceGroups(sp.IGName(), group.Zone, igl.logger)
+		if err != nil {
+			return fmt.Errorf("error listing additional IGs for linking with backend %+v: %w", sp, err)
+		}
+		for _, ig := range additional {
+			igLinks = append(igLinks, ig.SelfLink)
+		}
 	}
 
 	// ig_linker only supports L7 HTTP(s) External Load Balancer
@@ -98,7 +107,7 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 	// TODO(cheungdavid): Create ig linker logger that contains backendName,
 	// backendVersion, and backendScope before passing to backendPool.Get().
 	// See example in backendSyncer.ensureBackendService().
-	be, err := igl.backendPool.Get(sp.BackendName(), meta.VersionGA, meta.Global, igl.logger)
+	be, err := igl.backendPool.Get(ctx, sp.BackendName(), meta.VersionGA, meta.Global, igl.logger)
 	if err != nil {
 		return err
 	}
@@ -136,7 +145,7 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 		// TODO(cheungdavid): Create ig linker logger that contains backendName,
 		// backendVersion, and backendScope before passing to backendPool.Get().
 		// See example in backendSyncer.ensureBackendService().
-		if err := igl.backendPool.Update(be, igl.logger); err != nil {
+		if err := igl.backendPool.Update(ctx, be, igl.logger); err != nil {
 			if utils.IsHTTPErrorCode(err, http.StatusBadRequest) {
 				igl.logger.V(2).Info("Updating backend service backends with balancing mode failed, will try another mode", "balancingMode", bm, "err", err)
 				errs = append(errs, err.Error())
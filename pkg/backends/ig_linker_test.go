@@ -83,9 +83,9 @@ func TestLink(t *testing.T) {
 	}
 
 	// Mimic the syncer creating the backend.
-	linker.backendPool.Create(sp, "fake-health-check-link", klog.TODO())
+	linker.backendPool.Create(context.Background(), sp, "fake-health-check-link", klog.TODO())
 
-	if err := linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+	if err := linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 		t.Fatalf("%v", err)
 	}
 
@@ -142,9 +142,9 @@ func TestLinkWithCreationModeError(t *testing.T) {
 		}
 
 		// Mimic the syncer creating the backend.
-		linker.backendPool.Create(sp, "fake-health-check-link", klog.TODO())
+		linker.backendPool.Create(context.Background(), sp, "fake-health-check-link", klog.TODO())
 
-		if err := linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+		if err := linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 			t.Fatalf("%v", err)
 		}
 
@@ -162,7 +162,7 @@ func TestLinkWithCreationModeError(t *testing.T) {
 				t.Fatalf("Wrong balancing mode, expected %v got %v", modes[(i+1)%len(modes)], b.BalancingMode)
 			}
 		}
-		linker.backendPool.Delete(sp.BackendName(), features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
+		linker.backendPool.Delete(context.Background(), sp.BackendName(), features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
 	}
 }
 
@@ -96,10 +96,10 @@ func TestBackendInstanceGroupClobbering(t *testing.T) {
 		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v: %v", sp, err)
 	}
 
-	if err := jig.syncer.Sync([]utils.ServicePort{sp}, klog.TODO()); err != nil {
+	if err := jig.syncer.Sync(context.Background(), []utils.ServicePort{sp}, klog.TODO()); err != nil {
 		t.Fatalf("Did not expect error when syncing backend with port %v", sp.NodePort)
 	}
-	if err := jig.linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+	if err := jig.linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 		t.Fatalf("Did not expect error when linking backend with port %v to groups", sp.NodePort)
 	}
 
@@ -124,10 +124,10 @@ func TestBackendInstanceGroupClobbering(t *testing.T) {
 		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v: %v", sp, err)
 	}
 
-	if err := jig.syncer.Sync([]utils.ServicePort{sp}, klog.TODO()); err != nil {
+	if err := jig.syncer.Sync(context.Background(), []utils.ServicePort{sp}, klog.TODO()); err != nil {
 		t.Fatalf("Did not expect error when syncing backend with port %v", sp.NodePort)
 	}
-	if err := jig.linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+	if err := jig.linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 		t.Fatalf("Did not expect error when linking backend with port %v to groups", sp.NodePort)
 	}
 
@@ -172,10 +172,10 @@ func TestSyncChaosMonkey(t *testing.T) {
 		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v, err %v", sp, err)
 	}
 
-	if err := jig.syncer.Sync([]utils.ServicePort{sp}, klog.TODO()); err != nil {
+	if err := jig.syncer.Sync(context.Background(), []utils.ServicePort{sp}, klog.TODO()); err != nil {
 		t.Fatalf("Did not expect error when syncing backend with port %v, err: %v", sp.NodePort, err)
 	}
-	if err := jig.linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+	if err := jig.linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 		t.Fatalf("Did not expect error when linking backend with port %v to groups, err: %v", sp.NodePort, err)
 	}
 
@@ -205,10 +205,10 @@ func TestSyncChaosMonkey(t *testing.T) {
 		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v: %v", sp, err)
 	}
 
-	if err := jig.syncer.Sync([]utils.ServicePort{sp}, klog.TODO()); err != nil {
+	if err := jig.syncer.Sync(context.Background(), []utils.ServicePort{sp}, klog.TODO()); err != nil {
 		t.Fatalf("Did not expect error when syncing backend with port %v", sp.NodePort)
 	}
-	if err := jig.linker.Link(sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
+	if err := jig.linker.Link(context.Background(), sp, []GroupKey{{Zone: defaultTestZone}}); err != nil {
 		t.Fatalf("Did not expect error when linking backend with port %v to groups", sp.NodePort)
 	}
 	if createCalls > 0 {
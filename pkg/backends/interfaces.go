@@ -17,6 +17,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	api_v1 "k8s.io/api/core/v1"
 	"k8s.io/ingress-gce/pkg/composite"
@@ -34,12 +35,12 @@ type GroupKey struct {
 // Linker is an interface to link backends with their associated groups.
 type Linker interface {
 	// Link a BackendService to its groups.
-	Link(sp utils.ServicePort, groups []GroupKey) error
+	Link(ctx context.Context, sp utils.ServicePort, groups []GroupKey) error
 }
 
 // NEGGetter is an interface to retrieve NEG object
 type NEGGetter interface {
-	GetNetworkEndpointGroup(name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error)
+	GetNetworkEndpointGroup(ctx context.Context, name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error)
 }
 
 // ProbeProvider retrieves a probe struct given a nodePort
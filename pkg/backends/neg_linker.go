@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -61,14 +62,14 @@ func NewNEGLinker(
 }
 
 // Link implements Link.
-func (nl *negLinker) Link(sp utils.ServicePort, groups []GroupKey) error {
+func (nl *negLinker) Link(ctx context.Context, sp utils.ServicePort, groups []GroupKey) error {
 	if sp.VMIPNEGEnabled {
 		return fmt.Errorf("GCE_VM_IP NEGs are not supported by this linker")
 	}
 
 	version := befeatures.VersionFromServicePort(&sp)
 
-	negSelfLinks, err := nl.getNegSelfLinks(sp, groups)
+	negSelfLinks, err := nl.getNegSelfLinks(ctx, sp, groups)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (nl *negLinker) Link(sp utils.ServicePort, groups []GroupKey) error {
 	if err != nil {
 		return err
 	}
-	backendService, err := composite.GetBackendService(nl.cloud, key, version, nl.logger)
+	backendService, err := composite.GetBackendService(ctx, nl.cloud, key, version, nl.logger)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (nl *negLinker) Link(sp utils.ServicePort, groups []GroupKey) error {
 	nl.logger.V(2).Info("Backends changed for service port", "servicePort", sp.ID, "removing", diff.toRemove(), "adding", diff.toAdd(), "changed", diff.changed)
 
 	backendService.Backends = mergedBackend
-	return composite.UpdateBackendService(nl.cloud, key, backendService, nl.logger)
+	return composite.UpdateBackendService(ctx, nl.cloud, key, backendService, nl.logger)
 }
 
 type backendNegUrls struct {
@@ -137,7 +138,7 @@ type backendNegUrls struct {
 	negsToRemove []string
 }
 
-func (nl *negLinker) getNegSelfLinks(sp utils.ServicePort, groups []GroupKey) (backendNegUrls, error) {
+func (nl *negLinker) getNegSelfLinks(ctx context.Context, sp utils.ServicePort, groups []GroupKey) (backendNegUrls, error) {
 	version := befeatures.VersionFromServicePort(&sp)
 
 	if nl.enableMultiSubnetClusterPhase1 {
@@ -152,7 +153,7 @@ func (nl *negLinker) getNegSelfLinks(sp utils.ServicePort, groups []GroupKey) (b
 		// We will add all NEGs once CRD is available.
 		for _, group := range groups {
 			nl.logger.V(4).Info("Falling back to use NEG API to retrieve NEG url for NEG", "negName", negName)
-			neg, err := nl.negGetter.GetNetworkEndpointGroup(negName, group.Zone, version, nl.logger)
+			neg, err := nl.negGetter.GetNetworkEndpointGroup(ctx, negName, group.Zone, version, nl.logger)
 			if err != nil {
 				return backendNegUrls{}, err
 			}
@@ -175,7 +176,7 @@ func (nl *negLinker) getNegSelfLinks(sp utils.ServicePort, groups []GroupKey) (b
 		negUrl, ok := getNegUrlFromSvcneg(svcNegKey, group.Zone, nl.svcNegLister, nl.logger)
 		if !ok {
 			nl.logger.V(4).Info("Falling back to use NEG API to retrieve NEG url for NEG", "negName", negName)
-			neg, err := nl.negGetter.GetNetworkEndpointGroup(negName, group.Zone, version, nl.logger)
+			neg, err := nl.negGetter.GetNetworkEndpointGroup(ctx, negName, group.Zone, version, nl.logger)
 			if err != nil {
 				return backendNegUrls{}, err
 			}
@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -105,7 +106,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 				linker := newTestNEGLinker(fakeNEG, fakeGCE)
 
 				// Mimic how the syncer would create the backend.
-				if _, err := linker.backendPool.Create(tc.svcPort, "fake-healthcheck-link", klog.TODO()); err != nil {
+				if _, err := linker.backendPool.Create(context.Background(), tc.svcPort, "fake-healthcheck-link", klog.TODO()); err != nil {
 					t.Fatalf("Failed to create backend service to NEG for svcPort %v: %v", tc.svcPort, err)
 				}
 
@@ -138,13 +139,13 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 						Name:    tc.svcPort.NEGName(),
 						Version: version,
 					}
-					err := fakeNEG.CreateNetworkEndpointGroup(neg, key.Zone, klog.TODO())
+					err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, key.Zone, klog.TODO())
 					if err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", tc.svcPort, err)
 					}
 				}
 
-				if err := linker.Link(tc.svcPort, zones); err != nil {
+				if err := linker.Link(context.Background(), tc.svcPort, zones); err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v when populateSvcNeg = %v: %v", tc.svcPort, populateSvcNeg, err)
 				}
 
@@ -156,7 +157,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					if err != nil {
 						t.Fatalf("Failed to create composite key - %v", err)
 					}
-					bs, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+					bs, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 					if err != nil {
 						t.Fatalf("Failed to retrieve backend service using key %+v for svcPort %v: %v", key, tc.svcPort, err)
 					}
@@ -202,7 +203,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					linker.svcNegLister.Update(svcNegAfterShrink)
 				}
 
-				if err := linker.Link(tc.svcPort, shrinkZone); err != nil {
+				if err := linker.Link(context.Background(), tc.svcPort, shrinkZone); err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v when populateSvcNeg = %v: %v", tc.svcPort, populateSvcNeg, err)
 				}
 
@@ -282,7 +283,7 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 							Scope:   scope,
 							Version: version,
 						}
-						if err := fakeNEG.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+						if err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 							t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 						}
 					}
@@ -296,7 +297,7 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 					if err != nil {
 						t.Fatalf("Failed to create Backend Service key: %v", err)
 					}
-					if err := composite.CreateBackendService(fakeGCE, key, prevBe, klog.TODO()); err != nil {
+					if err := composite.CreateBackendService(context.Background(), fakeGCE, key, prevBe, klog.TODO()); err != nil {
 						t.Fatalf("Failed to create Backend Service: %v", err)
 					}
 
@@ -319,11 +320,11 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 						}
 					}
 
-					if err := linker.Link(svcPort, tc.currGroups); err != nil {
+					if err := linker.Link(context.Background(), svcPort, tc.currGroups); err != nil {
 						t.Fatalf("Failed to link Backend Service to NEG: %v", err)
 					}
 
-					updatedBe, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+					updatedBe, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 					if err != nil {
 						t.Fatalf("Failed to get Backend Service: %v", err)
 					}
@@ -414,7 +415,7 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 						Scope:   scope,
 						Version: version,
 					}
-					if err := fakeNEG.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+					if err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 					}
 				}
@@ -428,7 +429,7 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 				if err != nil {
 					t.Fatalf("Failed to create Backend Service key: %v", err)
 				}
-				if err := composite.CreateBackendService(fakeGCE, key, prevBe, klog.TODO()); err != nil {
+				if err := composite.CreateBackendService(context.Background(), fakeGCE, key, prevBe, klog.TODO()); err != nil {
 					t.Fatalf("Failed to create Backend Service: %v", err)
 				}
 
@@ -451,11 +452,11 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 					}
 				}
 
-				if err := linker.Link(svcPort, tc.currGroups); err != nil {
+				if err := linker.Link(context.Background(), svcPort, tc.currGroups); err != nil {
 					t.Fatalf("Failed to link Backend Service to NEG: %v", err)
 				}
 
-				updatedBe, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+				updatedBe, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 				if err != nil {
 					t.Fatalf("Failed to get Backend Service: %v", err)
 				}
@@ -546,13 +547,13 @@ func TestGetNegSelfLinks(t *testing.T) {
 						Name:    svcPort.NEGName(),
 						Version: befeatures.VersionFromServicePort(&svcPort),
 					}
-					err := fakeNEG.CreateNetworkEndpointGroup(neg, groupKey.Zone, klog.TODO())
+					err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, groupKey.Zone, klog.TODO())
 					if err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 					}
 				}
 
-				negLinks, err := linker.getNegSelfLinks(svcPort, groupKeys)
+				negLinks, err := linker.getNegSelfLinks(context.Background(), svcPort, groupKeys)
 				if err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v: %v", svcPort, err)
 				}
@@ -683,13 +684,13 @@ func TestGetNegSelfLinksWithMultiSubnetCluster(t *testing.T) {
 					Name:    svcPort.NEGName(),
 					Version: befeatures.VersionFromServicePort(&svcPort),
 				}
-				err := fakeNEG.CreateNetworkEndpointGroup(neg, groupKey.Zone, klog.TODO())
+				err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, groupKey.Zone, klog.TODO())
 				if err != nil {
 					t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 				}
 			}
 
-			negLinks, err := linker.getNegSelfLinks(svcPort, groupKeys)
+			negLinks, err := linker.getNegSelfLinks(context.Background(), svcPort, groupKeys)
 			if err != nil {
 				t.Fatalf("Failed to link backend service to NEG for svcPort %v: %v", svcPort, err)
 			}
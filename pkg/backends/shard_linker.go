@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/instancegroups"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+// shardLinker links new shards of an instance group to the global and regional
+// backend services of the primary instance group.
+type shardLinker struct {
+	cloud *gce.Cloud
+}
+
+// shardLinker is a ShardLinker
+var _ instancegroups.ShardLinker = (*shardLinker)(nil)
+
+// NewShardLinker returns a ShardLinker linking shards to the backend services
+// of the cloud project.
+func NewShardLinker(cloud *gce.Cloud) instancegroups.ShardLinker {
+	return &shardLinker{cloud: cloud}
+}
+
+// LinkShard implements instancegroups.ShardLinker.
+// The shard is added to every backend service which has the primary instance
+// group as a backend, with the same balancing mode and capacity.
+func (l *shardLinker) LinkShard(ctx context.Context, primary, shard *compute.InstanceGroup, logger klog.Logger) error {
+	for _, scope := range []*meta.Key{meta.GlobalKey(""), meta.RegionalKey("", l.cloud.Region())} {
+		bss, err := composite.ListBackendServices(ctx, l.cloud, scope, meta.VersionGA, logger, filter.None)
+		if err != nil {
+			return fmt.Errorf("failed to list backend services: %w", err)
+		}
+		for _, bs := range bss {
+			backend := shardBackend(bs, primary, shard)
+			if backend == nil {
+				continue
+			}
+			bs.Backends = append(bs.Backends, backend)
+			key := meta.GlobalKey(bs.Name)
+			if scope.Type() == meta.Regional {
+				key = meta.RegionalKey(bs.Name, scope.Region)
+			}
+			logger.V(2).Info("Linking instance group shard to backend service", "shard", shard.Name, "backendService", key)
+			if err := composite.UpdateBackendService(ctx, l.cloud, key, bs, logger); err != nil {
+				return fmt.Errorf("failed to link instance group %s to backend service %s: %w", shard.Name, bs.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// shardBackend returns the backend linking the shard to the backend service,
+// or nil if the primary instance group is not a backend of the backend service
+// or the shard already is.
+func shardBackend(bs *composite.BackendService, primary, shard *compute.InstanceGroup) *composite.Backend {
+	var primaryBackend *composite.Backend
+	for _, backend := range bs.Backends {
+		switch {
+		case utils.EqualResourceIDs(backend.Group, shard.SelfLink):
+			return nil
+		case utils.EqualResourceIDs(backend.Group, primary.SelfLink):
+			primaryBackend = backend
+		}
+	}
+	if primaryBackend == nil {
+		return nil
+	}
+	backend := *primaryBackend
+	backend.Group = shard.SelfLink
+	return &backend
+}
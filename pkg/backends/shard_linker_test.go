@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/mock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/klog/v2"
+)
+
+func TestLinkShard(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	mockGCE := fakeGCE.Compute().(*cloud.MockGCE)
+	mockGCE.MockBackendServices.UpdateHook = mock.UpdateBackendServiceHook
+	mockGCE.MockRegionBackendServices.UpdateHook = mock.UpdateRegionBackendServiceHook
+
+	igLink := func(name string) string {
+		return cloud.NewInstanceGroupsResourceID("mock-project", defaultTestZone, name).SelfLink(meta.VersionGA)
+	}
+	primary := &compute.InstanceGroup{Name: "k8s-ig--uid1", SelfLink: igLink("k8s-ig--uid1")}
+	shard := &compute.InstanceGroup{Name: "k8s-ig--uid1-1", SelfLink: igLink("k8s-ig--uid1-1")}
+	primaryBackend := &composite.Backend{Group: primary.SelfLink, BalancingMode: string(Rate), MaxRatePerInstance: maxRPS}
+	regionalBackend := &composite.Backend{Group: primary.SelfLink, BalancingMode: string(Connections)}
+	otherBackend := &composite.Backend{Group: igLink("other"), BalancingMode: string(Rate), MaxRatePerInstance: maxRPS}
+
+	region := fakeGCE.Region()
+	for _, bs := range []struct {
+		key      *meta.Key
+		backends []*composite.Backend
+	}{
+		{meta.GlobalKey("l7-backend"), []*composite.Backend{primaryBackend}},
+		{meta.GlobalKey("other-backend"), []*composite.Backend{otherBackend}},
+		{meta.RegionalKey("l4-backend", region), []*composite.Backend{regionalBackend}},
+	} {
+		if err := composite.CreateBackendService(context.Background(), fakeGCE, bs.key, &composite.BackendService{Name: bs.key.Name, Backends: bs.backends, Version: meta.VersionGA}, klog.TODO()); err != nil {
+			t.Fatalf("CreateBackendService(%s) returned error %v", bs.key, err)
+		}
+	}
+
+	linker := NewShardLinker(fakeGCE)
+	// Linking is idempotent.
+	for i := 0; i < 2; i++ {
+		if err := linker.LinkShard(context.Background(), primary, shard, klog.TODO()); err != nil {
+			t.Fatalf("LinkShard() returned error %v", err)
+		}
+	}
+
+	shardBackend := func(b *composite.Backend) *composite.Backend {
+		copy := *b
+		copy.Group = shard.SelfLink
+		return &copy
+	}
+	for _, tc := range []struct {
+		key  *meta.Key
+		want []*composite.Backend
+	}{
+		{meta.GlobalKey("l7-backend"), []*composite.Backend{primaryBackend, shardBackend(primaryBackend)}},
+		{meta.GlobalKey("other-backend"), []*composite.Backend{otherBackend}},
+		{meta.RegionalKey("l4-backend", region), []*composite.Backend{regionalBackend, shardBackend(regionalBackend)}},
+	} {
+		bs, err := composite.GetBackendService(context.Background(), fakeGCE, tc.key, meta.VersionGA, klog.TODO())
+		if err != nil {
+			t.Fatalf("GetBackendService(%s) returned error %v", tc.key, err)
+		}
+		if diff := cmp.Diff(tc.want, bs.Backends); diff != "" {
+			t.Errorf("backends of %s diff (-want +got):\n%s", tc.key, diff)
+		}
+	}
+}
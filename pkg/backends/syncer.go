@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -53,10 +54,10 @@ func NewBackendSyncer(
 
 // Sync a BackendService. Implementations should only create the BackendService
 // but not its groups.
-func (s *Syncer) Sync(svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
+func (s *Syncer) Sync(ctx context.Context, svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 	for _, sp := range svcPorts {
 		ingLogger.Info("Sync backend", "servicePort", fmt.Sprintf("%v", sp))
-		if err := s.ensureBackendService(sp, ingLogger); err != nil {
+		if err := s.ensureBackendService(ctx, sp, ingLogger); err != nil {
 			return err
 		}
 	}
@@ -64,7 +65,7 @@ func (s *Syncer) Sync(svcPorts []utils.ServicePort, ingLogger klog.Logger) error
 }
 
 // ensureBackendService will update or create a BackendService for the given port.
-func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logger) error {
+func (s *Syncer) ensureBackendService(ctx context.Context, sp utils.ServicePort, ingLogger klog.Logger) error {
 	// We must track the ports even if creating the backends failed, because
 	// we might've created health-check for them.
 	be := &composite.BackendService{}
@@ -78,10 +79,10 @@ func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logge
 		"backendScope", scope,
 		"port", sp.NodePort,
 	)
-	be, getErr := s.backendPool.Get(beName, version, scope, beLogger)
+	be, getErr := s.backendPool.Get(ctx, beName, version, scope, beLogger)
 
 	// Ensure health check for backend service exists.
-	hcLink, err := s.ensureHealthCheck(sp, beLogger)
+	hcLink, err := s.ensureHealthCheck(ctx, sp, beLogger)
 	if err != nil {
 		return fmt.Errorf("error ensuring health check: %w", err)
 	}
@@ -94,7 +95,7 @@ func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logge
 		}
 		// Only create the backend service if the error was 404.
 		beLogger.Info("Creating backend service")
-		be, err = s.backendPool.Create(sp, hcLink, beLogger)
+		be, err = s.backendPool.Create(ctx, sp, hcLink, beLogger)
 		if err != nil {
 			return err
 		}
@@ -121,12 +122,12 @@ func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logge
 	}
 
 	if needUpdate {
-		if err := s.backendPool.Update(be, beLogger); err != nil {
+		if err := s.backendPool.Update(ctx, be, beLogger); err != nil {
 			return err
 		}
 	}
 
-	if err := s.ensureBackendSignedURLKeys(sp, be, beLogger); err != nil {
+	if err := s.ensureBackendSignedURLKeys(ctx, sp, be, beLogger); err != nil {
 		return err
 	}
 
@@ -135,7 +136,7 @@ func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logge
 		// available. meta.Key is not needed as security policy supported only for
 		// global backends.
 		be.Scope = scope
-		if err := features.EnsureSecurityPolicy(s.cloud, sp, be, beLogger); err != nil {
+		if err := features.EnsureSecurityPolicy(ctx, s.cloud, sp, be, beLogger); err != nil {
 			return err
 		}
 	}
@@ -143,7 +144,7 @@ func (s *Syncer) ensureBackendService(sp utils.ServicePort, ingLogger klog.Logge
 	return nil
 }
 
-func (s *Syncer) ensureBackendSignedURLKeys(sp utils.ServicePort, be *composite.BackendService, beLogger klog.Logger) error {
+func (s *Syncer) ensureBackendSignedURLKeys(ctx context.Context, sp utils.ServicePort, be *composite.BackendService, beLogger klog.Logger) error {
 	existingKeyNames := map[string]bool{}
 	if be.CdnPolicy != nil && be.CdnPolicy.SignedUrlKeyNames != nil {
 		for _, key := range be.CdnPolicy.SignedUrlKeyNames {
@@ -170,7 +171,7 @@ func (s *Syncer) ensureBackendSignedURLKeys(sp utils.ServicePort, be *composite.
 		urlKeyLogger := beLogger.WithValues("SignedUrlKey", keyName)
 		if !found {
 			urlKeyLogger.Info("Removing SignedUrlKey")
-			if err := s.backendPool.DeleteSignedURLKey(be, keyName, urlKeyLogger); err != nil {
+			if err := s.backendPool.DeleteSignedURLKey(ctx, be, keyName, urlKeyLogger); err != nil {
 				return err
 			}
 		}
@@ -179,7 +180,7 @@ func (s *Syncer) ensureBackendSignedURLKeys(sp utils.ServicePort, be *composite.
 	for _, key := range newSignedUrlKeys {
 		urlKeyLogger := beLogger.WithValues("SignedUrlKey", key.KeyName)
 		urlKeyLogger.Info("Adding SignedUrlKey")
-		if err := s.backendPool.AddSignedURLKey(be, key, urlKeyLogger); err != nil {
+		if err := s.backendPool.AddSignedURLKey(ctx, be, key, urlKeyLogger); err != nil {
 			return err
 		}
 	}
@@ -187,7 +188,7 @@ func (s *Syncer) ensureBackendSignedURLKeys(sp utils.ServicePort, be *composite.
 }
 
 // GC garbage collects unused BackendService's
-func (s *Syncer) GC(svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
+func (s *Syncer) GC(ctx context.Context, svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 	knownPorts, err := knownPortsFromServicePorts(s.cloud, svcPorts)
 	if err != nil {
 		return err
@@ -199,11 +200,11 @@ func (s *Syncer) GC(svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 	if err != nil {
 		return fmt.Errorf("error creating l7 ilb key: %w", err)
 	}
-	ilbBackends, err := s.backendPool.List(key, lbfeatures.L7ILBVersions().BackendService, ilbBeLogger)
+	ilbBackends, err := s.backendPool.List(ctx, key, lbfeatures.L7ILBVersions().BackendService, ilbBeLogger)
 	if err != nil {
 		return fmt.Errorf("error listing regional backends: %w", err)
 	}
-	err = s.gc(ilbBackends, knownPorts, ilbBeLogger)
+	err = s.gc(ctx, ilbBackends, knownPorts, ilbBeLogger)
 	if err != nil {
 		return fmt.Errorf("error GCing regional Backends: %w", err)
 	}
@@ -214,11 +215,11 @@ func (s *Syncer) GC(svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 	if err != nil {
 		return fmt.Errorf("error creating l7 ilb key: %w", err)
 	}
-	backends, err := s.backendPool.List(key, meta.VersionGA, gaBeLogger)
+	backends, err := s.backendPool.List(ctx, key, meta.VersionGA, gaBeLogger)
 	if err != nil {
 		return fmt.Errorf("error listing backends: %w", err)
 	}
-	err = s.gc(backends, knownPorts, gaBeLogger)
+	err = s.gc(ctx, backends, knownPorts, gaBeLogger)
 	if err != nil {
 		return fmt.Errorf("error GCing Backends: %w", err)
 	}
@@ -227,7 +228,7 @@ func (s *Syncer) GC(svcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 }
 
 // gc deletes the provided backends
-func (s *Syncer) gc(backends []*composite.BackendService, knownPorts sets.String, ingLogger klog.Logger) error {
+func (s *Syncer) gc(ctx context.Context, backends []*composite.BackendService, knownPorts sets.String, ingLogger klog.Logger) error {
 	for _, be := range backends {
 		// Skip L4 LB backend services
 		// backendSyncer currently only GC backend services for L7 XLB/ILB.
@@ -253,13 +254,13 @@ func (s *Syncer) gc(backends []*composite.BackendService, knownPorts sets.String
 			"backendScope", scope,
 		)
 		beLogger.Info("GCing backendService")
-		err = s.backendPool.Delete(name, be.Version, scope, beLogger)
+		err = s.backendPool.Delete(ctx, name, be.Version, scope, beLogger)
 		if err != nil {
 			beLogger.Error(err, "backendPool.Delete()")
 			return err
 		}
 
-		if err := s.healthChecker.Delete(name, scope, beLogger); err != nil {
+		if err := s.healthChecker.Delete(ctx, name, scope, beLogger); err != nil {
 			return err
 		}
 	}
@@ -284,25 +285,25 @@ func knownPortsFromServicePorts(cloud *gce.Cloud, svcPorts []utils.ServicePort)
 }
 
 // Status returns the status of a BackendService given its name.
-func (s *Syncer) Status(name string, version meta.Version, scope meta.KeyType, ingLogger klog.Logger) (string, error) {
+func (s *Syncer) Status(ctx context.Context, name string, version meta.Version, scope meta.KeyType, ingLogger klog.Logger) (string, error) {
 	beLogger := ingLogger.WithValues(
 		"backendName", name,
 		"backendVersion", version,
 		"backendScope", scope,
 	)
-	return s.backendPool.Health(name, version, scope, beLogger)
+	return s.backendPool.Health(ctx, name, version, scope, beLogger)
 }
 
 // Shutdown cleans up all BackendService's previously synced.
 // TODO(cheungdavid): Shutdown() should be deprecated after the removal of delateAll option.
 func (s *Syncer) Shutdown() error {
-	if err := s.GC([]utils.ServicePort{}, klog.TODO()); err != nil {
+	if err := s.GC(context.Background(), []utils.ServicePort{}, klog.TODO()); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *Syncer) ensureHealthCheck(sp utils.ServicePort, beLogger klog.Logger) (string, error) {
+func (s *Syncer) ensureHealthCheck(ctx context.Context, sp utils.ServicePort, beLogger klog.Logger) (string, error) {
 	var probe *v1.Probe
 	var err error
 
@@ -312,7 +313,7 @@ func (s *Syncer) ensureHealthCheck(sp utils.ServicePort, beLogger klog.Logger) (
 			return "", fmt.Errorf("Error getting prober: %w", err)
 		}
 	}
-	return s.healthChecker.SyncServicePort(&sp, probe, beLogger)
+	return s.healthChecker.SyncServicePort(ctx, &sp, probe, beLogger)
 }
 
 // getHealthCheckLink gets the Healthcheck link off the BackendService
@@ -325,10 +326,11 @@ func getHealthCheckLink(be *composite.BackendService) string {
 
 // ensureProtocol updates the BackendService Protocol with the expected value
 func ensureProtocol(be *composite.BackendService, p utils.ServicePort) (needsUpdate bool) {
-	if be.Protocol == string(p.Protocol) {
+	protocol := backendProtocol(&p)
+	if be.Protocol == protocol {
 		return false
 	}
-	be.Protocol = string(p.Protocol)
+	be.Protocol = protocol
 	return true
 }
 
@@ -103,11 +103,11 @@ func (p *portset) check(fakeGCE *gce.Cloud) error {
 		}
 
 		if found {
-			if _, err := composite.GetBackendService(fakeGCE, key, features.VersionFromServicePort(&sp), klog.TODO()); err != nil {
+			if _, err := composite.GetBackendService(context.Background(), fakeGCE, key, features.VersionFromServicePort(&sp), klog.TODO()); err != nil {
 				return fmt.Errorf("backend for port %+v should exist, but got: %v", sp.NodePort, err)
 			}
 		} else {
-			bs, err := composite.GetBackendService(fakeGCE, key, features.VersionFromServicePort(&sp), klog.TODO())
+			bs, err := composite.GetBackendService(context.Background(), fakeGCE, key, features.VersionFromServicePort(&sp), klog.TODO())
 			if err == nil || !utils.IsHTTPErrorCode(err, http.StatusNotFound) {
 				if sp.VMIPNEGEnabled {
 					// It is expected that these Backends should not get cleaned up in the GC loop.
@@ -175,13 +175,13 @@ func TestSync(t *testing.T) {
 
 	for _, sp := range testCases {
 		t.Run(fmt.Sprintf("Port: %v Protocol: %v", sp.NodePort, sp.Protocol), func(t *testing.T) {
-			if err := syncer.Sync([]utils.ServicePort{sp}, klog.TODO()); err != nil {
+			if err := syncer.Sync(context.Background(), []utils.ServicePort{sp}, klog.TODO()); err != nil {
 				t.Fatalf("Unexpected error when syncing backend with port %v: %v", sp.NodePort, err)
 			}
 			beName := sp.BackendName()
 
 			// Check that the new backend has the right port
-			be, err := syncer.backendPool.Get(beName, features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
+			be, err := syncer.backendPool.Get(context.Background(), beName, features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
 			if err != nil {
 				t.Fatalf("Did not find expected backend with port %v", sp.NodePort)
 			}
@@ -189,7 +189,7 @@ func TestSync(t *testing.T) {
 				t.Fatalf("Backend %v has wrong port %v, expected %v", be.Name, be.Port, sp)
 			}
 
-			hc, err := syncer.healthChecker.Get(beName, features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
+			hc, err := syncer.healthChecker.Get(context.Background(), beName, features.VersionFromServicePort(&sp), features.ScopeFromServicePort(&sp), klog.TODO())
 			if err != nil {
 				t.Fatalf("Unexpected err when querying fake healthchecker: %v", err)
 			}
@@ -210,10 +210,10 @@ func TestSyncUpdateHTTPS(t *testing.T) {
 	syncer := newTestSyncer(fakeGCE)
 
 	p := utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP, BackendNamer: defaultNamer}
-	syncer.Sync([]utils.ServicePort{p}, klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{p}, klog.TODO())
 	beName := p.BackendName()
 
-	be, err := syncer.backendPool.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	be, err := syncer.backendPool.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if err != nil {
 		t.Fatalf("Unexpected err: %v", err)
 	}
@@ -223,16 +223,16 @@ func TestSyncUpdateHTTPS(t *testing.T) {
 	}
 
 	// Assert the proper health check was created
-	hc, _ := syncer.healthChecker.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	hc, _ := syncer.healthChecker.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if hc == nil || hc.Protocol() != p.Protocol {
 		t.Fatalf("Expected %s health check, received %v: ", p.Protocol, hc)
 	}
 
 	// Update service port to encrypted
 	p.Protocol = annotations.ProtocolHTTPS
-	syncer.Sync([]utils.ServicePort{p}, klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{p}, klog.TODO())
 
-	be, err = syncer.backendPool.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	be, err = syncer.backendPool.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if err != nil {
 		t.Fatalf("Unexpected err retrieving backend service after update: %v", err)
 	}
@@ -243,7 +243,7 @@ func TestSyncUpdateHTTPS(t *testing.T) {
 	}
 
 	// Assert the proper health check was created
-	hc, _ = syncer.healthChecker.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	hc, _ = syncer.healthChecker.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if hc == nil || hc.Protocol() != p.Protocol {
 		t.Fatalf("Expected %s health check, received %v: ", p.Protocol, hc)
 	}
@@ -254,10 +254,10 @@ func TestSyncUpdateHTTP2(t *testing.T) {
 	syncer := newTestSyncer(fakeGCE)
 
 	p := utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP, BackendNamer: defaultNamer}
-	syncer.Sync([]utils.ServicePort{p}, klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{p}, klog.TODO())
 	beName := p.BackendName()
 
-	be, err := syncer.backendPool.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	be, err := syncer.backendPool.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if err != nil {
 		t.Fatalf("Unexpected err: %v", err)
 	}
@@ -267,16 +267,16 @@ func TestSyncUpdateHTTP2(t *testing.T) {
 	}
 
 	// Assert the proper health check was created
-	hc, _ := syncer.healthChecker.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	hc, _ := syncer.healthChecker.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if hc == nil || hc.Protocol() != p.Protocol {
 		t.Fatalf("Expected %s health check, received %v: ", p.Protocol, hc)
 	}
 
 	// Update service port to HTTP2
 	p.Protocol = annotations.ProtocolHTTP2
-	syncer.Sync([]utils.ServicePort{p}, klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{p}, klog.TODO())
 
-	beBeta, err := syncer.backendPool.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	beBeta, err := syncer.backendPool.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if err != nil {
 		t.Fatalf("Unexpected err retrieving backend service after update: %v", err)
 	}
@@ -287,7 +287,7 @@ func TestSyncUpdateHTTP2(t *testing.T) {
 	}
 
 	// Assert the proper health check was created
-	hc, _ = syncer.healthChecker.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	hc, _ = syncer.healthChecker.Get(context.Background(), beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if hc == nil || hc.Protocol() != p.Protocol {
 		t.Fatalf("Expected %s health check, received %v: ", p.Protocol, hc)
 	}
@@ -308,7 +308,7 @@ func TestGC(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := syncer.Sync(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.Sync(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.Sync(%+v) = %v, want nil ", ps.existingPorts(), err)
 	}
 
@@ -317,7 +317,7 @@ func TestGC(t *testing.T) {
 	}
 
 	// Run a no-op GC (i.e nothing is actually cleaned up)
-	if err := syncer.GC(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.GC(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.GC(%+v) = %v, want nil", ps.existingPorts(), err)
 	}
 
@@ -330,7 +330,7 @@ func TestGC(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := syncer.GC(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.GC(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.GC(%+v) = %v, want nil", ps.existingPorts(), err)
 	}
 
@@ -358,7 +358,7 @@ func TestGCMixed(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := syncer.Sync(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.Sync(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.Sync(%+v) = %v, want nil ", ps.existingPorts(), err)
 	}
 
@@ -367,7 +367,7 @@ func TestGCMixed(t *testing.T) {
 	}
 
 	// Run a no-op GC (i.e nothing is actually cleaned up)
-	if err := syncer.GC(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.GC(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.GC(%+v) = %v, want nil", ps.existingPorts(), err)
 	}
 
@@ -380,7 +380,7 @@ func TestGCMixed(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := syncer.GC(ps.existingPorts(), klog.TODO()); err != nil {
+	if err := syncer.GC(context.Background(), ps.existingPorts(), klog.TODO()); err != nil {
 		t.Fatalf("syncer.GC(%+v) = %v, want nil", ps.existingPorts(), err)
 	}
 
@@ -486,19 +486,19 @@ func TestSyncQuota(t *testing.T) {
 				return false, nil
 			}
 
-			if err := syncer.Sync(tc.oldPorts, klog.TODO()); err != nil {
+			if err := syncer.Sync(context.Background(), tc.oldPorts, klog.TODO()); err != nil {
 				t.Errorf("Expected backend pool to add node ports, err: %v", err)
 			}
 
 			// Ensuring these ports again without first Garbage Collecting goes over
 			// the set quota. Expect an error here, until GC is called.
-			err := syncer.Sync(tc.newPorts, klog.TODO())
+			err := syncer.Sync(context.Background(), tc.newPorts, klog.TODO())
 			if tc.expectSyncErr && err == nil {
 				t.Errorf("Expect initial sync to go over quota, but received no error")
 			}
 
-			syncer.GC(tc.newPorts, klog.TODO())
-			if err := syncer.Sync(tc.newPorts, klog.TODO()); err != nil {
+			syncer.GC(context.Background(), tc.newPorts, klog.TODO())
+			if err := syncer.Sync(context.Background(), tc.newPorts, klog.TODO()); err != nil {
 				t.Errorf("Expected backend pool to add node ports, err: %v", err)
 			}
 
@@ -516,7 +516,7 @@ func TestSyncNEG(t *testing.T) {
 	syncer := newTestSyncer(fakeGCE)
 
 	svcPort := utils.ServicePort{NodePort: 81, Protocol: annotations.ProtocolHTTP, BackendNamer: defaultNamer}
-	if err := syncer.Sync([]utils.ServicePort{svcPort}, klog.TODO()); err != nil {
+	if err := syncer.Sync(context.Background(), []utils.ServicePort{svcPort}, klog.TODO()); err != nil {
 		t.Errorf("Expected backend pool to add node ports, err: %v", err)
 	}
 
@@ -528,7 +528,7 @@ func TestSyncNEG(t *testing.T) {
 
 	// Convert to NEG
 	svcPort.NEGEnabled = true
-	if err := syncer.Sync([]utils.ServicePort{svcPort}, klog.TODO()); err != nil {
+	if err := syncer.Sync(context.Background(), []utils.ServicePort{svcPort}, klog.TODO()); err != nil {
 		t.Errorf("Expected backend pool to add node ports, err: %v", err)
 	}
 
@@ -538,20 +538,20 @@ func TestSyncNEG(t *testing.T) {
 		t.Fatalf("Failed to get backend service with name %v: %v", negName, err)
 	}
 	// GC should garbage collect the Backend on the old naming schema
-	syncer.GC([]utils.ServicePort{svcPort}, klog.TODO())
+	syncer.GC(context.Background(), []utils.ServicePort{svcPort}, klog.TODO())
 
-	bs, err := syncer.backendPool.Get(nodePortName, features.VersionFromServicePort(&svcPort), features.ScopeFromServicePort(&svcPort), klog.TODO())
+	bs, err := syncer.backendPool.Get(context.Background(), nodePortName, features.VersionFromServicePort(&svcPort), features.ScopeFromServicePort(&svcPort), klog.TODO())
 	if err == nil {
 		t.Fatalf("Expected not to get BackendService with name %v, got: %+v", nodePortName, bs)
 	}
 
 	// Convert back to non-NEG
 	svcPort.NEGEnabled = false
-	if err := syncer.Sync([]utils.ServicePort{svcPort}, klog.TODO()); err != nil {
+	if err := syncer.Sync(context.Background(), []utils.ServicePort{svcPort}, klog.TODO()); err != nil {
 		t.Errorf("Expected backend pool to add node ports, err: %v", err)
 	}
 
-	syncer.GC([]utils.ServicePort{svcPort}, klog.TODO())
+	syncer.GC(context.Background(), []utils.ServicePort{svcPort}, klog.TODO())
 
 	_, err = fakeGCE.GetGlobalBackendService(nodePortName)
 	if err != nil {
@@ -564,7 +564,7 @@ func TestShutdown(t *testing.T) {
 	syncer := newTestSyncer(fakeGCE)
 
 	// Sync a backend and verify that it doesn't exist after Shutdown()
-	syncer.Sync([]utils.ServicePort{{NodePort: 80, BackendNamer: defaultNamer}}, klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{{NodePort: 80, BackendNamer: defaultNamer}}, klog.TODO())
 	syncer.Shutdown()
 	if _, err := fakeGCE.GetGlobalBackendService(defaultNamer.IGBackend(80)); err == nil {
 		t.Fatalf("%v", err)
@@ -579,6 +579,7 @@ func TestEnsureBackendServiceProtocol(t *testing.T) {
 		{NodePort: 80, Protocol: annotations.ProtocolHTTP, ID: utils.ServicePortID{Port: networkingv1.ServiceBackendPort{Number: 1}}, BackendNamer: defaultNamer},
 		{NodePort: 443, Protocol: annotations.ProtocolHTTPS, ID: utils.ServicePortID{Port: networkingv1.ServiceBackendPort{Number: 2}}, BackendNamer: defaultNamer},
 		{NodePort: 3000, Protocol: annotations.ProtocolHTTP2, ID: utils.ServicePortID{Port: networkingv1.ServiceBackendPort{Number: 3}}, BackendNamer: defaultNamer},
+		{NodePort: 3001, Protocol: annotations.ProtocolGRPC, ID: utils.ServicePortID{Port: networkingv1.ServiceBackendPort{Number: 4}}, BackendNamer: defaultNamer},
 	}
 
 	for _, oldPort := range svcPorts {
@@ -586,8 +587,8 @@ func TestEnsureBackendServiceProtocol(t *testing.T) {
 			t.Run(
 				fmt.Sprintf("Updating Port:%v Protocol:%v to Port:%v Protocol:%v", oldPort.NodePort, oldPort.Protocol, newPort.NodePort, newPort.Protocol),
 				func(t *testing.T) {
-					syncer.Sync([]utils.ServicePort{oldPort}, klog.TODO())
-					be, err := syncer.backendPool.Get(oldPort.BackendName(), features.VersionFromServicePort(&oldPort), features.ScopeFromServicePort(&oldPort), klog.TODO())
+					syncer.Sync(context.Background(), []utils.ServicePort{oldPort}, klog.TODO())
+					be, err := syncer.backendPool.Get(context.Background(), oldPort.BackendName(), features.VersionFromServicePort(&oldPort), features.ScopeFromServicePort(&oldPort), klog.TODO())
 					if err != nil {
 						t.Fatalf("%v", err)
 					}
@@ -597,13 +598,14 @@ func TestEnsureBackendServiceProtocol(t *testing.T) {
 						if needsProtocolUpdate {
 							t.Fatalf("Expected ensureProtocol for the same port to return false, got %v", needsProtocolUpdate)
 						}
-					} else {
+					} else if backendProtocol(&oldPort) != backendProtocol(&newPort) {
 						if !needsProtocolUpdate {
 							t.Fatalf("Expected ensureProtocol for updating to a new port to return true, got %v", needsProtocolUpdate)
 						}
 					}
 
-					if newPort.Protocol == annotations.ProtocolHTTP2 {
+					// GRPC ports are served over HTTP2.
+					if newPort.Protocol == annotations.ProtocolHTTP2 || newPort.Protocol == annotations.ProtocolGRPC {
 						if be.Protocol != string(annotations.ProtocolHTTP2) {
 							t.Fatalf("Expected HTTP2 protocol to be set on BackendService, got %v", be.Protocol)
 						}
@@ -629,8 +631,8 @@ func TestEnsureBackendServiceDescription(t *testing.T) {
 			t.Run(
 				fmt.Sprintf("Updating Port:%v Protocol:%v to Port:%v Protocol:%v", oldPort.NodePort, oldPort.Protocol, newPort.NodePort, newPort.Protocol),
 				func(t *testing.T) {
-					syncer.Sync([]utils.ServicePort{oldPort}, klog.TODO())
-					be, err := syncer.backendPool.Get(oldPort.BackendName(), features.VersionFromServicePort(&oldPort), features.ScopeFromServicePort(&oldPort), klog.TODO())
+					syncer.Sync(context.Background(), []utils.ServicePort{oldPort}, klog.TODO())
+					be, err := syncer.backendPool.Get(context.Background(), oldPort.BackendName(), features.VersionFromServicePort(&oldPort), features.ScopeFromServicePort(&oldPort), klog.TODO())
 					if err != nil {
 						t.Fatalf("%v", err)
 					}
@@ -656,8 +658,8 @@ func TestEnsureBackendServiceHealthCheckLink(t *testing.T) {
 	syncer := newTestSyncer(fakeGCE)
 
 	p := utils.ServicePort{NodePort: 80, Protocol: annotations.ProtocolHTTP, ID: utils.ServicePortID{Port: networkingv1.ServiceBackendPort{Number: 1}}, BackendNamer: defaultNamer}
-	syncer.Sync([]utils.ServicePort{p}, klog.TODO())
-	be, err := syncer.backendPool.Get(p.BackendName(), features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
+	syncer.Sync(context.Background(), []utils.ServicePort{p}, klog.TODO())
+	be, err := syncer.backendPool.Get(context.Background(), p.BackendName(), features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p), klog.TODO())
 	if err != nil {
 		t.Fatalf("%v", err)
 	}
@@ -18,6 +18,7 @@ limitations under the License.
 package composite
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -31,10 +32,12 @@ import (
 )
 
 // SetUrlMapForTargetHttpsProxy() sets the UrlMap for a target https proxy
-func SetUrlMapForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, urlMapLink string, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func SetUrlMapForTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, urlMapLink string, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("TargetHttpsProxy", "set_url_map", key.Region, key.Zone, string(targetHttpsProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	// Set name in case it is not present in the key
 	key.Name = targetHttpsProxy.Name
@@ -68,10 +71,12 @@ func SetUrlMapForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttp
 	}
 }
 
-func PatchRegionalTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func PatchRegionalTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("TargetHttpsProxy", "patch", key.Region, key.Zone, string(targetHttpsProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch key.Type() {
 	case meta.Regional:
@@ -114,10 +119,12 @@ func PatchRegionalTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHtt
 }
 
 // SetSslCertificateForTargetHttpsProxy() sets the SSL Certificate for a target https proxy
-func SetSslCertificateForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, sslCertURLs []string, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func SetSslCertificateForTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, sslCertURLs []string, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("TargetHttpsProxy", "set_ssl_certificate", key.Region, key.Zone, string(targetHttpsProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	// Set name in case it is not present in the key
 	key.Name = targetHttpsProxy.Name
@@ -155,10 +162,12 @@ func SetSslCertificateForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, ta
 }
 
 // SetSslPolicyForTargetHttpsProxy() sets the url map for a target proxy
-func SetSslPolicyForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, SslPolicyLink string, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func SetSslPolicyForTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, SslPolicyLink string, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("TargetHttpProxy", "set_url_map", key.Region, key.Zone, string(targetHttpsProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	// Set name in case it is not present in the key
 	key.Name = targetHttpsProxy.Name
@@ -193,10 +202,12 @@ func SetSslPolicyForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetH
 }
 
 // SetUrlMapForTargetHttpProxy() sets the url map for a target proxy
-func SetUrlMapForTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpProxy *TargetHttpProxy, urlMapLink string, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func SetUrlMapForTargetHttpProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpProxy *TargetHttpProxy, urlMapLink string, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("TargetHttpProxy", "set_url_map", key.Region, key.Zone, string(targetHttpProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	// Set name in case it is not present in the key
 	key.Name = targetHttpProxy.Name
@@ -231,10 +242,12 @@ func SetUrlMapForTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpP
 }
 
 // SetProxyForForwardingRule() sets the target proxy for a forwarding rule
-func SetProxyForForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, targetProxyLink string, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func SetProxyForForwardingRule(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, targetProxyLink string, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("ForwardingRule", "set_proxy", key.Region, key.Zone, string(forwardingRule.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	// Set name in case it is not present in the key
 	key.Name = forwardingRule.Name
@@ -269,15 +282,17 @@ func SetProxyForForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRul
 }
 
 // SetSecurityPolicy sets the cloud armor security policy for a backend service.
-func SetSecurityPolicy(gceCloud *gce.Cloud, backendService *BackendService, securityPolicy string, logger klog.Logger) error {
+func SetSecurityPolicy(ctx context.Context, gceCloud *gce.Cloud, backendService *BackendService, securityPolicy string, logger klog.Logger) error {
 	key := meta.GlobalKey(backendService.Name)
 	if backendService.Scope != meta.Global {
 		return fmt.Errorf("cloud armor security policies not supported for %s backend service %s", backendService.Scope, backendService.Name)
 	}
 
-	ctx, cancel := cloud.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("BackendService", "set_security_policy", key.Region, key.Zone, string(backendService.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	logger.V(3).Info("setting security policy for backend service", "key", key)
 
 	switch backendService.Version {
@@ -305,10 +320,12 @@ func SetSecurityPolicy(gceCloud *gce.Cloud, backendService *BackendService, secu
 	}
 }
 
-func AddSignedUrlKey(gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, signedUrlKey *SignedUrlKey, logger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func AddSignedUrlKey(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, signedUrlKey *SignedUrlKey, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("BackendService", "addSignedUrlKey", key.Region, key.Zone, string(backendService.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch backendService.Version {
 	case meta.VersionAlpha:
 		alphaKey, err := signedUrlKey.ToAlpha()
@@ -334,10 +351,12 @@ func AddSignedUrlKey(gceCloud *gce.Cloud, key *meta.Key, backendService *Backend
 	}
 }
 
-func DeleteSignedUrlKey(gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, keyName string, urlKeyLogger klog.Logger) error {
-	ctx, cancel := cloud.ContextWithCallTimeout()
+func DeleteSignedUrlKey(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, keyName string, urlKeyLogger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := metrics.NewMetricContext("BackendService", "deleteSignedUrlKey", key.Region, key.Zone, string(backendService.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch backendService.Version {
 	case meta.VersionAlpha:
 		urlKeyLogger.Info("Updating alpha BackendService, delete SignedUrlKey")
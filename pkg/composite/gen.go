@@ -19,6 +19,7 @@ limitations under the License.
 package composite
 
 import (
+	"context"
 	"fmt"
 
 	cloudprovider "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -6999,10 +7000,12 @@ type WeightedBackendService struct {
 	NullFields      []string `json:"-"`
 }
 
-func CreateAddress(gceCloud *gce.Cloud, key *meta.Key, address *Address, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateAddress(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, address *Address, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("Address", "create", key.Region, key.Zone, string(address.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch address.Version {
 	case meta.VersionAlpha:
@@ -7053,11 +7056,13 @@ func CreateAddress(gceCloud *gce.Cloud, key *meta.Key, address *Address, logger
 	}
 }
 
-func DeleteAddress(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteAddress(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("Address", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -7090,11 +7095,13 @@ func DeleteAddress(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, log
 	}
 }
 
-func GetAddress(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*Address, error) {
+func GetAddress(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*Address, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("Address", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -7142,10 +7149,12 @@ func GetAddress(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger
 	return compositeType, nil
 }
 
-func ListAddresses(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*Address, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListAddresses(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*Address, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("Address", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -7284,10 +7293,12 @@ func (address *Address) ToGA() (*compute.Address, error) {
 	return ga, nil
 }
 
-func CreateBackendService(gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateBackendService(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("BackendService", "create", key.Region, key.Zone, string(backendService.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch backendService.Version {
 	case meta.VersionAlpha:
@@ -7338,10 +7349,12 @@ func CreateBackendService(gceCloud *gce.Cloud, key *meta.Key, backendService *Ba
 	}
 }
 
-func UpdateBackendService(gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func UpdateBackendService(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("BackendService", "update", key.Region, key.Zone, string(backendService.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch backendService.Version {
 	case meta.VersionAlpha:
 		alpha, err := backendService.ToAlpha()
@@ -7388,11 +7401,13 @@ func UpdateBackendService(gceCloud *gce.Cloud, key *meta.Key, backendService *Ba
 	}
 }
 
-func DeleteBackendService(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteBackendService(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("BackendService", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -7425,11 +7440,13 @@ func DeleteBackendService(gceCloud *gce.Cloud, key *meta.Key, version meta.Versi
 	}
 }
 
-func GetBackendService(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*BackendService, error) {
+func GetBackendService(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*BackendService, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("BackendService", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -7474,10 +7491,12 @@ func GetBackendService(gceCloud *gce.Cloud, key *meta.Key, version meta.Version,
 	return compositeType, nil
 }
 
-func ListBackendServices(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*BackendService, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListBackendServices(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*BackendService, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("BackendService", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -7664,10 +7683,12 @@ func (backendService *BackendService) ToGA() (*compute.BackendService, error) {
 	return ga, nil
 }
 
-func CreateForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateForwardingRule(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("ForwardingRule", "create", key.Region, key.Zone, string(forwardingRule.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch forwardingRule.Version {
 	case meta.VersionAlpha:
@@ -7718,10 +7739,12 @@ func CreateForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRule *Fo
 	}
 }
 
-func PatchForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func PatchForwardingRule(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, forwardingRule *ForwardingRule, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("ForwardingRule", "patch", key.Region, key.Zone, string(forwardingRule.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch forwardingRule.Version {
 	case meta.VersionAlpha:
 		alpha, err := forwardingRule.ToAlpha()
@@ -7771,11 +7794,13 @@ func PatchForwardingRule(gceCloud *gce.Cloud, key *meta.Key, forwardingRule *For
 	}
 }
 
-func DeleteForwardingRule(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteForwardingRule(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("ForwardingRule", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -7808,11 +7833,13 @@ func DeleteForwardingRule(gceCloud *gce.Cloud, key *meta.Key, version meta.Versi
 	}
 }
 
-func GetForwardingRule(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*ForwardingRule, error) {
+func GetForwardingRule(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*ForwardingRule, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("ForwardingRule", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -7860,10 +7887,12 @@ func GetForwardingRule(gceCloud *gce.Cloud, key *meta.Key, version meta.Version,
 	return compositeType, nil
 }
 
-func ListForwardingRules(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*ForwardingRule, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListForwardingRules(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*ForwardingRule, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("ForwardingRule", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -8002,10 +8031,12 @@ func (forwardingRule *ForwardingRule) ToGA() (*compute.ForwardingRule, error) {
 	return ga, nil
 }
 
-func CreateHealthCheck(gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCheck, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateHealthCheck(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCheck, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("HealthCheck", "create", key.Region, key.Zone, string(healthCheck.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch healthCheck.Version {
 	case meta.VersionAlpha:
@@ -8056,10 +8087,12 @@ func CreateHealthCheck(gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCh
 	}
 }
 
-func UpdateHealthCheck(gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCheck, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func UpdateHealthCheck(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCheck, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("HealthCheck", "update", key.Region, key.Zone, string(healthCheck.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch healthCheck.Version {
 	case meta.VersionAlpha:
 		alpha, err := healthCheck.ToAlpha()
@@ -8106,11 +8139,13 @@ func UpdateHealthCheck(gceCloud *gce.Cloud, key *meta.Key, healthCheck *HealthCh
 	}
 }
 
-func DeleteHealthCheck(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteHealthCheck(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("HealthCheck", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -8143,11 +8178,13 @@ func DeleteHealthCheck(gceCloud *gce.Cloud, key *meta.Key, version meta.Version,
 	}
 }
 
-func GetHealthCheck(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*HealthCheck, error) {
+func GetHealthCheck(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*HealthCheck, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("HealthCheck", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -8192,10 +8229,12 @@ func GetHealthCheck(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, lo
 	return compositeType, nil
 }
 
-func ListHealthChecks(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*HealthCheck, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListHealthChecks(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*HealthCheck, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("HealthCheck", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -8516,10 +8555,12 @@ func (networkEndpoint *NetworkEndpoint) ToGA() (*compute.NetworkEndpoint, error)
 	return ga, nil
 }
 
-func CreateNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, networkEndpointGroup *NetworkEndpointGroup, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateNetworkEndpointGroup(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, networkEndpointGroup *NetworkEndpointGroup, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "create", key.Region, key.Zone, string(networkEndpointGroup.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch key.Type() {
 	case meta.Zonal:
 	default:
@@ -8554,11 +8595,13 @@ func CreateNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, networkEndpo
 	}
 }
 
-func DeleteNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteNetworkEndpointGroup(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch key.Type() {
 	case meta.Zonal:
 	default:
@@ -8578,11 +8621,13 @@ func DeleteNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, version meta
 	}
 }
 
-func GetNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*NetworkEndpointGroup, error) {
+func GetNetworkEndpointGroup(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*NetworkEndpointGroup, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -8616,10 +8661,12 @@ func GetNetworkEndpointGroup(gceCloud *gce.Cloud, key *meta.Key, version meta.Ve
 	return compositeType, nil
 }
 
-func ListNetworkEndpointGroups(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*NetworkEndpointGroup, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListNetworkEndpointGroups(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*NetworkEndpointGroup, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -8654,11 +8701,13 @@ func ListNetworkEndpointGroups(gceCloud *gce.Cloud, key *meta.Key, version meta.
 	return compositeObjs, nil
 }
 
-func AttachNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsAttachEndpointsRequest, logger klog.Logger) error {
+func AttachNetworkEndpoints(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsAttachEndpointsRequest, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "attach", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch key.Type() {
 	case meta.Zonal:
@@ -8691,11 +8740,13 @@ func AttachNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Ver
 	}
 }
 
-func DetachNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsDetachEndpointsRequest, logger klog.Logger) error {
+func DetachNetworkEndpoints(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsDetachEndpointsRequest, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "detach", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch key.Type() {
 	case meta.Zonal:
@@ -8728,11 +8779,13 @@ func DetachNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Ver
 	}
 }
 
-func ListNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsListEndpointsRequest, logger klog.Logger) ([]*NetworkEndpointWithHealthStatus, error) {
+func ListNetworkEndpoints(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *NetworkEndpointGroupsListEndpointsRequest, logger klog.Logger) ([]*NetworkEndpointWithHealthStatus, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -8781,10 +8834,12 @@ func ListNetworkEndpoints(gceCloud *gce.Cloud, key *meta.Key, version meta.Versi
 	return compositeObjs, nil
 }
 
-func AggregatedListNetworkEndpointGroup(gceCloud *gce.Cloud, version meta.Version, logger klog.Logger) (map[*meta.Key]*NetworkEndpointGroup, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func AggregatedListNetworkEndpointGroup(ctx context.Context, gceCloud *gce.Cloud, version meta.Version, logger klog.Logger) (map[*meta.Key]*NetworkEndpointGroup, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("NetworkEndpointGroup", "aggregateList", "", "", string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	compositeMap := make(map[*meta.Key]*NetworkEndpointGroup)
 	var gceObjs interface{}
@@ -9393,10 +9448,12 @@ func (signedUrlKey *SignedUrlKey) ToGA() (*compute.SignedUrlKey, error) {
 	return ga, nil
 }
 
-func CreateSslCertificate(gceCloud *gce.Cloud, key *meta.Key, sslCertificate *SslCertificate, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateSslCertificate(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, sslCertificate *SslCertificate, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("SslCertificate", "create", key.Region, key.Zone, string(sslCertificate.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch sslCertificate.Version {
 	case meta.VersionAlpha:
@@ -9447,11 +9504,13 @@ func CreateSslCertificate(gceCloud *gce.Cloud, key *meta.Key, sslCertificate *Ss
 	}
 }
 
-func DeleteSslCertificate(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteSslCertificate(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("SslCertificate", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -9484,11 +9543,13 @@ func DeleteSslCertificate(gceCloud *gce.Cloud, key *meta.Key, version meta.Versi
 	}
 }
 
-func GetSslCertificate(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*SslCertificate, error) {
+func GetSslCertificate(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*SslCertificate, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("SslCertificate", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -9533,10 +9594,12 @@ func GetSslCertificate(gceCloud *gce.Cloud, key *meta.Key, version meta.Version,
 	return compositeType, nil
 }
 
-func ListSslCertificates(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*SslCertificate, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListSslCertificates(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*SslCertificate, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("SslCertificate", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -9675,10 +9738,12 @@ func (sslCertificate *SslCertificate) ToGA() (*compute.SslCertificate, error) {
 	return ga, nil
 }
 
-func CreateTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpProxy *TargetHttpProxy, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateTargetHttpProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpProxy *TargetHttpProxy, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpProxy", "create", key.Region, key.Zone, string(targetHttpProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch targetHttpProxy.Version {
 	case meta.VersionAlpha:
@@ -9729,11 +9794,13 @@ func CreateTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpProxy *
 	}
 }
 
-func DeleteTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteTargetHttpProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpProxy", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -9766,11 +9833,13 @@ func DeleteTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Vers
 	}
 }
 
-func GetTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*TargetHttpProxy, error) {
+func GetTargetHttpProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*TargetHttpProxy, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpProxy", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -9815,10 +9884,12 @@ func GetTargetHttpProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Version
 	return compositeType, nil
 }
 
-func ListTargetHttpProxies(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*TargetHttpProxy, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListTargetHttpProxies(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*TargetHttpProxy, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpProxy", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -9957,10 +10028,12 @@ func (targetHttpProxy *TargetHttpProxy) ToGA() (*compute.TargetHttpProxy, error)
 	return ga, nil
 }
 
-func CreateTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpsProxy", "create", key.Region, key.Zone, string(targetHttpsProxy.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch targetHttpsProxy.Version {
 	case meta.VersionAlpha:
@@ -10011,11 +10084,13 @@ func CreateTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy
 	}
 }
 
-func DeleteTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpsProxy", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -10048,11 +10123,13 @@ func DeleteTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Ver
 	}
 }
 
-func GetTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*TargetHttpsProxy, error) {
+func GetTargetHttpsProxy(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*TargetHttpsProxy, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpsProxy", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -10097,10 +10174,12 @@ func GetTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, version meta.Versio
 	return compositeType, nil
 }
 
-func ListTargetHttpsProxies(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*TargetHttpsProxy, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListTargetHttpsProxies(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*TargetHttpsProxy, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("TargetHttpsProxy", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -10239,10 +10318,12 @@ func (targetHttpsProxy *TargetHttpsProxy) ToGA() (*compute.TargetHttpsProxy, err
 	return ga, nil
 }
 
-func CreateUrlMap(gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func CreateUrlMap(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("UrlMap", "create", key.Region, key.Zone, string(urlMap.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch urlMap.Version {
 	case meta.VersionAlpha:
@@ -10293,10 +10374,12 @@ func CreateUrlMap(gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klo
 	}
 }
 
-func UpdateUrlMap(gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func UpdateUrlMap(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("UrlMap", "update", key.Region, key.Zone, string(urlMap.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 	switch urlMap.Version {
 	case meta.VersionAlpha:
 		alpha, err := urlMap.ToAlpha()
@@ -10343,11 +10426,13 @@ func UpdateUrlMap(gceCloud *gce.Cloud, key *meta.Key, urlMap *UrlMap, logger klo
 	}
 }
 
-func DeleteUrlMap(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func DeleteUrlMap(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("UrlMap", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch version {
 	case meta.VersionAlpha:
@@ -10380,11 +10465,13 @@ func DeleteUrlMap(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logg
 	}
 }
 
-func GetUrlMap(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*UrlMap, error) {
+func GetUrlMap(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*UrlMap, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("UrlMap", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -10429,10 +10516,12 @@ func GetUrlMap(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger
 	return compositeType, nil
 }
 
-func ListUrlMaps(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*UrlMap, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func ListUrlMaps(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*UrlMap, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("UrlMap", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -75,6 +75,7 @@ limitations under the License.
 
 package composite
 import (
+	"context"
 	"fmt"
 
 	"k8s.io/klog/v2"
@@ -217,10 +218,12 @@ func genFuncs(wr io.Writer) {
 {{- end}} {{/* IsDefaultZonalService */}}
 	{{if .IsMainService}}
 		{{if .HasCRUD}}
-func Create{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func Create{{.Name}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "create", key.Region, key.Zone, string({{.VarName}}.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	{{- if $onlyZonalKeySupported}}
 	switch key.Type() {
@@ -295,10 +298,12 @@ func Create{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}
 }
 
 {{if .HasUpdate}}
-func Update{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func Update{{.Name}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "update", key.Region, key.Zone, string({{.VarName}}.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	{{- if $onlyZonalKeySupported}}
 	switch key.Type() {
@@ -371,10 +376,12 @@ func Update{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}
 {{- end}} {{/*HasUpdate*/}}
 
 {{if .HasPatch}}
-func Patch{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func Patch{{.Name}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}}, logger klog.Logger) error {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "patch", key.Region, key.Zone, string({{.VarName}}.Version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	{{- if $onlyZonalKeySupported}}
 	switch key.Type() {
@@ -457,11 +464,13 @@ func Patch{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, {{.VarName}} *{{.Name}},
 
 
 
-func Delete{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
+func Delete{{.Name}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "delete", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	{{- if $onlyZonalKeySupported}}
 	switch key.Type() {
@@ -517,11 +526,13 @@ func Delete{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, l
 	}
 }
 
-func Get{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*{{.Name}}, error) {
+func Get{{.Name}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger) (*{{.Name}}, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "get", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObj interface{}
 	var err error
@@ -598,10 +609,12 @@ func Get{{.Name}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logg
   	return compositeType, nil
 }
 
-func List{{.GetCloudProviderName}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*{{.Name}}, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func List{{.GetCloudProviderName}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, logger klog.Logger, filter *filter.F) ([]*{{.Name}}, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -674,11 +687,13 @@ func List{{.GetCloudProviderName}}(gceCloud *gce.Cloud, key *meta.Key, version m
 }
 
 {{if .IsGroupResourceService}}
-func {{.GetGroupResourceInfo.AttachFuncName}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.AttachReqName}}, logger klog.Logger) error {
+func {{.GetGroupResourceInfo.AttachFuncName}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.AttachReqName}}, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "attach", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch key.Type() {
 	case meta.Zonal:
@@ -711,11 +726,13 @@ func {{.GetGroupResourceInfo.AttachFuncName}}(gceCloud *gce.Cloud, key *meta.Key
 	}
 }
 
-func {{.GetGroupResourceInfo.DetachFuncName}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.DetachReqName}}, logger klog.Logger) error {
+func {{.GetGroupResourceInfo.DetachFuncName}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.DetachReqName}}, logger klog.Logger) error {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "detach", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	switch key.Type() {
 	case meta.Zonal:
@@ -748,11 +765,13 @@ func {{.GetGroupResourceInfo.DetachFuncName}}(gceCloud *gce.Cloud, key *meta.Key
 	}
 }
 
-func {{.GetGroupResourceInfo.ListFuncName}}(gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.ListReqName}}, logger klog.Logger) ([]*{{.GetGroupResourceInfo.ListRespName}}, error) {
+func {{.GetGroupResourceInfo.ListFuncName}}(ctx context.Context, gceCloud *gce.Cloud, key *meta.Key, version meta.Version, req *{{.GetGroupResourceInfo.ListReqName}}, logger klog.Logger) ([]*{{.GetGroupResourceInfo.ListRespName}}, error) {
 	logger = logger.WithValues("name", key.Name)
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "list", key.Region, key.Zone, string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	var gceObjs interface{}
 	var err error
@@ -801,10 +820,12 @@ func {{.GetGroupResourceInfo.ListFuncName}}(gceCloud *gce.Cloud, key *meta.Key,
 	return compositeObjs, nil
 }
 
-func {{.GetGroupResourceInfo.AggListFuncName}}{{.GetGroupResourceInfo.AggListRespName}}(gceCloud *gce.Cloud, version meta.Version, logger klog.Logger) (map[*meta.Key]*{{.GetGroupResourceInfo.AggListRespName}}, error) {
-	ctx, cancel := cloudprovider.ContextWithCallTimeout()
+func {{.GetGroupResourceInfo.AggListFuncName}}{{.GetGroupResourceInfo.AggListRespName}}(ctx context.Context, gceCloud *gce.Cloud, version meta.Version, logger klog.Logger) (map[*meta.Key]*{{.GetGroupResourceInfo.AggListRespName}}, error) {
+	ctx, cancel := contextWithCallTimeout(ctx)
 	defer cancel()
 	mc := compositemetrics.NewMetricContext("{{.Name}}", "aggregateList", "", "", string(version))
+	ctx = mc.StartSpan(ctx)
+	defer mc.EndSpan()
 
 	compositeMap := make(map[*meta.Key]*{{.GetGroupResourceInfo.AggListRespName}})
 	var gceObjs interface{}
@@ -17,9 +17,13 @@ limitations under the License.
 package metrics
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/ingress-gce/pkg/tracing"
 )
 
 type apiCallMetrics struct {
@@ -43,6 +47,10 @@ type metricContext struct {
 	// The cardinalities of attributes and metricLabels (defined above) must
 	// match, or prometheus will panic.
 	attributes []string
+	// span traces the API call, it is nil unless StartSpan was called.
+	span trace.Span
+	// err is the result of the API call observed by Observe.
+	err error
 }
 
 // Value for an unused label in the metric dimension.
@@ -55,10 +63,30 @@ func (mc *metricContext) Observe(err error) error {
 	if err != nil {
 		apiMetrics.errors.WithLabelValues(mc.attributes...).Inc()
 	}
+	mc.err = err
 
 	return err
 }
 
+// StartSpan starts the span of the API call as a child of the span in ctx and
+// returns ctx with the span. The span must be ended with EndSpan.
+func (mc *metricContext) StartSpan(ctx context.Context) context.Context {
+	ctx, mc.span = tracing.Start(ctx, "composite."+mc.attributes[0],
+		attribute.String("region", mc.attributes[1]),
+		attribute.String("zone", mc.attributes[2]),
+		attribute.String("version", mc.attributes[3]),
+	)
+	return ctx
+}
+
+// EndSpan ends the span started by StartSpan with the result observed by
+// Observe, if any.
+func (mc *metricContext) EndSpan() {
+	if mc.span != nil {
+		tracing.End(mc.span, mc.err)
+	}
+}
+
 func NewMetricContext(prefix, request, region, zone, version string) *metricContext {
 	if len(zone) == 0 {
 		zone = unusedMetricLabel
@@ -17,15 +17,28 @@ limitations under the License.
 package composite
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 )
 
+// callTimeout is the timeout of a GCE API call, the same as the one of
+// cloud.ContextWithCallTimeout.
+const callTimeout = 1 * time.Hour
+
+// contextWithCallTimeout returns ctx with the timeout of a GCE API call. The
+// API call keeps the values of ctx, e.g. its span and its rate limiting
+// priority.
+func contextWithCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, callTimeout)
+}
+
 // CreateKey is a helper function for creating a meta.Key when interacting with the
 // composite functions.  For regional scopes, this function looks up
 // the region of the gceCloud object.  You should use this wherever possible to avoid
@@ -35,8 +35,10 @@ import (
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
 	informerbackendconfig "k8s.io/ingress-gce/pkg/backendconfig/client/informers/externalversions/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/backends"
 	"k8s.io/ingress-gce/pkg/common/typed"
 	"k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	frontendconfigclient "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned"
 	informerfrontendconfig "k8s.io/ingress-gce/pkg/frontendconfig/client/informers/externalversions/frontendconfig/v1beta1"
@@ -109,7 +111,7 @@ type ControllerContext struct {
 	Translator   *translator.Translator
 	ZoneGetter   *zonegetter.ZoneGetter
 
-	recordersManager *recorders.Manager
+	recordersManager events.RecorderProducer
 
 	logger klog.Logger
 }
@@ -125,6 +127,9 @@ type ControllerContextConfig struct {
 	DefaultBackendSvcPort                utils.ServicePort
 	HealthCheckPath                      string
 	MaxIGSize                            int
+	EnableMultipleIGs                    bool
+	IGAdoptionNamePrefixes               []string
+	IGAdoptionLabel                      string
 	RunL4ILBController                   bool
 	RunL4NetLBController                 bool
 	RunL4StandaloneNEGController         bool
@@ -147,6 +152,25 @@ type ControllerContextConfig struct {
 	EnableL4NetLBRBSByDefault            bool
 }
 
+// ControllerInformers are the informers a ControllerContext is built on. Optional
+// informers are nil when the corresponding CRD client is not configured.
+type ControllerInformers struct {
+	Ingress          cache.SharedIndexInformer
+	Service          cache.SharedIndexInformer
+	BackendConfig    cache.SharedIndexInformer
+	FrontendConfig   cache.SharedIndexInformer
+	Pod              cache.SharedIndexInformer
+	Node             cache.SharedIndexInformer
+	EndpointSlice    cache.SharedIndexInformer
+	SvcNeg           cache.SharedIndexInformer
+	SA               cache.SharedIndexInformer
+	Firewall         cache.SharedIndexInformer
+	Network          cache.SharedIndexInformer
+	GKENetworkParams cache.SharedIndexInformer
+	NodeTopology     cache.SharedIndexInformer
+	L4LBConfig       cache.SharedIndexInformer
+}
+
 // NewControllerContext returns a new shared set of informers.
 func NewControllerContext(
 	kubeClient kubernetes.Interface,
@@ -165,8 +189,6 @@ func NewControllerContext(
 	config ControllerContextConfig,
 	logger klog.Logger,
 ) (*ControllerContext, error) {
-	logger = logger.WithName("ControllerContext")
-
 	podInformer := informerv1.NewPodInformer(kubeClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	nodeInformer := informerv1.NewNodeInformer(kubeClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
 
@@ -178,74 +200,114 @@ func NewControllerContext(
 		logger.Error(err, "unable to SetTransForm")
 	}
 
-	context := &ControllerContext{
-		KubeClient:              kubeClient,
-		FirewallClient:          firewallClient,
-		SvcNegClient:            svcnegClient,
-		SAClient:                saClient,
-		EventRecorderClient:     eventRecorderClient,
-		NodeTopologyClient:      nodeTopologyClient,
-		L4LBConfigClient:        l4LBConfigClient,
-		Cloud:                   cloud,
-		ClusterNamer:            clusterNamer,
-		L4Namer:                 namer.NewL4Namer(string(kubeSystemUID), clusterNamer),
-		KubeSystemUID:           kubeSystemUID,
-		ControllerMetrics:       metrics.NewControllerMetrics(flags.F.MetricsExportInterval, logger),
-		ControllerContextConfig: config,
-		L4Metrics:               l4metrics.NewCollector(flags.F.MetricsExportInterval, flags.F.L4NetLBProvisionDeadline, flags.F.EnableL4NetLBDualStack, flags.F.EnableL4ILBDualStack, logger),
-		IngressInformer:         informernetworking.NewIngressInformer(kubeClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
-		ServiceInformer:         informerv1.NewServiceInformer(kubeClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
-		PodInformer:             podInformer,
-		NodeInformer:            nodeInformer,
-		SvcNegInformer:          informersvcneg.NewServiceNetworkEndpointGroupInformer(svcnegClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
-		recordersManager:        recorders.NewManager(eventRecorderClient, logger),
-		logger:                  logger,
+	informers := ControllerInformers{
+		Ingress: informernetworking.NewIngressInformer(kubeClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
+		Service: informerv1.NewServiceInformer(kubeClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
+		Pod:     podInformer,
+		Node:    nodeInformer,
+		SvcNeg:  informersvcneg.NewServiceNetworkEndpointGroupInformer(svcnegClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer()),
 	}
 
 	if backendConfigClient != nil {
-		context.BackendConfigInformer = informerbackendconfig.NewBackendConfigInformer(backendConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		informers.BackendConfig = informerbackendconfig.NewBackendConfigInformer(backendConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	if frontendConfigClient != nil {
-		context.FrontendConfigInformer = informerfrontendconfig.NewFrontendConfigInformer(frontendConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		informers.FrontendConfig = informerfrontendconfig.NewFrontendConfigInformer(frontendConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	if firewallClient != nil {
-		context.FirewallInformer = informerfirewall.NewGCPFirewallInformer(firewallClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		informers.Firewall = informerfirewall.NewGCPFirewallInformer(firewallClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	if saClient != nil {
-		context.SAInformer = informerserviceattachment.NewServiceAttachmentInformer(saClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		informers.SA = informerserviceattachment.NewServiceAttachmentInformer(saClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	if networkClient != nil {
-		context.NetworkInformer = informernetwork.NewNetworkInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
-		context.GKENetworkParamsInformer = informernetwork.NewGKENetworkParamSetInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
-	}
-
-	if flags.F.GKEClusterType == ClusterTypeRegional {
-		context.RegionalCluster = true
+		informers.Network = informernetwork.NewNetworkInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		informers.GKENetworkParams = informernetwork.NewGKENetworkParamSetInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	if flags.F.EnableMultiSubnetClusterPhase1 {
 		if nodeTopologyClient != nil {
-			context.NodeTopologyInformer = informernodetopology.NewFilteredNodeTopologyInformer(nodeTopologyClient, config.ResyncPeriod, utils.NewNamespaceIndexer(), func(listOptions *metav1.ListOptions) {
+			informers.NodeTopology = informernodetopology.NewFilteredNodeTopologyInformer(nodeTopologyClient, config.ResyncPeriod, utils.NewNamespaceIndexer(), func(listOptions *metav1.ListOptions) {
 				listOptions.FieldSelector = fmt.Sprintf("metadata.name=%s", flags.F.NodeTopologyCRName)
 			})
 		}
 	}
 
-	// L4LB Logging CRD informer
-	if flags.F.ManageL4LBLogging {
-		context.L4LBConfigInformer = informerl4lbconfig.NewL4LBConfigInformer(l4LBConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+	// L4LBConfig CRD informer
+	if flags.F.ManageL4LBLogging || flags.F.ManageL4LBConnectionTracking {
+		informers.L4LBConfig = informerl4lbconfig.NewL4LBConfigInformer(l4LBConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
 	// Do not trigger periodic resync on EndpointSlices object.
 	// This aims improve NEG controller performance by avoiding unnecessary NEG sync that triggers for each NEG syncer.
 	// As periodic resync may temporary starve NEG API ratelimit quota.
-	context.EndpointSliceInformer = discoveryinformer.NewEndpointSliceInformer(kubeClient, config.Namespace, 0,
+	informers.EndpointSlice = discoveryinformer.NewEndpointSliceInformer(kubeClient, config.Namespace, 0,
 		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, endpointslices.EndpointSlicesByServiceIndex: endpointslices.EndpointSlicesByServiceFunc})
 
+	return NewControllerContextWithInformers(kubeClient, firewallClient, svcnegClient, saClient, nodeTopologyClient, l4LBConfigClient, eventRecorderClient, cloud, clusterNamer, kubeSystemUID, config, informers, logger)
+}
+
+// NewControllerContextWithInformers returns a ControllerContext built on the given
+// informers. The informers are not owned by the context: Start runs them, so callers
+// that share informers across contexts must run them themselves.
+func NewControllerContextWithInformers(
+	kubeClient kubernetes.Interface,
+	firewallClient firewallclient.Interface,
+	svcnegClient svcnegclient.Interface,
+	saClient serviceattachmentclient.Interface,
+	nodeTopologyClient nodetopologyclient.Interface,
+	l4LBConfigClient l4lbconfigclient.Interface,
+	eventRecorderClient kubernetes.Interface,
+	cloud *gce.Cloud,
+	clusterNamer *namer.Namer,
+	kubeSystemUID types.UID,
+	config ControllerContextConfig,
+	informers ControllerInformers,
+	logger klog.Logger,
+) (*ControllerContext, error) {
+	logger = logger.WithName("ControllerContext")
+
+	context := &ControllerContext{
+		KubeClient:               kubeClient,
+		FirewallClient:           firewallClient,
+		SvcNegClient:             svcnegClient,
+		SAClient:                 saClient,
+		EventRecorderClient:      eventRecorderClient,
+		NodeTopologyClient:       nodeTopologyClient,
+		L4LBConfigClient:         l4LBConfigClient,
+		Cloud:                    cloud,
+		ClusterNamer:             clusterNamer,
+		L4Namer:                  namer.NewL4Namer(string(kubeSystemUID), clusterNamer),
+		KubeSystemUID:            kubeSystemUID,
+		ControllerMetrics:        metrics.NewControllerMetrics(flags.F.MetricsExportInterval, logger),
+		ControllerContextConfig:  config,
+		L4Metrics:                l4metrics.NewCollector(flags.F.MetricsExportInterval, flags.F.L4NetLBProvisionDeadline, flags.F.EnableL4NetLBDualStack, flags.F.EnableL4ILBDualStack, logger),
+		IngressInformer:          informers.Ingress,
+		ServiceInformer:          informers.Service,
+		BackendConfigInformer:    informers.BackendConfig,
+		FrontendConfigInformer:   informers.FrontendConfig,
+		PodInformer:              informers.Pod,
+		NodeInformer:             informers.Node,
+		EndpointSliceInformer:    informers.EndpointSlice,
+		SvcNegInformer:           informers.SvcNeg,
+		SAInformer:               informers.SA,
+		FirewallInformer:         informers.Firewall,
+		NetworkInformer:          informers.Network,
+		GKENetworkParamsInformer: informers.GKENetworkParams,
+		NodeTopologyInformer:     informers.NodeTopology,
+		L4LBConfigInformer:       informers.L4LBConfig,
+		recordersManager:         events.NewFilteringRecorderProducer(recorders.NewManager(eventRecorderClient, logger), events.FilterOptionsFromFlags()),
+		logger:                   logger,
+	}
+
+	if flags.F.GKEClusterType == ClusterTypeRegional {
+		context.RegionalCluster = true
+	}
+
 	context.Translator = translator.NewTranslator(
 		context.ServiceInformer,
 		context.BackendConfigInformer,
@@ -275,13 +337,18 @@ func NewControllerContext(
 	}
 
 	context.InstancePool = instancegroups.NewManager(&instancegroups.ManagerConfig{
-		Cloud:        context.Cloud,
+		Cloud:        instancegroups.NewCloudProvider(context.Cloud),
 		Namer:        context.ClusterNamer,
 		Recorders:    context,
 		BasePath:     utils.GetBasePath(context.Cloud),
 		ZoneGetter:   context.ZoneGetter,
 		MaxIGSize:    config.MaxIGSize,
 		ReadOnlyMode: config.ReadOnlyMode,
+
+		EnableMultipleIGs:    config.EnableMultipleIGs,
+		AdoptionNamePrefixes: config.IGAdoptionNamePrefixes,
+		AdoptionLabel:        config.IGAdoptionLabel,
+		ShardLinker:          backends.NewShardLinker(context.Cloud),
 	})
 
 	return context, nil
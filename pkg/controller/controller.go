@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	context2 "context"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"go.opentelemetry.io/otel/attribute"
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +42,7 @@ import (
 	"k8s.io/ingress-gce/pkg/common/operator"
 	"k8s.io/ingress-gce/pkg/context"
 	legacytranslator "k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/debug"
 	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/frontendconfig"
@@ -49,9 +52,13 @@ import (
 	"k8s.io/ingress-gce/pkg/loadbalancers/features"
 	"k8s.io/ingress-gce/pkg/metrics"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	negmetrics "k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
+	"k8s.io/ingress-gce/pkg/ratelimit"
 	ingsync "k8s.io/ingress-gce/pkg/sync"
+	"k8s.io/ingress-gce/pkg/systemhealth"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/translator"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
@@ -107,6 +114,13 @@ type LoadBalancerController struct {
 
 	backendPool *backends.Pool
 
+	// debugTracker records the outcome of the last sync of each ingress for
+	// the debug endpoints.
+	debugTracker *debug.SyncTracker
+
+	// syncTimeline measures the sync latency of ingresses.
+	syncTimeline *synctimeline.Timeline
+
 	logger klog.Logger
 }
 
@@ -146,6 +160,8 @@ func NewLoadBalancerController(
 		metrics:                        ctx.ControllerMetrics,
 		ZoneGetter:                     ctx.ZoneGetter,
 		enableMultiSubnetClusterPhase1: enableMultiSubnetClusterPhase1,
+		debugTracker:                   debug.NewSyncTracker(),
+		syncTimeline:                   synctimeline.Ingress,
 		backendPool:                    backendPool,
 		logger:                         logger,
 	}
@@ -168,6 +184,7 @@ func NewLoadBalancerController(
 
 			ingLogger.Info("Ingress added, enqueuing")
 			lbc.ctx.Recorder(addIng.Namespace).Eventf(addIng, apiv1.EventTypeNormal, events.SyncIngress, "Scheduled for sync")
+			lbc.syncTimeline.ObserveChange(common.NamespacedName(addIng))
 			lbc.ingQueue.Enqueue(obj)
 		},
 		DeleteFunc: func(obj interface{}) {
@@ -188,6 +205,7 @@ func NewLoadBalancerController(
 			}
 
 			ingLogger.Info("Ingress deleted, enqueueing")
+			lbc.syncTimeline.ObserveChange(common.NamespacedName(delIng))
 			lbc.ingQueue.Enqueue(obj)
 		},
 		UpdateFunc: func(old, cur interface{}) {
@@ -209,13 +227,21 @@ func NewLoadBalancerController(
 				}
 				return
 			}
-			if reflect.DeepEqual(old, cur) {
+			resync := reflect.DeepEqual(old, cur)
+			if resync {
 				ingLogger.Info("Periodic enqueueing of ingress")
 			} else {
 				ingLogger.Info("Ingress changed, enqueuing")
 			}
+			if old.(*v1.Ingress).Generation != curIng.Generation {
+				lbc.syncTimeline.ObserveChange(common.NamespacedName(curIng))
+			}
 			lbc.ctx.Recorder(curIng.Namespace).Eventf(curIng, apiv1.EventTypeNormal, events.SyncIngress, "Scheduled for sync")
-			lbc.ingQueue.Enqueue(cur)
+			if resync {
+				lbc.ingQueue.EnqueueResync(cur)
+			} else {
+				lbc.ingQueue.Enqueue(cur)
+			}
 		},
 	})
 
@@ -344,7 +370,7 @@ func (lbc *LoadBalancerController) SystemHealth() error {
 	version := meta.VersionGA
 	var scope meta.KeyType = meta.Global
 	beLogger := lbc.logger.WithValues("backendServiceName", name, "backendVersion", version, "backendScope", scope)
-	_, err := lbc.backendPool.Get(name, meta.VersionGA, meta.Global, beLogger)
+	_, err := lbc.backendPool.Get(context2.Background(), name, meta.VersionGA, meta.Global, beLogger)
 
 	// If this container is scheduled on a node without compute/rw it is
 	// effectively useless, but it is healthy. Reporting it as unhealthy
@@ -356,6 +382,13 @@ func (lbc *LoadBalancerController) SystemHealth() error {
 	return utils.IgnoreHTTPNotFound(err)
 }
 
+// HealthStatus returns the detailed health of the ingress controller.
+func (lbc *LoadBalancerController) HealthStatus() systemhealth.Status {
+	return systemhealth.StatusFromError(lbc.SystemHealth()).
+		WithCacheSynced(lbc.hasSynced()).
+		WithQueueDepth(lbc.ingQueue.Len())
+}
+
 // Run starts the loadbalancer controller.
 func (lbc *LoadBalancerController) Run() {
 	defer func() {
@@ -386,7 +419,7 @@ func (lbc *LoadBalancerController) Stop() {
 }
 
 // SyncBackends implements Controller.
-func (lbc *LoadBalancerController) SyncBackends(state interface{}, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) SyncBackends(ctx context2.Context, state interface{}, ingLogger klog.Logger) error {
 	// TODO: Only lock per resource
 	// It is incredibly tricky to get an efficient synchronization method here.
 	// For now, we are effectively making backend syncing single-threaded to avoid
@@ -407,7 +440,10 @@ func (lbc *LoadBalancerController) SyncBackends(state interface{}, ingLogger klo
 
 	// Only sync instance group when IG is used for this ingress
 	if len(nodePorts(ingSvcPorts)) > 0 {
-		if err := lbc.syncInstanceGroup(syncState.ing, ingSvcPorts, ingLogger); err != nil {
+		endPhase := syncState.trace.Track(synctimeline.PhaseInstanceGroups)
+		err := lbc.syncInstanceGroup(ctx, syncState.ing, ingSvcPorts, ingLogger)
+		endPhase()
+		if err != nil {
 			ingLogger.Error(err, "Failed to sync instance group", "ingress", syncState.ing)
 			return err
 		}
@@ -416,7 +452,8 @@ func (lbc *LoadBalancerController) SyncBackends(state interface{}, ingLogger klo
 	}
 
 	// Sync the backends
-	if err := lbc.backendSyncer.Sync(ingSvcPorts, ingLogger); err != nil {
+	defer syncState.trace.Track(synctimeline.PhaseBackends)()
+	if err := lbc.backendSyncer.Sync(ctx, ingSvcPorts, ingLogger); err != nil {
 		return err
 	}
 
@@ -450,10 +487,10 @@ func (lbc *LoadBalancerController) SyncBackends(state interface{}, ingLogger klo
 		var linkErr error
 		if sp.NEGEnabled {
 			// Link backend to NEG's if the backend has NEG enabled.
-			linkErr = lbc.negLinker.Link(sp, igGroupKeys)
+			linkErr = lbc.negLinker.Link(ctx, sp, igGroupKeys)
 		} else {
 			// Otherwise, link backend to IG's.
-			linkErr = lbc.igLinker.Link(sp, negGroupKeys)
+			linkErr = lbc.igLinker.Link(ctx, sp, negGroupKeys)
 		}
 		if linkErr != nil {
 			return linkErr
@@ -464,7 +501,7 @@ func (lbc *LoadBalancerController) SyncBackends(state interface{}, ingLogger klo
 }
 
 // syncInstanceGroup creates instance groups, syncs instances, sets named ports and updates instance group annotation
-func (lbc *LoadBalancerController) syncInstanceGroup(ing *v1.Ingress, ingSvcPorts []utils.ServicePort, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) syncInstanceGroup(ctx context2.Context, ing *v1.Ingress, ingSvcPorts []utils.ServicePort, ingLogger klog.Logger) error {
 	nodePorts := nodePorts(ingSvcPorts)
 	ingLogger.Info("Syncing Instance Group", "nodePorts", nodePorts)
 	igs, err := lbc.instancePool.EnsureInstanceGroupsAndPorts(lbc.ctx.ClusterNamer.InstanceGroup(), nodePorts, ingLogger)
@@ -477,7 +514,7 @@ func (lbc *LoadBalancerController) syncInstanceGroup(ing *v1.Ingress, ingSvcPort
 		return err
 	}
 	// Add/remove instances to the instance groups.
-	if err = lbc.instancePool.Sync(utils.GetNodeNames(nodes), ingLogger); err != nil {
+	if err = lbc.instancePool.Sync(ctx, utils.GetNodeNames(nodes), ingLogger); err != nil {
 		return err
 	}
 
@@ -502,11 +539,11 @@ func (lbc *LoadBalancerController) syncInstanceGroup(ing *v1.Ingress, ingSvcPort
 }
 
 // GCBackends implements Controller.
-func (lbc *LoadBalancerController) GCBackends(toKeep []*v1.Ingress, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) GCBackends(ctx context2.Context, toKeep []*v1.Ingress, ingLogger klog.Logger) error {
 	// Only GCE ingress associated resources are managed by this controller.
 	GCEIngresses := operator.Ingresses(toKeep).Filter(utils.IsGCEIngress).AsList()
 	svcPortsToKeep := lbc.ToSvcPorts(GCEIngresses)
-	if err := lbc.backendSyncer.GC(svcPortsToKeep, ingLogger); err != nil {
+	if err := lbc.backendSyncer.GC(ctx, svcPortsToKeep, ingLogger); err != nil {
 		return err
 	}
 	// TODO(ingress#120): Move this to the backend pool so it mirrors creation
@@ -522,7 +559,7 @@ func (lbc *LoadBalancerController) GCBackends(toKeep []*v1.Ingress, ingLogger kl
 }
 
 // SyncLoadBalancer implements Controller.
-func (lbc *LoadBalancerController) SyncLoadBalancer(state interface{}, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) SyncLoadBalancer(ctx context2.Context, state interface{}, ingLogger klog.Logger) error {
 	ingLogger = ingLogger.WithName("SyncLoadBalancer")
 	// We expect state to be a syncState
 	syncState, ok := state.(*syncState)
@@ -534,9 +571,14 @@ func (lbc *LoadBalancerController) SyncLoadBalancer(state interface{}, ingLogger
 	if err != nil {
 		return err
 	}
+	lb.SyncTrace = syncState.trace
+	lbc.debugTracker.SetState(common.IngressKeyFunc(syncState.ing, ingLogger), ingressDebugState{
+		UrlMap:      syncState.urlMap,
+		RuntimeInfo: newRuntimeInfoDebugState(lb),
+	})
 
 	// Create higher-level LB resources.
-	l7, err := lbc.l7Pool.Ensure(lb)
+	l7, err := lbc.l7Pool.Ensure(ctx, lb)
 	if err != nil {
 		return err
 	}
@@ -546,13 +588,13 @@ func (lbc *LoadBalancerController) SyncLoadBalancer(state interface{}, ingLogger
 }
 
 // GCv1LoadBalancers implements Controller.
-func (lbc *LoadBalancerController) GCv1LoadBalancers(toKeep []*v1.Ingress) error {
-	return lbc.l7Pool.GCv1(common.ToIngressKeys(toKeep, lbc.logger))
+func (lbc *LoadBalancerController) GCv1LoadBalancers(ctx context2.Context, toKeep []*v1.Ingress) error {
+	return lbc.l7Pool.GCv1(ctx, common.ToIngressKeys(toKeep, lbc.logger))
 }
 
 // GCv2LoadBalancer implements Controller.
-func (lbc *LoadBalancerController) GCv2LoadBalancer(ing *v1.Ingress, scope meta.KeyType) error {
-	return lbc.l7Pool.GCv2(ing, scope)
+func (lbc *LoadBalancerController) GCv2LoadBalancer(ctx context2.Context, ing *v1.Ingress, scope meta.KeyType) error {
+	return lbc.l7Pool.GCv2(ctx, ing, scope)
 }
 
 // EnsureDeleteV1Finalizers implements Controller.
@@ -586,7 +628,7 @@ func (lbc *LoadBalancerController) EnsureDeleteV2Finalizer(ing *v1.Ingress, ingL
 }
 
 // PostProcess implements Controller.
-func (lbc *LoadBalancerController) PostProcess(state interface{}, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) PostProcess(ctx context2.Context, state interface{}, ingLogger klog.Logger) error {
 	ingLogger = ingLogger.WithName("PostProcess")
 	// We expect state to be a syncState
 	syncState, ok := state.(*syncState)
@@ -595,12 +637,12 @@ func (lbc *LoadBalancerController) PostProcess(state interface{}, ingLogger klog
 	}
 
 	// Update the ingress status.
-	return lbc.updateIngressStatus(syncState.l7, syncState.ing, ingLogger)
+	return lbc.updateIngressStatus(ctx, syncState.l7, syncState.ing, ingLogger)
 }
 
 // preSyncGC is intended to execute GC logic before sync if necessary. e.g. Ingress ing has deletion timestamp.
 // preSyncGC returns if the sync needs to take place or not.
-func (lbc *LoadBalancerController) preSyncGC(key string, scope meta.KeyType, ingExists bool, ing *v1.Ingress, ingLogger klog.Logger) (bool, error) {
+func (lbc *LoadBalancerController) preSyncGC(ctx context2.Context, key string, scope meta.KeyType, ingExists bool, ing *v1.Ingress, ingLogger klog.Logger) (bool, error) {
 	lbc.gcLock.Lock()
 	defer lbc.gcLock.Unlock()
 	ingLogger = ingLogger.WithName("preSyncGC")
@@ -613,7 +655,7 @@ func (lbc *LoadBalancerController) preSyncGC(key string, scope meta.KeyType, ing
 	if !ingExists || utils.NeedsCleanup(ing) {
 		frontendGCAlgorithm := frontendGCAlgorithm(ingExists, false, ing, ingLogger)
 		// GC will find GCE resources that were used for this ingress and delete them.
-		err := lbc.ingSyncer.GC(allIngresses, ing, frontendGCAlgorithm, scope, ingLogger)
+		err := lbc.ingSyncer.GC(ctx, allIngresses, ing, frontendGCAlgorithm, scope, ingLogger)
 		// Skip emitting an event if ingress does not exist as we cannot retrieve ingress namespace.
 		if err != nil && ingExists {
 			ingLogger.Error(err, "Error in ingress GC")
@@ -628,7 +670,7 @@ func (lbc *LoadBalancerController) preSyncGC(key string, scope meta.KeyType, ing
 	return true, nil
 }
 
-func (lbc *LoadBalancerController) gcRegionalIngressResources(ing *v1.Ingress, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) gcRegionalIngressResources(ctx context2.Context, ing *v1.Ingress, ingLogger klog.Logger) error {
 	lbc.gcLock.Lock()
 	defer lbc.gcLock.Unlock()
 	ingLogger = ingLogger.WithName("gcRegionalIngressResources")
@@ -643,7 +685,7 @@ func (lbc *LoadBalancerController) gcRegionalIngressResources(ing *v1.Ingress, i
 	}).AsList()
 	// Use strategy CleanupV2FrontendResourcesScopeChange which will only delete
 	// GCP resources, but will leave Ingress (by not removing the finalizer).
-	if gcErr := lbc.ingSyncer.GC(filteredIngresses, ing, utils.CleanupV2FrontendResourcesScopeChange, meta.Regional, ingLogger); gcErr != nil {
+	if gcErr := lbc.ingSyncer.GC(ctx, filteredIngresses, ing, utils.CleanupV2FrontendResourcesScopeChange, meta.Regional, ingLogger); gcErr != nil {
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.GarbageCollection, "Error during garbage collection: %v", gcErr)
 		return fmt.Errorf("error during GC %v", gcErr)
 	}
@@ -651,7 +693,7 @@ func (lbc *LoadBalancerController) gcRegionalIngressResources(ing *v1.Ingress, i
 }
 
 // postSyncGC cleans up the unnecessary resources (backend-services, frontend resources in wrong scope) after sync.
-func (lbc *LoadBalancerController) postSyncGC(key string, syncErr error, oldScope *meta.KeyType, newScope meta.KeyType, ingExists bool, ing *v1.Ingress, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) postSyncGC(ctx context2.Context, key string, syncErr error, oldScope *meta.KeyType, newScope meta.KeyType, ingExists bool, ing *v1.Ingress, ingLogger klog.Logger) error {
 	lbc.gcLock.Lock()
 	defer lbc.gcLock.Unlock()
 	ingLogger = ingLogger.WithName("postSyncGC")
@@ -664,7 +706,7 @@ func (lbc *LoadBalancerController) postSyncGC(key string, syncErr error, oldScop
 	// free up enough quota for the next sync to pass.
 	allIngresses := lbc.ctx.Ingresses().List()
 	frontendGCAlgorithm := frontendGCAlgorithm(ingExists, oldScope != nil, ing, ingLogger)
-	if gcErr := lbc.ingSyncer.GC(allIngresses, ing, frontendGCAlgorithm, newScope, ingLogger); gcErr != nil {
+	if gcErr := lbc.ingSyncer.GC(ctx, allIngresses, ing, frontendGCAlgorithm, newScope, ingLogger); gcErr != nil {
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.GarbageCollection, "Error during garbage collection: %v", gcErr)
 		return fmt.Errorf("error during sync %v, error during GC %v", syncErr, gcErr)
 	}
@@ -673,8 +715,24 @@ func (lbc *LoadBalancerController) postSyncGC(key string, syncErr error, oldScop
 
 // sync manages Ingress create/updates/deletes events from queue.
 func (lbc *LoadBalancerController) sync(key string) error {
+	priorityCtx := ratelimit.WithPriority(context2.Background(), ratelimit.SyncPriority(lbc.syncTimeline.Pending(key)))
+	ctx, logger, span := tracing.StartSync(priorityCtx, lbc.logger, "LoadBalancerController.sync", attribute.String("key", key))
+	trace := lbc.syncTimeline.StartSync(key)
+	err := lbc.syncInternal(ctx, key, trace, logger)
+	trace.Done(err)
+	tracing.End(span, err)
+	// Only Ingresses synced to GCE are tracked, so forget the Ingress once it
+	// is deleted, handed to another controller or no longer needs a sync.
+	if ing, exists, _ := lbc.ctx.Ingresses().GetByKey(key); !exists || !utils.IsGCEIngress(ing) || utils.NeedsCleanup(ing) {
+		lbc.syncTimeline.Forget(key)
+	}
+	lbc.recordSyncStatus(key, err)
+	return err
+}
+
+func (lbc *LoadBalancerController) syncInternal(ctx context2.Context, key string, trace *synctimeline.Trace, logger klog.Logger) error {
 	syncTrackingId := rand.Int31()
-	ingLogger := lbc.logger.WithValues("ingressKey", key, "syncId", syncTrackingId)
+	ingLogger := logger.WithValues("ingressKey", key, "syncId", syncTrackingId)
 	if !lbc.hasSynced() {
 		time.Sleep(context.StoreSyncPollPeriod)
 		return fmt.Errorf("waiting for stores to sync")
@@ -688,7 +746,7 @@ func (lbc *LoadBalancerController) sync(key string) error {
 
 	// Capture GC state for ingress.
 	scope := features.ScopeFromIngress(ing)
-	needSync, err := lbc.preSyncGC(key, scope, ingExists, ing, ingLogger)
+	needSync, err := lbc.preSyncGC(ctx, key, scope, ingExists, ing, ingLogger)
 	if err != nil {
 		return err
 	}
@@ -699,19 +757,19 @@ func (lbc *LoadBalancerController) sync(key string) error {
 
 	// Ensure that a finalizer is attached.
 	if flags.F.FinalizerAdd {
-		if ing, err = lbc.ensureFinalizer(ing, ingLogger); err != nil {
+		if ing, err = lbc.ensureFinalizer(ctx, ing, ingLogger); err != nil {
 			return err
 		}
 	}
 
 	if lbc.ctx.EnableIngressRegionalExternal {
-		classNameChanged, err := lbc.l7Pool.DidRegionalClassChange(ing, ingLogger)
+		classNameChanged, err := lbc.l7Pool.DidRegionalClassChange(ctx, ing, ingLogger)
 		if err != nil {
 			return fmt.Errorf("failed checking regional class name change for ing %v, err: %w", ing, err)
 		}
 		if classNameChanged {
 			ingLogger.Info("Detected Ingress class change, cleaning up old resources")
-			err := lbc.gcRegionalIngressResources(ing, ingLogger)
+			err := lbc.gcRegionalIngressResources(ctx, ing, ingLogger)
 			if err != nil {
 				return fmt.Errorf("failed while handling ingress class name change. Ingress: %v, err: %w", ing, err)
 			}
@@ -719,8 +777,11 @@ func (lbc *LoadBalancerController) sync(key string) error {
 	}
 
 	// Bootstrap state for GCP sync.
+	endTranslate := trace.Track(synctimeline.PhaseTranslate)
 	urlMap, errs, warnings := lbc.Translator.TranslateIngress(ing, lbc.ctx.DefaultBackendSvcPort.ID, lbc.ctx.ClusterNamer)
+	endTranslate()
 
+	lbc.debugTracker.SetState(key, ingressDebugState{UrlMap: urlMap})
 	if errs != nil {
 		msg := fmt.Errorf("invalid ingress spec: %v", utils.JoinErrs(errs))
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.TranslateIngress, "Translation failed: %v", msg)
@@ -729,12 +790,12 @@ func (lbc *LoadBalancerController) sync(key string) error {
 
 	if warnings {
 		msg := "THC annotation is present for at least one Service, but the Transparent Health Checks feature is not enabled."
-		lbc.ctx.Recorder(ing.Namespace).Event(ing, apiv1.EventTypeWarning, "THCAnnotationWithoutFlag", msg)
+		lbc.ctx.Recorder(ing.Namespace).Event(ing, apiv1.EventTypeWarning, events.ReasonTHCAnnotationWithoutFlag, msg)
 	}
 
 	// Sync GCP resources.
-	syncState := &syncState{urlMap, ing, nil}
-	syncErr := lbc.ingSyncer.Sync(syncState, ingLogger)
+	syncState := &syncState{urlMap, ing, nil, trace}
+	syncErr := lbc.ingSyncer.Sync(ctx, syncState, ingLogger)
 	if syncErr != nil {
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SyncIngress, "Error syncing to GCP: %v", syncErr.Error())
 	} else {
@@ -749,7 +810,7 @@ func (lbc *LoadBalancerController) sync(key string) error {
 
 	// Check for scope change GC
 	var oldScope *meta.KeyType
-	oldScope, err = lbc.l7Pool.FrontendScopeChangeGC(ing, ingLogger)
+	oldScope, err = lbc.l7Pool.FrontendScopeChangeGC(ctx, ing, ingLogger)
 	if err != nil {
 		return err
 	}
@@ -757,12 +818,12 @@ func (lbc *LoadBalancerController) sync(key string) error {
 		scope = *oldScope
 	}
 
-	return lbc.postSyncGC(key, syncErr, oldScope, scope, ingExists, ing, ingLogger)
+	return lbc.postSyncGC(ctx, key, syncErr, oldScope, scope, ingExists, ing, ingLogger)
 }
 
 // updateIngressStatus updates the IP and annotations of a loadbalancer.
 // The annotations are parsed by kubectl describe.
-func (lbc *LoadBalancerController) updateIngressStatus(l7 *loadbalancers.L7, ing *v1.Ingress, ingLogger klog.Logger) error {
+func (lbc *LoadBalancerController) updateIngressStatus(ctx context2.Context, l7 *loadbalancers.L7, ing *v1.Ingress, ingLogger klog.Logger) error {
 	ingClient := lbc.ctx.KubeClient.NetworkingV1().Ingresses(ing.Namespace)
 
 	// Update IP through update/status endpoint
@@ -786,7 +847,7 @@ func (lbc *LoadBalancerController) updateIngressStatus(l7 *loadbalancers.L7, ing
 		}
 	}
 
-	newAnnotations, err := loadbalancers.GetLBAnnotations(l7, ing.ObjectMeta.DeepCopy().Annotations, lbc.backendSyncer, ingLogger)
+	newAnnotations, err := loadbalancers.GetLBAnnotations(ctx, l7, ing.ObjectMeta.DeepCopy().Annotations, lbc.backendSyncer, ingLogger)
 	if err != nil {
 		return err
 	}
@@ -874,9 +935,28 @@ func (lbc *LoadBalancerController) ToSvcPorts(ings []*v1.Ingress) []utils.Servic
 	return knownPorts
 }
 
+// IGNamedPorts returns a function listing the node ports of the instance group
+// backends of all GCE Ingresses, i.e. the named ports the cluster instance
+// groups need. Like ToSvcPorts, it is used for GC. It fails if any Ingress
+// fails to translate, since the ports of that Ingress would be missing and
+// pruned.
+func IGNamedPorts(ctx *context.ControllerContext) func() ([]int64, error) {
+	return func() ([]int64, error) {
+		var svcPorts []utils.ServicePort
+		for _, ing := range operator.Ingresses(ctx.Ingresses().List()).Filter(utils.IsGCEIngress).AsList() {
+			urlMap, errs, _ := ctx.Translator.TranslateIngress(ing, ctx.DefaultBackendSvcPort.ID, ctx.ClusterNamer)
+			if errs != nil {
+				return nil, fmt.Errorf("failed to translate ingress %s: %v", klog.KObj(ing), utils.JoinErrs(errs))
+			}
+			svcPorts = append(svcPorts, urlMap.AllServicePorts()...)
+		}
+		return nodePorts(svcPorts), nil
+	}
+}
+
 // defaultFrontendNamingScheme returns frontend naming scheme for an ingress without finalizer.
 // This is used for adding an appropriate finalizer on the ingress.
-func (lbc *LoadBalancerController) defaultFrontendNamingScheme(ing *v1.Ingress) (namer.Scheme, error) {
+func (lbc *LoadBalancerController) defaultFrontendNamingScheme(ctx context2.Context, ing *v1.Ingress) (namer.Scheme, error) {
 	// Ingress frontend naming scheme is determined based on the following logic,
 	// V2 frontend namer is disabled         : v1 frontend naming scheme
 	// V2 frontend namer is enabled
@@ -890,7 +970,7 @@ func (lbc *LoadBalancerController) defaultFrontendNamingScheme(ing *v1.Ingress)
 	if !utils.HasVIP(ing) {
 		return namer.V2NamingScheme, nil
 	}
-	urlMapExists, err := lbc.l7Pool.HasUrlMap(ing)
+	urlMapExists, err := lbc.l7Pool.HasUrlMap(ctx, ing)
 	if err != nil {
 		return "", err
 	}
@@ -901,13 +981,13 @@ func (lbc *LoadBalancerController) defaultFrontendNamingScheme(ing *v1.Ingress)
 }
 
 // ensureFinalizer ensures that a finalizer is attached.
-func (lbc *LoadBalancerController) ensureFinalizer(ing *v1.Ingress, ingLogger klog.Logger) (*v1.Ingress, error) {
+func (lbc *LoadBalancerController) ensureFinalizer(ctx context2.Context, ing *v1.Ingress, ingLogger klog.Logger) (*v1.Ingress, error) {
 	ingLogger = ingLogger.WithName("ensureFinalizer")
 	if common.HasFinalizer(ing.ObjectMeta) {
 		ingLogger.Info("Finalizer exists")
 		return ing, nil
 	}
-	namingScheme, err := lbc.defaultFrontendNamingScheme(ing)
+	namingScheme, err := lbc.defaultFrontendNamingScheme(ctx, ing)
 	if err != nil {
 		return nil, err
 	}
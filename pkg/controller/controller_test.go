@@ -718,6 +718,55 @@ func TestMCIngressIG(t *testing.T) {
 	}
 }
 
+// TestIGNamedPorts asserts that the named ports of the instance groups are
+// the node ports of the GCE Ingresses, and that they are not listed when an
+// Ingress fails to translate.
+func TestIGNamedPorts(t *testing.T) {
+	lbc, err := newLoadBalancerController()
+	if err != nil {
+		t.Fatalf("failed to initialize load balancer controller")
+	}
+
+	svc := test.NewService(types.NamespacedName{Name: "my-service", Namespace: "default"}, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Port: 80, NodePort: 30001}},
+	})
+	addService(lbc, svc)
+
+	defaultBackend := backend("my-service", networkingv1.ServiceBackendPort{Number: 80})
+	ing := test.NewIngress(types.NamespacedName{Name: "my-ingress", Namespace: "default"},
+		networkingv1.IngressSpec{
+			DefaultBackend: &defaultBackend,
+		})
+	addIngress(lbc, ing)
+	// Ingresses of other classes are ignored, even when they are invalid.
+	missingBackend := backend("missing-service", networkingv1.ServiceBackendPort{Number: 80})
+	otherIng := test.NewIngress(types.NamespacedName{Name: "other-ingress", Namespace: "default"},
+		networkingv1.IngressSpec{
+			DefaultBackend: &missingBackend,
+		})
+	otherIng.ObjectMeta.Annotations = map[string]string{"kubernetes.io/ingress.class": "nginx"}
+	addIngress(lbc, otherIng)
+
+	neededPorts := IGNamedPorts(lbc.ctx)
+	ports, err := neededPorts()
+	if err != nil {
+		t.Fatalf("IGNamedPorts() returned error %v", err)
+	}
+	if diff := cmp.Diff([]int64{30001}, ports); diff != "" {
+		t.Errorf("IGNamedPorts() mismatch (-want +got):\n%s", diff)
+	}
+
+	invalidIng := test.NewIngress(types.NamespacedName{Name: "invalid-ingress", Namespace: "default"},
+		networkingv1.IngressSpec{
+			DefaultBackend: &missingBackend,
+		})
+	addIngress(lbc, invalidIng)
+	if ports, err := neededPorts(); err == nil {
+		t.Errorf("IGNamedPorts() = %v, want error for Ingress with a missing Service", ports)
+	}
+}
+
 // TestToRuntimeInfoCerts asserts that both pre-shared and secret-based certs
 // are included in the RuntimeInfo.
 func TestToRuntimeInfoCerts(t *testing.T) {
@@ -1094,7 +1143,7 @@ func TestGCRegionalIngressResources(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			fr, err := composite.GetForwardingRule(lbc.ctx.Cloud, fwRulekey, meta.VersionGA, lbc.logger)
+			fr, err := composite.GetForwardingRule(context2.Background(), lbc.ctx.Cloud, fwRulekey, meta.VersionGA, lbc.logger)
 			if err != nil || fr == nil {
 				t.Fatalf("Expected to get forwarding rule, got fr = %v, err = %v", fr, err)
 			}
@@ -1107,12 +1156,12 @@ func TestGCRegionalIngressResources(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			bs, err := composite.GetBackendService(lbc.ctx.Cloud, bsKey, meta.VersionGA, lbc.logger)
+			bs, err := composite.GetBackendService(context2.Background(), lbc.ctx.Cloud, bsKey, meta.VersionGA, lbc.logger)
 			if err != nil || bs == nil {
 				t.Fatalf("Expected to get backend service, got bs = %v, err = %v", bs, err)
 			}
 
-			err = lbc.gcRegionalIngressResources(ingressToDelete, lbc.logger)
+			err = lbc.gcRegionalIngressResources(context2.Background(), ingressToDelete, lbc.logger)
 			if err != nil {
 				t.Fatalf("lbc.gcRegionalIngressResources(%v, ...) returned error %v", ingressToDelete, err)
 			}
@@ -1126,13 +1175,13 @@ func TestGCRegionalIngressResources(t *testing.T) {
 			}
 
 			// verify forwarding rule do not exist
-			_, err = composite.GetForwardingRule(lbc.ctx.Cloud, fwRulekey, meta.VersionGA, lbc.logger)
+			_, err = composite.GetForwardingRule(context2.Background(), lbc.ctx.Cloud, fwRulekey, meta.VersionGA, lbc.logger)
 			if err == nil || !utils.IsNotFoundError(err) {
 				t.Errorf("Expected to get 404 error querying for forwarding rule %v, got %v", fwRulekey, err)
 			}
 
 			// verify RXLB backend service does not exist.
-			_, err = composite.GetBackendService(lbc.ctx.Cloud, bsKey, meta.VersionGA, lbc.logger)
+			_, err = composite.GetBackendService(context2.Background(), lbc.ctx.Cloud, bsKey, meta.VersionGA, lbc.logger)
 			if err == nil || !utils.IsNotFoundError(err) {
 				t.Errorf("Expected to get 404 error querying for backend service %v, got %v", bsKey, err)
 			}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/loadbalancers"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+// ingressDebugState is the state of an ingress recorded during its last sync.
+type ingressDebugState struct {
+	UrlMap *utils.GCEURLMap `json:"urlMap"`
+	// RuntimeInfo is nil if the sync failed before the load balancer was ensured.
+	RuntimeInfo *runtimeInfoDebugState `json:"runtimeInfo,omitempty"`
+}
+
+// runtimeInfoDebugState is the serializable subset of L7RuntimeInfo.
+// Certificates are reduced to their name and hash so that private keys are
+// never served. The ingress itself is omitted.
+type runtimeInfoDebugState struct {
+	IP             string                                `json:"ip,omitempty"`
+	TLS            []tlsCertDebugState                   `json:"tls,omitempty"`
+	TLSName        string                                `json:"tlsName,omitempty"`
+	AllowHTTP      bool                                  `json:"allowHTTP"`
+	StaticIPName   string                                `json:"staticIPName,omitempty"`
+	StaticIPSubnet string                                `json:"staticIPSubnet,omitempty"`
+	FrontendConfig *frontendconfigv1beta1.FrontendConfig `json:"frontendConfig,omitempty"`
+}
+
+type tlsCertDebugState struct {
+	Name     string `json:"name"`
+	CertHash string `json:"certHash"`
+}
+
+func newRuntimeInfoDebugState(lb *loadbalancers.L7RuntimeInfo) *runtimeInfoDebugState {
+	state := &runtimeInfoDebugState{
+		IP:             lb.IP,
+		TLSName:        lb.TLSName,
+		AllowHTTP:      lb.AllowHTTP,
+		StaticIPName:   lb.StaticIPName,
+		StaticIPSubnet: lb.StaticIPSubnet,
+		FrontendConfig: lb.FrontendConfig,
+	}
+	for _, cert := range lb.TLS {
+		state.TLS = append(state.TLS, tlsCertDebugState{Name: cert.Name, CertHash: cert.CertHash})
+	}
+	return state
+}
+
+// ingressDebugInfo is the document served on /debug/ingress.
+type ingressDebugInfo struct {
+	debug.SyncStatus
+	Requeues int `json:"requeues"`
+}
+
+// recordSyncStatus records the outcome of a sync for the debug endpoints.
+// Deleted ingresses are forgotten.
+func (lbc *LoadBalancerController) recordSyncStatus(key string, err error) {
+	if _, exists, _ := lbc.ctx.Ingresses().GetByKey(key); !exists {
+		lbc.debugTracker.Forget(key)
+		return
+	}
+	lbc.debugTracker.Record(key, err)
+}
+
+// DebugInfo returns the translated url map, the runtime info and the outcome
+// of the last sync of the ingress. It implements debug.Provider.
+func (lbc *LoadBalancerController) DebugInfo(namespace, name string) (interface{}, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}.String()
+	status, ok := lbc.debugTracker.Get(key)
+	if !ok {
+		return nil, debug.ErrNotFound
+	}
+	return ingressDebugInfo{SyncStatus: status, Requeues: lbc.ingQueue.NumRequeues(cache.ExplicitKey(key))}, nil
+}
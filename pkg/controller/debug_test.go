@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	api_v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/loadbalancers"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/translator"
+)
+
+func TestDebugInfo(t *testing.T) {
+	// The ingress queue, which tracks requeues, is only created with workers.
+	defer func(n int) { flags.F.NumIngressWorkers = n }(flags.F.NumIngressWorkers)
+	flags.F.NumIngressWorkers = 1
+	lbc, err := newLoadBalancerController()
+	if err != nil {
+		t.Fatalf("failed to initialize load balancer controller")
+	}
+	if _, err := lbc.DebugInfo("default", "my-ingress"); !errors.Is(err, debug.ErrNotFound) {
+		t.Fatalf("DebugInfo() for an unsynced ingress returned %v, want %v", err, debug.ErrNotFound)
+	}
+
+	// The backend service does not exist yet, so the first sync fails.
+	defaultBackend := backend("my-service", networkingv1.ServiceBackendPort{Number: 80})
+	ing := test.NewIngress(types.NamespacedName{Name: "my-ingress", Namespace: "default"},
+		networkingv1.IngressSpec{
+			DefaultBackend: &defaultBackend,
+		})
+	addIngress(lbc, ing)
+	ingStoreKey := getKey(ing, t)
+	if err := lbc.sync(ingStoreKey); err == nil {
+		t.Fatalf("lbc.sync(%v) = nil, want error", ingStoreKey)
+	}
+
+	info := debugInfo(t, lbc, ing)
+	if !strings.Contains(info.LastError, "my-service") {
+		t.Errorf("DebugInfo().LastError = %q, want it to mention the missing service", info.LastError)
+	}
+	state, ok := info.State.(ingressDebugState)
+	if !ok {
+		t.Fatalf("DebugInfo().State = %T, want ingressDebugState", info.State)
+	}
+	if state.UrlMap == nil || state.RuntimeInfo != nil {
+		t.Errorf("DebugInfo().State = %+v, want only the url map for an ingress which failed translation", state)
+	}
+
+	svc := test.NewService(types.NamespacedName{Name: "my-service", Namespace: "default"}, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Port: 80}},
+	})
+	addService(lbc, svc)
+	if err := lbc.sync(ingStoreKey); err != nil {
+		t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+	}
+
+	info = debugInfo(t, lbc, ing)
+	if info.LastError != "" {
+		t.Errorf("DebugInfo().LastError = %q, want none", info.LastError)
+	}
+	state = info.State.(ingressDebugState)
+	if state.RuntimeInfo == nil {
+		t.Fatalf("DebugInfo().State.RuntimeInfo = nil, want runtime info of the synced ingress")
+	}
+	if got := state.UrlMap.DefaultBackend.ID.Service.Name; got != "my-service" {
+		t.Errorf("DebugInfo().State.UrlMap.DefaultBackend = %q, want my-service", got)
+	}
+	if _, err := json.Marshal(info); err != nil {
+		t.Errorf("json.Marshal(DebugInfo()) = %v, want nil", err)
+	}
+
+	// Deleted ingresses are forgotten.
+	deleteIngress(lbc, ing)
+	if err := lbc.sync(ingStoreKey); err != nil {
+		t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+	}
+	if _, err := lbc.DebugInfo(ing.Namespace, ing.Name); !errors.Is(err, debug.ErrNotFound) {
+		t.Errorf("DebugInfo() for a deleted ingress returned %v, want %v", err, debug.ErrNotFound)
+	}
+}
+
+func TestRuntimeInfoDebugStateOmitsKeys(t *testing.T) {
+	lb := &loadbalancers.L7RuntimeInfo{
+		TLS: []*translator.TLSCerts{{
+			Key:      "private-key",
+			Cert:     "cert",
+			Name:     "my-cert",
+			CertHash: "abcd",
+		}},
+		AllowHTTP: true,
+	}
+	out, err := json.Marshal(newRuntimeInfoDebugState(lb))
+	if err != nil {
+		t.Fatalf("json.Marshal() = %v, want nil", err)
+	}
+	if strings.Contains(string(out), "private-key") {
+		t.Errorf("serialized runtime info %s contains the private key", out)
+	}
+	if !strings.Contains(string(out), "my-cert") || !strings.Contains(string(out), "abcd") {
+		t.Errorf("serialized runtime info %s, want certificate name and hash", out)
+	}
+}
+
+func debugInfo(t *testing.T, lbc *LoadBalancerController, ing *networkingv1.Ingress) ingressDebugInfo {
+	t.Helper()
+	got, err := lbc.DebugInfo(ing.Namespace, ing.Name)
+	if err != nil {
+		t.Fatalf("DebugInfo(%s, %s) = %v, want nil", ing.Namespace, ing.Name, err)
+	}
+	return got.(ingressDebugInfo)
+}
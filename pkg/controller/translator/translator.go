@@ -463,6 +463,7 @@ func validateAndModifyPrefixPathType(path v1.HTTPIngressPath) ([]string, error)
 
 // geHTTPProbe returns the http readiness probe from the first container
 // that matches targetPort, from the set of pods matching the given labels.
+// For the GRPC protocol the gRPC readiness probe is returned instead.
 func (t *Translator) getHTTPProbe(svc api_v1.Service, targetPort intstr.IntOrString, protocol annotations.AppProtocol) (*api_v1.Probe, error) {
 	l := svc.Spec.Selector
 
@@ -482,6 +483,19 @@ func (t *Translator) getHTTPProbe(svc api_v1.Service, targetPort intstr.IntOrStr
 		}
 		logStr := fmt.Sprintf("Pod %v matching service selectors %v (targetport %+v)", pod.Name, l, targetPort)
 		for _, c := range pod.Spec.Containers {
+			if protocol == annotations.ProtocolGRPC {
+				if c.ReadinessProbe == nil || c.ReadinessProbe.ProbeHandler.GRPC == nil {
+					continue
+				}
+				for _, p := range c.Ports {
+					if ((targetPort.Type == intstr.Int && targetPort.IntVal == p.ContainerPort) ||
+						(targetPort.Type == intstr.String && targetPort.StrVal == p.Name)) &&
+						c.ReadinessProbe.ProbeHandler.GRPC.Port == p.ContainerPort {
+						return c.ReadinessProbe, nil
+					}
+				}
+				continue
+			}
 			if !isSimpleHTTPProbe(c.ReadinessProbe) || getProbeScheme(protocol) != c.ReadinessProbe.HTTPGet.Scheme {
 				continue
 			}
@@ -507,7 +521,7 @@ func (t *Translator) getHTTPProbe(svc api_v1.Service, targetPort intstr.IntOrStr
 				}
 			}
 		}
-		t.logger.V(5).Info(fmt.Sprintf("%v: lacks a matching HTTP probe for use in health checks.", logStr))
+		t.logger.V(5).Info(fmt.Sprintf("%v: lacks a matching HTTP or gRPC probe for use in health checks.", logStr))
 	}
 	return nil, nil
 }
@@ -551,7 +565,7 @@ func isSimpleHTTPProbe(probe *api_v1.Probe) bool {
 // getProbeScheme returns the Kubernetes API URL scheme corresponding to the
 // protocol.
 func getProbeScheme(protocol annotations.AppProtocol) api_v1.URIScheme {
-	if protocol == annotations.ProtocolHTTP2 {
+	if protocol == annotations.ProtocolHTTP2 || protocol == annotations.ProtocolGRPC {
 		return api_v1.URISchemeHTTPS
 	}
 	return api_v1.URIScheme(string(protocol))
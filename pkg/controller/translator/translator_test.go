@@ -627,6 +627,34 @@ func TestGetProbeNamedPort(t *testing.T) {
 	}
 }
 
+func TestGetProbeGRPC(t *testing.T) {
+	translator := fakeTranslator()
+	grpcPort := utils.ServicePort{NodePort: 3001, Protocol: annotations.ProtocolGRPC}
+	http2Port := utils.ServicePort{NodePort: 3002, Protocol: annotations.ProtocolHTTP2}
+	nodePortToHealthCheck := map[utils.ServicePort]string{grpcPort: "", http2Port: ""}
+	for _, svc := range makeServices(nodePortToHealthCheck, apiv1.NamespaceDefault) {
+		translator.ServiceInformer.GetIndexer().Add(svc)
+	}
+	serviceName := "foo.Bar"
+	for _, pod := range makePods(nodePortToHealthCheck, apiv1.NamespaceDefault) {
+		pod.Spec.Containers[0].ReadinessProbe.ProbeHandler = apiv1.ProbeHandler{GRPC: &apiv1.GRPCAction{Port: 80, Service: &serviceName}}
+		translator.PodInformer.GetIndexer().Add(pod)
+	}
+
+	got, err := translator.GetProbe(grpcPort)
+	if err != nil || got == nil || got.ProbeHandler.GRPC == nil {
+		t.Fatalf("GetProbe(%v) = %v, %v, want gRPC probe", grpcPort, got, err)
+	}
+	if *got.ProbeHandler.GRPC.Service != serviceName {
+		t.Errorf("GetProbe(%v) service = %q, want %q", grpcPort, *got.ProbeHandler.GRPC.Service, serviceName)
+	}
+
+	// gRPC probes are only used for GRPC service ports.
+	if got, err := translator.GetProbe(http2Port); err != nil || got != nil {
+		t.Errorf("GetProbe(%v) = %v, %v, want nil, nil", http2Port, got, err)
+	}
+}
+
 func TestGetProbeCrossNamespace(t *testing.T) {
 	translator := fakeTranslator()
 
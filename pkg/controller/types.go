@@ -19,6 +19,7 @@ package controller
 import (
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/ingress-gce/pkg/loadbalancers"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 )
 
@@ -27,4 +28,6 @@ type syncState struct {
 	urlMap *utils.GCEURLMap
 	ing    *v1.Ingress
 	l7     *loadbalancers.L7
+	// trace measures the phases of the sync.
+	trace *synctimeline.Trace
 }
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug serves read-only, per-object controller state over HTTP.
+// The returned documents are meant for humans debugging a running glbc and
+// carry no compatibility guarantees.
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// PathPrefix is the path under which the debug endpoints are served.
+// Objects are addressed as <PathPrefix><kind>/<namespace>/<name>.
+const PathPrefix = "/debug/"
+
+// ErrNotFound is returned by a Provider when it has no state for the
+// requested object.
+var ErrNotFound = errors.New("object not found")
+
+// Provider returns the debug state of the object with the given namespace
+// and name. The result is serialized to JSON.
+type Provider func(namespace, name string) (interface{}, error)
+
+// Registry dispatches debug requests to the Providers registered for the
+// requested kind.
+type Registry struct {
+	lock      sync.RWMutex
+	providers map[string][]Provider
+	logger    klog.Logger
+}
+
+// NewRegistry creates a new Registry without any providers.
+func NewRegistry(logger klog.Logger) *Registry {
+	return &Registry{
+		providers: make(map[string][]Provider),
+		logger:    logger.WithName("Debug"),
+	}
+}
+
+// AddProvider registers a provider serving objects of the given kind, e.g.
+// "ingress" for /debug/ingress/<namespace>/<name>. Providers registered for
+// the same kind are consulted in registration order until one of them finds
+// the object.
+func (r *Registry) AddProvider(kind string, p Provider) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.logger.Info("Adding debug provider", "kind", kind)
+	r.providers[kind] = append(r.providers[kind], p)
+}
+
+// get returns the state of the given object from the first provider of the
+// kind which knows about it.
+func (r *Registry) get(kind, namespace, name string) (interface{}, error) {
+	r.lock.RLock()
+	providers, ok := r.providers[kind]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrNotFound, kind)
+	}
+
+	for _, p := range providers {
+		state, err := p(namespace, name)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		return state, err
+	}
+	return nil, fmt.Errorf("%w: %s %s/%s", ErrNotFound, kind, namespace, name)
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, PathPrefix), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		http.Error(w, "expected "+PathPrefix+"<kind>/<namespace>/<name>", http.StatusBadRequest)
+		return
+	}
+	kind, namespace, name := parts[0], parts[1], parts[2]
+
+	state, err := r.get(kind, namespace, name)
+	if errors.Is(err, ErrNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		r.logger.Error(err, "Failed to marshal debug state", "kind", kind, "namespace", namespace, "name", name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		r.logger.Error(err, "Error writing bytes")
+	}
+}
+
+// SyncStatus is the outcome of the most recent sync of an object.
+type SyncStatus struct {
+	LastSyncTime time.Time `json:"lastSyncTime"`
+	// LastError is empty if the last sync succeeded.
+	LastError string `json:"lastError,omitempty"`
+	// State is the controller specific state recorded during the sync.
+	State interface{} `json:"state,omitempty"`
+}
+
+// SyncTracker records the SyncStatus of objects synced by a controller,
+// keyed by the controller's queue key.
+type SyncTracker struct {
+	lock    sync.Mutex
+	entries map[string]SyncStatus
+}
+
+// NewSyncTracker creates an empty SyncTracker.
+func NewSyncTracker() *SyncTracker {
+	return &SyncTracker{entries: make(map[string]SyncStatus)}
+}
+
+// Record stores the result of a sync of the object with the given key.
+// Previously recorded state is kept.
+func (t *SyncTracker) Record(key string, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	status := t.entries[key]
+	status.LastSyncTime = time.Now()
+	status.LastError = ""
+	if err != nil {
+		status.LastError = err.Error()
+	}
+	t.entries[key] = status
+}
+
+// SetState stores the controller specific state of the object with the
+// given key. The state must not be mutated afterwards.
+func (t *SyncTracker) SetState(key string, state interface{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	status := t.entries[key]
+	status.State = state
+	t.entries[key] = status
+}
+
+// Get returns the SyncStatus of the object with the given key.
+func (t *SyncTracker) Get(key string) (SyncStatus, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	status, ok := t.entries[key]
+	return status, ok
+}
+
+// Forget drops everything recorded for the object with the given key.
+func (t *SyncTracker) Forget(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.entries, key)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/klog/v2"
+)
+
+func TestRegistryServeHTTP(t *testing.T) {
+	r := NewRegistry(klog.TODO())
+	r.AddProvider("ingress", func(namespace, name string) (interface{}, error) {
+		switch name {
+		case "found":
+			return map[string]string{"name": namespace + "/" + name}, nil
+		case "broken":
+			return nil, errors.New("boom")
+		}
+		return nil, ErrNotFound
+	})
+	// Providers of the same kind are consulted in registration order.
+	r.AddProvider("l4", func(namespace, name string) (interface{}, error) {
+		if name == "ilb" {
+			return "ilb", nil
+		}
+		return nil, ErrNotFound
+	})
+	r.AddProvider("l4", func(namespace, name string) (interface{}, error) {
+		if name == "netlb" {
+			return "netlb", nil
+		}
+		return nil, ErrNotFound
+	})
+
+	for _, tc := range []struct {
+		desc     string
+		method   string
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{
+			desc:     "found",
+			path:     "/debug/ingress/default/found",
+			wantCode: http.StatusOK,
+			wantBody: `"name": "default/found"`,
+		},
+		{
+			desc:     "not found",
+			path:     "/debug/ingress/default/missing",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			desc:     "provider error",
+			path:     "/debug/ingress/default/broken",
+			wantCode: http.StatusInternalServerError,
+			wantBody: "boom",
+		},
+		{
+			desc:     "unknown kind",
+			path:     "/debug/pod/default/found",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			desc:     "first provider",
+			path:     "/debug/l4/default/ilb",
+			wantCode: http.StatusOK,
+			wantBody: `"ilb"`,
+		},
+		{
+			desc:     "second provider",
+			path:     "/debug/l4/default/netlb",
+			wantCode: http.StatusOK,
+			wantBody: `"netlb"`,
+		},
+		{
+			desc:     "missing name",
+			path:     "/debug/ingress/default",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			desc:     "too many segments",
+			path:     "/debug/ingress/default/found/extra",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			desc:     "not a GET",
+			method:   http.MethodPut,
+			path:     "/debug/ingress/default/found",
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(method, tc.path, nil))
+			if rec.Code != tc.wantCode {
+				t.Errorf("%s %s returned code %d, want %d", method, tc.path, rec.Code, tc.wantCode)
+			}
+			if !strings.Contains(rec.Body.String(), tc.wantBody) {
+				t.Errorf("%s %s returned body %q, want it to contain %q", method, tc.path, rec.Body.String(), tc.wantBody)
+			}
+		})
+	}
+}
+
+func TestSyncTracker(t *testing.T) {
+	tracker := NewSyncTracker()
+	const key = "default/foo"
+
+	if _, ok := tracker.Get(key); ok {
+		t.Fatalf("Get(%q) found an entry in an empty tracker", key)
+	}
+
+	tracker.SetState(key, "state")
+	tracker.Record(key, fmt.Errorf("sync failed"))
+	status, ok := tracker.Get(key)
+	if !ok {
+		t.Fatalf("Get(%q) = _, false, want true", key)
+	}
+	if status.LastError != "sync failed" || status.State != "state" || status.LastSyncTime.IsZero() {
+		t.Errorf("Get(%q) = %+v, want error, state and sync time to be recorded", key, status)
+	}
+
+	// A successful sync clears the error but keeps the state.
+	tracker.Record(key, nil)
+	status, _ = tracker.Get(key)
+	if status.LastError != "" || status.State != "state" {
+		t.Errorf("Get(%q) = %+v, want no error and the previous state", key, status)
+	}
+
+	tracker.Forget(key)
+	if _, ok := tracker.Get(key); ok {
+		t.Errorf("Get(%q) found an entry after Forget", key)
+	}
+}
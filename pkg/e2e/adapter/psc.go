@@ -177,12 +177,14 @@ func toPSCV1(in *sav1beta1.ServiceAttachment) *sav1.ServiceAttachment {
 	}
 
 	out.Spec = sav1.ServiceAttachmentSpec{
-		ConnectionPreference: in.Spec.ConnectionPreference,
-		NATSubnets:           in.Spec.NATSubnets,
-		ResourceRef:          in.Spec.ResourceRef,
-		ProxyProtocol:        in.Spec.ProxyProtocol,
-		ConsumerAllowList:    convertConsumerProjectToV1(in.Spec.ConsumerAllowList),
-		ConsumerRejectList:   in.Spec.ConsumerRejectList,
+		ConnectionPreference:      in.Spec.ConnectionPreference,
+		NATSubnets:                in.Spec.NATSubnets,
+		ResourceRef:               in.Spec.ResourceRef,
+		ProxyProtocol:             in.Spec.ProxyProtocol,
+		ConsumerAllowList:         convertConsumerProjectToV1(in.Spec.ConsumerAllowList),
+		ConsumerRejectList:        in.Spec.ConsumerRejectList,
+		DomainNames:               in.Spec.DomainNames,
+		PropagatedConnectionLimit: in.Spec.PropagatedConnectionLimit,
 	}
 
 	out.Status = sav1.ServiceAttachmentStatus{
@@ -208,7 +210,10 @@ func convertConsumerProjectToV1(in []sav1beta1.ConsumerProject) []sav1.ConsumerP
 func convertForwardingRulesToV1(in []sav1beta1.ConsumerForwardingRule) []sav1.ConsumerForwardingRule {
 	var out []sav1.ConsumerForwardingRule
 	for _, rule := range in {
-		out = append(out, sav1.ConsumerForwardingRule(rule))
+		out = append(out, sav1.ConsumerForwardingRule{
+			ForwardingRuleURL: rule.ForwardingRuleURL,
+			Status:            rule.Status,
+		})
 	}
 	return out
 }
@@ -223,12 +228,14 @@ func toPSCV1beta1(in *sav1.ServiceAttachment) *sav1beta1.ServiceAttachment {
 	}
 
 	out.Spec = sav1beta1.ServiceAttachmentSpec{
-		ConnectionPreference: in.Spec.ConnectionPreference,
-		NATSubnets:           in.Spec.NATSubnets,
-		ResourceRef:          in.Spec.ResourceRef,
-		ProxyProtocol:        in.Spec.ProxyProtocol,
-		ConsumerAllowList:    convertConsumerProjectToV1beta1(in.Spec.ConsumerAllowList),
-		ConsumerRejectList:   in.Spec.ConsumerRejectList,
+		ConnectionPreference:      in.Spec.ConnectionPreference,
+		NATSubnets:                in.Spec.NATSubnets,
+		ResourceRef:               in.Spec.ResourceRef,
+		ProxyProtocol:             in.Spec.ProxyProtocol,
+		ConsumerAllowList:         convertConsumerProjectToV1beta1(in.Spec.ConsumerAllowList),
+		ConsumerRejectList:        in.Spec.ConsumerRejectList,
+		DomainNames:               in.Spec.DomainNames,
+		PropagatedConnectionLimit: in.Spec.PropagatedConnectionLimit,
 	}
 
 	out.Status = sav1beta1.ServiceAttachmentStatus{
@@ -254,7 +261,10 @@ func convertConsumerProjectToV1beta1(in []sav1.ConsumerProject) []sav1beta1.Cons
 func convertForwardingRulesToV1beta1(in []sav1.ConsumerForwardingRule) []sav1beta1.ConsumerForwardingRule {
 	var out []sav1beta1.ConsumerForwardingRule
 	for _, rule := range in {
-		out = append(out, sav1beta1.ConsumerForwardingRule(rule))
+		out = append(out, sav1beta1.ConsumerForwardingRule{
+			ForwardingRuleURL: rule.ForwardingRuleURL,
+			Status:            rule.Status,
+		})
 	}
 	return out
 }
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	sav1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1"
 	sav1beta1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1beta1"
+	"k8s.io/utils/ptr"
 )
 
 func TestPSCConversions(t *testing.T) {
@@ -37,7 +38,9 @@ func TestPSCConversions(t *testing.T) {
 							Project:         "my-project-2",
 						},
 					},
-					ConsumerRejectList: []string{"reject-project-1", "reject-project-2"},
+					ConsumerRejectList:        []string{"reject-project-1", "reject-project-2"},
+					DomainNames:               ptr.To([]string{"p.example.com."}),
+					PropagatedConnectionLimit: ptr.To[int64](10),
 				},
 				Status: sav1beta1.ServiceAttachmentStatus{
 					ServiceAttachmentURL: "gce-service-attachment-url",
@@ -75,7 +78,9 @@ func TestPSCConversions(t *testing.T) {
 							Project:         "my-project-2",
 						},
 					},
-					ConsumerRejectList: []string{"reject-project-1", "reject-project-2"},
+					ConsumerRejectList:        []string{"reject-project-1", "reject-project-2"},
+					DomainNames:               ptr.To([]string{"p.example.com."}),
+					PropagatedConnectionLimit: ptr.To[int64](10),
 				},
 				Status: sav1.ServiceAttachmentStatus{
 					ServiceAttachmentURL: "gce-service-attachment-url",
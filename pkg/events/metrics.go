@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	eventCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "events_total",
+			Help: "Number of Kubernetes events emitted by the controllers, by reason and type, including the suppressed ones",
+		},
+		[]string{"reason", "type"},
+	)
+	suppressedEventCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "events_suppressed_total",
+			Help: "Number of Kubernetes events not recorded because they were duplicates or over the rate limit of their object",
+		},
+		[]string{"reason", "type", "cause"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(eventCount, suppressedEventCount)
+}
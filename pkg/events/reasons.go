@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import v1 "k8s.io/api/core/v1"
+
+// Reasons of the events recorded by the L4 controllers.
+const (
+	ReasonAdd                               = "ADD"
+	ReasonSyncLoadBalancerSuccessful        = "SyncLoadBalancerSuccessful"
+	ReasonSyncLoadBalancerFailed            = "SyncLoadBalancerFailed"
+	ReasonSyncExternalLoadBalancerFailed    = "SyncExternalLoadBalancerFailed"
+	ReasonSyncInstanceGroupsFailed          = "SyncInstanceGroupsFailed"
+	ReasonDeletingLoadBalancer              = "DeletingLoadBalancer"
+	ReasonDeletedLoadBalancer               = "DeletedLoadBalancer"
+	ReasonDeleteLoadBalancer                = "DeleteLoadBalancer"
+	ReasonDeleteLoadBalancerFailed          = "DeleteLoadBalancerFailed"
+	ReasonDeleteInstanceGroupFailed         = "DeleteInstanceGroupFailed"
+	ReasonNoForwardingRuleRef               = "NoForwardingRuleRef"
+	ReasonForwardingRuleUnusable            = "ForwardingRuleUnusable"
+	ReasonUnexpectedlyRemovedFinalizer      = "UnexpectedlyRemovedFinalizer"
+	ReasonConflictingConfiguration          = "ConflictingConfiguration"
+	ReasonUnsupportedConfiguration          = "UnsupportedConfiguration"
+	ReasonTargetPoolRaceWithRBS             = "TargetPoolRaceWithRBS"
+	ReasonCleanRBSResourcesForLegacyService = "CleanRBSResourcesForLegacyService"
+	ReasonCanNotMigrateTargetPoolToRBS      = "CanNotMigrateTargetPoolToRBS"
+	ReasonILBOptionsIgnored                 = "ILBOptionsIgnored"
+	ReasonAllowlistingRequired              = "AllowlistingRequired"
+	ReasonMixedStaticIP                     = "MixedStaticIP"
+	ReasonXPN                               = "XPN"
+	ReasonStrongSessionAffinity             = "EnableStrongAffinity"
+
+	ReasonL4LBConfigAnnotationRemoved         = "L4LBConfigAnnotationRemoved"
+	ReasonL4LBConfigNotFound                  = "L4LBConfigNotFound"
+	ReasonL4LBConfigFetchFailed               = "L4LBConfigFetchFailed"
+	ReasonL4LBConfigInvalidMode               = "L4LBConfigInvalidMode"
+	ReasonL4LBConfigInvalidConnectionTracking = "L4LBConfigInvalidConnectionTracking"
+	ReasonL4LBConfigUnknownError              = "L4LBConfigUnknownError"
+
+	// The reasons below report a change of the named Service field.
+	ReasonType                     = "Type"
+	ReasonLoadBalancerSourceRanges = "LoadBalancerSourceRanges"
+	ReasonPorts                    = "Ports"
+	ReasonSessionAffinity          = "SessionAffinity"
+	ReasonPortsSessionAffinity     = "Ports/SessionAffinity"
+	ReasonSessionAffinityConfig    = "SessionAffinityConfig"
+	ReasonLoadbalancerIP           = "LoadbalancerIP"
+	ReasonExternalIP               = "ExternalIP"
+	ReasonAnnotations              = "Annotations"
+	ReasonUID                      = "UID"
+	ReasonExternalTrafficPolicy    = "ExternalTrafficPolicy"
+	ReasonHealthCheckNodePort      = "HealthCheckNodePort"
+	ReasonTrafficDistribution      = "TrafficDistribution"
+	ReasonIPFamilies               = "IPFamilies"
+)
+
+// Reasons of the events recorded by the NEG controller.
+const (
+	ReasonProcessServiceSkipped               = "ProcessServiceSkipped"
+	ReasonProcessServiceFailed                = "ProcessServiceFailed"
+	ReasonSyncNetworkEndpointGroupFailed      = "SyncNetworkEndpointGroupFailed"
+	ReasonIgnoreZonePreprovisioningAnnotation = "IgnoreZonePreprovisioningAnnotation"
+	ReasonEnterDegradedMode                   = "EnterDegradedMode"
+	ReasonExitDegradedMode                    = "ExitDegradedMode"
+	ReasonRetryFailed                         = "RetryFailed"
+	ReasonLabelsExceededLimit                 = "LabelsExceededLimit"
+	ReasonCreate                              = "Create"
+	ReasonDelete                              = "Delete"
+	ReasonAttach                              = "Attach"
+	ReasonAttachFailed                        = "AttachFailed"
+	ReasonDetach                              = "Detach"
+	ReasonDetachFailed                        = "DetachFailed"
+	ReasonNegCRError                          = "NegCRError"
+	ReasonLoadBalancerNegReady                = "LoadBalancerNegReady"
+	ReasonLoadBalancerNegTimeout              = "LoadBalancerNegTimeout"
+	ReasonLoadBalancerNegWithoutHealthCheck   = "LoadBalancerNegWithoutHealthCheck"
+	ReasonLoadBalancerNegNotReady             = "LoadBalancerNegNotReady"
+)
+
+// Reasons of the conditions set on the NEG CRs by the NEG controller. These
+// are not event reasons, so they are not in Reasons.
+const (
+	ReasonNegSyncSuccessful           = "NegSyncSuccessful"
+	ReasonNegSyncFailed               = "NegSyncFailed"
+	ReasonNegInitializationSuccessful = "NegInitializationSuccessful"
+	ReasonNegInitializationFailed     = "NegInitializationFailed"
+)
+
+// Reasons of the events recorded by the Ingress controller.
+const (
+	ReasonWillNotConfigureFrontend     = "WillNotConfigureFrontend"
+	ReasonTHCConfigured                = "THCConfigured"
+	ReasonTHCAnnotationWithoutFlag     = "THCAnnotationWithoutFlag"
+	ReasonTHCAnnotationWithoutNEG      = "THCAnnotationWithoutNEG"
+	ReasonBackendConfigOverridesTHC    = "BackendConfigOverridesTHC"
+	ReasonHealthcheckDescriptionUpdate = "HealthcheckDescriptionUpdate"
+	ReasonFirewallDriftDetected        = "FirewallDriftDetected"
+	ReasonFirewallDriftRepaired        = "FirewallDriftRepaired"
+)
+
+// Reasons of the events recorded by the PSC controller.
+const (
+	ReasonProcessServiceAttachmentFailed = "ProcessServiceAttachmentFailed"
+	ReasonServiceAttachmentCreated       = "ServiceAttachmentCreated"
+	ReasonServiceAttachmentReleased      = "ServiceAttachmentReleased"
+	ReasonServiceAttachmentGCError       = "ServiceAttachmentGCError"
+	ReasonConsumerApprovalIgnored        = "ConsumerApprovalIgnored"
+	ReasonConsumerConnectionPending      = "ConsumerConnectionPending"
+	ReasonUnsyncedField                  = "UnsyncedField"
+)
+
+// Severity is the types of the events recorded with a reason.
+type Severity int
+
+const (
+	// SeverityNormal reasons are recorded by Normal events.
+	SeverityNormal Severity = iota
+	// SeverityWarning reasons are recorded by Warning events.
+	SeverityWarning
+	// SeverityMixed reasons are recorded by Normal events on success and by
+	// Warning events on failure.
+	SeverityMixed
+)
+
+// ReasonSeverity is a reason of the events recorded by the controllers with
+// its severity.
+type ReasonSeverity struct {
+	Reason   string
+	Severity Severity
+}
+
+// Reasons lists the reasons of the events recorded by the controllers. Alerts
+// can rely on these reasons, unlike on the event messages. The events with
+// other reasons are counted as otherReason.
+var Reasons = []ReasonSeverity{
+	{Reason: AddNodes, Severity: SeverityMixed},
+	{Reason: RemoveNodes, Severity: SeverityMixed},
+	{Reason: SyncIngress, Severity: SeverityMixed},
+	{Reason: TranslateIngress, Severity: SeverityWarning},
+	{Reason: IPChanged, Severity: SeverityNormal},
+	{Reason: GarbageCollection, Severity: SeverityWarning},
+
+	{Reason: ReasonAdd, Severity: SeverityNormal},
+	{Reason: ReasonSyncLoadBalancerSuccessful, Severity: SeverityNormal},
+	{Reason: ReasonSyncLoadBalancerFailed, Severity: SeverityWarning},
+	{Reason: ReasonSyncExternalLoadBalancerFailed, Severity: SeverityWarning},
+	{Reason: ReasonSyncInstanceGroupsFailed, Severity: SeverityWarning},
+	{Reason: ReasonDeletingLoadBalancer, Severity: SeverityNormal},
+	{Reason: ReasonDeletedLoadBalancer, Severity: SeverityNormal},
+	{Reason: ReasonDeleteLoadBalancer, Severity: SeverityWarning},
+	{Reason: ReasonDeleteLoadBalancerFailed, Severity: SeverityWarning},
+	{Reason: ReasonDeleteInstanceGroupFailed, Severity: SeverityWarning},
+	{Reason: ReasonNoForwardingRuleRef, Severity: SeverityWarning},
+	{Reason: ReasonForwardingRuleUnusable, Severity: SeverityWarning},
+	{Reason: ReasonUnexpectedlyRemovedFinalizer, Severity: SeverityWarning},
+	{Reason: ReasonConflictingConfiguration, Severity: SeverityWarning},
+	{Reason: ReasonUnsupportedConfiguration, Severity: SeverityWarning},
+	{Reason: ReasonTargetPoolRaceWithRBS, Severity: SeverityWarning},
+	{Reason: ReasonCleanRBSResourcesForLegacyService, Severity: SeverityWarning},
+	{Reason: ReasonCanNotMigrateTargetPoolToRBS, Severity: SeverityWarning},
+	{Reason: ReasonILBOptionsIgnored, Severity: SeverityWarning},
+	{Reason: ReasonAllowlistingRequired, Severity: SeverityWarning},
+	{Reason: ReasonMixedStaticIP, Severity: SeverityNormal},
+	{Reason: ReasonXPN, Severity: SeverityNormal},
+	{Reason: ReasonStrongSessionAffinity, Severity: SeverityWarning},
+
+	{Reason: ReasonL4LBConfigAnnotationRemoved, Severity: SeverityWarning},
+	{Reason: ReasonL4LBConfigNotFound, Severity: SeverityWarning},
+	{Reason: ReasonL4LBConfigFetchFailed, Severity: SeverityWarning},
+	{Reason: ReasonL4LBConfigInvalidMode, Severity: SeverityWarning},
+	{Reason: ReasonL4LBConfigInvalidConnectionTracking, Severity: SeverityWarning},
+	{Reason: ReasonL4LBConfigUnknownError, Severity: SeverityWarning},
+
+	{Reason: ReasonType, Severity: SeverityNormal},
+	{Reason: ReasonLoadBalancerSourceRanges, Severity: SeverityNormal},
+	{Reason: ReasonPorts, Severity: SeverityNormal},
+	{Reason: ReasonSessionAffinity, Severity: SeverityNormal},
+	{Reason: ReasonPortsSessionAffinity, Severity: SeverityNormal},
+	{Reason: ReasonSessionAffinityConfig, Severity: SeverityNormal},
+	{Reason: ReasonLoadbalancerIP, Severity: SeverityNormal},
+	{Reason: ReasonExternalIP, Severity: SeverityNormal},
+	{Reason: ReasonAnnotations, Severity: SeverityNormal},
+	{Reason: ReasonUID, Severity: SeverityNormal},
+	{Reason: ReasonExternalTrafficPolicy, Severity: SeverityNormal},
+	{Reason: ReasonHealthCheckNodePort, Severity: SeverityNormal},
+	{Reason: ReasonTrafficDistribution, Severity: SeverityNormal},
+	{Reason: ReasonIPFamilies, Severity: SeverityNormal},
+
+	{Reason: ReasonProcessServiceSkipped, Severity: SeverityWarning},
+	{Reason: ReasonProcessServiceFailed, Severity: SeverityWarning},
+	{Reason: ReasonSyncNetworkEndpointGroupFailed, Severity: SeverityWarning},
+	{Reason: ReasonIgnoreZonePreprovisioningAnnotation, Severity: SeverityWarning},
+	{Reason: ReasonEnterDegradedMode, Severity: SeverityWarning},
+	{Reason: ReasonExitDegradedMode, Severity: SeverityNormal},
+	{Reason: ReasonRetryFailed, Severity: SeverityWarning},
+	{Reason: ReasonLabelsExceededLimit, Severity: SeverityWarning},
+	{Reason: ReasonCreate, Severity: SeverityNormal},
+	{Reason: ReasonDelete, Severity: SeverityNormal},
+	{Reason: ReasonAttach, Severity: SeverityNormal},
+	{Reason: ReasonAttachFailed, Severity: SeverityWarning},
+	{Reason: ReasonDetach, Severity: SeverityNormal},
+	{Reason: ReasonDetachFailed, Severity: SeverityWarning},
+	{Reason: ReasonNegCRError, Severity: SeverityWarning},
+	{Reason: ReasonLoadBalancerNegReady, Severity: SeverityNormal},
+	{Reason: ReasonLoadBalancerNegTimeout, Severity: SeverityNormal},
+	{Reason: ReasonLoadBalancerNegWithoutHealthCheck, Severity: SeverityNormal},
+	{Reason: ReasonLoadBalancerNegNotReady, Severity: SeverityNormal},
+
+	{Reason: ReasonWillNotConfigureFrontend, Severity: SeverityWarning},
+	{Reason: ReasonTHCConfigured, Severity: SeverityNormal},
+	{Reason: ReasonTHCAnnotationWithoutFlag, Severity: SeverityWarning},
+	{Reason: ReasonTHCAnnotationWithoutNEG, Severity: SeverityWarning},
+	{Reason: ReasonBackendConfigOverridesTHC, Severity: SeverityWarning},
+	{Reason: ReasonHealthcheckDescriptionUpdate, Severity: SeverityNormal},
+	{Reason: ReasonFirewallDriftDetected, Severity: SeverityWarning},
+	{Reason: ReasonFirewallDriftRepaired, Severity: SeverityNormal},
+
+	{Reason: ReasonProcessServiceAttachmentFailed, Severity: SeverityWarning},
+	{Reason: ReasonServiceAttachmentCreated, Severity: SeverityNormal},
+	{Reason: ReasonServiceAttachmentReleased, Severity: SeverityNormal},
+	{Reason: ReasonServiceAttachmentGCError, Severity: SeverityWarning},
+	{Reason: ReasonConsumerApprovalIgnored, Severity: SeverityWarning},
+	{Reason: ReasonConsumerConnectionPending, Severity: SeverityNormal},
+	{Reason: ReasonUnsyncedField, Severity: SeverityWarning},
+}
+
+// catalog maps the Reasons to their severity.
+var catalog = func() map[string]Severity {
+	catalog := make(map[string]Severity, len(Reasons))
+	for _, r := range Reasons {
+		catalog[r.Reason] = r.Severity
+	}
+	return catalog
+}()
+
+// otherReason is the reason label of the events whose reason is not in the
+// catalog.
+const otherReason = "Other"
+
+// Lookup returns the severity of reason, and false if reason is not in the
+// catalog.
+func Lookup(reason string) (Severity, bool) {
+	severity, ok := catalog[reason]
+	return severity, ok
+}
+
+// Allows returns true if events of eventtype may be recorded with the
+// severity.
+func (s Severity) Allows(eventtype string) bool {
+	switch s {
+	case SeverityNormal:
+		return eventtype == v1.EventTypeNormal
+	case SeverityWarning:
+		return eventtype == v1.EventTypeWarning
+	}
+	return eventtype == v1.EventTypeNormal || eventtype == v1.EventTypeWarning
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestReasons(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, r := range Reasons {
+		if r.Reason == "" || r.Reason == otherReason {
+			t.Errorf("Reasons contains reason %q, want a reason recorded by the controllers", r.Reason)
+		}
+		if seen[r.Reason] {
+			t.Errorf("Reasons contains reason %q more than once", r.Reason)
+		}
+		seen[r.Reason] = true
+		if r.Severity < SeverityNormal || r.Severity > SeverityMixed {
+			t.Errorf("Reason %q has unknown severity %d", r.Reason, r.Severity)
+		}
+		if severity, ok := Lookup(r.Reason); !ok || severity != r.Severity {
+			t.Errorf("Lookup(%q) = %d, %v, want %d, true", r.Reason, severity, ok, r.Severity)
+		}
+	}
+	if len(catalog) != len(Reasons) {
+		t.Errorf("catalog has %d reasons, want the %d Reasons", len(catalog), len(Reasons))
+	}
+	for _, reason := range []string{ReasonNegSyncSuccessful, ReasonNegSyncFailed, ReasonNegInitializationSuccessful, ReasonNegInitializationFailed} {
+		if _, ok := Lookup(reason); ok {
+			t.Errorf("Lookup(%q) = _, true for a NEG CR condition reason, want false", reason)
+		}
+	}
+}
+
+func TestSeverityAllows(t *testing.T) {
+	for _, tc := range []struct {
+		reason      string
+		wantNormal  bool
+		wantWarning bool
+	}{
+		{reason: ReasonSyncLoadBalancerSuccessful, wantNormal: true},
+		{reason: ReasonSyncLoadBalancerFailed, wantWarning: true},
+		{reason: SyncIngress, wantNormal: true, wantWarning: true},
+	} {
+		severity, ok := Lookup(tc.reason)
+		if !ok {
+			t.Fatalf("Lookup(%q) = _, false, want the reason in the catalog", tc.reason)
+		}
+		if got := severity.Allows(v1.EventTypeNormal); got != tc.wantNormal {
+			t.Errorf("Severity of %q allows Normal events = %v, want %v", tc.reason, got, tc.wantNormal)
+		}
+		if got := severity.Allows(v1.EventTypeWarning); got != tc.wantWarning {
+			t.Errorf("Severity of %q allows Warning events = %v, want %v", tc.reason, got, tc.wantWarning)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+)
+
+// maxTrackedEvents bounds the number of events and objects whose recent
+// events are remembered.
+const maxTrackedEvents = 4096
+
+// FilterOptions configures the deduplication and the rate limiting of events.
+type FilterOptions struct {
+	// DedupWindow is the time during which identical events of an object
+	// are recorded only once. Zero disables deduplication.
+	DedupWindow time.Duration
+	// QPS and Burst limit the rate of the events of each object. Zero QPS
+	// disables rate limiting.
+	QPS   float32
+	Burst int
+}
+
+// FilterOptionsFromFlags returns the filter options set by the flags.
+func FilterOptionsFromFlags() FilterOptions {
+	return FilterOptions{
+		DedupWindow: flags.F.EventDedupWindow,
+		QPS:         float32(flags.F.EventQPSPerObject),
+		Burst:       flags.F.EventBurstPerObject,
+	}
+}
+
+// filter deduplicates and rate limits the events of all the recorders of a
+// RecorderProducer.
+type filter struct {
+	options FilterOptions
+	clock   clock.PassiveClock
+
+	// lock serializes the rate limiter creation.
+	lock sync.Mutex
+	// recorded maps the recent events to the time they were recorded.
+	recorded *lru.Cache
+	// limiters maps the objects to their rate limiter.
+	limiters *lru.Cache
+}
+
+func newFilter(options FilterOptions, clock clock.PassiveClock) *filter {
+	return &filter{
+		options:  options,
+		clock:    clock,
+		recorded: lru.New(maxTrackedEvents),
+		limiters: lru.New(maxTrackedEvents),
+	}
+}
+
+type eventKey struct {
+	object    string
+	eventtype string
+	reason    string
+	message   string
+}
+
+// allow returns true if the event should be recorded, and counts it.
+func (f *filter) allow(object runtime.Object, eventtype, reason, message string) bool {
+	reasonLabel := reason
+	if _, ok := catalog[reason]; !ok {
+		reasonLabel = otherReason
+	}
+	eventCount.WithLabelValues(reasonLabel, eventtype).Inc()
+
+	objectKey := objectKey(object)
+	now := f.clock.Now()
+	key := eventKey{object: objectKey, eventtype: eventtype, reason: reason, message: message}
+	if f.options.DedupWindow > 0 {
+		if last, ok := f.recorded.Get(key); ok && now.Sub(last.(time.Time)) < f.options.DedupWindow {
+			suppressedEventCount.WithLabelValues(reasonLabel, eventtype, "duplicate").Inc()
+			return false
+		}
+	}
+	if f.options.QPS > 0 && !f.limiter(objectKey).TryAccept() {
+		suppressedEventCount.WithLabelValues(reasonLabel, eventtype, "rate_limited").Inc()
+		return false
+	}
+	if f.options.DedupWindow > 0 {
+		f.recorded.Add(key, now)
+	}
+	return true
+}
+
+func (f *filter) limiter(objectKey string) flowcontrol.PassiveRateLimiter {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if limiter, ok := f.limiters.Get(objectKey); ok {
+		return limiter.(flowcontrol.PassiveRateLimiter)
+	}
+	limiter := flowcontrol.NewTokenBucketPassiveRateLimiterWithClock(f.options.QPS, f.options.Burst, f.clock)
+	f.limiters.Add(objectKey, limiter)
+	return limiter
+}
+
+// objectKey identifies the object an event is about.
+func objectKey(object runtime.Object) string {
+	if ref, ok := object.(*v1.ObjectReference); ok {
+		return fmt.Sprintf("%s/%s/%s/%s", ref.Kind, ref.Namespace, ref.Name, ref.UID)
+	}
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return fmt.Sprintf("%T", object)
+	}
+	return fmt.Sprintf("%T/%s/%s/%s", object, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID())
+}
+
+// filteringRecorder is a record.EventRecorder which drops the events
+// rejected by its filter.
+type filteringRecorder struct {
+	recorder record.EventRecorder
+	filter   *filter
+}
+
+// NewFilteringRecorder returns a recorder which counts the events by reason,
+// and drops the duplicate events and the events over the rate limit of their
+// object before passing them to recorder.
+func NewFilteringRecorder(recorder record.EventRecorder, options FilterOptions) record.EventRecorder {
+	return &filteringRecorder{recorder: recorder, filter: newFilter(options, clock.RealClock{})}
+}
+
+func (r *filteringRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.filter.allow(object, eventtype, reason, message) {
+		r.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *filteringRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *filteringRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.filter.allow(object, eventtype, reason, message) {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// filteringRecorderProducer wraps the recorders of a RecorderProducer into
+// filteringRecorders sharing a filter.
+type filteringRecorderProducer struct {
+	producer RecorderProducer
+	filter   *filter
+
+	lock      sync.Mutex
+	recorders map[string]record.EventRecorder
+}
+
+// NewFilteringRecorderProducer returns a RecorderProducer whose recorders
+// filter events as the ones returned by NewFilteringRecorder, before passing
+// them to the recorders of producer.
+func NewFilteringRecorderProducer(producer RecorderProducer, options FilterOptions) RecorderProducer {
+	return &filteringRecorderProducer{
+		producer:  producer,
+		filter:    newFilter(options, clock.RealClock{}),
+		recorders: make(map[string]record.EventRecorder),
+	}
+}
+
+func (p *filteringRecorderProducer) Recorder(ns string) record.EventRecorder {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if recorder, ok := p.recorders[ns]; ok {
+		return recorder
+	}
+	recorder := &filteringRecorder{recorder: p.producer.Recorder(ns), filter: p.filter}
+	p.recorders[ns] = recorder
+	return recorder
+}
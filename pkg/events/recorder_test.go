@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newTestRecorder(options FilterOptions) (*filteringRecorder, *record.FakeRecorder, *clocktesting.FakeClock) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	fakeRecorder := record.NewFakeRecorder(100)
+	return &filteringRecorder{recorder: fakeRecorder, filter: newFilter(options, fakeClock)}, fakeRecorder, fakeClock
+}
+
+func testService(name string) *v1.Service {
+	return &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, UID: types.UID("uid-" + name)}}
+}
+
+func TestFilteringRecorderDedup(t *testing.T) {
+	recorder, fakeRecorder, fakeClock := newTestRecorder(FilterOptions{DedupWindow: time.Minute})
+	svc := testService("svc")
+	duplicates := testutil.ToFloat64(suppressedEventCount.WithLabelValues(ReasonSyncLoadBalancerFailed, v1.EventTypeWarning, "duplicate"))
+
+	recorder.Eventf(svc, v1.EventTypeWarning, ReasonSyncLoadBalancerFailed, "Error syncing load balancer: %v", "quota")
+	recorder.Eventf(svc, v1.EventTypeWarning, ReasonSyncLoadBalancerFailed, "Error syncing load balancer: %v", "quota")
+	// Events with another message or of another object are not duplicates.
+	recorder.Eventf(svc, v1.EventTypeWarning, ReasonSyncLoadBalancerFailed, "Error syncing load balancer: %v", "timeout")
+	recorder.Eventf(testService("other"), v1.EventTypeWarning, ReasonSyncLoadBalancerFailed, "Error syncing load balancer: %v", "quota")
+	if got := len(fakeRecorder.Events); got != 3 {
+		t.Errorf("Recorded %d events, want 3", got)
+	}
+	if got := testutil.ToFloat64(suppressedEventCount.WithLabelValues(ReasonSyncLoadBalancerFailed, v1.EventTypeWarning, "duplicate")) - duplicates; got != 1 {
+		t.Errorf("Suppressed %v duplicate events, want 1", got)
+	}
+
+	fakeClock.Step(time.Minute)
+	recorder.Eventf(svc, v1.EventTypeWarning, ReasonSyncLoadBalancerFailed, "Error syncing load balancer: %v", "quota")
+	if got := len(fakeRecorder.Events); got != 4 {
+		t.Errorf("Recorded %d events after the dedup window, want 4", got)
+	}
+}
+
+func TestFilteringRecorderRateLimit(t *testing.T) {
+	recorder, fakeRecorder, fakeClock := newTestRecorder(FilterOptions{QPS: 1, Burst: 2})
+	svc := testService("svc")
+
+	for i := 0; i < 5; i++ {
+		recorder.Eventf(svc, v1.EventTypeNormal, ReasonSyncLoadBalancerSuccessful, "Sync %d", i)
+	}
+	// The rate limit is per object.
+	recorder.Event(testService("other"), v1.EventTypeNormal, ReasonSyncLoadBalancerSuccessful, "Sync")
+	if got := len(fakeRecorder.Events); got != 3 {
+		t.Errorf("Recorded %d events, want 3", got)
+	}
+
+	fakeClock.Step(time.Second)
+	recorder.Event(svc, v1.EventTypeNormal, ReasonSyncLoadBalancerSuccessful, "Sync")
+	if got := len(fakeRecorder.Events); got != 4 {
+		t.Errorf("Recorded %d events after a second, want 4", got)
+	}
+}
+
+func TestFilteringRecorderCount(t *testing.T) {
+	recorder, fakeRecorder, _ := newTestRecorder(FilterOptions{})
+	synced := testutil.ToFloat64(eventCount.WithLabelValues(ReasonSyncLoadBalancerSuccessful, v1.EventTypeNormal))
+	other := testutil.ToFloat64(eventCount.WithLabelValues(otherReason, v1.EventTypeNormal))
+
+	recorder.Event(testService("svc"), v1.EventTypeNormal, ReasonSyncLoadBalancerSuccessful, "Synced")
+	recorder.Event(testService("svc"), v1.EventTypeNormal, ReasonSyncLoadBalancerSuccessful, "Synced")
+	GlobalEventf(recorder, v1.EventTypeNormal, "NotInCatalog", "Something happened")
+	if got := len(fakeRecorder.Events); got != 3 {
+		t.Errorf("Recorded %d events without filter options, want 3", got)
+	}
+	if got := testutil.ToFloat64(eventCount.WithLabelValues(ReasonSyncLoadBalancerSuccessful, v1.EventTypeNormal)) - synced; got != 2 {
+		t.Errorf("Counted %v events of reason %s, want 2", got, ReasonSyncLoadBalancerSuccessful)
+	}
+	if got := testutil.ToFloat64(eventCount.WithLabelValues(otherReason, v1.EventTypeNormal)) - other; got != 1 {
+		t.Errorf("Counted %v events of reason %s, want 1", got, otherReason)
+	}
+}
+
+func TestFilteringRecorderProducer(t *testing.T) {
+	producer := NewFilteringRecorderProducer(RecorderProducerMock{}, FilterOptions{DedupWindow: time.Minute})
+	if producer.Recorder("ns") != producer.Recorder("ns") {
+		t.Errorf("Recorder() returned different recorders for the same namespace")
+	}
+	first, second := producer.Recorder("ns").(*filteringRecorder), producer.Recorder("other").(*filteringRecorder)
+	if first.filter != second.filter {
+		t.Errorf("Recorders of different namespaces do not share their filter")
+	}
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewalls
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+	apiv1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/firewalls/metrics"
+	"k8s.io/ingress-gce/pkg/flags"
+	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/zonegetter"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// FirewallDriftDetectedReason is the event reason used when a firewall rule differs from the expected one.
+	FirewallDriftDetectedReason = events.ReasonFirewallDriftDetected
+	// FirewallDriftRepairedReason is the event reason used when a drifted firewall rule was repaired.
+	FirewallDriftRepairedReason = events.ReasonFirewallDriftRepaired
+)
+
+// FirewallAuditor periodically lists the firewall rules claimed by the cluster namers and
+// compares them with the rules expected for live Ingresses and Services.
+// Drifted rules are repaired and orphaned rules are deleted, unless the auditor only reports findings.
+type FirewallAuditor struct {
+	ctx *context.ControllerContext
+	// l7 is used to compute and repair the L7 firewall rule. L7 firewall rule is not audited when nil.
+	l7 *FirewallController
+	// auditL4 specifies if the firewall rules of L4 Services are audited.
+	auditL4 bool
+	// repair specifies if findings are repaired, or only reported.
+	repair    bool
+	period    time.Duration
+	hasSynced func() bool
+	stopCh    <-chan struct{}
+
+	logger klog.Logger
+}
+
+// NewFirewallAuditor returns a new firewall auditor.
+// l7 is the controller managing the L7 firewall rule, nil if the L7 rule should not be audited.
+func NewFirewallAuditor(ctx *context.ControllerContext, l7 *FirewallController, auditL4, repair bool, period time.Duration, stopCh <-chan struct{}, logger klog.Logger) *FirewallAuditor {
+	if l7 != nil && l7.l7Rules == nil {
+		// L7 VPC firewall rule is not enforced by the controller.
+		l7 = nil
+	}
+	return &FirewallAuditor{
+		ctx:       ctx,
+		l7:        l7,
+		auditL4:   auditL4 && !flags.F.DisableL4LBFirewall && !UseFirewallPolicy(),
+		repair:    repair,
+		period:    period,
+		hasSynced: ctx.HasSynced,
+		stopCh:    stopCh,
+		logger:    logger.WithName("FirewallAuditor"),
+	}
+}
+
+// Run audits the firewall rules every period until stopCh is closed.
+func (fa *FirewallAuditor) Run() {
+	fa.logger.Info("Starting firewall auditor", "period", fa.period, "repair", fa.repair, "auditL7", fa.l7 != nil, "auditL4", fa.auditL4)
+	wait.Until(func() {
+		if err := fa.audit(); err != nil {
+			fa.logger.Error(err, "Firewall audit failed")
+		}
+	}, fa.period, fa.stopCh)
+	fa.logger.Info("Firewall auditor stopped")
+}
+
+func (fa *FirewallAuditor) audit() error {
+	if !fa.hasSynced() {
+		return fmt.Errorf("waiting for stores to sync")
+	}
+	start := time.Now()
+	fa.logger.V(3).Info("Auditing firewall rules")
+	defer func() {
+		fa.logger.V(3).Info("Finished auditing firewall rules", "timeTaken", time.Since(start))
+	}()
+
+	firewalls, err := NewFirewallAdapter(fa.ctx.Cloud).ListFirewalls()
+	if err != nil {
+		return fmt.Errorf("failed to list firewall rules: %w", err)
+	}
+
+	var errs []error
+	if fa.l7 != nil {
+		if err := fa.auditL7Firewall(firewalls); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if fa.auditL4 {
+		if err := fa.auditL4Firewalls(firewalls); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// auditL7Firewall compares the L7 firewall rule with the rule expected for the live GCE Ingresses.
+func (fa *FirewallAuditor) auditL7Firewall(firewalls []*compute.Firewall) error {
+	name := fa.ctx.ClusterNamer.FirewallRule()
+	var existing *compute.Firewall
+	for _, fw := range firewalls {
+		if fw.Name == name {
+			existing = fw
+			break
+		}
+	}
+	if existing == nil {
+		metrics.PublishManagedRules(metrics.L7LBTypeLabel, 0)
+		return nil
+	}
+	metrics.PublishManagedRules(metrics.L7LBTypeLabel, 1)
+	fwLogger := fa.logger.WithValues("firewallRuleName", name)
+
+	gceIngresses := fa.l7.gceIngresses()
+	if len(gceIngresses) == 0 {
+		fwLogger.Info("Found orphaned L7 firewall rule")
+		if !fa.repair {
+			metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.OrphanFindingLabel, metrics.ReportedActionLabel)
+			return nil
+		}
+		if err := fa.l7.firewallPool.GC(); err != nil {
+			metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.OrphanFindingLabel, metrics.FailedActionLabel)
+			return fmt.Errorf("failed to delete orphaned L7 firewall rule %s: %w", name, err)
+		}
+		metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.OrphanFindingLabel, metrics.DeletedActionLabel)
+		return nil
+	}
+
+	inputs, err := fa.l7.buildL7FirewallInputs(gceIngresses)
+	if err != nil {
+		return err
+	}
+	expected, err := fa.l7.l7Rules.buildExpectedFW(inputs.nodeNames, inputs.additionalPorts, inputs.additionalRanges, inputs.allowNodePort)
+	if err != nil {
+		return err
+	}
+	if equal(expected, existing, fwLogger) {
+		return nil
+	}
+
+	fwLogger.Info("Found drifted L7 firewall rule")
+	for _, ing := range gceIngresses {
+		fa.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, FirewallDriftDetectedReason, "Firewall rule %s was modified outside of the controller", name)
+	}
+	if !fa.repair {
+		metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.DriftFindingLabel, metrics.ReportedActionLabel)
+		return nil
+	}
+	if err := fa.l7.firewallPool.Sync(inputs.nodeNames, inputs.additionalPorts, inputs.additionalRanges, inputs.allowNodePort); err != nil {
+		metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.DriftFindingLabel, metrics.FailedActionLabel)
+		return fmt.Errorf("failed to repair L7 firewall rule %s: %w", name, err)
+	}
+	metrics.PublishFinding(metrics.L7LBTypeLabel, metrics.DriftFindingLabel, metrics.RepairedActionLabel)
+	for _, ing := range gceIngresses {
+		fa.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeNormal, FirewallDriftRepairedReason, "Firewall rule %s was repaired", name)
+	}
+	return nil
+}
+
+// auditL4Firewalls compares the L4 firewall rules with the rules expected for the live L4 Services.
+//
+// Ownership of the rules is determined by names, so rules of Services which
+// are still being provisioned or deleted are not considered orphaned.
+// The drift is checked for TargetTags of all rules and, for the IPv4 nodes rule,
+// also for SourceRanges and Allowed ports. Other fields are reconciled by Service syncs.
+func (fa *FirewallAuditor) auditL4Firewalls(firewalls []*compute.Firewall) error {
+	owners, sharedNames := fa.l4FirewallOwners()
+
+	var managed []*compute.Firewall
+	for _, fw := range firewalls {
+		if fa.ctx.L4Namer.NameBelongsToCluster(fw.Name) {
+			managed = append(managed, fw)
+		}
+	}
+	metrics.PublishManagedRules(metrics.L4LBTypeLabel, len(managed))
+	if len(managed) == 0 {
+		return nil
+	}
+
+	nodeTags := make(map[l4NodeSelection][]string)
+	// Failures are kept too, so that nodes are listed once per audit.
+	nodeTagErrs := make(map[l4NodeSelection]error)
+	tagsOf := func(selection l4NodeSelection) ([]string, error) {
+		if tags, ok := nodeTags[selection]; ok {
+			return tags, nil
+		}
+		if err, ok := nodeTagErrs[selection]; ok {
+			return nil, err
+		}
+		var nodes []*apiv1.Node
+		var err error
+		if selection == l4DefaultSubnetNodes {
+			nodes, err = fa.ctx.ZoneGetter.ListNodesInDefaultSubnet(zonegetter.CandidateNodesFilter, fa.logger)
+		} else {
+			nodes, err = fa.ctx.ZoneGetter.ListNodes(zonegetter.CandidateNodesFilter, fa.logger)
+		}
+		if err != nil {
+			nodeTagErrs[selection] = err
+			return nil, err
+		}
+		tags, err := fa.ctx.Cloud.GetNodeTags(utils.GetNodeNames(nodes))
+		if err != nil {
+			nodeTagErrs[selection] = err
+			return nil, err
+		}
+		nodeTags[selection] = tags
+		return tags, nil
+	}
+
+	var errs []error
+	for _, fw := range managed {
+		svc, owned := owners[fw.Name]
+		selection, shared := sharedNames[fw.Name]
+		if !owned && !shared {
+			if err := fa.handleL4Orphan(fw); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if fw.Network != "" && !utils.EqualResourceIDs(fw.Network, fa.ctx.Cloud.NetworkURL()) {
+			// Rules of Services in non-default networks target a subset of nodes.
+			continue
+		}
+		if owned {
+			selection = l4NodeSelectionOf(svc)
+		}
+		if selection == l4UnknownNodes {
+			// The target tags are set by the last synced Service.
+			fa.logger.V(2).Info("Skipping drift check of L4 firewall rule targeting nodes selected by several controllers", "firewallRuleName", fw.Name)
+			continue
+		}
+		tags, err := tagsOf(selection)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := fa.checkL4Drift(fw, svc, tags); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// l4NodeSelection is the set of nodes targeted by the firewall rules of a Service.
+type l4NodeSelection int
+
+const (
+	// l4UnknownNodes is used when the controller of the Service is not known yet,
+	// or when a shared rule is used by Services selecting different nodes.
+	l4UnknownNodes l4NodeSelection = iota
+	// l4AllNodes are the candidate nodes of all subnets.
+	l4AllNodes
+	// l4DefaultSubnetNodes are the candidate nodes of the default subnet.
+	l4DefaultSubnetNodes
+)
+
+// l4NodeSelectionOf returns the nodes the L4 controller of the Service targets:
+// instance groups of NetLB Services only contain nodes of the default subnet.
+func l4NodeSelectionOf(svc *apiv1.Service) l4NodeSelection {
+	switch {
+	case utils.HasL4ILBFinalizerV2(svc), utils.HasL4NetLBFinalizerV3(svc):
+		return l4AllNodes
+	case utils.HasL4NetLBFinalizerV2(svc):
+		return l4DefaultSubnetNodes
+	default:
+		return l4UnknownNodes
+	}
+}
+
+// l4FirewallOwners returns the Services owning the L4 firewall rules, keyed by the rule name,
+// and the node selection of the shared health check firewall rules, if any L4 Service exists.
+func (fa *FirewallAuditor) l4FirewallOwners() (map[string]*apiv1.Service, map[string]l4NodeSelection) {
+	namer := fa.ctx.L4Namer
+	owners := make(map[string]*apiv1.Service)
+	sharedNames := make(map[string]l4NodeSelection)
+	for _, svc := range fa.ctx.Services().List() {
+		if svc.Spec.Type != apiv1.ServiceTypeLoadBalancer && !utils.HasL4ILBFinalizerV2(svc) && !utils.HasL4NetLBRBSFinalizers(svc) {
+			continue
+		}
+		for _, name := range []string{
+			namer.L4Firewall(svc.Namespace, svc.Name),
+			namer.L4FirewallDeny(svc.Namespace, svc.Name),
+			namer.L4IPv6Firewall(svc.Namespace, svc.Name),
+			namer.L4IPv6FirewallDeny(svc.Namespace, svc.Name),
+			namer.L4HealthCheckFirewall(svc.Namespace, svc.Name, false),
+			namer.L4IPv6HealthCheckFirewall(svc.Namespace, svc.Name, false),
+		} {
+			owners[name] = svc
+		}
+		selection := l4NodeSelectionOf(svc)
+		for _, name := range []string{
+			namer.L4HealthCheckFirewall(svc.Namespace, svc.Name, true),
+			namer.L4IPv6HealthCheckFirewall(svc.Namespace, svc.Name, true),
+		} {
+			if current, ok := sharedNames[name]; ok && current != selection {
+				selection = l4UnknownNodes
+			}
+			sharedNames[name] = selection
+		}
+	}
+	return owners, sharedNames
+}
+
+func (fa *FirewallAuditor) handleL4Orphan(fw *compute.Firewall) error {
+	fwLogger := fa.logger.WithValues("firewallRuleName", fw.Name)
+	fwLogger.Info("Found orphaned L4 firewall rule")
+	if !fa.repair {
+		metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.OrphanFindingLabel, metrics.ReportedActionLabel)
+		return nil
+	}
+	if err := EnsureL4FirewallRuleDeleted(fa.ctx.Cloud, fw.Name, fwLogger); err != nil {
+		metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.OrphanFindingLabel, metrics.FailedActionLabel)
+		return fmt.Errorf("failed to delete orphaned L4 firewall rule %s: %w", fw.Name, err)
+	}
+	metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.OrphanFindingLabel, metrics.DeletedActionLabel)
+	return nil
+}
+
+// checkL4Drift compares the L4 firewall rule with the expected one and repairs it if needed.
+// svc is nil for shared health check firewall rules.
+func (fa *FirewallAuditor) checkL4Drift(existing *compute.Firewall, svc *apiv1.Service, nodeTags []string) error {
+	fwLogger := fa.logger.WithValues("firewallRuleName", existing.Name)
+	expected := *existing
+	expected.TargetTags = nodeTags
+	if svc != nil && existing.Name == fa.ctx.L4Namer.L4Firewall(svc.Namespace, svc.Name) {
+		sourceRanges, err := l4utils.IPv4ServiceSourceRanges(svc)
+		if err != nil {
+			// Invalid source ranges are reported by the L4 controllers.
+			fwLogger.V(2).Info("Skipping drift check of L4 firewall rule with invalid source ranges", "err", err)
+			return nil
+		}
+		expected.SourceRanges = sourceRanges
+		expected.Allowed = expectedL4Allowed(svc)
+	}
+
+	eq, err := Equal(&expected, existing, true)
+	if err != nil {
+		return err
+	}
+	if eq {
+		return nil
+	}
+
+	fwLogger.Info("Found drifted L4 firewall rule")
+	if svc != nil {
+		fa.ctx.Recorder(svc.Namespace).Eventf(svc, apiv1.EventTypeWarning, FirewallDriftDetectedReason, "Firewall rule %s was modified outside of the controller", existing.Name)
+	}
+	if !fa.repair {
+		metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.DriftFindingLabel, metrics.ReportedActionLabel)
+		return nil
+	}
+	if err := NewFirewallAdapter(fa.ctx.Cloud).PatchFirewall(&expected); err != nil {
+		metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.DriftFindingLabel, metrics.FailedActionLabel)
+		return fmt.Errorf("failed to repair L4 firewall rule %s: %w", existing.Name, err)
+	}
+	metrics.PublishFinding(metrics.L4LBTypeLabel, metrics.DriftFindingLabel, metrics.RepairedActionLabel)
+	if svc != nil {
+		fa.ctx.Recorder(svc.Namespace).Eventf(svc, apiv1.EventTypeNormal, FirewallDriftRepairedReason, "Firewall rule %s was repaired", existing.Name)
+	}
+	return nil
+}
+
+// expectedL4Allowed returns the Allowed rules of the IPv4 nodes firewall rule of the Service,
+// the same way the L4 controllers do.
+func expectedL4Allowed(svc *apiv1.Service) []*compute.FirewallAllowed {
+	mixedProtocol := flags.F.EnableL4ILBMixedProtocol
+	if !utils.HasL4ILBFinalizerV2(svc) {
+		mixedProtocol = flags.F.EnableL4NetLBMixedProtocol
+	}
+	if mixedProtocol {
+		return AllowedForService(svc.Spec.Ports)
+	}
+	return []*compute.FirewallAllowed{
+		{
+			IPProtocol: string(utils.GetProtocol(svc.Spec.Ports)),
+			Ports:      utils.GetServicePortRanges(svc.Spec.Ports),
+		},
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewalls
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/mock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+	api_v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/ingress-gce/pkg/utils/zonegetter"
+	"k8s.io/klog/v2"
+)
+
+func TestAuditL7Firewall(t *testing.T) {
+	fwc, err := newFirewallController()
+	if err != nil {
+		t.Fatalf("failed to initialize firewall controller: %v", err)
+	}
+	mockGCE := fwc.ctx.Cloud.Compute().(*cloud.MockGCE)
+	mockGCE.MockFirewalls.UpdateHook = mock.UpdateFirewallHook
+	mockGCE.MockFirewalls.PatchHook = mock.UpdateFirewallHook
+	defaultSvc := test.NewService(test.DefaultBeSvcPort.ID.Service, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Name: "http", Port: 80, NodePort: 30000}},
+	})
+	fwc.ctx.ServiceInformer.GetIndexer().Add(defaultSvc)
+	ing := test.NewIngress(types.NamespacedName{Name: "my-ingress", Namespace: "default"}, networkingv1.IngressSpec{})
+	fwc.ctx.IngressInformer.GetIndexer().Add(ing)
+
+	key, _ := common.KeyFunc(queueKey)
+	if err := fwc.sync(key); err != nil {
+		t.Fatalf("fwc.sync() = %v, want nil", err)
+	}
+	want, err := fwc.ctx.Cloud.GetFirewall(ruleName)
+	if err != nil {
+		t.Fatalf("cloud.GetFirewall(%v) = _, %v, want _, nil", ruleName, err)
+	}
+
+	// Widen the source ranges, as if edited manually.
+	drifted := *want
+	drifted.SourceRanges = []string{"0.0.0.0/0"}
+	if err := fwc.ctx.Cloud.UpdateFirewall(&drifted); err != nil {
+		t.Fatalf("cloud.UpdateFirewall() = %v, want nil", err)
+	}
+
+	reportOnly := NewFirewallAuditor(fwc.ctx, fwc, false, false, time.Minute, nil, klog.TODO())
+	reportOnly.hasSynced = func() bool { return true }
+	if err := reportOnly.audit(); err != nil {
+		t.Fatalf("audit() = %v, want nil", err)
+	}
+	got, err := fwc.ctx.Cloud.GetFirewall(ruleName)
+	if err != nil {
+		t.Fatalf("cloud.GetFirewall(%v) = _, %v, want _, nil", ruleName, err)
+	}
+	if diff := cmp.Diff([]string{"0.0.0.0/0"}, got.SourceRanges); diff != "" {
+		t.Errorf("Report-only audit modified the firewall rule (-want +got):\n%s", diff)
+	}
+
+	auditor := NewFirewallAuditor(fwc.ctx, fwc, false, true, time.Minute, nil, klog.TODO())
+	auditor.hasSynced = func() bool { return true }
+	if err := auditor.audit(); err != nil {
+		t.Fatalf("audit() = %v, want nil", err)
+	}
+	got, err = fwc.ctx.Cloud.GetFirewall(ruleName)
+	if err != nil {
+		t.Fatalf("cloud.GetFirewall(%v) = _, %v, want _, nil", ruleName, err)
+	}
+	if diff := cmp.Diff(want.SourceRanges, got.SourceRanges, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Audit did not repair the firewall rule (-want +got):\n%s", diff)
+	}
+
+	// Without Ingresses the rule is an orphan.
+	fwc.ctx.IngressInformer.GetIndexer().Delete(ing)
+	if err := auditor.audit(); err != nil {
+		t.Fatalf("audit() = %v, want nil", err)
+	}
+	if _, err := fwc.ctx.Cloud.GetFirewall(ruleName); !utils.IsNotFoundError(err) {
+		t.Errorf("cloud.GetFirewall(%v) = _, %v, want _, 404 error", ruleName, err)
+	}
+}
+
+func TestAuditL4Firewalls(t *testing.T) {
+	fwc, err := newFirewallController()
+	if err != nil {
+		t.Fatalf("failed to initialize firewall controller: %v", err)
+	}
+	mockGCE := fwc.ctx.Cloud.Compute().(*cloud.MockGCE)
+	mockGCE.MockFirewalls.UpdateHook = mock.UpdateFirewallHook
+	mockGCE.MockFirewalls.PatchHook = mock.UpdateFirewallHook
+	ctx := fwc.ctx
+	nodeNames := []string{"node-1", "node-2"}
+	nodes, err := test.CreateAndInsertNodes(ctx.Cloud, nodeNames, test.DefaultTestClusterValues().ZoneName)
+	if err != nil {
+		t.Fatalf("CreateAndInsertNodes() = %v, want nil", err)
+	}
+	for _, node := range nodes {
+		ctx.NodeInformer.GetIndexer().Add(node)
+	}
+
+	svc := &api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "svc", Namespace: "default", Finalizers: []string{common.ILBFinalizerV2}},
+		Spec: api_v1.ServiceSpec{
+			Type:                     api_v1.ServiceTypeLoadBalancer,
+			Ports:                    []api_v1.ServicePort{{Port: 80, Protocol: api_v1.ProtocolTCP}},
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+		},
+	}
+	ctx.ServiceInformer.GetIndexer().Add(svc)
+
+	nodesFwName := ctx.L4Namer.L4Firewall(svc.Namespace, svc.Name)
+	hcFwName := ctx.L4Namer.L4HealthCheckFirewall(svc.Namespace, svc.Name, false)
+	orphanFwName := ctx.L4Namer.L4Firewall("default", "deleted-svc")
+	unmanagedFwName := "user-firewall"
+	for _, fw := range []*compute.Firewall{
+		{
+			Name:         nodesFwName,
+			Network:      ctx.Cloud.NetworkURL(),
+			SourceRanges: []string{"0.0.0.0/0"},
+			TargetTags:   []string{"node-1"},
+			Allowed:      []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"80", "22"}}},
+		},
+		{
+			Name:         hcFwName,
+			Network:      ctx.Cloud.NetworkURL(),
+			SourceRanges: []string{"130.211.0.0/22"},
+			TargetTags:   nodeNames,
+			Allowed:      []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"10256"}}},
+		},
+		{Name: orphanFwName, Network: ctx.Cloud.NetworkURL(), TargetTags: nodeNames},
+		{Name: unmanagedFwName, Network: ctx.Cloud.NetworkURL(), TargetTags: nodeNames},
+	} {
+		if err := ctx.Cloud.CreateFirewall(fw); err != nil {
+			t.Fatalf("cloud.CreateFirewall(%s) = %v, want nil", fw.Name, err)
+		}
+	}
+
+	auditor := NewFirewallAuditor(ctx, nil, true, true, time.Minute, nil, klog.TODO())
+	auditor.hasSynced = func() bool { return true }
+	if err := auditor.audit(); err != nil {
+		t.Fatalf("audit() = %v, want nil", err)
+	}
+
+	nodesFw, err := ctx.Cloud.GetFirewall(nodesFwName)
+	if err != nil {
+		t.Fatalf("cloud.GetFirewall(%s) = _, %v, want _, nil", nodesFwName, err)
+	}
+	wantNodesFw := &compute.Firewall{
+		SourceRanges: []string{"10.0.0.0/8"},
+		TargetTags:   nodeNames,
+		Allowed:      []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"80"}}},
+	}
+	if eq, err := Equal(wantNodesFw, nodesFw, true); !eq || err != nil {
+		t.Errorf("Drifted L4 firewall rule was not repaired, got %+v", nodesFw)
+	}
+	if _, err := ctx.Cloud.GetFirewall(hcFwName); err != nil {
+		t.Errorf("cloud.GetFirewall(%s) = _, %v, want _, nil", hcFwName, err)
+	}
+	if _, err := ctx.Cloud.GetFirewall(orphanFwName); !utils.IsNotFoundError(err) {
+		t.Errorf("cloud.GetFirewall(%s) = _, %v, want _, 404 error", orphanFwName, err)
+	}
+	if _, err := ctx.Cloud.GetFirewall(unmanagedFwName); err != nil {
+		t.Errorf("Firewall rule %s not managed by the controller should be left in place, got %v", unmanagedFwName, err)
+	}
+}
+
+const defaultTestSubnetURL = "https://www.googleapis.com/compute/v1/projects/mock-project/regions/test-region/subnetworks/default"
+
+func TestAuditL4FirewallsNetLBInstanceGroupNodes(t *testing.T) {
+	fwc, err := newFirewallController()
+	if err != nil {
+		t.Fatalf("failed to initialize firewall controller: %v", err)
+	}
+	mockGCE := fwc.ctx.Cloud.Compute().(*cloud.MockGCE)
+	ctx := fwc.ctx
+	// Instance groups of NetLB Services only contain the nodes of the default subnet.
+	ctx.ZoneGetter, err = zonegetter.NewFakeZoneGetterWithNodeTopologyHasSynced(ctx.NodeInformer, zonegetter.FakeNodeTopologyInformer(), defaultTestSubnetURL, false)
+	if err != nil {
+		t.Fatalf("NewFakeZoneGetterWithNodeTopologyHasSynced() = %v, want nil", err)
+	}
+	nodes, err := test.CreateAndInsertNodes(ctx.Cloud, []string{"node-1", "node-2", "other-subnet-node"}, test.DefaultTestClusterValues().ZoneName)
+	if err != nil {
+		t.Fatalf("CreateAndInsertNodes() = %v, want nil", err)
+	}
+	for _, node := range nodes {
+		node.Spec.PodCIDR = "10.100.0.0/24"
+		if node.Name == "other-subnet-node" {
+			node.Labels[utils.LabelNodeSubnet] = "other-subnet"
+		}
+		ctx.NodeInformer.GetIndexer().Add(node)
+	}
+
+	svc := &api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "svc", Namespace: "default", Finalizers: []string{common.NetLBFinalizerV2}},
+		Spec: api_v1.ServiceSpec{
+			Type:  api_v1.ServiceTypeLoadBalancer,
+			Ports: []api_v1.ServicePort{{Port: 80, Protocol: api_v1.ProtocolTCP}},
+		},
+	}
+	ctx.ServiceInformer.GetIndexer().Add(svc)
+
+	fwName := ctx.L4Namer.L4Firewall(svc.Namespace, svc.Name)
+	if err := ctx.Cloud.CreateFirewall(&compute.Firewall{
+		Name:         fwName,
+		Network:      ctx.Cloud.NetworkURL(),
+		SourceRanges: []string{"0.0.0.0/0"},
+		TargetTags:   []string{"node-1", "node-2"},
+		Allowed:      []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"80"}}},
+	}); err != nil {
+		t.Fatalf("cloud.CreateFirewall(%s) = %v, want nil", fwName, err)
+	}
+	updated := false
+	mockGCE.MockFirewalls.PatchHook = func(hookCtx context.Context, key *meta.Key, fw *compute.Firewall, m *cloud.MockFirewalls, options ...cloud.Option) error {
+		updated = true
+		return mock.UpdateFirewallHook(hookCtx, key, fw, m, options...)
+	}
+	mockGCE.MockFirewalls.UpdateHook = mockGCE.MockFirewalls.PatchHook
+
+	auditor := NewFirewallAuditor(ctx, nil, true, true, time.Minute, nil, klog.TODO())
+	auditor.hasSynced = func() bool { return true }
+	if err := auditor.audit(); err != nil {
+		t.Fatalf("audit() = %v, want nil", err)
+	}
+	if updated {
+		fw, _ := ctx.Cloud.GetFirewall(fwName)
+		t.Errorf("audit() updated the firewall rule of the NetLB Service to %v, want the nodes of the default subnet only", fw.TargetTags)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/ingress-gce/pkg/common/operator"
 	"k8s.io/ingress-gce/pkg/context"
 	"k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/loadbalancers/features"
 	"k8s.io/ingress-gce/pkg/utils"
@@ -54,8 +55,11 @@ var (
 
 // FirewallController synchronizes the firewall rule for all ingresses.
 type FirewallController struct {
-	ctx                           *context.ControllerContext
-	firewallPool                  SingleFirewallPool
+	ctx          *context.ControllerContext
+	firewallPool SingleFirewallPool
+	// l7Rules manages the GCE L7 VPC firewall rule. It is nil when the firewall enforcement is disabled
+	// or the rule is written into a network firewall policy.
+	l7Rules                       *FirewallRules
 	queue                         utils.TaskQueue
 	translator                    *translator.Translator
 	zoneGetter                    *zonegetter.ZoneGetter
@@ -112,15 +116,22 @@ func NewFirewallController(
 		firewallCRPool := NewFirewallCRPool(ctx.FirewallClient, ctx.Cloud, ctx.ClusterNamer, sourceRanges, portRanges, disableFWEnforcement, logger)
 		compositeFirewallPool.pools = append(compositeFirewallPool.pools, firewallCRPool)
 	}
-	if !disableFWEnforcement {
-		firewallPool := NewFirewallPool(ctx.Cloud, ctx.ClusterNamer, sourceRanges, portRanges, logger)
-		compositeFirewallPool.pools = append(compositeFirewallPool.pools, firewallPool)
+	var l7Rules *FirewallRules
+	switch {
+	case disableFWEnforcement:
+	case UseFirewallPolicy():
+		firewallPolicyPool := NewFirewallPolicyPool(NewFirewallPolicyAdapter(ctx.Cloud), ctx.Cloud, ctx.ClusterNamer, sourceRanges, portRanges, logger)
+		compositeFirewallPool.pools = append(compositeFirewallPool.pools, firewallPolicyPool)
+	default:
+		l7Rules = newFirewallRules(ctx.Cloud, ctx.ClusterNamer, sourceRanges, portRanges, logger)
+		compositeFirewallPool.pools = append(compositeFirewallPool.pools, l7Rules)
 	}
 
 	fwc := &FirewallController{
 		ctx:                           ctx,
 		zoneGetter:                    ctx.ZoneGetter,
 		firewallPool:                  compositeFirewallPool,
+		l7Rules:                       l7Rules,
 		translator:                    ctx.Translator,
 		hasSynced:                     ctx.HasSynced,
 		enableIngressRegionalExternal: enableRegionalXLB,
@@ -250,9 +261,7 @@ func (fwc *FirewallController) sync(key string) error {
 	}
 	fwc.logger.V(3).Info("Syncing firewall")
 
-	gceIngresses := operator.Ingresses(fwc.ctx.Ingresses().List()).Filter(func(ing *v1.Ingress) bool {
-		return utils.IsGCEIngress(ing)
-	}).AsList()
+	gceIngresses := fwc.gceIngresses()
 
 	// If there are no more ingresses, then delete the firewall rule.
 	if len(gceIngresses) == 0 {
@@ -262,11 +271,50 @@ func (fwc *FirewallController) sync(key string) error {
 		return nil
 	}
 
+	inputs, err := fwc.buildL7FirewallInputs(gceIngresses)
+	if err != nil {
+		return err
+	}
+
+	// Ensure firewall rule for the cluster and pass any NEG endpoint ports.
+	if err := fwc.firewallPool.Sync(inputs.nodeNames, inputs.additionalPorts, inputs.additionalRanges, inputs.allowNodePort); err != nil {
+		fwErr := firewallXPNError(err)
+		if fwErr == nil {
+			return err
+		}
+		// XPN: Raise an event on each ingress
+		for _, ing := range gceIngresses {
+			if annotations.FromIngress(ing).SuppressFirewallXPNError() {
+				continue
+			}
+			fwc.ctx.Recorder(ing.Namespace).Event(ing, apiv1.EventTypeNormal, events.ReasonXPN, fwErr.Message)
+		}
+	}
+	return nil
+}
+
+// gceIngresses returns the single-cluster GCE Ingresses.
+func (fwc *FirewallController) gceIngresses() []*v1.Ingress {
+	return operator.Ingresses(fwc.ctx.Ingresses().List()).Filter(func(ing *v1.Ingress) bool {
+		return utils.IsGCEIngress(ing)
+	}).AsList()
+}
+
+// l7FirewallInputs holds the arguments of SingleFirewallPool.Sync for the L7 firewall rule.
+type l7FirewallInputs struct {
+	nodeNames        []string
+	additionalPorts  []string
+	additionalRanges []string
+	allowNodePort    bool
+}
+
+// buildL7FirewallInputs computes the L7 firewall rule inputs for the given GCE Ingresses.
+func (fwc *FirewallController) buildL7FirewallInputs(gceIngresses []*v1.Ingress) (*l7FirewallInputs, error) {
 	// gceSvcPorts contains the ServicePorts used by only single-cluster ingress.
 	gceSvcPorts := fwc.ToSvcPorts(gceIngresses)
 	nodes, err := fwc.zoneGetter.ListNodes(zonegetter.CandidateNodesFilter, fwc.logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	negPorts := fwc.translator.GatherEndpointPorts(gceSvcPorts)
 
@@ -284,7 +332,7 @@ func (fwc *FirewallController) sync(key string) error {
 	ilbRange, err := fwc.ilbFirewallSrcRange(gceIngresses)
 	if err != nil {
 		if err != features.ErrSubnetNotFound && err != ErrNoILBIngress {
-			return err
+			return nil, err
 		}
 	} else {
 		additionalRanges = append(additionalRanges, ilbRange)
@@ -294,7 +342,7 @@ func (fwc *FirewallController) sync(key string) error {
 		fwc.logger.Info("fwc.rxlbFirewallsSrcRange", "rxlbRange", rxlbRange, "err", err)
 		if err != nil {
 			if err != features.ErrSubnetNotFound && err != ErrNoRXLBIngress {
-				return err
+				return nil, err
 			}
 		} else {
 			additionalRanges = append(additionalRanges, rxlbRange)
@@ -306,21 +354,12 @@ func (fwc *FirewallController) sync(key string) error {
 	additionalPorts = append(additionalPorts, hcPorts...)
 	additionalPorts = append(additionalPorts, negPorts...)
 
-	// Ensure firewall rule for the cluster and pass any NEG endpoint ports.
-	if err := fwc.firewallPool.Sync(utils.GetNodeNames(nodes), additionalPorts, additionalRanges, needNodePort); err != nil {
-		fwErr := firewallXPNError(err)
-		if fwErr == nil {
-			return err
-		}
-		// XPN: Raise an event on each ingress
-		for _, ing := range gceIngresses {
-			if annotations.FromIngress(ing).SuppressFirewallXPNError() {
-				continue
-			}
-			fwc.ctx.Recorder(ing.Namespace).Event(ing, apiv1.EventTypeNormal, "XPN", fwErr.Message)
-		}
-	}
-	return nil
+	return &l7FirewallInputs{
+		nodeNames:        utils.GetNodeNames(nodes),
+		additionalPorts:  additionalPorts,
+		additionalRanges: additionalRanges,
+		allowNodePort:    needNodePort,
+	}, nil
 }
 
 func (fwc *FirewallController) ilbFirewallSrcRange(gceIngresses []*v1.Ingress) (string, error) {
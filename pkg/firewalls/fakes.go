@@ -145,3 +145,90 @@ func copyFirewall(f *compute.Firewall) (*compute.Firewall, error) {
 	}
 	return &firewall, nil
 }
+
+type fakeFirewallPolicyClient struct {
+	policies         map[string]*compute.FirewallPolicy
+	networkProjectID string
+	readOnly         bool
+	// addRuleHook is called before a rule is added to the policy.
+	addRuleHook func(policy *compute.FirewallPolicy)
+}
+
+// NewFakeFirewallPolicyClient creates a fake for network firewall policies with the given, empty, policies.
+func NewFakeFirewallPolicyClient(readOnly bool, policies ...string) *fakeFirewallPolicyClient {
+	fc := &fakeFirewallPolicyClient{
+		policies:         make(map[string]*compute.FirewallPolicy),
+		networkProjectID: "test-network-project",
+		readOnly:         readOnly,
+	}
+	for _, name := range policies {
+		fc.policies[name] = &compute.FirewallPolicy{Name: name}
+	}
+	return fc
+}
+
+func (fc *fakeFirewallPolicyClient) GetFirewallPolicy(policy string) (*compute.FirewallPolicy, error) {
+	p, exists := fc.policies[policy]
+	if !exists {
+		return nil, test.FakeGoogleAPINotFoundErr()
+	}
+	return p, nil
+}
+
+func (fc *fakeFirewallPolicyClient) AddFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error {
+	if fc.readOnly {
+		return test.FakeGoogleAPIForbiddenErr()
+	}
+	p, err := fc.GetFirewallPolicy(policy)
+	if err != nil {
+		return err
+	}
+	if fc.addRuleHook != nil {
+		fc.addRuleHook(p)
+	}
+	for _, r := range p.Rules {
+		if r.Priority == rule.Priority {
+			return fmt.Errorf("rule with priority %d already exists in policy %s", rule.Priority, policy)
+		}
+	}
+	p.Rules = append(p.Rules, rule)
+	return nil
+}
+
+func (fc *fakeFirewallPolicyClient) PatchFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error {
+	if fc.readOnly {
+		return test.FakeGoogleAPIForbiddenErr()
+	}
+	p, err := fc.GetFirewallPolicy(policy)
+	if err != nil {
+		return err
+	}
+	for i, r := range p.Rules {
+		if r.Priority == rule.Priority {
+			p.Rules[i] = rule
+			return nil
+		}
+	}
+	return test.FakeGoogleAPINotFoundErr()
+}
+
+func (fc *fakeFirewallPolicyClient) RemoveFirewallPolicyRule(policy string, priority int64) error {
+	if fc.readOnly {
+		return test.FakeGoogleAPIForbiddenErr()
+	}
+	p, err := fc.GetFirewallPolicy(policy)
+	if err != nil {
+		return err
+	}
+	for i, r := range p.Rules {
+		if r.Priority == priority {
+			p.Rules = append(p.Rules[:i], p.Rules[i+1:]...)
+			return nil
+		}
+	}
+	return test.FakeGoogleAPINotFoundErr()
+}
+
+func (fc *fakeFirewallPolicyClient) NetworkProjectID() string {
+	return fc.networkProjectID
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewalls
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/flags"
+	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/utils"
+	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+const (
+	firewallPolicyActionAllow = "allow"
+	firewallPolicyActionDeny  = "deny"
+	firewallPolicyIngress     = "INGRESS"
+	// firewallPolicyAddAttempts is the number of attempts to add a rule when
+	// the allocated priority is taken concurrently, e.g. by another controller.
+	firewallPolicyAddAttempts = 3
+)
+
+// newFirewallPolicyClient returns the client used by the L4 firewall functions.
+// It is a variable so that tests can replace it with a fake.
+var newFirewallPolicyClient = func(cloud *gce.Cloud) FirewallPolicyClient {
+	return NewFirewallPolicyAdapter(cloud)
+}
+
+// UseFirewallPolicy returns true if firewall rules are written into the
+// network firewall policy set by --firewall-policy instead of VPC firewall rules.
+func UseFirewallPolicy() bool {
+	return flags.F.FirewallPolicyName != ""
+}
+
+// firewallPolicyRules writes firewall rules into a network firewall policy.
+// Rules are identified by their rule name. Priorities are allocated by the
+// controller from [priorityStart, priorityEnd] following the priority of the
+// VPC firewall: rules with a priority below the default one are allocated from
+// the start of the range and rules with a priority above it from the end. At
+// the default priority, allow rules are allocated from the start and deny rules
+// from the end, so that allow rules take precedence.
+//
+// Priorities are not reserved across controllers: the policy is the source of
+// truth and the allocation is retried when the priority was taken concurrently.
+type firewallPolicyRules struct {
+	client           FirewallPolicyClient
+	policy           string
+	priorityStart    int64
+	priorityEnd      int64
+	targetSecureTags []string
+
+	logger klog.Logger
+}
+
+func newFirewallPolicyRules(client FirewallPolicyClient, logger klog.Logger) *firewallPolicyRules {
+	var tags []string
+	for _, tag := range strings.Split(flags.F.FirewallPolicyTargetSecureTags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return &firewallPolicyRules{
+		client:           client,
+		policy:           flags.F.FirewallPolicyName,
+		priorityStart:    int64(flags.F.FirewallPolicyPriorityStart),
+		priorityEnd:      int64(flags.F.FirewallPolicyPriorityEnd),
+		targetSecureTags: tags,
+		logger:           logger.WithValues("firewallPolicy", flags.F.FirewallPolicyName),
+	}
+}
+
+// ensureRule creates or patches the policy rule equivalent to the given VPC firewall.
+// Target tags of the firewall are ignored, the rule targets the configured secure tags.
+func (fpr *firewallPolicyRules) ensureRule(fw *compute.Firewall, skipDescription bool) (l4utils.ResourceSyncStatus, error) {
+	expectedRule := fpr.toPolicyRule(fw)
+	var addErr error
+	var addedPriority int64
+	for attempt := 0; attempt < firewallPolicyAddAttempts; attempt++ {
+		policy, err := fpr.client.GetFirewallPolicy(fpr.policy)
+		if err != nil {
+			return l4utils.ResourceResync, err
+		}
+		// Existing rules are patched in place, also when added concurrently by another controller.
+		if existingRule := findPolicyRule(policy, fw.Name); existingRule != nil {
+			return fpr.patchRule(expectedRule, existingRule, skipDescription)
+		}
+		if addErr != nil && !priorityTakenByOtherRule(policy, addedPriority, fw.Name) {
+			// The rule was not added for another reason than a conflict.
+			return l4utils.ResourceResync, addErr
+		}
+
+		priority, err := fpr.allocatePriority(policy, fw)
+		if err != nil {
+			return l4utils.ResourceResync, err
+		}
+		expectedRule.Priority = priority
+		fpr.logger.V(2).Info("Creating firewall policy rule", "ruleName", fw.Name, "priority", priority)
+		addErr = fpr.client.AddFirewallPolicyRule(fpr.policy, expectedRule)
+		if addErr == nil {
+			return l4utils.ResourceUpdate, nil
+		}
+		if utils.IsForbiddenError(addErr) {
+			gcloudCmd := fpr.gcloudCmd("create", expectedRule)
+			fpr.logger.V(3).Info("Could not create firewall policy rule. Raising event for cmd", "err", addErr, "gcloudCmd", gcloudCmd)
+			return l4utils.ResourceUpdate, newFirewallXPNError(addErr, gcloudCmd)
+		}
+		addedPriority = priority
+		fpr.logger.V(2).Info("Failed to create firewall policy rule, checking for a conflicting rule", "ruleName", fw.Name, "priority", priority, "err", addErr)
+	}
+	return l4utils.ResourceResync, addErr
+}
+
+// patchRule patches the existing policy rule if it differs from the expected rule.
+// The rule keeps its priority.
+func (fpr *firewallPolicyRules) patchRule(expectedRule, existingRule *compute.FirewallPolicyRule, skipDescription bool) (l4utils.ResourceSyncStatus, error) {
+	expectedRule.Priority = existingRule.Priority
+	if eq, err := equalPolicyRules(expectedRule, existingRule, skipDescription); eq || err != nil {
+		return l4utils.ResourceResync, err
+	}
+
+	fpr.logger.V(2).Info("Patching firewall policy rule", "ruleName", expectedRule.RuleName, "priority", expectedRule.Priority)
+	err := fpr.client.PatchFirewallPolicyRule(fpr.policy, expectedRule)
+	if utils.IsForbiddenError(err) {
+		gcloudCmd := fpr.gcloudCmd("update", expectedRule)
+		fpr.logger.V(3).Info("Could not patch firewall policy rule. Raising event for cmd", "err", err, "gcloudCmd", gcloudCmd)
+		return l4utils.ResourceUpdate, newFirewallXPNError(err, gcloudCmd)
+	}
+	return l4utils.ResourceUpdate, err
+}
+
+// ensureRuleDeleted removes the rule with the given name from the policy, if it exists.
+func (fpr *firewallPolicyRules) ensureRuleDeleted(name string) error {
+	policy, err := fpr.client.GetFirewallPolicy(fpr.policy)
+	if err != nil {
+		return err
+	}
+	rule := findPolicyRule(policy, name)
+	if rule == nil {
+		fpr.logger.V(3).Info("Firewall policy rule didn't exist when attempting delete", "ruleName", name)
+		return nil
+	}
+
+	fpr.logger.V(2).Info("Removing firewall policy rule", "ruleName", name, "priority", rule.Priority)
+	err = utils.IgnoreHTTPNotFound(fpr.client.RemoveFirewallPolicyRule(fpr.policy, rule.Priority))
+	if utils.IsForbiddenError(err) {
+		gcloudCmd := fmt.Sprintf("gcloud compute network-firewall-policies rules delete %d --firewall-policy %s --global-firewall-policy --project %s", rule.Priority, fpr.policy, fpr.client.NetworkProjectID())
+		fpr.logger.V(3).Info("Could not remove firewall policy rule. Raising event for cmd", "err", err, "gcloudCmd", gcloudCmd)
+		return newFirewallXPNError(err, gcloudCmd)
+	}
+	return err
+}
+
+// allocatePriority returns the first priority in the configured range that is
+// not used by any rule of the policy, searching from the end of the range for
+// rules which must be evaluated after the rules at the default priority.
+func (fpr *firewallPolicyRules) allocatePriority(policy *compute.FirewallPolicy, fw *compute.Firewall) (int64, error) {
+	used := make(map[int64]bool)
+	for _, rule := range policy.Rules {
+		used[rule.Priority] = true
+	}
+	fwPriority := fw.Priority
+	if fwPriority == 0 {
+		fwPriority = priority(nil)
+	}
+	fromEnd := fwPriority > priority(nil) || (fwPriority == priority(nil) && len(fw.Denied) > 0)
+	if fromEnd {
+		for p := fpr.priorityEnd; p >= fpr.priorityStart; p-- {
+			if !used[p] {
+				return p, nil
+			}
+		}
+	} else {
+		for p := fpr.priorityStart; p <= fpr.priorityEnd; p++ {
+			if !used[p] {
+				return p, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no free priority in range [%d, %d] of firewall policy %s", fpr.priorityStart, fpr.priorityEnd, fpr.policy)
+}
+
+// priorityTakenByOtherRule returns true if a rule other than the named one
+// uses the priority in the policy.
+func priorityTakenByOtherRule(policy *compute.FirewallPolicy, priority int64, name string) bool {
+	for _, rule := range policy.Rules {
+		if rule.Priority == priority && rule.RuleName != name {
+			return true
+		}
+	}
+	return false
+}
+
+func (fpr *firewallPolicyRules) toPolicyRule(fw *compute.Firewall) *compute.FirewallPolicyRule {
+	rule := &compute.FirewallPolicyRule{
+		RuleName:    fw.Name,
+		Description: fw.Description,
+		Direction:   firewallPolicyIngress,
+		Action:      firewallPolicyActionAllow,
+		Match: &compute.FirewallPolicyRuleMatcher{
+			SrcIpRanges:  fw.SourceRanges,
+			DestIpRanges: fw.DestinationRanges,
+		},
+	}
+	for _, a := range fw.Allowed {
+		rule.Match.Layer4Configs = append(rule.Match.Layer4Configs, &compute.FirewallPolicyRuleMatcherLayer4Config{IpProtocol: strings.ToLower(a.IPProtocol), Ports: a.Ports})
+	}
+	if len(fw.Denied) > 0 {
+		rule.Action = firewallPolicyActionDeny
+		for _, d := range fw.Denied {
+			rule.Match.Layer4Configs = append(rule.Match.Layer4Configs, &compute.FirewallPolicyRuleMatcherLayer4Config{IpProtocol: strings.ToLower(d.IPProtocol), Ports: d.Ports})
+		}
+	}
+	for _, tag := range fpr.targetSecureTags {
+		rule.TargetSecureTags = append(rule.TargetSecureTags, &compute.FirewallPolicyRuleSecureTag{Name: tag})
+	}
+	return rule
+}
+
+// gcloudCmd generates a gcloud command to create or update the given policy rule.
+func (fpr *firewallPolicyRules) gcloudCmd(verb string, rule *compute.FirewallPolicyRule) string {
+	var layer4 []string
+	for _, l4 := range rule.Match.Layer4Configs {
+		if len(l4.Ports) == 0 {
+			layer4 = append(layer4, l4.IpProtocol)
+		}
+		for _, p := range l4.Ports {
+			layer4 = append(layer4, fmt.Sprintf("%s:%s", l4.IpProtocol, p))
+		}
+	}
+	sort.Strings(layer4)
+	srcRanges := append([]string{}, rule.Match.SrcIpRanges...)
+	sort.Strings(srcRanges)
+	cmd := fmt.Sprintf("gcloud compute network-firewall-policies rules %s %d --firewall-policy %s --global-firewall-policy --rule-name %s --description %q --action %s --direction %s --layer4-configs %s --src-ip-ranges %s",
+		verb, rule.Priority, fpr.policy, rule.RuleName, rule.Description, rule.Action, rule.Direction, strings.Join(layer4, ","), strings.Join(srcRanges, ","))
+	if len(rule.Match.DestIpRanges) > 0 {
+		destRanges := append([]string{}, rule.Match.DestIpRanges...)
+		sort.Strings(destRanges)
+		cmd += fmt.Sprintf(" --dest-ip-ranges %s", strings.Join(destRanges, ","))
+	}
+	if len(fpr.targetSecureTags) > 0 {
+		cmd += fmt.Sprintf(" --target-secure-tags %s", strings.Join(fpr.targetSecureTags, ","))
+	}
+	return cmd + fmt.Sprintf(" --project %s", fpr.client.NetworkProjectID())
+}
+
+func findPolicyRule(policy *compute.FirewallPolicy, name string) *compute.FirewallPolicyRule {
+	for _, rule := range policy.Rules {
+		if rule.RuleName == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// equalPolicyRules compares the fields of the policy rules managed by the controller.
+//
+// Returns error when there is a port definition that isn't an int or range (int-int)
+func equalPolicyRules(a, b *compute.FirewallPolicyRule, skipDescription bool) (bool, error) {
+	var aMatch, bMatch compute.FirewallPolicyRuleMatcher
+	if a.Match != nil {
+		aMatch = *a.Match
+	}
+	if b.Match != nil {
+		bMatch = *b.Match
+	}
+	var aTags, bTags []string
+	for _, tag := range a.TargetSecureTags {
+		aTags = append(aTags, tag.Name)
+	}
+	for _, tag := range b.TargetSecureTags {
+		bTags = append(bTags, tag.Name)
+	}
+
+	switch {
+	case a.Action != b.Action || a.Direction != b.Direction || a.Priority != b.Priority || a.Disabled != b.Disabled:
+		return false, nil
+	case !equalIPRangeSet(aMatch.SrcIpRanges, bMatch.SrcIpRanges):
+		return false, nil
+	case !equalIPRangeSet(aMatch.DestIpRanges, bMatch.DestIpRanges):
+		return false, nil
+	case !utils.EqualStringSets(aTags, bTags):
+		return false, nil
+	case !skipDescription && a.Description != b.Description:
+		return false, nil
+	default:
+		return equalAllowRules(layer4ConfigsToAllowed(aMatch.Layer4Configs), layer4ConfigsToAllowed(bMatch.Layer4Configs))
+	}
+}
+
+func layer4ConfigsToAllowed(configs []*compute.FirewallPolicyRuleMatcherLayer4Config) []*compute.FirewallAllowed {
+	var allowed []*compute.FirewallAllowed
+	for _, c := range configs {
+		allowed = append(allowed, &compute.FirewallAllowed{IPProtocol: c.IpProtocol, Ports: c.Ports})
+	}
+	return allowed
+}
+
+// FirewallPolicyPool manages the L7 firewall rule in the network firewall policy
+// set by --firewall-policy.
+type FirewallPolicyPool struct {
+	// l7Rules builds the expected L7 firewall, its target tags are not used.
+	l7Rules     *FirewallRules
+	policyRules *firewallPolicyRules
+	namer       *namer_util.Namer
+
+	logger klog.Logger
+}
+
+// NewFirewallPolicyPool creates a new manager of the L7 firewall policy rule.
+func NewFirewallPolicyPool(client FirewallPolicyClient, cloud Firewall, namer *namer_util.Namer, l7SrcRanges []string, nodePortRanges []string, logger klog.Logger) SingleFirewallPool {
+	logger = logger.WithName("FirewallPolicyPool")
+	return &FirewallPolicyPool{
+		l7Rules:     newFirewallRules(cloud, namer, l7SrcRanges, nodePortRanges, logger),
+		policyRules: newFirewallPolicyRules(client, logger),
+		namer:       namer,
+		logger:      logger,
+	}
+}
+
+// Sync syncs the L7 firewall policy rule.
+func (fpp *FirewallPolicyPool) Sync(nodeNames, additionalPorts, additionalRanges []string, allowNodePort bool) error {
+	fpp.logger.V(4).Info("Sync", "nodeNames", nodeNames)
+	expectedFirewall, err := fpp.l7Rules.buildExpectedFW(nodeNames, additionalPorts, additionalRanges, allowNodePort)
+	if err != nil {
+		return err
+	}
+	_, err = fpp.policyRules.ensureRule(expectedFirewall, false)
+	return err
+}
+
+// GC removes the L7 firewall policy rule.
+func (fpp *FirewallPolicyPool) GC() error {
+	name := fpp.namer.FirewallRule()
+	fpp.logger.V(3).Info("Deleting firewall policy rule", "ruleName", name)
+	return fpp.policyRules.ensureRuleDeleted(name)
+}
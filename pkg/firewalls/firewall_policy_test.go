@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewalls
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/flags"
+	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+const testFirewallPolicy = "test-policy"
+
+func setFirewallPolicyFlags(t *testing.T, start, end int, secureTags string) {
+	oldName, oldStart, oldEnd, oldTags := flags.F.FirewallPolicyName, flags.F.FirewallPolicyPriorityStart, flags.F.FirewallPolicyPriorityEnd, flags.F.FirewallPolicyTargetSecureTags
+	t.Cleanup(func() {
+		flags.F.FirewallPolicyName, flags.F.FirewallPolicyPriorityStart, flags.F.FirewallPolicyPriorityEnd, flags.F.FirewallPolicyTargetSecureTags = oldName, oldStart, oldEnd, oldTags
+	})
+	flags.F.FirewallPolicyName = testFirewallPolicy
+	flags.F.FirewallPolicyPriorityStart = start
+	flags.F.FirewallPolicyPriorityEnd = end
+	flags.F.FirewallPolicyTargetSecureTags = secureTags
+}
+
+func TestFirewallPolicyPool(t *testing.T) {
+	setFirewallPolicyFlags(t, 100, 200, "tagValues/1, tagValues/2")
+	client := NewFakeFirewallPolicyClient(false, testFirewallPolicy)
+	fp := NewFirewallPolicyPool(client, NewFakeFirewallsProvider(false, false), defaultNamer, srcRanges, []string{"30000-32767"}, klog.TODO())
+	ruleName := defaultNamer.FirewallRule()
+
+	if err := fp.Sync([]string{"node-1"}, nil, nil, true); err != nil {
+		t.Fatalf("fp.Sync() = %v, want nil", err)
+	}
+	policy, _ := client.GetFirewallPolicy(testFirewallPolicy)
+	rule := findPolicyRule(policy, ruleName)
+	if rule == nil {
+		t.Fatalf("Rule %s not found in policy %+v", ruleName, policy)
+	}
+	want := &compute.FirewallPolicyRule{
+		RuleName:    ruleName,
+		Description: "GCE L7 firewall rule",
+		Direction:   "INGRESS",
+		Action:      "allow",
+		Priority:    100,
+		Match: &compute.FirewallPolicyRuleMatcher{
+			SrcIpRanges:   srcRanges,
+			Layer4Configs: []*compute.FirewallPolicyRuleMatcherLayer4Config{{IpProtocol: "tcp", Ports: []string{"30000-32767"}}},
+		},
+		TargetSecureTags: []*compute.FirewallPolicyRuleSecureTag{{Name: "tagValues/1"}, {Name: "tagValues/2"}},
+	}
+	if eq, err := equalPolicyRules(want, rule, false); !eq || err != nil {
+		t.Errorf("equalPolicyRules() = %v, %v, want true, nil; got rule %+v", eq, err, rule)
+	}
+
+	// Adding a port patches the rule in place.
+	if err := fp.Sync([]string{"node-1"}, []string{"8080"}, nil, true); err != nil {
+		t.Fatalf("fp.Sync() = %v, want nil", err)
+	}
+	if len(policy.Rules) != 1 {
+		t.Fatalf("Got %d rules in policy, want 1", len(policy.Rules))
+	}
+	want.Match.Layer4Configs[0].Ports = []string{"30000-32767", "8080"}
+	if eq, err := equalPolicyRules(want, policy.Rules[0], false); !eq || err != nil {
+		t.Errorf("equalPolicyRules() = %v, %v, want true, nil; got rule %+v", eq, err, policy.Rules[0])
+	}
+
+	if err := fp.GC(); err != nil {
+		t.Fatalf("fp.GC() = %v, want nil", err)
+	}
+	if len(policy.Rules) != 0 {
+		t.Errorf("Got rules %+v after GC, want none", policy.Rules)
+	}
+	// GC is idempotent.
+	if err := fp.GC(); err != nil {
+		t.Errorf("fp.GC() = %v, want nil", err)
+	}
+}
+
+func TestFirewallPolicyPoolForbidden(t *testing.T) {
+	setFirewallPolicyFlags(t, 100, 200, "")
+	client := NewFakeFirewallPolicyClient(true, testFirewallPolicy)
+	fp := NewFirewallPolicyPool(client, NewFakeFirewallsProvider(false, false), defaultNamer, srcRanges, []string{"30000-32767"}, klog.TODO())
+
+	err := fp.Sync([]string{"node-1"}, nil, nil, true)
+	fwErr, ok := err.(*FirewallXPNError)
+	if !ok {
+		t.Fatalf("fp.Sync() = %v, want *FirewallXPNError", err)
+	}
+	wantCmd := "gcloud compute network-firewall-policies rules create 100 --firewall-policy test-policy --global-firewall-policy"
+	if !strings.Contains(fwErr.Message, wantCmd) {
+		t.Errorf("Got message %q, want it to contain %q", fwErr.Message, wantCmd)
+	}
+}
+
+func TestEnsureL4FirewallPolicyRule(t *testing.T) {
+	setFirewallPolicyFlags(t, 1000, 1002, "")
+	client := NewFakeFirewallPolicyClient(false, testFirewallPolicy)
+	oldNewClient := newFirewallPolicyClient
+	t.Cleanup(func() { newFirewallPolicyClient = oldNewClient })
+	newFirewallPolicyClient = func(*gce.Cloud) FirewallPolicyClient { return client }
+	cloud := gce.NewFakeGCECloud(test.DefaultTestClusterValues())
+	nsName := utils.ServiceKeyFunc("test-ns", "test-name")
+
+	allowParams := &FirewallParams{
+		Name:         "allow-rule",
+		IP:           "10.0.0.1",
+		SourceRanges: []string{"10.1.2.8/29"},
+		Allowed:      []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"8080"}}},
+		L4Type:       utils.XLB,
+	}
+	denyParams := &FirewallParams{
+		Name:         "deny-rule",
+		IP:           "10.0.0.1",
+		SourceRanges: []string{"0.0.0.0/0"},
+		Denied:       []*compute.FirewallDenied{{IPProtocol: "all"}},
+		L4Type:       utils.XLB,
+	}
+	for _, tc := range []struct {
+		params     *FirewallParams
+		wantStatus l4utils.ResourceSyncStatus
+	}{
+		{params: allowParams, wantStatus: l4utils.ResourceUpdate},
+		{params: denyParams, wantStatus: l4utils.ResourceUpdate},
+		{params: allowParams, wantStatus: l4utils.ResourceResync},
+	} {
+		status, err := EnsureL4FirewallRule(cloud, nsName, tc.params, false, klog.TODO())
+		if err != nil {
+			t.Fatalf("EnsureL4FirewallRule(%s) = _, %v, want _, nil", tc.params.Name, err)
+		}
+		if status != tc.wantStatus {
+			t.Errorf("EnsureL4FirewallRule(%s) = %v, _, want %v, _", tc.params.Name, status, tc.wantStatus)
+		}
+	}
+
+	policy, _ := client.GetFirewallPolicy(testFirewallPolicy)
+	gotPriorities := map[string]int64{}
+	gotActions := map[string]string{}
+	for _, rule := range policy.Rules {
+		gotPriorities[rule.RuleName] = rule.Priority
+		gotActions[rule.RuleName] = rule.Action
+	}
+	if diff := cmp.Diff(map[string]int64{"allow-rule": 1000, "deny-rule": 1002}, gotPriorities); diff != "" {
+		t.Errorf("Unexpected rule priorities (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"allow-rule": "allow", "deny-rule": "deny"}, gotActions); diff != "" {
+		t.Errorf("Unexpected rule actions (-want +got):\n%s", diff)
+	}
+
+	// Only one priority is left in the range.
+	if _, err := EnsureL4FirewallRule(cloud, nsName, &FirewallParams{Name: "another-rule", Allowed: allowParams.Allowed, L4Type: utils.XLB}, false, klog.TODO()); err != nil {
+		t.Fatalf("EnsureL4FirewallRule(another-rule) = _, %v, want _, nil", err)
+	}
+	if _, err := EnsureL4FirewallRule(cloud, nsName, &FirewallParams{Name: "no-priority-rule", Allowed: allowParams.Allowed, L4Type: utils.XLB}, false, klog.TODO()); err == nil {
+		t.Errorf("EnsureL4FirewallRule(no-priority-rule) = _, nil, want error for exhausted priority range")
+	}
+
+	for _, name := range []string{"allow-rule", "deny-rule", "another-rule", "missing-rule"} {
+		if err := EnsureL4FirewallRuleDeleted(cloud, name, klog.TODO()); err != nil {
+			t.Errorf("EnsureL4FirewallRuleDeleted(%s) = %v, want nil", name, err)
+		}
+	}
+	if len(policy.Rules) != 0 {
+		t.Errorf("Got rules %+v after deletion, want none", policy.Rules)
+	}
+}
+
+func TestEnsureL4FirewallPolicyRulePriority(t *testing.T) {
+	setFirewallPolicyFlags(t, 1000, 1010, "tagValues/1")
+	client := NewFakeFirewallPolicyClient(false, testFirewallPolicy)
+	oldNewClient := newFirewallPolicyClient
+	t.Cleanup(func() { newFirewallPolicyClient = oldNewClient })
+	newFirewallPolicyClient = func(*gce.Cloud) FirewallPolicyClient { return client }
+	cloud := gce.NewFakeGCECloud(test.DefaultTestClusterValues())
+	nsName := utils.ServiceKeyFunc("test-ns", "test-name")
+	allowed := []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"8080"}}}
+	denied := []*compute.FirewallDenied{{IPProtocol: "all"}}
+
+	for _, params := range []*FirewallParams{
+		{Name: "deny-rule", Denied: denied, Priority: DenyTrafficPriority, L4Type: utils.XLB},
+		{Name: "allow-rule", Allowed: allowed, Priority: AllowTrafficPriority, L4Type: utils.XLB},
+		{Name: "late-allow-rule", Allowed: allowed, Priority: ptr.To(2000), L4Type: utils.XLB},
+		{Name: "early-deny-rule", Denied: denied, Priority: ptr.To(500), L4Type: utils.XLB},
+	} {
+		if _, err := EnsureL4FirewallRule(cloud, nsName, params, false, klog.TODO()); err != nil {
+			t.Fatalf("EnsureL4FirewallRule(%s) = _, %v, want _, nil", params.Name, err)
+		}
+	}
+
+	policy, _ := client.GetFirewallPolicy(testFirewallPolicy)
+	gotPriorities := map[string]int64{}
+	for _, rule := range policy.Rules {
+		gotPriorities[rule.RuleName] = rule.Priority
+	}
+	want := map[string]int64{"allow-rule": 1000, "early-deny-rule": 1001, "deny-rule": 1010, "late-allow-rule": 1009}
+	if diff := cmp.Diff(want, gotPriorities); diff != "" {
+		t.Errorf("Unexpected rule priorities (-want +got):\n%s", diff)
+	}
+}
+
+func TestEnsureFirewallPolicyRuleConflict(t *testing.T) {
+	setFirewallPolicyFlags(t, 100, 200, "tagValues/1")
+	client := NewFakeFirewallPolicyClient(false, testFirewallPolicy)
+	fpr := newFirewallPolicyRules(client, klog.TODO())
+	fw := &compute.Firewall{Name: "rule", SourceRanges: []string{"10.0.0.0/8"}, Allowed: []*compute.FirewallAllowed{{IPProtocol: "TCP", Ports: []string{"80"}}}}
+
+	// Another controller takes the allocated priority before the rule is added.
+	conflicts := 1
+	client.addRuleHook = func(policy *compute.FirewallPolicy) {
+		if conflicts > 0 {
+			conflicts--
+			policy.Rules = append(policy.Rules, &compute.FirewallPolicyRule{RuleName: "other-rule", Priority: 100})
+		}
+	}
+	status, err := fpr.ensureRule(fw, false)
+	if err != nil || status != l4utils.ResourceUpdate {
+		t.Fatalf("ensureRule() = %v, %v, want %v, nil", status, err, l4utils.ResourceUpdate)
+	}
+	policy, _ := client.GetFirewallPolicy(testFirewallPolicy)
+	if rule := findPolicyRule(policy, fw.Name); rule == nil || rule.Priority != 101 {
+		t.Errorf("Got rule %+v, want rule %s with priority 101", rule, fw.Name)
+	}
+
+	// The rule is created concurrently with the same name.
+	client.addRuleHook = func(policy *compute.FirewallPolicy) {
+		policy.Rules = append(policy.Rules, &compute.FirewallPolicyRule{RuleName: "concurrent-rule", Priority: 102, Action: "allow", Direction: "INGRESS"})
+	}
+	if _, err := fpr.ensureRule(&compute.Firewall{Name: "concurrent-rule", Allowed: fw.Allowed}, false); err != nil {
+		t.Fatalf("ensureRule(concurrent-rule) = _, %v, want _, nil", err)
+	}
+	if rule := findPolicyRule(policy, "concurrent-rule"); rule == nil || rule.Priority != 102 || len(rule.TargetSecureTags) != 1 {
+		t.Errorf("Got rule %+v, want rule concurrent-rule patched in place at priority 102", rule)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	compute "google.golang.org/api/compute/v1"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/composite/metrics"
@@ -44,6 +45,15 @@ func (fa *firewallAdapter) GetFirewall(name string) (*compute.Firewall, error) {
 	return v, mc.Observe(err)
 }
 
+// ListFirewalls returns all Firewalls in the project.
+func (fa *firewallAdapter) ListFirewalls() ([]*compute.Firewall, error) {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("firewall", "list", "<n/a>", "<n/a>", "<n/a>")
+	v, err := fa.gc.Compute().Firewalls().List(ctx, filter.None)
+	return v, mc.Observe(err)
+}
+
 // CreateFirewall creates the passed firewall
 func (fa *firewallAdapter) CreateFirewall(f *compute.Firewall) error {
 	ctx, cancel := cloud.ContextWithCallTimeout()
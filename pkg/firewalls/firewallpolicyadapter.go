@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewalls
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite/metrics"
+)
+
+// firewallPolicyAdapter accesses network firewall policies of the network
+// project through the GA compute API.
+type firewallPolicyAdapter struct {
+	gc *gce.Cloud
+}
+
+// NewFirewallPolicyAdapter takes a Cloud and constructs a firewallPolicyAdapter.
+func NewFirewallPolicyAdapter(g *gce.Cloud) *firewallPolicyAdapter {
+	return &firewallPolicyAdapter{
+		gc: g,
+	}
+}
+
+// GetFirewallPolicy returns the network firewall policy by name.
+func (fpa *firewallPolicyAdapter) GetFirewallPolicy(policy string) (*compute.FirewallPolicy, error) {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("firewallpolicy", "get", "<n/a>", "<n/a>", "<n/a>")
+	v, err := fpa.gc.ComputeServices().GA.NetworkFirewallPolicies.Get(fpa.NetworkProjectID(), policy).Context(ctx).Do()
+	return v, mc.Observe(err)
+}
+
+// AddFirewallPolicyRule inserts the rule into the network firewall policy.
+func (fpa *firewallPolicyAdapter) AddFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("firewallpolicy", "add_rule", "<n/a>", "<n/a>", "<n/a>")
+	op, err := fpa.gc.ComputeServices().GA.NetworkFirewallPolicies.AddRule(fpa.NetworkProjectID(), policy, rule).Context(ctx).Do()
+	return mc.Observe(fpa.wait(ctx, op, err))
+}
+
+// PatchFirewallPolicyRule patches the rule of the network firewall policy with the same priority.
+func (fpa *firewallPolicyAdapter) PatchFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("firewallpolicy", "patch_rule", "<n/a>", "<n/a>", "<n/a>")
+	op, err := fpa.gc.ComputeServices().GA.NetworkFirewallPolicies.PatchRule(fpa.NetworkProjectID(), policy, rule).Priority(rule.Priority).Context(ctx).Do()
+	return mc.Observe(fpa.wait(ctx, op, err))
+}
+
+// RemoveFirewallPolicyRule removes the rule with the given priority from the network firewall policy.
+func (fpa *firewallPolicyAdapter) RemoveFirewallPolicyRule(policy string, priority int64) error {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("firewallpolicy", "remove_rule", "<n/a>", "<n/a>", "<n/a>")
+	op, err := fpa.gc.ComputeServices().GA.NetworkFirewallPolicies.RemoveRule(fpa.NetworkProjectID(), policy).Priority(priority).Context(ctx).Do()
+	return mc.Observe(fpa.wait(ctx, op, err))
+}
+
+// NetworkProjectID returns the project owning the network and its firewall policies.
+func (fpa *firewallPolicyAdapter) NetworkProjectID() string {
+	return fpa.gc.NetworkProjectID()
+}
+
+// wait blocks until the global operation returned by a rule mutation is done.
+func (fpa *firewallPolicyAdapter) wait(ctx context.Context, op *compute.Operation, err error) error {
+	if err != nil {
+		return err
+	}
+	for op.Status != "DONE" {
+		op, err = fpa.gc.ComputeServices().GA.GlobalOperations.Wait(fpa.NetworkProjectID(), op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return fmt.Errorf("operation %s failed: %s: %s", op.Name, op.Error.Errors[0].Code, op.Error.Errors[0].Message)
+	}
+	return nil
+}
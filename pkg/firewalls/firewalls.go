@@ -52,6 +52,10 @@ type FirewallRules struct {
 // cloud: the cloud object implementing Firewall.
 // namer: cluster namer.
 func NewFirewallPool(cloud Firewall, namer *namer_util.Namer, l7SrcRanges []string, nodePortRanges []string, logger klog.Logger) SingleFirewallPool {
+	return newFirewallRules(cloud, namer, l7SrcRanges, nodePortRanges, logger)
+}
+
+func newFirewallRules(cloud Firewall, namer *namer_util.Namer, l7SrcRanges []string, nodePortRanges []string, logger klog.Logger) *FirewallRules {
 	_, err := netset.ParseIPNets(l7SrcRanges...)
 	if err != nil {
 		klog.Fatalf("Could not parse L7 src ranges %v for firewall rule: %v", l7SrcRanges, err)
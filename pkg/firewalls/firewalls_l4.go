@@ -22,6 +22,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
 	"k8s.io/ingress-gce/pkg/network"
@@ -45,6 +46,9 @@ type FirewallParams struct {
 
 func EnsureL4FirewallRule(cloud *gce.Cloud, nsName string, params *FirewallParams, sharedRule bool, fwLogger klog.Logger) (l4utils.ResourceSyncStatus, error) {
 	fwLogger = fwLogger.WithValues("l4Type", params.L4Type.ToString())
+	if UseFirewallPolicy() {
+		return ensureL4FirewallPolicyRule(cloud, nsName, params, sharedRule, fwLogger)
+	}
 	fa := NewFirewallAdapter(cloud)
 	existingFw, err := fa.GetFirewall(params.Name)
 	if err != nil && !utils.IsNotFoundError(err) {
@@ -100,6 +104,28 @@ func EnsureL4FirewallRule(cloud *gce.Cloud, nsName string, params *FirewallParam
 	return l4utils.ResourceUpdate, err
 }
 
+// ensureL4FirewallPolicyRule is the equivalent of EnsureL4FirewallRule writing
+// the rule into the network firewall policy set by --firewall-policy.
+func ensureL4FirewallPolicyRule(cloud *gce.Cloud, nsName string, params *FirewallParams, sharedRule bool, fwLogger klog.Logger) (l4utils.ResourceSyncStatus, error) {
+	fwDesc, err := utils.MakeL4LBFirewallDescription(nsName, params.IP, meta.VersionGA, sharedRule)
+	if err != nil {
+		fwLogger.Info("EnsureL4FirewallRule: failed to generate description for L4 rule", "err", err)
+	}
+	expectedFw := &compute.Firewall{
+		Name:         params.Name,
+		Description:  fwDesc,
+		SourceRanges: params.SourceRanges,
+		Allowed:      params.Allowed,
+		Denied:       params.Denied,
+		Priority:     priority(params.Priority),
+	}
+	if flags.F.EnablePinhole {
+		expectedFw.DestinationRanges = params.DestinationRanges
+	}
+	// Don't compare the "description" field for shared firewall rules
+	return newFirewallPolicyRules(newFirewallPolicyClient(cloud), fwLogger).ensureRule(expectedFw, sharedRule)
+}
+
 func priority(wantPriority *int) int64 {
 	const defaultPriority = 1000
 	if wantPriority == nil {
@@ -109,6 +135,9 @@ func priority(wantPriority *int) int64 {
 }
 
 func EnsureL4FirewallRuleDeleted(cloud *gce.Cloud, fwName string, fwLogger klog.Logger) error {
+	if UseFirewallPolicy() {
+		return newFirewallPolicyRules(newFirewallPolicyClient(cloud), fwLogger).ensureRuleDeleted(fwName)
+	}
 	fa := NewFirewallAdapter(cloud)
 	if err := utils.IgnoreHTTPNotFound(fa.DeleteFirewall(fwName)); err != nil {
 		if utils.IsForbiddenError(err) && cloud.OnXPN() {
@@ -126,7 +155,7 @@ func ensureFirewall(svc *v1.Service, shared bool, params *FirewallParams, cloud
 	updateStatus, err := EnsureL4FirewallRule(cloud, nsName, params, shared, fwLogger)
 	if err != nil {
 		if fwErr, ok := err.(*FirewallXPNError); ok {
-			recorder.Event(svc, v1.EventTypeNormal, "XPN", fwErr.Message)
+			recorder.Event(svc, v1.EventTypeNormal, events.ReasonXPN, fwErr.Message)
 			return updateStatus, nil
 		}
 		return updateStatus, err
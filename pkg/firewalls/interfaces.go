@@ -42,3 +42,12 @@ type Firewall interface {
 	// OnXPN returns true if the GCE NetworkProjectID != ProjectID.
 	OnXPN() bool
 }
+
+// FirewallPolicyClient interfaces with the GCE network firewall policy api.
+type FirewallPolicyClient interface {
+	GetFirewallPolicy(policy string) (*compute.FirewallPolicy, error)
+	AddFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error
+	PatchFirewallPolicyRule(policy string, rule *compute.FirewallPolicyRule) error
+	RemoveFirewallPolicyRule(policy string, priority int64) error
+	NetworkProjectID() string
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/klog/v2"
+)
+
+const (
+	L7LBTypeLabel = "L7"
+	L4LBTypeLabel = "L4"
+
+	DriftFindingLabel  = "Drift"
+	OrphanFindingLabel = "Orphan"
+
+	ReportedActionLabel = "Reported"
+	RepairedActionLabel = "Repaired"
+	DeletedActionLabel  = "Deleted"
+	FailedActionLabel   = "Failed"
+)
+
+var (
+	firewallAuditFindings = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "firewall_audit_findings",
+			Help: "Count of firewall rules found drifted or orphaned by the firewall audit",
+		},
+		[]string{"lb_type", "finding", "action"},
+	)
+	firewallAuditManagedRules = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "firewall_audit_managed_rules",
+			Help: "Number of firewall rules managed by the controller, observed during the last firewall audit",
+		},
+		[]string{"lb_type"},
+	)
+)
+
+// init metrics.
+func init() {
+	klog.V(3).Infof("Registering firewall audit findings metric: %v", firewallAuditFindings)
+	prometheus.MustRegister(firewallAuditFindings)
+	klog.V(3).Infof("Registering firewall audit managed rules metric: %v", firewallAuditManagedRules)
+	prometheus.MustRegister(firewallAuditManagedRules)
+}
+
+// PublishFinding counts a firewall rule found drifted or orphaned by the audit and the action taken on it.
+func PublishFinding(lbType, finding, action string) {
+	firewallAuditFindings.WithLabelValues(lbType, finding, action).Inc()
+}
+
+// PublishManagedRules sets the number of firewall rules managed by the controller.
+func PublishManagedRules(lbType string, count int) {
+	firewallAuditManagedRules.WithLabelValues(lbType).Set(float64(count))
+}
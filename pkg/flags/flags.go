@@ -65,6 +65,8 @@ var F = struct {
 	GCEOperationPollInterval     time.Duration
 	GCERateLimit                 RateLimitSpecs
 	GCERateLimitScale            float64
+	GCEProjectQPS                float64
+	GCEProjectBurst              int
 	GKEClusterName               string
 	GKEClusterHash               string
 	GKEClusterType               string
@@ -76,6 +78,7 @@ var F = struct {
 	KubeConfigFile               string
 	NegGCPeriod                  time.Duration
 	NumNegGCWorkers              int
+	NEGShards                    int
 	NodePortRanges               PortRanges
 	ResyncPeriod                 time.Duration
 	L4NetLBProvisionDeadline     time.Duration
@@ -93,6 +96,8 @@ var F = struct {
 	LeaderElection               LeaderElectionConfiguration
 	MetricsExportInterval        time.Duration
 	NegMetricsExportInterval     time.Duration
+	TracingOTLPEndpoint          string
+	TracingSampleRatio           float64
 	KubeClientQPS                float32
 	KubeClientBurst              int
 	ReadOnlyMode                 bool
@@ -118,6 +123,14 @@ var F = struct {
 	GateNEGByLock                               bool
 	GateL4ByLock                                bool
 	EnableMultipleIGs                           bool
+	EnableTracing                               bool
+	EnableFairQueuing                           bool
+	EventDedupWindow                            time.Duration
+	EventQPSPerObject                           float64
+	EventBurstPerObject                         int
+	IGAdoptionNamePrefixes                      string
+	IGAdoptionLabel                             string
+	IGNamedPortGCPeriod                         time.Duration
 	EnableL4StrongSessionAffinity               bool
 	EnableNEGLabelPropagation                   bool
 	EnableMultiNetworking                       bool
@@ -137,8 +150,10 @@ var F = struct {
 	NodeTopologyCRName                          string
 	EnableWeightedL4ILB                         bool
 	EnableWeightedL4NetLB                       bool
+	PublishL4EndpointWeights                    bool
 	EnableDiscretePortForwarding                bool
 	EnableMultiProjectMode                      bool
+	EnableMultiProjectLBControllers             bool
 	EnableL4ILBZonalAffinity                    bool
 	ProviderConfigNameLabelKey                  string
 	EnableL4ILBMixedProtocol                    bool
@@ -150,6 +165,7 @@ var F = struct {
 	MultiProjectOwnerLabelKey                   string
 	OverrideHealthCheckSourceCIDRs              string
 	ManageL4LBLogging                           bool
+	ManageL4LBConnectionTracking                bool
 	EnableNEGsForIngress                        bool
 	L4ILBLegacyHeadStartTime                    time.Duration
 	EnableIPv6NodeNEGEndpoints                  bool
@@ -157,6 +173,12 @@ var F = struct {
 	EnableNEGPreprovisioning                    bool
 	EnablePSCReconcileConnections               bool
 	EnableL4NEGLocalIncludeDrainNodes           bool
+	FirewallAuditPeriod                         time.Duration
+	EnableFirewallAuditRepair                   bool
+	FirewallPolicyName                          string
+	FirewallPolicyPriorityStart                 int
+	FirewallPolicyPriorityEnd                   int
+	FirewallPolicyTargetSecureTags              string
 	// EnableL4DenyFirewallExplicitlySet will be set to true if the argument was explicitly set by the user.
 	EnableL4DenyFirewallExplicitlySet bool
 	EnableL4NetLBRBSByDefault         bool
@@ -173,6 +195,7 @@ var F = struct {
 	DeprecatedEnableFrontendConfig bool
 }{
 	GCERateLimitScale: 1.0,
+	GCEProjectBurst:   10,
 }
 
 type LeaderElectionConfiguration struct {
@@ -260,6 +283,10 @@ additional delay if needed.`)
 	flag.Float64Var(&F.GCERateLimitScale, "gce-ratelimit-scale", 1.0,
 		`Optional, scales rate limit options by a constant factor.
 1.0 is no multiplier. 5.0 means increase all rate and capacity by 5x.`)
+	flag.Float64Var(&F.GCEProjectQPS, "gce-project-qps", 0,
+		`Rate of GCE API calls to a project, shared by all controllers on top of the limits of --gce-ratelimit. Calls of deletions and of syncs of changed objects are let through before the calls of periodic resyncs and garbage collection. Calls made through the gce.Cloud wrappers, such as those of firewalls, do not carry the priority of their sync and are let through at normal priority, between the two. The rate is cut on quota errors and the Retry-After header of GCE is honored. Disabled when 0.`)
+	flag.IntVar(&F.GCEProjectBurst, "gce-project-burst", 10,
+		`Number of GCE API calls to a project which can be made at once when --gce-project-qps is set.`)
 	flag.DurationVar(&F.GCEOperationPollInterval, "gce-operation-poll-interval", time.Second,
 		`Minimum time between polling requests to GCE for checking the status of an operation.`)
 	flag.StringVar(&F.HealthCheckPath, "health-check-path", "/",
@@ -297,6 +324,8 @@ L7 load balancing. CSV values accepted. Example: -node-port-ranges=80,8080,400-5
 	flag.DurationVar(&F.NegGCPeriod, "neg-gc-period", 120*time.Second,
 		`Relist and garbage collect NEGs this often.`)
 	flag.IntVar(&F.NumNegGCWorkers, "num-neg-gc-workers", 10, "Number of goroutines created by NEG garbage collector. This value controls the maximum number of concurrent calls made to the GCE NEG Delete API.")
+	flag.IntVar(&F.NEGShards, "neg-shards", 0,
+		`Number of shards the Services processed by the NEG controller are split into by namespace and name. When greater than 1, each glbc replica runs the NEG controller for the shards whose leases it holds, and shards are rebalanced when replicas come and go. Requires --leader-elect. The value must be the same on all replicas.`)
 	flag.BoolVar(&F.EnableReadinessReflector, "enable-readiness-reflector", true, "Enable NEG Readiness Reflector")
 	flag.BoolVar(&F.FinalizerAdd, "enable-finalizer-add",
 		F.FinalizerAdd, "Enable adding Finalizer to Ingress.")
@@ -337,10 +366,20 @@ L7 load balancing. CSV values accepted. Example: -node-port-ranges=80,8080,400-5
 	// External L4 Load Balancer, please contact Google Cloud support team.
 	flag.BoolVar(&F.EnableL4StrongSessionAffinity, "enable-l4lb-strong-sa", false, "Enable Strong Session Affinity for L4 External Load Balancers. The feature is restricted for allow-listed clusters only.")
 	flag.BoolVar(&F.EnableMultipleIGs, "enable-multiple-igs", false, "Enable using multiple unmanaged instance groups")
+	flag.StringVar(&F.IGAdoptionNamePrefixes, "ig-adoption-name-prefixes", "", "Comma-separated list of name prefixes of existing unmanaged instance groups to adopt. Cluster nodes which are members of adopted instance groups are left there and linked to backends through them.")
+	flag.StringVar(&F.IGAdoptionLabel, "ig-adoption-label", "", "Label in key=value form that marks existing unmanaged instance groups to adopt. Unmanaged instance groups do not support labels, so the label is matched against the instance group description.")
+	flag.DurationVar(&F.IGNamedPortGCPeriod, "ig-named-port-gc-period", 0, "Period of the instance group controller pruning named ports not used by any GCE Ingress from the cluster instance groups and their shards. Disabled when 0. Requires the ingress controller to be enabled.")
 	flag.BoolVar(&F.EnableMultiNetworking, "enable-multi-networking", false, "Enable support for multi-networking L4 load balancers.")
 	flag.IntVar(&F.MaxIGSize, "max-ig-size", 1000, "Max number of instances in Instance Group")
 	flag.DurationVar(&F.MetricsExportInterval, "metrics-export-interval", 10*time.Minute, `Period for calculating and exporting metrics related to state of managed objects.`)
 	flag.DurationVar(&F.NegMetricsExportInterval, "neg-metrics-export-interval", 5*time.Second, `Period for calculating and exporting internal neg controller metrics, not usage.`)
+	flag.BoolVar(&F.EnableFairQueuing, "enable-fair-queuing", false, `Enable fair queuing in the Ingress, L4 and PSC controller queues: namespaces, or ProviderConfigs in multi-project mode, are served in turn, and changes made by users are processed before periodic resyncs and retries.`)
+	flag.DurationVar(&F.EventDedupWindow, "event-dedup-window", 0, `Time during which identical Kubernetes events of an object are recorded only once. Disabled when 0.`)
+	flag.Float64Var(&F.EventQPSPerObject, "event-qps-per-object", 0, `Rate limit of the Kubernetes events recorded for each object, in events per second. Disabled when 0.`)
+	flag.IntVar(&F.EventBurstPerObject, "event-burst-per-object", 10, `Burst of the Kubernetes events recorded for each object when --event-qps-per-object is set.`)
+	flag.BoolVar(&F.EnableTracing, "enable-tracing", false, `Enable exporting OpenTelemetry traces of controller syncs and the GCE API calls they make to the collector at --tracing-otlp-endpoint.`)
+	flag.StringVar(&F.TracingOTLPEndpoint, "tracing-otlp-endpoint", "http://localhost:4317", `URL of the OTLP gRPC collector receiving traces when --enable-tracing is set.`)
+	flag.Float64Var(&F.TracingSampleRatio, "tracing-sample-ratio", 0.1, `Fraction of controller syncs which are traced when --enable-tracing is set, between 0 and 1.`)
 	flag.BoolVar(&F.EnableDegradedMode, "enable-degraded-mode", false, `Enable degraded mode endpoint calculation and use results when error state is triggered. enabledDegradedMode also enables degrade mode correctness metrics with or without enabledDegradedModeMetrics.`)
 	flag.BoolVar(&F.EnableDegradedModeMetrics, "enable-degraded-mode-metrics", false, `Enable metrics collection for degraded mode, but uses normal mode calculation result when error state is triggered.`)
 	flag.BoolVar(&F.EnableNEGLabelPropagation, "enable-label-propagation", false, "Enable NEG endpoint label propagation")
@@ -357,11 +396,13 @@ L7 load balancing. CSV values accepted. Example: -node-port-ranges=80,8080,400-5
 	flag.StringVar(&F.NodeTopologyCRName, "node-topology-cr-name", "default", "The name of the Node Topology CR.")
 	flag.BoolVar(&F.EnableWeightedL4ILB, "enable-weighted-l4-ilb", false, "Enable Weighted Load balancing for L4 ILB.")
 	flag.BoolVar(&F.EnableWeightedL4NetLB, "enable-weighted-l4-netlb", false, "EnableWeighted Load balancing for  L4 NetLB .")
+	flag.BoolVar(&F.PublishL4EndpointWeights, "publish-l4-endpoint-weights", false, "Publish the weights of the nodes of endpoint-hints Weighted L4 NEGs as Node annotations, for the health check responder of the nodes to report them. Only enable it when the nodes run a responder reading these annotations.")
 	flag.BoolVar(&F.EnableL4ILBZonalAffinity, "enable-l4ilb-zonal-affinity", false, "Enable Zonal Affinity for L4 ILB.")
 	flag.Float32Var(&F.KubeClientQPS, "kube-client-qps", 0.0, "The QPS that the controllers' kube client should adhere to through client side throttling. If zero, client will be created with default settings.")
 	flag.IntVar(&F.KubeClientBurst, "kube-client-burst", 0, "The burst QPS that the controllers' kube client should adhere to through client side throttling. If zero, client will be created with default settings.")
 	flag.BoolVar(&F.EnableDiscretePortForwarding, "enable-discrete-port-forwarding", false, "Enable forwarding of individual ports instead of port ranges.")
 	flag.BoolVar(&F.EnableMultiProjectMode, "enable-multi-project-mode", false, "Enable running in multi-project mode.")
+	flag.BoolVar(&F.EnableMultiProjectLBControllers, "enable-multi-project-lb-controllers", false, "Enable running the Ingress, L4 and PSC controllers for each ProviderConfig in multi-project mode. The controllers are selected with --run-ingress-controller, --run-l4-controller, --run-l4-netlb-controller and --enable-psc.")
 	flag.BoolVar(&F.EnableL4ILBMixedProtocol, "enable-l4ilb-mixed-protocol", false, "Enable support for mixed protocol L4 internal load balancers.")
 	flag.BoolVar(&F.EnableL4NetLBMixedProtocol, "enable-l4netlb-mixed-protocol", false, "Enable support for mixed protocol L4 external load balancers.")
 	flag.BoolVar(&F.EnableL3ForwardingRuleForNetLBMixedProtocol, "enable-l3-default-forwarding-rule-for-netlb-mixed-protocol", false, "Enable support for mixed protocol L4 external load balancers using L3_DEFAULT forwarding rule. This has multiple benefits over the previous NetLB mixed protocol implementation, including lower cost and no traffic interruption on mixed-to-mixed port changes. This flag will eventually be a default implementation for --enable-l4netlb-mixed-protocol.")
@@ -372,6 +413,13 @@ L7 load balancing. CSV values accepted. Example: -node-port-ranges=80,8080,400-5
 	flag.StringVar(&F.MultiProjectOwnerLabelKey, "multi-project-owner-label-key", "multiproject.gke.io/owner", "The label key for multi-project owner, which is used to identify the owner of objects in multi-project mode.")
 	flag.StringVar(&F.OverrideHealthCheckSourceCIDRs, "override-health-check-src-cidrs", "", "Overrides the default source IP ranges used when configuring firewall rules to allow health check probes for L7 load balancers. Provide the ranges as a comma-separated list of CIDRs. Example: --override-health-check-src-cidrs=130.211.0.0/22,35.191.0.0/16")
 	flag.BoolVar(&F.ManageL4LBLogging, "manage-l4lb-logging", false, "Manage L4 ILB/NetLB logging.")
+	flag.DurationVar(&F.FirewallAuditPeriod, "firewall-audit-period", 0, "Period of auditing firewall rules managed by the controller against the rules expected for live Ingresses and Services. Auditing is disabled when set to 0.")
+	flag.BoolVar(&F.EnableFirewallAuditRepair, "enable-firewall-audit-repair", false, "Repair drifted and delete orphaned firewall rules found by the firewall audit. If false, findings are only reported.")
+	flag.StringVar(&F.FirewallPolicyName, "firewall-policy", "", "Name of the network firewall policy, in the network project, to write L7 and L4 firewall rules into instead of creating VPC firewall rules. VPC firewall rules are used when empty.")
+	flag.IntVar(&F.FirewallPolicyPriorityStart, "firewall-policy-priority-start", 1000, "Lowest priority the controller allocates to rules in the network firewall policy set by --firewall-policy.")
+	flag.IntVar(&F.FirewallPolicyPriorityEnd, "firewall-policy-priority-end", 65534, "Highest priority the controller allocates to rules in the network firewall policy set by --firewall-policy.")
+	flag.StringVar(&F.FirewallPolicyTargetSecureTags, "firewall-policy-target-secure-tags", "", "Comma-separated list of secure tag values (tagValues/123) that rules in the network firewall policy set by --firewall-policy apply to. Required with --firewall-policy.")
+	flag.BoolVar(&F.ManageL4LBConnectionTracking, "manage-l4lb-connection-tracking", false, "Manage L4 ILB/NetLB connection tracking policy from L4LBConfig. The policy of services without ConnectionTracking in their L4LBConfig is reset to the GCE default.")
 	flag.BoolVar(&F.ReadOnlyMode, "read-only-controllers", false, "When enabled, this flag runs the IG, NEG, L4 ILB, and L4 NetLB controllers in a read-only mode. This prevents them from executing any mutating API calls (e.g., create, update, delete), allowing you to safely observe controller behavior without modifying resources. The Ingress controller is exempt from this mode.")
 	flag.BoolVar(&F.EnableNEGsForIngress, "enable-negs-for-ingress", true, "Allow the NEG controller to create NEGs for Ingress services.")
 	flag.DurationVar(&F.L4ILBLegacyHeadStartTime, "prevent-legacy-race-l4-ilb", 0*time.Second, "Delay before processing new L4 ILB services without existing finalizers. This gives the legacy controller a head start to claim the service, preventing a race condition upon service creation.")
@@ -393,6 +441,18 @@ func Validate() {
 		klog.Fatalf("The flag --transparent-health-checks-port cannot be used without --enable-transparent-health-checks.")
 	}
 
+	if F.GCEProjectQPS > 0 && F.GCEProjectBurst < 1 {
+		klog.Fatalf("The flag --gce-project-burst must be at least 1 when --gce-project-qps is set, got %d.", F.GCEProjectBurst)
+	}
+
+	if F.NEGShards > 1 && !F.LeaderElection.LeaderElect {
+		klog.Fatalf("The flag --neg-shards requires --leader-elect.")
+	}
+
+	if F.TracingSampleRatio < 0 || F.TracingSampleRatio > 1 {
+		klog.Fatalf("The flag --tracing-sample-ratio must be between 0 and 1, got %v.", F.TracingSampleRatio)
+	}
+
 	if err := validation.ValidateHealthCheckSourceCIDRs(F.OverrideHealthCheckSourceCIDRs); err != nil {
 		klog.Fatalf("Invalid --override-health-check-src-cidrs flag: %v", err)
 	}
@@ -401,6 +461,14 @@ func Validate() {
 		klog.Fatalf("The flag --enable-l4-deny-firewall requires --enable-pinhole and --enable-l4-deny-firewall-rollback-cleanup to be true.")
 	}
 
+	if F.FirewallPolicyName != "" && (F.FirewallPolicyPriorityStart < 0 || F.FirewallPolicyPriorityStart > F.FirewallPolicyPriorityEnd || F.FirewallPolicyPriorityEnd > 2147483647) {
+		klog.Fatalf("Invalid firewall policy priority range [%d, %d].", F.FirewallPolicyPriorityStart, F.FirewallPolicyPriorityEnd)
+	}
+
+	if F.FirewallPolicyName != "" && strings.Trim(F.FirewallPolicyTargetSecureTags, ", ") == "" {
+		klog.Fatalf("The flag --firewall-policy requires --firewall-policy-target-secure-tags, rules without target secure tags apply to all instances in the network.")
+	}
+
 	if F.EnableL3ForwardingRuleForNetLBMixedProtocol && !F.EnableL4NetLBMixedProtocol {
 		klog.Fatalf("The flag --enable-l3-default-forwarding-rule-for-netlb-mixed-protocol requires --enable-l4netlb-mixed-protocol to be true.")
 	}
@@ -78,6 +78,9 @@ func calculateDiff(old, new *translator.HealthCheck, c *backendconfigv1.HealthCh
 	if (fullDiffOnRecalculation || c.RequestPath != nil) && old.RequestPath != new.RequestPath {
 		changes.add("RequestPath", old.RequestPath, new.RequestPath)
 	}
+	if (fullDiffOnRecalculation || c.GRPCServiceName != nil) && old.GRPCServiceName != new.GRPCServiceName {
+		changes.add("GRPCServiceName", old.GRPCServiceName, new.GRPCServiceName)
+	}
 	if (fullDiffOnRecalculation || c.Port != nil) && old.Port != new.Port {
 		changes.add("Port", strconv.FormatInt(old.Port, 10), strconv.FormatInt(new.Port, 10))
 	}
@@ -106,6 +109,9 @@ func mergeUserSettings(existing, newHC *translator.HealthCheck) *translator.Heal
 	hc := *newHC // return a copy
 
 	hc.HTTPHealthCheck = existing.HTTPHealthCheck
+	if existing.Protocol() == newHC.Protocol() {
+		hc.GRPCServiceName = existing.GRPCServiceName
+	}
 	hc.HealthCheck.CheckIntervalSec = existing.HealthCheck.CheckIntervalSec
 	hc.HealthCheck.HealthyThreshold = existing.HealthCheck.HealthyThreshold
 	hc.HealthCheck.TimeoutSec = existing.HealthCheck.TimeoutSec
@@ -17,6 +17,7 @@ limitations under the License.
 package healthchecks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/translator"
 	"k8s.io/ingress-gce/pkg/utils"
@@ -140,7 +142,7 @@ func (h *HealthChecks) generateServiceInfo(sp utils.ServicePort) healthcheck.Ser
 }
 
 // SyncServicePort implements HealthChecker.
-func (h *HealthChecks) SyncServicePort(sp *utils.ServicePort, probe *v1.Probe, beLogger klog.Logger) (string, error) {
+func (h *HealthChecks) SyncServicePort(ctx context.Context, sp *utils.ServicePort, probe *v1.Probe, beLogger klog.Logger) (string, error) {
 	spLogger := beLogger.WithValues(
 		"servicePortID", sp.ID,
 		"protocol", sp.Protocol,
@@ -157,7 +159,7 @@ func (h *HealthChecks) SyncServicePort(sp *utils.ServicePort, probe *v1.Probe, b
 	hc := h.new(*sp, spLogger)
 	if sp.THCConfiguration.THCOptInOnSvc {
 		spLogger.Info("ServicePort has Transparent Health Checks enabled")
-		return h.sync(hc, nil, sp.THCConfiguration, spLogger)
+		return h.sync(ctx, hc, nil, sp.THCConfiguration, spLogger)
 	}
 	if probe != nil {
 		spLogger.Info("Applying httpGet settings of readinessProbe to health check on port", "port", fmt.Sprintf("%+v", sp))
@@ -171,7 +173,7 @@ func (h *HealthChecks) SyncServicePort(sp *utils.ServicePort, probe *v1.Probe, b
 	if bchcc != nil {
 		spLogger.Info("ServicePort has BackendConfig healthcheck override")
 	}
-	return h.sync(hc, bchcc, sp.THCConfiguration, spLogger)
+	return h.sync(ctx, hc, bchcc, sp.THCConfiguration, spLogger)
 }
 
 // emitTHCEvents emits Events about successful or attempted THC configuration.
@@ -180,25 +182,25 @@ func (h *HealthChecks) emitTHCEvents(hc *translator.HealthCheck, thcEvents utils
 	if thcEvents.THCConfigured {
 		message := "Transparent Health Check successfully configured."
 		h.recorderGetter.Recorder(hc.Service.Namespace).Event(
-			hc.Service, v1.EventTypeNormal, "THCConfigured", message)
+			hc.Service, v1.EventTypeNormal, events.ReasonTHCConfigured, message)
 		hcLogger.Info(message)
 	}
 	if thcEvents.BackendConfigOverridesTHC {
 		message := "Both THC and BackendConfig annotations present and the BackendConfig has spec.healthCheck. The THC annotation will be ignored."
 		h.recorderGetter.Recorder(hc.Service.Namespace).Event(
-			hc.Service, v1.EventTypeWarning, "BackendConfigOverridesTHC", message)
+			hc.Service, v1.EventTypeWarning, events.ReasonBackendConfigOverridesTHC, message)
 		hcLogger.Info(message)
 	}
 	if thcEvents.THCAnnotationWithoutFlag {
 		message := "THC annotation present, but the Transparent Health Checks feature is not enabled."
 		h.recorderGetter.Recorder(hc.Service.Namespace).Event(
-			hc.Service, v1.EventTypeWarning, "THCAnnotationWithoutFlag", message)
+			hc.Service, v1.EventTypeWarning, events.ReasonTHCAnnotationWithoutFlag, message)
 		hcLogger.Info(message)
 	}
 	if thcEvents.THCAnnotationWithoutNEG {
 		message := "THC annotation present, but NEG is disabled. Will not enable Transparent Health Checks."
 		h.recorderGetter.Recorder(hc.Service.Namespace).Event(
-			hc.Service, v1.EventTypeWarning, "THCAnnotationWithoutNEG", message)
+			hc.Service, v1.EventTypeWarning, events.ReasonTHCAnnotationWithoutNEG, message)
 		hcLogger.Info(message)
 	}
 
@@ -231,7 +233,7 @@ func (h *HealthChecks) shouldRecalculateHC(existingHC *translator.HealthCheck, b
 // sync retrieves a health check based on port, checks type and settings and updates/creates if necessary.
 // sync is only called by the backends.Add func - it's not a pool like other resources.
 // We assume that backendConfigHCConfig cannot be non-nil and thcOptIn be true simultaneously.
-func (h *HealthChecks) sync(hc *translator.HealthCheck, backendConfigHCConfig *backendconfigv1.HealthCheckConfig, thcConf utils.THCConfiguration, spLogger klog.Logger) (string, error) {
+func (h *HealthChecks) sync(ctx context.Context, hc *translator.HealthCheck, backendConfigHCConfig *backendconfigv1.HealthCheckConfig, thcConf utils.THCConfiguration, spLogger klog.Logger) (string, error) {
 	hcLogger := spLogger.WithValues("healthCheckName", hc.Name)
 	if backendConfigHCConfig != nil && thcConf.THCOptInOnSvc {
 		hcLogger.Info("BackendConfig exists and thcOptIn true simultaneously. Ignoring transparent health check.")
@@ -246,17 +248,17 @@ func (h *HealthChecks) sync(hc *translator.HealthCheck, backendConfigHCConfig *b
 		scope = meta.Global
 	}
 
-	existingHC, err := h.Get(hc.Name, hc.Version(), scope, hcLogger)
+	existingHC, err := h.Get(ctx, hc.Name, hc.Version(), scope, hcLogger)
 	if utils.IsHTTPErrorCode(err, http.StatusNotFound) {
 		hcLogger.Info("Health check does not exist, creating", "healthCheck", fmt.Sprintf("%+v", hc), "backendConfigHCConfig", fmt.Sprintf("%+v", backendConfigHCConfig))
-		if err = h.create(hc, backendConfigHCConfig, hcLogger); err != nil {
+		if err = h.create(ctx, hc, backendConfigHCConfig, hcLogger); err != nil {
 			hcLogger.Error(err, "Health check creation error")
 			return "", err
 		}
 		h.emitTHCEvents(hc, thcConf.THCEvents, hcLogger)
 		// TODO(bowei) -- we don't need to fetch the self-link here as it is
 		// returned as part of the GCE call.
-		selfLink, err := h.getHealthCheckLink(hc.Name, hc.Version(), scope, hcLogger)
+		selfLink, err := h.getHealthCheckLink(ctx, hc.Name, hc.Version(), scope, hcLogger)
 		hcLogger.Info("Health check selflink", "healthCheckSelfLink", selfLink)
 		return selfLink, err
 	}
@@ -310,12 +312,12 @@ func (h *HealthChecks) sync(hc *translator.HealthCheck, backendConfigHCConfig *b
 			message := fmt.Sprintf("Healthcheck will be updated and the only field updated is Description.\nOld: %+v\nNew: %+v\n", existingHC, hc)
 			if hc.Service != nil {
 				h.recorderGetter.Recorder(hc.Service.Namespace).Event(
-					hc.Service, v1.EventTypeNormal, "HealthcheckDescriptionUpdate", message)
+					hc.Service, v1.EventTypeNormal, events.ReasonHealthcheckDescriptionUpdate, message)
 			} else {
 				hcLogger.Info(message)
 			}
 		}
-		err := h.update(hc, hcLogger)
+		err := h.update(ctx, hc, hcLogger)
 		if err != nil {
 			hcLogger.Error(err, "Health check update error")
 		}
@@ -356,7 +358,7 @@ func (h *HealthChecks) isDescriptionOnlyUpdateNeeded(changes *fieldDiffs, existi
 }
 
 // TODO(shance): merge with existing hc code
-func (h *HealthChecks) createRegional(hc *translator.HealthCheck, hcLogger klog.Logger) error {
+func (h *HealthChecks) createRegional(ctx context.Context, hc *translator.HealthCheck, hcLogger klog.Logger) error {
 	alpha, err := hc.ToAlphaComputeHealthCheck()
 	if err != nil {
 		return err
@@ -374,7 +376,7 @@ func (h *HealthChecks) createRegional(hc *translator.HealthCheck, hcLogger klog.
 
 	compositeType.Version = meta.VersionGA
 	compositeType.Region = key.Region
-	err = composite.CreateHealthCheck(cloud, key, compositeType, hcLogger)
+	err = composite.CreateHealthCheck(ctx, cloud, key, compositeType, hcLogger)
 	if err != nil {
 		return fmt.Errorf("Error creating health check %v: %w", compositeType, err)
 	}
@@ -382,7 +384,7 @@ func (h *HealthChecks) createRegional(hc *translator.HealthCheck, hcLogger klog.
 	return nil
 }
 
-func (h *HealthChecks) create(hc *translator.HealthCheck, bchcc *backendconfigv1.HealthCheckConfig, hcLogger klog.Logger) error {
+func (h *HealthChecks) create(ctx context.Context, hc *translator.HealthCheck, bchcc *backendconfigv1.HealthCheckConfig, hcLogger klog.Logger) error {
 	if bchcc != nil {
 		// BackendConfig healthcheck settings always take precedence.
 		hc.UpdateFromBackendConfig(bchcc, hcLogger)
@@ -390,11 +392,11 @@ func (h *HealthChecks) create(hc *translator.HealthCheck, bchcc *backendconfigv1
 	// special case ILB to avoid mucking with stable HC code
 	if hc.ForILB {
 		hcLogger.Info("Creating ILB Health Check")
-		return h.createRegional(hc, hcLogger)
+		return h.createRegional(ctx, hc, hcLogger)
 	}
 	if hc.ForRegionalXLB {
 		hcLogger.Info("Creating XLB Regional Health Check")
-		return h.createRegional(hc, hcLogger)
+		return h.createRegional(ctx, hc, hcLogger)
 	}
 
 	switch hc.Version() {
@@ -425,7 +427,7 @@ func (h *HealthChecks) create(hc *translator.HealthCheck, bchcc *backendconfigv1
 }
 
 // TODO(shance): merge with existing hc code
-func (h *HealthChecks) updateRegional(hc *translator.HealthCheck, hcLogger klog.Logger) error {
+func (h *HealthChecks) updateRegional(ctx context.Context, hc *translator.HealthCheck, hcLogger klog.Logger) error {
 	// special case ILB to avoid mucking with stable HC code
 	alpha, err := hc.ToAlphaComputeHealthCheck()
 	if err != nil {
@@ -442,18 +444,18 @@ func (h *HealthChecks) updateRegional(hc *translator.HealthCheck, hcLogger klog.
 	compositeType.Version = meta.VersionGA
 	compositeType.Region = key.Region
 
-	return composite.UpdateHealthCheck(cloud, key, compositeType, hcLogger)
+	return composite.UpdateHealthCheck(ctx, cloud, key, compositeType, hcLogger)
 }
 
-func (h *HealthChecks) update(hc *translator.HealthCheck, hcLogger klog.Logger) error {
+func (h *HealthChecks) update(ctx context.Context, hc *translator.HealthCheck, hcLogger klog.Logger) error {
 	hcLogger = hcLogger.WithValues("healthCheck", fmt.Sprintf("%v", hc))
 	if hc.ForILB {
 		hcLogger.Info("Updating ILB Health Check")
-		return h.updateRegional(hc, hcLogger)
+		return h.updateRegional(ctx, hc, hcLogger)
 	}
 	if hc.ForRegionalXLB {
 		hcLogger.Info("Updating XLB Regional Health Check")
-		return h.updateRegional(hc, hcLogger)
+		return h.updateRegional(ctx, hc, hcLogger)
 	}
 	switch hc.Version() {
 	case meta.VersionAlpha:
@@ -482,8 +484,8 @@ func (h *HealthChecks) update(hc *translator.HealthCheck, hcLogger klog.Logger)
 	}
 }
 
-func (h *HealthChecks) getHealthCheckLink(name string, version meta.Version, scope meta.KeyType, hcLogger klog.Logger) (string, error) {
-	hc, err := h.Get(name, version, scope, hcLogger)
+func (h *HealthChecks) getHealthCheckLink(ctx context.Context, name string, version meta.Version, scope meta.KeyType, hcLogger klog.Logger) (string, error) {
+	hc, err := h.Get(ctx, name, version, scope, hcLogger)
 	if err != nil {
 		return "", err
 	}
@@ -491,7 +493,7 @@ func (h *HealthChecks) getHealthCheckLink(name string, version meta.Version, sco
 }
 
 // Delete deletes the health check by port.
-func (h *HealthChecks) Delete(name string, scope meta.KeyType, beLogger klog.Logger) error {
+func (h *HealthChecks) Delete(ctx context.Context, name string, scope meta.KeyType, beLogger klog.Logger) error {
 	hcLogger := beLogger.WithValues("healthCheckName", name)
 	if scope == meta.Regional {
 		cloud := h.cloud.(*gce.Cloud)
@@ -501,7 +503,7 @@ func (h *HealthChecks) Delete(name string, scope meta.KeyType, beLogger klog.Log
 		}
 		hcLogger.Info("Deleting regional health check")
 		// L7-ILB is the only use of regional right now
-		if err = composite.DeleteHealthCheck(cloud, key, meta.VersionGA, hcLogger); err != nil {
+		if err = composite.DeleteHealthCheck(ctx, cloud, key, meta.VersionGA, hcLogger); err != nil {
 			// Ignore error if the deletion candidate is being used by another resource.
 			// In most of the cases, this is the associated backend resource itself.
 			if utils.IsHTTPErrorCode(err, http.StatusNotFound) || utils.IsInUsedByError(err) {
@@ -528,14 +530,14 @@ func (h *HealthChecks) Delete(name string, scope meta.KeyType, beLogger klog.Log
 }
 
 // TODO(shance): merge with existing hc code
-func (h *HealthChecks) getRegional(name string, hcLogger klog.Logger) (*translator.HealthCheck, error) {
+func (h *HealthChecks) getRegional(ctx context.Context, name string, hcLogger klog.Logger) (*translator.HealthCheck, error) {
 	cloud := h.cloud.(*gce.Cloud)
 	key, err := composite.CreateKey(cloud, name, meta.Regional)
 	if err != nil {
 		return nil, err
 	}
 	// L7-ILB is the only use of regional right now
-	hc, err := composite.GetHealthCheck(cloud, key, meta.VersionGA, hcLogger)
+	hc, err := composite.GetHealthCheck(ctx, cloud, key, meta.VersionGA, hcLogger)
 	if err != nil {
 		return nil, err
 	}
@@ -553,12 +555,12 @@ func (h *HealthChecks) getRegional(name string, hcLogger klog.Logger) (*translat
 }
 
 // Get returns the health check by port
-func (h *HealthChecks) Get(name string, version meta.Version, scope meta.KeyType, hcLogger klog.Logger) (*translator.HealthCheck, error) {
+func (h *HealthChecks) Get(ctx context.Context, name string, version meta.Version, scope meta.KeyType, hcLogger klog.Logger) (*translator.HealthCheck, error) {
 	hcLogger.Info("Getting Health Check", "healthCheckVersion", version, "healthCheckScope", scope)
 
 	if scope == meta.Regional {
 		hcLogger.Info("Getting Regional Health Check")
-		return h.getRegional(name, hcLogger)
+		return h.getRegional(ctx, name, hcLogger)
 	}
 
 	var hc *computealpha.HealthCheck
@@ -623,5 +625,8 @@ func formatBackendConfigHC(b *backendconfigv1.HealthCheckConfig) string {
 	if b.RequestPath != nil {
 		ret = append(ret, fmt.Sprintf("requestPath=%q", *b.RequestPath))
 	}
+	if b.GRPCServiceName != nil {
+		ret = append(ret, fmt.Sprintf("grpcServiceName=%q", *b.GRPCServiceName))
+	}
 	return strings.Join(ret, ", ")
 }
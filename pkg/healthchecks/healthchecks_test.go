@@ -128,7 +128,7 @@ func TestHealthCheckAdd(t *testing.T) {
 	healthChecks := NewHealthChecker(fakeGCE, "/", defaultBackendSvc, NewFakeRecorderGetter(0), NewFakeServiceGetter(), HealthcheckFlags{})
 
 	sp := &utils.ServicePort{NodePort: 80, Protocol: annotations.ProtocolHTTP, NEGEnabled: false, BackendNamer: testNamer}
-	_, err := healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err := healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -139,7 +139,7 @@ func TestHealthCheckAdd(t *testing.T) {
 	}
 
 	sp = &utils.ServicePort{NodePort: 443, Protocol: annotations.ProtocolHTTPS, NEGEnabled: false, BackendNamer: testNamer}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -150,7 +150,7 @@ func TestHealthCheckAdd(t *testing.T) {
 	}
 
 	sp = &utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP2, NEGEnabled: false, BackendNamer: testNamer}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -161,7 +161,7 @@ func TestHealthCheckAdd(t *testing.T) {
 	}
 
 	sp = &utils.ServicePort{NodePort: 8080, Protocol: annotations.ProtocolHTTP, NEGEnabled: false, BackendNamer: testNamer, THCConfiguration: utils.THCConfiguration{THCOptInOnSvc: true}}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -189,7 +189,7 @@ func TestHealthCheckAddExisting(t *testing.T) {
 
 	sp := &utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP, NEGEnabled: false, BackendNamer: testNamer}
 	// Should not fail adding the same type of health check
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -201,7 +201,7 @@ func TestHealthCheckAddExisting(t *testing.T) {
 
 	// Enable Transparent Health Checks
 	sp = &utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP, NEGEnabled: false, BackendNamer: testNamer, THCConfiguration: utils.THCConfiguration{THCOptInOnSvc: true}}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -223,7 +223,7 @@ func TestHealthCheckAddExisting(t *testing.T) {
 	fakeGCE.CreateHealthCheck(v1hc)
 
 	sp = &utils.ServicePort{NodePort: 4000, Protocol: annotations.ProtocolHTTPS, NEGEnabled: false, BackendNamer: testNamer}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -245,7 +245,7 @@ func TestHealthCheckAddExisting(t *testing.T) {
 	fakeGCE.CreateHealthCheck(v1hc)
 
 	sp = &utils.ServicePort{NodePort: 5000, Protocol: annotations.ProtocolHTTPS, NEGEnabled: false, BackendNamer: testNamer}
-	_, err = healthChecks.SyncServicePort(sp, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), sp, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -274,7 +274,7 @@ func TestHealthCheckDelete(t *testing.T) {
 	fakeGCE.CreateHealthCheck(v1hc)
 
 	// Delete only HTTP 1234
-	err = healthChecks.Delete(testNamer.IGBackend(1234), meta.Global, klog.TODO())
+	err = healthChecks.Delete(context.Background(), testNamer.IGBackend(1234), meta.Global, klog.TODO())
 	if err != nil {
 		t.Errorf("unexpected error when deleting health check, err: %v", err)
 	}
@@ -301,7 +301,7 @@ func TestHTTP2HealthCheckDelete(t *testing.T) {
 	fakeGCE.CreateAlphaHealthCheck(alphahc)
 
 	// Delete only HTTP2 1234
-	err = healthChecks.Delete(testNamer.IGBackend(1234), meta.Global, klog.TODO())
+	err = healthChecks.Delete(context.Background(), testNamer.IGBackend(1234), meta.Global, klog.TODO())
 	if err != nil {
 		t.Errorf("unexpected error when deleting health check, err: %v", err)
 	}
@@ -335,7 +335,7 @@ func TestRegionalHealthCheckDelete(t *testing.T) {
 	)
 	hcName := testNamer.NEG("ns2", "svc2", 80)
 
-	if err := healthChecks.create(hc, nil, klog.TODO()); err != nil {
+	if err := healthChecks.create(context.Background(), hc, nil, klog.TODO()); err != nil {
 		t.Fatalf("healthchecks.Create(%q) = %v, want nil", hc.Name, err)
 	}
 
@@ -345,17 +345,17 @@ func TestRegionalHealthCheckDelete(t *testing.T) {
 	}
 
 	// Verify that Health-check exists.
-	if existingHC, err := composite.GetHealthCheck(fakeGCE, key, features.L7ILBVersions().HealthCheck, klog.TODO()); err != nil || existingHC == nil {
+	if existingHC, err := composite.GetHealthCheck(context.Background(), fakeGCE, key, features.L7ILBVersions().HealthCheck, klog.TODO()); err != nil || existingHC == nil {
 		t.Fatalf("GetHealthCheck(%q) = %v, %v, want nil", hc.Name, existingHC, err)
 	}
 
 	// Delete HTTP health-check.
-	if err = healthChecks.Delete(hcName, meta.Regional, klog.TODO()); err != nil {
+	if err = healthChecks.Delete(context.Background(), hcName, meta.Regional, klog.TODO()); err != nil {
 		t.Errorf("healthchecks.Delete(%q, %q) = %v, want nil", hcName, meta.Regional, err)
 	}
 
 	// Validate health-check is deleted.
-	if _, err = composite.GetHealthCheck(fakeGCE, key, features.L7ILBVersions().HealthCheck, klog.TODO()); !utils.IsHTTPErrorCode(err, http.StatusNotFound) {
+	if _, err = composite.GetHealthCheck(context.Background(), fakeGCE, key, features.L7ILBVersions().HealthCheck, klog.TODO()); !utils.IsHTTPErrorCode(err, http.StatusNotFound) {
 		t.Errorf("Expected not-found error, actual: %v", err)
 	}
 }
@@ -440,20 +440,20 @@ func TestHealthCheckUpdate(t *testing.T) {
 	fakeGCE.CreateHealthCheck(v1hc)
 
 	// Verify the health check exists
-	_, err = healthChecks.Get(hc.Name, meta.VersionGA, meta.Global, klog.TODO())
+	_, err = healthChecks.Get(context.Background(), hc.Name, meta.VersionGA, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
 
 	// Change to HTTPS
 	hc.Type = string(annotations.ProtocolHTTPS)
-	_, err = healthChecks.sync(hc, nil, utils.THCConfiguration{}, klog.TODO())
+	_, err = healthChecks.sync(context.Background(), hc, nil, utils.THCConfiguration{}, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
 
 	// Verify the health check exists
-	_, err = healthChecks.Get(hc.Name, meta.VersionGA, meta.Global, klog.TODO())
+	_, err = healthChecks.Get(context.Background(), hc.Name, meta.VersionGA, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -465,13 +465,13 @@ func TestHealthCheckUpdate(t *testing.T) {
 
 	// Change to HTTP2
 	hc.Type = string(annotations.ProtocolHTTP2)
-	_, err = healthChecks.sync(hc, nil, utils.THCConfiguration{}, klog.TODO())
+	_, err = healthChecks.sync(context.Background(), hc, nil, utils.THCConfiguration{}, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
 
 	// Verify the health check exists. HTTP2 is alpha-only.
-	_, err = healthChecks.Get(hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
+	_, err = healthChecks.Get(context.Background(), hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -484,14 +484,14 @@ func TestHealthCheckUpdate(t *testing.T) {
 	// Change to NEG Health Check
 	hc.ForNEG = true
 	hc.PortSpecification = "USE_SERVING_PORT"
-	_, err = healthChecks.sync(hc, nil, utils.THCConfiguration{}, klog.TODO())
+	_, err = healthChecks.sync(context.Background(), hc, nil, utils.THCConfiguration{}, klog.TODO())
 
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
 
 	// Verify the health check exists.
-	hc, err = healthChecks.Get(hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
+	hc, err = healthChecks.Get(context.Background(), hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -505,13 +505,13 @@ func TestHealthCheckUpdate(t *testing.T) {
 	hc.Port = 3000
 	hc.PortSpecification = ""
 
-	_, err = healthChecks.sync(hc, nil, utils.THCConfiguration{}, klog.TODO())
+	_, err = healthChecks.sync(context.Background(), hc, nil, utils.THCConfiguration{}, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
 
 	// Verify the health check exists.
-	hc, err = healthChecks.Get(hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
+	hc, err = healthChecks.Get(context.Background(), hc.Name, meta.VersionAlpha, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -549,7 +549,7 @@ func TestEnableTHC(t *testing.T) {
 	fakeGCE.CreateHealthCheck(v1hc)
 
 	// Verify the health check exists
-	initialObtainedHC, err := healthChecks.Get(hc.Name, meta.VersionGA, meta.Global, klog.TODO())
+	initialObtainedHC, err := healthChecks.Get(context.Background(), hc.Name, meta.VersionGA, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -570,13 +570,13 @@ func TestEnableTHC(t *testing.T) {
 	translator.OverwriteWithTHC(hc, thcPort, klog.TODO())
 	hc.Name = oldName
 	// Enable Transparent Health Checks
-	_, err = healthChecks.sync(hc, nil, utils.THCConfiguration{THCOptInOnSvc: true}, klog.TODO())
+	_, err = healthChecks.sync(context.Background(), hc, nil, utils.THCConfiguration{THCOptInOnSvc: true}, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
 
 	// Verify the health check exists
-	obtainedHC, err := healthChecks.Get(hc.Name, meta.VersionGA, meta.Global, klog.TODO())
+	obtainedHC, err := healthChecks.Get(context.Background(), hc.Name, meta.VersionGA, meta.Global, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected the health check to exist, err: %v", err)
 	}
@@ -693,7 +693,7 @@ func TestRolloutUpdateCustomHCDescription(t *testing.T) {
 	fakeSingletonRecorderGetter := NewFakeSingletonRecorderGetter(1)
 	healthChecks := NewHealthChecker(fakeGCE, "/", defaultBackendSvc, fakeSingletonRecorderGetter, NewFakeServiceGetter(), HealthcheckFlags{})
 
-	_, err := healthChecks.SyncServicePort(defaultSP, nil, klog.TODO())
+	_, err := healthChecks.SyncServicePort(context.Background(), defaultSP, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
@@ -705,7 +705,7 @@ func TestRolloutUpdateCustomHCDescription(t *testing.T) {
 			outputDefaultHC.Description, translator.DescriptionForDefaultHealthChecks)
 	}
 
-	_, err = healthChecks.SyncServicePort(backendConfigSP, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), backendConfigSP, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
@@ -722,7 +722,7 @@ func TestRolloutUpdateCustomHCDescription(t *testing.T) {
 	// Modify the flag and see what happens.
 	flags.F.EnableUpdateCustomHealthCheckDescription = true
 
-	_, err = healthChecks.SyncServicePort(backendConfigSP, nil, klog.TODO())
+	_, err = healthChecks.SyncServicePort(context.Background(), backendConfigSP, nil, klog.TODO())
 	if err != nil {
 		t.Fatalf("unexpected err while syncing healthcheck, err %v", err)
 	}
@@ -792,6 +792,15 @@ func TestVersion(t *testing.T) {
 			},
 			version: meta.VersionBeta,
 		},
+		{
+			desc: "Basic gRPC Health Check",
+			hc: &translator.HealthCheck{
+				HealthCheck: computealpha.HealthCheck{
+					Type: string(annotations.ProtocolGRPC),
+				},
+			},
+			version: meta.VersionBeta,
+		},
 		{
 			desc: "Http Health Check with NEG",
 			hc: &translator.HealthCheck{
@@ -1088,6 +1097,18 @@ func TestCalculateDiff(t *testing.T) {
 		hasDiff: true,
 	})
 
+	oldHC := translator.DefaultNEGHealthCheck(annotations.ProtocolGRPC, klog.TODO())
+	newHC = translator.DefaultNEGHealthCheck(annotations.ProtocolGRPC, klog.TODO())
+	newHC.GRPCServiceName = "foo.Bar"
+	cases = append(cases, tc{
+		desc:     "Backendconfig GRPCServiceName",
+		old:      oldHC,
+		new:      newHC,
+		c:        &backendconfigv1.HealthCheckConfig{GRPCServiceName: s("foo.Bar")},
+		hasDiff:  true,
+		diffSize: i64(1),
+	})
+
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			diffs := calculateDiff(tc.old, tc.new, tc.c, false)
@@ -1804,7 +1825,7 @@ func TestSyncServicePort(t *testing.T) {
 				THCPort: 7877,
 			})
 
-			gotSelfLink, err := hcs.SyncServicePort(tc.sp, tc.probe, klog.TODO())
+			gotSelfLink, err := hcs.SyncServicePort(context.Background(), tc.sp, tc.probe, klog.TODO())
 			if gotErr := err != nil; gotErr != tc.wantErr {
 				t.Errorf("hcs.SyncServicePort(tc.sp, tc.probe) = _, %v; gotErr = %t, want %t\nsp = %s\nprobe = %s", err, gotErr, tc.wantErr, pretty.Sprint(tc.sp), pretty.Sprint(tc.probe))
 			}
@@ -1850,7 +1871,7 @@ func TestSyncServicePort(t *testing.T) {
 				return nil
 			}
 
-			gotSelfLink, err = hcs.SyncServicePort(tc.sp, tc.probe, klog.TODO())
+			gotSelfLink, err = hcs.SyncServicePort(context.Background(), tc.sp, tc.probe, klog.TODO())
 			if gotErr := err != nil; gotErr != tc.wantErr {
 				t.Errorf("hcs.SyncServicePort(tc.sp, tc.probe) = %v; gotErr = %t, want %t\nsp = %s\nprobe = %s", err, gotErr, tc.wantErr, pretty.Sprint(tc.sp), pretty.Sprint(tc.probe))
 			}
@@ -2117,7 +2138,7 @@ func TestCustomHealthcheckRemoval(t *testing.T) {
 				THCPort: 7877,
 			})
 
-			gotSelfLink, err := hcs.SyncServicePort(&tc.sp, tc.probe, klog.TODO())
+			gotSelfLink, err := hcs.SyncServicePort(context.Background(), &tc.sp, tc.probe, klog.TODO())
 			if gotErr := err != nil; gotErr != tc.wantErr {
 				t.Errorf("hcs.SyncServicePort(tc.sp, tc.probe) = _, %v; gotErr = %t, want %t\nsp = %s\nprobe = %s", err, gotErr, tc.wantErr, pretty.Sprint(tc.sp), pretty.Sprint(tc.probe))
 			}
@@ -2156,7 +2177,7 @@ func TestCustomHealthcheckRemoval(t *testing.T) {
 				return nil
 			}
 
-			gotSelfLink, err = hcs.SyncServicePort(&tc.sp, tc.probe, klog.TODO())
+			gotSelfLink, err = hcs.SyncServicePort(context.Background(), &tc.sp, tc.probe, klog.TODO())
 			if gotErr := err != nil; gotErr != tc.wantErr {
 				t.Errorf("hcs.SyncServicePort(tc.sp, tc.probe) = %v; gotErr = %t, want %t\nsp = %s\nprobe = %s", err, gotErr, tc.wantErr, pretty.Sprint(tc.sp), pretty.Sprint(tc.probe))
 			}
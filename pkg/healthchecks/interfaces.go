@@ -17,6 +17,7 @@ limitations under the License.
 package healthchecks
 
 import (
+	"context"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	computealpha "google.golang.org/api/compute/v0.alpha"
 	computebeta "google.golang.org/api/compute/v0.beta"
@@ -54,9 +55,9 @@ type HealthChecker interface {
 	// ServicePort and Pod Probe definition.
 	//
 	// `probe` can be nil if no probe exists.
-	SyncServicePort(sp *utils.ServicePort, probe *v1.Probe, logger klog.Logger) (string, error)
-	Delete(name string, scope meta.KeyType, logger klog.Logger) error
-	Get(name string, version meta.Version, scope meta.KeyType, logger klog.Logger) (*translator.HealthCheck, error)
+	SyncServicePort(ctx context.Context, sp *utils.ServicePort, probe *v1.Probe, logger klog.Logger) (string, error)
+	Delete(ctx context.Context, name string, scope meta.KeyType, logger klog.Logger) error
+	Get(ctx context.Context, name string, version meta.Version, scope meta.KeyType, logger klog.Logger) (*translator.HealthCheck, error)
 }
 
 // ServiceGetter is an interface to retrieve Kubernetes Services.
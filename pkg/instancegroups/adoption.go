@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// zoneGroups holds the instance groups of a single zone that the manager
+// syncs nodes into (managed) or treats as already serving nodes (adopted).
+type zoneGroups struct {
+	// managed are the cluster instance groups ordered by shard index. The
+	// first one is always the primary group named by the namer.
+	managed []*compute.InstanceGroup
+	// adopted are unmanaged instance groups created outside of the controller
+	// which match the adoption name prefixes or label.
+	adopted []*compute.InstanceGroup
+}
+
+// shardName returns the name of the instance group holding the given shard.
+// Shard 0 is the primary instance group.
+func shardName(base string, index int) string {
+	if index == 0 {
+		return base
+	}
+	return fmt.Sprintf("%s-%d", base, index)
+}
+
+// shardIndex returns the shard index encoded in name, or false if name is not
+// a shard of the base instance group.
+func shardIndex(base, name string) (int, bool) {
+	if name == base {
+		return 0, true
+	}
+	suffix, ok := strings.CutPrefix(name, base+"-")
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index <= 0 || strconv.Itoa(index) != suffix {
+		return 0, false
+	}
+	return index, true
+}
+
+// isAdoptable returns true if the instance group was created outside of the
+// controller and matches one of the adoption name prefixes or carries the
+// adoption label. Unmanaged instance groups do not support labels, so the
+// label is matched as a whitespace or comma separated key=value token of the
+// instance group description.
+func (m *manager) isAdoptable(ig *compute.InstanceGroup) bool {
+	if m.namer.NameBelongsToEntity(ig.Name) {
+		return false
+	}
+	for _, prefix := range m.adoptionNamePrefixes {
+		if prefix != "" && strings.HasPrefix(ig.Name, prefix) {
+			return true
+		}
+	}
+	if m.adoptionLabel == "" {
+		return false
+	}
+	for _, token := range strings.FieldsFunc(ig.Description, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' || r == '\t' }) {
+		if token == m.adoptionLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// adoptionEnabled returns true if the manager is configured to adopt unmanaged
+// instance groups.
+func (m *manager) adoptionEnabled() bool {
+	return len(m.adoptionNamePrefixes) > 0 || m.adoptionLabel != ""
+}
+
+// groupsInZone returns the managed shards and adopted instance groups in zone.
+// Without multiple instance groups or adoption configured only the primary
+// instance group is returned, which keeps the number of API calls unchanged.
+// The returned primary group may be nil if it does not exist yet.
+func (m *manager) groupsInZone(base, zone string) (*zoneGroups, error) {
+	groups := &zoneGroups{}
+	if !m.enableMultipleIGs && !m.adoptionEnabled() {
+		groups.managed = []*compute.InstanceGroup{{Name: base}}
+		return groups, nil
+	}
+
+	igs, err := m.cloud.ListInstanceGroups(zone)
+	if err != nil {
+		return nil, err
+	}
+	shards := map[int]*compute.InstanceGroup{0: {Name: base}}
+	for _, ig := range igs {
+		if index, ok := shardIndex(base, ig.Name); ok && (index == 0 || m.enableMultipleIGs) {
+			shards[index] = ig
+			continue
+		}
+		if m.isAdoptable(ig) {
+			groups.adopted = append(groups.adopted, ig)
+		}
+	}
+
+	indexes := make([]int, 0, len(shards))
+	for index := range shards {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		groups.managed = append(groups.managed, shards[index])
+	}
+	sort.Slice(groups.adopted, func(i, j int) bool { return groups.adopted[i].Name < groups.adopted[j].Name })
+	return groups, nil
+}
+
+// assignShards distributes nodes across shards of at most maxSize nodes
+// while moving as few nodes as possible. Nodes stay in the shard they are
+// currently a member of, unless that shard exceeds maxSize; the remaining
+// nodes fill shards in index order, appending new shards as needed. current
+// holds the members of each existing shard ordered by shard index. The
+// returned slice has one entry per shard and is at least as long as current.
+func assignShards(nodes []string, current [][]string, maxSize int) [][]string {
+	if maxSize <= 0 {
+		maxSize = len(nodes)
+	}
+	want := map[string]bool{}
+	for _, node := range nodes {
+		want[node] = true
+	}
+
+	assigned := map[string]bool{}
+	shards := make([][]string, len(current))
+	for i, members := range current {
+		sorted := append([]string(nil), members...)
+		sort.Strings(sorted)
+		for _, node := range sorted {
+			if !want[node] || assigned[node] || len(shards[i]) >= maxSize {
+				continue
+			}
+			shards[i] = append(shards[i], node)
+			assigned[node] = true
+		}
+	}
+
+	var unassigned []string
+	for _, node := range nodes {
+		if !assigned[node] {
+			unassigned = append(unassigned, node)
+		}
+	}
+	sort.Strings(unassigned)
+
+	for i := 0; len(unassigned) > 0; i++ {
+		if i == len(shards) {
+			shards = append(shards, nil)
+		}
+		free := maxSize - len(shards[i])
+		if free <= 0 {
+			if maxSize == 0 {
+				break
+			}
+			continue
+		}
+		if free > len(unassigned) {
+			free = len(unassigned)
+		}
+		shards[i] = append(shards[i], unassigned[:free]...)
+		unassigned = unassigned[free:]
+	}
+	return shards
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils/zonegetter"
+	"k8s.io/klog/v2"
+)
+
+func newNodePoolWithConfig(t *testing.T, f Provider, config ManagerConfig, nodes ...string) Manager {
+	t.Helper()
+	fakeZoneGetter, err := zonegetter.NewFakeZoneGetter(zonegetter.FakeNodeInformer(), zonegetter.FakeNodeTopologyInformer(), defaultTestSubnetURL, false)
+	if err != nil {
+		t.Fatalf("NewFakeZoneGetter() returned error %v", err)
+	}
+	if err := zonegetter.AddFakeNodes(fakeZoneGetter, defaultTestZone, nodes...); err != nil {
+		t.Fatalf("AddFakeNodes() returned error %v", err)
+	}
+	config.Cloud = f
+	config.Namer = defaultNamer
+	config.Recorders = &test.FakeRecorderSource{}
+	config.BasePath = basePath
+	config.ZoneGetter = fakeZoneGetter
+	return NewManager(&config)
+}
+
+func instancesIn(t *testing.T, f *FakeInstanceGroups, name string) sets.String {
+	t.Helper()
+	list, err := f.ListInstancesInInstanceGroup(name, defaultTestZone, allInstances)
+	if err != nil {
+		t.Fatalf("ListInstancesInInstanceGroup(%s) returned error %v", name, err)
+	}
+	instances, err := test.InstancesListToNameSet(list)
+	if err != nil {
+		t.Fatalf("InstancesListToNameSet() returned error %v", err)
+	}
+	return instances
+}
+
+func TestShardIndex(t *testing.T) {
+	base := "k8s-ig--uid1"
+	testCases := []struct {
+		name      string
+		wantIndex int
+		wantOK    bool
+	}{
+		{name: base, wantIndex: 0, wantOK: true},
+		{name: base + "-1", wantIndex: 1, wantOK: true},
+		{name: base + "-12", wantIndex: 12, wantOK: true},
+		{name: base + "-0", wantOK: false},
+		{name: base + "-01", wantOK: false},
+		{name: base + "-x", wantOK: false},
+		{name: "other-ig", wantOK: false},
+	}
+	for _, tc := range testCases {
+		index, ok := shardIndex(base, tc.name)
+		if ok != tc.wantOK || index != tc.wantIndex {
+			t.Errorf("shardIndex(%q) = %d, %v, want %d, %v", tc.name, index, ok, tc.wantIndex, tc.wantOK)
+		}
+		if ok && shardName(base, index) != tc.name {
+			t.Errorf("shardName(%d) = %q, want %q", index, shardName(base, index), tc.name)
+		}
+	}
+}
+
+func TestAssignShards(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		nodes   []string
+		current [][]string
+		maxSize int
+		want    [][]string
+	}{
+		{
+			desc:    "new nodes fill shards in order",
+			nodes:   []string{"n5", "n4", "n3", "n2", "n1"},
+			maxSize: 2,
+			want:    [][]string{{"n1", "n2"}, {"n3", "n4"}, {"n5"}},
+		},
+		{
+			desc:    "nodes stay in their shard when others leave",
+			nodes:   []string{"n2", "n3", "n4"},
+			current: [][]string{{"n1", "n2"}, {"n3", "n4"}},
+			maxSize: 2,
+			want:    [][]string{{"n2"}, {"n3", "n4"}},
+		},
+		{
+			desc:    "new node takes the free slot without moving others",
+			nodes:   []string{"n0", "n2", "n3", "n4"},
+			current: [][]string{{"n2"}, {"n3", "n4"}},
+			maxSize: 2,
+			want:    [][]string{{"n2", "n0"}, {"n3", "n4"}},
+		},
+		{
+			desc:    "overfull shard spills into a new shard",
+			nodes:   []string{"n1", "n2", "n3"},
+			current: [][]string{{"n1", "n2", "n3"}},
+			maxSize: 2,
+			want:    [][]string{{"n1", "n2"}, {"n3"}},
+		},
+		{
+			desc:    "node in two shards is kept in the first",
+			nodes:   []string{"n1"},
+			current: [][]string{{"n1"}, {"n1"}},
+			maxSize: 2,
+			want:    [][]string{{"n1"}, nil},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := assignShards(tc.nodes, tc.current, tc.maxSize)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("assignShards() returned diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncMultipleIGsIsStable(t *testing.T) {
+	primary := &compute.InstanceGroup{Name: defaultNamer.InstanceGroup()}
+	shard := &compute.InstanceGroup{Name: shardName(defaultNamer.InstanceGroup(), 1)}
+	fakeIGs := NewFakeInstanceGroups(map[string]IGsToInstances{
+		defaultTestZone: {
+			primary: sets.NewString("n1", "n3"),
+			shard:   sets.NewString("n2", "n4"),
+		},
+	}, 2)
+	pool := newNodePoolWithConfig(t, fakeIGs, ManagerConfig{MaxIGSize: 2, EnableMultipleIGs: true}, "n0", "n2", "n3", "n4", "n5")
+
+	if err := pool.Sync(context.Background(), []string{"n0", "n2", "n3", "n4", "n5"}, klog.TODO()); err != nil {
+		t.Fatalf("Sync() returned error %v", err)
+	}
+
+	if got, want := instancesIn(t, fakeIGs, primary.Name), sets.NewString("n0", "n3"); !got.Equal(want) {
+		t.Errorf("primary instance group has %v, want %v", got.List(), want.List())
+	}
+	if got, want := instancesIn(t, fakeIGs, shard.Name), sets.NewString("n2", "n4"); !got.Equal(want) {
+		t.Errorf("shard 1 has %v, want %v", got.List(), want.List())
+	}
+	newShard := shardName(defaultNamer.InstanceGroup(), 2)
+	if got, want := instancesIn(t, fakeIGs, newShard), sets.NewString("n5"); !got.Equal(want) {
+		t.Errorf("shard 2 has %v, want %v", got.List(), want.List())
+	}
+
+	additional, err := pool.AdditionalInstanceGroups(primary.Name, defaultTestZone, klog.TODO())
+	if err != nil {
+		t.Fatalf("AdditionalInstanceGroups() returned error %v", err)
+	}
+	var names []string
+	for _, ig := range additional {
+		names = append(names, ig.Name)
+	}
+	if diff := cmp.Diff([]string{shard.Name, newShard}, names); diff != "" {
+		t.Errorf("AdditionalInstanceGroups() returned diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestSyncAdoptsUnmanagedInstanceGroups(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		config      ManagerConfig
+		adopted     *compute.InstanceGroup
+		wantCluster []string
+		wantAdopted bool
+	}{
+		{
+			desc:        "adopted by name prefix",
+			config:      ManagerConfig{MaxIGSize: 10, AdoptionNamePrefixes: []string{"legacy-"}},
+			adopted:     &compute.InstanceGroup{Name: "legacy-pool"},
+			wantCluster: []string{"n3"},
+			wantAdopted: true,
+		},
+		{
+			desc:        "adopted by label in description",
+			config:      ManagerConfig{MaxIGSize: 10, AdoptionLabel: "owner=platform"},
+			adopted:     &compute.InstanceGroup{Name: "pool", Description: "team=infra, owner=platform"},
+			wantCluster: []string{"n3"},
+			wantAdopted: true,
+		},
+		{
+			desc:        "not adopted without matching prefix or label",
+			config:      ManagerConfig{MaxIGSize: 10, AdoptionNamePrefixes: []string{"legacy-"}, AdoptionLabel: "owner=platform"},
+			adopted:     &compute.InstanceGroup{Name: "pool", Description: "owner=someone-else"},
+			wantCluster: []string{"n1", "n2", "n3"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			primary := &compute.InstanceGroup{Name: defaultNamer.InstanceGroup()}
+			fakeIGs := NewFakeInstanceGroups(map[string]IGsToInstances{
+				defaultTestZone: {
+					primary:    sets.NewString("n2"),
+					tc.adopted: sets.NewString("n1", "n2"),
+				},
+			}, 10)
+			pool := newNodePoolWithConfig(t, fakeIGs, tc.config, "n1", "n2", "n3")
+
+			if err := pool.Sync(context.Background(), []string{"n1", "n2", "n3"}, klog.TODO()); err != nil {
+				t.Fatalf("Sync() returned error %v", err)
+			}
+			if got := instancesIn(t, fakeIGs, primary.Name); !got.Equal(sets.NewString(tc.wantCluster...)) {
+				t.Errorf("cluster instance group has %v, want %v", got.List(), tc.wantCluster)
+			}
+			if got := instancesIn(t, fakeIGs, tc.adopted.Name); !got.Equal(sets.NewString("n1", "n2")) {
+				t.Errorf("adopted instance group has %v, want unchanged [n1 n2]", got.List())
+			}
+
+			additional, err := pool.AdditionalInstanceGroups(primary.Name, defaultTestZone, klog.TODO())
+			if err != nil {
+				t.Fatalf("AdditionalInstanceGroups() returned error %v", err)
+			}
+			if gotAdopted := len(additional) == 1 && additional[0].Name == tc.adopted.Name; gotAdopted != tc.wantAdopted {
+				t.Errorf("AdditionalInstanceGroups() = %v, want adopted group returned = %v", additional, tc.wantAdopted)
+			}
+		})
+	}
+}
+
+// fakeShardLinker records the shards it links and the members they had.
+type fakeShardLinker struct {
+	igs    *FakeInstanceGroups
+	linked map[string]string
+}
+
+func (l *fakeShardLinker) LinkShard(_ context.Context, primary, shard *compute.InstanceGroup, logger klog.Logger) error {
+	if l.linked == nil {
+		l.linked = map[string]string{}
+	}
+	l.linked[shard.Name] = primary.Name
+	if members := l.igs.zonesToIGsToInstances[defaultTestZone][shard]; members.Len() != 0 {
+		return fmt.Errorf("shard %s linked with members %v, want it linked before nodes are added", shard.Name, members.List())
+	}
+	return nil
+}
+
+func TestSyncLinksNewShard(t *testing.T) {
+	primary := &compute.InstanceGroup{Name: defaultNamer.InstanceGroup(), SelfLink: "https://www.googleapis.com/compute/v1/projects/mock-project/zones/" + defaultTestZone + "/instanceGroups/" + defaultNamer.InstanceGroup()}
+	fakeIGs := NewFakeInstanceGroups(map[string]IGsToInstances{
+		defaultTestZone: {primary: sets.NewString("n1", "n2")},
+	}, 2)
+	linker := &fakeShardLinker{igs: fakeIGs}
+	pool := newNodePoolWithConfig(t, fakeIGs, ManagerConfig{MaxIGSize: 2, EnableMultipleIGs: true, ShardLinker: linker}, "n1", "n2", "n3")
+
+	if err := pool.Sync(context.Background(), []string{"n1", "n2"}, klog.TODO()); err != nil {
+		t.Fatalf("Sync() returned error %v", err)
+	}
+	if len(linker.linked) != 0 {
+		t.Errorf("Sync() without new shards linked %v", linker.linked)
+	}
+
+	if err := pool.Sync(context.Background(), []string{"n1", "n2", "n3"}, klog.TODO()); err != nil {
+		t.Fatalf("Sync() returned error %v", err)
+	}
+	newShard := shardName(primary.Name, 1)
+	if diff := cmp.Diff(map[string]string{newShard: primary.Name}, linker.linked); diff != "" {
+		t.Errorf("linked shards diff (-want +got):\n%s", diff)
+	}
+	if got, want := instancesIn(t, fakeIGs, newShard), sets.NewString("n3"); !got.Equal(want) {
+		t.Errorf("shard 1 has %v, want %v", got.List(), want.List())
+	}
+}
+
+func TestEnsureInstanceGroupsAndPortsLeavesAdoptedPorts(t *testing.T) {
+	primary := &compute.InstanceGroup{Name: defaultNamer.InstanceGroup()}
+	shard := &compute.InstanceGroup{Name: shardName(defaultNamer.InstanceGroup(), 1)}
+	adoptedPorts := []*compute.NamedPort{{Name: "http", Port: 80}}
+	adopted := &compute.InstanceGroup{Name: "legacy-pool", NamedPorts: adoptedPorts}
+	fakeIGs := NewFakeInstanceGroups(map[string]IGsToInstances{
+		defaultTestZone: {
+			primary: sets.NewString("n1"),
+			shard:   sets.NewString("n2"),
+			adopted: sets.NewString("n3"),
+		},
+	}, 10)
+	pool := newNodePoolWithConfig(t, fakeIGs, ManagerConfig{MaxIGSize: 1, EnableMultipleIGs: true, AdoptionNamePrefixes: []string{"legacy-"}}, "n1", "n2", "n3")
+
+	igs, err := pool.EnsureInstanceGroupsAndPorts(primary.Name, []int64{30001}, klog.TODO())
+	if err != nil {
+		t.Fatalf("EnsureInstanceGroupsAndPorts() returned error %v", err)
+	}
+	var names []string
+	for _, ig := range igs {
+		names = append(names, ig.Name)
+	}
+	if diff := cmp.Diff([]string{primary.Name, shard.Name, adopted.Name}, names); diff != "" {
+		t.Errorf("EnsureInstanceGroupsAndPorts() returned diff (-want +got):\n%s", diff)
+	}
+	wantPorts := []*compute.NamedPort{{Name: defaultNamer.NamedPort(30001), Port: 30001}}
+	for _, ig := range []*compute.InstanceGroup{primary, shard} {
+		if diff := cmp.Diff(wantPorts, ig.NamedPorts); diff != "" {
+			t.Errorf("named ports of %s diff (-want +got):\n%s", ig.Name, diff)
+		}
+	}
+	if diff := cmp.Diff(adoptedPorts, adopted.NamedPorts); diff != "" {
+		t.Errorf("named ports of adopted instance group %s changed (-want +got):\n%s", adopted.Name, diff)
+	}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package instancegroups
 
 import (
+	"context"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
 	"k8s.io/ingress-gce/pkg/utils"
@@ -44,6 +46,11 @@ type Controller struct {
 	// readOnlyMode is enabled when the controller should skip the sync
 	readOnlyMode bool
 
+	// neededNamedPorts returns the named ports needed by live ServicePorts.
+	// Stale named ports are garbage collected every namedPortGCPeriod when set.
+	neededNamedPorts  func() ([]int64, error)
+	namedPortGCPeriod time.Duration
+
 	stopCh     <-chan struct{}
 	zoneGetter *zonegetter.ZoneGetter
 
@@ -58,6 +65,10 @@ type ControllerConfig struct {
 	EnableMultiSubnetCluster bool
 	ReadOnlyMode             bool
 	StopCh                   <-chan struct{}
+	// NeededNamedPorts and NamedPortGCPeriod enable the garbage collection
+	// of instance group named ports not used by any live ServicePort.
+	NeededNamedPorts  func() ([]int64, error)
+	NamedPortGCPeriod time.Duration
 }
 
 var defaultNodeObj = &apiv1.Node{
@@ -75,6 +86,8 @@ func NewController(config *ControllerConfig, logger klog.Logger) *Controller {
 		hasSynced:                config.HasSynced,
 		enableMultiSubnetCluster: config.EnableMultiSubnetCluster,
 		readOnlyMode:             config.ReadOnlyMode,
+		neededNamedPorts:         config.NeededNamedPorts,
+		namedPortGCPeriod:        config.NamedPortGCPeriod,
 		stopCh:                   config.StopCh,
 		logger:                   logger,
 	}
@@ -108,6 +121,9 @@ func (c *Controller) Run() {
 	}
 	c.logger.V(2).Info("Caches synced", "timeTaken", time.Now().Sub(start))
 	go c.queue.Run()
+	if c.neededNamedPorts != nil && c.namedPortGCPeriod > 0 {
+		go wait.Until(c.gcNamedPorts, c.namedPortGCPeriod, c.stopCh)
+	}
 	<-c.stopCh
 	c.Shutdown()
 }
@@ -141,5 +157,16 @@ func (c *Controller) sync(key string) error {
 	if err != nil {
 		return err
 	}
-	return c.igManager.Sync(utils.GetNodeNames(nodes), c.logger)
+	return c.igManager.Sync(context.Background(), utils.GetNodeNames(nodes), c.logger)
+}
+
+// gcNamedPorts prunes the named ports of the instance groups which are not
+// needed by any live ServicePort and adds the missing ones.
+func (c *Controller) gcNamedPorts() {
+	start := time.Now()
+	if err := c.igManager.ReconcileNamedPorts(c.neededNamedPorts, c.logger); err != nil {
+		c.logger.Error(err, "Failed to reconcile instance group named ports")
+		return
+	}
+	c.logger.V(4).Info("Reconciled instance group named ports", "timeTaken", time.Since(start))
 }
@@ -194,7 +194,7 @@ type IGManagerFake struct {
 	syncedNodes [][]string
 }
 
-func (igmf *IGManagerFake) Sync(nodeNames []string, logger klog.Logger) error {
+func (igmf *IGManagerFake) Sync(_ context.Context, nodeNames []string, logger klog.Logger) error {
 	igmf.syncedNodes = append(igmf.syncedNodes, nodeNames)
 	return nil
 }
@@ -221,3 +221,11 @@ func (igmf *IGManagerFake) Get(name, zone string) (*compute.InstanceGroup, error
 func (igmf *IGManagerFake) List(logger klog.Logger) ([]string, error) {
 	return []string{}, nil
 }
+
+func (igmf *IGManagerFake) ReconcileNamedPorts(neededPorts func() ([]int64, error), logger klog.Logger) error {
+	return nil
+}
+
+func (igmf *IGManagerFake) AdditionalInstanceGroups(name, zone string, logger klog.Logger) ([]*compute.InstanceGroup, error) {
+	return nil, nil
+}
@@ -50,6 +50,7 @@ type FakeInstanceGroups struct {
 	calls                 []int
 	zonesToIGsToInstances map[string]IGsToInstances
 	maxIGSize             int
+	fingerprints          int
 }
 
 // getInstanceGroup implements fake getting ig by name in zone
@@ -144,12 +145,20 @@ func (f *FakeInstanceGroups) RemoveInstancesFromInstanceGroup(name, zone string,
 	return nil
 }
 
-func (f *FakeInstanceGroups) SetNamedPortsOfInstanceGroup(igName, zone string, namedPorts []*compute.NamedPort) error {
+// SetNamedPortsOfInstanceGroup fakes setting the named ports of an instance
+// group. It fails like GCE when the fingerprint does not match and changes the
+// fingerprint otherwise.
+func (f *FakeInstanceGroups) SetNamedPortsOfInstanceGroup(igName, zone string, namedPorts []*compute.NamedPort, fingerprint string) error {
 	ig, err := f.getInstanceGroup(igName, zone)
 	if err != nil {
 		return err
 	}
+	if fingerprint != ig.Fingerprint {
+		return test.FakeGoogleAPIPreconditionFailedErr()
+	}
+	f.fingerprints++
 	ig.NamedPorts = namedPorts
+	ig.Fingerprint = fmt.Sprintf("fingerprint-%d", f.fingerprints)
 	return nil
 }
 
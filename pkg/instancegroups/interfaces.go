@@ -17,6 +17,7 @@ limitations under the License.
 package instancegroups
 
 import (
+	"context"
 	compute "google.golang.org/api/compute/v1"
 	"k8s.io/klog/v2"
 )
@@ -30,8 +31,21 @@ type Manager interface {
 
 	Get(name, zone string) (*compute.InstanceGroup, error)
 	List(logger klog.Logger) ([]string, error)
+	// AdditionalInstanceGroups returns the instance groups in the zone, other than the
+	// named one, which also hold cluster nodes: its shards and adopted instance groups.
+	AdditionalInstanceGroups(name, zone string, logger klog.Logger) ([]*compute.InstanceGroup, error)
 
-	Sync(nodeNames []string, logger klog.Logger) error
+	Sync(ctx context.Context, nodeNames []string, logger klog.Logger) error
+	// ReconcileNamedPorts sets the named ports of the cluster instance groups to
+	// the ports returned by neededPorts, pruning stale ones and adding missing ones.
+	ReconcileNamedPorts(neededPorts func() ([]int64, error), logger klog.Logger) error
+}
+
+// ShardLinker links a new shard of the cluster instance group to the backend
+// services which the primary instance group is a backend of, so that the nodes
+// added to the shard serve traffic without waiting for the next backend sync.
+type ShardLinker interface {
+	LinkShard(ctx context.Context, primary, shard *compute.InstanceGroup, logger klog.Logger) error
 }
 
 // Provider is an interface for managing gce instances groups, and the instances therein.
@@ -46,5 +60,8 @@ type Provider interface {
 	AddInstancesToInstanceGroup(name, zone string, instanceRefs []*compute.InstanceReference) error
 	RemoveInstancesFromInstanceGroup(name, zone string, instanceRefs []*compute.InstanceReference) error
 	ToInstanceReferences(zone string, instanceNames []string) (refs []*compute.InstanceReference)
-	SetNamedPortsOfInstanceGroup(igName, zone string, namedPorts []*compute.NamedPort) error
+	// SetNamedPortsOfInstanceGroup sets the named ports of the instance group
+	// if its fingerprint still matches, so that concurrent changes are not
+	// overwritten.
+	SetNamedPortsOfInstanceGroup(igName, zone string, namedPorts []*compute.NamedPort, fingerprint string) error
 }
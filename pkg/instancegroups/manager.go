@@ -17,6 +17,7 @@ limitations under the License.
 package instancegroups
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -51,6 +52,11 @@ type manager struct {
 	instanceLinkFormat string
 	maxIGSize          int
 	readOnlyMode       bool
+
+	enableMultipleIGs    bool
+	adoptionNamePrefixes []string
+	adoptionLabel        string
+	shardLinker          ShardLinker
 }
 
 type recorderSource interface {
@@ -67,6 +73,18 @@ type ManagerConfig struct {
 	ZoneGetter   *zonegetter.ZoneGetter
 	MaxIGSize    int
 	ReadOnlyMode bool
+	// EnableMultipleIGs shards the nodes of a zone across several instance
+	// groups of at most MaxIGSize nodes instead of truncating the node list.
+	EnableMultipleIGs bool
+	// AdoptionNamePrefixes and AdoptionLabel select existing unmanaged instance
+	// groups whose nodes are left in place instead of being added to the
+	// cluster instance groups. AdoptionLabel has the form key=value and is
+	// matched against the instance group description.
+	AdoptionNamePrefixes []string
+	AdoptionLabel        string
+	// ShardLinker, if set, links the shards created by Sync to the backend
+	// services of the primary instance group.
+	ShardLinker ShardLinker
 }
 
 // NewManager creates a new node pool using ManagerConfig.
@@ -79,6 +97,11 @@ func NewManager(config *ManagerConfig) Manager {
 		ZoneGetter:         config.ZoneGetter,
 		maxIGSize:          config.MaxIGSize,
 		readOnlyMode:       config.ReadOnlyMode,
+
+		enableMultipleIGs:    config.EnableMultipleIGs,
+		adoptionNamePrefixes: config.AdoptionNamePrefixes,
+		adoptionLabel:        config.AdoptionLabel,
+		shardLinker:          config.ShardLinker,
 	}
 }
 
@@ -108,7 +131,9 @@ func (m *manager) InstanceGroupsExist(name string, logger klog.Logger) (exist bo
 
 // EnsureInstanceGroupsAndPorts creates or gets an instance group if it doesn't exist
 // and adds the given ports to it. Returns a list of one instance group per zone,
-// all of which have the exact same named ports.
+// all of which have the exact same named ports. Shards that already exist get the
+// ports as well and are appended to the list, followed by the adopted instance
+// groups, whose named ports are owned by their creator and left as they are.
 func (m *manager) EnsureInstanceGroupsAndPorts(name string, ports []int64, logger klog.Logger) (igs []*compute.InstanceGroup, err error) {
 	iglogger := logger.WithName("InstanceGroupsManager")
 	// Instance groups need to be created in all zones that nodes are in.
@@ -124,10 +149,42 @@ func (m *manager) EnsureInstanceGroupsAndPorts(name string, ports []int64, logge
 		}
 
 		igs = append(igs, ig)
+
+		if !m.enableMultipleIGs && !m.adoptionEnabled() {
+			continue
+		}
+		groups, err := m.groupsInZone(name, zone)
+		if err != nil {
+			return nil, err
+		}
+		for _, ig := range groups.managed[1:] {
+			if err := m.ensurePorts(ig, zone, ports, iglogger); err != nil {
+				return nil, err
+			}
+			igs = append(igs, ig)
+		}
+		igs = append(igs, groups.adopted...)
 	}
 	return igs, nil
 }
 
+// AdditionalInstanceGroups returns the shards of the named instance group other
+// than the primary one and the adopted instance groups in the given zone.
+// Backends need to be linked to these groups in addition to the primary one.
+func (m *manager) AdditionalInstanceGroups(name, zone string, logger klog.Logger) ([]*compute.InstanceGroup, error) {
+	if !m.enableMultipleIGs && !m.adoptionEnabled() {
+		return nil, nil
+	}
+	groups, err := m.groupsInZone(name, zone)
+	if err != nil {
+		return nil, err
+	}
+	var igs []*compute.InstanceGroup
+	igs = append(igs, groups.managed[1:]...)
+	igs = append(igs, groups.adopted...)
+	return igs, nil
+}
+
 func (m *manager) ensureInstanceGroupAndPorts(name, zone string, ports []int64, logger klog.Logger) (*compute.InstanceGroup, error) {
 	logger.V(3).Info("Ensuring instance group", "name", name, "zone", zone, "ports", ports)
 
@@ -158,6 +215,14 @@ func (m *manager) ensureInstanceGroupAndPorts(name, zone string, ports []int64,
 		logger.V(2).Info("Instance group already exists", "key", klog.KRef(zone, name))
 	}
 
+	if err := m.ensurePorts(ig, zone, ports, logger); err != nil {
+		return nil, err
+	}
+	return ig, nil
+}
+
+// ensurePorts adds the named ports missing from the instance group.
+func (m *manager) ensurePorts(ig *compute.InstanceGroup, zone string, ports []int64, logger klog.Logger) error {
 	// Build map of existing ports
 	existingPorts := map[int64]bool{}
 	for _, np := range ig.NamedPorts {
@@ -181,13 +246,12 @@ func (m *manager) ensureInstanceGroupAndPorts(name, zone string, ports []int64,
 	}
 
 	if len(newNamedPorts) > 0 {
-		logger.V(3).Info("Instance group does not have ports, adding them now", "key", klog.KRef(zone, name), "ports", fmt.Sprintf("%+v", newPorts))
-		if err := m.cloud.SetNamedPortsOfInstanceGroup(ig.Name, zone, append(ig.NamedPorts, newNamedPorts...)); err != nil {
-			return nil, err
+		logger.V(3).Info("Instance group does not have ports, adding them now", "key", klog.KRef(zone, ig.Name), "ports", fmt.Sprintf("%+v", newPorts))
+		if err := m.cloud.SetNamedPortsOfInstanceGroup(ig.Name, zone, append(ig.NamedPorts, newNamedPorts...), ig.Fingerprint); err != nil {
+			return err
 		}
 	}
-
-	return ig, nil
+	return nil
 }
 
 // DeleteInstanceGroup deletes the given IG by name, from all zones.
@@ -200,16 +264,29 @@ func (m *manager) DeleteInstanceGroup(name string, logger klog.Logger) error {
 		return err
 	}
 	for _, zone := range zones {
-		if err := m.cloud.DeleteInstanceGroup(name, zone); err != nil {
-			if utils.IsNotFoundError(err) {
-				logger.V(3).Info("Instance group in zone did not exist", "name", name, "zone", zone)
-			} else if utils.IsInUsedByError(err) {
-				logger.V(3).Info("Could not delete instance group in zone because it's still in use. Ignoring", "name", name, "zone", zone, "err", err)
-			} else {
+		names := []string{name}
+		if m.enableMultipleIGs {
+			groups, err := m.groupsInZone(name, zone)
+			if err != nil {
 				errs = append(errs, err)
+				continue
+			}
+			for _, ig := range groups.managed[1:] {
+				names = append(names, ig.Name)
+			}
+		}
+		for _, name := range names {
+			if err := m.cloud.DeleteInstanceGroup(name, zone); err != nil {
+				if utils.IsNotFoundError(err) {
+					logger.V(3).Info("Instance group in zone did not exist", "name", name, "zone", zone)
+				} else if utils.IsInUsedByError(err) {
+					logger.V(3).Info("Could not delete instance group in zone because it's still in use. Ignoring", "name", name, "zone", zone, "err", err)
+				} else {
+					errs = append(errs, err)
+				}
+			} else {
+				logger.V(3).Info("Deleted instance group in zone", "name", name, "zone", zone)
 			}
-		} else {
-			logger.V(3).Info("Deleted instance group in zone", "name", name, "zone", zone)
 		}
 	}
 	if len(errs) == 0 {
@@ -262,6 +339,96 @@ func (m *manager) List(logger klog.Logger) ([]string, error) {
 	return names.UnsortedList(), nil
 }
 
+// ReconcileNamedPorts sets the named ports of the cluster instance group and
+// its shards in all zones to the ports returned by neededPorts. Stale named
+// ports are pruned and missing ones added. Only named ports following the
+// namer's naming scheme are pruned, and adopted instance groups are left as
+// they are. neededPorts is called after the instance groups are read, so a port
+// added by a backend sync in the meantime always belongs to a ServicePort that
+// neededPorts returns and is not pruned. The named ports are set with the
+// fingerprint of the instance groups read, so a concurrent change of the named
+// ports makes the reconciliation of that instance group fail instead of being
+// overwritten.
+func (m *manager) ReconcileNamedPorts(neededPorts func() ([]int64, error), logger klog.Logger) error {
+	iglogger := logger.WithName("InstanceGroupsManager")
+	if m.readOnlyMode {
+		iglogger.Info("Skipping named port reconciliation since controller is in read-only mode")
+		return nil
+	}
+
+	zones, err := m.ZoneGetter.ListZonesInDefaultSubnet(zonegetter.AllNodesFilter, iglogger)
+	if err != nil {
+		return err
+	}
+	igName := m.namer.InstanceGroup()
+	igsByZone := map[string][]*compute.InstanceGroup{}
+	for _, zone := range zones {
+		groups, err := m.groupsInZone(igName, zone)
+		if err != nil {
+			return err
+		}
+		for _, managed := range groups.managed {
+			ig, err := m.Get(managed.Name, zone)
+			if err != nil {
+				if utils.IsNotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			igsByZone[zone] = append(igsByZone[zone], ig)
+		}
+	}
+
+	ports, err := neededPorts()
+	if err != nil {
+		return fmt.Errorf("failed to get needed named ports: %w", err)
+	}
+	wantPorts := sets.New[int64](ports...)
+
+	var errs []error
+	for zone, igs := range igsByZone {
+		for _, ig := range igs {
+			namedPorts, stale, missing := m.reconcileNamedPorts(ig.NamedPorts, wantPorts)
+			metrics.PublishNamedPortDrift(len(stale), len(missing))
+			if len(stale) == 0 && len(missing) == 0 {
+				continue
+			}
+			iglogger.V(2).Info("Reconciling named ports of instance group", "key", klog.KRef(zone, ig.Name), "stalePorts", stale, "missingPorts", missing)
+			if err := m.cloud.SetNamedPortsOfInstanceGroup(ig.Name, zone, namedPorts, ig.Fingerprint); err != nil {
+				iglogger.Error(err, "Failed to set named ports of instance group", "key", klog.KRef(zone, ig.Name))
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%v", errs)
+}
+
+// reconcileNamedPorts returns the named ports an instance group should have
+// given its current named ports and the wanted ports, along with the stale
+// ports it prunes and the missing ports it adds.
+func (m *manager) reconcileNamedPorts(current []*compute.NamedPort, wantPorts sets.Set[int64]) (namedPorts []*compute.NamedPort, stale, missing []int64) {
+	existing := sets.New[int64]()
+	for _, np := range current {
+		if np.Name == m.namer.NamedPort(np.Port) && !wantPorts.Has(np.Port) {
+			stale = append(stale, np.Port)
+			continue
+		}
+		existing.Insert(np.Port)
+		namedPorts = append(namedPorts, np)
+	}
+	for _, port := range sets.List(wantPorts) {
+		if existing.Has(port) {
+			continue
+		}
+		missing = append(missing, port)
+		namedPorts = append(namedPorts, &compute.NamedPort{Name: m.namer.NamedPort(port), Port: port})
+	}
+	return namedPorts, stale, missing
+}
+
 // splitNodesByZones takes a list of node names and returns a map of zone:node names.
 // It figures out the zones by asking the zoneLister.
 func (m *manager) splitNodesByZone(names []string, logger klog.Logger) map[string][]string {
@@ -314,7 +481,7 @@ func (m *manager) remove(groupName string, nodeNames []string, zone string, logg
 }
 
 // Sync nodes with the instances in the instance group.
-func (m *manager) Sync(nodes []string, logger klog.Logger) (err error) {
+func (m *manager) Sync(ctx context.Context, nodes []string, logger klog.Logger) (err error) {
 	iglogger := logger.WithName("InstanceGroupsManager")
 	iglogger.V(2).Info("Syncing nodes", "nodes", events.TruncatedStringList(nodes))
 
@@ -363,60 +530,156 @@ func (m *manager) Sync(nodes []string, logger klog.Logger) (err error) {
 		if zone == zonegetter.EmptyZone {
 			continue // skip ensuring instance group for empty zone
 		}
-		igName := m.namer.InstanceGroup()
-		if len(kubeNodesFromZone) > m.maxIGSize {
-			sortedKubeNodesFromZone := sets.NewString(kubeNodesFromZone...).List()
-			loggableNodeList := events.TruncatedStringList(sortedKubeNodesFromZone[m.maxIGSize:])
-			iglogger.Info(fmt.Sprintf("Total number of kubeNodes: %d, truncating to maximum Instance Group size = %d. zone: %s. First truncated instances: %v", len(kubeNodesFromZone), m.maxIGSize, zone, loggableNodeList))
-			kubeNodesFromZone = sortedKubeNodesFromZone[:m.maxIGSize]
+		if err := m.syncZone(ctx, zone, kubeNodesFromZone, emptyZoneNodesNames, iglogger); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		kubeNodes := sets.NewString(kubeNodesFromZone...)
+// syncZone syncs the nodes of a single zone with the members of the cluster
+// instance groups in that zone. Nodes which are members of adopted instance
+// groups are left there and removed from the cluster instance groups, since an
+// instance can be a member of only one load balanced instance group.
+func (m *manager) syncZone(ctx context.Context, zone string, kubeNodesFromZone []string, emptyZoneNodesNames sets.String, iglogger klog.Logger) error {
+	igName := m.namer.InstanceGroup()
+	groups, err := m.groupsInZone(igName, zone)
+	if err != nil {
+		iglogger.Error(err, "Failed to list instance groups", "zone", zone)
+		return err
+	}
 
-		gceNodes := sets.NewString()
-		instances, err := m.cloud.ListInstancesInInstanceGroup(igName, zone, allInstances)
+	kubeNodes := sets.NewString(kubeNodesFromZone...)
+	adoptedNodes := sets.NewString()
+	for _, ig := range groups.adopted {
+		members, err := m.instanceNames(ig.Name, zone, iglogger)
 		if err != nil {
-			iglogger.Error(err, "Failed to list instance from instance group", "zone", zone, "igName", igName)
 			return err
 		}
-		for _, ins := range instances {
-			instance, err := utils.KeyName(ins.Instance)
-			if err != nil {
-				iglogger.Error(err, "Failed to read instance name from ULR, skipping single instance", "Instance URL", ins.Instance)
-			}
-			gceNodes.Insert(instance)
-		}
-
-		removalCandidates := gceNodes.Difference(kubeNodes)
-		iglogger.V(2).Info("Nodes that are removal candidates", "removalCandidates", events.TruncatedStringList(removalCandidates.List()))
+		adoptedNodes.Insert(members.Intersection(kubeNodes).UnsortedList()...)
+	}
+	if adoptedNodes.Len() > 0 {
+		iglogger.V(2).Info("Nodes served by adopted instance groups", "zone", zone, "adoptedNodes", events.TruncatedStringList(adoptedNodes.List()))
+		kubeNodes = kubeNodes.Difference(adoptedNodes)
+	}
 
-		removeNodes := removalCandidates.Difference(emptyZoneNodesNames).List()                                                                   // Do not remove nodes which zone label still need to be assigned
-		iglogger.V(2).Info("Removing nodes (after ignoring nodes without zone assigned)", "removeNodes", events.TruncatedStringList(removeNodes)) // Do not remove nodes which zone label still need to be assigned
+	gceNodes := make([]sets.String, len(groups.managed))
+	current := make([][]string, len(groups.managed))
+	for i, ig := range groups.managed {
+		if gceNodes[i], err = m.instanceNames(ig.Name, zone, iglogger); err != nil {
+			return err
+		}
+		current[i] = gceNodes[i].List()
+	}
 
-		addNodes := kubeNodes.Difference(gceNodes).List()
+	var desired [][]string
+	if m.enableMultipleIGs {
+		desired = assignShards(kubeNodes.List(), current, m.maxIGSize)
+	} else {
+		sortedKubeNodes := kubeNodes.List()
+		if len(sortedKubeNodes) > m.maxIGSize {
+			loggableNodeList := events.TruncatedStringList(sortedKubeNodes[m.maxIGSize:])
+			iglogger.Info(fmt.Sprintf("Total number of kubeNodes: %d, truncating to maximum Instance Group size = %d. zone: %s. First truncated instances: %v", len(sortedKubeNodes), m.maxIGSize, zone, loggableNodeList))
+			sortedKubeNodes = sortedKubeNodes[:m.maxIGSize]
+		}
+		desired = [][]string{sortedKubeNodes}
+	}
 
-		iglogger.V(2).Info("Removing nodes", "removeNodes", events.TruncatedStringList(removeNodes))
-		iglogger.V(2).Info("Adding nodes", "addNodes", events.TruncatedStringList(addNodes))
+	// Remove nodes from all shards first, so that nodes moved between shards
+	// are never members of two load balanced instance groups.
+	for i, ig := range groups.managed {
+		removalCandidates := gceNodes[i].Difference(sets.NewString(desired[i]...))
+		iglogger.V(2).Info("Nodes that are removal candidates", "name", ig.Name, "removalCandidates", events.TruncatedStringList(removalCandidates.List()))
 
+		removeNodes := removalCandidates.Difference(emptyZoneNodesNames).List() // Do not remove nodes which zone label still need to be assigned
+		iglogger.V(2).Info("Removing nodes", "name", ig.Name, "removeNodes", events.TruncatedStringList(removeNodes))
+		if len(removeNodes) == 0 {
+			continue
+		}
 		start := time.Now()
-		if len(removeNodes) != 0 {
-			metrics.PublishInstanceGroupRemove(len(removeNodes))
-			err = m.remove(igName, removeNodes, zone, iglogger)
-			iglogger.V(2).Info("Remove finished", "name", igName, "err", err, "timeTaken", time.Now().Sub(start), "removeNodes", events.TruncatedStringList(removeNodes))
-			if err != nil {
-				return err
-			}
+		metrics.PublishInstanceGroupRemove(len(removeNodes))
+		err = m.remove(ig.Name, removeNodes, zone, iglogger)
+		iglogger.V(2).Info("Remove finished", "name", ig.Name, "err", err, "timeTaken", time.Now().Sub(start), "removeNodes", events.TruncatedStringList(removeNodes))
+		if err != nil {
+			return err
 		}
+	}
 
-		start = time.Now()
-		if len(addNodes) != 0 {
-			metrics.PublishInstanceGroupAdd(len(addNodes))
-			err = m.add(igName, addNodes, zone, iglogger)
-			iglogger.V(2).Info("Add finished", "name", igName, "err", err, "timeTaken", time.Now().Sub(start), "addNodes", events.TruncatedStringList(addNodes))
-			if err != nil {
+	for i, nodes := range desired {
+		name := shardName(igName, i)
+		existing := sets.NewString()
+		if i < len(gceNodes) {
+			existing = gceNodes[i]
+		}
+		addNodes := sets.NewString(nodes...).Difference(existing).List()
+		iglogger.V(2).Info("Adding nodes", "name", name, "addNodes", events.TruncatedStringList(addNodes))
+		if len(addNodes) == 0 {
+			continue
+		}
+		if i >= len(groups.managed) {
+			if err := m.createShard(name, zone, groups.managed[0], iglogger); err != nil {
 				return err
 			}
+			if err := m.linkShard(ctx, name, zone, groups.managed[0], iglogger); err != nil {
+				return err
+			}
+		}
+		start := time.Now()
+		metrics.PublishInstanceGroupAdd(len(addNodes))
+		err = m.add(name, addNodes, zone, iglogger)
+		iglogger.V(2).Info("Add finished", "name", name, "err", err, "timeTaken", time.Now().Sub(start), "addNodes", events.TruncatedStringList(addNodes))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instanceNames returns the names of the instances in the instance group.
+func (m *manager) instanceNames(igName, zone string, logger klog.Logger) (sets.String, error) {
+	names := sets.NewString()
+	instances, err := m.cloud.ListInstancesInInstanceGroup(igName, zone, allInstances)
+	if err != nil {
+		logger.Error(err, "Failed to list instance from instance group", "zone", zone, "igName", igName)
+		return nil, err
+	}
+	for _, ins := range instances {
+		instance, err := utils.KeyName(ins.Instance)
+		if err != nil {
+			logger.Error(err, "Failed to read instance name from ULR, skipping single instance", "Instance URL", ins.Instance)
 		}
+		names.Insert(instance)
+	}
+	return names, nil
+}
+
+// createShard creates a new shard of the cluster instance group with the named
+// ports of the primary instance group, so that it can be linked to the same
+// backend services.
+func (m *manager) createShard(name, zone string, primary *compute.InstanceGroup, logger klog.Logger) error {
+	logger.V(2).Info("Creating instance group shard", "key", klog.KRef(zone, name))
+	ig := &compute.InstanceGroup{Name: name, NamedPorts: primary.NamedPorts}
+	if err := m.cloud.CreateInstanceGroup(ig, zone); err != nil && !utils.IsHTTPErrorCode(err, http.StatusConflict) {
+		logger.Error(err, "Failed to create instance group shard", "key", klog.KRef(zone, name))
+		return err
+	}
+	return nil
+}
+
+// linkShard links the new shard to the backend services of the primary instance
+// group. Nothing is linked to a primary instance group which does not exist yet.
+func (m *manager) linkShard(ctx context.Context, name, zone string, primary *compute.InstanceGroup, logger klog.Logger) error {
+	if m.shardLinker == nil || primary.SelfLink == "" {
+		return nil
+	}
+	shard, err := m.Get(name, zone)
+	if err != nil {
+		logger.Error(err, "Failed to get instance group shard", "key", klog.KRef(zone, name))
+		return err
+	}
+	if err := m.shardLinker.LinkShard(ctx, primary, shard, logger); err != nil {
+		logger.Error(err, "Failed to link instance group shard to backend services", "key", klog.KRef(zone, name))
+		return err
 	}
 	return nil
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package instancegroups
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -26,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/ingress-gce/pkg/utils"
 
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/googleapi"
 	"k8s.io/klog/v2"
 
@@ -162,7 +165,7 @@ func TestNodePoolSyncWithEmptyZone(t *testing.T) {
 
 			// run sync step
 			nodeNames := getNodeNames(tc.kubeNodes)
-			err = pool.Sync(nodeNames, klog.TODO())
+			err = pool.Sync(context.Background(), nodeNames, klog.TODO())
 			if err != nil {
 				t.Fatalf("pool.Sync(%v) returned error %v, want nil", nodeNames, err)
 			}
@@ -291,7 +294,7 @@ func TestNodePoolSync(t *testing.T) {
 
 		// run sync with expected kubeNodes
 		apiCallsCountBeforeSync := len(fakeGCEInstanceGroups.calls)
-		err = pool.Sync(testCase.kubeNodes.List(), klog.TODO())
+		err = pool.Sync(context.Background(), testCase.kubeNodes.List(), klog.TODO())
 		if err != nil {
 			t.Fatalf("pool.Sync(%v) returned error %v, want nil", testCase.kubeNodes.List(), err)
 		}
@@ -333,7 +336,7 @@ func TestNodePoolSync(t *testing.T) {
 
 		// call sync one more time and check that it will be no-op and will not cause any api calls
 		apiCallsCountBeforeSync = len(fakeGCEInstanceGroups.calls)
-		err = pool.Sync(testCase.kubeNodes.List(), klog.TODO())
+		err = pool.Sync(context.Background(), testCase.kubeNodes.List(), klog.TODO())
 		if err != nil {
 			t.Fatalf("pool.Sync(%v) returned error %v, want nil", testCase.kubeNodes.List(), err)
 		}
@@ -368,11 +371,11 @@ func TestInstanceAlreadyMemberOfIG(t *testing.T) {
 	}
 
 	// run sync with 2 times, expect not error despite fakeInstanceGroups will return 'memberAlreadyExists'
-	err = pool.Sync(kubeNodes.List(), klog.TODO())
+	err = pool.Sync(context.Background(), kubeNodes.List(), klog.TODO())
 	if err != nil {
 		t.Fatalf("pool.Sync() returned error %v, want nil", err)
 	}
-	err = pool.Sync(kubeNodes.List(), klog.TODO())
+	err = pool.Sync(context.Background(), kubeNodes.List(), klog.TODO())
 	if err != nil {
 		t.Fatalf("pool.Sync() returned error %v, want nil", err)
 	}
@@ -459,7 +462,7 @@ func TestNodePoolSyncHugeCluster(t *testing.T) {
 			allKubeNodes = append(allKubeNodes, tc.kubeNodesZoneC.List()...)
 
 			// Execute manager's main instance group sync function
-			err = pool.Sync(allKubeNodes, klog.TODO())
+			err = pool.Sync(context.Background(), allKubeNodes, klog.TODO())
 			if err != nil {
 				t.Fatalf("pool.Sync(_) returned error %v, want nil", err)
 			}
@@ -480,7 +483,7 @@ func TestNodePoolSyncHugeCluster(t *testing.T) {
 			}
 
 			apiCallsCountBeforeSync := len(fakeGCEInstanceGroups.calls)
-			err = pool.Sync(allKubeNodes, klog.TODO())
+			err = pool.Sync(context.Background(), allKubeNodes, klog.TODO())
 			if err != nil {
 				t.Fatalf("pool.Sync(_) returned error %v, want nil", err)
 			}
@@ -515,7 +518,7 @@ func TestInstanceTruncatingOrder(t *testing.T) {
 	}
 
 	// Execute manager's main instance group sync function
-	err = pool.Sync(kubeNodesZoneA, klog.TODO())
+	err = pool.Sync(context.Background(), kubeNodesZoneA, klog.TODO())
 	if err != nil {
 		t.Fatalf("pool.Sync(_) returned error %v, want nil", err)
 	}
@@ -639,7 +642,6 @@ func TestSetNamedPorts(t *testing.T) {
 			[]int64{80, 83},
 			[]int64{80, 81, 82, 83},
 		},
-		// TODO: Add tests to remove named ports when we support that.
 	}
 	for _, testCase := range testCases {
 		igs, err := pool.EnsureInstanceGroupsAndPorts("ig", testCase.activePorts, klog.TODO())
@@ -661,6 +663,69 @@ func TestSetNamedPorts(t *testing.T) {
 	}
 }
 
+func TestReconcileNamedPorts(t *testing.T) {
+	namedPorts := func(ports ...int64) []*compute.NamedPort {
+		var nps []*compute.NamedPort
+		for _, port := range ports {
+			nps = append(nps, &compute.NamedPort{Name: defaultNamer.NamedPort(port), Port: port})
+		}
+		return nps
+	}
+	userPort := &compute.NamedPort{Name: "http", Port: 8080}
+	primary := &compute.InstanceGroup{Name: defaultNamer.InstanceGroup(), NamedPorts: append(namedPorts(80, 81), userPort)}
+	shard := &compute.InstanceGroup{Name: shardName(defaultNamer.InstanceGroup(), 1), NamedPorts: namedPorts(81)}
+	other := &compute.InstanceGroup{Name: "other", NamedPorts: namedPorts(80, 81)}
+	fakeIGs := NewFakeInstanceGroups(map[string]IGsToInstances{
+		defaultTestZone: {
+			primary: sets.NewString(),
+			shard:   sets.NewString(),
+			other:   sets.NewString(),
+		},
+	}, 10)
+	pool := newNodePoolWithConfig(t, fakeIGs, ManagerConfig{MaxIGSize: 10, EnableMultipleIGs: true}, "n1")
+
+	if err := pool.ReconcileNamedPorts(func() ([]int64, error) { return []int64{81, 82}, nil }, klog.TODO()); err != nil {
+		t.Fatalf("ReconcileNamedPorts() returned error %v", err)
+	}
+
+	for _, tc := range []struct {
+		ig   *compute.InstanceGroup
+		want []*compute.NamedPort
+	}{
+		{ig: primary, want: append(namedPorts(81), userPort, namedPorts(82)[0])},
+		{ig: shard, want: namedPorts(81, 82)},
+		{ig: other, want: namedPorts(80, 81)},
+	} {
+		ig, err := fakeIGs.GetInstanceGroup(tc.ig.Name, defaultTestZone)
+		if err != nil {
+			t.Fatalf("GetInstanceGroup(%s) returned error %v", tc.ig.Name, err)
+		}
+		if diff := cmp.Diff(tc.want, ig.NamedPorts); diff != "" {
+			t.Errorf("named ports of %s have diff (-want +got):\n%s", tc.ig.Name, diff)
+		}
+	}
+
+	wantErr := fmt.Errorf("lister not ready")
+	if err := pool.ReconcileNamedPorts(func() ([]int64, error) { return nil, wantErr }, klog.TODO()); !errors.Is(err, wantErr) {
+		t.Errorf("ReconcileNamedPorts() returned error %v, want %v", err, wantErr)
+	}
+
+	// Named ports changed after the instance groups are read are not
+	// overwritten, since the fingerprint no longer matches.
+	changed := &compute.InstanceGroup{Name: shard.Name, NamedPorts: namedPorts(81, 82, 83), Fingerprint: "changed"}
+	neededPorts := func() ([]int64, error) {
+		delete(fakeIGs.zonesToIGsToInstances[defaultTestZone], shard)
+		fakeIGs.zonesToIGsToInstances[defaultTestZone][changed] = sets.NewString()
+		return []int64{81}, nil
+	}
+	if err := pool.ReconcileNamedPorts(neededPorts, klog.TODO()); err == nil {
+		t.Errorf("ReconcileNamedPorts() returned no error for instance group %s changed concurrently", shard.Name)
+	}
+	if diff := cmp.Diff(namedPorts(81, 82, 83), changed.NamedPorts); diff != "" {
+		t.Errorf("named ports of %s have diff (-want +got):\n%s", shard.Name, diff)
+	}
+}
+
 func TestGetInstanceReferences(t *testing.T) {
 	maxIGSize := 1000
 	zonesToIGs := map[string]IGsToInstances{
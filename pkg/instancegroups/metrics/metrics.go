@@ -24,6 +24,9 @@ import (
 const (
 	AddOperationTypeLabel    = "Add"
 	RemoveOperationTypeLabel = "Remove"
+
+	StaleNamedPortLabel   = "Stale"
+	MissingNamedPortLabel = "Missing"
 )
 
 var (
@@ -42,6 +45,13 @@ var (
 		},
 		[]string{"operation_type"},
 	)
+	instanceGroupNamedPortDrift = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "instance_group_named_port_drift_count",
+			Help: "Count of stale or missing named ports found on instance groups during named port reconciliation",
+		},
+		[]string{"drift_type"},
+	)
 )
 
 // init metrics.
@@ -50,6 +60,8 @@ func init() {
 	prometheus.MustRegister(instanceGroupEventSize)
 	klog.V(3).Infof("Registering Instance Group event count metric: %v", instanceGroupEventCount)
 	prometheus.MustRegister(instanceGroupEventCount)
+	klog.V(3).Infof("Registering Instance Group named port drift metric: %v", instanceGroupNamedPortDrift)
+	prometheus.MustRegister(instanceGroupNamedPortDrift)
 }
 
 // PublishInstanceGroupAdd counts how many times with attempt to add nodes to an instance group and the number of nodes present in each attempt.
@@ -63,3 +75,9 @@ func PublishInstanceGroupRemove(count int) {
 	instanceGroupEventSize.WithLabelValues(RemoveOperationTypeLabel).Observe((float64(count)))
 	instanceGroupEventCount.WithLabelValues(RemoveOperationTypeLabel).Inc()
 }
+
+// PublishNamedPortDrift counts the stale and missing named ports found on an instance group during named port reconciliation.
+func PublishNamedPortDrift(stale, missing int) {
+	instanceGroupNamedPortDrift.WithLabelValues(StaleNamedPortLabel).Add(float64(stale))
+	instanceGroupNamedPortDrift.WithLabelValues(MissingNamedPortLabel).Add(float64(missing))
+}
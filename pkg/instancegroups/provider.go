@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+)
+
+// cloudProvider is a Provider backed by gce.Cloud. It overrides
+// SetNamedPortsOfInstanceGroup since gce.Cloud sets named ports without a
+// fingerprint.
+type cloudProvider struct {
+	*gce.Cloud
+}
+
+// NewCloudProvider returns a Provider managing the instance groups of the cloud.
+func NewCloudProvider(cloud *gce.Cloud) Provider {
+	return &cloudProvider{Cloud: cloud}
+}
+
+// SetNamedPortsOfInstanceGroup implements Provider.
+func (p *cloudProvider) SetNamedPortsOfInstanceGroup(igName, zone string, namedPorts []*compute.NamedPort, fingerprint string) error {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+
+	req := &compute.InstanceGroupsSetNamedPortsRequest{NamedPorts: namedPorts, Fingerprint: fingerprint}
+	return p.Compute().InstanceGroups().SetNamedPorts(ctx, meta.ZonalKey(igName, zone), req)
+}
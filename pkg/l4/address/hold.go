@@ -1,6 +1,7 @@
 package address
 
 import (
+	"context"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	api_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,7 +24,7 @@ type HoldConfig struct {
 }
 
 type ForwardingRuleDeleter interface {
-	Delete(name string) error
+	Delete(ctx context.Context, name string) error
 }
 
 type HoldResult struct {
@@ -35,7 +36,7 @@ type HoldResult struct {
 // HoldExternalIPv4 will determine which IP to use for forwarding rules
 // and will hold it for future forwarding rules. After binding
 // IP to a forwarding rule call Release to prevent leaks.
-func HoldExternalIPv4(cfg HoldConfig) (HoldResult, error) {
+func HoldExternalIPv4(ctx context.Context, cfg HoldConfig) (HoldResult, error) {
 	var err error
 	res := HoldResult{
 		Release: func() error { return nil },
@@ -77,7 +78,7 @@ func HoldExternalIPv4(cfg HoldConfig) (HoldResult, error) {
 	// check if it matches network tiers from forwarding rule and external ip Address.
 	// If they do not match, tear down the existing resources with the wrong tier.
 	if isFromAnnotation {
-		if err := tearDownRulesIfNetworkTierMismatch(cfg.ForwardingRuleDeleter, cfg.ExistingRules, netTier); err != nil {
+		if err := tearDownRulesIfNetworkTierMismatch(ctx, cfg.ForwardingRuleDeleter, cfg.ExistingRules, netTier); err != nil {
 			log.Error(err, "TearDownRulesIfNetworkTierMismatch returned error")
 			return res, err
 		}
@@ -88,7 +89,7 @@ func HoldExternalIPv4(cfg HoldConfig) (HoldResult, error) {
 		}
 	}
 
-	res.IP, res.Managed, err = addrMgr.HoldAddress()
+	res.IP, res.Managed, err = addrMgr.HoldAddress(ctx)
 	if err != nil {
 		log.Error(err, "HoldAddress returned error")
 		return res, err
@@ -110,7 +111,7 @@ func pickForwardingRuleToInferIP(existingRules []*composite.ForwardingRule) *com
 	return nil
 }
 
-func tearDownRulesIfNetworkTierMismatch(deleter ForwardingRuleDeleter, existingRules []*composite.ForwardingRule, tier cloud.NetworkTier) error {
+func tearDownRulesIfNetworkTierMismatch(ctx context.Context, deleter ForwardingRuleDeleter, existingRules []*composite.ForwardingRule, tier cloud.NetworkTier) error {
 	for _, rule := range existingRules {
 		if rule == nil {
 			continue
@@ -120,7 +121,7 @@ func tearDownRulesIfNetworkTierMismatch(deleter ForwardingRuleDeleter, existingR
 			continue
 		}
 
-		if err := deleter.Delete(rule.Name); err != nil {
+		if err := deleter.Delete(ctx, rule.Name); err != nil {
 			return err
 		}
 	}
@@ -203,7 +203,7 @@ func TestHoldExternalIPv4(t *testing.T) {
 			cfg := arrange(t, tC.existingRules, tC.service)
 
 			// Act
-			got, err := address.HoldExternalIPv4(cfg)
+			got, err := address.HoldExternalIPv4(context.Background(), cfg)
 			if err != nil {
 				t.Fatalf("unexpected err: %v", err)
 			}
@@ -289,7 +289,7 @@ func arrange(t *testing.T, existingRules []*composite.ForwardingRule, service *a
 	}
 	provider := forwardingrules.New(fakeGCE, meta.VersionGA, meta.Regional, klog.TODO())
 	for _, rule := range existingRules {
-		if err := provider.Create(rule); err != nil {
+		if err := provider.Create(context.Background(), rule); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package address
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -102,7 +103,7 @@ func NewManager(svc gce.CloudAddressService, serviceName, region, subnetURL, nam
 // HoldAddress will ensure that the IP is reserved with an address - either owned by the controller
 // or by a user. If the address is not the addressManager.name, then it's assumed to be a user's address.
 // The string returned is the reserved IP address and IPAddressType indicating if IP address is managed by controller.
-func (m *Manager) HoldAddress() (string, IPAddressType, error) {
+func (m *Manager) HoldAddress(ctx context.Context) (string, IPAddressType, error) {
 	// HoldAddress starts with retrieving the address that we use for this load balancer (by name).
 	// Retrieving an address by IP will indicate if the IP is reserved and if reserved by the user
 	// or the controller, but won't tell us the current state of the controller's IP. The address
@@ -158,7 +159,7 @@ func (m *Manager) HoldAddress() (string, IPAddressType, error) {
 		}
 	}
 
-	return m.ensureAddressReservation()
+	return m.ensureAddressReservation(ctx)
 }
 
 // ReleaseAddress will release the address if it's owned by the controller.
@@ -216,11 +217,11 @@ func DecompressAddr(addr string) string {
 	return strings.Replace(addr, "::", expanded, 1)
 }
 
-func (m *Manager) IsAddressInForwardingRules() bool {
+func (m *Manager) IsAddressInForwardingRules(ctx context.Context) bool {
 	// Check if the address is in forwarding rules. If it is, we don't need to reserve it.
 	if gceCloud, ok := m.svc.(*gce.Cloud); ok {
 		key := meta.RegionalKey(m.name, m.region)
-		fr, err := composite.GetForwardingRule(gceCloud, key, meta.VersionGA, m.frLogger)
+		fr, err := composite.GetForwardingRule(ctx, gceCloud, key, meta.VersionGA, m.frLogger)
 		if err != nil {
 			m.frLogger.V(4).Info("failed to lookup forwarding rules, err: %v", err)
 			return false
@@ -257,7 +258,7 @@ func (m *Manager) IsAddressInForwardingRules() bool {
 
 // ensureAddressReservation reserves ip address and returns address as a string,
 // IPAddressType indicating whether ip address is managed by controller and error.
-func (m *Manager) ensureAddressReservation() (string, IPAddressType, error) {
+func (m *Manager) ensureAddressReservation(ctx context.Context) (string, IPAddressType, error) {
 	// Try reserving the IP with controller-owned address name
 	// If am.targetIP is an empty string, a new IP will be created.
 	newAddr := &compute.Address{
@@ -344,7 +345,7 @@ func (m *Manager) ensureAddressReservation() (string, IPAddressType, error) {
 		return "", IPAddrUndefined, fmt.Errorf("failed to reserve address %q with no specific IP, err: %v", m.name, reserveErr)
 	}
 
-	if ok := m.IsAddressInForwardingRules(); ok {
+	if ok := m.IsAddressInForwardingRules(ctx); ok {
 		return m.targetIP, IPAddrUnmanaged, nil
 	} else {
 		m.frLogger.V(4).Info("IP not found in forwarding rules", "ip", m.targetIP)
@@ -17,6 +17,7 @@ limitations under the License.
 package address_test
 
 import (
+	"context"
 	"net"
 	"testing"
 
@@ -136,7 +137,7 @@ func TestAddressManagerExternallyOwned(t *testing.T) {
 	require.NoError(t, err)
 
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", targetIP, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
-	ipToUse, ipType, err := mgr.HoldAddress()
+	ipToUse, ipType, err := mgr.HoldAddress(context.Background())
 	require.NoError(t, err)
 	assert.NotEmpty(t, ipToUse)
 	assert.Equal(t, address.IPAddrUnmanaged, ipType, "IP Address should not be marked as controller's managed")
@@ -169,7 +170,7 @@ func TestAddressManagerExternallyOwnedAndOrphaned(t *testing.T) {
 	require.NoError(t, err)
 
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, externalName, externalIP, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
-	ipToUse, ipType, err := mgr.HoldAddress()
+	ipToUse, ipType, err := mgr.HoldAddress(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, ipToUse, externalIP)
 	assert.Equal(t, address.IPAddrUnmanaged, ipType, "IP Address should not be marked as controller's managed")
@@ -196,7 +197,7 @@ func TestAddressManagerNonExisting(t *testing.T) {
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", targetIP, cloud.SchemeExternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
 
 	svc.Compute().(*cloud.MockGCE).MockAddresses.InsertHook = test.InsertAddressNotAllocatedToProjectErrorHook
-	_, _, err = mgr.HoldAddress()
+	_, _, err = mgr.HoldAddress(context.Background())
 	require.Error(t, err)
 	assert.True(t, l4utils.IsIPConfigurationError(err))
 }
@@ -215,7 +216,7 @@ func TestAddressManagerWrongTypeReserved(t *testing.T) {
 
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", targetIP, cloud.SchemeExternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
 
-	_, _, err = mgr.HoldAddress()
+	_, _, err = mgr.HoldAddress(context.Background())
 	require.Error(t, err)
 	assert.True(t, l4utils.IsIPConfigurationError(err))
 }
@@ -232,7 +233,7 @@ func TestAddressManagerExternallyOwnedWrongNetworkTier(t *testing.T) {
 	require.NoError(t, err, "")
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", targetIP, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
 	svc.Compute().(*cloud.MockGCE).MockAddresses.InsertHook = test.InsertAddressNetworkErrorHook
-	_, _, err = mgr.HoldAddress()
+	_, _, err = mgr.HoldAddress(context.Background())
 	if err == nil || !l4utils.IsNetworkTierError(err) {
 		t.Fatalf("mgr.HoldAddress() = %v, l4utils.IsNetworkTierError(err) = %t, want %t", err, l4utils.IsNetworkTierError(err), true)
 	}
@@ -250,7 +251,7 @@ func TestAddressManagerBadExternallyOwned(t *testing.T) {
 	require.NoError(t, err)
 
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", targetIP, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
-	ad, _, err := mgr.HoldAddress()
+	ad, _, err := mgr.HoldAddress(context.Background())
 	assert.NotNil(t, err) // FIXME
 	require.Equal(t, ad, "")
 }
@@ -272,7 +273,7 @@ func TestAddressManagerBadExternallyOwnedFromAnnotation(t *testing.T) {
 	require.NoError(t, err)
 
 	mgr := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, addrName, targetIP, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, klog.TODO())
-	ad, _, err := mgr.HoldAddress()
+	ad, _, err := mgr.HoldAddress(context.Background())
 	assert.NotNil(t, err) // FIXME
 	require.Equal(t, ad, "")
 
@@ -410,7 +411,7 @@ func TestIsAddressInForwardingRules(t *testing.T) {
 			}
 
 			m := address.NewManager(svc, testSvcName, vals.Region, testSubnet, testLBName, "", tc.address, cloud.SchemeInternal, cloud.NetworkTierPremium, tc.ipVersion, klog.TODO())
-			got := m.IsAddressInForwardingRules()
+			got := m.IsAddressInForwardingRules(context.Background())
 			if got != tc.wantResult {
 				t.Errorf("IsAddressInForwardingRules() unexpectet result, want = %v, got=%v, svc=%+v", tc.wantResult, tc.address, svc)
 			}
@@ -419,7 +420,7 @@ func TestIsAddressInForwardingRules(t *testing.T) {
 }
 
 func testHoldAddress(t *testing.T, mgr *address.Manager, svc gce.CloudAddressService, name, region, targetIP, scheme, netTier string) {
-	ipToUse, ipType, err := mgr.HoldAddress()
+	ipToUse, ipType, err := mgr.HoldAddress(context.Background())
 	require.NoError(t, err)
 	assert.NotEmpty(t, ipToUse)
 	assert.Equal(t, address.IPAddrManaged, ipType, "IP Address should be marked as controller's managed")
@@ -534,7 +535,7 @@ func mustCreateForwardingRule(t *testing.T, cloud *gce.Cloud, fr *composite.Forw
 	t.Helper()
 
 	key := meta.RegionalKey(fr.Name, cloud.Region())
-	err := composite.CreateForwardingRule(cloud, key, fr, klog.TODO())
+	err := composite.CreateForwardingRule(context.Background(), cloud, key, fr, klog.TODO())
 	if err != nil {
 		t.Fatalf("composite.CreateForwardingRule(_, %s, %v) returned error %v, want nil", key, fr, err)
 	}
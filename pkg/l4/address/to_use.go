@@ -6,6 +6,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/klog/v2"
 
@@ -16,8 +17,9 @@ import (
 // address evaluated in the following order:
 //
 //  1. Use static addresses annotation "networking.gke.io/load-balancer-ip-addresses".
-//  2. Use .Spec.LoadBalancerIP (old field, was deprecated).
-//  3. Use existing forwarding rule IP. If subnetwork was changed (or no existing IP),
+//  2. Use adopted address annotation "networking.gke.io/l4-adopt-address".
+//  3. Use .Spec.LoadBalancerIP (old field, was deprecated).
+//  4. Use existing forwarding rule IP. If subnetwork was changed (or no existing IP),
 //     reset the IP (by returning empty string).
 func IPv4ToUse(cloud *gce.Cloud, recorder record.EventRecorder, svc *v1.Service, fwdRule *composite.ForwardingRule, requestedSubnet string) (ipAddress, ipName string, err error) {
 	// Get value from new annotation which support both IPv4 and IPv6
@@ -27,11 +29,18 @@ func IPv4ToUse(cloud *gce.Cloud, recorder record.EventRecorder, svc *v1.Service,
 	}
 	if ipv4FromAnnotation != "" {
 		if svc.Spec.LoadBalancerIP != "" {
-			recorder.Event(svc, v1.EventTypeNormal, "MixedStaticIP", "Found both .Spec.LoadBalancerIP and \"networking.gke.io/load-balancer-ip-addresses\" annotation. Consider using annotation only.")
+			recorder.Event(svc, v1.EventTypeNormal, events.ReasonMixedStaticIP, "Found both .Spec.LoadBalancerIP and \"networking.gke.io/load-balancer-ip-addresses\" annotation. Consider using annotation only.")
 		}
 		return ipv4FromAnnotation, ipNameFromAnnotation, nil
 		// if no value from annotation (for example, annotation has only IPv6 addresses) -- continue
 	}
+	adoptedIPv4, adoptedIPName, err := annotations.FromService(svc).AdoptedIPv4Address(cloud)
+	if err != nil {
+		return "", "", err
+	}
+	if adoptedIPv4 != "" {
+		return adoptedIPv4, adoptedIPName, nil
+	}
 	if svc.Spec.LoadBalancerIP != "" {
 		return svc.Spec.LoadBalancerIP, "", nil
 	}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"strings"
+
+	"k8s.io/cloud-provider-gcp/providers/gce"
+)
+
+const (
+	// AdoptForwardingRuleKey is annotated on an L4 Service to take over an existing,
+	// user-created IPv4 forwarding rule (by name) instead of creating a new one.
+	// Adopted forwarding rules are updated in place and are never deleted by the controller.
+	AdoptForwardingRuleKey = "networking.gke.io/l4-adopt-forwarding-rule"
+	// AdoptBackendServiceKey is annotated on an L4 Service to take over an existing,
+	// user-created regional backend service (by name) instead of creating a new one.
+	// Adopted backend services are updated in place and are never deleted by the controller.
+	AdoptBackendServiceKey = "networking.gke.io/l4-adopt-backend-service"
+	// AdoptAddressKey is annotated on an L4 Service to take over an existing,
+	// user-reserved regional IPv4 address (by name). The address is never released by the controller.
+	AdoptAddressKey = "networking.gke.io/l4-adopt-address"
+)
+
+// AdoptedResources holds names of user-created GCE resources that
+// an L4 Service requested to be managed by the controller.
+type AdoptedResources struct {
+	ForwardingRule string
+	BackendService string
+	Address        string
+}
+
+// IsEmpty returns true if no resources are adopted.
+func (ar AdoptedResources) IsEmpty() bool {
+	return ar.ForwardingRule == "" && ar.BackendService == "" && ar.Address == ""
+}
+
+// AdoptedResources returns the names of the resources the Service wants to adopt.
+func (svc *Service) AdoptedResources() AdoptedResources {
+	return AdoptedResources{
+		ForwardingRule: strings.TrimSpace(svc.v[AdoptForwardingRuleKey]),
+		BackendService: strings.TrimSpace(svc.v[AdoptBackendServiceKey]),
+		Address:        strings.TrimSpace(svc.v[AdoptAddressKey]),
+	}
+}
+
+// AdoptedIPv4Address returns IPv4 address from networking.gke.io/l4-adopt-address annotation.
+// If no IPv4 address found, returns empty string.
+func (svc *Service) AdoptedIPv4Address(cloud *gce.Cloud) (ipAddress, ipName string, err error) {
+	return ipAddressFromAnnotation(svc, cloud, AdoptAddressKey, IPv4Version)
+}
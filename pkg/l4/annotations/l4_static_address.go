@@ -23,20 +23,20 @@ const (
 // IPv4AddressAnnotation return IPv4 address from networking.gke.io/load-balancer-ip-addresses annotation.
 // If no IPv4 address found, returns empty string.
 func (svc *Service) IPv4AddressAnnotation(cloud *gce.Cloud) (ipAddress, ipName string, err error) {
-	return ipAddressFromAnnotation(svc, cloud, IPv4Version)
+	return ipAddressFromAnnotation(svc, cloud, StaticL4AddressesAnnotationKey, IPv4Version)
 }
 
 // IPv6AddressAnnotation return IPv6 address from networking.gke.io/load-balancer-ip-addresses annotation.
 // If no IPv6 address found, returns empty string.
 func (svc *Service) IPv6AddressAnnotation(cloud *gce.Cloud) (ipAddress, ipName string, err error) {
-	return ipAddressFromAnnotation(svc, cloud, IPv6Version)
+	return ipAddressFromAnnotation(svc, cloud, StaticL4AddressesAnnotationKey, IPv6Version)
 }
 
-// ipAddressFromAnnotation checks annotation networking.gke.io/load-balancer-ip-addresses,
+// ipAddressFromAnnotation checks the given annotation (e.g. networking.gke.io/load-balancer-ip-addresses),
 // which should store comma separate names of IP Addresses reserved in google cloud,
 // and returns first address and its name that matches required IpVersion (IPV4 or IPV6).
-func ipAddressFromAnnotation(svc *Service, cloud *gce.Cloud, ipVersion string) (ipAddress, ipName string, err error) {
-	annotationVal, ok := svc.v[StaticL4AddressesAnnotationKey]
+func ipAddressFromAnnotation(svc *Service, cloud *gce.Cloud, annotationKey string, ipVersion string) (ipAddress, ipName string, err error) {
+	annotationVal, ok := svc.v[annotationKey]
 	if !ok {
 		return "", "", nil
 	}
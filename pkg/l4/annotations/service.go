@@ -104,6 +104,17 @@ const (
 	WeightedL4AnnotationKey = "networking.gke.io/weighted-load-balancing"
 	// Service annotation value for using pods-per-node Weighted load balancing in both ILB and NetlB
 	WeightedL4AnnotationPodsPerNode = "pods-per-node"
+	// Service annotation value for Weighted load balancing in both ILB and NetLB, with weights of GCE_VM_IP NEG
+	// endpoints derived from EndpointSlice topology hints and node capacity.
+	WeightedL4AnnotationEndpointHints = "endpoint-hints"
+	// NodeCapacityAnnotationKey is annotated on Nodes to scale their weight in endpoint-hints Weighted load balancing.
+	// The value is a positive integer, nodes without the annotation have capacity 1.
+	NodeCapacityAnnotationKey = "networking.gke.io/l4-node-capacity"
+	// EndpointWeightAnnotationPrefix is followed by the name of a GCE_VM_IP NEG to form the Node annotation
+	// carrying the weight of the node in that NEG for endpoint-hints Weighted load balancing. The health check
+	// responder of the node reports it in the X-Load-Balancing-Endpoint-Weight header of the health check response.
+	// It is only published with --publish-l4-endpoint-weights.
+	EndpointWeightAnnotationPrefix = "l4-endpoint-weight.networking.gke.io/"
 
 	// Service annotation key for specifying L4LBConfig
 	L4LBConfigKey = "networking.gke.io/l4lb-config"
@@ -194,6 +205,17 @@ func HasWeightedLBPodsPerNodeAnnotation(service *v1.Service) bool {
 	return false
 }
 
+// HasWeightedLBEndpointHintsAnnotation checks if the given service has endpoint-hints Weighted load balancing annotation
+func HasWeightedLBEndpointHintsAnnotation(service *v1.Service) bool {
+	if service == nil {
+		return false
+	}
+	if val, ok := service.Annotations[WeightedL4AnnotationKey]; ok && val == WeightedL4AnnotationEndpointHints {
+		return true
+	}
+	return false
+}
+
 // HasLoadBalancerClass checks if the given service has a specific loadBalancerClass set.
 func HasLoadBalancerClass(service *v1.Service, key string) bool {
 	if service.Spec.LoadBalancerClass != nil {
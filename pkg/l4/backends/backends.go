@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net/http"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/flags"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/l4lbconfig"
 	"k8s.io/ingress-gce/pkg/network"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -37,6 +39,7 @@ const (
 	DefaultConnectionDrainingTimeoutSeconds = 30
 	defaultTrackingMode                     = "PER_CONNECTION"
 	PerSessionTrackingMode                  = "PER_SESSION" // the only one supported with strong session affinity
+	DefaultConnectionPersistence            = "DEFAULT_FOR_PROTOCOL"
 	ZonalAffinityEnabledSpillover           = "ZONAL_AFFINITY_SPILL_CROSS_ZONE"
 	DefaultZonalAffinitySpilloverRatio      = 0
 	ZonalAffinityDisabledSpillover          = "ZONAL_AFFINITY_DISABLED"
@@ -100,10 +103,13 @@ type L4BackendServiceParams struct {
 	NamespacedName           types.NamespacedName
 	NetworkInfo              *network.NetworkInfo
 	ConnectionTrackingPolicy *composite.BackendServiceConnectionTrackingPolicy
-	LocalityLbPolicy         LocalityLBPolicyType
-	EnableZonalAffinity      bool
-	LogConfig                *composite.BackendServiceLogConfig
-	LogConfigControlEnabled  bool
+	// ConnectionTrackingControlEnabled specifies that ConnectionTrackingPolicy comes from
+	// the L4LBConfig of the service and has to be applied regardless of the Pool configuration.
+	ConnectionTrackingControlEnabled bool
+	LocalityLbPolicy                 LocalityLBPolicyType
+	EnableZonalAffinity              bool
+	LogConfig                        *composite.BackendServiceLogConfig
+	LogConfigControlEnabled          bool
 }
 
 var versionPrecedence = map[meta.Version]int{
@@ -152,7 +158,7 @@ func isLowerAPIVersion(a, b meta.Version) bool {
 }
 
 // Update a BackendService given the composite type.
-func (p *Pool) Update(be *composite.BackendService, beLogger klog.Logger) error {
+func (p *Pool) Update(ctx context.Context, be *composite.BackendService, beLogger klog.Logger) error {
 	// Ensure the backend service has the proper version before updating.
 	be.Version = readAPIVersionFromL4Description(be.Description, beLogger)
 	scope, err := composite.ScopeFromSelfLink(be.SelfLink)
@@ -164,19 +170,19 @@ func (p *Pool) Update(be *composite.BackendService, beLogger klog.Logger) error
 	if err != nil {
 		return err
 	}
-	if err := composite.UpdateBackendService(p.cloud, key, be, beLogger); err != nil {
+	if err := composite.UpdateBackendService(ctx, p.cloud, key, be, beLogger); err != nil {
 		return err
 	}
 	return nil
 }
 
 // Get a composite BackendService given a required version.
-func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (*composite.BackendService, error) {
+func (p *Pool) Get(ctx context.Context, name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) (*composite.BackendService, error) {
 	key, err := composite.CreateKey(p.cloud, name, scope)
 	if err != nil {
 		return nil, err
 	}
-	be, err := composite.GetBackendService(p.cloud, key, version, beLogger)
+	be, err := composite.GetBackendService(ctx, p.cloud, key, version, beLogger)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +192,7 @@ func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogg
 	versionRequired := readAPIVersionFromL4Description(be.Description, beLogger)
 
 	if isLowerAPIVersion(versionRequired, version) {
-		be, err = composite.GetBackendService(p.cloud, key, versionRequired, beLogger)
+		be, err = composite.GetBackendService(ctx, p.cloud, key, versionRequired, beLogger)
 		if err != nil {
 			return nil, err
 		}
@@ -195,7 +201,7 @@ func (p *Pool) Get(name string, version meta.Version, scope meta.KeyType, beLogg
 }
 
 // Delete a BackendService given its name.
-func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) error {
+func (p *Pool) Delete(ctx context.Context, name string, version meta.Version, scope meta.KeyType, beLogger klog.Logger) error {
 	beLogger.Info("Deleting backend service")
 
 	key, err := composite.CreateKey(p.cloud, name, scope)
@@ -203,7 +209,7 @@ func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beL
 		return err
 	}
 	beLogger = beLogger.WithValues("backendKey", key)
-	err = composite.DeleteBackendService(p.cloud, key, version, beLogger)
+	err = composite.DeleteBackendService(ctx, p.cloud, key, version, beLogger)
 	if err != nil {
 		if utils.IsHTTPErrorCode(err, http.StatusNotFound) || utils.IsInUsedByError(err) {
 			// key also contains region information.
@@ -218,13 +224,13 @@ func (p *Pool) Delete(name string, version meta.Version, scope meta.KeyType, beL
 }
 
 // List BackendService names that are managed by this pool.
-func (p *Pool) List(key *meta.Key, version meta.Version, beLogger klog.Logger) ([]*composite.BackendService, error) {
+func (p *Pool) List(ctx context.Context, key *meta.Key, version meta.Version, beLogger klog.Logger) ([]*composite.BackendService, error) {
 	// TODO: for consistency with the rest of this sub-package this method
 	// should return a list of backend ports.
 	var backends []*composite.BackendService
 	var err error
 
-	backends, err = composite.ListBackendServices(p.cloud, key, version, beLogger, filter.None)
+	backends, err = composite.ListBackendServices(ctx, p.cloud, key, version, beLogger, filter.None)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +257,7 @@ func apiVersionRequiredbyServiceFeatures(params L4BackendServiceParams) meta.Ver
 }
 
 // EnsureL4BackendService creates or updates the backend service with the given name.
-func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger klog.Logger) (*composite.BackendService, l4utils.ResourceSyncStatus, error) {
+func (p *Pool) EnsureL4BackendService(ctx context.Context, params L4BackendServiceParams, beLogger klog.Logger) (*composite.BackendService, l4utils.ResourceSyncStatus, error) {
 	start := time.Now()
 	beLogger = beLogger.WithValues("L4BackendServiceParams", params)
 	beLogger.V(2).Info("EnsureL4BackendService started")
@@ -267,7 +273,7 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 	}
 	expectedVersion := apiVersionRequiredbyServiceFeatures(params)
 
-	currentBS, err := composite.GetBackendService(p.cloud, key, expectedVersion, beLogger)
+	currentBS, err := composite.GetBackendService(ctx, p.cloud, key, expectedVersion, beLogger)
 	if err != nil && !utils.IsNotFoundError(err) {
 		return nil, l4utils.ResourceResync, err
 	}
@@ -303,8 +309,9 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 		expectedBS.NetworkPassThroughLbTrafficPolicy = zonalAffinityDisabledTrafficPolicy()
 	}
 
-	// We need this configuration only for Strong Session Affinity feature
-	if p.useConnectionTrackingPolicy {
+	// We need this configuration for Strong Session Affinity feature and for policies from L4LBConfig
+	manageConnectionTracking := p.useConnectionTrackingPolicy || params.ConnectionTrackingControlEnabled
+	if manageConnectionTracking {
 		beLogger.V(2).Info(fmt.Sprintf("EnsureL4BackendService: using connection tracking policy: %+v", params.ConnectionTrackingPolicy))
 		expectedBS.ConnectionTrackingPolicy = params.ConnectionTrackingPolicy
 	}
@@ -322,7 +329,7 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 	// Create backend service if none was found
 	if currentBS == nil {
 		beLogger.V(2).Info("EnsureL4BackendService: creating backend service")
-		err := composite.CreateBackendService(p.cloud, key, expectedBS, beLogger)
+		err := composite.CreateBackendService(ctx, p.cloud, key, expectedBS, beLogger)
 		if err != nil {
 			return nil, l4utils.ResourceResync, err
 		}
@@ -330,7 +337,7 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 		// We need to perform a GCE call to re-fetch the object we just created
 		// so that the "Fingerprint" field is filled in. This is needed to update the
 		// object without error. The lookup is also needed to populate the selfLink.
-		createdBS, err := composite.GetBackendService(p.cloud, key, expectedBS.Version, beLogger)
+		createdBS, err := composite.GetBackendService(ctx, p.cloud, key, expectedBS.Version, beLogger)
 		return createdBS, l4utils.ResourceUpdate, err
 	} else {
 		// Determine the appropriate API version to use for updating the backend service
@@ -338,7 +345,7 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 		expectedBS.Version = selectApiVersionForUpdate(apiVersion, expectedBS.Version)
 	}
 
-	if backendSvcEqual(expectedBS, currentBS, p.useConnectionTrackingPolicy, params.LogConfigControlEnabled) {
+	if backendSvcEqual(expectedBS, currentBS, manageConnectionTracking, params.LogConfigControlEnabled) {
 		beLogger.V(2).Info("EnsureL4BackendService: backend service did not change, skipping update")
 		return currentBS, l4utils.ResourceResync, nil
 	}
@@ -351,12 +358,12 @@ func (p *Pool) EnsureL4BackendService(params L4BackendServiceParams, beLogger kl
 	expectedBS.Fingerprint = currentBS.Fingerprint
 	// Copy backends to avoid detaching them during update. This could be replaced with a patch call in the future.
 	expectedBS.Backends = currentBS.Backends
-	if err := composite.UpdateBackendService(p.cloud, key, expectedBS, beLogger); err != nil {
+	if err := composite.UpdateBackendService(ctx, p.cloud, key, expectedBS, beLogger); err != nil {
 		return nil, l4utils.ResourceUpdate, err
 	}
 	beLogger.V(2).Info("EnsureL4BackendService: updated backend service successfully")
 
-	updatedBS, err := composite.GetBackendService(p.cloud, key, expectedBS.Version, beLogger)
+	updatedBS, err := composite.GetBackendService(ctx, p.cloud, key, expectedBS.Version, beLogger)
 	return updatedBS, l4utils.ResourceUpdate, err
 }
 
@@ -481,13 +488,30 @@ func zonalAffinityEqual(a, b *composite.BackendService) bool {
 
 // connectionTrackingPolicyEqual returns true if both elements are equal
 // and return false if at least one parameter is different
+// Backend services without policy use the GCE default one.
 func connectionTrackingPolicyEqual(a, b *composite.BackendServiceConnectionTrackingPolicy) bool {
-	if a == nil || b == nil {
-		return a == b
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil {
+		a = l4lbconfig.DefaultConnectionTrackingPolicy()
+	}
+	if b == nil {
+		b = l4lbconfig.DefaultConnectionTrackingPolicy()
 	}
 	return a.TrackingMode == b.TrackingMode &&
 		a.EnableStrongAffinity == b.EnableStrongAffinity &&
-		a.IdleTimeoutSec == b.IdleTimeoutSec
+		a.IdleTimeoutSec == b.IdleTimeoutSec &&
+		connectionPersistence(a) == connectionPersistence(b)
+}
+
+// connectionPersistence returns ConnectionPersistenceOnUnhealthyBackends of the policy,
+// treating the unset value as the GCE default.
+func connectionPersistence(policy *composite.BackendServiceConnectionTrackingPolicy) string {
+	if policy.ConnectionPersistenceOnUnhealthyBackends == "" {
+		return DefaultConnectionPersistence
+	}
+	return policy.ConnectionPersistenceOnUnhealthyBackends
 }
 
 func zonalAffinityEnabledTrafficPolicy() *composite.BackendServiceNetworkPassThroughLbTrafficPolicy {
@@ -1,6 +1,7 @@
 package backends
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -39,6 +40,8 @@ func TestEnsureL4BackendService(t *testing.T) {
 		schemeType                  string
 		enableStrongSessionAffinity bool
 		connectionTrackingPolicy    *composite.BackendServiceConnectionTrackingPolicy
+		// connectionTrackingControlEnabled is set when the policy comes from L4LBConfig
+		connectionTrackingControlEnabled bool
 	}{
 		{
 			desc:             "Test basic Backend Service with Internal scheme type",
@@ -88,6 +91,20 @@ func TestEnsureL4BackendService(t *testing.T) {
 				TrackingMode:         perSessionTrackingMode,
 			},
 		},
+		{
+			desc:             "Test Backend Service with Connection Tracking Policy from L4LBConfig",
+			serviceName:      "test-service",
+			serviceNamespace: "test-ns",
+			protocol:         "TCP",
+			affinityType:     string(v1.ServiceAffinityNone),
+			schemeType:       string(cloud.SchemeInternal),
+			connectionTrackingPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+				IdleTimeoutSec:                           prolongedIdleTimeout,
+				TrackingMode:                             perConnectionTrackingMode,
+				ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST",
+			},
+			connectionTrackingControlEnabled: true,
+		},
 		{
 			desc:                        "Test Backend Service with enabled SSA but empty connectionTrackingPolicy",
 			serviceName:                 "test-service",
@@ -108,16 +125,17 @@ func TestEnsureL4BackendService(t *testing.T) {
 			bsName := l4namer.L4Backend(tc.serviceNamespace, tc.serviceName)
 			network := &network.NetworkInfo{IsDefault: false, NetworkURL: "https://www.googleapis.com/compute/v1/projects/test-poject/global/networks/test-vpc"}
 			backendParams := L4BackendServiceParams{
-				Name:                     bsName,
-				HealthCheckLink:          hcLink,
-				Protocol:                 tc.protocol,
-				SessionAffinity:          tc.affinityType,
-				Scheme:                   tc.schemeType,
-				NamespacedName:           namespacedName,
-				NetworkInfo:              network,
-				ConnectionTrackingPolicy: tc.connectionTrackingPolicy,
+				Name:                             bsName,
+				HealthCheckLink:                  hcLink,
+				Protocol:                         tc.protocol,
+				SessionAffinity:                  tc.affinityType,
+				Scheme:                           tc.schemeType,
+				NamespacedName:                   namespacedName,
+				NetworkInfo:                      network,
+				ConnectionTrackingPolicy:         tc.connectionTrackingPolicy,
+				ConnectionTrackingControlEnabled: tc.connectionTrackingControlEnabled,
 			}
-			bs, _, err := backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+			bs, _, err := backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 			if err != nil {
 				t.Errorf("EnsureL4BackendService failed")
 			}
@@ -148,7 +166,7 @@ func TestEnsureL4BackendService(t *testing.T) {
 			if bs.ConnectionDraining == nil || bs.ConnectionDraining.DrainingTimeoutSec != DefaultConnectionDrainingTimeoutSeconds {
 				t.Errorf("BackendService.ConnectionDraining was not populated correctly, want=connection draining with %v, got=%v", DefaultConnectionDrainingTimeoutSeconds, bs.ConnectionDraining)
 			}
-			if tc.enableStrongSessionAffinity {
+			if tc.enableStrongSessionAffinity || tc.connectionTrackingControlEnabled {
 				if diff := cmp.Diff(bs.ConnectionTrackingPolicy, tc.connectionTrackingPolicy); diff != "" {
 					t.Errorf("BackendService.ConnectionTrackingPolicy was not populated correctly, expected to be different: %s", diff)
 				}
@@ -225,7 +243,7 @@ func TestEnsureL4BackendServiceUpdate(t *testing.T) {
 				NamespacedName:  namespacedName,
 				NetworkInfo:     network,
 			}
-			_, updated, err := backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+			_, updated, err := backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 			if err != nil {
 				t.Errorf("EnsureL4BackendService failed")
 			}
@@ -233,7 +251,7 @@ func TestEnsureL4BackendServiceUpdate(t *testing.T) {
 				t.Errorf("EnsureL4BackendService was supposed to return update=true but returned=false")
 			}
 			// do a second update to verify if no GCE resource update was done.
-			_, updated, err = backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+			_, updated, err = backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 			if err != nil {
 				t.Errorf("EnsureL4BackendService failed")
 			}
@@ -250,7 +268,7 @@ func TestEnsureL4BackendServiceUpdate(t *testing.T) {
 				NamespacedName:  namespacedName,
 				NetworkInfo:     network,
 			}
-			_, updated, err = backendPool.EnsureL4BackendService(updatedBackendParams, klog.TODO())
+			_, updated, err = backendPool.EnsureL4BackendService(context.Background(), updatedBackendParams, klog.TODO())
 			if err != nil {
 				t.Errorf("EnsureL4BackendService failed")
 			}
@@ -299,7 +317,7 @@ func TestEnsureL4BackendServiceDoesNotDetachBackends(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to create key %v", err)
 			}
-			err = composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO())
+			err = composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to create the existing backend service: %v", err)
 			}
@@ -315,7 +333,7 @@ func TestEnsureL4BackendServiceDoesNotDetachBackends(t *testing.T) {
 				NetworkInfo:              network,
 				ConnectionTrackingPolicy: noConnectionTrackingPolicy,
 			}
-			bs, _, err := backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+			bs, _, err := backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 			if err != nil {
 				t.Errorf("EnsureL4BackendService failed")
 			}
@@ -997,6 +1015,39 @@ func TestBackendSvcEqual(t *testing.T) {
 			newBackendService: &composite.BackendService{},
 			wantEqual:         true,
 		},
+		{
+			desc:                      "Test different ConnectionPersistenceOnUnhealthyBackends",
+			compareConnectionTracking: true,
+			oldBackendService: &composite.BackendService{
+				ConnectionTrackingPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+					TrackingMode:                             perConnectionTrackingMode,
+					ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST",
+				},
+			},
+			newBackendService: &composite.BackendService{
+				ConnectionTrackingPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+					TrackingMode:                             perConnectionTrackingMode,
+					ConnectionPersistenceOnUnhealthyBackends: "ALWAYS_PERSIST",
+				},
+			},
+			wantEqual: false,
+		},
+		{
+			desc:                      "Test unset ConnectionPersistenceOnUnhealthyBackends equals the default",
+			compareConnectionTracking: true,
+			oldBackendService: &composite.BackendService{
+				ConnectionTrackingPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+					TrackingMode:                             perConnectionTrackingMode,
+					ConnectionPersistenceOnUnhealthyBackends: DefaultConnectionPersistence,
+				},
+			},
+			newBackendService: &composite.BackendService{
+				ConnectionTrackingPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+					TrackingMode: perConnectionTrackingMode,
+				},
+			},
+			wantEqual: true,
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1129,7 +1180,7 @@ func TestUpdateLocalityLBPolicy(t *testing.T) {
 				SessionAffinity:  "NONE",
 				HealthChecks:     []string{""},
 			}
-			err = composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO())
+			err = composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to create the existing backend service: %v", err)
 			}
@@ -1139,7 +1190,7 @@ func TestUpdateLocalityLBPolicy(t *testing.T) {
 				NamespacedName:   namespacedName,
 				LocalityLbPolicy: tc.updatedBSLocalityLbPolicy,
 			}
-			updatedBS, _, err := backendPool.EnsureL4BackendService(updateBackendParams, klog.TODO())
+			updatedBS, _, err := backendPool.EnsureL4BackendService(context.Background(), updateBackendParams, klog.TODO())
 			if updatedBS.LocalityLbPolicy != string(tc.wantBSLocalityLbPolicy) {
 				t.Errorf("Update LocalityLbPolicy failed, got: %v, want %v", updatedBS.LocalityLbPolicy, tc.wantBSLocalityLbPolicy)
 			}
@@ -1310,9 +1361,9 @@ func TestVersionSelectionInUpdate(t *testing.T) {
 			}
 
 			// Create or update the backend service
-			if err := composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO()); err != nil {
+			if err := composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
 				// If it already exists, try updating it
-				if err := composite.UpdateBackendService(fakeGCE, key, existingBS, klog.TODO()); err != nil {
+				if err := composite.UpdateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
 					t.Fatalf("Failed to create/update backend service: %v", err)
 				}
 			}
@@ -1327,7 +1378,7 @@ func TestVersionSelectionInUpdate(t *testing.T) {
 				NamespacedName:  namespacedName,
 			}
 
-			updatedBS, _, err := backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+			updatedBS, _, err := backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 			if err != nil {
 				t.Fatalf("EnsureL4BackendService failed: %v", err)
 			}
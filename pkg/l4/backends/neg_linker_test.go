@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -100,7 +101,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					NamespacedName:  tc.svcPort.ID.Service,
 					NetworkInfo:     nil,
 				}
-				if _, _, err := linker.backendPool.EnsureL4BackendService(params, klog.TODO()); err != nil {
+				if _, _, err := linker.backendPool.EnsureL4BackendService(context.Background(), params, klog.TODO()); err != nil {
 					t.Fatalf("Failed to create backend service to NEG for svcPort %v: %v", tc.svcPort, err)
 				}
 
@@ -136,13 +137,13 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					if tc.svcPort.VMIPNEGEnabled {
 						neg.NetworkEndpointType = string(negtypes.VmIpEndpointType)
 					}
-					err := fakeNEG.CreateNetworkEndpointGroup(neg, key.Zone, klog.TODO())
+					err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, key.Zone, klog.TODO())
 					if err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", tc.svcPort, err)
 					}
 				}
 
-				if err := linker.Link(tc.svcPort, zones); err != nil {
+				if err := linker.Link(context.Background(), tc.svcPort, zones); err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v when populateSvcNeg = %v: %v", tc.svcPort, populateSvcNeg, err)
 				}
 
@@ -154,7 +155,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					if err != nil {
 						t.Fatalf("Failed to create composite key - %v", err)
 					}
-					bs, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+					bs, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 					if err != nil {
 						t.Fatalf("Failed to retrieve backend service using key %+v for svcPort %v: %v", key, tc.svcPort, err)
 					}
@@ -206,7 +207,7 @@ func TestLinkWithDifferentSvcPorts(t *testing.T) {
 					linker.svcNegLister.Update(svcNegAfterShrink)
 				}
 
-				if err := linker.Link(tc.svcPort, shrinkZone); err != nil {
+				if err := linker.Link(context.Background(), tc.svcPort, shrinkZone); err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v when populateSvcNeg = %v: %v", tc.svcPort, populateSvcNeg, err)
 				}
 
@@ -283,7 +284,7 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 							Scope:   scope,
 							Version: version,
 						}
-						if err := fakeNEG.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+						if err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 							t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 						}
 					}
@@ -297,7 +298,7 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 					if err != nil {
 						t.Fatalf("Failed to create Backend Service key: %v", err)
 					}
-					if err := composite.CreateBackendService(fakeGCE, key, prevBe, klog.TODO()); err != nil {
+					if err := composite.CreateBackendService(context.Background(), fakeGCE, key, prevBe, klog.TODO()); err != nil {
 						t.Fatalf("Failed to create Backend Service: %v", err)
 					}
 
@@ -320,11 +321,11 @@ func TestLinkWithNEGUpdates(t *testing.T) {
 						}
 					}
 
-					if err := linker.Link(svcPort, tc.currGroups); err != nil {
+					if err := linker.Link(context.Background(), svcPort, tc.currGroups); err != nil {
 						t.Fatalf("Failed to link Backend Service to NEG: %v", err)
 					}
 
-					updatedBe, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+					updatedBe, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 					if err != nil {
 						t.Fatalf("Failed to get Backend Service: %v", err)
 					}
@@ -412,7 +413,7 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 						Scope:   scope,
 						Version: version,
 					}
-					if err := fakeNEG.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+					if err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 					}
 				}
@@ -426,7 +427,7 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 				if err != nil {
 					t.Fatalf("Failed to create Backend Service key: %v", err)
 				}
-				if err := composite.CreateBackendService(fakeGCE, key, prevBe, klog.TODO()); err != nil {
+				if err := composite.CreateBackendService(context.Background(), fakeGCE, key, prevBe, klog.TODO()); err != nil {
 					t.Fatalf("Failed to create Backend Service: %v", err)
 				}
 
@@ -449,11 +450,11 @@ func TestLinkWithNEGUpdatesWithMultiSubnetCluster(t *testing.T) {
 					}
 				}
 
-				if err := linker.Link(svcPort, tc.currGroups); err != nil {
+				if err := linker.Link(context.Background(), svcPort, tc.currGroups); err != nil {
 					t.Fatalf("Failed to link Backend Service to NEG: %v", err)
 				}
 
-				updatedBe, err := composite.GetBackendService(fakeGCE, key, version, klog.TODO())
+				updatedBe, err := composite.GetBackendService(context.Background(), fakeGCE, key, version, klog.TODO())
 				if err != nil {
 					t.Fatalf("Failed to get Backend Service: %v", err)
 				}
@@ -541,13 +542,13 @@ func TestGetNegSelfLinks(t *testing.T) {
 						Name:    svcPort.NEGName(),
 						Version: meta.VersionGA,
 					}
-					err := fakeNEG.CreateNetworkEndpointGroup(neg, groupKey.Zone, klog.TODO())
+					err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, groupKey.Zone, klog.TODO())
 					if err != nil {
 						t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 					}
 				}
 
-				negLinks, err := linker.getNegSelfLinks(svcPort, groupKeys)
+				negLinks, err := linker.getNegSelfLinks(context.Background(), svcPort, groupKeys)
 				if err != nil {
 					t.Fatalf("Failed to link backend service to NEG for svcPort %v: %v", svcPort, err)
 				}
@@ -675,13 +676,13 @@ func TestGetNegSelfLinksWithMultiSubnetCluster(t *testing.T) {
 					Name:    svcPort.NEGName(),
 					Version: meta.VersionGA,
 				}
-				err := fakeNEG.CreateNetworkEndpointGroup(neg, groupKey.Zone, klog.TODO())
+				err := fakeNEG.CreateNetworkEndpointGroup(context.Background(), neg, groupKey.Zone, klog.TODO())
 				if err != nil {
 					t.Fatalf("unexpected error creating NEG for svcPort %v: %v", svcPort, err)
 				}
 			}
 
-			negLinks, err := linker.getNegSelfLinks(svcPort, groupKeys)
+			negLinks, err := linker.getNegSelfLinks(context.Background(), svcPort, groupKeys)
 			if err != nil {
 				t.Fatalf("Failed to link backend service to NEG for svcPort %v: %v", svcPort, err)
 			}
@@ -14,6 +14,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"fmt"
 	cloudprovider "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -42,7 +43,7 @@ func NewRegionalInstanceGroupLinker(instancePool instancegroups.Manager, backend
 }
 
 // Link performs linking instance groups to regional backend service
-func (linker *RegionalInstanceGroupLinker) Link(sp utils.ServicePort, projectID string, zones []string) error {
+func (linker *RegionalInstanceGroupLinker) Link(ctx context.Context, sp utils.ServicePort, projectID string, zones []string) error {
 	linker.logger.V(2).Info("Link", "servicePort", sp, "projectID", projectID, "zones", zones)
 
 	var igLinks []string
@@ -50,11 +51,19 @@ func (linker *RegionalInstanceGroupLinker) Link(sp utils.ServicePort, projectID
 		key := meta.ZonalKey(sp.IGName(), zone)
 		igSelfLink := cloudprovider.SelfLink(meta.VersionGA, projectID, "instanceGroups", key)
 		igLinks = append(igLinks, igSelfLink)
+
+		additional, err := linker.instancePool.AdditionalInstanceGroups(sp.IGName(), zone, linker.logger)
+		if err != nil {
+			return fmt.Errorf("error listing additional IGs for linking with backend %s: %w", sp.BackendName(), err)
+		}
+		for _, ig := range additional {
+			igLinks = append(igLinks, ig.SelfLink)
+		}
 	}
 	// TODO(cheungdavid): Create regional ig linker logger that contains backendName,
 	// backendVersion, and backendScope before passing to backendPool.Get().
 	// See example in backendSyncer.ensureBackendService().
-	bs, err := linker.backendPool.Get(sp.BackendName(), meta.VersionGA, meta.Regional, linker.logger)
+	bs, err := linker.backendPool.Get(ctx, sp.BackendName(), meta.VersionGA, meta.Regional, linker.logger)
 	if err != nil {
 		return err
 	}
@@ -89,7 +98,7 @@ func (linker *RegionalInstanceGroupLinker) Link(sp utils.ServicePort, projectID
 	}
 
 	linker.logger.V(3).Info("Update Backend", "backendName", sp.BackendName(), "addedBackends", len(addIGs), "totalBackends", len(bs.Backends))
-	if err := linker.backendPool.Update(bs, linker.logger); err != nil {
+	if err := linker.backendPool.Update(ctx, bs, linker.logger); err != nil {
 		return fmt.Errorf("updating backend service %s for IG failed, err:%w", sp.BackendName(), err)
 	}
 	return nil
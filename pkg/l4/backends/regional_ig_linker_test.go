@@ -15,6 +15,7 @@ limitations under the License.
 package backends
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -88,18 +89,18 @@ func TestRegionalLink(t *testing.T) {
 		t.Fatalf("failed to generate test regional ig linker: %v", err)
 	}
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err == nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err == nil {
 		t.Fatalf("Linking when instances does not exist should return error")
 	}
 	if _, err := linker.instancePool.EnsureInstanceGroupsAndPorts(l4Namer.InstanceGroup(), []int64{sp.NodePort}, klog.TODO()); err != nil {
 		t.Fatalf("Unexpected error when ensuring IG for ServicePort %+v: %v", sp, err)
 	}
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err == nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err == nil {
 		t.Fatalf("Linking when backend service does not exist should return error")
 	}
 	createBackendService(t, sp, fakeBackendPool)
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
 		t.Fatalf("Unexpected error in Link. Error: %v", err)
 	}
 
@@ -133,13 +134,13 @@ func TestRegionalUpdateLink(t *testing.T) {
 	}
 	createBackendService(t, sp, fakeBackendPool)
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 	// Add error hook to check that Link function will not call Backend Service Update when no IG was added
 	(fakeGCE.Compute().(*cloud.MockGCE)).MockRegionBackendServices.UpdateHook = test.UpdateRegionBackendServiceWithErrorHookUpdate
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 	be, err := fakeGCE.GetRegionBackendService(sp.BackendName(), fakeGCE.Region())
@@ -172,11 +173,11 @@ func TestRegionalUpdateLinkWithNewBackends(t *testing.T) {
 	}
 	createBackendService(t, sp, fakeBackendPool)
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone, usCentral1CZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone, usCentral1CZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 	be, err := fakeGCE.GetRegionBackendService(sp.BackendName(), fakeGCE.Region())
@@ -219,11 +220,11 @@ func TestRegionalUpdateLinkWithRemovedBackends(t *testing.T) {
 	}
 	createBackendService(t, sp, fakeBackendPool)
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone, usCentral1CZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone, usCentral1CZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 
-	if err := linker.Link(sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
+	if err := linker.Link(context.Background(), sp, fakeGCE.ProjectID(), []string{usCentral1AZone}); err != nil {
 		t.Fatalf("Unexpected error in Link(_). Error: %v", err)
 	}
 	be, err := fakeGCE.GetRegionBackendService(sp.BackendName(), fakeGCE.Region())
@@ -262,7 +263,7 @@ func createBackendService(t *testing.T, sp utils.ServicePort, backendPool *Pool)
 		NetworkInfo:              &network.NetworkInfo{IsDefault: true},
 		ConnectionTrackingPolicy: nil,
 	}
-	if _, _, err := backendPool.EnsureL4BackendService(backendParams, klog.TODO()); err != nil {
+	if _, _, err := backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO()); err != nil {
 		t.Fatalf("Error creating backend service %v", err)
 	}
 }
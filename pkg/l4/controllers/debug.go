@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/l4/resources"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+// l4SyncDebugState is the serializable subset of an L4 ILB or NetLB sync
+// result. The sync error is reported by debug.SyncStatus.
+type l4SyncDebugState struct {
+	SyncType           string                     `json:"syncType"`
+	StartTime          time.Time                  `json:"startTime"`
+	GCEResourceInError string                     `json:"gceResourceInError,omitempty"`
+	ResourceUpdates    string                     `json:"resourceUpdates"`
+	Annotations        map[string]string          `json:"annotations,omitempty"`
+	Conditions         []metav1.Condition         `json:"conditions,omitempty"`
+	Status             *corev1.LoadBalancerStatus `json:"status,omitempty"`
+}
+
+func newILBSyncDebugState(result *resources.L4ILBSyncResult) l4SyncDebugState {
+	return l4SyncDebugState{
+		SyncType:           result.SyncType,
+		StartTime:          result.StartTime,
+		GCEResourceInError: result.GCEResourceInError,
+		ResourceUpdates:    result.ResourceUpdates.String(),
+		Annotations:        result.Annotations,
+		Conditions:         result.Conditions,
+		Status:             result.Status,
+	}
+}
+
+func newNetLBSyncDebugState(result *resources.L4NetLBSyncResult) l4SyncDebugState {
+	return l4SyncDebugState{
+		SyncType:           result.SyncType,
+		StartTime:          result.StartTime,
+		GCEResourceInError: result.GCEResourceInError,
+		ResourceUpdates:    result.GCEResourceUpdate.String(),
+		Annotations:        result.Annotations,
+		Conditions:         result.Conditions,
+		Status:             result.Status,
+	}
+}
+
+// l4DebugInfo is the document served on /debug/l4.
+type l4DebugInfo struct {
+	debug.SyncStatus
+	Requeues int `json:"requeues"`
+}
+
+// recordL4SyncStatus records the outcome of a service sync for the debug
+// endpoints. Deleted services are forgotten.
+func recordL4SyncStatus(tracker *debug.SyncTracker, services cache.Store, key string, err error) {
+	if _, exists, _ := services.GetByKey(key); !exists {
+		tracker.Forget(key)
+		return
+	}
+	tracker.Record(key, err)
+}
+
+func l4DebugInfoFor(tracker *debug.SyncTracker, queue utils.TaskQueue, namespace, name string) (interface{}, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}.String()
+	status, ok := tracker.Get(key)
+	if !ok {
+		return nil, debug.ErrNotFound
+	}
+	return l4DebugInfo{SyncStatus: status, Requeues: queue.NumRequeues(cache.ExplicitKey(key))}, nil
+}
+
+// DebugInfo returns the last sync result of the ILB service. It implements
+// debug.Provider.
+func (l4c *L4Controller) DebugInfo(namespace, name string) (interface{}, error) {
+	return l4DebugInfoFor(l4c.debugTracker, l4c.svcQueue, namespace, name)
+}
+
+// DebugInfo returns the last sync result of the NetLB service. It
+// implements debug.Provider.
+func (lc *L4NetLBController) DebugInfo(namespace, name string) (interface{}, error) {
+	return l4DebugInfoFor(lc.debugTracker, lc.svcQueue, namespace, name)
+}
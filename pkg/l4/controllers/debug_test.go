@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/test"
+)
+
+func TestL4ControllerDebugInfo(t *testing.T) {
+	l4c, _ := newServiceController(t, newFakeGCE(), false)
+	newSvc := test.NewL4ILBService(false, 8080)
+	if _, err := l4c.DebugInfo(newSvc.Namespace, newSvc.Name); !errors.Is(err, debug.ErrNotFound) {
+		t.Fatalf("DebugInfo() for an unsynced service returned %v, want %v", err, debug.ErrNotFound)
+	}
+
+	addILBService(l4c, newSvc)
+	addNEGAndSvcNegL4Controller(l4c, newSvc)
+	key := getKeyForSvc(newSvc, t)
+	if err := l4c.syncWrapper(key); err != nil {
+		t.Fatalf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
+	}
+
+	got, err := l4c.DebugInfo(newSvc.Namespace, newSvc.Name)
+	if err != nil {
+		t.Fatalf("DebugInfo() = %v, want nil", err)
+	}
+	info := got.(l4DebugInfo)
+	if info.LastError != "" || info.LastSyncTime.IsZero() {
+		t.Errorf("DebugInfo() = %+v, want a successful sync to be recorded", info)
+	}
+	state, ok := info.State.(l4SyncDebugState)
+	if !ok {
+		t.Fatalf("DebugInfo().State = %T, want l4SyncDebugState", info.State)
+	}
+	if state.Status == nil || len(state.Status.Ingress) == 0 {
+		t.Errorf("DebugInfo().State.Status = %v, want the load balancer status", state.Status)
+	}
+	if len(state.Annotations) == 0 {
+		t.Errorf("DebugInfo().State.Annotations is empty, want the resource annotations")
+	}
+	if _, err := json.Marshal(info); err != nil {
+		t.Errorf("json.Marshal(DebugInfo()) = %v, want nil", err)
+	}
+
+	// Deleted services are forgotten.
+	deleteILBService(l4c, newSvc)
+	if err := l4c.syncWrapper(key); err != nil {
+		t.Fatalf("Failed to sync deleted service %s, err %v", newSvc.Name, err)
+	}
+	if _, err := l4c.DebugInfo(newSvc.Namespace, newSvc.Name); !errors.Is(err, debug.ErrNotFound) {
+		t.Errorf("DebugInfo() for a deleted service returned %v, want %v", err, debug.ErrNotFound)
+	}
+}
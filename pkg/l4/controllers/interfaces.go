@@ -1,8 +1,11 @@
 package controllers
 
-import "k8s.io/ingress-gce/pkg/composite"
+import (
+	"context"
+	"k8s.io/ingress-gce/pkg/composite"
+)
 
 // ForwardingRulesGetter is an interface which allows getting Google Cloud Forwarding Rules
 type ForwardingRulesGetter interface {
-	Get(name string) (*composite.ForwardingRule, error)
+	Get(ctx context.Context, name string) (*composite.ForwardingRule, error)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	context2 "context"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,15 +39,21 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/ingress-gce/pkg/common/operator"
 	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/backends"
 	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
 	"k8s.io/ingress-gce/pkg/l4/metrics"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	negmetrics "k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/network"
+	"k8s.io/ingress-gce/pkg/ratelimit"
+	"k8s.io/ingress-gce/pkg/systemhealth"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -86,6 +94,11 @@ type L4Controller struct {
 	hasSynced       func() bool
 
 	serviceVersions *serviceVersionsTracker
+	// debugTracker records the outcome of the last sync of each service for
+	// the debug endpoints.
+	debugTracker *debug.SyncTracker
+	// syncTimeline measures the sync latency of services.
+	syncTimeline *synctimeline.Timeline
 
 	logger klog.Logger
 }
@@ -107,6 +120,8 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 		forwardingRules: forwardingrules.New(ctx.Cloud, meta.VersionGA, meta.Regional, logger),
 		enableDualStack: ctx.EnableL4ILBDualStack,
 		serviceVersions: NewServiceVersionsTracker(),
+		debugTracker:    debug.NewSyncTracker(),
+		syncTimeline:    synctimeline.L4ILB,
 		logger:          logger,
 		hasSynced:       ctx.HasSynced,
 	}
@@ -142,8 +157,9 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 			// Check for deletion since updates or deletes show up as Add when controller restarts.
 			if needsILB || l4c.needsDeletion(addSvc) {
 				svcLogger.V(3).Info("ILB Service added, enqueuing")
-				l4c.ctx.Recorder(addSvc.Namespace).Event(addSvc, v1.EventTypeNormal, "ADD", svcKey)
+				l4c.ctx.Recorder(addSvc.Namespace).Event(addSvc, v1.EventTypeNormal, events.ReasonAdd, svcKey)
 				l4c.serviceVersions.SetLastUpdateSeen(svcKey, addSvc.ResourceVersion, svcLogger)
+				l4c.syncTimeline.ObserveChange(svcKey)
 				l4c.svcQueue.Enqueue(addSvc)
 				l4c.enqueueTracker.Track()
 			} else {
@@ -166,6 +182,7 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 			if needsUpdate || needsDeletion {
 				svcLogger.V(3).Info("Service changed, enqueuing", "needsUpdate", needsUpdate, "needsDeletion", needsDeletion)
 				l4c.serviceVersions.SetLastUpdateSeen(svcKey, curSvc.ResourceVersion, svcLogger)
+				l4c.syncTimeline.ObserveChange(svcKey)
 				l4c.svcQueue.Enqueue(curSvc)
 				l4c.enqueueTracker.Track()
 				return
@@ -176,7 +193,7 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 				// this will happen when informers run a resync on all the existing services even when the object is
 				// not modified.
 				svcLogger.V(3).Info("Periodic enqueueing of service")
-				l4c.svcQueue.Enqueue(curSvc)
+				l4c.svcQueue.EnqueueResync(curSvc)
 				l4c.enqueueTracker.Track()
 			} else if needsILB {
 				l4c.serviceVersions.SetLastIgnored(svcKey, curSvc.ResourceVersion, svcLogger)
@@ -195,24 +212,24 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 		logger.V(3).Info("set up SvcNegInformer event handlers")
 	}
 
-	if flags.F.ManageL4LBLogging {
+	if flags.F.ManageL4LBLogging || flags.F.ManageL4LBConnectionTracking {
 		ctx.L4LBConfigInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4c.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4c.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 			UpdateFunc: func(_, obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4c.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4c.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4c.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4c.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 		})
@@ -221,12 +238,12 @@ func NewILBController(ctx *context.ControllerContext, stopCh <-chan struct{}, lo
 	return l4c
 }
 
-func (l4c *L4Controller) enqueueServicesReferencingL4LBConfig(l4LBConfigObject *l4lbconfigv1.L4LBConfig) {
+func (l4c *L4Controller) enqueueServicesReferencingL4LBConfig(ctx context2.Context, l4LBConfigObject *l4lbconfigv1.L4LBConfig) {
 	services := operator.Services(l4c.ctx.Services().List(), l4c.logger).ReferencesL4LBConfig(l4LBConfigObject).AsList()
 	for _, svc := range services {
 		svcKey := utils.ServiceKeyFunc(svc.Namespace, svc.Name)
 		svcLogger := l4c.logger.WithValues("serviceKey", svcKey)
-		if l4c.shouldProcessService(svc, svcLogger) {
+		if l4c.shouldProcessService(ctx, svc, svcLogger) {
 			l4c.serviceVersions.SetLastUpdateSeen(svcKey, svc.ResourceVersion, svcLogger)
 			l4c.svcQueue.Enqueue(svc)
 		}
@@ -234,17 +251,24 @@ func (l4c *L4Controller) enqueueServicesReferencingL4LBConfig(l4LBConfigObject *
 	l4c.enqueueTracker.Track()
 }
 
-func (l4c *L4Controller) SystemHealth() error {
+// SystemHealth returns the health of the controller. It is degraded when
+// the controller is falling behind.
+func (l4c *L4Controller) SystemHealth() systemhealth.Status {
 	lastEnqueueTime := l4c.enqueueTracker.Get()
 	lastSyncTime := l4c.syncTracker.Get()
 	// if lastEnqueue time is more than 30 minutes before the last sync time, the controller is falling behind.
 	// This indicates that the controller was stuck handling a previous update, or sync function did not get invoked.
 	syncTimeLatest := lastEnqueueTime.Add(enqueueToSyncDelayThreshold)
+	status := systemhealth.StatusFromError(nil).
+		WithCacheSynced(l4c.hasSynced()).
+		WithLastSync(lastSyncTime).
+		WithQueueDepth(l4c.svcQueue.Len())
 	controllerHealth := metrics.ControllerHealthyStatus
 	if lastSyncTime.After(syncTimeLatest) {
 		msg := fmt.Sprintf("L4 ILB Sync happened at time %v, %v after enqueue time, last enqueue time %v, threshold is %v", lastSyncTime, lastSyncTime.Sub(lastEnqueueTime), lastEnqueueTime, enqueueToSyncDelayThreshold)
 		// Log here, context/http handler do no log the error.
 		l4c.logger.Error(nil, msg)
+		status = status.Degraded(msg)
 		metrics.PublishL4FailedHealthCheckCount(L4ILBControllerName)
 		controllerHealth = metrics.ControllerUnhealthyStatus
 		// Reset trackers. Otherwise, if there is nothing in the queue then it will report the FailedHealthCheckCount every time the checkHealth is called
@@ -255,7 +279,7 @@ func (l4c *L4Controller) SystemHealth() error {
 	if l4c.enableDualStack {
 		metrics.PublishL4ControllerHealthCheckStatus(l4ILBDualStackControllerName, controllerHealth)
 	}
-	return nil
+	return status
 }
 
 func (l4c *L4Controller) Run() {
@@ -283,7 +307,7 @@ func (l4c *L4Controller) shutdown() {
 // If the service has either the v1 finalizer or the forwarding rule created by v1 implementation(service controller),
 // the subsetting controller will not process it. Processing it will fail forwarding rule creation with the same IP anyway.
 // This check prevents processing of v1-implemented services whose finalizer field got wiped out.
-func (l4c *L4Controller) shouldProcessService(service *v1.Service, svcLogger klog.Logger) bool {
+func (l4c *L4Controller) shouldProcessService(ctx context2.Context, service *v1.Service, svcLogger klog.Logger) bool {
 	// Ignore services with LoadBalancerClass different than "networking.gke.io/l4-regional-internal" used for this controller.
 	// LoadBalancerClass can't be updated (see the field API doc) so we don't need to worry about cleaning up services that changed the class.
 	// Services with a different loadBalancerClass shouldn't even be added to the queue
@@ -311,7 +335,7 @@ func (l4c *L4Controller) shouldProcessService(service *v1.Service, svcLogger klo
 	frLogger := svcLogger.WithValues("forwardingRule", frName)
 	// Processing should continue if an external forwarding rule exists. This can happen if the service is transitioning from External to Internal.
 	// The external forwarding rule might not be deleted by the time this controller starts processing the service.
-	fr, err := l4c.forwardingRules.Get(frName)
+	fr, err := l4c.forwardingRules.Get(ctx, frName)
 	if utils.IsNotFoundError(err) {
 		frLogger.Info("Legacy ForwardingRule not found, start processing service")
 		return true
@@ -329,8 +353,8 @@ func (l4c *L4Controller) shouldProcessService(service *v1.Service, svcLogger klo
 
 // processServiceCreateOrUpdate ensures load balancer resources for the given service, as needed.
 // Returns an error if processing the service update failed.
-func (l4c *L4Controller) processServiceCreateOrUpdate(service *v1.Service, svcLogger klog.Logger) *resources.L4ILBSyncResult {
-	if !l4c.shouldProcessService(service, svcLogger) {
+func (l4c *L4Controller) processServiceCreateOrUpdate(ctx context2.Context, service *v1.Service, trace *synctimeline.Trace, svcLogger klog.Logger) *resources.L4ILBSyncResult {
+	if !l4c.shouldProcessService(ctx, service, svcLogger) {
 		return nil
 	}
 
@@ -361,16 +385,17 @@ func (l4c *L4Controller) processServiceCreateOrUpdate(service *v1.Service, svcLo
 		DisableNodesFirewallProvisioning: l4c.ctx.DisableL4LBFirewall,
 		EnableMixedProtocol:              l4c.ctx.EnableL4ILBMixedProtocol,
 		EnableZonalAffinity:              l4c.ctx.EnableL4ILBZonalAffinity,
+		SyncTrace:                        trace,
 	}
 	if l4c.ctx.L4LBConfigInformer != nil {
 		l4ilbParams.L4LBConfigLister = l4c.ctx.L4LBConfigInformer.GetIndexer()
 	}
 
 	l4 := resources.NewL4Handler(l4ilbParams, svcLogger)
-	syncResult := l4.EnsureInternalLoadBalancer(utils.GetNodeNames(nodes), service)
+	syncResult := l4.EnsureInternalLoadBalancer(ctx, utils.GetNodeNames(nodes), service)
 	// syncResult will not be nil
 	if syncResult.Error != nil {
-		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 			"Error syncing load balancer: %v", syncResult.Error)
 		if resources.IsUserError(syncResult.Error) {
 			syncResult.MetricsLegacyState.IsUserError = true
@@ -381,21 +406,24 @@ func (l4c *L4Controller) processServiceCreateOrUpdate(service *v1.Service, svcLo
 		return syncResult
 	}
 	if syncResult.Status == nil {
-		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 			"Empty status returned, even though there were no errors")
 		syncResult.Error = fmt.Errorf("service status returned by EnsureInternalLoadBalancer for %s is nil",
 			l4.NamespacedName.String())
 		return syncResult
 	}
-	if err = l4c.linkNEG(l4, svcLogger); err != nil {
-		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+	endBackends := trace.Track(synctimeline.PhaseBackends)
+	err = l4c.linkNEG(ctx, l4, svcLogger)
+	endBackends()
+	if err != nil {
+		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 			"Failed to link NEG with Backend Service for load balancer, err: %v", err)
 		syncResult.Error = err
 		return syncResult
 	}
 	err = updateServiceStatus(l4c.ctx, service, syncResult.Status, syncResult.Conditions, nil, svcLogger)
 	if err != nil {
-		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 			"Error updating load balancer status: %v", err)
 		syncResult.Error = err
 		return syncResult
@@ -403,16 +431,16 @@ func (l4c *L4Controller) processServiceCreateOrUpdate(service *v1.Service, svcLo
 	if l4c.enableDualStack {
 		l4c.emitEnsuredDualStackEvent(service)
 		if err = updateL4DualStackResourcesAnnotations(l4c.ctx, service, syncResult.Annotations, svcLogger); err != nil {
-			l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+			l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 				"Failed to update Dual Stack annotations for load balancer, err: %v", err)
 			syncResult.Error = fmt.Errorf("failed to set Dual Stack resource annotations, err: %w", err)
 			return syncResult
 		}
 	} else {
-		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, "SyncLoadBalancerSuccessful",
+		l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, events.ReasonSyncLoadBalancerSuccessful,
 			"Successfully ensured load balancer resources")
 		if err = updateL4ResourcesAnnotations(l4c.ctx, service, syncResult.Annotations, svcLogger); err != nil {
-			l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncLoadBalancerFailed",
+			l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncLoadBalancerFailed,
 				"Failed to update annotations for load balancer, err: %v", err)
 			syncResult.Error = fmt.Errorf("failed to set resource annotations, err: %w", err)
 			return syncResult
@@ -426,11 +454,11 @@ func (l4c *L4Controller) emitEnsuredDualStackEvent(service *v1.Service) {
 	for _, ipFamily := range service.Spec.IPFamilies {
 		ipFamilies = append(ipFamilies, string(ipFamily))
 	}
-	l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, "SyncLoadBalancerSuccessful",
+	l4c.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, events.ReasonSyncLoadBalancerSuccessful,
 		"Successfully ensured %v load balancer resources", strings.Join(ipFamilies, " "))
 }
 
-func (l4c *L4Controller) processServiceDeletion(key string, svc *v1.Service, svcLogger klog.Logger) *resources.L4ILBSyncResult {
+func (l4c *L4Controller) processServiceDeletion(ctx context2.Context, key string, svc *v1.Service, svcLogger klog.Logger) *resources.L4ILBSyncResult {
 	startTime := time.Now()
 	svcLogger.Info("Deleting L4 ILB service")
 	defer func() {
@@ -454,17 +482,17 @@ func (l4c *L4Controller) processServiceDeletion(key string, svc *v1.Service, svc
 	}
 
 	l4 := resources.NewL4Handler(l4ilbParams, svcLogger)
-	l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, "DeletingLoadBalancer", "Deleting load balancer for %s", key)
-	result := l4.EnsureInternalLoadBalancerDeleted(svc)
+	l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, events.ReasonDeletingLoadBalancer, "Deleting load balancer for %s", key)
+	result := l4.EnsureInternalLoadBalancerDeleted(ctx, svc)
 	if result.Error != nil {
-		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancerFailed", "Error deleting load balancer: %v", result.Error)
+		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancerFailed, "Error deleting load balancer: %v", result.Error)
 		return result
 	}
 	// Reset the loadbalancer status first, before resetting annotations.
 	// Other controllers(like service-controller) will process the service update if annotations change, but will ignore a service status change.
 	// Following this order avoids a race condition when a service is changed from LoadBalancer type Internal to External.
 	if err := updateServiceStatus(l4c.ctx, svc, &v1.LoadBalancerStatus{}, []metav1.Condition{}, nil, svcLogger); err != nil {
-		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 			"Error resetting load balancer status to empty: %v", err)
 		result.Error = fmt.Errorf("failed to reset ILB status, err: %w", err)
 		return result
@@ -472,14 +500,14 @@ func (l4c *L4Controller) processServiceDeletion(key string, svc *v1.Service, svc
 	// Also remove any ILB annotations from the service metadata
 	if l4c.enableDualStack {
 		if err := updateL4DualStackResourcesAnnotations(l4c.ctx, svc, nil, svcLogger); err != nil {
-			l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+			l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 				"Error resetting DualStack resource annotations for load balancer: %v", err)
 			result.Error = fmt.Errorf("failed to reset DualStack resource annotations, err: %w", err)
 			return result
 		}
 	} else {
 		if err := updateL4ResourcesAnnotations(l4c.ctx, svc, nil, svcLogger); err != nil {
-			l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+			l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 				"Error resetting resource annotations for load balancer: %v", err)
 			result.Error = fmt.Errorf("failed to reset resource annotations, err: %w", err)
 			return result
@@ -487,17 +515,17 @@ func (l4c *L4Controller) processServiceDeletion(key string, svc *v1.Service, svc
 	}
 
 	if err := common.EnsureDeleteServiceFinalizer(svc, common.ILBFinalizerV2, l4c.ctx.KubeClient, svcLogger); err != nil {
-		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancerFailed",
+		l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancerFailed,
 			"Error removing finalizer from load balancer: %v", err)
 		result.Error = fmt.Errorf("failed to remove ILB finalizer, err: %w", err)
 		return result
 	}
-	l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, "DeletedLoadBalancer", "Deleted load balancer")
+	l4c.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, events.ReasonDeletedLoadBalancer, "Deleted load balancer")
 	return result
 }
 
 // linkNEG associates the NEG to the backendService for the given L4 ILB service.
-func (l4c *L4Controller) linkNEG(l4 *resources.L4, svcLogger klog.Logger) error {
+func (l4c *L4Controller) linkNEG(ctx context2.Context, l4 *resources.L4, svcLogger klog.Logger) error {
 	// link neg to backend service
 	zones, err := l4c.zoneGetter.ListZones(zonegetter.CandidateAndUnreadyNodesFilter, svcLogger)
 	if err != nil {
@@ -507,7 +535,7 @@ func (l4c *L4Controller) linkNEG(l4 *resources.L4, svcLogger klog.Logger) error
 	for _, zone := range zones {
 		groupKeys = append(groupKeys, backends.GroupKey{Zone: zone})
 	}
-	return l4c.NegLinker.Link(l4.ServicePort, groupKeys)
+	return l4c.NegLinker.Link(ctx, l4.ServicePort, groupKeys)
 }
 
 func (l4c *L4Controller) syncWrapper(key string) (err error) {
@@ -522,11 +550,15 @@ func (l4c *L4Controller) syncWrapper(key string) (err error) {
 			err = fmt.Errorf("%s", errMessage)
 		}
 	}()
-	syncErr := l4c.sync(key, svcLogger)
+	priorityCtx := ratelimit.WithPriority(context2.Background(), ratelimit.SyncPriority(l4c.syncTimeline.Pending(key)))
+	ctx, svcLogger, span := tracing.StartSync(priorityCtx, svcLogger, "L4Controller.sync", attribute.String("key", key))
+	syncErr := l4c.sync(ctx, key, svcLogger)
+	tracing.End(span, syncErr)
+	recordL4SyncStatus(l4c.debugTracker, l4c.ctx.ServiceInformer.GetStore(), key, syncErr)
 	return skipUserError(syncErr, svcLogger)
 }
 
-func (l4c *L4Controller) sync(key string, svcLogger klog.Logger) error {
+func (l4c *L4Controller) sync(ctx context2.Context, key string, svcLogger klog.Logger) (err error) {
 	l4c.syncTracker.Track()
 	metrics.PublishL4controllerLastSyncTime(L4ILBControllerName)
 
@@ -538,8 +570,11 @@ func (l4c *L4Controller) sync(key string, svcLogger klog.Logger) error {
 		// The service will not exist if its resources and finalizer are handled by the legacy service controller and
 		// it has been deleted. As long as the V2 finalizer is present, the service will not be deleted by apiserver.
 		svcLogger.V(3).Info("Ignoring delete of service not managed by L4 controller")
+		l4c.syncTimeline.Forget(key)
 		return nil
 	}
+	trace := l4c.syncTimeline.StartSync(key)
+	defer func() { trace.Done(err) }()
 
 	if l4c.ctx.ReadOnlyMode {
 		l4c.serviceVersions.SetProcessed(key, svc.ResourceVersion, true, false, svcLogger)
@@ -553,11 +588,13 @@ func (l4c *L4Controller) sync(key string, svcLogger klog.Logger) error {
 	var result *resources.L4ILBSyncResult
 	if l4c.needsDeletion(svc) {
 		svcLogger.V(2).Info("Deleting ILB resources for service managed by L4 controller")
-		result = l4c.processServiceDeletion(key, svc, svcLogger)
+		result = l4c.processServiceDeletion(ctx, key, svc, svcLogger)
 		if result == nil {
 			return nil
 		}
+		l4c.debugTracker.SetState(key, newILBSyncDebugState(result))
 		l4c.serviceVersions.Delete(key)
+		l4c.syncTimeline.Forget(key)
 		l4c.publishMetrics(result, namespacedName, false, svcLogger)
 		return skipUserError(result.Error, svcLogger)
 	}
@@ -565,11 +602,12 @@ func (l4c *L4Controller) sync(key string, svcLogger klog.Logger) error {
 	// longer needing an ILB.
 	if wantsILB, _ := annotations.WantsL4ILB(svc); wantsILB {
 		svcLogger.V(2).Info("Ensuring ILB resources for service managed by L4 controller")
-		result = l4c.processServiceCreateOrUpdate(svc, svcLogger)
+		result = l4c.processServiceCreateOrUpdate(ctx, svc, trace, svcLogger)
 		if result == nil {
 			// result will be nil if the service was ignored(due to presence of service controller finalizer).
 			return nil
 		}
+		l4c.debugTracker.SetState(key, newILBSyncDebugState(result))
 		svcLogger.V(3).Info("Resources modified in the sync", "modifiedResources", result.ResourceUpdates.String(), "wasResync", isResync)
 		if isResync {
 			if result.ResourceUpdates.WereAnyResourcesModified() {
@@ -610,7 +648,7 @@ func (l4c *L4Controller) needsUpdate(oldService *v1.Service, newService *v1.Serv
 	newSvcWantsILB, newType := annotations.WantsL4ILB(newService)
 	recorder := l4c.ctx.Recorder(oldService.Namespace)
 	if oldSvcWantsILB != newSvcWantsILB {
-		recorder.Eventf(newService, v1.EventTypeNormal, "Type", "%v -> %v", oldType, newType)
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonType, "%v -> %v", oldType, newType)
 		return true
 	}
 
@@ -626,35 +664,35 @@ func (l4c *L4Controller) needsUpdate(oldService *v1.Service, newService *v1.Serv
 	}
 
 	if !reflect.DeepEqual(oldService.Spec.LoadBalancerSourceRanges, newService.Spec.LoadBalancerSourceRanges) {
-		recorder.Eventf(newService, v1.EventTypeNormal, "LoadBalancerSourceRanges", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonLoadBalancerSourceRanges, "%v -> %v",
 			oldService.Spec.LoadBalancerSourceRanges, newService.Spec.LoadBalancerSourceRanges)
 		return true
 	}
 
 	if !portsEqualForLBService(oldService, newService) || oldService.Spec.SessionAffinity != newService.Spec.SessionAffinity {
-		recorder.Eventf(newService, v1.EventTypeNormal, "Ports/SessionAffinity", "Ports %v, SessionAffinity %v -> Ports %v, SessionAffinity  %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonPortsSessionAffinity, "Ports %v, SessionAffinity %v -> Ports %v, SessionAffinity  %v",
 			oldService.Spec.Ports, oldService.Spec.SessionAffinity, newService.Spec.Ports, newService.Spec.SessionAffinity)
 		return true
 	}
 
 	if !reflect.DeepEqual(oldService.Spec.SessionAffinityConfig, newService.Spec.SessionAffinityConfig) {
-		recorder.Eventf(newService, v1.EventTypeNormal, "SessionAffinityConfig", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonSessionAffinityConfig, "%v -> %v",
 			oldService.Spec.SessionAffinityConfig, newService.Spec.SessionAffinityConfig)
 		return true
 	}
 	if oldService.Spec.LoadBalancerIP != newService.Spec.LoadBalancerIP {
-		recorder.Eventf(newService, v1.EventTypeNormal, "LoadbalancerIP", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonLoadbalancerIP, "%v -> %v",
 			oldService.Spec.LoadBalancerIP, newService.Spec.LoadBalancerIP)
 		return true
 	}
 	if len(oldService.Spec.ExternalIPs) != len(newService.Spec.ExternalIPs) {
-		recorder.Eventf(newService, v1.EventTypeNormal, "ExternalIP", "Count: %v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonExternalIP, "Count: %v -> %v",
 			len(oldService.Spec.ExternalIPs), len(newService.Spec.ExternalIPs))
 		return true
 	}
 	for i := range oldService.Spec.ExternalIPs {
 		if oldService.Spec.ExternalIPs[i] != newService.Spec.ExternalIPs[i] {
-			recorder.Eventf(newService, v1.EventTypeNormal, "ExternalIP", "Added: %v",
+			recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonExternalIP, "Added: %v",
 				newService.Spec.ExternalIPs[i])
 			return true
 		}
@@ -662,33 +700,33 @@ func (l4c *L4Controller) needsUpdate(oldService *v1.Service, newService *v1.Serv
 	if !reflect.DeepEqual(oldService.Annotations, newService.Annotations) {
 		// Ignore update if only neg or ilb resources annotations changed, these are added by the neg/l4 controller.
 		if !annotations.OnlyStatusAnnotationsChanged(oldService, newService) {
-			recorder.Eventf(newService, v1.EventTypeNormal, "Annotations", "%v -> %v",
+			recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonAnnotations, "%v -> %v",
 				oldService.Annotations, newService.Annotations)
 			return true
 		}
 	}
 	if oldService.UID != newService.UID {
-		recorder.Eventf(newService, v1.EventTypeNormal, "UID", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonUID, "%v -> %v",
 			oldService.UID, newService.UID)
 		return true
 	}
 	if oldService.Spec.ExternalTrafficPolicy != newService.Spec.ExternalTrafficPolicy {
-		recorder.Eventf(newService, v1.EventTypeNormal, "ExternalTrafficPolicy", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonExternalTrafficPolicy, "%v -> %v",
 			oldService.Spec.ExternalTrafficPolicy, newService.Spec.ExternalTrafficPolicy)
 		return true
 	}
 	if oldService.Spec.HealthCheckNodePort != newService.Spec.HealthCheckNodePort {
-		recorder.Eventf(newService, v1.EventTypeNormal, "HealthCheckNodePort", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonHealthCheckNodePort, "%v -> %v",
 			oldService.Spec.HealthCheckNodePort, newService.Spec.HealthCheckNodePort)
 		return true
 	}
 	if oldService.Spec.TrafficDistribution != newService.Spec.TrafficDistribution {
-		recorder.Eventf(newService, v1.EventTypeNormal, "TrafficDistribution", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonTrafficDistribution, "%v -> %v",
 			oldService.Spec.TrafficDistribution, newService.Spec.TrafficDistribution)
 		return true
 	}
 	if l4c.enableDualStack && !reflect.DeepEqual(oldService.Spec.IPFamilies, newService.Spec.IPFamilies) {
-		recorder.Eventf(newService, v1.EventTypeNormal, "IPFamilies", "%v -> %v",
+		recorder.Eventf(newService, v1.EventTypeNormal, events.ReasonIPFamilies, "%v -> %v",
 			oldService.Spec.IPFamilies, newService.Spec.IPFamilies)
 		return true
 	}
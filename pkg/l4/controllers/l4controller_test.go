@@ -106,7 +106,7 @@ func TestProcessCreateOrUpdate(t *testing.T) {
 			addILBService(l4c, newSvc)
 			addNEGAndSvcNegL4Controller(l4c, newSvc)
 
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 			}
@@ -133,7 +133,7 @@ func TestProcessCreateOrUpdate(t *testing.T) {
 			// set the TrafficPolicy of the service to Local
 			newSvc.Spec.ExternalTrafficPolicy = api_v1.ServiceExternalTrafficPolicyTypeLocal
 			updateILBService(l4c, newSvc)
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync updated service %s, err %v", newSvc.Name, err)
 			}
@@ -161,7 +161,7 @@ func TestProcessCreateOrUpdate(t *testing.T) {
 			// Remove the Internal LoadBalancer annotation, this should trigger a cleanup.
 			delete(newSvc.Annotations, gce.ServiceAnnotationLoadBalancerType)
 			updateILBService(l4c, newSvc)
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync updated service %s, err %v", newSvc.Name, err)
 			}
@@ -186,7 +186,7 @@ func TestProcessCreateOrUpdate(t *testing.T) {
 			newSvc.DeletionTimestamp = &v1.Time{}
 			updateILBService(l4c, newSvc)
 			key, _ := common.KeyFunc(newSvc)
-			if err = l4c.sync(key, klog.TODO()); err != nil {
+			if err = l4c.sync(context2.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync deleted service %s, err %v", key, err)
 			}
 			for _, isShared := range []bool{true, false} {
@@ -211,7 +211,7 @@ func TestProcessUpdateExternalTrafficPolicy(t *testing.T) {
 	svc := test.NewL4ILBService(true, 8080)
 	addILBService(l4c, svc)
 	addNEGAndSvcNegL4Controller(l4c, svc)
-	err := l4c.sync(getKeyForSvc(svc, t), klog.TODO())
+	err := l4c.sync(context2.Background(), getKeyForSvc(svc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", svc.Name, err)
 	}
@@ -225,7 +225,7 @@ func TestProcessUpdateExternalTrafficPolicy(t *testing.T) {
 	// Set ExternalTrafficPolicy to Cluster.
 	svc.Spec.ExternalTrafficPolicy = api_v1.ServiceExternalTrafficPolicyTypeCluster
 	updateILBService(l4c, svc)
-	err = l4c.sync(getKeyForSvc(svc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(svc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync updated service %s, err %v", svc.Name, err)
 	}
@@ -246,7 +246,7 @@ func TestProcessUpdateExternalTrafficPolicy(t *testing.T) {
 	svc.DeletionTimestamp = &v1.Time{}
 	updateILBService(l4c, svc)
 	key, _ := common.KeyFunc(svc)
-	if err = l4c.sync(key, klog.TODO()); err != nil {
+	if err = l4c.sync(context2.Background(), key, klog.TODO()); err != nil {
 		t.Errorf("Failed to sync deleted service %s, err %v", key, err)
 	}
 	// Verify that both health checks were deleted.
@@ -282,7 +282,7 @@ func TestProcessDeletion(t *testing.T) {
 		newSvc := test.NewL4ILBService(false, 8080)
 		addILBService(l4c, newSvc)
 		addNEGAndSvcNegL4Controller(l4c, newSvc)
-		err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+		err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 		if err != nil {
 			t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 		}
@@ -317,7 +317,7 @@ func TestProcessDeletion(t *testing.T) {
 			t.Errorf("Incorrectly marked service %v as needing ILB deletion", newSvc)
 		}
 
-		err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+		err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 		if err != nil {
 			t.Errorf("Failed to sync updated service %s, err %v", newSvc.Name, err)
 		}
@@ -355,7 +355,7 @@ func TestProcessCreateLegacyService(t *testing.T) {
 	// Set the legacy finalizer
 	newSvc.Finalizers = append(newSvc.Finalizers, common.LegacyILBFinalizer)
 	addILBService(l4c, newSvc)
-	err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -387,7 +387,7 @@ func TestProcessCreateServiceWithLegacyInternalForwardingRule(t *testing.T) {
 	// A service can have the v1 finalizer reset due to a buggy script/manual operation.
 	// Subsetting controller should only process the service if it doesn't already have a forwarding rule.
 	createLegacyForwardingRule(t, newSvc, l4c.ctx.Cloud, string(cloud.SchemeInternal))
-	err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -424,7 +424,7 @@ func TestCreateServiceNoLegacyForwordingRule(t *testing.T) {
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
 
 	// Call sync and expect service not provisioned as existing forwarding rule can not be verified
-	err := l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err := l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -449,7 +449,7 @@ func TestCreateServiceUnknownLegacyForwordingRule(t *testing.T) {
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
 
 	// Call sync and expect service not provisioned as existing forwarding rule can not be verified
-	err := l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err := l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -475,7 +475,7 @@ func TestProcessCreateServiceWithLegacyExternalForwardingRule(t *testing.T) {
 	// Service processing should succeed in that case. The external forwarding rule will be deleted
 	// by service controller.
 	createLegacyForwardingRule(t, newSvc, l4c.ctx.Cloud, string(cloud.SchemeExternal))
-	err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -521,7 +521,7 @@ func TestProcessUpdateClusterIPToILBService(t *testing.T) {
 		t.Errorf("Incorrectly marked service %v as not needing update", newSvc)
 	}
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
-	err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly updated service %s, err %v", newSvc.Name, err)
 	}
@@ -645,7 +645,7 @@ func TestProcessServiceOnError(t *testing.T) {
 	newSvc := test.NewL4ILBService(false, 8080)
 	addILBService(l4c, newSvc)
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
-	err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err == nil {
 		t.Fatalf("Failed to generate error when syncing service %s", newSvc.Name)
 	}
@@ -665,7 +665,7 @@ func TestProcessServiceOnUserError(t *testing.T) {
 	newSvc := test.NewL4ILBService(false, 8080)
 	addILBService(l4c, newSvc)
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
-	syncResult := l4c.processServiceCreateOrUpdate(newSvc, klog.TODO())
+	syncResult := l4c.processServiceCreateOrUpdate(context2.Background(), newSvc, nil, klog.TODO())
 	if syncResult.Error == nil {
 		t.Fatalf("Failed to generate error when syncing service %s", newSvc.Name)
 	}
@@ -716,7 +716,7 @@ func TestCreateDeleteDualStackService(t *testing.T) {
 			test.MustCreateDualStackClusterSubnet(t, l4c.ctx.Cloud, "INTERNAL")
 			addILBService(l4c, newSvc)
 			addNEGAndSvcNegL4Controller(l4c, newSvc)
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 			}
@@ -735,7 +735,7 @@ func TestCreateDeleteDualStackService(t *testing.T) {
 			// Remove the Internal LoadBalancer annotation, this should trigger a cleanup.
 			delete(newSvc.Annotations, gce.ServiceAnnotationLoadBalancerType)
 			updateILBService(l4c, newSvc)
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync updated service %s, err %v", newSvc.Name, err)
 			}
@@ -754,7 +754,7 @@ func TestCreateDeleteDualStackService(t *testing.T) {
 			newSvc.DeletionTimestamp = &v1.Time{}
 			updateILBService(l4c, newSvc)
 			key, _ := common.KeyFunc(newSvc)
-			if err = l4c.sync(key, klog.TODO()); err != nil {
+			if err = l4c.sync(context2.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync deleted service %s, err %v", key, err)
 			}
 		})
@@ -771,7 +771,7 @@ func TestProcessDualStackServiceOnUserError(t *testing.T) {
 	newSvc := test.NewL4ILBDualStackService(8080, api_v1.ProtocolTCP, []api_v1.IPFamily{api_v1.IPv4Protocol, api_v1.IPv6Protocol}, api_v1.ServiceExternalTrafficPolicyTypeCluster)
 	addILBService(l4c, newSvc)
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
-	syncResult := l4c.processServiceCreateOrUpdate(newSvc, klog.TODO())
+	syncResult := l4c.processServiceCreateOrUpdate(context2.Background(), newSvc, nil, klog.TODO())
 	if syncResult.Error == nil {
 		t.Fatalf("Failed to generate error when syncing service %s", newSvc.Name)
 	}
@@ -793,7 +793,7 @@ func TestDualStackILBStatusForErrorSync(t *testing.T) {
 	newSvc := test.NewL4ILBDualStackService(8080, api_v1.ProtocolTCP, []api_v1.IPFamily{api_v1.IPv4Protocol, api_v1.IPv6Protocol}, api_v1.ServiceExternalTrafficPolicyTypeCluster)
 	addILBService(l4c, newSvc)
 	addNEGAndSvcNegL4Controller(l4c, newSvc)
-	syncResult := l4c.processServiceCreateOrUpdate(newSvc, klog.TODO())
+	syncResult := l4c.processServiceCreateOrUpdate(context2.Background(), newSvc, nil, klog.TODO())
 	if syncResult.Error == nil {
 		t.Fatalf("Failed to generate error when syncing service %s", newSvc.Name)
 	}
@@ -862,7 +862,7 @@ func TestProcessUpdateILBIPFamilies(t *testing.T) {
 			svc := test.NewL4ILBDualStackService(8080, api_v1.ProtocolTCP, tc.initialIPFamilies, api_v1.ServiceExternalTrafficPolicyTypeCluster)
 			addILBService(l4c, svc)
 			addNEGAndSvcNegL4Controller(l4c, svc)
-			err := l4c.sync(getKeyForSvc(svc, t), klog.TODO())
+			err := l4c.sync(context2.Background(), getKeyForSvc(svc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly added service %s, err %v", svc.Name, err)
 			}
@@ -882,7 +882,7 @@ func TestProcessUpdateILBIPFamilies(t *testing.T) {
 				t.Errorf("Service %v needsUpdate = %t, expected %t", newSvc, needsUpdate, tc.shouldUpdate)
 			}
 
-			err = l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+			err = l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly updated service %s, err %v", newSvc.Name, err)
 			}
@@ -903,7 +903,7 @@ func TestProcessCreateServiceWithLoadBalancerClass(t *testing.T) {
 	testLBClass := "testClass"
 	newSvc.Spec.LoadBalancerClass = &testLBClass
 	addILBService(l4c, newSvc)
-	err := l4c.sync(getKeyForSvc(newSvc, t), klog.TODO())
+	err := l4c.sync(context2.Background(), getKeyForSvc(newSvc, t), klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", newSvc.Name, err)
 	}
@@ -956,7 +956,7 @@ func TestEnsureInternalLoadBalancerClass(t *testing.T) {
 			addILBService(l4c, svc)
 			addNEGAndSvcNegL4Controller(l4c, svc)
 			key := getKeyForSvc(svc, t)
-			err := l4c.sync(key, klog.TODO())
+			err := l4c.sync(context2.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
@@ -974,7 +974,7 @@ func TestEnsureInternalLoadBalancerClass(t *testing.T) {
 			// Update ILB
 			svc.Spec.ExternalTrafficPolicy = api_v1.ServiceExternalTrafficPolicyTypeLocal
 			updateILBService(l4c, svc)
-			err = l4c.sync(key, klog.TODO())
+			err = l4c.sync(context2.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync updated service %s, err %v", svc.Name, err)
 			}
@@ -1004,7 +1004,7 @@ func TestEnsureInternalLoadBalancerClass(t *testing.T) {
 					t.Errorf("Service should not be marked for deletion")
 				}
 			}
-			err = l4c.sync(key, klog.TODO())
+			err = l4c.sync(context2.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync updated service %s, err %v", svc.Name, err)
 			}
@@ -1206,7 +1206,7 @@ func createLegacyForwardingRule(t *testing.T, svc *api_v1.Service, cloud *gce.Cl
 		IPProtocol:          "TCP",
 		LoadBalancingScheme: scheme,
 	}
-	if err = composite.CreateForwardingRule(cloud, key, existingFwdRule, klog.TODO()); err != nil {
+	if err = composite.CreateForwardingRule(context2.Background(), cloud, key, existingFwdRule, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake forwarding rule %s, err %v", frName, err)
 	}
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	context2 "context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/cloud-provider/service/helpers"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
 	l4metrics "k8s.io/ingress-gce/pkg/l4/metrics"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
@@ -39,7 +41,7 @@ import (
 
 const (
 	// ReasonL4LBConfigAnnotationRemoved is used when the annotation for L4LBConfig is removed from the service.
-	ReasonL4LBConfigAnnotationRemoved = "L4LBConfigAnnotationRemoved"
+	ReasonL4LBConfigAnnotationRemoved = events.ReasonL4LBConfigAnnotationRemoved
 )
 
 // computeNewAnnotationsIfNeeded checks if new annotations should be added to service.
@@ -187,7 +189,7 @@ func updateServiceStatus(ctx *context.ControllerContext, svc *v1.Service, newSta
 
 // isHealthCheckDeleted checks if given health check exists in GCE
 func isHealthCheckDeleted(cloud *gce.Cloud, hcName string, logger klog.Logger) bool {
-	_, err := composite.GetHealthCheck(cloud, meta.GlobalKey(hcName), meta.VersionGA, logger)
+	_, err := composite.GetHealthCheck(context2.Background(), cloud, meta.GlobalKey(hcName), meta.VersionGA, logger)
 	return utils.IsNotFoundError(err)
 }
 
@@ -213,7 +215,7 @@ func warnL4FinalizerRemoved(ctx *context.ControllerContext, oldService, newServi
 	}
 	for finalizer, metricFunction := range l4FinalizersWithMetrics {
 		if finalizerWasRemovedUnexpectedly(oldService, newService, finalizer) {
-			ctx.Recorder(newService.Namespace).Eventf(newService, v1.EventTypeWarning, "UnexpectedlyRemovedFinalizer",
+			ctx.Recorder(newService.Namespace).Eventf(newService, v1.EventTypeWarning, events.ReasonUnexpectedlyRemovedFinalizer,
 				"Finalizer %v was unexpectedly removed from the service.", finalizer)
 			metricFunction()
 		}
@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	context2 "context"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -30,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +39,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/ingress-gce/pkg/common/operator"
 	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/instancegroups"
 	"k8s.io/ingress-gce/pkg/l4/backends"
@@ -44,9 +48,13 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/metrics"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	negmetrics "k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/network"
+	"k8s.io/ingress-gce/pkg/ratelimit"
+	"k8s.io/ingress-gce/pkg/systemhealth"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -91,6 +99,11 @@ type L4NetLBController struct {
 	enableRBSDefault            bool
 
 	hasSynced func() bool
+	// debugTracker records the outcome of the last sync of each service for
+	// the debug endpoints.
+	debugTracker *debug.SyncTracker
+	// syncTimeline measures the sync latency of services.
+	syncTimeline *synctimeline.Timeline
 
 	logger klog.Logger
 }
@@ -122,6 +135,8 @@ func NewL4NetLBController(
 		enableNEGSupport:            ctx.EnableL4NetLBNEGs,
 		enableNEGAsDefault:          ctx.EnableL4NetLBNEGsDefault,
 		serviceVersions:             NewServiceVersionsTracker(),
+		debugTracker:                debug.NewSyncTracker(),
+		syncTimeline:                synctimeline.L4NetLB,
 		logger:                      logger,
 		hasSynced:                   ctx.HasSynced,
 		enableRBSDefault:            ctx.EnableL4NetLBRBSByDefault,
@@ -152,10 +167,11 @@ func NewL4NetLBController(
 			addSvc := obj.(*v1.Service)
 			svcKey := utils.ServiceKeyFunc(addSvc.Namespace, addSvc.Name)
 			svcLogger := logger.WithValues("serviceKey", svcKey)
-			if shouldProcess, _ := l4netLBc.shouldProcessService(addSvc, nil, svcLogger); shouldProcess {
+			if shouldProcess, _ := l4netLBc.shouldProcessService(context2.Background(), addSvc, nil, svcLogger); shouldProcess {
 				svcLogger.V(3).Info("L4 External LoadBalancer Service added, enqueuing")
-				l4netLBc.ctx.Recorder(addSvc.Namespace).Event(addSvc, v1.EventTypeNormal, "ADD", svcKey)
+				l4netLBc.ctx.Recorder(addSvc.Namespace).Event(addSvc, v1.EventTypeNormal, events.ReasonAdd, svcKey)
 				l4netLBc.serviceVersions.SetLastUpdateSeen(svcKey, addSvc.ResourceVersion, svcLogger)
+				l4netLBc.syncTimeline.ObserveChange(svcKey)
 				l4netLBc.svcQueue.Enqueue(addSvc)
 				l4netLBc.enqueueTracker.Track()
 			} else {
@@ -168,12 +184,15 @@ func NewL4NetLBController(
 			oldSvc := old.(*v1.Service)
 			svcKey := utils.ServiceKeyFunc(curSvc.Namespace, curSvc.Name)
 			svcLogger := logger.WithValues("serviceKey", svcKey)
-			if shouldProcess, isResync := l4netLBc.shouldProcessService(curSvc, oldSvc, svcLogger); shouldProcess {
+			if shouldProcess, isResync := l4netLBc.shouldProcessService(context2.Background(), curSvc, oldSvc, svcLogger); shouldProcess {
 				svcLogger.V(3).Info("L4 External LoadBalancer Service updated, enqueuing")
-				if !isResync {
+				if isResync {
+					l4netLBc.svcQueue.EnqueueResync(curSvc)
+				} else {
 					l4netLBc.serviceVersions.SetLastUpdateSeen(svcKey, curSvc.ResourceVersion, svcLogger)
+					l4netLBc.syncTimeline.ObserveChange(svcKey)
+					l4netLBc.svcQueue.Enqueue(curSvc)
 				}
-				l4netLBc.svcQueue.Enqueue(curSvc)
 				l4netLBc.enqueueTracker.Track()
 				return
 			}
@@ -191,24 +210,24 @@ func NewL4NetLBController(
 		logger.V(3).Info("set up SvcNegInformer event handlers")
 	}
 
-	if flags.F.ManageL4LBLogging {
+	if flags.F.ManageL4LBLogging || flags.F.ManageL4LBConnectionTracking {
 		ctx.L4LBConfigInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4netLBc.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4netLBc.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 			UpdateFunc: func(_, obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4netLBc.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4netLBc.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				l4lbconfig, ok := obj.(*l4lbconfigv1.L4LBConfig)
 				if ok {
-					l4netLBc.enqueueServicesReferencingL4LBConfig(l4lbconfig)
+					l4netLBc.enqueueServicesReferencingL4LBConfig(context2.Background(), l4lbconfig)
 				}
 			},
 		})
@@ -217,12 +236,12 @@ func NewL4NetLBController(
 	return l4netLBc
 }
 
-func (lc *L4NetLBController) enqueueServicesReferencingL4LBConfig(l4LBConfigObject *l4lbconfigv1.L4LBConfig) {
+func (lc *L4NetLBController) enqueueServicesReferencingL4LBConfig(ctx context2.Context, l4LBConfigObject *l4lbconfigv1.L4LBConfig) {
 	services := operator.Services(lc.ctx.Services().List(), lc.logger).ReferencesL4LBConfig(l4LBConfigObject).AsList()
 	for _, svc := range services {
 		svcKey := utils.ServiceKeyFunc(svc.Namespace, svc.Name)
 		svcLogger := lc.logger.WithValues("serviceKey", svcKey)
-		if shouldProcess, _ := lc.shouldProcessService(svc, nil, svcLogger); shouldProcess {
+		if shouldProcess, _ := lc.shouldProcessService(ctx, svc, nil, svcLogger); shouldProcess {
 			lc.serviceVersions.SetLastUpdateSeen(svcKey, svc.ResourceVersion, svcLogger)
 			lc.svcQueue.Enqueue(svc)
 		}
@@ -240,10 +259,10 @@ func (lc *L4NetLBController) needsAddition(newSvc, oldSvc *v1.Service) bool {
 }
 
 // needsDeletion return true if svc required deleting RBS based NetLB
-func (lc *L4NetLBController) needsDeletion(svc *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) needsDeletion(ctx context2.Context, svc *v1.Service, svcLogger klog.Logger) bool {
 	// Check if service was provisioned by RBS controller before -- if it has rbs finalizer, rbs loadBalancerClass or rbs forwarding rule
 	if !utils.HasL4NetLBFinalizerV2(svc) && !utils.HasL4NetLBFinalizerV3(svc) &&
-		!lc.hasRBSForwardingRule(svc, svcLogger) &&
+		!lc.hasRBSForwardingRule(ctx, svc, svcLogger) &&
 		!annotations.HasLoadBalancerClass(svc, annotations.RegionalExternalLoadBalancerClass) {
 		return false
 	}
@@ -274,7 +293,7 @@ func (lc *L4NetLBController) needsUpdate(newSvc, oldSvc *v1.Service) bool {
 	newSvcWantsNetLB, newType := annotations.WantsL4NetLB(newSvc)
 	recorder := lc.ctx.Recorder(oldSvc.Namespace)
 	if oldSvcWantsNetLB != newSvcWantsNetLB {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "Type", "%v -> %v", oldType, newType)
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonType, "%v -> %v", oldType, newType)
 		return true
 	}
 	if !newSvcWantsNetLB && !oldSvcWantsNetLB {
@@ -283,60 +302,60 @@ func (lc *L4NetLBController) needsUpdate(newSvc, oldSvc *v1.Service) bool {
 	}
 
 	if !reflect.DeepEqual(oldSvc.Spec.LoadBalancerSourceRanges, newSvc.Spec.LoadBalancerSourceRanges) {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "LoadBalancerSourceRanges", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonLoadBalancerSourceRanges, "%v -> %v",
 			oldSvc.Spec.LoadBalancerSourceRanges, newSvc.Spec.LoadBalancerSourceRanges)
 		return true
 	}
 
 	if !portsEqualForLBService(oldSvc, newSvc) {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "Ports", "%v -> %v", oldSvc.Spec.Ports, newSvc.Spec.Ports)
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonPorts, "%v -> %v", oldSvc.Spec.Ports, newSvc.Spec.Ports)
 		return true
 	}
 
 	if diff := cmp.Diff(oldSvc.Spec.SessionAffinity, newSvc.Spec.SessionAffinity); diff != "" {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "SessionAffinity", "%v -> %v", oldSvc.Spec.SessionAffinity, newSvc.Spec.SessionAffinity)
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonSessionAffinity, "%v -> %v", oldSvc.Spec.SessionAffinity, newSvc.Spec.SessionAffinity)
 		return true
 	}
 
 	if diff := cmp.Diff(oldSvc.Spec.SessionAffinityConfig, newSvc.Spec.SessionAffinityConfig); diff != "" {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "SessionAffinityConfig", "old -> new %s", diff)
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonSessionAffinityConfig, "old -> new %s", diff)
 		return true
 	}
 
 	if oldSvc.Spec.LoadBalancerIP != newSvc.Spec.LoadBalancerIP {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "LoadbalancerIP", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonLoadbalancerIP, "%v -> %v",
 			oldSvc.Spec.LoadBalancerIP, newSvc.Spec.LoadBalancerIP)
 		return true
 	}
 	if len(oldSvc.Spec.ExternalIPs) != len(newSvc.Spec.ExternalIPs) {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "ExternalIP", "Count: %v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonExternalIP, "Count: %v -> %v",
 			len(oldSvc.Spec.ExternalIPs), len(newSvc.Spec.ExternalIPs))
 		return true
 	}
 	for i := range oldSvc.Spec.ExternalIPs {
 		if oldSvc.Spec.ExternalIPs[i] != newSvc.Spec.ExternalIPs[i] {
-			recorder.Eventf(newSvc, v1.EventTypeNormal, "ExternalIP", "Added: %v",
+			recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonExternalIP, "Added: %v",
 				newSvc.Spec.ExternalIPs[i])
 			return true
 		}
 	}
 	if !reflect.DeepEqual(oldSvc.Annotations, newSvc.Annotations) {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "Annotations", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonAnnotations, "%v -> %v",
 			oldSvc.Annotations, newSvc.Annotations)
 		return true
 	}
 	if oldSvc.Spec.ExternalTrafficPolicy != newSvc.Spec.ExternalTrafficPolicy {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "ExternalTrafficPolicy", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonExternalTrafficPolicy, "%v -> %v",
 			oldSvc.Spec.ExternalTrafficPolicy, newSvc.Spec.ExternalTrafficPolicy)
 		return true
 	}
 	if oldSvc.Spec.HealthCheckNodePort != newSvc.Spec.HealthCheckNodePort {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "HealthCheckNodePort", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonHealthCheckNodePort, "%v -> %v",
 			oldSvc.Spec.HealthCheckNodePort, newSvc.Spec.HealthCheckNodePort)
 		return true
 	}
 	if lc.enableDualStack && !reflect.DeepEqual(oldSvc.Spec.IPFamilies, newSvc.Spec.IPFamilies) {
-		recorder.Eventf(newSvc, v1.EventTypeNormal, "IPFamilies", "%v -> %v",
+		recorder.Eventf(newSvc, v1.EventTypeNormal, events.ReasonIPFamilies, "%v -> %v",
 			oldSvc.Spec.IPFamilies, newSvc.Spec.IPFamilies)
 		return true
 	}
@@ -344,13 +363,13 @@ func (lc *L4NetLBController) needsUpdate(newSvc, oldSvc *v1.Service) bool {
 }
 
 // shouldProcessService checks if given service should be process by controller
-func (lc *L4NetLBController) shouldProcessService(newSvc, oldSvc *v1.Service, svcLogger klog.Logger) (shouldProcess bool, isResync bool) {
+func (lc *L4NetLBController) shouldProcessService(ctx context2.Context, newSvc, oldSvc *v1.Service, svcLogger klog.Logger) (shouldProcess bool, isResync bool) {
 	// Ignore services with LoadBalancerClass different than "networking.gke.io/l4-regional-external" used for this controller.
 	// LoadBalancerClass can't be updated (see the field API doc) so we don't need to worry about cleaning up services that changed the class.
 	if newSvc.Spec.LoadBalancerClass != nil {
 		if annotations.HasLoadBalancerClass(newSvc, annotations.RegionalExternalLoadBalancerClass) {
 			if newSvc.Annotations[annotations.ServiceAnnotationLoadBalancerType] == string(annotations.LBTypeInternal) {
-				lc.ctx.Recorder(newSvc.Namespace).Eventf(newSvc, v1.EventTypeWarning, "ConflictingConfiguration",
+				lc.ctx.Recorder(newSvc.Namespace).Eventf(newSvc, v1.EventTypeWarning, events.ReasonConflictingConfiguration,
 					"loadBalancerClass conflicts with %s: %q annotation. External LoadBalancer Service provisioned.", annotations.ServiceAnnotationLoadBalancerType, string(annotations.LBTypeInternal))
 			}
 		} else {
@@ -361,7 +380,7 @@ func (lc *L4NetLBController) shouldProcessService(newSvc, oldSvc *v1.Service, sv
 
 	warnL4FinalizerRemoved(lc.ctx, oldSvc, newSvc)
 
-	if !lc.isRBSBasedService(newSvc, svcLogger) && !lc.isRBSBasedService(oldSvc, svcLogger) {
+	if !lc.isRBSBasedService(ctx, newSvc, svcLogger) && !lc.isRBSBasedService(ctx, oldSvc, svcLogger) {
 		svcLogger.V(4).Info("Ignoring non RBS based NetLB service")
 		return false, false
 	}
@@ -372,7 +391,7 @@ func (lc *L4NetLBController) shouldProcessService(newSvc, oldSvc *v1.Service, sv
 		lc.ctx.Recorder(newSvc.Namespace).Event(newSvc, v1.EventTypeWarning, ReasonL4LBConfigAnnotationRemoved, "L4LBConfig annotation has been removed")
 	}
 
-	if lc.needsAddition(newSvc, oldSvc) || lc.needsUpdate(newSvc, oldSvc) || lc.needsDeletion(newSvc, svcLogger) {
+	if lc.needsAddition(newSvc, oldSvc) || lc.needsUpdate(newSvc, oldSvc) || lc.needsDeletion(ctx, newSvc, svcLogger) {
 		return true, false
 	}
 	needsResync := lc.needsPeriodicEnqueue(newSvc, oldSvc)
@@ -391,7 +410,7 @@ func (lc *L4NetLBController) hasForwardingRuleAnnotation(svc *v1.Service, frName
 }
 
 // isRBSBasedService checks if service has either RBS annotation, loadBalancerClass, finalizer or RBSForwardingRule
-func (lc *L4NetLBController) isRBSBasedService(svc *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) isRBSBasedService(ctx context2.Context, svc *v1.Service, svcLogger klog.Logger) bool {
 	// Check if the type=LoadBalancer, so we don't execute API calls o non-LB services
 	// this call is nil-safe
 	if !utils.IsLoadBalancerServiceType(svc) {
@@ -410,7 +429,7 @@ func (lc *L4NetLBController) isRBSBasedService(svc *v1.Service, svcLogger klog.L
 		svcLogger.V(4).Info("Service has RBS annotation")
 		return true
 	}
-	if lc.hasLegacyControllerOwnership(svc, svcLogger) {
+	if lc.hasLegacyControllerOwnership(ctx, svc, svcLogger) {
 		svcLogger.V(4).Info("Service has legacy controller ownership")
 		return false
 	}
@@ -418,14 +437,14 @@ func (lc *L4NetLBController) isRBSBasedService(svc *v1.Service, svcLogger klog.L
 		svcLogger.V(4).Info("RBS is enabled by default, treating service as RBS based")
 		return true
 	}
-	if lc.hasRBSForwardingRule(svc, svcLogger) {
+	if lc.hasRBSForwardingRule(ctx, svc, svcLogger) {
 		svcLogger.V(4).Info("Service has RBS forwarding rule")
 		return true
 	}
 	return false
 }
 
-func (lc *L4NetLBController) hasLegacyControllerOwnership(svc *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) hasLegacyControllerOwnership(ctx context2.Context, svc *v1.Service, svcLogger klog.Logger) bool {
 	// Check for legacy finalizer
 	if utils.HasL4NetLBFinalizerV1(svc) {
 		svcLogger.V(4).Info("Service has Legacy L4 NetLB finalizer", "finalizers", svc.ObjectMeta.Finalizers)
@@ -433,18 +452,18 @@ func (lc *L4NetLBController) hasLegacyControllerOwnership(svc *v1.Service, svcLo
 	}
 
 	// Check if forwarding rule points to a target pool
-	return lc.hasTargetPoolForwardingRule(svc, svcLogger)
+	return lc.hasTargetPoolForwardingRule(ctx, svc, svcLogger)
 }
 
-func (lc *L4NetLBController) preventLegacyServiceHandling(service *v1.Service, key string, svcLogger klog.Logger) (bool, error) {
+func (lc *L4NetLBController) preventLegacyServiceHandling(ctx context2.Context, service *v1.Service, key string, svcLogger klog.Logger) (bool, error) {
 	svcLogger.Info("Checking for legacy target pool service with RBS annotation or finalizers", "finalizers", service.ObjectMeta.Finalizers)
-	if lc.hasLegacyControllerOwnership(service, svcLogger) {
+	if lc.hasLegacyControllerOwnership(ctx, service, svcLogger) {
 		if utils.HasL4NetLBRBSFinalizers(service) {
 			// If we found that RBS finalizer was attached to service, it means that RBS controller
 			// had a race condition on Service creation with Legacy Controller.
 			// It should only happen during service creation, and we should clean up RBS resources
 			svcLogger.Info("Detected Target Pool on RBS service with RBS finalizer. Cleaning up RBS resources to prevent race condition.", "finalizers", service.ObjectMeta.Finalizers)
-			return true, lc.preventTargetPoolRaceWithRBSOnCreation(service, key, svcLogger)
+			return true, lc.preventTargetPoolRaceWithRBSOnCreation(ctx, service, key, svcLogger)
 		} else if annotations.HasRBSAnnotation(service) {
 			// Target Pool to RBS migration is NOT yet supported and causes service to break (for now).
 			// If we detect RBS annotation on legacy service, we remove RBS annotation,
@@ -458,14 +477,14 @@ func (lc *L4NetLBController) preventLegacyServiceHandling(service *v1.Service, k
 	return false, nil
 }
 
-func (lc *L4NetLBController) hasTargetPoolForwardingRule(service *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) hasTargetPoolForwardingRule(ctx context2.Context, service *v1.Service, svcLogger klog.Logger) bool {
 	frName := utils.LegacyForwardingRuleName(service)
 	if lc.hasForwardingRuleAnnotation(service, frName) {
 		svcLogger.V(4).Info("Service does not have Target Pool forwarding rule annotation", "forwardingRule", frName)
 		return false
 	}
 
-	existingFR, err := lc.forwardingRules.Get(frName)
+	existingFR, err := lc.forwardingRules.Get(ctx, frName)
 	if err != nil {
 		svcLogger.Error(err, "Error getting forwarding rule", "forwardingRule", frName)
 		return false
@@ -478,14 +497,14 @@ func (lc *L4NetLBController) hasTargetPoolForwardingRule(service *v1.Service, sv
 	return false
 }
 
-func (lc *L4NetLBController) preventTargetPoolRaceWithRBSOnCreation(service *v1.Service, key string, svcLogger klog.Logger) error {
-	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "TargetPoolRaceWithRBS",
+func (lc *L4NetLBController) preventTargetPoolRaceWithRBSOnCreation(ctx context2.Context, service *v1.Service, key string, svcLogger klog.Logger) error {
+	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonTargetPoolRaceWithRBS,
 		"Target Pool found on provisioned RBS service. Deleting RBS resources")
 
 	metrics.IncreaseL4NetLBTargetPoolRaceWithRBS()
-	result := lc.garbageCollectRBSNetLB(key, service, svcLogger)
+	result := lc.garbageCollectRBSNetLB(ctx, key, service, svcLogger)
 	if result.Error != nil {
-		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "CleanRBSResourcesForLegacyService",
+		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonCleanRBSResourcesForLegacyService,
 			"Failed to clean RBS resources for load balancer with target pool, err: %v", result.Error)
 		return result.Error
 	}
@@ -493,7 +512,7 @@ func (lc *L4NetLBController) preventTargetPoolRaceWithRBSOnCreation(service *v1.
 }
 
 func (lc *L4NetLBController) preventExistingTargetPoolToRBSMigration(service *v1.Service, svcLogger klog.Logger) error {
-	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "CanNotMigrateTargetPoolToRBS",
+	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonCanNotMigrateTargetPoolToRBS,
 		"RBS annotation was attached to the Legacy Target Pool service. Migration is not supported. Removing annotation")
 	metrics.IncreaseL4NetLBLegacyToRBSMigrationAttempts()
 
@@ -511,13 +530,13 @@ func (lc *L4NetLBController) deleteRBSAnnotation(service *v1.Service, svcLogger
 }
 
 // hasRBSForwardingRule checks if services loadbalancer has forwarding rule pointing to backend service
-func (lc *L4NetLBController) hasRBSForwardingRule(svc *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) hasRBSForwardingRule(ctx context2.Context, svc *v1.Service, svcLogger klog.Logger) bool {
 	frName := utils.LegacyForwardingRuleName(svc)
 	// to optimize number of api calls, at first, check if forwarding rule exists in annotation
 	if lc.hasForwardingRuleAnnotation(svc, frName) {
 		return true
 	}
-	existingFR, err := lc.forwardingRules.Get(frName)
+	existingFR, err := lc.forwardingRules.Get(ctx, frName)
 	if err != nil {
 		svcLogger.Error(err, "Error getting forwarding rule", "forwardingRule", frName)
 		return false
@@ -525,17 +544,24 @@ func (lc *L4NetLBController) hasRBSForwardingRule(svc *v1.Service, svcLogger klo
 	return existingFR != nil && existingFR.LoadBalancingScheme == string(cloud.SchemeExternal) && existingFR.BackendService != ""
 }
 
-func (lc *L4NetLBController) SystemHealth() error {
+// SystemHealth returns the health of the controller. It is degraded when
+// the controller is falling behind.
+func (lc *L4NetLBController) SystemHealth() systemhealth.Status {
 	lastEnqueueTime := lc.enqueueTracker.Get()
 	lastSyncTime := lc.syncTracker.Get()
 	// if lastEnqueue time is more than 15 minutes before the last sync time, the controller is falling behind.
 	// This indicates that the controller was stuck handling a previous update, or sync function did not get invoked.
 	syncTimeLatest := lastEnqueueTime.Add(enqueueToSyncDelayThreshold)
+	status := systemhealth.StatusFromError(nil).
+		WithCacheSynced(lc.hasSynced()).
+		WithLastSync(lastSyncTime).
+		WithQueueDepth(lc.svcQueue.Len())
 	controllerHealth := metrics.ControllerHealthyStatus
 	if lastSyncTime.After(syncTimeLatest) {
 		msg := fmt.Sprintf("L4 NetLB Sync happened at time %v, %v after enqueue time, last enqueue time %v, threshold is %v", lastSyncTime, lastSyncTime.Sub(lastEnqueueTime), lastEnqueueTime, enqueueToSyncDelayThreshold)
 		// Log here, context/http handler do no log the error.
 		lc.logger.Error(nil, msg)
+		status = status.Degraded(msg)
 		metrics.PublishL4FailedHealthCheckCount(L4NetLBControllerName)
 		controllerHealth = metrics.ControllerUnhealthyStatus
 		// Reset trackers. Otherwise, if there is nothing in the queue then it will report the FailedHealthCheckCount every time the checkHealth is called
@@ -546,7 +572,7 @@ func (lc *L4NetLBController) SystemHealth() error {
 	if lc.enableDualStack {
 		metrics.PublishL4ControllerHealthCheckStatus(l4NetLBDualStackControllerName, controllerHealth)
 	}
-	return nil
+	return status
 }
 
 // Run starts the loadbalancer controller.
@@ -584,11 +610,15 @@ func (lc *L4NetLBController) syncWrapper(key string) (err error) {
 			err = fmt.Errorf("%s", errMessage)
 		}
 	}()
-	syncErr := lc.sync(key, svcLogger)
+	priorityCtx := ratelimit.WithPriority(context2.Background(), ratelimit.SyncPriority(lc.syncTimeline.Pending(key)))
+	ctx, svcLogger, span := tracing.StartSync(priorityCtx, svcLogger, "L4NetLBController.sync", attribute.String("key", key))
+	syncErr := lc.sync(ctx, key, svcLogger)
+	tracing.End(span, syncErr)
+	recordL4SyncStatus(lc.debugTracker, lc.ctx.ServiceInformer.GetStore(), key, syncErr)
 	return skipUserError(syncErr, svcLogger)
 }
 
-func (lc *L4NetLBController) sync(key string, svcLogger klog.Logger) error {
+func (lc *L4NetLBController) sync(ctx context2.Context, key string, svcLogger klog.Logger) (err error) {
 	lc.syncTracker.Track()
 	metrics.PublishL4controllerLastSyncTime(L4NetLBControllerName)
 
@@ -598,15 +628,18 @@ func (lc *L4NetLBController) sync(key string, svcLogger klog.Logger) error {
 	}
 	if !exists || svc == nil {
 		svcLogger.V(3).Info("Ignoring sync of non-existent service")
+		lc.syncTimeline.Forget(key)
 		return nil
 	}
+	trace := lc.syncTimeline.StartSync(key)
+	defer func() { trace.Done(err) }()
 
 	if lc.ctx.ReadOnlyMode {
 		svcLogger.Info("Skipping syncing L4 NetLB RBS service since the controller is in read-only mode", "key", key)
 		return nil
 	}
 
-	isLegacyService, err := lc.preventLegacyServiceHandling(svc, key, svcLogger)
+	isLegacyService, err := lc.preventLegacyServiceHandling(ctx, svc, key, svcLogger)
 	if err != nil {
 		svcLogger.Info("lc.preventLegacyServiceHandling returned error, want nil", "service", svc, "err", err)
 		return err
@@ -617,23 +650,26 @@ func (lc *L4NetLBController) sync(key string, svcLogger klog.Logger) error {
 	}
 	isResync := lc.serviceVersions.IsResync(key, svc.ResourceVersion, svcLogger)
 	svcLogger.Info("Processing update operation for service", "resync", isResync, "resourceVersion", svc.ResourceVersion)
-	if lc.needsDeletion(svc, svcLogger) {
+	if lc.needsDeletion(ctx, svc, svcLogger) {
 		svcLogger.V(3).Info("Deleting L4 External LoadBalancer resources for service")
-		result := lc.garbageCollectRBSNetLB(key, svc, svcLogger)
+		result := lc.garbageCollectRBSNetLB(ctx, key, svc, svcLogger)
 		if result == nil {
 			return nil
 		}
+		lc.debugTracker.SetState(key, newNetLBSyncDebugState(result))
 		lc.serviceVersions.Delete(key)
+		lc.syncTimeline.Forget(key)
 		lc.publishMetrics(result, svc.Name, svc.Namespace, false, svcLogger)
 		return result.Error
 	}
 
 	if wantsNetLB, _ := annotations.WantsL4NetLB(svc); wantsNetLB {
-		result := lc.syncInternal(svc, svcLogger)
+		result := lc.syncInternal(ctx, svc, trace, svcLogger)
 		if result == nil {
 			// result will be nil if the service was ignored(due to presence of service controller finalizer).
 			return nil
 		}
+		lc.debugTracker.SetState(key, newNetLBSyncDebugState(result))
 		lc.serviceVersions.SetProcessed(key, svc.ResourceVersion, result.Error == nil, isResync, svcLogger)
 		lc.publishMetrics(result, svc.Name, svc.Namespace, isResync, svcLogger)
 		svcLogger.V(3).Info("Resources modified in the sync", "modifiedResources", result.GCEResourceUpdate.String(), "wasResync", isResync)
@@ -650,9 +686,9 @@ func (lc *L4NetLBController) sync(key string, svcLogger klog.Logger) error {
 
 // syncInternal ensures load balancer resources for the given service, as needed.
 // Returns an error if processing the service update failed.
-func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Logger) *resources.L4NetLBSyncResult {
+func (lc *L4NetLBController) syncInternal(ctx context2.Context, service *v1.Service, trace *synctimeline.Trace, svcLogger klog.Logger) *resources.L4NetLBSyncResult {
 	// check again that rbs is enabled.
-	if !lc.isRBSBasedService(service, svcLogger) {
+	if !lc.isRBSBasedService(ctx, service, svcLogger) {
 		svcLogger.Info("Skipping syncInternal. Service does not have RBS enabled")
 		return nil
 	}
@@ -663,7 +699,7 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 		svcLogger.Info("Finished syncing L4 NetLB RBS service", "timeTaken", time.Since(startTime))
 	}()
 
-	usesNegBackends := lc.shouldUseNEGBackends(service, svcLogger)
+	usesNegBackends := lc.shouldUseNEGBackends(ctx, service, svcLogger)
 
 	l4NetLBParams := &resources.L4NetLBParams{
 		Service:                            service,
@@ -680,6 +716,7 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 		UseNEGs:                            usesNegBackends,
 		UseDenyFirewalls:                   lc.ctx.EnableL4DenyFirewalls,
 		EnableDenyFirewallsRollbackCleanup: lc.ctx.EnableL4DenyFirewallsRollbackCleanup,
+		SyncTrace:                          trace,
 	}
 	if lc.ctx.L4LBConfigInformer != nil {
 		l4NetLBParams.L4LBConfigLister = lc.ctx.L4LBConfigInformer.GetIndexer()
@@ -708,8 +745,11 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 	nodeNames := utils.GetNodeNames(nodes)
 	isMultinet := lc.networkResolver.IsMultinetService(service)
 	if !isMultinet && !usesNegBackends {
-		if err := lc.ensureInstanceGroups(service, nodeNames, svcLogger); err != nil {
-			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncInstanceGroupsFailed",
+		endInstanceGroups := trace.Track(synctimeline.PhaseInstanceGroups)
+		err := lc.ensureInstanceGroups(ctx, service, nodeNames, svcLogger)
+		endInstanceGroups()
+		if err != nil {
+			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncInstanceGroupsFailed,
 				"Error syncing instance group, err: %v", err)
 			return &resources.L4NetLBSyncResult{Error: err}
 		}
@@ -717,9 +757,9 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 
 	// Use the same function for both create and updates. If controller crashes and restarts,
 	// all existing services will show up as Service Adds.
-	syncResult := l4netlb.EnsureFrontend(nodeNames, service, startTime)
+	syncResult := l4netlb.EnsureFrontend(ctx, nodeNames, service, startTime)
 	if syncResult.Error != nil {
-		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncExternalLoadBalancerFailed",
+		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncExternalLoadBalancerFailed,
 			"Error ensuring Resource for L4 External LoadBalancer, err: %v", syncResult.Error)
 		if resources.IsUserError(syncResult.Error) {
 			syncResult.MetricsLegacyState.IsUserError = true
@@ -733,8 +773,11 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 		linkType = negLink
 	}
 
-	if err = lc.ensureBackendLinking(service, linkType, svcLogger); err != nil {
-		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncExternalLoadBalancerFailed",
+	endBackends := trace.Track(synctimeline.PhaseBackends)
+	err = lc.ensureBackendLinking(ctx, service, linkType, svcLogger)
+	endBackends()
+	if err != nil {
+		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncExternalLoadBalancerFailed,
 			"Error linking backends to backend service, err: %v", err)
 		syncResult.Error = err
 		return syncResult
@@ -742,7 +785,7 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 
 	err = updateServiceStatus(lc.ctx, service, syncResult.Status, syncResult.Conditions, nil, svcLogger)
 	if err != nil {
-		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncExternalLoadBalancerFailed",
+		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncExternalLoadBalancerFailed,
 			"Error updating L4 External LoadBalancer, err: %v", err)
 		syncResult.Error = err
 		return syncResult
@@ -751,17 +794,17 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 		lc.emitEnsuredDualStackEvent(service)
 
 		if err = updateL4DualStackResourcesAnnotations(lc.ctx, service, syncResult.Annotations, svcLogger); err != nil {
-			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncExternalLoadBalancerFailed",
+			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncExternalLoadBalancerFailed,
 				"Failed to update annotations for load balancer, err: %v", err)
 			syncResult.Error = fmt.Errorf("failed to set resource annotations, err: %w", err)
 			return syncResult
 		}
 	} else {
-		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, "SyncLoadBalancerSuccessful",
+		lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, events.ReasonSyncLoadBalancerSuccessful,
 			"Successfully ensured L4 External LoadBalancer resources")
 
 		if err = updateL4ResourcesAnnotations(lc.ctx, service, syncResult.Annotations, svcLogger); err != nil {
-			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, "SyncExternalLoadBalancerFailed",
+			lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeWarning, events.ReasonSyncExternalLoadBalancerFailed,
 				"Failed to update annotations for load balancer, err: %v", err)
 			syncResult.Error = fmt.Errorf("failed to set resource annotations, err: %w", err)
 			return syncResult
@@ -771,7 +814,7 @@ func (lc *L4NetLBController) syncInternal(service *v1.Service, svcLogger klog.Lo
 	return syncResult
 }
 
-func (lc *L4NetLBController) shouldUseNEGBackends(service *v1.Service, svcLogger klog.Logger) bool {
+func (lc *L4NetLBController) shouldUseNEGBackends(ctx context2.Context, service *v1.Service, svcLogger klog.Logger) bool {
 	if !lc.enableNEGSupport {
 		return false
 	}
@@ -787,7 +830,7 @@ func (lc *L4NetLBController) shouldUseNEGBackends(service *v1.Service, svcLogger
 	// Do an extra check in case the customer removes the required finalizers
 	// Get the backend service if one exists and see what kind of backend
 	// is attached. If it's an IG keep using IGs.
-	backendType, err := lc.getBackendLinkType(service, svcLogger)
+	backendType, err := lc.getBackendLinkType(ctx, service, svcLogger)
 	if err != nil {
 		svcLogger.Error(err, "Could not determine the backend type of the existing backend service, deferring to default")
 		return true
@@ -798,9 +841,12 @@ func (lc *L4NetLBController) shouldUseNEGBackends(service *v1.Service, svcLogger
 	return backendType == nil || *backendType == negLink
 }
 
-func (lc *L4NetLBController) getBackendLinkType(service *v1.Service, svcLogger klog.Logger) (*backendLinkType, error) {
+func (lc *L4NetLBController) getBackendLinkType(ctx context2.Context, service *v1.Service, svcLogger klog.Logger) (*backendLinkType, error) {
 	bsName := lc.namer.L4Backend(service.Namespace, service.Name)
-	backendService, err := lc.backendPool.Get(bsName, meta.VersionGA, meta.Regional, svcLogger)
+	if adopted := annotations.FromService(service).AdoptedResources().BackendService; adopted != "" {
+		bsName = adopted
+	}
+	backendService, err := lc.backendPool.Get(ctx, bsName, meta.VersionGA, meta.Regional, svcLogger)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return nil, nil
@@ -833,11 +879,11 @@ func (lc *L4NetLBController) emitEnsuredDualStackEvent(service *v1.Service) {
 	for _, ipFamily := range service.Spec.IPFamilies {
 		ipFamilies = append(ipFamilies, string(ipFamily))
 	}
-	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, "SyncLoadBalancerSuccessful",
+	lc.ctx.Recorder(service.Namespace).Eventf(service, v1.EventTypeNormal, events.ReasonSyncLoadBalancerSuccessful,
 		"Successfully ensured %v External LoadBalancer resources", strings.Join(ipFamilies, " "))
 }
 
-func (lc *L4NetLBController) ensureBackendLinking(service *v1.Service, linkType backendLinkType, svcLogger klog.Logger) error {
+func (lc *L4NetLBController) ensureBackendLinking(ctx context2.Context, service *v1.Service, linkType backendLinkType, svcLogger klog.Logger) error {
 	start := time.Now()
 
 	svcLogger.V(2).Info("Linking backends to backend service for k8s service")
@@ -848,9 +894,10 @@ func (lc *L4NetLBController) ensureBackendLinking(service *v1.Service, linkType
 	namespacedName := types.NamespacedName{Name: service.Name, Namespace: service.Namespace}
 	portId := utils.ServicePortID{Service: namespacedName}
 	servicePort := utils.ServicePort{
-		ID:           portId,
-		BackendNamer: lc.namer,
-		L4RBSEnabled: true,
+		ID:                 portId,
+		BackendNamer:       lc.namer,
+		L4RBSEnabled:       true,
+		AdoptedBackendName: annotations.FromService(service).AdoptedResources().BackendService,
 	}
 	// NEG backends should only be used for multinetwork services on the non default network.
 	if linkType == negLink {
@@ -865,7 +912,7 @@ func (lc *L4NetLBController) ensureBackendLinking(service *v1.Service, linkType
 		for _, zone := range zones {
 			groupKeys = append(groupKeys, backends.GroupKey{Zone: zone})
 		}
-		return lc.negLinker.Link(servicePort, groupKeys)
+		return lc.negLinker.Link(ctx, servicePort, groupKeys)
 	} else if linkType == instanceGroupLink {
 		svcLogger.V(2).Info("Linking backend service with Instance Groups for service (uses default network)")
 		// The BackendService should be linked with zones of all nodes that's why AllNodesFilter is used.
@@ -875,13 +922,13 @@ func (lc *L4NetLBController) ensureBackendLinking(service *v1.Service, linkType
 		if err != nil {
 			return err
 		}
-		return lc.igLinker.Link(servicePort, lc.ctx.Cloud.ProjectID(), zones)
+		return lc.igLinker.Link(ctx, servicePort, lc.ctx.Cloud.ProjectID(), zones)
 	} else {
 		return fmt.Errorf("cannot link backend service - invalid backend link type %d", linkType)
 	}
 }
 
-func (lc *L4NetLBController) ensureInstanceGroups(service *v1.Service, nodeNames []string, svcLogger klog.Logger) error {
+func (lc *L4NetLBController) ensureInstanceGroups(ctx context2.Context, service *v1.Service, nodeNames []string, svcLogger klog.Logger) error {
 	// TODO(kl52752) Move instance creation and deletion logic to NodeController
 	// to avoid race condition between controllers
 	start := time.Now()
@@ -912,11 +959,11 @@ func (lc *L4NetLBController) ensureInstanceGroups(service *v1.Service, nodeNames
 		return fmt.Errorf("lc.instancePool.EnsureInstanceGroupsAndPorts(%s, %v) returned error %w", igName, nodePorts, err)
 	}
 
-	return lc.instancePool.Sync(nodeNames, svcLogger)
+	return lc.instancePool.Sync(ctx, nodeNames, svcLogger)
 }
 
 // garbageCollectRBSNetLB cleans-up all gce resources related to service and removes NetLB finalizer
-func (lc *L4NetLBController) garbageCollectRBSNetLB(key string, svc *v1.Service, svcLogger klog.Logger) *resources.L4NetLBSyncResult {
+func (lc *L4NetLBController) garbageCollectRBSNetLB(ctx context2.Context, key string, svc *v1.Service, svcLogger klog.Logger) *resources.L4NetLBSyncResult {
 	startTime := time.Now()
 	svcLogger.Info("Deleting L4 NetLB RBS service")
 	defer func() {
@@ -942,18 +989,18 @@ func (lc *L4NetLBController) garbageCollectRBSNetLB(key string, svc *v1.Service,
 		l4NetLBParams.L4LBConfigLister = lc.ctx.L4LBConfigInformer.GetIndexer()
 	}
 	l4netLB := resources.NewL4NetLB(l4NetLBParams, svcLogger)
-	lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, "DeletingLoadBalancer",
+	lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, events.ReasonDeletingLoadBalancer,
 		"Deleting L4 External LoadBalancer for %s", key)
 
-	result := l4netLB.EnsureLoadBalancerDeleted(svc)
+	result := l4netLB.EnsureLoadBalancerDeleted(ctx, svc)
 	if result.Error != nil {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancerFailed",
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancerFailed,
 			"Error deleting L4 External LoadBalancer, err: %v", result.Error)
 		return result
 	}
 
 	if err := updateServiceStatus(lc.ctx, svc, &v1.LoadBalancerStatus{}, []metav1.Condition{}, nil, svcLogger); err != nil {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 			"Error resetting L4 External LoadBalancer status to empty, err: %v", err)
 		result.Error = fmt.Errorf("Failed to reset L4 External LoadBalancer status, err: %w", err)
 		return result
@@ -962,7 +1009,7 @@ func (lc *L4NetLBController) garbageCollectRBSNetLB(key string, svc *v1.Service,
 	// Try to delete instance group, instancePool.DeleteInstanceGroup ignores errors if resource is in use or not found.
 	// TODO(cezarygerard) replace with multi-IG management
 	if err := lc.instancePool.DeleteInstanceGroup(lc.namer.InstanceGroup(), svcLogger); err != nil {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteInstanceGroupFailed",
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteInstanceGroupFailed,
 			"Error deleting delete Instance Group from L4 External LoadBalancer, err: %v", err)
 		result.Error = fmt.Errorf("Failed to delete Instance Group, err: %w", err)
 		return result
@@ -970,14 +1017,14 @@ func (lc *L4NetLBController) garbageCollectRBSNetLB(key string, svc *v1.Service,
 
 	if lc.enableDualStack {
 		if err := updateL4DualStackResourcesAnnotations(lc.ctx, svc, nil, svcLogger); err != nil {
-			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 				"Error removing Dual Stack resource annotations: %v", err)
 			result.Error = fmt.Errorf("failed to reset Dual Stack resource annotations, err: %w", err)
 			return result
 		}
 	} else {
 		if err := updateL4ResourcesAnnotations(lc.ctx, svc, nil, svcLogger); err != nil {
-			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancer",
+			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancer,
 				"Error removing resource annotations: %v", err)
 			result.Error = fmt.Errorf("failed to reset resource annotations, err: %w", err)
 			return result
@@ -988,13 +1035,13 @@ func (lc *L4NetLBController) garbageCollectRBSNetLB(key string, svc *v1.Service,
 	// updating annotations or other manipulations will fail
 	removeFinalizerKeys := []string{common.NetLBFinalizerV2, common.NetLBFinalizerV3}
 	if err := common.EnsureServiceDeleteFinalizers(svc, removeFinalizerKeys, lc.ctx.KubeClient, svcLogger); err != nil {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "DeleteLoadBalancerFailed",
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonDeleteLoadBalancerFailed,
 			"Error removing finalizer from L4 External LoadBalancer, err: %v", err)
 		result.Error = fmt.Errorf("Failed to remove L4 External LoadBalancer finalizer, err: %w", err)
 		return result
 	}
 
-	lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, "DeletedLoadBalancer", "Deleted L4 External LoadBalancer")
+	lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeNormal, events.ReasonDeletedLoadBalancer, "Deleted L4 External LoadBalancer")
 	return result
 }
 
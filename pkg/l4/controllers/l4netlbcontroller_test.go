@@ -173,7 +173,7 @@ func checkForwardingRule(lc *L4NetLBController, svc *v1.Service, expectedPortRan
 		return fmt.Errorf("There are no ports in service!")
 	}
 	frName := utils.LegacyForwardingRuleName(svc)
-	fwdRule, err := composite.GetForwardingRule(lc.ctx.Cloud, meta.RegionalKey(frName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), lc.ctx.Cloud, meta.RegionalKey(frName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return fmt.Errorf("Error getting forwarding rule: %v", err)
 	}
@@ -200,7 +200,7 @@ func createAndSyncNetLBSvcWithNEGs(t *testing.T, lc *L4NetLBController) (svc *v1
 func syncNetLBSvc(t *testing.T, lc *L4NetLBController, svc *v1.Service) (syncedSvc *v1.Service) {
 	addNetLBService(lc, svc)
 	key, _ := common.KeyFunc(svc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync newly added service %s, err %v", svc.Name, err)
 	}
@@ -302,7 +302,7 @@ func getBackend(l4netController *L4NetLBController, svc *v1.Service) (string, *c
 	backendServiceName := l4netController.namer.L4Backend(svc.Namespace, svc.Name)
 	key := meta.RegionalKey(backendServiceName, l4netController.ctx.Cloud.Region())
 	backendServiceLink := cloud.SelfLink(meta.VersionGA, l4netController.ctx.Cloud.ProjectID(), "backendServices", key)
-	bs, err := composite.GetBackendService(l4netController.ctx.Cloud, key, meta.VersionGA, klog.TODO())
+	bs, err := composite.GetBackendService(context.Background(), l4netController.ctx.Cloud, key, meta.VersionGA, klog.TODO())
 	return backendServiceLink, bs, err
 }
 
@@ -561,7 +561,7 @@ func TestForwardingRuleWithPortRange(t *testing.T) {
 		svc.UID = types.UID(svc.Name + fmt.Sprintf("-%d", rand.Intn(1001)))
 		addNetLBService(lc, svc)
 		key, _ := common.KeyFunc(svc)
-		if err := lc.sync(key, klog.TODO()); err != nil {
+		if err := lc.sync(context.Background(), key, klog.TODO()); err != nil {
 			t.Errorf("Failed to sync service %s, err: %v", key, err)
 		}
 
@@ -941,11 +941,11 @@ func TestProcessServiceCreateWithUsersProvidedIP(t *testing.T) {
 	svc.Spec.LoadBalancerIP = usersIP
 	addNetLBService(lc, svc)
 	key, _ := common.KeyFunc(svc)
-	if err := lc.sync(key, klog.TODO()); err == nil {
+	if err := lc.sync(context.Background(), key, klog.TODO()); err == nil {
 		t.Errorf("Expected sync error when address reservation fails.")
 	}
 	addUsersStaticAddress(lc, cloud.NetworkTierDefault)
-	if err := lc.sync(key, klog.TODO()); err != nil {
+	if err := lc.sync(context.Background(), key, klog.TODO()); err != nil {
 		t.Errorf("Un expected Error when trying to sync service with user's address, err: %v", err)
 	}
 	svc, err := lc.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
@@ -961,7 +961,7 @@ func TestProcessServiceCreateWithUsersProvidedIP(t *testing.T) {
 	// Mark the service for deletion by updating timestamp
 	svc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, svc)
-	if err := lc.sync(key, klog.TODO()); err != nil {
+	if err := lc.sync(context.Background(), key, klog.TODO()); err != nil {
 		t.Errorf("Unexpected Error when trying to sync service after deletion, err: %v", err)
 	}
 	adr, err := lc.ctx.Cloud.GetRegionAddress(userAddrName, lc.ctx.Cloud.Region())
@@ -995,17 +995,17 @@ func TestProcessServiceDeletion(t *testing.T) {
 	if !common.HasGivenFinalizer(svc.ObjectMeta, common.NetLBFinalizerV2) {
 		t.Errorf("Expected L4 External LoadBalancer finalizer")
 	}
-	if lc.needsDeletion(svc, klog.TODO()) {
+	if lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Service should not be marked for deletion")
 	}
 	// Mark the service for deletion by updating timestamp
 	svc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, svc)
-	if !lc.needsDeletion(svc, klog.TODO()) {
+	if !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Service should be marked for deletion")
 	}
 	key, _ := common.KeyFunc(svc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 	}
@@ -1042,17 +1042,17 @@ func TestProcessNEGServiceDeletion(t *testing.T) {
 	if !common.HasGivenFinalizer(svc.ObjectMeta, common.NetLBFinalizerV3) {
 		t.Errorf("Expected L4 External LoadBalancer finalizer")
 	}
-	if lc.needsDeletion(svc, klog.TODO()) {
+	if lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Service should not be marked for deletion")
 	}
 	// Mark the service for deletion by updating timestamp
 	svc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, svc)
-	if !lc.needsDeletion(svc, klog.TODO()) {
+	if !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Service should be marked for deletion")
 	}
 	key, _ := common.KeyFunc(svc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 	}
@@ -1135,15 +1135,15 @@ func TestServiceNeedsDeletionChecks(t *testing.T) {
 			svc.ObjectMeta.Finalizers = tc.finalizers
 
 			if tc.removeRBSForwardingRule {
-				lc.forwardingRules.(*forwardingrules.ForwardingRules).Delete(frName)
+				lc.forwardingRules.(*forwardingrules.ForwardingRules).Delete(context.Background(), frName)
 				delete(svc.Annotations, annotations.TCPForwardingRuleKey)
 			}
 
-			if tc.needsDeletion && !lc.needsDeletion(svc, klog.TODO()) {
+			if tc.needsDeletion && !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 				t.Errorf("Service should be marked for deletion")
 			}
 
-			if !tc.needsDeletion && lc.needsDeletion(svc, klog.TODO()) {
+			if !tc.needsDeletion && lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 				t.Errorf("Service should not be marked for deletion")
 			}
 		})
@@ -1177,18 +1177,18 @@ func TestProcessRBSServiceTypeTransition(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			lc := newL4NetLBServiceController()
 			svc := createAndSyncNetLBSvcWithInstanceGroups(t, lc)
-			if lc.needsDeletion(svc, klog.TODO()) {
+			if lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 				t.Errorf("Service should not be marked for deletion")
 			}
 
 			svc.Spec.Type = tc.finalType
 			updateNetLBService(lc, svc)
-			if !lc.needsDeletion(svc, klog.TODO()) {
+			if !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 				t.Errorf("RBS after switching to %v should be marked for deletion", tc.finalType)
 			}
 
 			key, _ := common.KeyFunc(svc)
-			err := lc.sync(key, klog.TODO())
+			err := lc.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
@@ -1233,7 +1233,7 @@ func TestServiceDeletionWhenInstanceGroupInUse(t *testing.T) {
 	svc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, svc)
 	key, _ := common.KeyFunc(svc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 	}
@@ -1264,7 +1264,7 @@ func TestInternalLoadBalancerShouldNotBeProcessByL4NetLBController(t *testing.T)
 	ilbSvc := test.NewL4ILBService(false, 8080)
 	addNetLBService(lc, ilbSvc)
 	key, _ := common.KeyFunc(ilbSvc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to sync service %s, err %v", ilbSvc.Name, err)
 	}
@@ -1276,7 +1276,7 @@ func TestInternalLoadBalancerShouldNotBeProcessByL4NetLBController(t *testing.T)
 	// Mark the service for deletion by updating timestamp
 	ilbSvc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, ilbSvc)
-	if lc.needsDeletion(ilbSvc, klog.TODO()) {
+	if lc.needsDeletion(context.Background(), ilbSvc, klog.TODO()) {
 		t.Fatalf("Service should not be marked for deletion!")
 	}
 }
@@ -1308,7 +1308,7 @@ func TestProcessServiceCreationFailed(t *testing.T) {
 		svc := test.NewL4NetLBRBSService(8080)
 		addNetLBService(lc, svc)
 		key, _ := common.KeyFunc(svc)
-		err := lc.sync(key, klog.TODO())
+		err := lc.sync(context.Background(), key, klog.TODO())
 		if err == nil || err.Error() != param.expectedError {
 			t.Errorf("Error mismatch got:'%v' want: '%v'", err, param.expectedError)
 		}
@@ -1326,7 +1326,7 @@ func TestMetricsWithSyncError(t *testing.T) {
 	addNetLBService(lc, svc)
 
 	key, _ := common.KeyFunc(svc)
-	err = lc.sync(key, klog.TODO())
+	err = lc.sync(context.Background(), key, klog.TODO())
 	if err == nil {
 		t.Errorf("Expected error in sync controller")
 	}
@@ -1374,12 +1374,12 @@ func TestProcessServiceDeletionFailed(t *testing.T) {
 		}
 		svc.DeletionTimestamp = &metav1.Time{}
 		updateNetLBService(lc, svc)
-		if !lc.needsDeletion(svc, klog.TODO()) {
+		if !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 			t.Fatalf("Service should be marked for deletion")
 		}
 		param.addMockFunc((lc.ctx.Cloud.Compute().(*cloud.MockGCE)))
 		key, _ := common.KeyFunc(svc)
-		err := lc.sync(key, klog.TODO())
+		err := lc.sync(context.Background(), key, klog.TODO())
 		if err == nil || errors.Is(err, errors.New(param.expectedError)) {
 			t.Errorf("Error mismatch '%v' != '%v'", err, param.expectedError)
 		}
@@ -1393,7 +1393,7 @@ func TestServiceStatusForErrorSync(t *testing.T) {
 	svc := test.NewL4NetLBRBSService(8080)
 	addNetLBService(lc, svc)
 
-	syncResult := lc.syncInternal(svc, klog.TODO())
+	syncResult := lc.syncInternal(context.Background(), svc, nil, klog.TODO())
 	if syncResult.Error == nil {
 		t.Errorf("Expected error in sync controller")
 	}
@@ -1411,7 +1411,7 @@ func TestServiceStatusForSuccessSync(t *testing.T) {
 	svc := test.NewL4NetLBRBSService(8080)
 	addNetLBService(lc, svc)
 
-	syncResult := lc.syncInternal(svc, klog.TODO())
+	syncResult := lc.syncInternal(context.Background(), svc, nil, klog.TODO())
 	if syncResult.Error != nil {
 		t.Errorf("Unexpected error in sync controller")
 	}
@@ -1491,7 +1491,7 @@ func TestProcessServiceUpdate(t *testing.T) {
 			Update: func(s *v1.Service) { s.Spec.LoadBalancerIP = loadBalancerIP },
 			CheckResult: func(lc *L4NetLBController, svc *v1.Service) error {
 				frName := utils.LegacyForwardingRuleName(svc)
-				fwdRule, err := composite.GetForwardingRule(lc.ctx.Cloud, meta.RegionalKey(frName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
+				fwdRule, err := composite.GetForwardingRule(context.Background(), lc.ctx.Cloud, meta.RegionalKey(frName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
 				if err != nil {
 					return fmt.Errorf("Error getting forwarding rule %v", err)
 				}
@@ -1573,7 +1573,7 @@ func TestProcessServiceUpdate(t *testing.T) {
 			}
 
 			key, _ := common.KeyFunc(newSvc)
-			err = l4netController.sync(key, klog.TODO())
+			err = l4netController.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly added service %s, err %v", svc.Name, err)
 			}
@@ -1607,7 +1607,7 @@ func TestHealthCheckWhenExternalTrafficPolicyWasUpdated(t *testing.T) {
 	// delete shared health check if is created, update service to Cluster and
 	// check that non-shared health check was created
 	hcNameShared := lc.namer.L4HealthCheck(svc.Namespace, svc.Name, true)
-	healthchecks.Fake(lc.ctx.Cloud, lc.ctx.Recorder(svc.Namespace)).DeleteHealthCheckWithFirewall(svc, lc.namer, true, meta.Regional, utils.XLB, klog.TODO())
+	healthchecks.Fake(lc.ctx.Cloud, lc.ctx.Recorder(svc.Namespace)).DeleteHealthCheckWithFirewall(context.Background(), svc, lc.namer, true, meta.Regional, utils.XLB, klog.TODO())
 	// Update ExternalTrafficPolicy to Cluster check if shared HC was created
 	err = updateAndAssertExternalTrafficPolicy(newSvc, lc, v1.ServiceExternalTrafficPolicyTypeCluster, hcNameShared)
 	if err != nil {
@@ -1616,7 +1616,7 @@ func TestHealthCheckWhenExternalTrafficPolicyWasUpdated(t *testing.T) {
 	newSvc.DeletionTimestamp = &metav1.Time{}
 	updateNetLBService(lc, newSvc)
 	key, _ := common.KeyFunc(newSvc)
-	if err = lc.sync(key, klog.TODO()); err != nil {
+	if err = lc.sync(context.Background(), key, klog.TODO()); err != nil {
 		t.Errorf("Failed to sync deleted service %s, err %v", key, err)
 	}
 	if !isHealthCheckDeleted(lc.ctx.Cloud, hcNameNonShared, klog.TODO()) {
@@ -1632,7 +1632,7 @@ func updateAndAssertExternalTrafficPolicy(newSvc *v1.Service, lc *L4NetLBControl
 	newSvc.Spec.ExternalTrafficPolicy = newPolicy
 	updateNetLBService(lc, newSvc)
 	key, _ := common.KeyFunc(newSvc)
-	err := lc.sync(key, klog.TODO())
+	err := lc.sync(context.Background(), key, klog.TODO())
 	if err != nil {
 		return fmt.Errorf("Failed to sync updated service %s, err %v", key, err)
 	}
@@ -1640,7 +1640,7 @@ func updateAndAssertExternalTrafficPolicy(newSvc *v1.Service, lc *L4NetLBControl
 	if err != nil {
 		return fmt.Errorf("Failed to lookup service %s, err: %v", newSvc.Name, err)
 	}
-	_, err = composite.GetHealthCheck(lc.ctx.Cloud, meta.RegionalKey(hcName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
+	_, err = composite.GetHealthCheck(context.Background(), lc.ctx.Cloud, meta.RegionalKey(hcName, lc.ctx.Cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return fmt.Errorf("Error getting health check %v", err)
 	}
@@ -1660,12 +1660,12 @@ func TestControllerUserIPWithStandardNetworkTier(t *testing.T) {
 	key, _ := common.KeyFunc(svc)
 	addUsersStaticAddress(lc, cloud.NetworkTierStandard)
 	// Sync should return error that Network Tier mismatch because we cannot tear User Managed Address.
-	if err := lc.sync(key, klog.TODO()); !l4utils.IsNetworkTierError(err) {
+	if err := lc.sync(context.Background(), key, klog.TODO()); !l4utils.IsNetworkTierError(err) {
 		t.Errorf("Expected error when trying to ensure service with wrong Network Tier, err: %v", err)
 	}
 	svc.Annotations[annotations.NetworkTierAnnotationKey] = string(cloud.NetworkTierStandard)
 	updateNetLBService(lc, svc)
-	if err := lc.sync(key, klog.TODO()); err != nil {
+	if err := lc.sync(context.Background(), key, klog.TODO()); err != nil {
 		t.Errorf("Unexpected error when trying to ensure service with STANDARD Network Tier, err: %v", err)
 	}
 }
@@ -1744,7 +1744,7 @@ func TestIsRBSBasedService(t *testing.T) {
 			controller.ctx.Cloud.Compute().(*cloud.MockGCE).MockForwardingRules.GetHook = testCase.frHook
 			addNetLBService(controller, svc)
 			// When
-			result := controller.isRBSBasedService(svc, klog.TODO())
+			result := controller.isRBSBasedService(context.Background(), svc, klog.TODO())
 
 			// Then
 			if result != testCase.expectRBSService {
@@ -1768,7 +1768,7 @@ func TestIsRBSBasedServiceWithILBServices(t *testing.T) {
 		annotations.TCPForwardingRuleKey: ilbFrName,
 		annotations.UDPForwardingRuleKey: ilbFrName,
 	}
-	if controller.isRBSBasedService(ilbSvc, klog.TODO()) {
+	if controller.isRBSBasedService(context.Background(), ilbSvc, klog.TODO()) {
 		t.Errorf("isRBSBasedService should not detect RBS in ILB services. Service: %v", ilbSvc)
 	}
 }
@@ -1783,21 +1783,21 @@ func TestIsRBSBasedServiceByForwardingRuleAnnotation(t *testing.T) {
 		annotations.UDPForwardingRuleKey: "fr-1",
 		annotations.TCPForwardingRuleKey: "fr-2",
 	}
-	if controller.isRBSBasedService(svc, klog.TODO()) {
+	if controller.isRBSBasedService(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Should not detect RBS by forwarding rule annotations without matching name. Service: %v", svc)
 	}
 
 	svc.Annotations = map[string]string{
 		annotations.TCPForwardingRuleKey: frName,
 	}
-	if !controller.isRBSBasedService(svc, klog.TODO()) {
+	if !controller.isRBSBasedService(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Should detect RBS by TCP forwarding rule annotation with matching name. Service %v", svc)
 	}
 
 	svc.Annotations = map[string]string{
 		annotations.UDPForwardingRuleKey: frName,
 	}
-	if !controller.isRBSBasedService(svc, klog.TODO()) {
+	if !controller.isRBSBasedService(context.Background(), svc, klog.TODO()) {
 		t.Errorf("Should detect RBS by UDP forwarding rule annotation with matching name. Service %v", svc)
 	}
 }
@@ -1898,7 +1898,7 @@ func TestShouldProcessService(t *testing.T) {
 			shouldResync:  true,
 		},
 	} {
-		result, isResync := l4netController.shouldProcessService(testCase.newSvc, testCase.oldSvc, klog.TODO())
+		result, isResync := l4netController.shouldProcessService(context.Background(), testCase.newSvc, testCase.oldSvc, klog.TODO())
 		if result != testCase.shouldProcess {
 			t.Errorf("Old service %v. New service %v. Expected needsUpdate: %t, got: %t", testCase.oldSvc, testCase.newSvc, testCase.shouldProcess, result)
 		}
@@ -1928,7 +1928,7 @@ func TestStrongSessionAffinityServiceUpdate(t *testing.T) {
 		t.Errorf("Service should be marked for update")
 	}
 	key, _ := common.KeyFunc(newSvc)
-	l4netController.sync(key, klog.TODO())
+	l4netController.sync(context.Background(), key, klog.TODO())
 
 	svcAfterUpdate, _ := l4netController.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
 
@@ -2056,7 +2056,7 @@ func TestPreventTargetPoolToRBSMigration(t *testing.T) {
 				t.Fatalf("common.KeyFunc(%v) returned error %v, want nil", svc, err)
 			}
 			// test only preventLegacyServiceHandling
-			_, err = controller.preventLegacyServiceHandling(svc, key, klog.TODO())
+			_, err = controller.preventLegacyServiceHandling(context.Background(), svc, key, klog.TODO())
 			if err != nil {
 				t.Fatalf("controller.preventLegacyServiceHandling(%v, %s) returned error %v, want nil", svc, key, err)
 			}
@@ -2084,7 +2084,7 @@ func TestPreventTargetPoolToRBSMigration(t *testing.T) {
 				t.Fatalf("common.KeyFunc(%v) returned error %v, want nil", svc2, err)
 			}
 
-			err = controller.sync(key, klog.TODO())
+			err = controller.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Fatalf("controller.sync(%s) returned error %v, want nil", key, err)
 			}
@@ -2153,7 +2153,7 @@ func TestIsRBSBasedServiceForNonLoadBalancersType(t *testing.T) {
 			}
 			controller := newL4NetLBServiceController()
 
-			if controller.isRBSBasedService(svc, klog.TODO()) {
+			if controller.isRBSBasedService(context.Background(), svc, klog.TODO()) {
 				t.Errorf("isRBSBasedService(%v) = true, want false", svc)
 			}
 		})
@@ -2205,7 +2205,7 @@ func TestCreateDeleteDualStackNetLBService(t *testing.T) {
 			}
 
 			key, _ := common.KeyFunc(svc)
-			err = controller.sync(key, klog.TODO())
+			err = controller.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync newly added service %s, err %v", svc.Name, err)
 			}
@@ -2243,7 +2243,7 @@ func TestProcessDualStackNetLBServiceOnUserError(t *testing.T) {
 	// Create cluster subnet with INTERNAL ipv6 access type to trigger user error.
 	test.MustCreateDualStackClusterSubnet(t, controller.ctx.Cloud, "INTERNAL")
 
-	syncResult := controller.syncInternal(svc, klog.TODO())
+	syncResult := controller.syncInternal(context.Background(), svc, nil, klog.TODO())
 	if syncResult.Error == nil {
 		t.Fatalf("Failed to generate error when syncing service %s", svc.Name)
 	}
@@ -2264,7 +2264,7 @@ type fakeNEGLinker struct {
 	sp     utils.ServicePort
 }
 
-func (l *fakeNEGLinker) Link(sp utils.ServicePort, groups []backends.GroupKey) error {
+func (l *fakeNEGLinker) Link(_ context.Context, sp utils.ServicePort, groups []backends.GroupKey) error {
 	l.called = true
 	l.sp = sp
 	return nil
@@ -2276,7 +2276,7 @@ func TestEnsureBackendLinkingWithNEGs(t *testing.T) {
 	controller.negLinker = linker
 	svc := test.NewL4NetLBRBSService(8080)
 
-	err := controller.ensureBackendLinking(svc, negLink, klog.TODO())
+	err := controller.ensureBackendLinking(context.Background(), svc, negLink, klog.TODO())
 	if err != nil {
 		t.Fatalf("ensureBackendLinking() failed, err=%v", err)
 	}
@@ -2309,7 +2309,7 @@ func TestEnsureBackendLinkingWithInstanceGroups(t *testing.T) {
 		t.Fatalf("CreateRegionBackendService() failed, err=%v", err)
 	}
 
-	err = controller.ensureBackendLinking(svc, instanceGroupLink, klog.TODO())
+	err = controller.ensureBackendLinking(context.Background(), svc, instanceGroupLink, klog.TODO())
 	if err != nil {
 		t.Fatalf("ensureBackendLinking() failed, err=%v", err)
 	}
@@ -2357,7 +2357,7 @@ func TestEnsureExternalLoadBalancerClass(t *testing.T) {
 			// Create NetLB
 			addNetLBService(lc, svc)
 			key, _ := common.KeyFunc(svc)
-			err := lc.sync(key, klog.TODO())
+			err := lc.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
@@ -2375,7 +2375,7 @@ func TestEnsureExternalLoadBalancerClass(t *testing.T) {
 			// Update NetLB
 			svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
 			updateNetLBService(lc, svc)
-			if err = lc.sync(key, klog.TODO()); err != nil {
+			if err = lc.sync(context.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
 			svc, err = lc.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
@@ -2396,15 +2396,15 @@ func TestEnsureExternalLoadBalancerClass(t *testing.T) {
 			svc.DeletionTimestamp = &metav1.Time{}
 			updateNetLBService(lc, svc)
 			if tc.shouldProcess {
-				if !lc.needsDeletion(svc, klog.TODO()) {
+				if !lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 					t.Errorf("Service should be marked for deletion")
 				}
 			} else {
-				if lc.needsDeletion(svc, klog.TODO()) {
+				if lc.needsDeletion(context.Background(), svc, klog.TODO()) {
 					t.Errorf("Service should not be marked for deletion")
 				}
 			}
-			if err = lc.sync(key, klog.TODO()); err != nil {
+			if err = lc.sync(context.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
 			svc, err = lc.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
@@ -2460,7 +2460,7 @@ func TestEnsureReadOnlyModeDoesNotProvision(t *testing.T) {
 			// Create NetLB
 			addNetLBService(lc, svc)
 			key, _ := common.KeyFunc(svc)
-			err := lc.sync(key, klog.TODO())
+			err := lc.sync(context.Background(), key, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
@@ -2478,7 +2478,7 @@ func TestEnsureReadOnlyModeDoesNotProvision(t *testing.T) {
 			// Update NetLB
 			svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
 			updateNetLBService(lc, svc)
-			if err = lc.sync(key, klog.TODO()); err != nil {
+			if err = lc.sync(context.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
 			svc, err = lc.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
@@ -2498,7 +2498,7 @@ func TestEnsureReadOnlyModeDoesNotProvision(t *testing.T) {
 			// Delete NetLB
 			svc.DeletionTimestamp = &metav1.Time{}
 			updateNetLBService(lc, svc)
-			if err = lc.sync(key, klog.TODO()); err != nil {
+			if err = lc.sync(context.Background(), key, klog.TODO()); err != nil {
 				t.Errorf("Failed to sync service %s, err %v", svc.Name, err)
 			}
 			svc, err = lc.ctx.KubeClient.CoreV1().Services(svc.Namespace).Get(context.TODO(), svc.Name, metav1.GetOptions{})
@@ -35,6 +35,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/ingress-gce/pkg/composite"
 	ccontext "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	l4metrics "k8s.io/ingress-gce/pkg/l4/metrics"
 	"k8s.io/ingress-gce/pkg/l4/resources"
@@ -135,6 +136,11 @@ func NewStandaloneNEGLBController(ctx *ccontext.ControllerContext, stopCh <-chan
 			}
 			oldSvc, okOld := old.(*v1.Service)
 			if okOld && lc.shouldProcess(oldSvc) || lc.shouldProcess(curSvc) {
+				if okOld && oldSvc.ResourceVersion == curSvc.ResourceVersion {
+					// Informer resync of an unchanged service.
+					lc.svcQueue.EnqueueResync(curSvc)
+					return
+				}
 				lc.enqueue(curSvc)
 			}
 		},
@@ -205,11 +211,11 @@ func (lc *StandaloneNEGLBController) syncWrapper(key string) (err error) {
 			err = fmt.Errorf("%s", errMessage)
 		}
 	}()
-	syncErr := lc.sync(key, svcLogger)
+	syncErr := lc.sync(context.Background(), key, svcLogger)
 	return syncErr
 }
 
-func (lc *StandaloneNEGLBController) sync(key string, svcLogger klog.Logger) error {
+func (lc *StandaloneNEGLBController) sync(ctx context.Context, key string, svcLogger klog.Logger) error {
 	start := time.Now()
 	l4metrics.PublishL4controllerLastSyncTime(StandaloneNEGLBControllerName)
 
@@ -238,7 +244,7 @@ func (lc *StandaloneNEGLBController) sync(key string, svcLogger klog.Logger) err
 		return nil
 	}
 
-	schemes, err := lc.syncStandaloneNEGLB(svc, svcLogger)
+	schemes, err := lc.syncStandaloneNEGLB(ctx, svc, svcLogger)
 	lc.publishMetrics(key, schemes, err, start)
 	return err
 }
@@ -309,10 +315,10 @@ func sortParsedFRs(frs []parsedForwardingRule) {
 	})
 }
 
-func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(svc *v1.Service, svcLogger klog.Logger) (lbSchemes []string, err error) {
+func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(ctx context.Context, svc *v1.Service, svcLogger klog.Logger) (lbSchemes []string, err error) {
 	frNamesStr, ok := svc.Annotations[annotations.CustomForwardingRuleKey]
 	if !ok || frNamesStr == "" {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "NoForwardingRuleRef", "Service has no forwarding rule reference")
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonNoForwardingRuleRef, "Service has no forwarding rule reference")
 		svcLogger.V(4).Info("Service has no forwarding rule reference, skipping")
 		cond := NewConditionExternalIPProgrammedFalse(NoForwardingRuleRef)
 		err := updateServiceStatus(lc.ctx, svc, &v1.LoadBalancerStatus{Ingress: nil}, []metav1.Condition{cond}, nil, svcLogger)
@@ -342,10 +348,10 @@ func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(svc *v1.Service, svcLog
 			errs = append(errs, err)
 		}
 		if len(errs) > 0 {
-			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "ForwardingRuleUnusable", "Could not use any Forwarding Rule %s", errors.Join(errs...).Error())
+			lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonForwardingRuleUnusable, "Could not use any Forwarding Rule %s", errors.Join(errs...).Error())
 			return nil, errors.Join(errs...)
 		}
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "NoForwardingRuleRef", "Service has no forwarding rule reference")
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonNoForwardingRuleRef, "Service has no forwarding rule reference")
 		return nil, l4utils.NewUserError(fmt.Errorf("service has no valid forwarding rule reference in annotation"))
 	}
 
@@ -353,7 +359,7 @@ func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(svc *v1.Service, svcLog
 		// Sort alphabetically forwarding rules so potentially skipped rules are consistent between resyncs.
 		sortParsedFRs(parsedRules)
 		skippedFrs := strings.Join(parsedFRNames(parsedRules[ForwardingRulesLimit:]), ", ")
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "ForwardingRuleUnusable", "Up to %d forwarding rules are supported. Skipping remaining forwarding rules (%s)", ForwardingRulesLimit, skippedFrs)
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonForwardingRuleUnusable, "Up to %d forwarding rules are supported. Skipping remaining forwarding rules (%s)", ForwardingRulesLimit, skippedFrs)
 		parsedRules = parsedRules[:ForwardingRulesLimit]
 	}
 
@@ -362,7 +368,7 @@ func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(svc *v1.Service, svcLog
 	var schemes []string
 
 	for _, parsed := range parsedRules {
-		fr, err := composite.GetForwardingRule(lc.ctx.Cloud, parsed.key, meta.VersionBeta, svcLogger)
+		fr, err := composite.GetForwardingRule(ctx, lc.ctx.Cloud, parsed.key, meta.VersionBeta, svcLogger)
 		if err != nil {
 			svcLogger.Error(err, "failed to get forwarding rule", "frName", parsed.rawName)
 			if utils.IsNotFoundError(err) {
@@ -393,7 +399,7 @@ func (lc *StandaloneNEGLBController) syncStandaloneNEGLB(svc *v1.Service, svcLog
 	}
 
 	if len(errs) > 0 {
-		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, "ForwardingRuleUnusable", "Could not use all Forwarding Rules %s", errors.Join(errs...).Error())
+		lc.ctx.Recorder(svc.Namespace).Eventf(svc, v1.EventTypeWarning, events.ReasonForwardingRuleUnusable, "Could not use all Forwarding Rules %s", errors.Join(errs...).Error())
 	}
 	// if at least one FR was ok then we use it
 	if len(lbIngresses) == 0 {
@@ -1030,7 +1030,7 @@ func TestStandaloneNEGLBSync(t *testing.T) {
 				if err != nil {
 					t.Fatalf("Failed to create key for forwarding rule %s: %v", name, err)
 				}
-				err = composite.CreateForwardingRule(fakeGCE, key, fr, klog.TODO())
+				err = composite.CreateForwardingRule(context.Background(), fakeGCE, key, fr, klog.TODO())
 				if err != nil {
 					t.Fatalf("Failed to create forwarding rule %s: %v", name, err)
 				}
@@ -1288,7 +1288,7 @@ func TestStandaloneNEGLBControllerMetrics_Success(t *testing.T) {
 		Scope:               meta.Regional,
 		Version:             meta.VersionBeta,
 	}
-	err = composite.CreateForwardingRule(fakeGCE, key, fr, klog.TODO())
+	err = composite.CreateForwardingRule(context.Background(), fakeGCE, key, fr, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to create forwarding rule: %v", err)
 	}
@@ -1355,7 +1355,7 @@ func TestStandaloneNEGLBControllerMetrics_UserError(t *testing.T) {
 		Scope:               meta.Regional,
 		Version:             meta.VersionBeta,
 	}
-	err = composite.CreateForwardingRule(fakeGCE, key, fr, klog.TODO())
+	err = composite.CreateForwardingRule(context.Background(), fakeGCE, key, fr, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to create forwarding rule: %v", err)
 	}
@@ -1475,7 +1475,7 @@ func TestStandaloneNEGLBControllerMetrics_Deletion(t *testing.T) {
 		Scope:               meta.Regional,
 		Version:             meta.VersionBeta,
 	}
-	err = composite.CreateForwardingRule(fakeGCE, key, fr, klog.TODO())
+	err = composite.CreateForwardingRule(context.Background(), fakeGCE, key, fr, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to create forwarding rule: %v", err)
 	}
@@ -1,6 +1,7 @@
 package forwardingrules
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
@@ -29,30 +30,30 @@ func New(cloud *gce.Cloud, version meta.Version, scope meta.KeyType, logger klog
 	}
 }
 
-func (frc *ForwardingRules) Create(forwardingRule *composite.ForwardingRule) error {
+func (frc *ForwardingRules) Create(ctx context.Context, forwardingRule *composite.ForwardingRule) error {
 	key, err := frc.createKey(forwardingRule.Name)
 	if err != nil {
 		frc.logger.Error(err, "Failed to create key for creating forwarding rule", "forwardingRuleName", forwardingRule.Name)
 		return nil
 	}
-	return composite.CreateForwardingRule(frc.cloud, key, forwardingRule, frc.logger)
+	return composite.CreateForwardingRule(ctx, frc.cloud, key, forwardingRule, frc.logger)
 }
 
-func (frc *ForwardingRules) Patch(forwardingRule *composite.ForwardingRule) error {
+func (frc *ForwardingRules) Patch(ctx context.Context, forwardingRule *composite.ForwardingRule) error {
 	key, err := frc.createKey(forwardingRule.Name)
 	if err != nil {
 		frc.logger.Error(err, "Failed to create key for creating forwarding rule", "forwardingRuleName", forwardingRule.Name)
 		return nil
 	}
-	return composite.PatchForwardingRule(frc.cloud, key, forwardingRule, frc.logger)
+	return composite.PatchForwardingRule(ctx, frc.cloud, key, forwardingRule, frc.logger)
 }
 
-func (frc *ForwardingRules) Get(name string) (*composite.ForwardingRule, error) {
+func (frc *ForwardingRules) Get(ctx context.Context, name string) (*composite.ForwardingRule, error) {
 	key, err := frc.createKey(name)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create key for fetching forwarding rule %s, err: %w", name, err)
 	}
-	fr, err := composite.GetForwardingRule(frc.cloud, key, frc.version, frc.logger)
+	fr, err := composite.GetForwardingRule(ctx, frc.cloud, key, frc.version, frc.logger)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		return nil, fmt.Errorf("Failed to get existing forwarding rule %s, err: %w", name, err)
 	}
@@ -60,25 +61,25 @@ func (frc *ForwardingRules) Get(name string) (*composite.ForwardingRule, error)
 }
 
 // List will list all of the Forwarding Rules in GCE matching the filter.
-func (frc *ForwardingRules) List(filter *filter.F) ([]*composite.ForwardingRule, error) {
+func (frc *ForwardingRules) List(ctx context.Context, filter *filter.F) ([]*composite.ForwardingRule, error) {
 	key, err := frc.createKey("")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create key for listing forwarding rules, err: %w", err)
 	}
 
-	frs, err := composite.ListForwardingRules(frc.cloud, key, frc.version, frc.logger, filter)
+	frs, err := composite.ListForwardingRules(ctx, frc.cloud, key, frc.version, frc.logger, filter)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		return nil, fmt.Errorf("failed to list forwarding rules with filter %v: %w", filter, err)
 	}
 	return frs, nil
 }
 
-func (frc *ForwardingRules) Delete(name string) error {
+func (frc *ForwardingRules) Delete(ctx context.Context, name string) error {
 	key, err := frc.createKey(name)
 	if err != nil {
 		return fmt.Errorf("Failed to create key for deleting forwarding rule %s, err: %w", name, err)
 	}
-	err = composite.DeleteForwardingRule(frc.cloud, key, frc.version, frc.logger)
+	err = composite.DeleteForwardingRule(ctx, frc.cloud, key, frc.version, frc.logger)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		return fmt.Errorf("Failed to delete forwarding rule %s, err: %w", name, err)
 	}
@@ -1,6 +1,7 @@
 package forwardingrules
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -42,7 +43,7 @@ func TestCreateForwardingRule(t *testing.T) {
 			fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
 			frc := New(fakeGCE, meta.VersionGA, meta.Regional, klog.TODO())
 
-			err := frc.Create(tc.frRule)
+			err := frc.Create(context.Background(), tc.frRule)
 			if err != nil {
 				t.Fatalf("frc.Create(%v), returned error %v, want nil", tc.frRule, err)
 			}
@@ -101,7 +102,7 @@ func TestGetForwardingRule(t *testing.T) {
 			frc := New(fakeGCE, meta.VersionGA, meta.Regional, klog.TODO())
 			mustCreateForwardingRules(t, fakeGCE, tc.existingFwdRules)
 
-			fr, err := frc.Get(tc.getFwdRuleName)
+			fr, err := frc.Get(context.Background(), tc.getFwdRuleName)
 			if err != nil {
 				t.Fatalf("frc.Get(%v), returned error %v, want nil", tc.getFwdRuleName, err)
 			}
@@ -210,7 +211,7 @@ func TestListForwardingRules(t *testing.T) {
 			mustCreateForwardingRules(t, fakeGCE, tc.existingFwdRules)
 
 			// Act
-			got, err := frc.List(tc.filter)
+			got, err := frc.List(context.Background(), tc.filter)
 			// Assert
 			if err != nil {
 				t.Fatalf("frc.List(%v), returned error %v, want nil", tc.filter, err)
@@ -275,7 +276,7 @@ func TestDeleteForwardingRule(t *testing.T) {
 			frc := New(fakeGCE, meta.VersionGA, meta.Regional, klog.TODO())
 			mustCreateForwardingRules(t, fakeGCE, tc.existingFwdRules)
 
-			err := frc.Delete(tc.deleteFwdRuleName)
+			err := frc.Delete(context.Background(), tc.deleteFwdRuleName)
 			if err != nil {
 				t.Fatalf("frc.Delete(%v), returned error %v, want nil", tc.deleteFwdRuleName, err)
 			}
@@ -300,7 +301,7 @@ func verifyForwardingRuleExists(cloud *gce.Cloud, name string) error {
 		return fmt.Errorf("failed to create key for fetching forwarding rule %s, err: %w", name, err)
 	}
 
-	_, err = composite.GetForwardingRule(cloud, key, meta.VersionGA, klog.TODO())
+	_, err = composite.GetForwardingRule(context.Background(), cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return fmt.Errorf("forwarding rule %s was not found, expected to exist", name)
@@ -316,7 +317,7 @@ func verifyForwardingRuleNotExists(cloud *gce.Cloud, name string) error {
 		return fmt.Errorf("failed to create key for fetching forwarding rule %s, err: %w", name, err)
 	}
 
-	_, err = composite.GetForwardingRule(cloud, key, meta.VersionGA, klog.TODO())
+	_, err = composite.GetForwardingRule(context.Background(), cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return nil
@@ -337,7 +338,7 @@ func mustCreateForwardingRule(t *testing.T, cloud *gce.Cloud, fr *composite.Forw
 	t.Helper()
 
 	key := meta.RegionalKey(fr.Name, cloud.Region())
-	err := composite.CreateForwardingRule(cloud, key, fr, klog.TODO())
+	err := composite.CreateForwardingRule(context.Background(), cloud, key, fr, klog.TODO())
 	if err != nil {
 		t.Fatalf("composite.CreateForwardingRule(_, %s, %v) returned error %v, want nil", key, fr, err)
 	}
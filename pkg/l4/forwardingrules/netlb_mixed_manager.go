@@ -1,6 +1,7 @@
 package forwardingrules
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -38,10 +39,10 @@ type Namer interface {
 //
 // It is assumed that delete doesn't return 404 errors when forwarding rule doesn't exist.
 type Provider interface {
-	Get(name string) (*composite.ForwardingRule, error)
-	Create(forwardingRule *composite.ForwardingRule) error
-	Delete(name string) error
-	Patch(forwardingRule *composite.ForwardingRule) error
+	Get(ctx context.Context, name string) (*composite.ForwardingRule, error)
+	Create(ctx context.Context, forwardingRule *composite.ForwardingRule) error
+	Delete(ctx context.Context, name string) error
+	Patch(ctx context.Context, forwardingRule *composite.ForwardingRule) error
 }
 
 // MixedManagerNetLB is responsible for Ensuring and Deleting Forwarding Rules
@@ -67,7 +68,7 @@ type EnsureNetLBResult struct {
 }
 
 // EnsureIPv4 will try to create or update forwarding rules for mixed protocol service.
-func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBResult, error) {
+func (m *MixedManagerNetLB) EnsureIPv4(ctx context.Context, backendServiceLink string) (EnsureNetLBResult, error) {
 	m.Logger = m.Logger.WithName("MixedManagerNetLB")
 	svcPorts := m.Service.Spec.Ports
 	res := EnsureNetLBResult{
@@ -78,12 +79,12 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 		return res, fmt.Errorf("MixedManagerELB shouldn't be used to ensure single protocol forwarding rules to be backwards compatible")
 	}
 
-	existing, err := m.AllRules()
+	existing, err := m.AllRules(ctx)
 	if err != nil {
 		return res, err
 	}
 
-	addressHandle, err := address.HoldExternalIPv4(address.HoldConfig{
+	addressHandle, err := address.HoldExternalIPv4(ctx, address.HoldConfig{
 		Cloud:                 m.Cloud,
 		Recorder:              m.Recorder,
 		Logger:                m.Logger,
@@ -103,12 +104,12 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 	res.IPManaged = addressHandle.Managed
 
 	// We need to delete legacy named forwarding rule to avoid port collisions
-	if err := m.deleteLegacy(); err != nil {
+	if err := m.deleteLegacy(ctx); err != nil {
 		return res, err
 	}
 
 	if m.L3DefaultEnabled {
-		res.L3FwdRule, res.SyncStatus, err = m.ensure(existing.L3, backendServiceLink, "L3_DEFAULT", addressHandle.IP)
+		res.L3FwdRule, res.SyncStatus, err = m.ensure(ctx, existing.L3, backendServiceLink, "L3_DEFAULT", addressHandle.IP)
 		if err != nil {
 			return res, err
 		}
@@ -117,7 +118,7 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 		if haveToDeleteSplitRules {
 			m.Logger.Info("TCP and UDP forwarding rules are present, deleting them in favor of L3_DEFAULT rule")
 			res.SyncStatus = l4utils.ResourceUpdate
-			if err := m.deleteExistingSplit(existing); err != nil {
+			if err := m.deleteExistingSplit(ctx, existing); err != nil {
 				return res, err
 			}
 		}
@@ -128,7 +129,7 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 	if existing.L3 != nil {
 		m.Logger.Info("L3 forwarding rule is present, deleting it in favor of split TCP and UDP rules")
 		res.SyncStatus = l4utils.ResourceUpdate
-		if err := m.delete("l3"); err != nil {
+		if err := m.delete(ctx, "l3"); err != nil {
 			return res, err
 		}
 	}
@@ -139,11 +140,11 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		res.TCPFwdRule, tcpSync, tcpErr = m.ensure(existing.TCP, backendServiceLink, "TCP", addressHandle.IP)
+		res.TCPFwdRule, tcpSync, tcpErr = m.ensure(ctx, existing.TCP, backendServiceLink, "TCP", addressHandle.IP)
 	}()
 	go func() {
 		defer wg.Done()
-		res.UDPFwdRule, udpSync, udpErr = m.ensure(existing.UDP, backendServiceLink, "UDP", addressHandle.IP)
+		res.UDPFwdRule, udpSync, udpErr = m.ensure(ctx, existing.UDP, backendServiceLink, "UDP", addressHandle.IP)
 	}()
 
 	wg.Wait()
@@ -163,7 +164,7 @@ func (m *MixedManagerNetLB) EnsureIPv4(backendServiceLink string) (EnsureNetLBRe
 // * if equal 			-> do nothing
 // * if can be patched 	-> patch
 // * else 				-> delete and recreate
-func (m *MixedManagerNetLB) ensure(existing *composite.ForwardingRule, backendServiceLink, protocol, ip string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+func (m *MixedManagerNetLB) ensure(ctx context.Context, existing *composite.ForwardingRule, backendServiceLink, protocol, ip string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
 	name := m.name(protocol)
 	start := time.Now()
 	log := m.Logger.
@@ -183,11 +184,11 @@ func (m *MixedManagerNetLB) ensure(existing *composite.ForwardingRule, backendSe
 
 	// Exists
 	if existing == nil {
-		if err := m.Provider.Create(wanted); err != nil {
+		if err := m.Provider.Create(ctx, wanted); err != nil {
 			log.Error(err, "Provider.Create returned error")
 			return nil, l4utils.ResourceUpdate, err
 		}
-		return m.getAfterUpdate(name)
+		return m.getAfterUpdate(ctx, name)
 	}
 
 	// Can't update
@@ -207,27 +208,27 @@ func (m *MixedManagerNetLB) ensure(existing *composite.ForwardingRule, backendSe
 
 	// Patchable
 	if patchable, filtered := PatchableIPv4(existing, wanted); patchable {
-		if err := m.Provider.Patch(filtered); err != nil {
+		if err := m.Provider.Patch(ctx, filtered); err != nil {
 			return nil, l4utils.ResourceUpdate, err
 		}
 		m.recordEventf("ForwardingRule %s patched", name)
-		return m.getAfterUpdate(name)
+		return m.getAfterUpdate(ctx, name)
 	}
 
 	// Recreate
-	if err := m.recreate(wanted); err != nil {
+	if err := m.recreate(ctx, wanted); err != nil {
 		return nil, l4utils.ResourceResync, err
 	}
-	return m.getAfterUpdate(name)
+	return m.getAfterUpdate(ctx, name)
 }
 
-func (m *MixedManagerNetLB) recreate(wanted *composite.ForwardingRule) error {
-	if err := m.Provider.Delete(wanted.Name); err != nil {
+func (m *MixedManagerNetLB) recreate(ctx context.Context, wanted *composite.ForwardingRule) error {
+	if err := m.Provider.Delete(ctx, wanted.Name); err != nil {
 		return err
 	}
 	m.recordEventf("ForwardingRule %s deleted", wanted.Name)
 
-	if err := m.Provider.Create(wanted); err != nil {
+	if err := m.Provider.Create(ctx, wanted); err != nil {
 		return err
 	}
 	m.recordEventf("ForwardingRule %s re-created", wanted.Name)
@@ -278,8 +279,8 @@ func (m *MixedManagerNetLB) buildWanted(backendServiceLink, name, protocol, ip s
 	}, nil
 }
 
-func (m *MixedManagerNetLB) getAfterUpdate(name string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
-	found, err := m.Provider.Get(name)
+func (m *MixedManagerNetLB) getAfterUpdate(ctx context.Context, name string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+	found, err := m.Provider.Get(ctx, name)
 	if err != nil {
 		return nil, l4utils.ResourceUpdate, err
 	}
@@ -307,7 +308,7 @@ type NetLBManagedRules struct {
 }
 
 // AllRules returns all forwarding rules for a service specified in the manager
-func (m *MixedManagerNetLB) AllRules() (NetLBManagedRules, error) {
+func (m *MixedManagerNetLB) AllRules(ctx context.Context) (NetLBManagedRules, error) {
 	var wg sync.WaitGroup
 	var tcp, udp, l3, legacy *composite.ForwardingRule
 	var tcpErr, udpErr, l3Err, legacyErr error
@@ -315,19 +316,19 @@ func (m *MixedManagerNetLB) AllRules() (NetLBManagedRules, error) {
 	wg.Add(4)
 	go func() {
 		defer wg.Done()
-		tcp, tcpErr = m.Provider.Get(m.name("tcp"))
+		tcp, tcpErr = m.Provider.Get(ctx, m.name("tcp"))
 	}()
 	go func() {
 		defer wg.Done()
-		udp, udpErr = m.Provider.Get(m.name("udp"))
+		udp, udpErr = m.Provider.Get(ctx, m.name("udp"))
 	}()
 	go func() {
 		defer wg.Done()
-		l3, l3Err = m.Provider.Get(m.name("l3"))
+		l3, l3Err = m.Provider.Get(ctx, m.name("l3"))
 	}()
 	go func() {
 		defer wg.Done()
-		legacy, legacyErr = m.Provider.Get(m.nameLegacy())
+		legacy, legacyErr = m.Provider.Get(ctx, m.nameLegacy())
 	}()
 	wg.Wait()
 
@@ -337,22 +338,22 @@ func (m *MixedManagerNetLB) AllRules() (NetLBManagedRules, error) {
 }
 
 // DeleteIPv4 will try to delete forwarding rules for mixed protocol NetLB service.
-func (m *MixedManagerNetLB) DeleteIPv4() error {
+func (m *MixedManagerNetLB) DeleteIPv4(ctx context.Context) error {
 	var wg sync.WaitGroup
 	var tcpErr, udpErr, l3Err error
 	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
-		tcpErr = m.delete("tcp")
+		tcpErr = m.delete(ctx, "tcp")
 	}()
 	go func() {
 		defer wg.Done()
-		udpErr = m.delete("udp")
+		udpErr = m.delete(ctx, "udp")
 	}()
 	go func() {
 		defer wg.Done()
-		l3Err = m.delete("l3")
+		l3Err = m.delete(ctx, "l3")
 	}()
 
 	wg.Wait()
@@ -361,7 +362,7 @@ func (m *MixedManagerNetLB) DeleteIPv4() error {
 
 // DeleteExistingIPv4 will try to delete forwarding rules for mixed protocol NetLB service
 // only if they have been found. This saves a few GCE calls compared to DeleteIPv4.
-func (m *MixedManagerNetLB) DeleteExistingIPv4(haveRules NetLBManagedRules) error {
+func (m *MixedManagerNetLB) DeleteExistingIPv4(ctx context.Context, haveRules NetLBManagedRules) error {
 	var wg sync.WaitGroup
 	var tcpErr, udpErr, l3Err error
 	wg.Add(3)
@@ -369,19 +370,19 @@ func (m *MixedManagerNetLB) DeleteExistingIPv4(haveRules NetLBManagedRules) erro
 	go func() {
 		defer wg.Done()
 		if haveRules.TCP != nil {
-			tcpErr = m.delete("tcp")
+			tcpErr = m.delete(ctx, "tcp")
 		}
 	}()
 	go func() {
 		defer wg.Done()
 		if haveRules.UDP != nil {
-			udpErr = m.delete("udp")
+			udpErr = m.delete(ctx, "udp")
 		}
 	}()
 	go func() {
 		defer wg.Done()
 		if haveRules.L3 != nil {
-			l3Err = m.delete("l3")
+			l3Err = m.delete(ctx, "l3")
 		}
 	}()
 
@@ -389,7 +390,7 @@ func (m *MixedManagerNetLB) DeleteExistingIPv4(haveRules NetLBManagedRules) erro
 	return errors.Join(tcpErr, udpErr, l3Err)
 }
 
-func (m *MixedManagerNetLB) deleteExistingSplit(haveRules NetLBManagedRules) error {
+func (m *MixedManagerNetLB) deleteExistingSplit(ctx context.Context, haveRules NetLBManagedRules) error {
 	var wg sync.WaitGroup
 	var tcpErr, udpErr error
 	wg.Add(2)
@@ -397,13 +398,13 @@ func (m *MixedManagerNetLB) deleteExistingSplit(haveRules NetLBManagedRules) err
 	go func() {
 		defer wg.Done()
 		if haveRules.TCP != nil {
-			tcpErr = m.delete("tcp")
+			tcpErr = m.delete(ctx, "tcp")
 		}
 	}()
 	go func() {
 		defer wg.Done()
 		if haveRules.UDP != nil {
-			udpErr = m.delete("udp")
+			udpErr = m.delete(ctx, "udp")
 		}
 	}()
 
@@ -411,16 +412,16 @@ func (m *MixedManagerNetLB) deleteExistingSplit(haveRules NetLBManagedRules) err
 	return errors.Join(tcpErr, udpErr)
 }
 
-func (m *MixedManagerNetLB) delete(protocol string) error {
+func (m *MixedManagerNetLB) delete(ctx context.Context, protocol string) error {
 	name := m.name(protocol)
-	return m.Provider.Delete(name)
+	return m.Provider.Delete(ctx, name)
 }
 
 // We need to clean up existing forwarding rule so that there isn't a port collision.
 // This means deleting forwarding rule with legacy name - starting with 'a'
 // and using protocol specific names identical to those used by ILB.
-func (m *MixedManagerNetLB) deleteLegacy() error {
-	return m.Provider.Delete(m.nameLegacy())
+func (m *MixedManagerNetLB) deleteLegacy(ctx context.Context) error {
+	return m.Provider.Delete(ctx, m.nameLegacy())
 }
 
 func (m *MixedManagerNetLB) name(protocol string) string {
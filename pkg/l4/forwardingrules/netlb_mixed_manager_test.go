@@ -236,7 +236,7 @@ func TestMixedManagerNetLB_EnsureIPv4_SplitRules(t *testing.T) {
 				}
 
 				// Act
-				result, err := mgr.EnsureIPv4(bsLink)
+				result, err := mgr.EnsureIPv4(context.Background(), bsLink)
 				// Assert
 				if err != nil {
 					t.Errorf("EnsureIPv4() error = %v", err)
@@ -463,7 +463,7 @@ func TestMixedManagerNetLB_EnsureIPv4_L3Default(t *testing.T) {
 				}
 
 				// Act
-				result, err := mgr.EnsureIPv4(bsLink)
+				result, err := mgr.EnsureIPv4(context.Background(), bsLink)
 				// Assert
 				if err != nil {
 					t.Errorf("EnsureIPv4() error = %v", err)
@@ -493,12 +493,12 @@ func TestMixedManagerNetLB_EnsureIPv4_Resync(t *testing.T) {
 	ports := []api_v1.ServicePort{{Protocol: api_v1.ProtocolTCP, Port: 80}, {Protocol: api_v1.ProtocolUDP, Port: 53}}
 	_, mgr := arrange(ports, true)
 	// Run first to create resources
-	if _, err := mgr.EnsureIPv4(bsLink); err != nil {
+	if _, err := mgr.EnsureIPv4(context.Background(), bsLink); err != nil {
 		t.Fatalf("EnsureIPv4() error = %v", err)
 	}
 
 	// Act
-	result, err := mgr.EnsureIPv4(bsLink)
+	result, err := mgr.EnsureIPv4(context.Background(), bsLink)
 	// Assert
 	if err != nil {
 		t.Fatalf("EnsureIPv4() error = %v", err)
@@ -534,7 +534,7 @@ func TestMixedManagerNetLB_EnsureIPv4_SingleProtocol(t *testing.T) {
 			_, mgr := arrange([]api_v1.ServicePort{{Protocol: api_v1.ProtocolTCP, Port: 8080}}, enableL3)
 
 			// Act
-			_, err := mgr.EnsureIPv4(bsLink)
+			_, err := mgr.EnsureIPv4(context.Background(), bsLink)
 
 			// Assert
 			if err == nil {
@@ -585,7 +585,7 @@ func TestMixedManagerNetLB_AllRules(t *testing.T) {
 			}
 
 			// Act
-			rules, err := mgr.AllRules()
+			rules, err := mgr.AllRules(context.Background())
 			// Assert
 			if err != nil {
 				t.Errorf("AllRules() error = %v", err)
@@ -623,7 +623,7 @@ func TestMixedManagerNetLB_EnsureIPv4_L3ExistsDuringMigration(t *testing.T) {
 	}
 
 	backendServiceLink := "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1/backendServices/k8s2-ai-ml-enabler"
-	res, err := m.EnsureIPv4(backendServiceLink)
+	res, err := m.EnsureIPv4(context.Background(), backendServiceLink)
 	if err != nil {
 		t.Errorf("EnsureIPv4() error = %v", err)
 	}
@@ -654,7 +654,7 @@ func TestMixedManagerNetLB_EnsureIPv4_L3ExistsDuringMigration(t *testing.T) {
 
 	// Act
 	m.L3DefaultEnabled = true
-	res, err = m.EnsureIPv4(backendServiceLink)
+	res, err = m.EnsureIPv4(context.Background(), backendServiceLink)
 	if err != nil {
 		t.Errorf("EnsureIPv4() error = %v", err)
 	}
@@ -726,7 +726,7 @@ func TestMixedManagerNetLB_DeleteIPv4(t *testing.T) {
 			}
 
 			// Act
-			err := mgr.DeleteIPv4()
+			err := mgr.DeleteIPv4(context.Background())
 			// Assert
 			if err != nil {
 				t.Errorf("DeleteIPv4() error = %v", err)
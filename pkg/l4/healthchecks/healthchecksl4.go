@@ -17,6 +17,7 @@ limitations under the License.
 package healthchecks
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/cloud-provider/service/helpers"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/firewalls"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
@@ -115,11 +117,11 @@ func healthcheckUnhealthyThreshold(isShared bool) int64 {
 // Firewall rules are always created at in the Global scope (vs
 // Regional). This means that one Firewall rule is created for
 // Services of different scope (Global vs Regional).
-func (l4hc *l4HealthChecks) EnsureHealthCheckWithFirewall(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, nodeNames []string, svcNetwork network.NetworkInfo, svcLogger klog.Logger) *EnsureHealthCheckResult {
-	return l4hc.EnsureHealthCheckWithDualStackFirewalls(svc, namer, sharedHC, scope, l4Type, nodeNames /*create IPv4*/, true /*don't create IPv6*/, false, svcNetwork, svcLogger)
+func (l4hc *l4HealthChecks) EnsureHealthCheckWithFirewall(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, nodeNames []string, svcNetwork network.NetworkInfo, svcLogger klog.Logger) *EnsureHealthCheckResult {
+	return l4hc.EnsureHealthCheckWithDualStackFirewalls(ctx, svc, namer, sharedHC, scope, l4Type, nodeNames /*create IPv4*/, true /*don't create IPv6*/, false, svcNetwork, svcLogger)
 }
 
-func (l4hc *l4HealthChecks) EnsureHealthCheckWithDualStackFirewalls(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, nodeNames []string, needsIPv4 bool, needsIPv6 bool, svcNetwork network.NetworkInfo, svcLogger klog.Logger) *EnsureHealthCheckResult {
+func (l4hc *l4HealthChecks) EnsureHealthCheckWithDualStackFirewalls(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, nodeNames []string, needsIPv4 bool, needsIPv6 bool, svcNetwork network.NetworkInfo, svcLogger klog.Logger) *EnsureHealthCheckResult {
 	namespacedName := types.NamespacedName{Name: svc.Name, Namespace: svc.Namespace}
 
 	hcName := namer.L4HealthCheck(svc.Namespace, svc.Name, sharedHC)
@@ -135,7 +137,7 @@ func (l4hc *l4HealthChecks) EnsureHealthCheckWithDualStackFirewalls(svc *corev1.
 	}
 	hcLogger.V(3).Info("L4 Healthcheck", "expectedPath", hcPath, "expectedPort", hcPort)
 
-	hcLink, wasUpdate, err := l4hc.ensureHealthCheck(hcName, namespacedName, sharedHC, hcPath, hcPort, scope, l4Type, hcLogger)
+	hcLink, wasUpdate, err := l4hc.ensureHealthCheck(ctx, hcName, namespacedName, sharedHC, hcPath, hcPort, scope, l4Type, hcLogger)
 	if err != nil {
 		hcLogger.Error(err, "Error while ensuring hc")
 		return &EnsureHealthCheckResult{
@@ -169,14 +171,14 @@ func (l4hc *l4HealthChecks) EnsureHealthCheckWithDualStackFirewalls(svc *corev1.
 	return hcResult
 }
 
-func (l4hc *l4HealthChecks) ensureHealthCheck(hcName string, svcName types.NamespacedName, shared bool, path string, port int32, scope meta.KeyType, l4Type utils.L4LBType, hcLogger klog.Logger) (string, l4utils.ResourceSyncStatus, error) {
+func (l4hc *l4HealthChecks) ensureHealthCheck(ctx context.Context, hcName string, svcName types.NamespacedName, shared bool, path string, port int32, scope meta.KeyType, l4Type utils.L4LBType, hcLogger klog.Logger) (string, l4utils.ResourceSyncStatus, error) {
 	start := time.Now()
 	hcLogger.V(2).Info("Ensuring healthcheck for service", "shared", shared, "path", path, "port", port, "scope", scope, "l4Type", l4Type.ToString())
 	defer func() {
 		hcLogger.V(2).Info("Finished ensuring healthcheck for service", "timeTaken", time.Since(start))
 	}()
 
-	hc, err := l4hc.hcProvider.Get(hcName, scope)
+	hc, err := l4hc.hcProvider.Get(ctx, hcName, scope)
 	if err != nil {
 		return "", l4utils.ResourceResync, err
 	}
@@ -190,7 +192,7 @@ func (l4hc *l4HealthChecks) ensureHealthCheck(hcName string, svcName types.Names
 	if hc == nil {
 		// Create the healthcheck
 		hcLogger.V(2).Info("Creating healthcheck for service", "shared", shared, "expectedHealthcheck", expectedHC)
-		err = l4hc.hcProvider.Create(expectedHC)
+		err = l4hc.hcProvider.Create(ctx, expectedHC)
 		if err != nil {
 			return "", l4utils.ResourceResync, err
 		}
@@ -208,7 +210,7 @@ func (l4hc *l4HealthChecks) ensureHealthCheck(hcName string, svcName types.Names
 	}
 	mergeHealthChecks(hc, expectedHC)
 	hcLogger.V(2).Info("Updating healthcheck for service", "updatedHealthcheck", expectedHC)
-	err = l4hc.hcProvider.Update(expectedHC.Name, scope, expectedHC)
+	err = l4hc.hcProvider.Update(ctx, expectedHC.Name, scope, expectedHC)
 	if err != nil {
 		return selfLink, l4utils.ResourceUpdate, err
 	}
@@ -295,20 +297,20 @@ func (l4hc *l4HealthChecks) firewallPriority() *int {
 	return nil // when the field is unset the priority by default is 1000
 }
 
-func (l4hc *l4HealthChecks) DeleteHealthCheckWithFirewall(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, svcLogger klog.Logger) (string, error) {
-	return l4hc.deleteHealthCheckWithDualStackFirewalls(svc, namer, sharedHC, scope, l4Type /* don't delete ipv6 */, false, svcLogger)
+func (l4hc *l4HealthChecks) DeleteHealthCheckWithFirewall(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, svcLogger klog.Logger) (string, error) {
+	return l4hc.deleteHealthCheckWithDualStackFirewalls(ctx, svc, namer, sharedHC, scope, l4Type /* don't delete ipv6 */, false, svcLogger)
 }
 
 // DeleteHealthCheckWithDualStackFirewalls deletes health check, ipv4 and ipv6 firewall rules for l4 service.
 // Checks if shared resources are safe to delete.
-func (l4hc *l4HealthChecks) DeleteHealthCheckWithDualStackFirewalls(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, svcLogger klog.Logger) (string, error) {
-	return l4hc.deleteHealthCheckWithDualStackFirewalls(svc, namer, sharedHC, scope, l4Type /* delete ipv6 */, true, svcLogger)
+func (l4hc *l4HealthChecks) DeleteHealthCheckWithDualStackFirewalls(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, svcLogger klog.Logger) (string, error) {
+	return l4hc.deleteHealthCheckWithDualStackFirewalls(ctx, svc, namer, sharedHC, scope, l4Type /* delete ipv6 */, true, svcLogger)
 }
 
 // deleteHealthCheckWithDualStackFirewalls deletes health check, ipv4  firewall rule
 // and ipv6 firewall if running in dual-stack mode for l4 service.
 // Checks if shared resources are safe to delete.
-func (l4hc *l4HealthChecks) deleteHealthCheckWithDualStackFirewalls(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, deleteIPv6 bool, svcLogger klog.Logger) (string, error) {
+func (l4hc *l4HealthChecks) deleteHealthCheckWithDualStackFirewalls(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, l4Type utils.L4LBType, deleteIPv6 bool, svcLogger klog.Logger) (string, error) {
 	if sharedHC {
 		// We need to acquire a controller-wide mutex to ensure that in the case of a healthcheck shared between loadbalancers that the sync of the GCE resources is not performed in parallel.
 		l4hc.sharedResourcesLock.Lock()
@@ -316,7 +318,7 @@ func (l4hc *l4HealthChecks) deleteHealthCheckWithDualStackFirewalls(svc *corev1.
 	}
 
 	svcLogger.V(3).Info("Trying to delete L4 healthcheck and firewall rule for service", "shared", sharedHC, "scope", scope)
-	hcWasDeleted, err := l4hc.deleteHealthCheck(svc, namer, sharedHC, scope, svcLogger)
+	hcWasDeleted, err := l4hc.deleteHealthCheck(ctx, svc, namer, sharedHC, scope, svcLogger)
 	if err != nil {
 		return annotations.HealthcheckResource, err
 	}
@@ -324,12 +326,12 @@ func (l4hc *l4HealthChecks) deleteHealthCheckWithDualStackFirewalls(svc *corev1.
 		return "", nil
 	}
 
-	resourceInError, err := l4hc.deleteIPv4HealthCheckFirewall(svc, namer, sharedHC, l4Type, svcLogger)
+	resourceInError, err := l4hc.deleteIPv4HealthCheckFirewall(ctx, svc, namer, sharedHC, l4Type, svcLogger)
 	if err != nil {
 		return resourceInError, err
 	}
 	if deleteIPv6 {
-		resourceInError, err = l4hc.deleteIPv6HealthCheckFirewall(svc, namer, sharedHC, l4Type, svcLogger)
+		resourceInError, err = l4hc.deleteIPv6HealthCheckFirewall(ctx, svc, namer, sharedHC, l4Type, svcLogger)
 		if err != nil {
 			return resourceInError, err
 		}
@@ -337,7 +339,7 @@ func (l4hc *l4HealthChecks) deleteHealthCheckWithDualStackFirewalls(svc *corev1.
 	return "", nil
 }
 
-func (l4hc *l4HealthChecks) deleteHealthCheck(svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, svcLogger klog.Logger) (bool, error) {
+func (l4hc *l4HealthChecks) deleteHealthCheck(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, sharedHC bool, scope meta.KeyType, svcLogger klog.Logger) (bool, error) {
 	start := time.Now()
 
 	hcName := namer.L4HealthCheck(svc.Namespace, svc.Name, sharedHC)
@@ -347,7 +349,7 @@ func (l4hc *l4HealthChecks) deleteHealthCheck(svc *corev1.Service, namer namer.L
 		svcLogger.V(3).Info("Finished deleting L4 healthcheck for service", "timeTaken", time.Since(start))
 	}()
 
-	err := l4hc.hcProvider.Delete(hcName, scope)
+	err := l4hc.hcProvider.Delete(ctx, hcName, scope)
 	if err != nil {
 		// Ignore deletion error due to health check in use by another resource.
 		if !utils.IsInUsedByError(err) {
@@ -360,7 +362,7 @@ func (l4hc *l4HealthChecks) deleteHealthCheck(svc *corev1.Service, namer namer.L
 	return true, nil
 }
 
-func (l4hc *l4HealthChecks) deleteIPv4HealthCheckFirewall(svc *corev1.Service, namer namer.L4ResourcesNamer, isSharedHC bool, l4type utils.L4LBType, svcLogger klog.Logger) (string, error) {
+func (l4hc *l4HealthChecks) deleteIPv4HealthCheckFirewall(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, isSharedHC bool, l4type utils.L4LBType, svcLogger klog.Logger) (string, error) {
 	hcName := namer.L4HealthCheck(svc.Namespace, svc.Name, isSharedHC)
 	hcFwName := namer.L4HealthCheckFirewall(svc.Namespace, svc.Name, isSharedHC)
 
@@ -371,10 +373,10 @@ func (l4hc *l4HealthChecks) deleteIPv4HealthCheckFirewall(svc *corev1.Service, n
 		fwLogger.V(3).Info("Finished deleting IPv4 Firewall for a healthcheck", "timeTaken", time.Since(start))
 	}()
 
-	return l4hc.deleteHealthCheckFirewall(svc, hcName, hcFwName, isSharedHC, l4type, fwLogger)
+	return l4hc.deleteHealthCheckFirewall(ctx, svc, hcName, hcFwName, isSharedHC, l4type, fwLogger)
 }
 
-func (l4hc *l4HealthChecks) deleteIPv6HealthCheckFirewall(svc *corev1.Service, namer namer.L4ResourcesNamer, isSharedHC bool, l4type utils.L4LBType, svcLogger klog.Logger) (string, error) {
+func (l4hc *l4HealthChecks) deleteIPv6HealthCheckFirewall(ctx context.Context, svc *corev1.Service, namer namer.L4ResourcesNamer, isSharedHC bool, l4type utils.L4LBType, svcLogger klog.Logger) (string, error) {
 	start := time.Now()
 
 	hcName := namer.L4HealthCheck(svc.Namespace, svc.Name, isSharedHC)
@@ -386,11 +388,11 @@ func (l4hc *l4HealthChecks) deleteIPv6HealthCheckFirewall(svc *corev1.Service, n
 		fwLogger.V(3).Info("Finished deleting IPv6 Firewall for a healthcheck", "timeTaken", time.Since(start))
 	}()
 
-	return l4hc.deleteHealthCheckFirewall(svc, hcName, ipv6hcFwName, isSharedHC, l4type, fwLogger)
+	return l4hc.deleteHealthCheckFirewall(ctx, svc, hcName, ipv6hcFwName, isSharedHC, l4type, fwLogger)
 }
 
-func (l4hc *l4HealthChecks) deleteHealthCheckFirewall(svc *corev1.Service, hcName, hcFwName string, sharedHC bool, l4Type utils.L4LBType, fwLogger klog.Logger) (string, error) {
-	safeToDelete, err := l4hc.healthCheckFirewallSafeToDelete(hcName, sharedHC, l4Type)
+func (l4hc *l4HealthChecks) deleteHealthCheckFirewall(ctx context.Context, svc *corev1.Service, hcName, hcFwName string, sharedHC bool, l4Type utils.L4LBType, fwLogger klog.Logger) (string, error) {
+	safeToDelete, err := l4hc.healthCheckFirewallSafeToDelete(ctx, hcName, sharedHC, l4Type)
 	if err != nil {
 		fwLogger.Error(err, "Failed to delete healthcheck firewall rule for service")
 		return annotations.HealthcheckResource, err
@@ -409,7 +411,7 @@ func (l4hc *l4HealthChecks) deleteHealthCheckFirewall(svc *corev1.Service, hcNam
 	return "", nil
 }
 
-func (l4hc *l4HealthChecks) healthCheckFirewallSafeToDelete(hcName string, sharedHC bool, l4Type utils.L4LBType) (bool, error) {
+func (l4hc *l4HealthChecks) healthCheckFirewallSafeToDelete(ctx context.Context, hcName string, sharedHC bool, l4Type utils.L4LBType) (bool, error) {
 	if !sharedHC {
 		return true, nil
 	}
@@ -419,7 +421,7 @@ func (l4hc *l4HealthChecks) healthCheckFirewallSafeToDelete(hcName string, share
 		scopeToCheck = meta.Global
 	}
 
-	hc, err := l4hc.hcProvider.Get(hcName, scopeToCheck)
+	hc, err := l4hc.hcProvider.Get(ctx, hcName, scopeToCheck)
 	if err != nil {
 		return false, fmt.Errorf("l4hc.hcProvider.Get(%s, %s) returned error %w, want nil", hcName, scopeToCheck, err)
 	}
@@ -433,7 +435,7 @@ func (l4hc *l4HealthChecks) deleteFirewall(name string, svc *corev1.Service, fwL
 	}
 	// Suppress Firewall XPN error, as this is no retryable and requires action by security admin
 	if fwErr, ok := err.(*firewalls.FirewallXPNError); ok {
-		l4hc.recorder.Event(svc, corev1.EventTypeNormal, "XPN", fwErr.Message)
+		l4hc.recorder.Event(svc, corev1.EventTypeNormal, events.ReasonXPN, fwErr.Message)
 		return nil
 	}
 	return err
@@ -511,20 +511,20 @@ func TestEnsureHealthCheck(t *testing.T) {
 			hcs := NewL4HealthChecks(fakeGCE, &record.FakeRecorder{}, klog.TODO(), true)
 
 			if tc.existingHC != nil {
-				err := hcs.hcProvider.Create(tc.existingHC)
+				err := hcs.hcProvider.Create(context.Background(), tc.existingHC)
 				if err != nil {
 					t.Errorf("hcProvider.Create() err=%v", err)
 				}
 			}
 
-			_, updated, err := hcs.ensureHealthCheck(hcName, namespacedName, tc.shared, hcDefaultPath, tc.port, tc.scope, tc.l4Type, klog.TODO())
+			_, updated, err := hcs.ensureHealthCheck(context.Background(), hcName, namespacedName, tc.shared, hcDefaultPath, tc.port, tc.scope, tc.l4Type, klog.TODO())
 			if err != nil {
 				t.Errorf("ensureHealthCheck() err=%v", err)
 			}
 			if updated != tc.wantUpdate {
 				t.Errorf("ensureHealthCheck() unexpected 'updated' value, want=%v, got=%v", tc.wantUpdate, updated)
 			}
-			resultHC, err := hcs.hcProvider.Get(hcName, tc.scope)
+			resultHC, err := hcs.hcProvider.Get(context.Background(), hcName, tc.scope)
 			if err != nil {
 				t.Errorf("hcProvider.Get() err=-%v", err)
 			}
@@ -728,7 +728,7 @@ func TestEnsureHealthCheckWithDualStackFirewalls(t *testing.T) {
 			}
 			hcs := NewL4HealthChecks(fakeGCE, &record.FakeRecorder{}, klog.TODO(), true)
 			if tc.existingHC != nil {
-				err := hcs.hcProvider.Create(tc.existingHC)
+				err := hcs.hcProvider.Create(context.Background(), tc.existingHC)
 				if err != nil {
 					t.Errorf("hcProvider.Create() err=%v", err)
 				}
@@ -740,7 +740,7 @@ func TestEnsureHealthCheckWithDualStackFirewalls(t *testing.T) {
 			mockGCE.MockFirewalls.PatchHook = mock.UpdateFirewallHook
 
 			sharedHC := !helpers.RequestsOnlyLocalTraffic(tc.svc)
-			result := hcs.EnsureHealthCheckWithDualStackFirewalls(svc, l4Namer, sharedHC, meta.Global, utils.XLB, nodeNames, true, tc.needIPv6, *svcNetwork, klog.TODO())
+			result := hcs.EnsureHealthCheckWithDualStackFirewalls(context.Background(), svc, l4Namer, sharedHC, meta.Global, utils.XLB, nodeNames, true, tc.needIPv6, *svcNetwork, klog.TODO())
 			if result.Err != nil {
 				t.Errorf("hcs.EnsureHealthCheckWithDualStackFirewalls() err=%v", result.Err)
 			}
@@ -750,7 +750,7 @@ func TestEnsureHealthCheckWithDualStackFirewalls(t *testing.T) {
 			if result.WasFirewallUpdated != tc.wantUpdateFw {
 				t.Errorf("result.WasFirewallUpdated want=%v, got=%v", tc.wantUpdateFw, result.WasFirewallUpdated)
 			}
-			resultHC, err := hcs.hcProvider.Get(result.HCName, meta.Global)
+			resultHC, err := hcs.hcProvider.Get(context.Background(), result.HCName, meta.Global)
 			if err != nil {
 				t.Errorf("hcProvider.Get() err=-%v", err)
 			}
@@ -893,7 +893,7 @@ func TestEnsureHealthCheckWithIPv6OnlyFirewalls(t *testing.T) {
 
 			hcs := NewL4HealthChecks(fakeGCE, &record.FakeRecorder{}, klog.TODO(), true)
 			if tc.existingHC != nil {
-				err := hcs.hcProvider.Create(tc.existingHC)
+				err := hcs.hcProvider.Create(context.Background(), tc.existingHC)
 				if err != nil {
 					t.Fatalf("hcProvider.Create() err=%v", err)
 				}
@@ -916,7 +916,7 @@ func TestEnsureHealthCheckWithIPv6OnlyFirewalls(t *testing.T) {
 
 			needsIPv4 := false
 			needsIPv6 := true
-			result := hcs.EnsureHealthCheckWithDualStackFirewalls(tc.svc, l4Namer, sharedHC, meta.Global, l4Type, nodeNames, needsIPv4, needsIPv6, *svcNetwork, klog.TODO())
+			result := hcs.EnsureHealthCheckWithDualStackFirewalls(context.Background(), tc.svc, l4Namer, sharedHC, meta.Global, l4Type, nodeNames, needsIPv4, needsIPv6, *svcNetwork, klog.TODO())
 			if result.Err != nil {
 				t.Fatalf("EnsureHealthCheckWithDualStackFirewalls() err=%v", result.Err)
 			}
@@ -927,7 +927,7 @@ func TestEnsureHealthCheckWithIPv6OnlyFirewalls(t *testing.T) {
 				t.Errorf("result.WasFirewallUpdated want=%v, got=%v", tc.wantUpdateFw, result.WasFirewallUpdated)
 			}
 
-			resultHC, err := hcs.hcProvider.Get(result.HCName, meta.Global)
+			resultHC, err := hcs.hcProvider.Get(context.Background(), result.HCName, meta.Global)
 			if err != nil {
 				t.Fatalf("hcProvider.Get() err=%v", err)
 			}
@@ -1,6 +1,7 @@
 package healthchecks
 
 import (
+	"context"
 	"fmt"
 
 	cloudprovider "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -26,12 +27,12 @@ func NewHealthChecks(cloud *gce.Cloud, version meta.Version, logger klog.Logger)
 	}
 }
 
-func (hc *HealthChecks) Get(name string, scope meta.KeyType) (*composite.HealthCheck, error) {
+func (hc *HealthChecks) Get(ctx context.Context, name string, scope meta.KeyType) (*composite.HealthCheck, error) {
 	key, err := hc.createKey(name, scope)
 	if err != nil {
 		return nil, fmt.Errorf("hc.createKey(%s, %s) returned error %w, want nil", name, scope, err)
 	}
-	healthCheck, err := composite.GetHealthCheck(hc.cloud, key, hc.version, hc.logger)
+	healthCheck, err := composite.GetHealthCheck(ctx, hc.cloud, key, hc.version, hc.logger)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return nil, nil
@@ -41,39 +42,39 @@ func (hc *HealthChecks) Get(name string, scope meta.KeyType) (*composite.HealthC
 	return healthCheck, nil
 }
 
-func (hc *HealthChecks) Create(healthCheck *composite.HealthCheck) error {
+func (hc *HealthChecks) Create(ctx context.Context, healthCheck *composite.HealthCheck) error {
 	key, err := hc.createKey(healthCheck.Name, healthCheck.Scope)
 	if err != nil {
 		return fmt.Errorf("hc.createKey(%s, %s) returned error: %w, want nil", healthCheck.Name, healthCheck.Scope, err)
 	}
 
-	err = composite.CreateHealthCheck(hc.cloud, key, healthCheck, hc.logger)
+	err = composite.CreateHealthCheck(ctx, hc.cloud, key, healthCheck, hc.logger)
 	if err != nil {
 		return fmt.Errorf("composite.CreateHealthCheck(_, %s, %v) returned error %w, want nil", key, healthCheck, err)
 	}
 	return nil
 }
 
-func (hc *HealthChecks) Update(name string, scope meta.KeyType, updatedHealthCheck *composite.HealthCheck) error {
+func (hc *HealthChecks) Update(ctx context.Context, name string, scope meta.KeyType, updatedHealthCheck *composite.HealthCheck) error {
 	key, err := hc.createKey(name, scope)
 	if err != nil {
 		return fmt.Errorf("hc.createKey(%s, %s) returned error: %w, want nil", name, scope, err)
 	}
 
-	err = composite.UpdateHealthCheck(hc.cloud, key, updatedHealthCheck, hc.logger)
+	err = composite.UpdateHealthCheck(ctx, hc.cloud, key, updatedHealthCheck, hc.logger)
 	if err != nil {
 		return fmt.Errorf("composite.UpdateHealthCheck(_, %s, %v) returned error %w, want nil", key, updatedHealthCheck, err)
 	}
 	return nil
 }
 
-func (hc *HealthChecks) Delete(name string, scope meta.KeyType) error {
+func (hc *HealthChecks) Delete(ctx context.Context, name string, scope meta.KeyType) error {
 	key, err := hc.createKey(name, scope)
 	if err != nil {
 		return fmt.Errorf("hc.createKey(%s, %s) returned error %w, want nil", name, scope, err)
 	}
 
-	return utils.IgnoreHTTPNotFound(composite.DeleteHealthCheck(hc.cloud, key, hc.version, hc.logger))
+	return utils.IgnoreHTTPNotFound(composite.DeleteHealthCheck(ctx, hc.cloud, key, hc.version, hc.logger))
 }
 
 func (hc *HealthChecks) SelfLink(name string, scope meta.KeyType) (string, error) {
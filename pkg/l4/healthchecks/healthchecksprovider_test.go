@@ -1,6 +1,7 @@
 package healthchecks
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -39,7 +40,7 @@ func TestCreateHealthCheck(t *testing.T) {
 			fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
 			hc := NewHealthChecks(fakeGCE, meta.VersionGA, klog.TODO())
 
-			err := hc.Create(tc.healthCheck)
+			err := hc.Create(context.Background(), tc.healthCheck)
 			if err != nil {
 				t.Fatalf("hc.Create(%v), returned error %v, want nil", tc.healthCheck, err)
 			}
@@ -121,7 +122,7 @@ func TestGetHealthCheck(t *testing.T) {
 			mustCreateHealthChecks(t, fakeGCE, tc.existingHealthChecks)
 			hcp := NewHealthChecks(fakeGCE, meta.VersionGA, klog.TODO())
 
-			hc, err := hcp.Get(tc.getHCName, tc.getHCScope)
+			hc, err := hcp.Get(context.Background(), tc.getHCName, tc.getHCScope)
 			if err != nil {
 				t.Fatalf("hcp.Get(%v), returned error %v, want nil", tc.getHCName, err)
 			}
@@ -198,7 +199,7 @@ func TestDeleteHealthCheck(t *testing.T) {
 			mustCreateHealthChecks(t, fakeGCE, tc.existingHealthChecks)
 			hc := NewHealthChecks(fakeGCE, meta.VersionGA, klog.TODO())
 
-			err := hc.Delete(tc.deleteHCName, tc.deleteHCScope)
+			err := hc.Delete(context.Background(), tc.deleteHCName, tc.deleteHCScope)
 			if err != nil {
 				t.Fatalf("hc.Delete(%v), returned error %v, want nil", tc.deleteHCName, err)
 			}
@@ -230,7 +231,7 @@ func verifyHealthCheckShouldExist(cloud *gce.Cloud, name string, scope meta.KeyT
 	if err != nil {
 		return fmt.Errorf("hailed to create key for fetching health check %s, err: %w", name, err)
 	}
-	_, err = composite.GetHealthCheck(cloud, key, meta.VersionGA, klog.TODO())
+	_, err = composite.GetHealthCheck(context.Background(), cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			if shouldExist {
@@ -261,7 +262,7 @@ func mustCreateHealthCheck(t *testing.T, cloud *gce.Cloud, hc *composite.HealthC
 	if err != nil {
 		t.Fatalf("composite.CreateKey(_, %s, %s) returned error %v, want nil", hc.Name, hc.Scope, err)
 	}
-	err = composite.CreateHealthCheck(cloud, key, hc, klog.TODO())
+	err = composite.CreateHealthCheck(context.Background(), cloud, key, hc, klog.TODO())
 	if err != nil {
 		t.Fatalf("composite.CreateHealthCheck(_, %s, %v) returned error %v, want nil", key, hc, err)
 	}
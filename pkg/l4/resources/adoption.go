@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/l4/annotations"
+	"k8s.io/ingress-gce/pkg/l4/backends"
+	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
+	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+// adoptedResources returns names of the user-created resources that the Service wants to adopt.
+func adoptedResources(svc *corev1.Service) annotations.AdoptedResources {
+	return annotations.FromService(svc).AdoptedResources()
+}
+
+// checkAdoptedForwardingRule verifies that the adopted forwarding rule can be
+// brought to the wanted state with a patch. Adopted forwarding rules are never recreated,
+// since that would cause an outage and could release the user's IP.
+func checkAdoptedForwardingRule(existing, wanted *composite.ForwardingRule) error {
+	if existing == nil {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, wanted.Name, "forwarding rule does not exist")
+	}
+	if existing.LoadBalancingScheme != wanted.LoadBalancingScheme {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, existing.Name,
+			fmt.Sprintf("load balancing scheme is %q, Service requires %q", existing.LoadBalancingScheme, wanted.LoadBalancingScheme))
+	}
+	if !strings.EqualFold(existing.IPProtocol, wanted.IPProtocol) {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, existing.Name,
+			fmt.Sprintf("protocol is %q, Service requires %q", existing.IPProtocol, wanted.IPProtocol))
+	}
+	if wanted.IPAddress != "" && existing.IPAddress != wanted.IPAddress {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, existing.Name,
+			fmt.Sprintf("IP address is %q, Service requires %q", existing.IPAddress, wanted.IPAddress))
+	}
+	if existing.BackendService != "" && !utils.EqualResourceIDs(existing.BackendService, wanted.BackendService) {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, existing.Name,
+			fmt.Sprintf("forwarding rule targets backend service %q, adopt it with %s annotation", existing.BackendService, annotations.AdoptBackendServiceKey))
+	}
+	equal, err := forwardingrules.EqualIPv4(existing, wanted)
+	if err != nil {
+		return err
+	}
+	if equal {
+		return nil
+	}
+	if patchable, _ := forwardingrules.PatchableIPv4(existing, wanted); patchable {
+		return nil
+	}
+	return l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, existing.Name,
+		"ports, network or subnetwork differ from the Service and can't be changed without recreating the forwarding rule")
+}
+
+// checkAdoptedBackendService verifies that the adopted backend service
+// can be updated in place to match the wanted parameters.
+func checkAdoptedBackendService(ctx context.Context, pool *backends.Pool, params backends.L4BackendServiceParams, logger klog.Logger) error {
+	existing, err := pool.Get(ctx, params.Name, meta.VersionGA, meta.Regional, logger)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return l4utils.NewIncompatibleAdoptedResourceError(annotations.BackendServiceResource, params.Name, "regional backend service does not exist")
+		}
+		return err
+	}
+	if existing.LoadBalancingScheme != params.Scheme {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.BackendServiceResource, params.Name,
+			fmt.Sprintf("load balancing scheme is %q, Service requires %q", existing.LoadBalancingScheme, params.Scheme))
+	}
+	if existing.Protocol != params.Protocol {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.BackendServiceResource, params.Name,
+			fmt.Sprintf("protocol is %q, Service requires %q", existing.Protocol, params.Protocol))
+	}
+	if params.NetworkInfo != nil && !params.NetworkInfo.IsDefault && !utils.EqualResourceIDs(existing.Network, params.NetworkInfo.NetworkURL) {
+		return l4utils.NewIncompatibleAdoptedResourceError(annotations.BackendServiceResource, params.Name,
+			fmt.Sprintf("network is %q, Service requires %q", existing.Network, params.NetworkInfo.NetworkURL))
+	}
+	return nil
+}
+
+// releaseAdoptedBackendService detaches cluster-owned NEGs and instance groups
+// (matched by groupNames) from the adopted backend service, so they can be garbage collected.
+// The backend service itself is left in place.
+func releaseAdoptedBackendService(ctx context.Context, pool *backends.Pool, bsName string, groupNames []string, logger klog.Logger) error {
+	bs, err := pool.Get(ctx, bsName, meta.VersionGA, meta.Regional, logger)
+	if err != nil {
+		return utils.IgnoreHTTPNotFound(err)
+	}
+
+	var remaining []*composite.Backend
+	for _, be := range bs.Backends {
+		id, err := cloud.ParseResourceURL(be.Group)
+		if err == nil && slices.Contains(groupNames, id.Key.Name) {
+			continue
+		}
+		remaining = append(remaining, be)
+	}
+	if len(remaining) == len(bs.Backends) {
+		return nil
+	}
+
+	logger.V(2).Info("Detaching cluster backends from adopted backend service", "backendServiceName", bsName, "detached", len(bs.Backends)-len(remaining))
+	bs.Backends = remaining
+	return pool.Update(ctx, bs, logger)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/l4/annotations"
+	"k8s.io/ingress-gce/pkg/l4/healthchecks"
+	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/network"
+	"k8s.io/ingress-gce/pkg/test"
+	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+const (
+	adoptedFRName = "user-created-fr"
+	adoptedBSName = "user-created-bs"
+	adoptedIP     = "10.0.0.42"
+)
+
+func createUserILBResources(t *testing.T, fakeGCE *gce.Cloud, frProtocol string) {
+	t.Helper()
+	bs := &compute.BackendService{
+		Name:                adoptedBSName,
+		Protocol:            "TCP",
+		LoadBalancingScheme: string(cloud.SchemeInternal),
+	}
+	if err := fakeGCE.CreateRegionBackendService(bs, fakeGCE.Region()); err != nil {
+		t.Fatalf("CreateRegionBackendService() returned error %v", err)
+	}
+	bs, err := fakeGCE.GetRegionBackendService(adoptedBSName, fakeGCE.Region())
+	if err != nil {
+		t.Fatalf("GetRegionBackendService() returned error %v", err)
+	}
+	fr := &compute.ForwardingRule{
+		Name:                adoptedFRName,
+		IPAddress:           adoptedIP,
+		IPProtocol:          frProtocol,
+		Ports:               []string{"8080"},
+		LoadBalancingScheme: string(cloud.SchemeInternal),
+		BackendService:      bs.SelfLink,
+		Network:             fakeGCE.NetworkURL(),
+		Subnetwork:          fakeGCE.SubnetworkURL(),
+		NetworkTier:         cloud.NetworkTierDefault.ToGCEValue(),
+		Description:         "created by hand",
+	}
+	if err := fakeGCE.CreateRegionForwardingRule(fr, fakeGCE.Region()); err != nil {
+		t.Fatalf("CreateRegionForwardingRule() returned error %v", err)
+	}
+}
+
+func newAdoptingL4Handler(fakeGCE *gce.Cloud) *L4 {
+	svc := test.NewL4ILBService(false, 8080)
+	svc.Annotations[annotations.AdoptForwardingRuleKey] = adoptedFRName
+	svc.Annotations[annotations.AdoptBackendServiceKey] = adoptedBSName
+	l4ilbParams := &L4ILBParams{
+		Service:         svc,
+		Cloud:           fakeGCE,
+		Namer:           namer_util.NewL4Namer(kubeSystemUID, nil),
+		Recorder:        record.NewFakeRecorder(100),
+		NetworkResolver: network.NewFakeResolver(network.DefaultNetwork(fakeGCE)),
+	}
+	l4 := NewL4Handler(l4ilbParams, klog.TODO())
+	l4.healthChecks = healthchecks.Fake(fakeGCE, l4ilbParams.Recorder)
+	return l4
+}
+
+func TestEnsureInternalLoadBalancerAdoptsResources(t *testing.T) {
+	t.Parallel()
+	nodeNames := []string{"test-node-1"}
+	vals := gce.DefaultTestClusterValues()
+	fakeGCE := getFakeGCECloud(vals)
+	createUserILBResources(t, fakeGCE, "TCP")
+	if _, err := test.CreateAndInsertNodes(fakeGCE, nodeNames, vals.ZoneName); err != nil {
+		t.Fatalf("Unexpected error when adding nodes %v", err)
+	}
+
+	l4 := newAdoptingL4Handler(fakeGCE)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, l4.Service)
+	if result.Error != nil {
+		t.Fatalf("EnsureInternalLoadBalancer() returned error %v", result.Error)
+	}
+	if got := result.Annotations[annotations.TCPForwardingRuleKey]; got != adoptedFRName {
+		t.Errorf("Forwarding rule annotation = %q, want %q", got, adoptedFRName)
+	}
+	if got := result.Annotations[annotations.BackendServiceKey]; got != adoptedBSName {
+		t.Errorf("Backend service annotation = %q, want %q", got, adoptedBSName)
+	}
+	if len(result.Status.Ingress) != 1 || result.Status.Ingress[0].IP != adoptedIP {
+		t.Errorf("Load balancer status = %+v, want IP %s", result.Status, adoptedIP)
+	}
+	if l4.ServicePort.BackendName() != adoptedBSName {
+		t.Errorf("ServicePort.BackendName() = %q, want %q", l4.ServicePort.BackendName(), adoptedBSName)
+	}
+	if err := verifyForwardingRuleNotExists(fakeGCE, l4.managedFRName()); err != nil {
+		t.Errorf("Controller-named forwarding rule was created: %v", err)
+	}
+	if err := verifyBackendServiceNotExists(fakeGCE, l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)); err != nil {
+		t.Errorf("Controller-named backend service was created: %v", err)
+	}
+	bs, err := fakeGCE.GetRegionBackendService(adoptedBSName, fakeGCE.Region())
+	if err != nil {
+		t.Fatalf("GetRegionBackendService() returned error %v", err)
+	}
+	if len(bs.HealthChecks) != 1 {
+		t.Errorf("Adopted backend service health checks = %v, want the controller health check", bs.HealthChecks)
+	}
+
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
+	if result.Error != nil {
+		t.Fatalf("EnsureInternalLoadBalancerDeleted() returned error %v", result.Error)
+	}
+	if _, err := fakeGCE.GetRegionForwardingRule(adoptedFRName, fakeGCE.Region()); err != nil {
+		t.Errorf("Adopted forwarding rule should be left in place, got error %v", err)
+	}
+	if _, err := fakeGCE.GetRegionBackendService(adoptedBSName, fakeGCE.Region()); err != nil {
+		t.Errorf("Adopted backend service should be left in place, got error %v", err)
+	}
+}
+
+func TestEnsureInternalLoadBalancerRejectsIncompatibleAdoption(t *testing.T) {
+	t.Parallel()
+	nodeNames := []string{"test-node-1"}
+	vals := gce.DefaultTestClusterValues()
+	fakeGCE := getFakeGCECloud(vals)
+	createUserILBResources(t, fakeGCE, "UDP")
+	if _, err := test.CreateAndInsertNodes(fakeGCE, nodeNames, vals.ZoneName); err != nil {
+		t.Fatalf("Unexpected error when adding nodes %v", err)
+	}
+
+	l4 := newAdoptingL4Handler(fakeGCE)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, l4.Service)
+	if !l4utils.IsIncompatibleAdoptedResourceError(result.Error) {
+		t.Fatalf("EnsureInternalLoadBalancer() returned error %v, want IncompatibleAdoptedResourceError", result.Error)
+	}
+	if !IsUserError(result.Error) {
+		t.Errorf("IsUserError(%v) = false, want true", result.Error)
+	}
+	fr, err := fakeGCE.GetRegionForwardingRule(adoptedFRName, fakeGCE.Region())
+	if err != nil {
+		t.Fatalf("Adopted forwarding rule should not be deleted, got error %v", err)
+	}
+	if fr.IPProtocol != "UDP" || fr.IPAddress != adoptedIP {
+		t.Errorf("Adopted forwarding rule was modified: %+v", fr)
+	}
+}
+
+func TestCheckAdoptedForwardingRule(t *testing.T) {
+	bsLink := "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/backendServices/bs"
+	wanted := &composite.ForwardingRule{
+		Name:                adoptedFRName,
+		IPAddress:           adoptedIP,
+		IPProtocol:          "TCP",
+		Ports:               []string{"80"},
+		LoadBalancingScheme: string(cloud.SchemeInternal),
+		BackendService:      bsLink,
+		NetworkTier:         cloud.NetworkTierPremium.ToGCEValue(),
+	}
+	for _, tc := range []struct {
+		desc     string
+		mutate   func(fr *composite.ForwardingRule)
+		existing bool
+		wantErr  bool
+	}{
+		{desc: "equal", existing: true},
+		{desc: "patchable global access", existing: true, mutate: func(fr *composite.ForwardingRule) { fr.AllowGlobalAccess = true }},
+		{desc: "missing", existing: false, wantErr: true},
+		{desc: "different scheme", existing: true, wantErr: true, mutate: func(fr *composite.ForwardingRule) { fr.LoadBalancingScheme = string(cloud.SchemeExternal) }},
+		{desc: "different IP", existing: true, wantErr: true, mutate: func(fr *composite.ForwardingRule) { fr.IPAddress = "10.0.0.1" }},
+		{desc: "different ports", existing: true, wantErr: true, mutate: func(fr *composite.ForwardingRule) { fr.Ports = []string{"443"} }},
+		{desc: "different backend service", existing: true, wantErr: true, mutate: func(fr *composite.ForwardingRule) {
+			fr.BackendService = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/backendServices/other"
+		}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var existing *composite.ForwardingRule
+			if tc.existing {
+				fr := *wanted
+				existing = &fr
+				if tc.mutate != nil {
+					tc.mutate(existing)
+				}
+			}
+			err := checkAdoptedForwardingRule(existing, wanted)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("checkAdoptedForwardingRule() = %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -174,7 +174,7 @@ func TestDenyFirewall(t *testing.T) {
 				log := klog.TODO()
 				if start.svc != nil {
 					l4netlb := helperL4NetLB(cloud, log, start.svc, denyFirewallEnabled)
-					res := l4netlb.EnsureFrontend([]string{nodeName}, start.svc, time.Now())
+					res := l4netlb.EnsureFrontend(context.Background(), []string{nodeName}, start.svc, time.Now())
 					if res.Error != nil {
 						t.Fatal(res.Error)
 					}
@@ -182,7 +182,7 @@ func TestDenyFirewall(t *testing.T) {
 
 				// Act
 				l4netlb := helperL4NetLB(cloud, log, end.svc, denyFirewallEnabled)
-				res := l4netlb.EnsureFrontend([]string{nodeName}, end.svc, time.Now())
+				res := l4netlb.EnsureFrontend(context.Background(), []string{nodeName}, end.svc, time.Now())
 
 				// Assert
 				if res.Error != nil {
@@ -206,7 +206,7 @@ func TestDenyFirewall(t *testing.T) {
 				}
 
 				// Act
-				res = l4netlb.EnsureLoadBalancerDeleted(end.svc)
+				res = l4netlb.EnsureLoadBalancerDeleted(context.Background(), end.svc)
 
 				// Assert
 				if res.Error != nil {
@@ -254,7 +254,7 @@ func TestDenyIsNotCreatedWhenAllowPriorityUpdateFails(t *testing.T) {
 			log := klog.TODO()
 
 			l4netlb := helperL4NetLB(gce, log, svc, denyFirewallDisabled)
-			res := l4netlb.EnsureFrontend([]string{nodeName}, svc, time.Now())
+			res := l4netlb.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 			if res.Error != nil {
 				t.Fatal(res.Error)
 			}
@@ -274,7 +274,7 @@ func TestDenyIsNotCreatedWhenAllowPriorityUpdateFails(t *testing.T) {
 
 			// Act
 			l4netlb = helperL4NetLB(gce, log, svc, denyFirewallEnabled)
-			res = l4netlb.EnsureFrontend([]string{nodeName}, svc, time.Now())
+			res = l4netlb.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 
 			// Assert
 			if wasGCECalled == false {
@@ -319,7 +319,7 @@ func TestDenyRespectsDisableNodeFirewallProvisioning(t *testing.T) {
 	}, log)
 
 	// Act
-	res := l4netlb.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res := l4netlb.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 
 	// Assert
 	if res.Error != nil {
@@ -364,7 +364,7 @@ func TestDenyRollforwardDoesNotBlockTraffic(t *testing.T) {
 	defer func() { flags.F.EnablePinhole = oldFlag }()
 
 	// Act
-	res := l4netlbDenyDisabled.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res := l4netlbDenyDisabled.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 	if res.Error != nil {
 		t.Fatal(res.Error)
 	}
@@ -401,7 +401,7 @@ func TestDenyRollforwardDoesNotBlockTraffic(t *testing.T) {
 
 	// Act
 	l4netlbDenyEnabled := helperL4NetLB(cloud, log, svc, denyFirewallEnabled)
-	res = l4netlbDenyEnabled.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res = l4netlbDenyEnabled.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 	if res.Error != nil {
 		t.Fatal(res.Error)
 	}
@@ -457,7 +457,7 @@ func TestDenyRollback(t *testing.T) {
 	defer func() { flags.F.EnablePinhole = oldFlag }()
 
 	// Act
-	res := l4netlbDenyEnabled.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res := l4netlbDenyEnabled.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 
 	// Assert
 	// No errors, including deny firewall blocking whole allow
@@ -497,7 +497,7 @@ func TestDenyRollback(t *testing.T) {
 
 	// Act
 	l4netlbDenyDisabled := helperL4NetLB(cloud, log, svc, denyFirewallDisabled)
-	res = l4netlbDenyDisabled.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res = l4netlbDenyDisabled.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 
 	// Assert
 	// No errors, including deny firewall blocking whole allow
@@ -608,7 +608,7 @@ func TestExportsCorrectDenyMetrics(t *testing.T) {
 			ensurer := helperL4NetLB(gce, klog.TODO(), svc, tc.denyEnabled)
 
 			// Act
-			res := ensurer.EnsureFrontend([]string{nodeName}, svc, time.Now())
+			res := ensurer.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 			// We expicitly don't care for errors - only for values stored in metrics
 			got := res.MetricsState.DenyFirewallStatus
 
@@ -641,7 +641,7 @@ func TestSkipRollbackCleanupIfDisabled(t *testing.T) {
 
 	log := klog.TODO()
 	ensurer := helperL4NetLBWithoutCleanup(gce, log, svc)
-	res := ensurer.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res := ensurer.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 	if res.Error != nil {
 		t.Fatal(res.Error)
 	}
@@ -658,7 +658,7 @@ func TestSkipRollbackCleanupIfDisabled(t *testing.T) {
 
 	// Verify that the cleanup logic is actually performed when it needs to be
 	ensurer = helperL4NetLB(gce, log, svc, denyFirewallDisabled) // cleanup is armed
-	res = ensurer.EnsureFrontend([]string{nodeName}, svc, time.Now())
+	res = ensurer.EnsureFrontend(context.Background(), []string{nodeName}, svc, time.Now())
 	if res.Error != nil {
 		t.Fatal(res.Error)
 	}
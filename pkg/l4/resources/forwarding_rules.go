@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/address"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/klog/v2"
 
@@ -54,7 +56,8 @@ const (
 
 // ensureIPv4ForwardingRule creates a forwarding rule with the given name, if it does not exist. It updates the existing
 // forwarding rule if needed.
-func (l4 *L4) ensureIPv4ForwardingRule(bsLink string, options gce.ILBOptions, existingFwdRule *composite.ForwardingRule, subnetworkURL, ipToUse string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+func (l4 *L4) ensureIPv4ForwardingRule(ctx context.Context, bsLink string, options gce.ILBOptions, existingFwdRule *composite.ForwardingRule, subnetworkURL, ipToUse string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+	defer l4.syncTrace.Track(synctimeline.PhaseForwardingRules)()
 	start := time.Now()
 
 	// version used for creating the existing forwarding rule.
@@ -107,6 +110,12 @@ func (l4 *L4) ensureIPv4ForwardingRule(bsLink string, options gce.ILBOptions, ex
 		Description:         frDesc,
 	}
 
+	if adoptedResources(l4.Service).ForwardingRule != "" {
+		if err := checkAdoptedForwardingRule(existingFwdRule, newFwdRule); err != nil {
+			return nil, l4utils.ResourceResync, err
+		}
+	}
+
 	if existingFwdRule != nil {
 		equal, err := forwardingrules.EqualIPv4(existingFwdRule, newFwdRule)
 		if err != nil {
@@ -123,23 +132,23 @@ func (l4 *L4) ensureIPv4ForwardingRule(bsLink string, options gce.ILBOptions, ex
 
 		patchable, filtered := forwardingrules.PatchableIPv4(existingFwdRule, newFwdRule)
 		if patchable {
-			if err = l4.forwardingRules.Patch(filtered); err != nil {
+			if err = l4.forwardingRules.Patch(ctx, filtered); err != nil {
 				return nil, l4utils.ResourceUpdate, err
 			}
 			l4.recorder.Eventf(l4.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s patched", existingFwdRule.Name)
 		} else {
-			if err := l4.updateForwardingRule(existingFwdRule, newFwdRule, frLogger); err != nil {
+			if err := l4.updateForwardingRule(ctx, existingFwdRule, newFwdRule, frLogger); err != nil {
 				return nil, l4utils.ResourceUpdate, err
 			}
 		}
 	} else {
-		if err = l4.createFwdRule(newFwdRule, frLogger); err != nil {
+		if err = l4.createFwdRule(ctx, newFwdRule, frLogger); err != nil {
 			return nil, l4utils.ResourceUpdate, err
 		}
 		l4.recorder.Eventf(l4.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s created", newFwdRule.Name)
 	}
 
-	readFwdRule, err := l4.forwardingRules.Get(newFwdRule.Name)
+	readFwdRule, err := l4.forwardingRules.Get(ctx, newFwdRule.Name)
 	if err != nil {
 		return nil, l4utils.ResourceUpdate, err
 	}
@@ -149,22 +158,22 @@ func (l4 *L4) ensureIPv4ForwardingRule(bsLink string, options gce.ILBOptions, ex
 	return readFwdRule, l4utils.ResourceUpdate, nil
 }
 
-func (l4 *L4) updateForwardingRule(existingFwdRule, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
-	if err := l4.forwardingRules.Delete(existingFwdRule.Name); err != nil {
+func (l4 *L4) updateForwardingRule(ctx context.Context, existingFwdRule, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
+	if err := l4.forwardingRules.Delete(ctx, existingFwdRule.Name); err != nil {
 		return err
 	}
 	l4.recorder.Eventf(l4.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s deleted", existingFwdRule.Name)
 
-	if err := l4.createFwdRule(newFr, frLogger); err != nil {
+	if err := l4.createFwdRule(ctx, newFr, frLogger); err != nil {
 		return err
 	}
 	l4.recorder.Eventf(l4.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s re-created", newFr.Name)
 	return nil
 }
 
-func (l4 *L4) createFwdRule(newFr *composite.ForwardingRule, frLogger klog.Logger) error {
+func (l4 *L4) createFwdRule(ctx context.Context, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
 	frLogger.V(2).Info("ensureIPv4ForwardingRule: Creating/Recreating forwarding rule")
-	if err := l4.forwardingRules.Create(newFr); err != nil {
+	if err := l4.forwardingRules.Create(ctx, newFr); err != nil {
 		if isAddressAlreadyInUseError(err) {
 			return l4utils.NewIPConfigurationError(newFr.IPAddress, err.Error())
 		}
@@ -179,8 +188,9 @@ func (l4 *L4) createFwdRule(newFr *composite.ForwardingRule, frLogger klog.Logge
 // ensureIPv4ForwardingRule creates a forwarding rule with the given name for L4NetLB,
 // if it does not exist. It updates the existing forwarding rule if needed.
 // This should only handle single protocol forwarding rules.
-func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.ForwardingRule, address.IPAddressType, l4utils.ResourceSyncStatus, error) {
-	frName := l4netlb.frName()
+func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(ctx context.Context, bsLink string) (*composite.ForwardingRule, address.IPAddressType, l4utils.ResourceSyncStatus, error) {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseForwardingRules)()
+	frName := l4netlb.ipv4FRName()
 
 	start := time.Now()
 	frLogger := l4netlb.svcLogger.WithValues("forwardingRuleName", frName)
@@ -191,18 +201,39 @@ func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.Forw
 
 	// version used for creating the existing forwarding rule.
 	version := meta.VersionGA
-	rules, err := l4netlb.mixedManager.AllRules()
+	rules, err := l4netlb.mixedManager.AllRules(ctx)
 	if err != nil {
 		frLogger.Error(err, "l4netlb.mixedManager.AllRules returned error")
 		return nil, address.IPAddrUndefined, l4utils.ResourceResync, err
 	}
+	netTier, _ := annotations.NetworkTier(l4netlb.Service)
 
-	addrHandle, err := address.HoldExternalIPv4(address.HoldConfig{
+	existingFwdRule := rules.Legacy
+	isAdopted := adoptedResources(l4netlb.Service).ForwardingRule != ""
+	if isAdopted {
+		existingFwdRule, err = l4netlb.forwardingRules.Get(ctx, frName)
+		if err != nil {
+			frLogger.Error(err, "l4netlb.forwardingRules.Get returned error for adopted forwarding rule")
+			return nil, address.IPAddrUndefined, l4utils.ResourceResync, err
+		}
+		// Check Network Tier before holding the address, since mismatched rules would be torn down there.
+		if existingFwdRule != nil && existingFwdRule.NetworkTier != netTier.ToGCEValue() {
+			resource := fmt.Sprintf("Adopted forwarding rule (%v)", frName)
+			return nil, address.IPAddrUndefined, l4utils.ResourceResync, l4utils.NewNetworkTierErr(resource, netTier.ToGCEValue(), existingFwdRule.NetworkTier)
+		}
+	}
+
+	existingRules := []*composite.ForwardingRule{rules.Legacy, rules.TCP, rules.UDP, rules.L3}
+	if isAdopted {
+		// Adopted rule goes first, so its IP is preserved.
+		existingRules = append([]*composite.ForwardingRule{existingFwdRule}, existingRules...)
+	}
+	addrHandle, err := address.HoldExternalIPv4(ctx, address.HoldConfig{
 		Cloud:                 l4netlb.cloud,
 		Recorder:              l4netlb.recorder,
 		Logger:                l4netlb.svcLogger,
 		Service:               l4netlb.Service,
-		ExistingRules:         []*composite.ForwardingRule{rules.Legacy, rules.TCP, rules.UDP, rules.L3},
+		ExistingRules:         existingRules,
 		ForwardingRuleDeleter: l4netlb.forwardingRules,
 	})
 	if err != nil {
@@ -218,15 +249,13 @@ func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.Forw
 
 	// Leaving this without feature flag, so after rollback
 	// forwarding rules will be cleaned up
-	if err := l4netlb.mixedManager.DeleteExistingIPv4(rules); err != nil {
+	if err := l4netlb.mixedManager.DeleteExistingIPv4(ctx, rules); err != nil {
 		frLogger.Error(err, "l4netlb.mixedManager.DeleteExistingIPv4 returned an error")
 		return nil, address.IPAddrUndefined, l4utils.ResourceResync, err
 	}
 
-	existingFwdRule := rules.Legacy
 	ipToUse := addrHandle.IP
 	isIPManaged := addrHandle.Managed
-	netTier, _ := annotations.NetworkTier(l4netlb.Service)
 	svcPorts := l4netlb.Service.Spec.Ports
 	ports := utils.GetPorts(svcPorts)
 	portRange := utils.MinMaxPortRange(svcPorts)
@@ -252,6 +281,12 @@ func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.Forw
 		newFwdRule.PortRange = ""
 	}
 
+	if isAdopted {
+		if err := checkAdoptedForwardingRule(existingFwdRule, newFwdRule); err != nil {
+			return nil, address.IPAddrUndefined, l4utils.ResourceResync, err
+		}
+	}
+
 	if existingFwdRule != nil {
 		if existingFwdRule.NetworkTier != newFwdRule.NetworkTier {
 			resource := fmt.Sprintf("Forwarding rule (%v)", frName)
@@ -273,23 +308,23 @@ func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.Forw
 
 		patchable, filtered := forwardingrules.PatchableIPv4(existingFwdRule, newFwdRule)
 		if patchable {
-			if err = l4netlb.forwardingRules.Patch(filtered); err != nil {
+			if err = l4netlb.forwardingRules.Patch(ctx, filtered); err != nil {
 				return nil, address.IPAddrUndefined, l4utils.ResourceUpdate, err
 			}
 			l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s patched", existingFwdRule.Name)
 		} else {
-			if err := l4netlb.updateForwardingRule(existingFwdRule, newFwdRule, frLogger); err != nil {
+			if err := l4netlb.updateForwardingRule(ctx, existingFwdRule, newFwdRule, frLogger); err != nil {
 				return nil, address.IPAddrUndefined, l4utils.ResourceUpdate, err
 			}
 		}
 
 	} else {
-		if err = l4netlb.createFwdRule(newFwdRule, frLogger); err != nil {
+		if err = l4netlb.createFwdRule(ctx, newFwdRule, frLogger); err != nil {
 			return nil, address.IPAddrUndefined, l4utils.ResourceUpdate, err
 		}
 		l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s created", newFwdRule.Name)
 	}
-	createdFr, err := l4netlb.forwardingRules.Get(newFwdRule.Name)
+	createdFr, err := l4netlb.forwardingRules.Get(ctx, newFwdRule.Name)
 	if err != nil {
 		return nil, address.IPAddrUndefined, l4utils.ResourceUpdate, err
 	}
@@ -299,22 +334,22 @@ func (l4netlb *L4NetLB) ensureIPv4ForwardingRule(bsLink string) (*composite.Forw
 	return createdFr, isIPManaged, l4utils.ResourceUpdate, err
 }
 
-func (l4netlb *L4NetLB) updateForwardingRule(existingFwdRule, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
-	if err := l4netlb.forwardingRules.Delete(existingFwdRule.Name); err != nil {
+func (l4netlb *L4NetLB) updateForwardingRule(ctx context.Context, existingFwdRule, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
+	if err := l4netlb.forwardingRules.Delete(ctx, existingFwdRule.Name); err != nil {
 		return err
 	}
 	l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s deleted", existingFwdRule.Name)
 
-	if err := l4netlb.createFwdRule(newFr, frLogger); err != nil {
+	if err := l4netlb.createFwdRule(ctx, newFr, frLogger); err != nil {
 		return err
 	}
 	l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %s re-created", newFr.Name)
 	return nil
 }
 
-func (l4netlb *L4NetLB) createFwdRule(newFr *composite.ForwardingRule, frLogger klog.Logger) error {
+func (l4netlb *L4NetLB) createFwdRule(ctx context.Context, newFr *composite.ForwardingRule, frLogger klog.Logger) error {
 	frLogger.V(2).Info("ensureIPv4ForwardingRule: Creating/Recreating forwarding rule")
-	if err := l4netlb.forwardingRules.Create(newFr); err != nil {
+	if err := l4netlb.forwardingRules.Create(ctx, newFr); err != nil {
 		if isAddressAlreadyInUseError(err) {
 			return l4utils.NewIPConfigurationError(newFr.IPAddress, addressAlreadyInUseMessageExternal)
 		}
@@ -327,9 +362,9 @@ func (l4netlb *L4NetLB) createFwdRule(newFr *composite.ForwardingRule, frLogger
 }
 
 // tearDownResourcesWithWrongNetworkTier removes forwarding rule or IP address if its Network Tier differs from desired.
-func (l4netlb *L4NetLB) tearDownResourcesWithWrongNetworkTier(existingFwdRule *composite.ForwardingRule, svcNetTier cloud.NetworkTier, am *address.Manager, frLogger klog.Logger) error {
+func (l4netlb *L4NetLB) tearDownResourcesWithWrongNetworkTier(ctx context.Context, existingFwdRule *composite.ForwardingRule, svcNetTier cloud.NetworkTier, am *address.Manager, frLogger klog.Logger) error {
 	if existingFwdRule != nil && existingFwdRule.NetworkTier != svcNetTier.ToGCEValue() {
-		err := l4netlb.forwardingRules.Delete(existingFwdRule.Name)
+		err := l4netlb.forwardingRules.Delete(ctx, existingFwdRule.Name)
 		if err != nil {
 			frLogger.Error(err, "l4netlb.forwardingRules.Delete returned error, want nil")
 		}
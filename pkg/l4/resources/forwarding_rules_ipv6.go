@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/address"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 
 	"k8s.io/cloud-provider-gcp/providers/gce"
@@ -43,7 +45,8 @@ const (
 	prefix96range = "/96"
 )
 
-func (l4 *L4) ensureIPv6ForwardingRule(bsLink string, options gce.ILBOptions, existingIPv6FwdRule *composite.ForwardingRule, ipv6AddressToUse string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+func (l4 *L4) ensureIPv6ForwardingRule(ctx context.Context, bsLink string, options gce.ILBOptions, existingIPv6FwdRule *composite.ForwardingRule, ipv6AddressToUse string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+	defer l4.syncTrace.Track(synctimeline.PhaseForwardingRules)()
 	start := time.Now()
 
 	expectedIPv6FwdRule, err := l4.buildExpectedIPv6ForwardingRule(bsLink, options, ipv6AddressToUse)
@@ -66,19 +69,19 @@ func (l4 *L4) ensureIPv6ForwardingRule(bsLink string, options gce.ILBOptions, ex
 			frLogger.V(2).Info("ensureIPv6ForwardingRule: Skipping update of unchanged ipv6 forwarding rule")
 			return existingIPv6FwdRule, l4utils.ResourceResync, nil
 		}
-		err = l4.deleteChangedIPv6ForwardingRule(existingIPv6FwdRule, expectedIPv6FwdRule)
+		err = l4.deleteChangedIPv6ForwardingRule(ctx, existingIPv6FwdRule, expectedIPv6FwdRule)
 		if err != nil {
 			return nil, l4utils.ResourceUpdate, err
 		}
 	}
 
 	frLogger.V(2).Info("ensureIPv6ForwardingRule: Creating/Recreating forwarding rule")
-	err = l4.forwardingRules.Create(expectedIPv6FwdRule)
+	err = l4.forwardingRules.Create(ctx, expectedIPv6FwdRule)
 	if err != nil {
 		return nil, l4utils.ResourceUpdate, err
 	}
 
-	createdFr, err := l4.forwardingRules.Get(expectedIPv6FwdRule.Name)
+	createdFr, err := l4.forwardingRules.Get(ctx, expectedIPv6FwdRule.Name)
 	return createdFr, l4utils.ResourceUpdate, err
 }
 
@@ -134,12 +137,12 @@ func (l4 *L4) buildExpectedIPv6ForwardingRule(bsLink string, options gce.ILBOpti
 	return fr, nil
 }
 
-func (l4 *L4) deleteChangedIPv6ForwardingRule(existingFwdRule *composite.ForwardingRule, expectedFwdRule *composite.ForwardingRule) error {
+func (l4 *L4) deleteChangedIPv6ForwardingRule(ctx context.Context, existingFwdRule *composite.ForwardingRule, expectedFwdRule *composite.ForwardingRule) error {
 	frDiff := cmp.Diff(existingFwdRule, expectedFwdRule, cmpopts.IgnoreFields(composite.ForwardingRule{}, "IPAddress"))
 	l4.svcLogger.V(2).Info("IPv6 forwarding rule changed. Deleting existing ipv6 forwarding rule.",
 		"existingForwardingRule", fmt.Sprintf("%+v", existingFwdRule), "newForwardingRule", fmt.Sprintf("%+v", expectedFwdRule), "diff", frDiff)
 
-	err := l4.forwardingRules.Delete(existingFwdRule.Name)
+	err := l4.forwardingRules.Delete(ctx, existingFwdRule.Name)
 	if err != nil {
 		return err
 	}
@@ -147,7 +150,8 @@ func (l4 *L4) deleteChangedIPv6ForwardingRule(existingFwdRule *composite.Forward
 	return nil
 }
 
-func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(bsLink string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(ctx context.Context, bsLink string) (*composite.ForwardingRule, l4utils.ResourceSyncStatus, error) {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseForwardingRules)()
 	start := time.Now()
 
 	// Single and mixed protocol use different names for ipv6 forwarding rules. We need to handle the transition between the two.
@@ -169,13 +173,13 @@ func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(bsLink string) (*composite.Forw
 		frLogger.V(2).Info("Finished ensuring external ipv6 forwarding rule for L4 NetLB Service", "timeTaken", time.Since(start))
 	}()
 
-	existingIPv6FwdRule, err := l4netlb.forwardingRules.Get(expectedIPv6FrName)
+	existingIPv6FwdRule, err := l4netlb.forwardingRules.Get(ctx, expectedIPv6FrName)
 	if err != nil {
 		frLogger.Error(err, "l4netlb.forwardingRules.Get returned error")
 		return nil, l4utils.ResourceResync, err
 	}
 
-	toDeleteIPv6FwdRule, err := l4netlb.forwardingRules.Get(toDeleteIPv6FrName)
+	toDeleteIPv6FwdRule, err := l4netlb.forwardingRules.Get(ctx, toDeleteIPv6FrName)
 	if err != nil {
 		frLogger.Error(err, "l4netlb.forwardingRules.Get returned error for alt FR")
 		return nil, l4utils.ResourceResync, err
@@ -219,19 +223,19 @@ func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(bsLink string) (*composite.Forw
 		// check if it matches network tiers from forwarding rule and external ip Address.
 		// If they do not match, tear down the existing resources with the wrong tier.
 		if isFromAnnotation {
-			if err := l4netlb.tearDownResourcesWithWrongNetworkTier(fwdRuleToDetermineIP, netTier, addrMgr, frLogger); err != nil {
+			if err := l4netlb.tearDownResourcesWithWrongNetworkTier(ctx, fwdRuleToDetermineIP, netTier, addrMgr, frLogger); err != nil {
 				return nil, l4utils.ResourceResync, err
 			}
 		}
 
-		ipv6AddrToUse, _, err = addrMgr.HoldAddress()
+		ipv6AddrToUse, _, err = addrMgr.HoldAddress(ctx)
 		if err != nil {
 			return nil, l4utils.ResourceResync, err
 		}
 		frLogger.V(2).Info("ensureIPv6ForwardingRule: reserved IP for the forwarding rule", "ip", ipv6AddrToUse)
 		// We delete the alt Forwarding Rule as it is not needed when switching from single to mixed and vice versa
 		if toDeleteIPv6FwdRule != nil {
-			err = l4netlb.forwardingRules.Delete(toDeleteIPv6FwdRule.Name)
+			err = l4netlb.forwardingRules.Delete(ctx, toDeleteIPv6FwdRule.Name)
 			if err != nil {
 				frLogger.Error(err, "l4netlb.forwardingRules.Delete returned error for alt FR")
 				return nil, l4utils.ResourceUpdate, err
@@ -248,7 +252,7 @@ func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(bsLink string) (*composite.Forw
 		}()
 	} else if existingIPv6FwdRule != nil && existingIPv6FwdRule.NetworkTier != netTier.ToGCEValue() {
 		frLogger.V(2).Info("deleting forwarding rule for service due to network tier mismatch", "existingTier", existingIPv6FwdRule.NetworkTier, "expectedTier", netTier)
-		err := l4netlb.forwardingRules.Delete(existingIPv6FwdRule.Name)
+		err := l4netlb.forwardingRules.Delete(ctx, existingIPv6FwdRule.Name)
 		if err != nil {
 			frLogger.Error(err, "l4netlb.forwardingRules.Delete returned error, want nil")
 		}
@@ -275,18 +279,18 @@ func (l4netlb *L4NetLB) ensureIPv6ForwardingRule(bsLink string) (*composite.Forw
 			frLogger.V(2).Info("ensureIPv6ForwardingRule: Skipping update of unchanged ipv6 forwarding rule")
 			return existingIPv6FwdRule, l4utils.ResourceResync, nil
 		}
-		err = l4netlb.deleteChangedIPv6ForwardingRule(existingIPv6FwdRule, expectedIPv6FwdRule)
+		err = l4netlb.deleteChangedIPv6ForwardingRule(ctx, existingIPv6FwdRule, expectedIPv6FwdRule)
 		if err != nil {
 			return nil, l4utils.ResourceUpdate, err
 		}
 	}
 	frLogger.V(2).Info("ensureIPv6ForwardingRule: Creating/Recreating forwarding rule")
-	err = l4netlb.forwardingRules.Create(expectedIPv6FwdRule)
+	err = l4netlb.forwardingRules.Create(ctx, expectedIPv6FwdRule)
 	if err != nil {
 		return nil, l4utils.ResourceUpdate, err
 	}
 
-	createdFr, err := l4netlb.forwardingRules.Get(expectedIPv6FwdRule.Name)
+	createdFr, err := l4netlb.forwardingRules.Get(ctx, expectedIPv6FwdRule.Name)
 	return createdFr, l4utils.ResourceUpdate, err
 }
 
@@ -351,12 +355,12 @@ func (l4netlb *L4NetLB) buildExpectedIPv6ForwardingRule(bsLink, ipv6AddressToUse
 	return fr, nil
 }
 
-func (l4netlb *L4NetLB) deleteChangedIPv6ForwardingRule(existingFwdRule *composite.ForwardingRule, expectedFwdRule *composite.ForwardingRule) error {
+func (l4netlb *L4NetLB) deleteChangedIPv6ForwardingRule(ctx context.Context, existingFwdRule *composite.ForwardingRule, expectedFwdRule *composite.ForwardingRule) error {
 	frDiff := cmp.Diff(existingFwdRule, expectedFwdRule, cmpopts.IgnoreFields(composite.ForwardingRule{}, "IPAddress"))
 	l4netlb.svcLogger.V(2).Info("IPv6 External forwarding rule changed. Deleting existing ipv6 forwarding rule.",
 		"existingForwardingRule", fmt.Sprintf("%+v", existingFwdRule), "newForwardingRule", fmt.Sprintf("%+v", expectedFwdRule), "diff", frDiff)
 
-	err := l4netlb.forwardingRules.Delete(existingFwdRule.Name)
+	err := l4netlb.forwardingRules.Delete(ctx, existingFwdRule.Name)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -185,7 +186,7 @@ func TestL4EnsureIPv6ForwardingRuleUpdate(t *testing.T) {
 			if tc.namedAddress != nil {
 				fakeGCE.ReserveRegionAddress(tc.namedAddress, fakeGCE.Region())
 			}
-			fr, updated, err := l4.ensureIPv6ForwardingRule(bsLink, gce.ILBOptions{}, tc.existingRule, "")
+			fr, updated, err := l4.ensureIPv6ForwardingRule(context.Background(), bsLink, gce.ILBOptions{}, tc.existingRule, "")
 
 			if err != nil && tc.wantErrMsg == "" {
 				t.Errorf("ensureIPv4ForwardingRule() err=%v", err)
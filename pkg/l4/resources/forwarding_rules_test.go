@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"net/http"
 	"strings"
 	"testing"
@@ -72,7 +73,7 @@ func TestL4CreateExternalForwardingRuleUserErrors(t *testing.T) {
 			addr := &compute.Address{Name: "my-important-address", Address: targetIP, AddressType: string(cloud.SchemeExternal)}
 			fakeGCE.ReserveRegionAddress(addr, fakeGCE.Region())
 			fakeGCE.Compute().(*cloud.MockGCE).MockForwardingRules.InsertHook = test.InsertForwardingRuleErrorHook(tc.insertError)
-			_, _, _, err := l4.ensureIPv4ForwardingRule("link")
+			_, _, _, err := l4.ensureIPv4ForwardingRule(context.Background(), "link")
 
 			require.Error(t, err)
 			assert.True(t, tc.expectedUserErrorFunc(err), "actual", err)
@@ -211,7 +212,7 @@ func TestL4CreateExternalForwardingRule(t *testing.T) {
 			if tc.namedAddress != nil {
 				fakeGCE.ReserveRegionAddress(tc.namedAddress, fakeGCE.Region())
 			}
-			fr, _, updated, err := l4.ensureIPv4ForwardingRule(bsLink)
+			fr, _, updated, err := l4.ensureIPv4ForwardingRule(context.Background(), bsLink)
 			if err != nil {
 				t.Errorf("ensureIPv4ForwardingRule() err=%v", err)
 			}
@@ -327,12 +328,12 @@ func TestL4CreateExternalForwardingRuleUpdate(t *testing.T) {
 			tc.wantRule.Name = utils.LegacyForwardingRuleName(tc.svc)
 			tc.existingRule.Name = utils.LegacyForwardingRuleName(tc.svc)
 			if tc.existingRule != nil {
-				l4.forwardingRules.Create(tc.existingRule)
+				l4.forwardingRules.Create(context.Background(), tc.existingRule)
 			}
 			if tc.namedAddress != nil {
 				fakeGCE.ReserveRegionAddress(tc.namedAddress, fakeGCE.Region())
 			}
-			fr, _, updated, err := l4.ensureIPv4ForwardingRule(bsLink)
+			fr, _, updated, err := l4.ensureIPv4ForwardingRule(context.Background(), bsLink)
 
 			if err != nil && tc.wantErrMsg == "" {
 				t.Errorf("ensureIPv4ForwardingRule() err=%v", err)
@@ -399,7 +400,7 @@ func TestL4EnsureIPv4ForwardingRuleAddressAlreadyInUse(t *testing.T) {
 			addr := &compute.Address{Name: "my-important-address", Address: targetIP, AddressType: string(cloud.SchemeInternal)}
 			fakeGCE.ReserveRegionAddress(addr, fakeGCE.Region())
 			fakeGCE.Compute().(*cloud.MockGCE).MockForwardingRules.InsertHook = test.InsertForwardingRuleErrorHook(tc.insertError)
-			_, _, err := l4.ensureIPv4ForwardingRule("link", gce.ILBOptions{}, nil, "subnetworkX", "1.1.1.1")
+			_, _, err := l4.ensureIPv4ForwardingRule(context.Background(), "link", gce.ILBOptions{}, nil, "subnetworkX", "1.1.1.1")
 
 			require.Error(t, err)
 			assert.True(t, tc.expectedUserErrorFunc(err))
@@ -443,7 +444,7 @@ func TestL4EnsureIPv4ForwardingRule(t *testing.T) {
 	ipToUse := "1.1.1.1"
 	bsLink := "http://www.googleapis.com/projects/test/regions/us-central1/backendServices/bs1"
 
-	forwardingRule, syncStatus, err := l4.ensureIPv4ForwardingRule(bsLink, gce.ILBOptions{}, nil, subnetworkURL, ipToUse)
+	forwardingRule, syncStatus, err := l4.ensureIPv4ForwardingRule(context.Background(), bsLink, gce.ILBOptions{}, nil, subnetworkURL, ipToUse)
 	require.NoError(t, err)
 	require.Equal(t, l4utils.ResourceUpdate, syncStatus)
 
@@ -595,7 +596,7 @@ func TestL4EnsureInternalForwardingRuleUpdate(t *testing.T) {
 			if tc.namedAddress != nil {
 				fakeGCE.ReserveRegionAddress(tc.namedAddress, fakeGCE.Region())
 			}
-			fr, updated, err := l4.ensureIPv4ForwardingRule(bsLink, gce.ILBOptions{}, tc.existingRule, subnetworkURL, "")
+			fr, updated, err := l4.ensureIPv4ForwardingRule(context.Background(), bsLink, gce.ILBOptions{}, tc.existingRule, subnetworkURL, "")
 
 			if err != nil && tc.wantErrMsg == "" {
 				t.Errorf("ensureIPv4ForwardingRule() err=%v", err)
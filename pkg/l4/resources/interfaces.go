@@ -17,13 +17,14 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"k8s.io/ingress-gce/pkg/composite"
 )
 
 // ForwardingRulesProvider is an interface to manage Google Cloud Forwarding Rules
 type ForwardingRulesProvider interface {
-	Get(name string) (*composite.ForwardingRule, error)
-	Create(forwardingRule *composite.ForwardingRule) error
-	Delete(name string) error
-	Patch(forwardingRule *composite.ForwardingRule) error
+	Get(ctx context.Context, name string) (*composite.ForwardingRule, error)
+	Create(ctx context.Context, forwardingRule *composite.ForwardingRule) error
+	Delete(ctx context.Context, name string) error
+	Patch(ctx context.Context, forwardingRule *composite.ForwardingRule) error
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/cloud-provider/service/helpers"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/firewalls"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/address"
@@ -41,6 +43,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/healthchecks"
 	"k8s.io/ingress-gce/pkg/l4/metrics"
 	"k8s.io/ingress-gce/pkg/l4lbconfig"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/network"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -85,6 +88,7 @@ type L4 struct {
 	enableZonalAffinity              bool
 	svcLogger                        klog.Logger
 	l4lbConfigLister                 cache.Store
+	syncTrace                        *synctimeline.Trace
 }
 
 // L4ILBSyncResult contains information about the outcome of an L4 ILB sync. It stores the list of resource name annotations,
@@ -127,6 +131,8 @@ type L4ILBParams struct {
 	DisableNodesFirewallProvisioning bool
 	EnableMixedProtocol              bool
 	L4LBConfigLister                 cache.Store
+	// SyncTrace measures the phases of the sync, it may be nil.
+	SyncTrace *synctimeline.Trace
 }
 
 // NewL4Handler creates a new L4Handler for the given L4 service.
@@ -150,12 +156,14 @@ func NewL4Handler(params *L4ILBParams, logger klog.Logger) *L4 {
 		enableZonalAffinity:              params.EnableZonalAffinity,
 		svcLogger:                        logger,
 		l4lbConfigLister:                 params.L4LBConfigLister,
+		syncTrace:                        params.SyncTrace,
 	}
 	l4.NamespacedName = types.NamespacedName{Name: params.Service.Name, Namespace: params.Service.Namespace}
 	l4.backendPool = backends.NewPool(l4.cloud, l4.namer)
 	l4.ServicePort = utils.ServicePort{
 		ID: utils.ServicePortID{Service: l4.NamespacedName}, BackendNamer: l4.namer,
-		VMIPNEGEnabled: true,
+		VMIPNEGEnabled:     true,
+		AdoptedBackendName: adoptedResources(params.Service).BackendService,
 	}
 	return l4
 }
@@ -168,7 +176,7 @@ func (l4 *L4) CreateKey(name string) (*meta.Key, error) {
 // getILBOptions fetches the optional features requested on the given ILB service.
 func (l4 *L4) getILBOptions() gce.ILBOptions {
 	if l4.cloud.IsLegacyNetwork() {
-		l4.recorder.Event(l4.Service, corev1.EventTypeWarning, "ILBOptionsIgnored", "Internal LoadBalancer options are not supported with Legacy Networks.")
+		l4.recorder.Event(l4.Service, corev1.EventTypeWarning, events.ReasonILBOptionsIgnored, "Internal LoadBalancer options are not supported with Legacy Networks.")
 		return gce.ILBOptions{}
 	}
 
@@ -179,24 +187,30 @@ func (l4 *L4) getILBOptions() gce.ILBOptions {
 }
 
 // EnsureInternalLoadBalancerDeleted performs a cleanup of all GCE resources for the given loadbalancer service.
-func (l4 *L4) EnsureInternalLoadBalancerDeleted(svc *corev1.Service) *L4ILBSyncResult {
+func (l4 *L4) EnsureInternalLoadBalancerDeleted(ctx context.Context, svc *corev1.Service) *L4ILBSyncResult {
 	l4.svcLogger.V(2).Info("EnsureInternalLoadBalancerDeleted: deleting L4 ILB LoadBalancer resources")
 	isMultinetService := l4.networkResolver.IsMultinetService(svc)
 	isWeightedLBPodsPerNode := l4.isWeightedLBPodsPerNode()
 	isLBWithZonalAffinity := l4.isLBWithZonalAffinity()
 	result := NewL4ILBSyncResult(SyncTypeDelete, time.Now(), svc, isMultinetService, isWeightedLBPodsPerNode, isLBWithZonalAffinity)
 
-	l4.deleteIPv4ResourcesOnDelete(result)
+	l4.deleteIPv4ResourcesOnDelete(ctx, result)
 	if l4.enableDualStack {
-		l4.deleteIPv6ResourcesOnDelete(result)
+		l4.deleteIPv6ResourcesOnDelete(ctx, result)
 	}
 
 	// Delete backend service
-	bsName := l4.namer.L4Backend(svc.Namespace, svc.Name)
-	// TODO(cheungdavid): Create backend logger that contains backendName,
-	// backendVersion, and backendScope before passing to backendPool.Delete().
-	// See example in backendSyncer.gc().
-	err := utils.IgnoreHTTPNotFound(l4.backendPool.Delete(bsName, meta.VersionGA, meta.Regional, l4.svcLogger))
+	bsName := l4.backendServiceName()
+	var err error
+	if adoptedResources(svc).BackendService != "" {
+		// Adopted backend service is left in place, only cluster backends are detached from it.
+		err = releaseAdoptedBackendService(ctx, l4.backendPool, bsName, []string{l4.namer.L4Backend(svc.Namespace, svc.Name)}, l4.svcLogger)
+	} else {
+		// TODO(cheungdavid): Create backend logger that contains backendName,
+		// backendVersion, and backendScope before passing to backendPool.Delete().
+		// See example in backendSyncer.gc().
+		err = utils.IgnoreHTTPNotFound(l4.backendPool.Delete(ctx, bsName, meta.VersionGA, meta.Regional, l4.svcLogger))
+	}
 	if err != nil {
 		l4.svcLogger.Error(err, "Failed to delete backends for internal loadbalancer service")
 		result.GCEResourceInError = annotations.BackendServiceResource
@@ -211,13 +225,13 @@ func (l4 *L4) EnsureInternalLoadBalancerDeleted(svc *corev1.Service) *L4ILBSyncR
 	// and delete them if needed.
 	for _, isShared := range []bool{true, false} {
 		if l4.enableDualStack {
-			resourceInError, err := l4.healthChecks.DeleteHealthCheckWithDualStackFirewalls(svc, l4.namer, isShared, meta.Global, utils.ILB, l4.svcLogger)
+			resourceInError, err := l4.healthChecks.DeleteHealthCheckWithDualStackFirewalls(ctx, svc, l4.namer, isShared, meta.Global, utils.ILB, l4.svcLogger)
 			if err != nil {
 				result.GCEResourceInError = resourceInError
 				result.Error = err
 			}
 		} else {
-			resourceInError, err := l4.healthChecks.DeleteHealthCheckWithFirewall(svc, l4.namer, isShared, meta.Global, utils.ILB, l4.svcLogger)
+			resourceInError, err := l4.healthChecks.DeleteHealthCheckWithFirewall(ctx, svc, l4.namer, isShared, meta.Global, utils.ILB, l4.svcLogger)
 			if err != nil {
 				result.GCEResourceInError = resourceInError
 				result.Error = err
@@ -233,9 +247,9 @@ func (l4 *L4) EnsureInternalLoadBalancerDeleted(svc *corev1.Service) *L4ILBSyncR
 // Checking for annotation saves us from emitting too much error logs "Resource not found".
 // If annotation was deleted, but resource still exists, it will be left till the Service deletion,
 // where we delete all resources, no matter if they exist in annotations.
-func (l4 *L4) deleteIPv4ResourcesOnSync(result *L4ILBSyncResult) {
+func (l4 *L4) deleteIPv4ResourcesOnSync(ctx context.Context, result *L4ILBSyncResult) {
 	l4.svcLogger.Info("Deleting IPv4 resources for L4 ILB Service on sync, with checking for existence in annotation")
-	l4.deleteIPv4ResourcesAnnotationBased(result, false)
+	l4.deleteIPv4ResourcesAnnotationBased(ctx, result, false)
 }
 
 // deleteIPv4ResourcesOnDelete deletes all resources specific to IPv4.
@@ -243,9 +257,9 @@ func (l4 *L4) deleteIPv4ResourcesOnSync(result *L4ILBSyncResult) {
 // During sync, we delete resources only that exist in annotations,
 // so they could be leaked, if annotation was deleted.
 // That's why on service deletion we delete all IPv4 resources, ignoring their existence in annotations
-func (l4 *L4) deleteIPv4ResourcesOnDelete(result *L4ILBSyncResult) {
+func (l4 *L4) deleteIPv4ResourcesOnDelete(ctx context.Context, result *L4ILBSyncResult) {
 	l4.svcLogger.Info("Deleting IPv4 resources for L4 ILB Service on delete, without checking for existence in annotation")
-	l4.deleteIPv4ResourcesAnnotationBased(result, true)
+	l4.deleteIPv4ResourcesAnnotationBased(ctx, result, true)
 }
 
 // deleteIPv4ResourcesAnnotationBased deletes IPv4 only resources with checking,
@@ -256,16 +270,16 @@ func (l4 *L4) deleteIPv4ResourcesOnDelete(result *L4ILBSyncResult) {
 // - IPv4 Firewall
 // This function does not delete Backend Service and Health Check, because they are shared between IPv4 and IPv6.
 // IPv4 Firewall Rule for Health Check also will not be deleted here, and will be left till the Service Deletion.
-func (l4 *L4) deleteIPv4ResourcesAnnotationBased(result *L4ILBSyncResult, shouldIgnoreAnnotations bool) {
+func (l4 *L4) deleteIPv4ResourcesAnnotationBased(ctx context.Context, result *L4ILBSyncResult, shouldIgnoreAnnotations bool) {
 	hasFwdRuleAnnotation := l4.hasAnnotation(annotations.TCPForwardingRuleKey) ||
 		l4.hasAnnotation(annotations.UDPForwardingRuleKey) ||
 		l4.hasAnnotation(annotations.L3ForwardingRuleKey)
 	if shouldIgnoreAnnotations || hasFwdRuleAnnotation {
 		var err error
 		if l4.enableDualStack && l4.enableMixedProtocol {
-			err = l4.deleteAllIPv4ForwardingRules()
+			err = l4.deleteAllIPv4ForwardingRules(ctx)
 		} else {
-			err = l4.deleteIPv4ForwardingRule()
+			err = l4.deleteIPv4ForwardingRule(ctx)
 		}
 		if err != nil {
 			l4.svcLogger.Error(err, "Failed to delete forwarding rule for internal loadbalancer service")
@@ -294,23 +308,27 @@ func (l4 *L4) deleteIPv4ResourcesAnnotationBased(result *L4ILBSyncResult, should
 	}
 }
 
-func (l4 *L4) deleteIPv4ForwardingRule() error {
+func (l4 *L4) deleteIPv4ForwardingRule(ctx context.Context) error {
 	start := time.Now()
 
 	frName := l4.GetFRName()
+	if adoptedResources(l4.Service).ForwardingRule != "" {
+		l4.svcLogger.Info("Skipping deletion of adopted IPv4 forwarding rule for L4 ILB Service", "forwardingRuleName", frName)
+		return nil
+	}
 
 	l4.svcLogger.Info("Deleting IPv4 forwarding rule for L4 ILB Service", "forwardingRuleName", frName)
 	defer func() {
 		l4.svcLogger.Info("Finished deleting IPv4 forwarding rule for L4 ILB Service", "forwardingRuleName", frName, "timeTaken", time.Since(start))
 	}()
 
-	return l4.forwardingRules.Delete(frName)
+	return l4.forwardingRules.Delete(ctx, frName)
 }
 
 // When switching from for example IPv4 TCP to IPv6 UDP, simply using GetFRName()
 // to delete won't be accurate since it will try to delete *IPv4 UDP* name not *TCP*,
 // and the UDP forwarding rule will be leaked.
-func (l4 *L4) deleteAllIPv4ForwardingRules() error {
+func (l4 *L4) deleteAllIPv4ForwardingRules(ctx context.Context) error {
 	start := time.Now()
 
 	frNameTCP := l4.getFRNameWithProtocol(forwardingrules.ProtocolTCP)
@@ -323,15 +341,15 @@ func (l4 *L4) deleteAllIPv4ForwardingRules() error {
 		l4.svcLogger.Info("Finished deleting all IPv4 forwarding rule for L4 ILB Service", "forwardingRuleNameTCP", frNameTCP, "forwardingRuleNameUDP", frNameUDP, "forwardingRuleNameL3", frNameL3, "timeTaken", time.Since(start))
 	}()
 
-	errTCP := l4.forwardingRules.Delete(frNameTCP)
-	errUDP := l4.forwardingRules.Delete(frNameUDP)
-	errL3 := l4.forwardingRules.Delete(frNameL3)
+	errTCP := l4.forwardingRules.Delete(ctx, frNameTCP)
+	errUDP := l4.forwardingRules.Delete(ctx, frNameUDP)
+	errL3 := l4.forwardingRules.Delete(ctx, frNameL3)
 
 	return errors.Join(errTCP, errUDP, errL3)
 }
 
 func (l4 *L4) deleteIPv4Address() error {
-	addressName := l4.GetFRName()
+	addressName := l4.managedFRName()
 
 	start := time.Now()
 	l4.svcLogger.Info("Deleting IPv4 address for L4 ILB Service", "addressName", addressName)
@@ -360,7 +378,7 @@ func (l4 *L4) deleteFirewall(name string, fwLogger klog.Logger) error {
 	err := firewalls.EnsureL4FirewallRuleDeleted(l4.cloud, name, fwLogger)
 	if err != nil {
 		if fwErr, ok := err.(*firewalls.FirewallXPNError); ok {
-			l4.recorder.Event(l4.Service, corev1.EventTypeNormal, "XPN", fwErr.Message)
+			l4.recorder.Event(l4.Service, corev1.EventTypeNormal, events.ReasonXPN, fwErr.Message)
 			return nil
 		}
 		return err
@@ -369,9 +387,18 @@ func (l4 *L4) deleteFirewall(name string, fwLogger klog.Logger) error {
 }
 
 // GetFRName returns the name of the forwarding rule for the given ILB service.
-// This appends the protocol to the forwarding rule name, which will help supporting multiple protocols in the same ILB
-// service.
+// This is either the name of the adopted forwarding rule or the name generated by the controller.
 func (l4 *L4) GetFRName() string {
+	if adopted := adoptedResources(l4.Service).ForwardingRule; adopted != "" {
+		return adopted
+	}
+	return l4.managedFRName()
+}
+
+// managedFRName returns the name of the controller-generated forwarding rule for the given ILB service.
+// This appends the protocol to the forwarding rule name, which will help supporting multiple protocols in the same ILB
+// service. It is also used to name the address reserved by the controller.
+func (l4 *L4) managedFRName() string {
 	ports := l4.Service.Spec.Ports
 	protocol := string(utils.GetProtocol(ports))
 	if l4.enableMixedProtocol {
@@ -384,6 +411,15 @@ func (l4 *L4) getFRNameWithProtocol(protocol string) string {
 	return l4.namer.L4ForwardingRule(l4.Service.Namespace, l4.Service.Name, strings.ToLower(protocol))
 }
 
+// backendServiceName returns the name of the adopted backend service,
+// or the name generated by the controller if none was adopted.
+func (l4 *L4) backendServiceName() string {
+	if adopted := adoptedResources(l4.Service).BackendService; adopted != "" {
+		return adopted
+	}
+	return l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
+}
+
 func (l4 *L4) subnetName() string {
 	// At first check custom subnet annotation.
 	customSubnetName := annotations.FromService(l4.Service).GetInternalLoadBalancerAnnotationSubnet()
@@ -399,7 +435,7 @@ func (l4 *L4) subnetName() string {
 
 // EnsureInternalLoadBalancer ensures that all GCE resources for the given loadbalancer service have
 // been created. It returns a LoadBalancerStatus with the updated ForwardingRule IP address.
-func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service) *L4ILBSyncResult {
+func (l4 *L4) EnsureInternalLoadBalancer(ctx context.Context, nodeNames []string, svc *corev1.Service) *L4ILBSyncResult {
 	l4.Service = svc
 
 	startTime := time.Now()
@@ -433,7 +469,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 		}
 	}
 
-	hcLink := l4.provideHealthChecks(nodeNames, result)
+	hcLink := l4.provideHealthChecks(ctx, nodeNames, result)
 	if result.Error != nil {
 		return result
 	}
@@ -446,11 +482,11 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 	}
 	l4.svcLogger.V(2).Info("subnetworkURL for service", "subnetworkURL", subnetworkURL)
 
-	bsName := l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
+	bsName := l4.backendServiceName()
 	// TODO(cheungdavid): Create backend logger that contains backendName,
 	// backendVersion, and backendScope before passing to backendPool.Get().
 	// See example in backendSyncer.ensureBackendService().
-	existingBS, err := l4.backendPool.Get(bsName, meta.VersionGA, l4.scope, l4.svcLogger)
+	existingBS, err := l4.backendPool.Get(ctx, bsName, meta.VersionGA, l4.scope, l4.svcLogger)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		l4.svcLogger.Error(err, "Failed to lookup existing backend service, ignoring err")
 	}
@@ -460,7 +496,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 	var ipv4AddressToUse string
 	var ipv4AddressName string
 	if !l4.enableDualStack || l4utils.NeedsIPv4(l4.Service) {
-		existingIPv4FR, err = l4.getOldIPv4ForwardingRule(existingBS)
+		existingIPv4FR, err = l4.getOldIPv4ForwardingRule(ctx, existingBS)
 		ipv4AddressToUse, ipv4AddressName, err = address.IPv4ToUse(l4.cloud, l4.recorder, l4.Service, existingIPv4FR, subnetworkURL)
 		if err != nil {
 			result.Error = fmt.Errorf("EnsureInternalLoadBalancer error: address.IPv4ToUse returned error: %w", err)
@@ -471,8 +507,8 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 			l4.svcLogger.V(2).Info("EnsureInternalLoadBalancer, reserve existing IPv4 address before making any changes")
 			nm := types.NamespacedName{Namespace: l4.Service.Namespace, Name: l4.Service.Name}.String()
 			// ILB can be created only in Premium Tier
-			addrMgr := address.NewManager(l4.cloud, nm, l4.cloud.Region(), subnetworkURL, l4.GetFRName(), ipv4AddressName, ipv4AddressToUse, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, l4.svcLogger)
-			ipv4AddressToUse, _, err = addrMgr.HoldAddress()
+			addrMgr := address.NewManager(l4.cloud, nm, l4.cloud.Region(), subnetworkURL, l4.managedFRName(), ipv4AddressName, ipv4AddressToUse, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv4Version, l4.svcLogger)
+			ipv4AddressToUse, _, err = addrMgr.HoldAddress(ctx)
 			if err != nil {
 				result.Error = fmt.Errorf("EnsureInternalLoadBalancer error: addrMgr.HoldAddress() returned error %w", err)
 				return result
@@ -493,7 +529,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 	var ipv6AddrToUse string
 	var ipv6AddressName string
 	if l4.enableDualStack && l4utils.NeedsIPv6(l4.Service) {
-		existingIPv6FR, err = l4.getOldIPv6ForwardingRule(existingBS)
+		existingIPv6FR, err = l4.getOldIPv6ForwardingRule(ctx, existingBS)
 		ipv6AddrToUse, ipv6AddressName, err = address.IPv6ToUse(l4.cloud, l4.Service, existingIPv6FR, subnetworkURL, l4.svcLogger)
 		if err != nil {
 			result.Error = fmt.Errorf("EnsureInternalLoadBalancer error: address.IPv6ToUse returned error: %w", err)
@@ -506,7 +542,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 			nm := types.NamespacedName{Namespace: l4.Service.Namespace, Name: l4.Service.Name}.String()
 			// ILB can be created only in Premium Tier
 			ipv6AddrMgr := address.NewManager(l4.cloud, nm, l4.cloud.Region(), subnetworkURL, l4.getIPv6FRName(), ipv6AddressName, ipv6AddrToUse, cloud.SchemeInternal, cloud.NetworkTierPremium, address.IPv6Version, l4.svcLogger)
-			ipv6AddrToUse, _, err = ipv6AddrMgr.HoldAddress()
+			ipv6AddrToUse, _, err = ipv6AddrMgr.HoldAddress(ctx)
 			if err != nil {
 				result.Error = fmt.Errorf("EnsureInternalLoadBalancer error: ipv6AddrMgr.HoldAddress() returned error %w", err)
 				return result
@@ -532,9 +568,10 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 	// otherwise, on updating backend service, google cloud api will return error
 	if existingBS != nil && existingBS.Protocol != backendProtocol {
 		l4.svcLogger.Info("Protocol changed for service", "existingProtocol", existingBS.Protocol, "newProtocol", backendProtocol)
-		if existingIPv4FR != nil {
+		// Adopted forwarding rule is never deleted, it will fail the validation instead.
+		if existingIPv4FR != nil && adoptedResources(l4.Service).ForwardingRule == "" {
 			// Delete ipv4 forwarding rule if it exists
-			err = l4.forwardingRules.Delete(existingIPv4FR.Name)
+			err = l4.forwardingRules.Delete(ctx, existingIPv4FR.Name)
 			if err != nil {
 				l4.svcLogger.Error(err, "Failed to delete forwarding rule", "forwardingRuleName", existingIPv4FR.Name)
 			}
@@ -542,7 +579,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 
 		if l4.enableDualStack && existingIPv6FR != nil {
 			// Delete ipv6 forwarding rule if it exists
-			err = l4.forwardingRules.Delete(existingIPv6FR.Name)
+			err = l4.forwardingRules.Delete(ctx, existingIPv6FR.Name)
 			if err != nil {
 				l4.svcLogger.Error(err, "Failed to delete ipv6 forwarding rule", "forwardingRuleName", existingIPv6FR.Name)
 			}
@@ -553,31 +590,56 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 
 	enableZonalAffinity := l4.requireZonalAffinity(svc)
 
+	connectionTrackingPolicy, connectionTrackingControlEnabled, connectionTrackingErr := l4lbconfig.DetermineL4ConnectionTrackingPolicy(l4.Service, l4.l4lbConfigLister)
+	if connectionTrackingErr != nil {
+		l4.svcLogger.Error(connectionTrackingErr, "Failed to determine L4 connection tracking policy")
+		l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(connectionTrackingErr), "Failed to apply L4LBConfig: %v", connectionTrackingErr)
+		if l4lbconfig.IsInvalidConnectionTrackingError(connectionTrackingErr) {
+			result.GCEResourceInError = annotations.BackendServiceResource
+			result.Error = l4utils.NewUserError(connectionTrackingErr)
+			return result
+		}
+	}
+
 	logConfig, loggingCondition, err := l4lbconfig.DetermineL4LoggingConfig(l4.Service, l4.l4lbConfigLister)
 	if err != nil {
 		l4.svcLogger.Error(err, "Failed to determine L4 logging config")
-		l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(err), "Failed to apply L4LBConfig: %v", err)
+		// Errors getting the L4LBConfig were already reported for the connection tracking policy.
+		if !errors.Is(connectionTrackingErr, err) {
+			l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(err), "Failed to apply L4LBConfig: %v", err)
+		}
 	}
 	l4.svcLogger.V(2).Info("Determined L4 logging config", "logConfig", logConfig, "loggingCondition", loggingCondition)
 	logConfigControlEnabled := loggingCondition.Status == metav1.ConditionTrue
 	result.MetricsState.LoggingControlEnabled = logConfigControlEnabled
 
 	backendParams := backends.L4BackendServiceParams{
-		Name:                     bsName,
-		HealthCheckLink:          hcLink,
-		Protocol:                 backendProtocol,
-		SessionAffinity:          string(l4.Service.Spec.SessionAffinity),
-		Scheme:                   string(cloud.SchemeInternal),
-		NamespacedName:           l4.NamespacedName,
-		NetworkInfo:              &l4.network,
-		ConnectionTrackingPolicy: noConnectionTrackingPolicy,
-		EnableZonalAffinity:      enableZonalAffinity,
-		LocalityLbPolicy:         localityLbPolicy,
-		LogConfig:                logConfig,
-		LogConfigControlEnabled:  logConfigControlEnabled,
-	}
-
-	bs, bsSyncStatus, err := l4.backendPool.EnsureL4BackendService(backendParams, l4.svcLogger)
+		Name:                             bsName,
+		HealthCheckLink:                  hcLink,
+		Protocol:                         backendProtocol,
+		SessionAffinity:                  string(l4.Service.Spec.SessionAffinity),
+		Scheme:                           string(cloud.SchemeInternal),
+		NamespacedName:                   l4.NamespacedName,
+		NetworkInfo:                      &l4.network,
+		ConnectionTrackingPolicy:         connectionTrackingPolicy,
+		ConnectionTrackingControlEnabled: connectionTrackingControlEnabled,
+		EnableZonalAffinity:              enableZonalAffinity,
+		LocalityLbPolicy:                 localityLbPolicy,
+		LogConfig:                        logConfig,
+		LogConfigControlEnabled:          logConfigControlEnabled,
+	}
+
+	if adoptedResources(l4.Service).BackendService != "" {
+		if err := checkAdoptedBackendService(ctx, l4.backendPool, backendParams, l4.svcLogger); err != nil {
+			result.GCEResourceInError = annotations.BackendServiceResource
+			result.Error = err
+			return result
+		}
+	}
+
+	endBackends := l4.syncTrace.Track(synctimeline.PhaseBackends)
+	bs, bsSyncStatus, err := l4.backendPool.EnsureL4BackendService(ctx, backendParams, l4.svcLogger)
+	endBackends()
 	result.ResourceUpdates.SetBackendService(bsSyncStatus)
 	if err != nil {
 		if logConfigControlEnabled {
@@ -587,7 +649,7 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 
 		if l4utils.IsUnsupportedFeatureError(err, string(backends.LocalityLbPolicyRendezvous)) {
 			result.GCEResourceInError = annotations.BackendServiceResource
-			l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, "AllowlistingRequired", WeightedLBPodsPerNodeAllowlistMessage)
+			l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, events.ReasonAllowlistingRequired, WeightedLBPodsPerNodeAllowlistMessage)
 			result.Error = l4utils.NewUserError(err)
 		} else {
 			result.GCEResourceInError = annotations.BackendServiceResource
@@ -606,9 +668,9 @@ func (l4 *L4) EnsureInternalLoadBalancer(nodeNames []string, svc *corev1.Service
 	}
 
 	if l4.enableDualStack {
-		l4.ensureDualStackResources(result, nodeNames, options, bs, existingIPv4FR, existingIPv6FR, subnetworkURL, ipv4AddressToUse, ipv6AddrToUse)
+		l4.ensureDualStackResources(ctx, result, nodeNames, options, bs, existingIPv4FR, existingIPv6FR, subnetworkURL, ipv4AddressToUse, ipv6AddrToUse)
 	} else {
-		l4.ensureIPv4Resources(result, nodeNames, options, bs, existingIPv4FR, subnetworkURL, ipv4AddressToUse)
+		l4.ensureIPv4Resources(ctx, result, nodeNames, options, bs, existingIPv4FR, subnetworkURL, ipv4AddressToUse)
 	}
 
 	if result.Error != nil {
@@ -636,17 +698,18 @@ func (l4 *L4) requireZonalAffinity(svc *corev1.Service) bool {
 		(*svc.Spec.TrafficDistribution == trafficDistributionZonalAffinityDeprecated || *svc.Spec.TrafficDistribution == trafficDistributionZonalAffinity)
 }
 
-func (l4 *L4) provideHealthChecks(nodeNames []string, result *L4ILBSyncResult) string {
+func (l4 *L4) provideHealthChecks(ctx context.Context, nodeNames []string, result *L4ILBSyncResult) string {
+	defer l4.syncTrace.Track(synctimeline.PhaseHealthChecks)()
 	if l4.enableDualStack {
-		return l4.provideDualStackHealthChecks(nodeNames, result)
+		return l4.provideDualStackHealthChecks(ctx, nodeNames, result)
 	}
-	return l4.provideIPv4HealthChecks(nodeNames, result)
+	return l4.provideIPv4HealthChecks(ctx, nodeNames, result)
 }
 
-func (l4 *L4) provideDualStackHealthChecks(nodeNames []string, result *L4ILBSyncResult) string {
+func (l4 *L4) provideDualStackHealthChecks(ctx context.Context, nodeNames []string, result *L4ILBSyncResult) string {
 	sharedHC := !helpers.RequestsOnlyLocalTraffic(l4.Service)
 
-	hcResult := l4.healthChecks.EnsureHealthCheckWithDualStackFirewalls(l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, nodeNames, l4utils.NeedsIPv4(l4.Service), l4utils.NeedsIPv6(l4.Service), l4.network, l4.svcLogger)
+	hcResult := l4.healthChecks.EnsureHealthCheckWithDualStackFirewalls(ctx, l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, nodeNames, l4utils.NeedsIPv4(l4.Service), l4utils.NeedsIPv6(l4.Service), l4.network, l4.svcLogger)
 	if hcResult.Err != nil {
 		result.GCEResourceInError = hcResult.GceResourceInError
 		result.Error = hcResult.Err
@@ -669,9 +732,9 @@ func (l4 *L4) provideDualStackHealthChecks(nodeNames []string, result *L4ILBSync
 	return hcResult.HCLink
 }
 
-func (l4 *L4) provideIPv4HealthChecks(nodeNames []string, result *L4ILBSyncResult) string {
+func (l4 *L4) provideIPv4HealthChecks(ctx context.Context, nodeNames []string, result *L4ILBSyncResult) string {
 	sharedHC := !helpers.RequestsOnlyLocalTraffic(l4.Service)
-	hcResult := l4.healthChecks.EnsureHealthCheckWithFirewall(l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, nodeNames, l4.network, l4.svcLogger)
+	hcResult := l4.healthChecks.EnsureHealthCheckWithFirewall(ctx, l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, nodeNames, l4.network, l4.svcLogger)
 	result.ResourceUpdates.SetHealthCheck(hcResult.WasUpdated)
 	result.ResourceUpdates.SetFirewallForHealthCheck(hcResult.WasFirewallUpdated)
 	if hcResult.Err != nil {
@@ -684,24 +747,24 @@ func (l4 *L4) provideIPv4HealthChecks(nodeNames []string, result *L4ILBSyncResul
 	return hcResult.HCLink
 }
 
-func (l4 *L4) ensureDualStackResources(result *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bs *composite.BackendService, existingIPv4FwdRule, existingIPv6FwdRule *composite.ForwardingRule, subnetworkURL, ipv4AddressToUse, ipv6AddressToUse string) {
+func (l4 *L4) ensureDualStackResources(ctx context.Context, result *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bs *composite.BackendService, existingIPv4FwdRule, existingIPv6FwdRule *composite.ForwardingRule, subnetworkURL, ipv4AddressToUse, ipv6AddressToUse string) {
 	if l4utils.NeedsIPv4(l4.Service) {
-		l4.ensureIPv4Resources(result, nodeNames, options, bs, existingIPv4FwdRule, subnetworkURL, ipv4AddressToUse)
+		l4.ensureIPv4Resources(ctx, result, nodeNames, options, bs, existingIPv4FwdRule, subnetworkURL, ipv4AddressToUse)
 	} else {
-		l4.deleteIPv4ResourcesOnSync(result)
+		l4.deleteIPv4ResourcesOnSync(ctx, result)
 	}
 	if l4utils.NeedsIPv6(l4.Service) {
-		l4.ensureIPv6Resources(result, nodeNames, options, bs.SelfLink, existingIPv6FwdRule, ipv6AddressToUse)
+		l4.ensureIPv6Resources(ctx, result, nodeNames, options, bs.SelfLink, existingIPv6FwdRule, ipv6AddressToUse)
 	} else {
-		l4.deleteIPv6ResourcesOnSync(result)
+		l4.deleteIPv6ResourcesOnSync(ctx, result)
 	}
 }
 
 // ensureIPv4Resources creates resources specific to IPv4 L4 Load Balancers:
 // - IPv4 Forwarding Rule
 // - IPv4 Firewall
-func (l4 *L4) ensureIPv4Resources(result *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bs *composite.BackendService, existingFR *composite.ForwardingRule, subnetworkURL, ipToUse string) {
-	fr, fwdRuleSyncStatus, err := l4.ensureIPv4ForwardingRule(bs.SelfLink, options, existingFR, subnetworkURL, ipToUse)
+func (l4 *L4) ensureIPv4Resources(ctx context.Context, result *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bs *composite.BackendService, existingFR *composite.ForwardingRule, subnetworkURL, ipToUse string) {
+	fr, fwdRuleSyncStatus, err := l4.ensureIPv4ForwardingRule(ctx, bs.SelfLink, options, existingFR, subnetworkURL, ipToUse)
 	result.ResourceUpdates.SetForwardingRule(fwdRuleSyncStatus)
 	if err != nil {
 		l4.svcLogger.Error(err, "ensureIPv4Resources: Failed to ensure forwarding rule for L4 ILB Service")
@@ -729,6 +792,7 @@ func (l4 *L4) ensureIPv4Resources(result *L4ILBSyncResult, nodeNames []string, o
 }
 
 func (l4 *L4) ensureIPv4NodesFirewall(nodeNames []string, ipAddress string, result *L4ILBSyncResult) {
+	defer l4.syncTrace.Track(synctimeline.PhaseFirewalls)()
 	// DisableL4LBFirewall flag disables L4 FW enforcment to remove conflicts with firewall policies
 	if l4.disableNodesFirewallProvisioning {
 		l4.svcLogger.Info("Skipped ensuring IPv4 nodes firewall for L4 ILB Service to enable compatibility with firewall policies. " +
@@ -817,7 +881,7 @@ func (l4 *L4) hasAnnotation(annotationKey string) bool {
 // getOldIPv4ForwardingRule returns old IPv4 forwarding rule, with checking backend service protocol, if it exists.
 // This is useful when switching protocols of the service,
 // because forwarding rule name depends on the protocol, and we need to get forwarding rule from the old protocol name.
-func (l4 *L4) getOldIPv4ForwardingRule(existingBS *composite.BackendService) (*composite.ForwardingRule, error) {
+func (l4 *L4) getOldIPv4ForwardingRule(ctx context.Context, existingBS *composite.BackendService) (*composite.ForwardingRule, error) {
 	servicePorts := l4.Service.Spec.Ports
 	bsProtocol := string(utils.GetProtocol(servicePorts))
 
@@ -826,7 +890,7 @@ func (l4 *L4) getOldIPv4ForwardingRule(existingBS *composite.BackendService) (*c
 	}
 
 	oldFRName := l4.GetFRName()
-	if existingBS != nil && existingBS.Protocol != bsProtocol {
+	if existingBS != nil && existingBS.Protocol != bsProtocol && adoptedResources(l4.Service).ForwardingRule == "" {
 		fwdRuleProtocol := existingBS.Protocol
 		if existingBS.Protocol == backends.ProtocolL3 {
 			fwdRuleProtocol = forwardingrules.ProtocolL3
@@ -834,7 +898,7 @@ func (l4 *L4) getOldIPv4ForwardingRule(existingBS *composite.BackendService) (*c
 		oldFRName = l4.getFRNameWithProtocol(fwdRuleProtocol)
 	}
 
-	return l4.forwardingRules.Get(oldFRName)
+	return l4.forwardingRules.Get(ctx, oldFRName)
 }
 
 // determineBackendServiceLocalityPolicy returns the locality policy to be used for the backend service of the internal load balancer.
@@ -850,20 +914,25 @@ func (l4 *L4) determineBackendServiceLocalityPolicy() backends.LocalityLBPolicyT
 			} else {
 				// If the service has the annotation "networking.gke.io/weighted-load-balancing = pods-per-node"
 				// and the external traffic policy is cluster, weighted load balancing is not enabled.
-				l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, "UnsupportedConfiguration",
+				l4.recorder.Eventf(l4.Service, corev1.EventTypeWarning, events.ReasonUnsupportedConfiguration,
 					"Weighted load balancing by pods-per-node has no effect with External Traffic Policy: Cluster.")
 				// The default unset locality lb policy is used to disable ILB Weighted Load Balancing
 				// It will eventually be "GCP_RENDEZVOUS"
 				return backends.LocalityLBPolicyDefault
 			}
 		}
+		if annotations.HasWeightedLBEndpointHintsAnnotation(l4.Service) {
+			// Weights of the GCE_VM_IP NEG endpoints are computed by the NEG controller from
+			// EndpointSlice hints and node capacity, which works for both external traffic policies.
+			return backends.LocalityLBPolicyWeightedRendezvous
+		}
 	}
 	// We leave the LocalityLbPolicy field unset since the default value will be handled outside of the controller.
 	return backends.LocalityLBPolicyDefault
 }
 
 func (l4 *L4) isWeightedLBPodsPerNode() bool {
-	return backends.LocalityLBPolicyWeightedRendezvous == l4.determineBackendServiceLocalityPolicy()
+	return annotations.HasWeightedLBPodsPerNodeAnnotation(l4.Service) && backends.LocalityLBPolicyWeightedRendezvous == l4.determineBackendServiceLocalityPolicy()
 }
 
 func (l4 *L4) isLBWithZonalAffinity() bool {
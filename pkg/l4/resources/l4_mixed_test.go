@@ -185,7 +185,7 @@ func TestEnsureMixedILB(t *testing.T) {
 				}
 				l4, fakeGCE := arrange(t, s.resources, svc)
 
-				result := l4.EnsureInternalLoadBalancer([]string{mixedprotocoltest.TestNode}, svc)
+				result := l4.EnsureInternalLoadBalancer(context.Background(), []string{mixedprotocoltest.TestNode}, svc)
 
 				wantResult := &L4ILBSyncResult{
 					Annotations: e.annotations,
@@ -293,7 +293,7 @@ func TestDeleteMixedILB(t *testing.T) {
 			}
 			l4, fakeGCE := arrange(t, tc.resources, svc)
 
-			result := l4.EnsureInternalLoadBalancerDeleted(svc)
+			result := l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 
 			wantResult := &L4ILBSyncResult{
 				Annotations: map[string]string{},
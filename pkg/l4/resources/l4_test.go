@@ -36,6 +36,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/l4/backends"
 	"k8s.io/ingress-gce/pkg/l4/healthchecks"
+	"k8s.io/ingress-gce/pkg/l4lbconfig"
 	"k8s.io/ingress-gce/pkg/network"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/klog/v2"
@@ -140,7 +141,7 @@ func TestEnsureInternalBackendServiceUpdates(t *testing.T) {
 		NetworkInfo:              network.DefaultNetwork(fakeGCE),
 		ConnectionTrackingPolicy: noConnectionTrackingPolicy,
 	}
-	_, wasUpdate, err := l4.backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+	_, wasUpdate, err := l4.backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to ensure backend service  %s - err %v", bsName, err)
 	}
@@ -150,7 +151,7 @@ func TestEnsureInternalBackendServiceUpdates(t *testing.T) {
 
 	backendParams.SessionAffinity = string(v1.ServiceAffinityNone)
 	// Update the Internal Backend Service with a new ServiceAffinity
-	_, wasUpdate, err = l4.backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+	_, wasUpdate, err = l4.backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to ensure backend service  %s - err %v", bsName, err)
 	}
@@ -158,7 +159,7 @@ func TestEnsureInternalBackendServiceUpdates(t *testing.T) {
 		t.Errorf("A change in Backend Service %s should be reported as update but it was not ", bsName)
 	}
 	key := meta.RegionalKey(bsName, l4.cloud.Region())
-	bs, err := composite.GetBackendService(l4.cloud, key, meta.VersionGA, klog.TODO())
+	bs, err := composite.GetBackendService(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to get backend service  %s - err %v", bsName, err)
 	}
@@ -170,12 +171,12 @@ func TestEnsureInternalBackendServiceUpdates(t *testing.T) {
 	newTimeout := int64(backends.DefaultConnectionDrainingTimeoutSeconds * 2)
 	bs.ConnectionDraining.DrainingTimeoutSec = newTimeout
 	bs.SessionAffinity = strings.ToUpper(string(v1.ServiceAffinityClientIP))
-	err = composite.UpdateBackendService(l4.cloud, key, bs, klog.TODO())
+	err = composite.UpdateBackendService(context.Background(), l4.cloud, key, bs, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to update backend service with new connection draining timeout - err %v", err)
 	}
 	// ensure the backend back to previous params
-	bs, _, err = l4.backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+	bs, _, err = l4.backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to ensure backend service  %s - err %v", bsName, err)
 	}
@@ -187,7 +188,7 @@ func TestEnsureInternalBackendServiceUpdates(t *testing.T) {
 	}
 
 	// check if triggering ensure with the same params does not cause an update
-	bs, wasUpdate, err = l4.backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+	bs, wasUpdate, err = l4.backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to ensure backend service  %s - err %v", bsName, err)
 	}
@@ -245,7 +246,7 @@ func TestEnsureInternalLoadBalancer(t *testing.T) {
 				t.Errorf("Unexpected error when adding nodes %v", err)
 			}
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -256,7 +257,7 @@ func TestEnsureInternalLoadBalancer(t *testing.T) {
 
 			backendServiceName := l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
 			key := meta.RegionalKey(backendServiceName, l4.cloud.Region())
-			bs, err := composite.GetBackendService(l4.cloud, key, meta.VersionGA, klog.TODO())
+			bs, err := composite.GetBackendService(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to lookup backend service, err %v", err)
 			}
@@ -266,11 +267,11 @@ func TestEnsureInternalLoadBalancer(t *testing.T) {
 			}
 			// Add a backend list to simulate NEG linker populating the backends.
 			bs.Backends = []*composite.Backend{{Group: "test"}}
-			if err := composite.UpdateBackendService(l4.cloud, key, bs, klog.TODO()); err != nil {
+			if err := composite.UpdateBackendService(context.Background(), l4.cloud, key, bs, klog.TODO()); err != nil {
 				t.Errorf("Failed updating backend service, err %v", err)
 			}
 			// Simulate a periodic sync. The backends list should not be reconciled.
-			result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -278,7 +279,7 @@ func TestEnsureInternalLoadBalancer(t *testing.T) {
 				t.Errorf("Got empty loadBalancer status using handler %v", l4)
 			}
 			assertILBResources(t, l4, nodeNames, result.Annotations)
-			bs, err = composite.GetBackendService(l4.cloud, meta.RegionalKey(backendServiceName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
+			bs, err = composite.GetBackendService(context.Background(), l4.cloud, meta.RegionalKey(backendServiceName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to lookup backend service, err %v", err)
 			}
@@ -311,7 +312,7 @@ func TestEnsureInternalLoadBalancerTypeChange(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -323,7 +324,7 @@ func TestEnsureInternalLoadBalancerTypeChange(t *testing.T) {
 	// Now add the latest annotation and change scheme to external
 	svc.Annotations[gce.ServiceAnnotationLoadBalancerType] = ""
 	// This will be invoked by service_controller
-	if result = l4.EnsureInternalLoadBalancerDeleted(svc); result.Error != nil {
+	if result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc); result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
 	assertILBResourcesDeleted(t, l4)
@@ -358,7 +359,7 @@ func TestEnsureInternalLoadBalancerWithExistingResources(t *testing.T) {
 	// Create the expected resources necessary for an Internal Load Balancer
 	sharedHC := !servicehelper.RequestsOnlyLocalTraffic(svc)
 	defaultNetwork := network.DefaultNetwork(fakeGCE)
-	hcResult := l4.healthChecks.EnsureHealthCheckWithFirewall(l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, []string{}, *defaultNetwork, klog.TODO())
+	hcResult := l4.healthChecks.EnsureHealthCheckWithFirewall(context.Background(), l4.Service, l4.namer, sharedHC, meta.Global, utils.ILB, []string{}, *defaultNetwork, klog.TODO())
 
 	if hcResult.Err != nil {
 		t.Errorf("Failed to create healthcheck, err %v", hcResult.Err)
@@ -373,11 +374,11 @@ func TestEnsureInternalLoadBalancerWithExistingResources(t *testing.T) {
 		NetworkInfo:              defaultNetwork,
 		ConnectionTrackingPolicy: noConnectionTrackingPolicy,
 	}
-	_, _, err := l4.backendPool.EnsureL4BackendService(backendParams, klog.TODO())
+	_, _, err := l4.backendPool.EnsureL4BackendService(context.Background(), backendParams, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to create backendservice, err %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -430,7 +431,7 @@ func TestEnsureInternalLoadBalancerClearPreviousResources(t *testing.T) {
 		IPProtocol:          "TCP",
 		LoadBalancingScheme: string(cloud.SchemeInternal),
 	}
-	if err = composite.CreateForwardingRule(l4.cloud, key, existingFwdRule, klog.TODO()); err != nil {
+	if err = composite.CreateForwardingRule(context.Background(), l4.cloud, key, existingFwdRule, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake forwarding rule %s, err %v", lbName, err)
 	}
 	key.Name = lbName
@@ -455,7 +456,7 @@ func TestEnsureInternalLoadBalancerClearPreviousResources(t *testing.T) {
 	// Create a healthcheck with an incomplete fields
 	existingHC := &composite.HealthCheck{Name: hcName}
 	// hcName will be same as lbName since this service uses trafficPolicy Local. So the same key can be used.
-	if err = composite.CreateHealthCheck(fakeGCE, key, existingHC, klog.TODO()); err != nil {
+	if err = composite.CreateHealthCheck(context.Background(), fakeGCE, key, existingHC, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake healthcheck %s, err %v", hcName, err)
 	}
 
@@ -466,24 +467,24 @@ func TestEnsureInternalLoadBalancerClearPreviousResources(t *testing.T) {
 		LoadBalancingScheme: string(cloud.SchemeInternal),
 	}
 
-	if err = composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO()); err != nil {
+	if err = composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake backend service %s, err %v", lbName, err)
 	}
 	key.Name = frName
 	// Set the backend service link correctly, so that forwarding rule comparison works correctly
 	existingFwdRule.BackendService = cloud.SelfLink(meta.VersionGA, vals.ProjectID, "backendServices", meta.RegionalKey(existingBS.Name, vals.Region))
-	if err = composite.DeleteForwardingRule(fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
+	if err = composite.DeleteForwardingRule(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
 		t.Errorf("Failed to delete forwarding rule, err %v", err)
 	}
-	if err = composite.CreateForwardingRule(fakeGCE, key, existingFwdRule, klog.TODO()); err != nil {
+	if err = composite.CreateForwardingRule(context.Background(), fakeGCE, key, existingFwdRule, klog.TODO()); err != nil {
 		t.Errorf("Failed to update forwarding rule with new BS link, err %v", err)
 	}
-	if result := l4.EnsureInternalLoadBalancer(nodeNames, svc); result.Error != nil {
+	if result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc); result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer %s, err %v", lbName, result.Error)
 	}
 	key.Name = frName
 	// Expect new resources with the correct attributes to be created
-	newFwdRule, err := composite.GetForwardingRule(fakeGCE, key, meta.VersionGA, klog.TODO())
+	newFwdRule, err := composite.GetForwardingRule(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to lookup forwarding rule %s, err %v", lbName, err)
 	}
@@ -500,7 +501,7 @@ func TestEnsureInternalLoadBalancerClearPreviousResources(t *testing.T) {
 	}
 
 	key.Name = lbName
-	newHC, err := composite.GetHealthCheck(fakeGCE, key, meta.VersionGA, klog.TODO())
+	newHC, err := composite.GetHealthCheck(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to lookup healthcheck %s, err %v", lbName, err)
 	}
@@ -508,7 +509,7 @@ func TestEnsureInternalLoadBalancerClearPreviousResources(t *testing.T) {
 		t.Errorf("Expected incomplete healthcheck to be updated")
 	}
 
-	newBS, err := composite.GetBackendService(fakeGCE, key, meta.VersionGA, klog.TODO())
+	newBS, err := composite.GetBackendService(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to lookup backend service %s, err %v", lbName, err)
 	}
@@ -552,13 +553,13 @@ func TestUpdateResourceLinks(t *testing.T) {
 	}
 	key.Name = "hc1"
 	hc1 := &composite.HealthCheck{Name: "hc1"}
-	if err = composite.CreateHealthCheck(fakeGCE, key, hc1, klog.TODO()); err != nil {
+	if err = composite.CreateHealthCheck(context.Background(), fakeGCE, key, hc1, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake healthcheck hc1 , err %v", err)
 	}
 
 	key.Name = "hc2"
 	hc2 := &composite.HealthCheck{Name: "hc2"}
-	if err = composite.CreateHealthCheck(fakeGCE, key, hc2, klog.TODO()); err != nil {
+	if err = composite.CreateHealthCheck(context.Background(), fakeGCE, key, hc2, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake healthcheck hc2, err %v", err)
 	}
 
@@ -571,17 +572,17 @@ func TestUpdateResourceLinks(t *testing.T) {
 		HealthChecks:        []string{"hc1", "hc2"},
 	}
 
-	if err = composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO()); err != nil {
+	if err = composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake backend service %s, err %v", lbName, err)
 	}
-	bs, err := composite.GetBackendService(fakeGCE, key, meta.VersionGA, klog.TODO())
+	bs, err := composite.GetBackendService(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to lookup backend service")
 	}
 	if !reflect.DeepEqual(bs.HealthChecks, []string{"hc1", "hc2"}) {
 		t.Errorf("Unexpected healthchecks in backend service - %v", bs.HealthChecks)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer %s, err %v", lbName, result.Error)
 	}
@@ -590,14 +591,14 @@ func TestUpdateResourceLinks(t *testing.T) {
 
 	// ensure that the other healthchecks still exist.
 	key.Name = "hc1"
-	if hc1, err = composite.GetHealthCheck(fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
+	if hc1, err = composite.GetHealthCheck(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
 		t.Errorf("Failed to lookup healthcheck - hc1")
 	}
 	if hc1 == nil {
 		t.Errorf("Got nil healthcheck")
 	}
 	key.Name = "hc2"
-	if hc2, err = composite.GetHealthCheck(fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
+	if hc2, err = composite.GetHealthCheck(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO()); err != nil {
 		t.Errorf("Failed to lookup healthcheck - hc1")
 	}
 	if hc2 == nil {
@@ -640,15 +641,15 @@ func TestEnsureInternalLoadBalancerHealthCheckConfigurable(t *testing.T) {
 
 	// Create a healthcheck with an incorrect threshold, default value is 8s.
 	existingHC := &composite.HealthCheck{Name: hcName, CheckIntervalSec: 6000}
-	if err = composite.CreateHealthCheck(fakeGCE, key, existingHC, klog.TODO()); err != nil {
+	if err = composite.CreateHealthCheck(context.Background(), fakeGCE, key, existingHC, klog.TODO()); err != nil {
 		t.Errorf("Failed to create fake healthcheck %s, err %v", hcName, err)
 	}
 
-	if result := l4.EnsureInternalLoadBalancer(nodeNames, svc); result.Error != nil {
+	if result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc); result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer %s, err %v", lbName, result.Error)
 	}
 
-	newHC, err := composite.GetHealthCheck(fakeGCE, key, meta.VersionGA, klog.TODO())
+	newHC, err := composite.GetHealthCheck(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to lookup healthcheck %s, err %v", lbName, err)
 	}
@@ -680,7 +681,7 @@ func TestEnsureInternalLoadBalancerDeleted(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -690,7 +691,7 @@ func TestEnsureInternalLoadBalancerDeleted(t *testing.T) {
 	assertILBResources(t, l4, nodeNames, result.Annotations)
 
 	// Delete the loadbalancer.
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -720,7 +721,7 @@ func TestEnsureInternalLoadBalancerDeletedTwiceDoesNotError(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -730,14 +731,14 @@ func TestEnsureInternalLoadBalancerDeletedTwiceDoesNotError(t *testing.T) {
 	assertILBResources(t, l4, nodeNames, result.Annotations)
 
 	// Delete the loadbalancer
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
 	assertILBResourcesDeleted(t, l4)
 
 	// Deleting the loadbalancer and resources again should not cause an error.
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -760,7 +761,7 @@ func TestEnsureInternalLoadBalancerDeletedWithSharedHC(t *testing.T) {
 	}
 
 	// Delete the loadbalancer.
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -800,7 +801,7 @@ func TestHealthCheckFirewallDeletionWithNetLB(t *testing.T) {
 	l4NetLB.healthChecks = l4.healthChecks
 
 	// create netlb resources
-	xlbResult := l4NetLB.EnsureFrontend(nodeNames, netlbSvc, time.Now())
+	xlbResult := l4NetLB.EnsureFrontend(context.Background(), nodeNames, netlbSvc, time.Now())
 	if xlbResult.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", xlbResult.Error)
 	}
@@ -811,7 +812,7 @@ func TestHealthCheckFirewallDeletionWithNetLB(t *testing.T) {
 	assertNetLBResources(t, l4NetLB, nodeNames)
 
 	// Delete the ILB loadbalancer
-	result = l4.EnsureInternalLoadBalancerDeleted(ilbSvc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), ilbSvc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -850,7 +851,7 @@ func ensureService(fakeGCE *gce.Cloud, namer *namer_util.L4Namer, nodeNames []st
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, zoneName); err != nil {
 		return nil, nil, &L4ILBSyncResult{Error: fmt.Errorf("Unexpected error when adding nodes %v", err)}
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		return nil, nil, result
 	}
@@ -889,7 +890,7 @@ func TestEnsureInternalLoadBalancerWithSpecialHealthCheck(t *testing.T) {
 	svc.Spec.Type = v1.ServiceTypeLoadBalancer
 	svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
 
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -903,7 +904,7 @@ func TestEnsureInternalLoadBalancerWithSpecialHealthCheck(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	hc, err := composite.GetHealthCheck(l4.cloud, key, meta.VersionGA, klog.TODO())
+	hc, err := composite.GetHealthCheck(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil || hc == nil {
 		t.Errorf("Failed to get healthcheck, err %v", err)
 	}
@@ -1004,14 +1005,14 @@ func TestEnsureInternalLoadBalancerErrors(t *testing.T) {
 				t.Errorf("Unexpected error when adding nodes %v", err)
 			}
 			// Create a dummy forwarding rule in order to trigger a delete in the EnsureInternalLoadBalancer function.
-			if err = composite.CreateForwardingRule(l4.cloud, key, &composite.ForwardingRule{Name: frName}, klog.TODO()); err != nil {
+			if err = composite.CreateForwardingRule(context.Background(), l4.cloud, key, &composite.ForwardingRule{Name: frName}, klog.TODO()); err != nil {
 				t.Errorf("Failed to create fake forwarding rule %s, err %v", frName, err)
 			}
 			// Inject error hooks after creating the forwarding rule.
 			if tc.injectMock != nil {
 				tc.injectMock(fakeGCE.Compute().(*cloud.MockGCE))
 			}
-			result := l4.EnsureInternalLoadBalancer(nodeNames, params.service)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, params.service)
 			if result.Error == nil {
 				t.Errorf("Expected error when %s", desc)
 			}
@@ -1088,7 +1089,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
 	frName := l4.GetFRName()
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1099,7 +1100,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 
 	// Change service to include the global access annotation
 	svc.Annotations[gce.ServiceAnnotationILBAllowGlobalAccess] = "true"
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1115,7 +1116,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1127,7 +1128,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 	}
 	// remove the annotation and disable global access.
 	delete(svc.Annotations, gce.ServiceAnnotationILBAllowGlobalAccess)
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1135,7 +1136,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 		t.Errorf("Got empty loadBalancer status using handler %v", l4)
 	}
 	// make sure GlobalAccess field is off.
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1148,7 +1149,7 @@ func TestEnsureInternalLoadBalancerEnableGlobalAccess(t *testing.T) {
 	}
 	assertILBResources(t, l4, nodeNames, result.Annotations)
 	// Delete the service
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -1177,7 +1178,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1187,7 +1188,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 	assertILBResourcesWithCustomSubnet(t, l4, nodeNames, result.Annotations, l4.cloud.SubnetworkURL())
 
 	frName := l4.GetFRName()
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil || fwdRule == nil {
 		t.Errorf("Unexpected error looking up forwarding rule - err %v", err)
 	}
@@ -1213,7 +1214,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 	requestedIP := "4.5.6.7"
 	svc.Annotations[gce.ServiceAnnotationILBSubnet] = subnetNames[0]
 	svc.Spec.LoadBalancerIP = requestedIP
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if err != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", err)
 	}
@@ -1227,7 +1228,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 
 	// Change to a different subnet
 	svc.Annotations[gce.ServiceAnnotationILBSubnet] = subnetNames[1]
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1241,7 +1242,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 
 	// Verify new annotation "networking.gke.io/load-balancer-subnet" works and get prioritized.
 	svc.Annotations[annotations.CustomSubnetAnnotationKey] = subnetNames[2]
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1256,7 +1257,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 	// remove both annotations - ILB should revert to default subnet.
 	delete(svc.Annotations, gce.ServiceAnnotationILBSubnet)
 	delete(svc.Annotations, annotations.CustomSubnetAnnotationKey)
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1264,7 +1265,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 	if len(result.Status.Ingress) == 0 {
 		t.Errorf("Got empty loadBalancer status using handler %v", l4)
 	}
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil || fwdRule == nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -1272,7 +1273,7 @@ func TestEnsureInternalLoadBalancerCustomSubnet(t *testing.T) {
 		t.Errorf("Unexpected subnet value '%s' in ILB ForwardingRule.", fwdRule.Subnetwork)
 	}
 	// Delete the loadbalancer
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 	}
@@ -1327,7 +1328,7 @@ func TestDualStackILBBadCustomSubnet(t *testing.T) {
 
 			svc.Annotations[annotations.CustomSubnetAnnotationKey] = customBadSubnetName
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error == nil {
 				t.Fatalf("Expected error ensuring internal dualstack loadbalancer in bad subnet, got: %v", result.Error)
 			}
@@ -1380,7 +1381,7 @@ func TestInternalLBBadCustomSubnet(t *testing.T) {
 				}
 			}
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if tc.existingSubnetwork {
 				if result.Error != nil {
 					t.Errorf("Unexpected error when existing subnet specified: %v", result.Error)
@@ -1533,7 +1534,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	}
 
 	frName := l4.getFRNameWithProtocol("TCP")
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1545,7 +1546,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1554,7 +1555,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	}
 	// change the protocol to UDP
 	svc.Spec.Ports[0].Protocol = v1.ProtocolUDP
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1563,7 +1564,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	}
 	assertILBResources(t, l4, nodeNames, result.Annotations)
 	// Make sure the old forwarding rule is deleted
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if !utils.IsNotFoundError(err) {
 		t.Errorf("Failed to delete ForwardingRule %s", frName)
 	}
@@ -1571,7 +1572,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	if key, err = composite.CreateKey(l4.cloud, frName, meta.Regional); err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	if fwdRule, err = composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO()); err != nil {
+	if fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO()); err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
 	if fwdRule.IPProtocol != "UDP" {
@@ -1581,7 +1582,7 @@ func TestEnsureInternalLoadBalancerModifyProtocol(t *testing.T) {
 	// on final deletion of Load Balancer we don't need to check if address was reserved (which was happening in the hook)
 	c.MockForwardingRules.DeleteHook = nil
 	// Delete the service
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -1676,7 +1677,7 @@ func TestDualStackInternalLoadBalancerModifyProtocol(t *testing.T) {
 				t.Errorf("Unexpected error when adding nodes %v", err)
 			}
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -1685,7 +1686,7 @@ func TestDualStackInternalLoadBalancerModifyProtocol(t *testing.T) {
 
 			// Change Protocol and trigger sync
 			svc.Spec.Ports[0].Protocol = v1.ProtocolUDP
-			result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -1693,7 +1694,7 @@ func TestDualStackInternalLoadBalancerModifyProtocol(t *testing.T) {
 			assertDualStackILBResources(t, l4, nodeNames)
 
 			c.MockForwardingRules.DeleteHook = nil
-			l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+			l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 			assertDualStackILBResourcesDeleted(t, l4)
 		})
 	}
@@ -1737,7 +1738,7 @@ func TestDualStackInternalLoadBalancerModifyPorts(t *testing.T) {
 			svc := test.NewL4ILBDualStackService(8080, v1.ProtocolTCP, tc.ipFamilies, v1.ServiceExternalTrafficPolicyTypeCluster)
 			l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -1746,14 +1747,14 @@ func TestDualStackInternalLoadBalancerModifyPorts(t *testing.T) {
 
 			// Change Protocol and trigger sync
 			svc.Spec.Ports[0].Port = 80
-			result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
 			l4.Service.Annotations = result.Annotations
 			assertDualStackILBResources(t, l4, nodeNames)
 
-			l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+			l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 			assertDualStackILBResourcesDeleted(t, l4)
 		})
 	}
@@ -1782,7 +1783,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1795,7 +1796,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1811,7 +1812,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		{Name: "testport", Port: int32(8300), Protocol: "TCP"},
 		{Name: "testport", Port: int32(8400), Protocol: "TCP"},
 	}
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1819,7 +1820,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		t.Errorf("Got empty loadBalancer status using handler %v", l4)
 	}
 	assertILBResources(t, l4, nodeNames, result.Annotations)
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1837,7 +1838,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		{Name: "testport", Port: int32(8400), Protocol: "TCP"},
 	}
 	expectPorts := []string{"8090", "8100", "8300", "8400"}
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1845,7 +1846,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		t.Errorf("Got empty loadBalancer status using handler %v", l4)
 	}
 	assertILBResources(t, l4, nodeNames, result.Annotations)
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, key, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1856,7 +1857,7 @@ func TestEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		t.Errorf("Expected AllPorts field to be unset in forwarding rule - %+v", fwdRule)
 	}
 	// Delete the service
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -1871,7 +1872,7 @@ func TestIPv6OnlyEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 	l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
 	// Initial creation with port 8080
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1884,7 +1885,7 @@ func TestIPv6OnlyEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error when creating key - %v", err)
 	}
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, frKey, meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, frKey, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1901,14 +1902,14 @@ func TestIPv6OnlyEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		{Name: "testport", Port: int32(8300), Protocol: "TCP"},
 		{Name: "testport", Port: int32(8400), Protocol: "TCP"},
 	}
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
 	l4.Service.Annotations = result.Annotations
 	assertDualStackILBResources(t, l4, nodeNames)
 
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, frKey, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, frKey, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1927,14 +1928,14 @@ func TestIPv6OnlyEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		{Name: "testport", Port: int32(8400), Protocol: "TCP"},
 	}
 	expectPorts := []string{"8090", "8100", "8300", "8400"}
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
 	l4.Service.Annotations = result.Annotations
 	assertDualStackILBResources(t, l4, nodeNames)
 
-	fwdRule, err = composite.GetForwardingRule(l4.cloud, frKey, meta.VersionGA, klog.TODO())
+	fwdRule, err = composite.GetForwardingRule(context.Background(), l4.cloud, frKey, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Unexpected error when looking up forwarding rule - %v", err)
 	}
@@ -1945,7 +1946,7 @@ func TestIPv6OnlyEnsureInternalLoadBalancerAllPorts(t *testing.T) {
 		t.Errorf("Expected AllPorts field to be unset in forwarding rule - %+v", fwdRule)
 	}
 	// Delete the service
-	result = l4.EnsureInternalLoadBalancerDeleted(svc)
+	result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error %v", result.Error)
 	}
@@ -1997,7 +1998,7 @@ func TestEnsureInternalDualStackLoadBalancer(t *testing.T) {
 			svc := test.NewL4ILBDualStackService(8080, v1.ProtocolTCP, tc.ipFamilies, tc.trafficPolicy)
 			l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -2007,7 +2008,7 @@ func TestEnsureInternalDualStackLoadBalancer(t *testing.T) {
 			l4.Service.Annotations = result.Annotations
 			assertDualStackILBResources(t, l4, nodeNames)
 
-			l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+			l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 			assertDualStackILBResourcesDeleted(t, l4)
 		})
 	}
@@ -2183,7 +2184,7 @@ func TestDualStackILBTransitions(t *testing.T) {
 
 			l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			svc.Annotations = result.Annotations
 			assertDualStackILBResources(t, l4, nodeNames)
 
@@ -2191,11 +2192,11 @@ func TestDualStackILBTransitions(t *testing.T) {
 			finalSvc.Annotations = svc.Annotations
 			l4.Service = finalSvc
 
-			result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			finalSvc.Annotations = result.Annotations
 			assertDualStackILBResources(t, l4, nodeNames)
 
-			l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+			l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 			assertDualStackILBResourcesDeleted(t, l4)
 		})
 	}
@@ -2229,7 +2230,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 	l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	svc.Annotations = result.Annotations
 	assertDualStackILBResources(t, l4, nodeNames)
 
@@ -2241,7 +2242,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol}
 
 	// Run new sync. Controller should not delete resources, if they don't exist in annotation
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	svc.Annotations = result.Annotations
 
 	ipv6FWName := l4.namer.L4IPv6Firewall(l4.Service.Namespace, l4.Service.Name)
@@ -2257,7 +2258,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 		t.Errorf("forwarding rule %s was deleted, expected not", ipv6FRName)
 	}
 
-	l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+	l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 	// After complete deletion, IPv6 and IPv4 resources should be cleaned up, even if the were leaked
 	assertDualStackILBResourcesDeleted(t, l4)
 }
@@ -2270,7 +2271,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 	l4 := mustSetupILBTestHandler(t, svc, nodeNames)
 
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}
-	result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	svc.Annotations = result.Annotations
 	assertDualStackILBResources(t, l4, nodeNames)
 
@@ -2282,7 +2283,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
 
 	// Run new sync. Controller should not delete resources, if they don't exist in annotation
-	result = l4.EnsureInternalLoadBalancer(nodeNames, svc)
+	result = l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 	svc.Annotations = result.Annotations
 
 	// Verify IPv6 Firewall was not deleted
@@ -2299,7 +2300,7 @@ func TestDualStackILBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 		t.Errorf("forwarding rule %s was deleted, expected not", ipv4FRName)
 	}
 
-	l4.EnsureInternalLoadBalancerDeleted(l4.Service)
+	l4.EnsureInternalLoadBalancerDeleted(context.Background(), l4.Service)
 	// After complete deletion, IPv6 and IPv4 resources should be cleaned up, even if the were leaked
 	assertDualStackILBResourcesDeleted(t, l4)
 }
@@ -2362,7 +2363,7 @@ func TestDualStackILBStaticIPAnnotation(t *testing.T) {
 			svc.Annotations[annotations.StaticL4AddressesAnnotationKey] = tc.staticAnnotationVal
 
 			svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -2383,7 +2384,7 @@ func TestDualStackILBStaticIPAnnotation(t *testing.T) {
 			}
 
 			// Delete the loadbalancer
-			result = l4.EnsureInternalLoadBalancerDeleted(svc)
+			result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 			if result.Error != nil {
 				t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 			}
@@ -2455,7 +2456,7 @@ func TestIPv6OnlyILBStaticIPAnnotation(t *testing.T) {
 			}
 			svc.Annotations[annotations.StaticL4AddressesAnnotationKey] = tc.staticAnnotationVal
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -2473,7 +2474,7 @@ func TestIPv6OnlyILBStaticIPAnnotation(t *testing.T) {
 			}
 
 			// Delete the loadbalancer
-			result = l4.EnsureInternalLoadBalancerDeleted(svc)
+			result = l4.EnsureInternalLoadBalancerDeleted(context.Background(), svc)
 			if result.Error != nil {
 				t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 			}
@@ -2496,6 +2497,7 @@ func TestWeightedILB(t *testing.T) {
 	tests := []struct {
 		desc                     string
 		addAnnotationForWeighted bool
+		weightedAnnotationValue  string
 		weightedFlagEnabled      bool
 		externalTrafficPolicy    v1.ServiceExternalTrafficPolicy
 		wantLocalityLBPolicy     backends.LocalityLBPolicyType
@@ -2556,6 +2558,22 @@ func TestWeightedILB(t *testing.T) {
 			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeCluster,
 			wantLocalityLBPolicy:     backends.LocalityLBPolicyDefault,
 		},
+		{
+			desc:                     "Flag enabled, Service with endpoint-hints weighted annotation, externalTrafficPolicy local",
+			addAnnotationForWeighted: true,
+			weightedAnnotationValue:  annotations.WeightedL4AnnotationEndpointHints,
+			weightedFlagEnabled:      true,
+			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeLocal,
+			wantLocalityLBPolicy:     backends.LocalityLBPolicyWeightedRendezvous,
+		},
+		{
+			desc:                     "Flag enabled, Service with endpoint-hints weighted annotation, externalTrafficPolicy cluster",
+			addAnnotationForWeighted: true,
+			weightedAnnotationValue:  annotations.WeightedL4AnnotationEndpointHints,
+			weightedFlagEnabled:      true,
+			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeCluster,
+			wantLocalityLBPolicy:     backends.LocalityLBPolicyWeightedRendezvous,
+		},
 	}
 
 	for _, tc := range tests {
@@ -2565,8 +2583,11 @@ func TestWeightedILB(t *testing.T) {
 
 			svc := test.NewL4ILBService(false, 8080)
 			svc.Spec.ExternalTrafficPolicy = tc.externalTrafficPolicy
+			if tc.weightedAnnotationValue == "" {
+				tc.weightedAnnotationValue = annotations.WeightedL4AnnotationPodsPerNode
+			}
 			if tc.addAnnotationForWeighted {
-				svc.Annotations[annotations.WeightedL4AnnotationKey] = annotations.WeightedL4AnnotationPodsPerNode
+				svc.Annotations[annotations.WeightedL4AnnotationKey] = tc.weightedAnnotationValue
 			}
 			nodeNames := []string{"test-node-1"}
 			vals := gce.DefaultTestClusterValues()
@@ -2591,13 +2612,13 @@ func TestWeightedILB(t *testing.T) {
 				t.Errorf("Unexpected error when adding nodes %v", err)
 			}
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Fatalf("Failed to ensure internal loadBalancer, err %v", result.Error)
 			}
 			backendServiceName := l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
 			key := meta.RegionalKey(backendServiceName, l4.cloud.Region())
-			bs, err := composite.GetBackendService(l4.cloud, key, meta.VersionGA, klog.TODO())
+			bs, err := composite.GetBackendService(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to read BackendService, %v", err)
 			}
@@ -2607,7 +2628,7 @@ func TestWeightedILB(t *testing.T) {
 			}
 
 			isWeightedLBPodsPerNode := l4.isWeightedLBPodsPerNode()
-			if tc.weightedFlagEnabled && tc.addAnnotationForWeighted && tc.externalTrafficPolicy == v1.ServiceExternalTrafficPolicyTypeLocal && !isWeightedLBPodsPerNode {
+			if tc.weightedFlagEnabled && tc.addAnnotationForWeighted && tc.weightedAnnotationValue == annotations.WeightedL4AnnotationPodsPerNode && tc.externalTrafficPolicy == v1.ServiceExternalTrafficPolicyTypeLocal && !isWeightedLBPodsPerNode {
 				t.Errorf("Expected isWeightedLBPodsPerNode() to return true for Service with weighted load balancing enabled")
 			}
 		})
@@ -2697,13 +2718,13 @@ func TestZonalAffinity(t *testing.T) {
 				t.Errorf("Unexpected error when adding nodes %v", err)
 			}
 
-			result := l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			result := l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 			if result.Error != nil {
 				t.Fatalf("Failed to ensure internal loadBalancer, err %v", result.Error)
 			}
 			backendServiceName := l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
 			key := meta.RegionalKey(backendServiceName, l4.cloud.Region())
-			bs, err := composite.GetBackendService(l4.cloud, key, meta.VersionAlpha, klog.TODO())
+			bs, err := composite.GetBackendService(context.Background(), l4.cloud, key, meta.VersionAlpha, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to read BackendService, %v", err)
 			}
@@ -2963,7 +2984,7 @@ func getAndVerifyILBHealthCheck(l4 *L4) (*composite.HealthCheck, error) {
 	isSharedHC := !servicehelper.RequestsOnlyLocalTraffic(l4.Service)
 	hcName := l4.namer.L4HealthCheck(l4.Service.Namespace, l4.Service.Name, isSharedHC)
 
-	healthcheck, err := composite.GetHealthCheck(l4.cloud, meta.GlobalKey(hcName), meta.VersionGA, klog.TODO())
+	healthcheck, err := composite.GetHealthCheck(context.Background(), l4.cloud, meta.GlobalKey(hcName), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch healthcheck %s - err %w", hcName, err)
 	}
@@ -2985,7 +3006,7 @@ func getAndVerifyILBHealthCheck(l4 *L4) (*composite.HealthCheck, error) {
 func getAndVerifyILBBackendService(l4 *L4, healthCheck *composite.HealthCheck) (*composite.BackendService, error) {
 	backendServiceName := l4.namer.L4Backend(l4.Service.Namespace, l4.Service.Name)
 	key := meta.RegionalKey(backendServiceName, l4.cloud.Region())
-	bs, err := composite.GetBackendService(l4.cloud, key, meta.VersionGA, klog.TODO())
+	bs, err := composite.GetBackendService(context.Background(), l4.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch backend service %s - err %w", backendServiceName, err)
 	}
@@ -3026,7 +3047,7 @@ func verifyILBIPv6ForwardingRule(l4 *L4, backendServiceLink, expectedSubnet stri
 }
 
 func verifyILBForwardingRule(l4 *L4, frName, backendServiceLink, expectedSubnet, expectedNetworkURL string) error {
-	fwdRule, err := composite.GetForwardingRule(l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4.cloud, meta.RegionalKey(frName, l4.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return fmt.Errorf("failed to fetch forwarding rule %s - err %w", frName, err)
 	}
@@ -3289,6 +3310,129 @@ func createVMInstanceWithTag(t *testing.T, fakeGCE *gce.Cloud, name, tag string)
 	}
 }
 
+func TestEnsureInternalLoadBalancer_L4LBConfigConnectionTracking(t *testing.T) {
+	perSession := &composite.BackendServiceConnectionTrackingPolicy{
+		TrackingMode:                             "PER_SESSION",
+		ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST",
+		IdleTimeoutSec:                           1200,
+	}
+	neverPersist := &composite.BackendServiceConnectionTrackingPolicy{
+		TrackingMode:                             "PER_CONNECTION",
+		ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST",
+		IdleTimeoutSec:                           600,
+	}
+
+	testCases := []struct {
+		desc                 string
+		existingPolicy       *composite.BackendServiceConnectionTrackingPolicy
+		hasAnnotation        bool
+		crdConfig            *l4lbconfigv1.ConnectionTrackingConfig
+		expectedPolicy       *composite.BackendServiceConnectionTrackingPolicy
+		expectNotFoundEvents int
+	}{
+		{
+			desc:           "Flag ON, CRD sets the policy",
+			existingPolicy: perSession,
+			hasAnnotation:  true,
+			crdConfig:      &l4lbconfigv1.ConnectionTrackingConfig{ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST"},
+			expectedPolicy: neverPersist,
+		},
+		{
+			desc:           "Flag ON, no annotation resets the policy",
+			existingPolicy: perSession,
+			expectedPolicy: l4lbconfig.DefaultConnectionTrackingPolicy(),
+		},
+		{
+			desc:           "Flag ON, CRD field omitted resets the policy",
+			existingPolicy: perSession,
+			hasAnnotation:  true,
+			expectedPolicy: l4lbconfig.DefaultConnectionTrackingPolicy(),
+		},
+		{
+			desc:                 "Flag ON, CRD deleted resets the policy and warns once",
+			existingPolicy:       perSession,
+			hasAnnotation:        true,
+			expectedPolicy:       l4lbconfig.DefaultConnectionTrackingPolicy(),
+			expectNotFoundEvents: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			oldManage, oldLogging := flags.F.ManageL4LBConnectionTracking, flags.F.ManageL4LBLogging
+			defer func() {
+				flags.F.ManageL4LBConnectionTracking, flags.F.ManageL4LBLogging = oldManage, oldLogging
+			}()
+			flags.F.ManageL4LBConnectionTracking = true
+			// Logging also reads the L4LBConfig, a missing config must be reported once.
+			flags.F.ManageL4LBLogging = true
+
+			vals := gce.DefaultTestClusterValues()
+			fakeGCE := getFakeGCECloud(vals)
+			nodeNames := []string{"test-node-1"}
+			svc := test.NewL4ILBService(false, 8080)
+
+			configName := "l4-config"
+			lister := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			if tc.hasAnnotation {
+				svc.Annotations[annotations.L4LBConfigKey] = configName
+				if tc.expectNotFoundEvents == 0 {
+					lister.Add(&l4lbconfigv1.L4LBConfig{
+						ObjectMeta: metav1.ObjectMeta{Name: configName, Namespace: svc.Namespace},
+						Spec:       l4lbconfigv1.L4LBConfigSpec{ConnectionTracking: tc.crdConfig},
+					})
+				}
+			}
+
+			bsName := namer_util.NewL4Namer(kubeSystemUID, nil).L4Backend(svc.Namespace, svc.Name)
+			key := meta.RegionalKey(bsName, vals.Region)
+			existingBS := &composite.BackendService{
+				Name:                     bsName,
+				ConnectionTrackingPolicy: tc.existingPolicy,
+				Protocol:                 "TCP",
+			}
+			if err := composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
+				t.Errorf("Failed to create fake backend service %s, err %v", bsName, err)
+			}
+
+			recorder := record.NewFakeRecorder(100)
+			l4 := NewL4Handler(&L4ILBParams{
+				Service:          svc,
+				Cloud:            fakeGCE,
+				Namer:            namer_util.NewL4Namer(kubeSystemUID, nil),
+				Recorder:         recorder,
+				NetworkResolver:  network.NewFakeResolver(network.DefaultNetwork(fakeGCE)),
+				L4LBConfigLister: lister,
+			}, klog.TODO())
+			l4.healthChecks = healthchecks.Fake(fakeGCE, l4.recorder)
+
+			if _, err := test.CreateAndInsertNodes(l4.cloud, nodeNames, vals.ZoneName); err != nil {
+				t.Errorf("Unexpected error when adding nodes %v", err)
+			}
+
+			l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
+
+			finalBS, err := composite.GetBackendService(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
+			if err != nil {
+				t.Fatalf("Failed to get backend service %s, err %v", bsName, err)
+			}
+			if diff := cmp.Diff(tc.expectedPolicy, finalBS.ConnectionTrackingPolicy); diff != "" {
+				t.Errorf("BackendService ConnectionTrackingPolicy mismatch (-want +got):\n%s", diff)
+			}
+			notFoundEvents := 0
+			close(recorder.Events)
+			for event := range recorder.Events {
+				if strings.Contains(event, l4lbconfig.ReasonL4LBConfigNotFound) {
+					notFoundEvents++
+				}
+			}
+			if notFoundEvents != tc.expectNotFoundEvents {
+				t.Errorf("Got %d %s events, want %d", notFoundEvents, l4lbconfig.ReasonL4LBConfigNotFound, tc.expectNotFoundEvents)
+			}
+		})
+	}
+}
+
 func TestEnsureInternalLoadBalancer_L4LBConfigLogging(t *testing.T) {
 	loggingEnabled := &composite.BackendServiceLogConfig{Enable: true, SampleRate: 1.0, OptionalMode: "EXCLUDE_ALL_OPTIONAL"}
 	loggingDisabled := &composite.BackendServiceLogConfig{Enable: false}
@@ -3407,7 +3551,7 @@ func TestEnsureInternalLoadBalancer_L4LBConfigLogging(t *testing.T) {
 					LogConfig: tc.existingGCEConfig,
 					Protocol:  "TCP",
 				}
-				if err := composite.CreateBackendService(fakeGCE, key, existingBS, klog.TODO()); err != nil {
+				if err := composite.CreateBackendService(context.Background(), fakeGCE, key, existingBS, klog.TODO()); err != nil {
 					t.Errorf("Failed to create fake backend service %s, err %v", bsName, err)
 				}
 			}
@@ -3427,11 +3571,11 @@ func TestEnsureInternalLoadBalancer_L4LBConfigLogging(t *testing.T) {
 			}
 
 			// Execute sync
-			l4.EnsureInternalLoadBalancer(nodeNames, svc)
+			l4.EnsureInternalLoadBalancer(context.Background(), nodeNames, svc)
 
 			// Verification
 			key := meta.RegionalKey(bsName, fakeGCE.Region())
-			finalBS, _ := composite.GetBackendService(fakeGCE, key, meta.VersionGA, klog.TODO())
+			finalBS, _ := composite.GetBackendService(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 
 			if diff := cmp.Diff(tc.expectedGCEConfig, finalBS.LogConfig); diff != "" {
 				t.Errorf("BackendService LogConfig mismatch (-want +got):\n%s", diff)
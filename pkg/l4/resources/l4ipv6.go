@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"k8s.io/ingress-gce/pkg/l4/backends"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/ingress-gce/pkg/firewalls"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 
 	"k8s.io/cloud-provider-gcp/providers/gce"
@@ -38,8 +40,8 @@ import (
 // - IPv6 Forwarding Rule
 // - IPv6 Firewall
 // it also adds IPv6 address to LB status
-func (l4 *L4) ensureIPv6Resources(syncResult *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bsLink string, existingIPv6FwdRule *composite.ForwardingRule, ipv6AddressToUse string) {
-	ipv6fr, fwdRuleSyncStatus, err := l4.ensureIPv6ForwardingRule(bsLink, options, existingIPv6FwdRule, ipv6AddressToUse)
+func (l4 *L4) ensureIPv6Resources(ctx context.Context, syncResult *L4ILBSyncResult, nodeNames []string, options gce.ILBOptions, bsLink string, existingIPv6FwdRule *composite.ForwardingRule, ipv6AddressToUse string) {
+	ipv6fr, fwdRuleSyncStatus, err := l4.ensureIPv6ForwardingRule(ctx, bsLink, options, existingIPv6FwdRule, ipv6AddressToUse)
 	syncResult.ResourceUpdates.SetForwardingRule(fwdRuleSyncStatus)
 	if err != nil {
 		l4.svcLogger.Error(err, "ensureIPv6Resources: Failed to ensure ipv6 forwarding rule")
@@ -75,9 +77,9 @@ func (l4 *L4) ensureIPv6Resources(syncResult *L4ILBSyncResult, nodeNames []strin
 // Checking for annotation saves us from emitting too much error logs "Resource not found".
 // If annotation was deleted, but resource still exists, it will be left till the Service deletion,
 // where we delete all resources, no matter if they exist in annotations.
-func (l4 *L4) deleteIPv6ResourcesOnSync(syncResult *L4ILBSyncResult) {
+func (l4 *L4) deleteIPv6ResourcesOnSync(ctx context.Context, syncResult *L4ILBSyncResult) {
 	l4.svcLogger.Info("Deleting IPv6 resources for L4 ILB Service on sync, with checking for existence in annotation")
-	l4.deleteIPv6ResourcesAnnotationBased(syncResult, true)
+	l4.deleteIPv6ResourcesAnnotationBased(ctx, syncResult, true)
 }
 
 // deleteIPv6ResourcesOnDelete deletes all resources specific to IPv6.
@@ -85,9 +87,9 @@ func (l4 *L4) deleteIPv6ResourcesOnSync(syncResult *L4ILBSyncResult) {
 // During sync, we delete resources only that exist in annotations,
 // so they could be leaked, if annotation was deleted.
 // That's why on service deletion we delete all IPv4 resources, ignoring their existence in annotations
-func (l4 *L4) deleteIPv6ResourcesOnDelete(syncResult *L4ILBSyncResult) {
+func (l4 *L4) deleteIPv6ResourcesOnDelete(ctx context.Context, syncResult *L4ILBSyncResult) {
 	l4.svcLogger.Info("Deleting IPv6 resources for L4 ILB Service on delete, without checking for existence in annotation")
-	l4.deleteIPv6ResourcesAnnotationBased(syncResult, false)
+	l4.deleteIPv6ResourcesAnnotationBased(ctx, syncResult, false)
 }
 
 // deleteIPv6ResourcesAnnotationBased deletes IPv6 only resources with checking,
@@ -97,7 +99,7 @@ func (l4 *L4) deleteIPv6ResourcesOnDelete(syncResult *L4ILBSyncResult) {
 // - IPv6 Firewall
 // This function does not delete Backend Service and Health Check, because they are shared between IPv4 and IPv6.
 // IPv6 Firewall Rule for Health Check also will not be deleted here, and will be left till the Service Deletion.
-func (l4 *L4) deleteIPv6ResourcesAnnotationBased(syncResult *L4ILBSyncResult, shouldCheckAnnotations bool) {
+func (l4 *L4) deleteIPv6ResourcesAnnotationBased(ctx context.Context, syncResult *L4ILBSyncResult, shouldCheckAnnotations bool) {
 	hasFwdRuleAnnotation := l4.hasAnnotation(annotations.TCPForwardingRuleIPv6Key) ||
 		l4.hasAnnotation(annotations.UDPForwardingRuleIPv6Key) ||
 		l4.hasAnnotation(annotations.L3ForwardingRuleIPv6Key)
@@ -105,9 +107,9 @@ func (l4 *L4) deleteIPv6ResourcesAnnotationBased(syncResult *L4ILBSyncResult, sh
 	if !shouldCheckAnnotations || hasFwdRuleAnnotation {
 		var err error
 		if l4.enableDualStack && l4.enableMixedProtocol {
-			err = l4.deleteAllIPv6ForwardingRules()
+			err = l4.deleteAllIPv6ForwardingRules(ctx)
 		} else {
-			err = l4.deleteIPv6ForwardingRule()
+			err = l4.deleteIPv6ForwardingRule(ctx)
 		}
 		if err != nil {
 			l4.svcLogger.Error(err, "Failed to delete ipv6 forwarding rule for internal loadbalancer service")
@@ -139,6 +141,7 @@ func (l4 *L4) getIPv6FRNameWithProtocol(protocol string) string {
 }
 
 func (l4 *L4) ensureIPv6NodesFirewall(ipAddress string, nodeNames []string, result *L4ILBSyncResult) {
+	defer l4.syncTrace.Track(synctimeline.PhaseFirewalls)()
 	// DisableL4LBFirewall flag disables L4 FW enforcment to remove conflicts with firewall policies
 	if l4.disableNodesFirewallProvisioning {
 		l4.svcLogger.Info("Skipped ensuring IPv6 nodes firewall for L4 ILB Service to enable compatibility with firewall policies. " +
@@ -196,7 +199,7 @@ func (l4 *L4) ensureIPv6NodesFirewall(ipAddress string, nodeNames []string, resu
 	result.Annotations[annotations.FirewallRuleIPv6Key] = firewallName
 }
 
-func (l4 *L4) deleteIPv6ForwardingRule() error {
+func (l4 *L4) deleteIPv6ForwardingRule(ctx context.Context) error {
 	start := time.Now()
 
 	ipv6FrName := l4.getIPv6FRName()
@@ -206,13 +209,13 @@ func (l4 *L4) deleteIPv6ForwardingRule() error {
 		l4.svcLogger.V(2).Info("Finished deleting IPv6 forwarding rule for L4 ILB Service", "forwardingRuleName", ipv6FrName, "timeTaken", time.Since(start))
 	}()
 
-	return l4.forwardingRules.Delete(ipv6FrName)
+	return l4.forwardingRules.Delete(ctx, ipv6FrName)
 }
 
 // When switching from for example IPv6 TCP to IPv4 UDP, simply using GetFRName()
 // to delete won't be accurate since it will try to delete *IPv6 UDP* name not *TCP*,
 // and the UDP forwarding rule will be leaked.
-func (l4 *L4) deleteAllIPv6ForwardingRules() error {
+func (l4 *L4) deleteAllIPv6ForwardingRules(ctx context.Context) error {
 	start := time.Now()
 
 	frNameTCP := l4.getIPv6FRNameWithProtocol(forwardingrules.ProtocolTCP)
@@ -225,9 +228,9 @@ func (l4 *L4) deleteAllIPv6ForwardingRules() error {
 		l4.svcLogger.Info("Finished deleting all IPv6 forwarding rule for L4 ILB Service", "forwardingRuleNameTCP", frNameTCP, "forwardingRuleNameUDP", frNameUDP, "forwardingRuleNameL3", frNameL3, "timeTaken", time.Since(start))
 	}()
 
-	errTCP := l4.forwardingRules.Delete(frNameTCP)
-	errUDP := l4.forwardingRules.Delete(frNameUDP)
-	errL3 := l4.forwardingRules.Delete(frNameL3)
+	errTCP := l4.forwardingRules.Delete(ctx, frNameTCP)
+	errUDP := l4.forwardingRules.Delete(ctx, frNameUDP)
+	errL3 := l4.forwardingRules.Delete(ctx, frNameL3)
 
 	return errors.Join(errTCP, errUDP, errL3)
 }
@@ -248,7 +251,7 @@ func (l4 *L4) deleteIPv6NodesFirewall() error {
 // getOldIPv6ForwardingRule returns old IPv6 forwarding rule, with checking backend service protocol, if it exists.
 // This is useful when switching protocols of the service,
 // because forwarding rule name depends on the protocol, and we need to get forwarding rule from the old protocol name.
-func (l4 *L4) getOldIPv6ForwardingRule(existingBS *composite.BackendService) (*composite.ForwardingRule, error) {
+func (l4 *L4) getOldIPv6ForwardingRule(ctx context.Context, existingBS *composite.BackendService) (*composite.ForwardingRule, error) {
 	servicePorts := l4.Service.Spec.Ports
 	bsProtocol := string(utils.GetProtocol(servicePorts))
 
@@ -265,7 +268,7 @@ func (l4 *L4) getOldIPv6ForwardingRule(existingBS *composite.BackendService) (*c
 		oldIPv6FRName = l4.getIPv6FRNameWithProtocol(fwdRuleProtocol)
 	}
 
-	return l4.forwardingRules.Get(oldIPv6FRName)
+	return l4.forwardingRules.Get(ctx, oldIPv6FRName)
 }
 
 func (l4 *L4) serviceSubnetHasInternalIPv6Range() error {
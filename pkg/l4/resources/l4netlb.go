@@ -17,6 +17,8 @@ limitations under the License.
 package resources
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -31,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/cloud-provider/service/helpers"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/firewalls"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/address"
@@ -40,6 +43,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/healthchecks"
 	"k8s.io/ingress-gce/pkg/l4/metrics"
 	"k8s.io/ingress-gce/pkg/l4lbconfig"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/network"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -90,6 +94,7 @@ type L4NetLB struct {
 	svcLogger                        klog.Logger
 	useNEGs                          bool
 	l4lbConfigLister                 cache.Store
+	syncTrace                        *synctimeline.Trace
 }
 
 // L4NetLBSyncResult contains information about the outcome of an L4 NetLB sync. It stores the list of resource name annotations,
@@ -151,6 +156,8 @@ type L4NetLBParams struct {
 	UseDenyFirewalls                   bool
 	EnableDenyFirewallsRollbackCleanup bool
 	L4LBConfigLister                   cache.Store
+	// SyncTrace measures the phases of the sync, it may be nil.
+	SyncTrace *synctimeline.Trace
 }
 
 // NewL4NetLB creates a new Handler for the given L4NetLB service.
@@ -189,6 +196,7 @@ func NewL4NetLB(params *L4NetLBParams, logger klog.Logger) *L4NetLB {
 		useNEGs:                            params.UseNEGs,
 		svcLogger:                          logger,
 		l4lbConfigLister:                   params.L4LBConfigLister,
+		syncTrace:                          params.SyncTrace,
 	}
 	return l4netlb
 }
@@ -242,7 +250,7 @@ func (l4netlb *L4NetLB) checkStrongSessionAffinityRequirements() *l4utils.UserEr
 // been created. It is health check, firewall rules, backend service and forwarding rule.
 // It returns a LoadBalancerStatus with the updated ForwardingRule IP address.
 // This function does not link instances to Backend Service.
-func (l4netlb *L4NetLB) EnsureFrontend(nodeNames []string, svc *corev1.Service, startTime time.Time) *L4NetLBSyncResult {
+func (l4netlb *L4NetLB) EnsureFrontend(ctx context.Context, nodeNames []string, svc *corev1.Service, startTime time.Time) *L4NetLBSyncResult {
 	isMultinetService := l4netlb.networkResolver.IsMultinetService(svc)
 	serviceUsesSSA := l4netlb.enableStrongSessionAffinity && annotations.HasStrongSessionAffinityAnnotation(l4netlb.Service)
 	isWeightedLBPodsPerNode := l4netlb.isWeightedLBPodsPerNode()
@@ -298,20 +306,20 @@ func (l4netlb *L4NetLB) EnsureFrontend(nodeNames []string, svc *corev1.Service,
 		return result
 	}
 
-	hcLink := l4netlb.provideHealthChecks(nodeNames, result)
+	hcLink := l4netlb.provideHealthChecks(ctx, nodeNames, result)
 	if result.Error != nil {
 		return result
 	}
 
-	bsLink := l4netlb.provideBackendService(result, hcLink)
+	bsLink := l4netlb.provideBackendService(ctx, result, hcLink)
 	if result.Error != nil {
 		return result
 	}
 
 	if l4netlb.enableDualStack {
-		l4netlb.ensureDualStackResources(result, nodeNames, bsLink)
+		l4netlb.ensureDualStackResources(ctx, result, nodeNames, bsLink)
 	} else {
-		l4netlb.ensureIPv4Resources(result, nodeNames, bsLink)
+		l4netlb.ensureIPv4Resources(ctx, result, nodeNames, bsLink)
 	}
 
 	result.MetricsState.DenyFirewallStatus = denyFirewallMetricLabels(result.Annotations)
@@ -337,17 +345,18 @@ func denyFirewallMetricLabels(serviceAnnotations map[string]string) metrics.Deny
 	}
 }
 
-func (l4netlb *L4NetLB) provideHealthChecks(nodeNames []string, result *L4NetLBSyncResult) string {
+func (l4netlb *L4NetLB) provideHealthChecks(ctx context.Context, nodeNames []string, result *L4NetLBSyncResult) string {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseHealthChecks)()
 	if l4netlb.enableDualStack {
-		return l4netlb.provideDualStackHealthChecks(nodeNames, result)
+		return l4netlb.provideDualStackHealthChecks(ctx, nodeNames, result)
 	}
-	return l4netlb.provideIPv4HealthChecks(nodeNames, result)
+	return l4netlb.provideIPv4HealthChecks(ctx, nodeNames, result)
 }
 
-func (l4netlb *L4NetLB) provideDualStackHealthChecks(nodeNames []string, result *L4NetLBSyncResult) string {
+func (l4netlb *L4NetLB) provideDualStackHealthChecks(ctx context.Context, nodeNames []string, result *L4NetLBSyncResult) string {
 	sharedHC := !helpers.RequestsOnlyLocalTraffic(l4netlb.Service)
 
-	hcResult := l4netlb.healthChecks.EnsureHealthCheckWithDualStackFirewalls(l4netlb.Service, l4netlb.namer, sharedHC, l4netlb.scope, utils.XLB, nodeNames, l4utils.NeedsIPv4(l4netlb.Service), l4utils.NeedsIPv6(l4netlb.Service), l4netlb.networkInfo, l4netlb.svcLogger)
+	hcResult := l4netlb.healthChecks.EnsureHealthCheckWithDualStackFirewalls(ctx, l4netlb.Service, l4netlb.namer, sharedHC, l4netlb.scope, utils.XLB, nodeNames, l4utils.NeedsIPv4(l4netlb.Service), l4utils.NeedsIPv6(l4netlb.Service), l4netlb.networkInfo, l4netlb.svcLogger)
 	result.GCEResourceUpdate.SetHealthCheck(hcResult.WasUpdated)
 	result.GCEResourceUpdate.SetFirewallForHealthCheck(hcResult.WasFirewallUpdated)
 	if hcResult.Err != nil {
@@ -371,9 +380,9 @@ func (l4netlb *L4NetLB) provideDualStackHealthChecks(nodeNames []string, result
 	return hcResult.HCLink
 }
 
-func (l4netlb *L4NetLB) provideIPv4HealthChecks(nodeNames []string, result *L4NetLBSyncResult) string {
+func (l4netlb *L4NetLB) provideIPv4HealthChecks(ctx context.Context, nodeNames []string, result *L4NetLBSyncResult) string {
 	sharedHC := !helpers.RequestsOnlyLocalTraffic(l4netlb.Service)
-	hcResult := l4netlb.healthChecks.EnsureHealthCheckWithFirewall(l4netlb.Service, l4netlb.namer, sharedHC, l4netlb.scope, utils.XLB, nodeNames, l4netlb.networkInfo, l4netlb.svcLogger)
+	hcResult := l4netlb.healthChecks.EnsureHealthCheckWithFirewall(ctx, l4netlb.Service, l4netlb.namer, sharedHC, l4netlb.scope, utils.XLB, nodeNames, l4netlb.networkInfo, l4netlb.svcLogger)
 	result.GCEResourceUpdate.SetHealthCheck(hcResult.WasUpdated)
 	result.GCEResourceUpdate.SetFirewallForHealthCheck(hcResult.WasFirewallUpdated)
 	if hcResult.Err != nil {
@@ -387,20 +396,22 @@ func (l4netlb *L4NetLB) provideIPv4HealthChecks(nodeNames []string, result *L4Ne
 }
 
 // connectionTrackingPolicy returns BackendServiceConnectionTrackingPolicy
-// based on StrongSessionAffinity and IdleTimeoutSec
-func (l4netlb *L4NetLB) connectionTrackingPolicy() *composite.BackendServiceConnectionTrackingPolicy {
+// based on StrongSessionAffinity and IdleTimeoutSec, and whether it is managed.
+// Without StrongSessionAffinity, the policy from the L4LBConfig of the Service is used.
+func (l4netlb *L4NetLB) connectionTrackingPolicy() (*composite.BackendServiceConnectionTrackingPolicy, bool, error) {
 	if !l4netlb.enableStrongSessionAffinity || !annotations.HasStrongSessionAffinityAnnotation(l4netlb.Service) {
-		return nil
+		return l4lbconfig.DetermineL4ConnectionTrackingPolicy(l4netlb.Service, l4netlb.l4lbConfigLister)
 	}
 	connectionTrackingPolicy := composite.BackendServiceConnectionTrackingPolicy{}
 	connectionTrackingPolicy.EnableStrongAffinity = true
 	connectionTrackingPolicy.TrackingMode = backends.PerSessionTrackingMode
 	connectionTrackingPolicy.IdleTimeoutSec = int64(*l4netlb.Service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds)
-	return &connectionTrackingPolicy
+	return &connectionTrackingPolicy, true, nil
 }
 
-func (l4netlb *L4NetLB) provideBackendService(syncResult *L4NetLBSyncResult, hcLink string) string {
-	bsName := l4netlb.namer.L4Backend(l4netlb.Service.Namespace, l4netlb.Service.Name)
+func (l4netlb *L4NetLB) provideBackendService(ctx context.Context, syncResult *L4NetLBSyncResult, hcLink string) string {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseBackends)()
+	bsName := l4netlb.backendServiceName()
 	servicePorts := l4netlb.Service.Spec.Ports
 
 	protocol := string(utils.GetProtocol(servicePorts))
@@ -410,32 +421,55 @@ func (l4netlb *L4NetLB) provideBackendService(syncResult *L4NetLBSyncResult, hcL
 
 	localityLbPolicy := l4netlb.determineBackendServiceLocalityPolicy()
 
-	connectionTrackingPolicy := l4netlb.connectionTrackingPolicy()
+	connectionTrackingPolicy, connectionTrackingControlEnabled, connectionTrackingErr := l4netlb.connectionTrackingPolicy()
+	if connectionTrackingErr != nil {
+		l4netlb.svcLogger.Error(connectionTrackingErr, "Failed to determine L4 connection tracking policy")
+		l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(connectionTrackingErr), "L4LBConfig could not be applied: %v", connectionTrackingErr)
+		if l4lbconfig.IsInvalidConnectionTrackingError(connectionTrackingErr) {
+			syncResult.GCEResourceInError = annotations.BackendServiceResource
+			syncResult.Error = l4utils.NewUserError(connectionTrackingErr)
+			syncResult.MetricsLegacyState.IsUserError = true
+			return ""
+		}
+	}
 
 	logConfig, loggingCondition, err := l4lbconfig.DetermineL4LoggingConfig(l4netlb.Service, l4netlb.l4lbConfigLister)
 	if err != nil {
 		l4netlb.svcLogger.Error(err, "Failed to determine L4 logging config")
-		l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(err), "L4LBConfig could not be retrieved: %v", err)
+		// Errors getting the L4LBConfig were already reported for the connection tracking policy.
+		if !errors.Is(connectionTrackingErr, err) {
+			l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, l4lbconfig.GetReasonForError(err), "L4LBConfig could not be retrieved: %v", err)
+		}
 	}
 	l4netlb.svcLogger.V(2).Info("Determined L4 logging config", "logConfig", logConfig, "loggingCondition", loggingCondition)
 	logConfigControlEnabled := loggingCondition.Status == metav1.ConditionTrue
 	syncResult.MetricsState.LoggingControlEnabled = logConfigControlEnabled
 
 	backendParams := backends.L4BackendServiceParams{
-		Name:                     bsName,
-		HealthCheckLink:          hcLink,
-		Protocol:                 protocol,
-		SessionAffinity:          string(l4netlb.Service.Spec.SessionAffinity),
-		Scheme:                   string(cloud.SchemeExternal),
-		NamespacedName:           l4netlb.NamespacedName,
-		NetworkInfo:              network.DefaultNetwork(l4netlb.cloud),
-		ConnectionTrackingPolicy: connectionTrackingPolicy,
-		LocalityLbPolicy:         localityLbPolicy,
-		LogConfig:                logConfig,
-		LogConfigControlEnabled:  logConfigControlEnabled,
-	}
-
-	bs, wasUpdate, err := l4netlb.backendPool.EnsureL4BackendService(backendParams, l4netlb.svcLogger)
+		Name:                             bsName,
+		HealthCheckLink:                  hcLink,
+		Protocol:                         protocol,
+		SessionAffinity:                  string(l4netlb.Service.Spec.SessionAffinity),
+		Scheme:                           string(cloud.SchemeExternal),
+		NamespacedName:                   l4netlb.NamespacedName,
+		NetworkInfo:                      network.DefaultNetwork(l4netlb.cloud),
+		ConnectionTrackingPolicy:         connectionTrackingPolicy,
+		ConnectionTrackingControlEnabled: connectionTrackingControlEnabled,
+		LocalityLbPolicy:                 localityLbPolicy,
+		LogConfig:                        logConfig,
+		LogConfigControlEnabled:          logConfigControlEnabled,
+	}
+
+	if adoptedResources(l4netlb.Service).BackendService != "" {
+		if err := checkAdoptedBackendService(ctx, l4netlb.backendPool, backendParams, l4netlb.svcLogger); err != nil {
+			syncResult.GCEResourceInError = annotations.BackendServiceResource
+			syncResult.Error = err
+			syncResult.MetricsLegacyState.IsUserError = IsUserError(err)
+			return ""
+		}
+	}
+
+	bs, wasUpdate, err := l4netlb.backendPool.EnsureL4BackendService(ctx, backendParams, l4netlb.svcLogger)
 	syncResult.GCEResourceUpdate.SetBackendService(wasUpdate)
 	if err != nil {
 		if logConfigControlEnabled {
@@ -444,7 +478,7 @@ func (l4netlb *L4NetLB) provideBackendService(syncResult *L4NetLBSyncResult, hcL
 		}
 		if l4utils.IsUnsupportedFeatureError(err, strongSessionAffinityFeatureName) {
 			syncResult.GCEResourceInError = annotations.BackendServiceResource
-			l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, strongSessionAffinityFeatureName, strongSessionAffinityConditionedSupportMsg)
+			l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, events.ReasonStrongSessionAffinity, strongSessionAffinityConditionedSupportMsg)
 			syncResult.Error = l4utils.NewUserError(err)
 			syncResult.MetricsLegacyState.IsUserError = true
 		} else { // not UserError but something else
@@ -466,29 +500,35 @@ func (l4netlb *L4NetLB) provideBackendService(syncResult *L4NetLBSyncResult, hcL
 	return bs.SelfLink
 }
 
-func (l4netlb *L4NetLB) ensureDualStackResources(result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
+func (l4netlb *L4NetLB) ensureDualStackResources(ctx context.Context, result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
 	if l4utils.NeedsIPv4(l4netlb.Service) {
-		l4netlb.ensureIPv4Resources(result, nodeNames, bsLink)
+		l4netlb.ensureIPv4Resources(ctx, result, nodeNames, bsLink)
 	} else {
-		l4netlb.deleteIPv4ResourcesOnSync(result)
+		l4netlb.deleteIPv4ResourcesOnSync(ctx, result)
 	}
 	if l4utils.NeedsIPv6(l4netlb.Service) {
-		l4netlb.ensureIPv6Resources(result, nodeNames, bsLink)
+		l4netlb.ensureIPv6Resources(ctx, result, nodeNames, bsLink)
 	} else {
-		l4netlb.deleteIPv6ResourcesOnSync(result)
+		l4netlb.deleteIPv6ResourcesOnSync(ctx, result)
 	}
 }
 
 // ensureIPv4Resources creates resources specific to IPv4 L4 Load Balancers:
 // - IPv4 Forwarding Rule
 // - IPv4 Firewall
-func (l4netlb *L4NetLB) ensureIPv4Resources(result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
+func (l4netlb *L4NetLB) ensureIPv4Resources(ctx context.Context, result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
 	if l4netlb.enableMixedProtocol && forwardingrules.NeedsMixed(l4netlb.Service.Spec.Ports) {
-		l4netlb.ensureIPv4MixedResources(result, nodeNames, bsLink)
+		if adopted := adoptedResources(l4netlb.Service).ForwardingRule; adopted != "" {
+			result.GCEResourceInError = annotations.ForwardingRuleResource
+			result.Error = l4utils.NewIncompatibleAdoptedResourceError(annotations.ForwardingRuleResource, adopted, "adoption is not supported for mixed protocol Services")
+			result.MetricsLegacyState.IsUserError = true
+			return
+		}
+		l4netlb.ensureIPv4MixedResources(ctx, result, nodeNames, bsLink)
 		return
 	}
 
-	fr, ipAddrType, wasUpdate, err := l4netlb.ensureIPv4ForwardingRule(bsLink)
+	fr, ipAddrType, wasUpdate, err := l4netlb.ensureIPv4ForwardingRule(ctx, bsLink)
 	result.GCEResourceUpdate.SetForwardingRule(wasUpdate)
 	if err != nil {
 		// User can misconfigure the forwarding rule if Network Tier will not match service level Network Tier.
@@ -514,8 +554,10 @@ func (l4netlb *L4NetLB) ensureIPv4Resources(result *L4NetLBSyncResult, nodeNames
 	result.Status = utils.AddIPToLBStatus(result.Status, fr.IPAddress)
 }
 
-func (l4netlb *L4NetLB) ensureIPv4MixedResources(result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
-	res, err := l4netlb.mixedManager.EnsureIPv4(bsLink)
+func (l4netlb *L4NetLB) ensureIPv4MixedResources(ctx context.Context, result *L4NetLBSyncResult, nodeNames []string, bsLink string) {
+	endForwardingRules := l4netlb.syncTrace.Track(synctimeline.PhaseForwardingRules)
+	res, err := l4netlb.mixedManager.EnsureIPv4(ctx, bsLink)
+	endForwardingRules()
 
 	result.GCEResourceUpdate.SetForwardingRule(res.SyncStatus)
 	if err != nil {
@@ -559,6 +601,7 @@ func (l4netlb *L4NetLB) ensureIPv4MixedResources(result *L4NetLBSyncResult, node
 }
 
 func (l4netlb *L4NetLB) ensureIPv4NodesFirewall(nodeNames []string, ipAddress string, result *L4NetLBSyncResult) {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseFirewalls)()
 	// DisableL4LBFirewall flag disables L4 FW enforcment to remove conflicts with firewall policies
 	if l4netlb.disableNodesFirewallProvisioning {
 		l4netlb.svcLogger.Info("Skipped ensuring IPv4 nodes firewall for L4 NetLB Service to enable compatibility with firewall policies. " +
@@ -683,6 +726,11 @@ func (l4netlb *L4NetLB) softlyCleanUpDenyFirewallsWhenRolledBack() error {
 func cleanUpDenyFirewallRule(cloud *gce.Cloud, fwName string, logger klog.Logger) error {
 	log := logger.WithName("cleanUpDenyFirewallRule").WithValues("firewallName", fwName)
 
+	// Rules of the firewall policy are looked up in the policy before deleting.
+	if firewalls.UseFirewallPolicy() {
+		return firewalls.EnsureL4FirewallRuleDeleted(cloud, fwName, log)
+	}
+
 	// Skip deleting if it doesn't exist to not produce noisy audit logs
 	fa := firewalls.NewFirewallAdapter(cloud)
 	if _, err := fa.GetFirewall(fwName); err != nil {
@@ -699,7 +747,7 @@ func cleanUpDenyFirewallRule(cloud *gce.Cloud, fwName string, logger klog.Logger
 
 // EnsureLoadBalancerDeleted performs a cleanup of all GCE resources for the given loadbalancer service.
 // It is health check, firewall rules and backend service
-func (l4netlb *L4NetLB) EnsureLoadBalancerDeleted(svc *corev1.Service) *L4NetLBSyncResult {
+func (l4netlb *L4NetLB) EnsureLoadBalancerDeleted(ctx context.Context, svc *corev1.Service) *L4NetLBSyncResult {
 	isMultinetService := l4netlb.networkResolver.IsMultinetService(svc)
 	useSSA := l4netlb.enableStrongSessionAffinity && annotations.HasStrongSessionAffinityAnnotation(l4netlb.Service)
 	isWeightedLBPodsPerNode := l4netlb.isWeightedLBPodsPerNode()
@@ -707,13 +755,13 @@ func (l4netlb *L4NetLB) EnsureLoadBalancerDeleted(svc *corev1.Service) *L4NetLBS
 
 	l4netlb.Service = svc
 
-	l4netlb.deleteIPv4ResourcesOnDelete(result)
+	l4netlb.deleteIPv4ResourcesOnDelete(ctx, result)
 	if l4netlb.enableDualStack {
-		l4netlb.deleteIPv6ResourcesOnDelete(result)
+		l4netlb.deleteIPv6ResourcesOnDelete(ctx, result)
 	}
 
-	l4netlb.deleteBackendService(result)
-	l4netlb.deleteHealthChecksWithFirewall(result)
+	l4netlb.deleteBackendService(ctx, result)
+	l4netlb.deleteHealthChecksWithFirewall(ctx, result)
 
 	return result
 }
@@ -724,9 +772,9 @@ func (l4netlb *L4NetLB) EnsureLoadBalancerDeleted(svc *corev1.Service) *L4NetLBS
 // Checking for annotation saves us from emitting too much error logs "Resource not found".
 // If annotation was deleted, but resource still exists, it will be left till the Service deletion,
 // where we delete all resources, no matter if they exist in annotations.
-func (l4netlb *L4NetLB) deleteIPv4ResourcesOnSync(result *L4NetLBSyncResult) {
+func (l4netlb *L4NetLB) deleteIPv4ResourcesOnSync(ctx context.Context, result *L4NetLBSyncResult) {
 	l4netlb.svcLogger.Info("Deleting IPv4 resources for L4 NetLB Service on sync, with checking for existence in annotation")
-	l4netlb.deleteIPv4ResourcesAnnotationBased(result, false)
+	l4netlb.deleteIPv4ResourcesAnnotationBased(ctx, result, false)
 }
 
 // deleteIPv4ResourcesOnDelete deletes all resources specific to IPv4.
@@ -734,9 +782,9 @@ func (l4netlb *L4NetLB) deleteIPv4ResourcesOnSync(result *L4NetLBSyncResult) {
 // During sync, we delete resources only that exist in annotations,
 // so they could be leaked, if annotation was deleted.
 // That's why on service deletion we delete all IPv4 resources, ignoring their existence in annotations
-func (l4netlb *L4NetLB) deleteIPv4ResourcesOnDelete(result *L4NetLBSyncResult) {
+func (l4netlb *L4NetLB) deleteIPv4ResourcesOnDelete(ctx context.Context, result *L4NetLBSyncResult) {
 	l4netlb.svcLogger.Info("Deleting IPv4 resources for L4 NetLB Service on delete, without checking for existence in annotation")
-	l4netlb.deleteIPv4ResourcesAnnotationBased(result, true)
+	l4netlb.deleteIPv4ResourcesAnnotationBased(ctx, result, true)
 }
 
 // deleteIPv4ResourcesAnnotationBased deletes IPv4 only resources with checking,
@@ -747,15 +795,15 @@ func (l4netlb *L4NetLB) deleteIPv4ResourcesOnDelete(result *L4NetLBSyncResult) {
 // - IPv4 Firewall
 // This function does not delete Backend Service and Health Check, because they are shared between IPv4 and IPv6.
 // IPv4 Firewall Rule for Health Check also will not be deleted here, and will be left till the Service Deletion.
-func (l4netlb *L4NetLB) deleteIPv4ResourcesAnnotationBased(result *L4NetLBSyncResult, shouldIgnoreAnnotations bool) {
+func (l4netlb *L4NetLB) deleteIPv4ResourcesAnnotationBased(ctx context.Context, result *L4NetLBSyncResult, shouldIgnoreAnnotations bool) {
 	if shouldIgnoreAnnotations || l4netlb.hasAnnotation(annotations.TCPForwardingRuleKey) || l4netlb.hasAnnotation(annotations.UDPForwardingRuleKey) || l4netlb.hasAnnotation(annotations.L3ForwardingRuleKey) {
-		err := l4netlb.deleteIPv4ForwardingRule()
+		err := l4netlb.deleteIPv4ForwardingRule(ctx)
 		if err != nil {
 			l4netlb.svcLogger.Error(err, "Failed to delete forwarding rule for NetLB RBS service")
 			result.Error = err
 			result.GCEResourceInError = annotations.ForwardingRuleResource
 		}
-		if err = l4netlb.mixedManager.DeleteIPv4(); err != nil {
+		if err = l4netlb.mixedManager.DeleteIPv4(ctx); err != nil {
 			l4netlb.svcLogger.Error(err, "Failed to delete mixed protocol forwarding rules for NetLB RBS service")
 		}
 	}
@@ -788,17 +836,21 @@ func (l4netlb *L4NetLB) deleteIPv4ResourcesAnnotationBased(result *L4NetLBSyncRe
 	}
 }
 
-func (l4netlb *L4NetLB) deleteIPv4ForwardingRule() error {
+func (l4netlb *L4NetLB) deleteIPv4ForwardingRule(ctx context.Context) error {
 	start := time.Now()
 
-	frName := l4netlb.frName()
+	frName := l4netlb.ipv4FRName()
+	if adoptedResources(l4netlb.Service).ForwardingRule != "" {
+		l4netlb.svcLogger.V(2).Info("Skipping deletion of adopted IPv4 external forwarding rule for L4 NetLB Service", "forwardingRuleName", frName)
+		return nil
+	}
 
 	l4netlb.svcLogger.V(2).Info("Deleting IPv4 external forwarding rule for L4 NetLB Service", "forwardingRuleName", frName)
 	defer func() {
 		l4netlb.svcLogger.V(2).Info("Finished deleting IPv4 external forwarding rule for L4 NetLB Service", "forwardingRuleName", frName, "timeTaken", time.Since(start))
 	}()
 
-	return l4netlb.forwardingRules.Delete(frName)
+	return l4netlb.forwardingRules.Delete(ctx, frName)
 }
 
 func (l4netlb *L4NetLB) deleteIPv4Address() error {
@@ -831,7 +883,7 @@ func (l4netlb *L4NetLB) deleteFirewall(firewallName string, fwLogger klog.Logger
 	err := firewalls.EnsureL4FirewallRuleDeleted(l4netlb.cloud, firewallName, fwLogger)
 	if err != nil {
 		if fwErr, ok := err.(*firewalls.FirewallXPNError); ok {
-			l4netlb.recorder.Event(l4netlb.Service, corev1.EventTypeNormal, "XPN", fwErr.Message)
+			l4netlb.recorder.Event(l4netlb.Service, corev1.EventTypeNormal, events.ReasonXPN, fwErr.Message)
 			return nil
 		}
 		return err
@@ -839,8 +891,8 @@ func (l4netlb *L4NetLB) deleteFirewall(firewallName string, fwLogger klog.Logger
 	return nil
 }
 
-func (l4netlb *L4NetLB) deleteBackendService(result *L4NetLBSyncResult) {
-	bsName := l4netlb.namer.L4Backend(l4netlb.Service.Namespace, l4netlb.Service.Name)
+func (l4netlb *L4NetLB) deleteBackendService(ctx context.Context, result *L4NetLBSyncResult) {
+	bsName := l4netlb.backendServiceName()
 
 	start := time.Now()
 	l4netlb.svcLogger.V(2).Info("Deleting backend service for L4 NetLB Service", "backendServiceName", bsName)
@@ -848,10 +900,24 @@ func (l4netlb *L4NetLB) deleteBackendService(result *L4NetLBSyncResult) {
 		l4netlb.svcLogger.V(2).Info("Finished deleting backend service for L4 NetLB Service", "backendServiceName", bsName, "timeTaken", time.Since(start))
 	}()
 
+	if adoptedResources(l4netlb.Service).BackendService != "" {
+		// Adopted backend service is left in place, only cluster backends are detached from it.
+		groupName := l4netlb.namer.L4Backend(l4netlb.Service.Namespace, l4netlb.Service.Name)
+		if !l4netlb.useNEGs {
+			groupName = l4netlb.namer.InstanceGroup()
+		}
+		if err := releaseAdoptedBackendService(ctx, l4netlb.backendPool, bsName, []string{groupName}, l4netlb.svcLogger); err != nil {
+			l4netlb.svcLogger.Error(err, "Failed to detach backends from adopted backend service for L4 External LoadBalancer service")
+			result.GCEResourceInError = annotations.BackendServiceResource
+			result.Error = err
+		}
+		return
+	}
+
 	// TODO(cheungdavid): Create backend logger that contains backendName,
 	// backendVersion, and backendScope before passing to backendPool.Delete().
 	// See example in backendSyncer.ensureBackendService().
-	err := utils.IgnoreHTTPNotFound(l4netlb.backendPool.Delete(bsName, meta.VersionGA, meta.Regional, l4netlb.svcLogger))
+	err := utils.IgnoreHTTPNotFound(l4netlb.backendPool.Delete(ctx, bsName, meta.VersionGA, meta.Regional, l4netlb.svcLogger))
 	if err != nil {
 		l4netlb.svcLogger.Error(err, "Failed to delete backends for L4 External LoadBalancer service")
 		result.GCEResourceInError = annotations.BackendServiceResource
@@ -859,7 +925,7 @@ func (l4netlb *L4NetLB) deleteBackendService(result *L4NetLBSyncResult) {
 	}
 }
 
-func (l4netlb *L4NetLB) deleteHealthChecksWithFirewall(result *L4NetLBSyncResult) {
+func (l4netlb *L4NetLB) deleteHealthChecksWithFirewall(ctx context.Context, result *L4NetLBSyncResult) {
 	start := time.Now()
 	l4netlb.svcLogger.V(2).Info("Deleting all health checks and firewalls for health checks for L4 NetLB service")
 	defer func() {
@@ -874,14 +940,14 @@ func (l4netlb *L4NetLB) deleteHealthChecksWithFirewall(result *L4NetLBSyncResult
 	// and delete them if needed.
 	for _, isShared := range []bool{true, false} {
 		if l4netlb.enableDualStack {
-			resourceInError, err := l4netlb.healthChecks.DeleteHealthCheckWithDualStackFirewalls(l4netlb.Service, l4netlb.namer, isShared, meta.Regional, utils.XLB, l4netlb.svcLogger)
+			resourceInError, err := l4netlb.healthChecks.DeleteHealthCheckWithDualStackFirewalls(ctx, l4netlb.Service, l4netlb.namer, isShared, meta.Regional, utils.XLB, l4netlb.svcLogger)
 			if err != nil {
 				result.GCEResourceInError = resourceInError
 				result.Error = err
 				// continue with deletion of the non-shared Healthcheck regardless of the error, both healthchecks may need to be deleted,
 			}
 		} else {
-			resourceInError, err := l4netlb.healthChecks.DeleteHealthCheckWithFirewall(l4netlb.Service, l4netlb.namer, isShared, meta.Regional, utils.XLB, l4netlb.svcLogger)
+			resourceInError, err := l4netlb.healthChecks.DeleteHealthCheckWithFirewall(ctx, l4netlb.Service, l4netlb.namer, isShared, meta.Regional, utils.XLB, l4netlb.svcLogger)
 			if err != nil {
 				result.GCEResourceInError = resourceInError
 				result.Error = err
@@ -905,6 +971,24 @@ func (l4netlb *L4NetLB) frName() string {
 	return utils.LegacyForwardingRuleName(l4netlb.Service)
 }
 
+// ipv4FRName returns the name of the adopted IPv4 forwarding rule,
+// or the legacy forwarding rule name if none was adopted.
+func (l4netlb *L4NetLB) ipv4FRName() string {
+	if adopted := adoptedResources(l4netlb.Service).ForwardingRule; adopted != "" {
+		return adopted
+	}
+	return l4netlb.frName()
+}
+
+// backendServiceName returns the name of the adopted backend service,
+// or the name generated by the controller if none was adopted.
+func (l4netlb *L4NetLB) backendServiceName() string {
+	if adopted := adoptedResources(l4netlb.Service).BackendService; adopted != "" {
+		return adopted
+	}
+	return l4netlb.namer.L4Backend(l4netlb.Service.Namespace, l4netlb.Service.Name)
+}
+
 // determineBackendServiceLocalityPolicy returns the locality policy to be used for the backend service of the external load balancer.
 func (l4netlb *L4NetLB) determineBackendServiceLocalityPolicy() backends.LocalityLBPolicyType {
 	// If the service has weighted load balancing enabled, the locality policy can only be WEIGHTED_MAGLEV or MAGLEV.
@@ -918,10 +1002,14 @@ func (l4netlb *L4NetLB) determineBackendServiceLocalityPolicy() backends.Localit
 			} else {
 				// If the service has the annotation "networking.gke.io/weighted-load-balancing = pods-per-node"
 				// and the external traffic policy is cluster, weighted load balancing is not enabled.
-				l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, "UnsupportedConfiguration",
+				l4netlb.recorder.Eventf(l4netlb.Service, corev1.EventTypeWarning, events.ReasonUnsupportedConfiguration,
 					"Weighted load balancing by pods-per-node has no effect with External Traffic Policy: Cluster.")
 				return backends.LocalityLBPolicyMaglev
 			}
+		} else if annotations.HasWeightedLBEndpointHintsAnnotation(l4netlb.Service) {
+			// Weights of the GCE_VM_IP NEG endpoints are computed by the NEG controller from
+			// EndpointSlice hints and node capacity, which works for both external traffic policies.
+			return backends.LocalityLBPolicyWeightedMaglev
 		} else {
 			return backends.LocalityLBPolicyMaglev
 		}
@@ -931,7 +1019,7 @@ func (l4netlb *L4NetLB) determineBackendServiceLocalityPolicy() backends.Localit
 }
 
 func (l4netlb *L4NetLB) isWeightedLBPodsPerNode() bool {
-	return backends.LocalityLBPolicyWeightedMaglev == l4netlb.determineBackendServiceLocalityPolicy()
+	return annotations.HasWeightedLBPodsPerNodeAnnotation(l4netlb.Service) && backends.LocalityLBPolicyWeightedMaglev == l4netlb.determineBackendServiceLocalityPolicy()
 }
 
 func (l4netlb *L4NetLB) mixedProtocolUsingL3() bool {
@@ -226,7 +226,7 @@ func TestEnsureMixedNetLB(t *testing.T) {
 				}
 				l4netlb, fakeGCE := arrangeNetLB(t, s.resources, svc, e.useL3)
 
-				result := l4netlb.EnsureFrontend([]string{mixedprotocoltest.TestNode}, svc, time.Now())
+				result := l4netlb.EnsureFrontend(context.Background(), []string{mixedprotocoltest.TestNode}, svc, time.Now())
 
 				wantResult := &L4NetLBSyncResult{
 					Annotations: e.annotations,
@@ -427,7 +427,7 @@ func TestDeleteMixedNetLB(t *testing.T) {
 			}
 			l4NetLB, fakeGCE := arrangeNetLB(t, tc.resources, svc, tc.useL3)
 
-			result := l4NetLB.EnsureLoadBalancerDeleted(svc)
+			result := l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc)
 
 			wantResult := &L4NetLBSyncResult{
 				Annotations: map[string]string{},
@@ -565,7 +565,7 @@ func TestMixedFlagsTriggerCorrectCodePaths(t *testing.T) {
 			l4NetLB := NewL4NetLB(params, klog.TODO())
 			l4NetLB.healthChecks = healthchecks.Fake(fakeGCE, params.Recorder)
 
-			result := l4NetLB.EnsureFrontend([]string{mixedprotocoltest.TestNode}, &tc.svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), []string{mixedprotocoltest.TestNode}, &tc.svc, time.Now())
 
 			if result.Error != nil {
 				t.Fatalf("EnsureFrontend() returned error %v", result.Error)
@@ -651,7 +651,7 @@ func TestIPv6MixedAndSingleMigrationNoCoexistence(t *testing.T) {
 				return false, nil
 			}
 
-			result := l4netlb.EnsureFrontend([]string{mixedprotocoltest.TestNode}, svc, time.Now())
+			result := l4netlb.EnsureFrontend(context.Background(), []string{mixedprotocoltest.TestNode}, svc, time.Now())
 			if result.Error != nil {
 				t.Fatalf("EnsureFrontend() returned error %v", result.Error)
 			}
@@ -84,7 +84,7 @@ func TestEnsureL4NetLoadBalancer(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4netlb.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -125,7 +125,7 @@ func TestEnsureMultinetL4NetLoadBalancer(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4netlb.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -161,7 +161,7 @@ func TestDeleteL4NetLoadBalancer(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4NetLB.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -171,7 +171,7 @@ func TestDeleteL4NetLoadBalancer(t *testing.T) {
 	l4NetLB.Service.Annotations = result.Annotations
 	assertNetLBResources(t, l4NetLB, nodeNames)
 
-	if err := l4NetLB.EnsureLoadBalancerDeleted(svc); err.Error != nil {
+	if err := l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc); err.Error != nil {
 		t.Errorf("UnexpectedError %v", err.Error)
 	}
 	assertNetLBResourcesDeleted(t, l4NetLB)
@@ -186,7 +186,7 @@ func TestDeleteL4NetLoadBalancerWithSharedHC(t *testing.T) {
 	_, _ = ensureLoadBalancer(8080, vals, fakeGCE, t)
 	svc, l4NetLB := ensureLoadBalancer(8081, vals, fakeGCE, t)
 
-	if err := l4NetLB.EnsureLoadBalancerDeleted(svc); err.Error != nil {
+	if err := l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc); err.Error != nil {
 		t.Errorf("UnexpectedError %v", err.Error)
 	}
 	// Health check is in used by second service
@@ -227,7 +227,7 @@ func TestHealthCheckFirewallDeletionWithILB(t *testing.T) {
 	l4NetLB.healthChecks = l4ilb.healthChecks
 
 	// create netlb resources
-	result := l4NetLB.EnsureFrontend(nodeNames, netlbSvc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, netlbSvc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -238,7 +238,7 @@ func TestHealthCheckFirewallDeletionWithILB(t *testing.T) {
 	assertNetLBResources(t, l4NetLB, nodeNames)
 
 	// Delete the NetLB loadbalancer.
-	if err := l4NetLB.EnsureLoadBalancerDeleted(netlbSvc); err.Error != nil {
+	if err := l4NetLB.EnsureLoadBalancerDeleted(context.Background(), netlbSvc); err.Error != nil {
 		t.Errorf("UnexpectedError %v", err.Error)
 	}
 
@@ -254,7 +254,7 @@ func TestHealthCheckFirewallDeletionWithILB(t *testing.T) {
 	}
 
 	// The healthcheck itself should be deleted.
-	_, err = composite.GetHealthCheck(l4NetLB.cloud, meta.RegionalKey(hcName, l4NetLB.cloud.Region()), meta.VersionGA, klog.TODO())
+	_, err = composite.GetHealthCheck(context.Background(), l4NetLB.cloud, meta.RegionalKey(hcName, l4NetLB.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err == nil || !strings.Contains(err.Error(), "not found") {
 		t.Errorf("Healthcheck %s should be deleted", hcName)
 	}
@@ -285,7 +285,7 @@ func TestMetricsForStandardNetworkTier(t *testing.T) {
 	if _, err := test.CreateAndInsertNodes(l4netlb.cloud, nodeNames, vals.ZoneName); err != nil {
 		t.Errorf("Unexpected error when adding nodes %v", err)
 	}
-	result := l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -294,7 +294,7 @@ func TestMetricsForStandardNetworkTier(t *testing.T) {
 	}
 	// Check that service sync will return error if User Address IP Network Tier mismatch with service Network Tier.
 	svc.ObjectMeta.Annotations[annotations.NetworkTierAnnotationKey] = string(cloud.NetworkTierPremium)
-	result = l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error == nil || !l4utils.IsNetworkTierError(result.Error) {
 		t.Errorf("LoadBalancer sync should return Network Tier error, err %v", result.Error)
 	}
@@ -310,7 +310,7 @@ func TestMetricsForStandardNetworkTier(t *testing.T) {
 	svc.Spec.LoadBalancerIP = usersIPPremium
 	delete(svc.ObjectMeta.Annotations, annotations.NetworkTierAnnotationKey)
 
-	result = l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error == nil || !l4utils.IsNetworkTierError(result.Error) {
 		t.Errorf("LoadBalancer sync should return Network Tier error, err %v", result.Error)
 	}
@@ -427,7 +427,7 @@ func TestEnsureExternalDualStackLoadBalancer(t *testing.T) {
 			svc := test.NewL4NetLBRBSDualStackService(v1.ProtocolTCP, tc.ipFamilies, tc.trafficPolicy)
 			l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -437,7 +437,7 @@ func TestEnsureExternalDualStackLoadBalancer(t *testing.T) {
 			l4NetLB.Service.Annotations = result.Annotations
 			assertDualStackNetLBResources(t, l4NetLB, nodeNames)
 
-			l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+			l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 			assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 		})
 	}
@@ -453,14 +453,14 @@ func TestEnsureDualStackNetLBNetworkTierChange(t *testing.T) {
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
 	// Ensure dualstack load balancer with Standard Network Tier and verify it did not synced successfully.
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if _, ok := result.Error.(*l4utils.UnsupportedNetworkTierError); !ok {
 		t.Errorf("Expected error to be of type *l4utils.UnsupportedNetworkTierError, got %T", result.Error)
 	}
 
 	// Change network Tier to Premium, and trigger sync.
 	svc.Annotations[annotations.NetworkTierAnnotationKey] = "Premium"
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error == nil {
 		// This is buggy existing behaviour. Switching Network Tier (even before DualStack),
 		// returns error on the first sync. However, after that it immediately resyncs,
@@ -468,7 +468,7 @@ func TestEnsureDualStackNetLBNetworkTierChange(t *testing.T) {
 		// but in separate PR.
 		t.Errorf("Expected error on the first sync after switching network tier, got %v", result.Error)
 	}
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -479,7 +479,7 @@ func TestEnsureDualStackNetLBNetworkTierChange(t *testing.T) {
 	svc.Annotations[annotations.NetworkTierAnnotationKey] = "Premium"
 	assertDualStackNetLBResources(t, l4NetLB, nodeNames)
 
-	l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+	l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 	assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 }
 
@@ -547,7 +547,7 @@ func TestDualStackNetLBTransitions(t *testing.T) {
 
 			l4NetLB.cloud.Compute().(*cloud.MockGCE).MockForwardingRules.DeleteHook = assertAddressOldReservedHook(t, l4NetLB.cloud)
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			svc.Annotations = result.Annotations
 			assertDualStackNetLBResources(t, l4NetLB, nodeNames)
 
@@ -555,12 +555,12 @@ func TestDualStackNetLBTransitions(t *testing.T) {
 			finalSvc.Annotations = svc.Annotations
 			l4NetLB.Service = finalSvc
 
-			result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			finalSvc.Annotations = result.Annotations
 			assertDualStackNetLBResources(t, l4NetLB, nodeNames)
 
 			l4NetLB.cloud.Compute().(*cloud.MockGCE).MockForwardingRules.DeleteHook = nil
-			l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+			l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 			assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 		})
 	}
@@ -594,7 +594,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	svc.Annotations = result.Annotations
 
 	// Delete IPv4 resources annotation
@@ -605,7 +605,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
 
 	// Run new sync. Controller should not delete resources, if they don't exist in annotation
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	svc.Annotations = result.Annotations
 
 	// Verify IPv4 Firewall was not deleted
@@ -622,7 +622,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv6Resources(t *testing.T) {
 		t.Errorf("forwarding rule %s was deleted, expected not", ipv6FRName)
 	}
 
-	l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+	l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 	// After complete deletion, IPv6 and IPv4 resources should be cleaned up, even if the were leaked
 	assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 }
@@ -635,7 +635,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol, v1.IPv4Protocol}
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	svc.Annotations = result.Annotations
 	assertDualStackNetLBResources(t, l4NetLB, nodeNames)
 
@@ -647,7 +647,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
 
 	// Run new sync. Controller should not delete resources, if they don't exist in annotation
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	svc.Annotations = result.Annotations
 
 	// Verify IPv4 Firewall was not deleted
@@ -664,7 +664,7 @@ func TestDualStackNetLBSyncIgnoresNoAnnotationIPv4Resources(t *testing.T) {
 		t.Errorf("forwarding rule %s was deleted, expected not", ipv4FRName)
 	}
 
-	l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+	l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 	// After complete deletion, IPv6 and IPv4 resources should be cleaned up, even if the were leaked
 	assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 }
@@ -682,7 +682,7 @@ func TestEnsureIPv6ExternalLoadBalancerCustomSubnet(t *testing.T) {
 	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-	result := l4NetLB.EnsureFrontend(nodeNames, l4NetLB.Service, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, l4NetLB.Service, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -701,7 +701,7 @@ func TestEnsureIPv6ExternalLoadBalancerCustomSubnet(t *testing.T) {
 		t.Fatalf("Failed to create subnet, error: %v", err)
 	}
 	svc.Annotations[annotations.CustomSubnetAnnotationKey] = "test-subnet"
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -715,7 +715,7 @@ func TestEnsureIPv6ExternalLoadBalancerCustomSubnet(t *testing.T) {
 		t.Fatalf("Failed to create subnet, error: %v", err)
 	}
 	svc.Annotations[annotations.CustomSubnetAnnotationKey] = "another-subnet"
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -724,7 +724,7 @@ func TestEnsureIPv6ExternalLoadBalancerCustomSubnet(t *testing.T) {
 
 	// remove the annotation - NetLB should revert to default subnet.
 	delete(svc.Annotations, annotations.CustomSubnetAnnotationKey)
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -732,7 +732,7 @@ func TestEnsureIPv6ExternalLoadBalancerCustomSubnet(t *testing.T) {
 	assertDualStackNetLBResourcesWithCustomIPv6Subnet(t, l4NetLB, nodeNames, l4NetLB.cloud.SubnetworkURL())
 
 	// Delete the loadbalancer
-	result = l4NetLB.EnsureLoadBalancerDeleted(svc)
+	result = l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 	}
@@ -787,7 +787,7 @@ func TestDualStackNetLBBadCustomSubnet(t *testing.T) {
 
 			svc.Annotations[annotations.CustomSubnetAnnotationKey] = customBadSubnetName
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if result.Error == nil {
 				t.Fatalf("Expected error ensuring external dualstack loadbalancer in bad subnet, got: %v", result.Error)
 			}
@@ -849,7 +849,7 @@ func TestDualStackNetLBNetworkTier(t *testing.T) {
 			svc.Annotations[annotations.NetworkTierAnnotationKey] = tc.networkTier
 			svc.Spec.IPFamilies = tc.ipFamilies
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if tc.returnError {
 				if result.Error == nil {
 					t.Fatalf("Expected an error to be returned when ensuring the external load balancer, but the call succeeded unexpectedly.")
@@ -922,7 +922,7 @@ func TestDualStackNetLBStaticIPAnnotation(t *testing.T) {
 			svc.Annotations[annotations.StaticL4AddressesAnnotationKey] = tc.staticAnnotationVal
 			svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -942,7 +942,7 @@ func TestDualStackNetLBStaticIPAnnotation(t *testing.T) {
 				}
 			}
 			// Delete the loadbalancer
-			result = l4NetLB.EnsureLoadBalancerDeleted(svc)
+			result = l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc)
 			if result.Error != nil {
 				t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 			}
@@ -969,7 +969,7 @@ func TestEnsureIPv6OnlyNetLBNetworkTierChange(t *testing.T) {
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
 	// Initial Sync with Standard Tier: Expect an error - external IPv6 LoadBalancers require Premium Tier.
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if _, ok := result.Error.(*l4utils.UnsupportedNetworkTierError); !ok {
 		t.Errorf("Expected error to be of type *l4utils.UnsupportedNetworkTierError for Stanard Tier, got %T", result.Error)
 	}
@@ -979,7 +979,7 @@ func TestEnsureIPv6OnlyNetLBNetworkTierChange(t *testing.T) {
 
 	// Since the previous sync failed early, no GCE resources should have been created.
 	// This sync should now provision the resources correctly with Premium Tier.
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure load balancer after switching to Premium, err %v", result.Error)
 	}
@@ -990,7 +990,7 @@ func TestEnsureIPv6OnlyNetLBNetworkTierChange(t *testing.T) {
 
 	// This call ensures that resyncing an already correctly provisioned service
 	// does not cause errors or unexpected changes
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer on the second sync, err %v", result.Error)
 	}
@@ -1000,7 +1000,7 @@ func TestEnsureIPv6OnlyNetLBNetworkTierChange(t *testing.T) {
 
 	assertNetLBResourcesIPv6Only(t, l4NetLB, nodeNames)
 
-	l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+	l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 	assertDualStackNetLBResourcesDeleted(t, l4NetLB)
 }
 
@@ -1011,7 +1011,7 @@ func TestIPv6OnlyNetLBSyncIgnoresNoAnnotationResources(t *testing.T) {
 	svc := test.NewL4NetLBRBSDualStackService(v1.ProtocolTCP, []v1.IPFamily{v1.IPv6Protocol}, v1.ServiceExternalTrafficPolicyTypeCluster)
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-	result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Initial EnsureFrontend failed: %v", result.Error)
 	}
@@ -1034,7 +1034,7 @@ func TestIPv6OnlyNetLBSyncIgnoresNoAnnotationResources(t *testing.T) {
 	l4NetLB.Service = svc
 
 	// Run new sync. Controller should not delete resources, if they don't exist in annotation
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("EnsureFrontend after deleting annotations failed: %v", result.Error)
 	}
@@ -1053,7 +1053,7 @@ func TestIPv6OnlyNetLBSyncIgnoresNoAnnotationResources(t *testing.T) {
 		t.Errorf("forwarding rule %s was deleted, expected not", ipv6FRName)
 	}
 
-	deleteResult := l4NetLB.EnsureLoadBalancerDeleted(l4NetLB.Service)
+	deleteResult := l4NetLB.EnsureLoadBalancerDeleted(context.Background(), l4NetLB.Service)
 	if deleteResult.Error != nil {
 		t.Errorf("EnsureLoadBalancerDeleted() resulted in an error: %v", deleteResult.Error)
 	}
@@ -1068,7 +1068,7 @@ func TestEnsureExternalLoadBalancerCustomSubnetIPv6Only(t *testing.T) {
 	svc := test.NewL4NetLBRBSDualStackService(v1.ProtocolTCP, []v1.IPFamily{v1.IPv6Protocol}, v1.ServiceExternalTrafficPolicyTypeCluster)
 	l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 
-	result := l4NetLB.EnsureFrontend(nodeNames, l4NetLB.Service, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, l4NetLB.Service, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1087,7 +1087,7 @@ func TestEnsureExternalLoadBalancerCustomSubnetIPv6Only(t *testing.T) {
 		t.Fatalf("Failed to create subnet, error: %v", err)
 	}
 	svc.Annotations[annotations.CustomSubnetAnnotationKey] = "test-subnet"
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1101,7 +1101,7 @@ func TestEnsureExternalLoadBalancerCustomSubnetIPv6Only(t *testing.T) {
 		t.Fatalf("Failed to create subnet, error: %v", err)
 	}
 	svc.Annotations[annotations.CustomSubnetAnnotationKey] = "another-subnet"
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1110,7 +1110,7 @@ func TestEnsureExternalLoadBalancerCustomSubnetIPv6Only(t *testing.T) {
 
 	// remove the annotation - NetLB should revert to default subnet.
 	delete(svc.Annotations, annotations.CustomSubnetAnnotationKey)
-	result = l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+	result = l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1118,7 +1118,7 @@ func TestEnsureExternalLoadBalancerCustomSubnetIPv6Only(t *testing.T) {
 	assertDualStackNetLBResourcesWithCustomIPv6Subnet(t, l4NetLB, nodeNames, l4NetLB.cloud.SubnetworkURL())
 
 	// Delete the loadbalancer
-	result = l4NetLB.EnsureLoadBalancerDeleted(svc)
+	result = l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc)
 	if result.Error != nil {
 		t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 	}
@@ -1180,7 +1180,7 @@ func TestIPv6OnlyNetLBStaticIPAnnotation(t *testing.T) {
 			}
 			svc.Annotations[annotations.StaticL4AddressesAnnotationKey] = tc.staticAnnotationVal
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if result.Error != nil {
 				t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
@@ -1204,7 +1204,7 @@ func TestIPv6OnlyNetLBStaticIPAnnotation(t *testing.T) {
 			}
 
 			// Delete the loadbalancer
-			result = l4NetLB.EnsureLoadBalancerDeleted(svc)
+			result = l4NetLB.EnsureLoadBalancerDeleted(context.Background(), svc)
 			if result.Error != nil {
 				t.Errorf("Unexpected error deleting loadbalancer - err %v", result.Error)
 			}
@@ -1373,7 +1373,7 @@ func ensureLoadBalancer(port int, vals gce.TestClusterValues, fakeGCE *gce.Cloud
 	l4NetLB := NewL4NetLB(l4NetLBParams, klog.TODO())
 	l4NetLB.healthChecks = healthchecks.Fake(fakeGCE, l4NetLBParams.Recorder)
 
-	result := l4NetLB.EnsureFrontend(emptyNodes, svc, time.Now())
+	result := l4NetLB.EnsureFrontend(context.Background(), emptyNodes, svc, time.Now())
 	if result.Error != nil {
 		t.Errorf("Failed to ensure loadBalancer, err %v", result.Error)
 	}
@@ -1479,6 +1479,7 @@ func TestWeightedNetLB(t *testing.T) {
 	testCases := []struct {
 		desc                     string
 		addAnnotationForWeighted bool
+		weightedAnnotationValue  string
 		weightedFlagEnabled      bool
 		externalTrafficPolicy    v1.ServiceExternalTrafficPolicy
 		wantLocalityLBPolicy     backends.LocalityLBPolicyType
@@ -1511,6 +1512,22 @@ func TestWeightedNetLB(t *testing.T) {
 			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeCluster,
 			wantLocalityLBPolicy:     backends.LocalityLBPolicyMaglev,
 		},
+		{
+			desc:                     "Flag enabled, Service with endpoint-hints weighted annotation, externalTrafficPolicy local",
+			addAnnotationForWeighted: true,
+			weightedAnnotationValue:  annotations.WeightedL4AnnotationEndpointHints,
+			weightedFlagEnabled:      true,
+			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeLocal,
+			wantLocalityLBPolicy:     backends.LocalityLBPolicyWeightedMaglev,
+		},
+		{
+			desc:                     "Flag enabled, Service with endpoint-hints weighted annotation, externalTrafficPolicy cluster",
+			addAnnotationForWeighted: true,
+			weightedAnnotationValue:  annotations.WeightedL4AnnotationEndpointHints,
+			weightedFlagEnabled:      true,
+			externalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeCluster,
+			wantLocalityLBPolicy:     backends.LocalityLBPolicyWeightedMaglev,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1518,8 +1535,11 @@ func TestWeightedNetLB(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			svc := test.NewL4NetLBRBSService(8080)
 			svc.Spec.ExternalTrafficPolicy = tc.externalTrafficPolicy
+			if tc.weightedAnnotationValue == "" {
+				tc.weightedAnnotationValue = annotations.WeightedL4AnnotationPodsPerNode
+			}
 			if tc.addAnnotationForWeighted {
-				svc.Annotations[annotations.WeightedL4AnnotationKey] = annotations.WeightedL4AnnotationPodsPerNode
+				svc.Annotations[annotations.WeightedL4AnnotationKey] = tc.weightedAnnotationValue
 			}
 
 			nodeNames := []string{"test-node-1"}
@@ -1527,14 +1547,14 @@ func TestWeightedNetLB(t *testing.T) {
 			l4NetLB := mustSetupNetLBTestHandler(t, svc, nodeNames)
 			l4NetLB.enableWeightedLB = tc.weightedFlagEnabled
 
-			result := l4NetLB.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4NetLB.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if result.Error != nil {
 				t.Fatalf("Failed to ensure loadBalancer, err %v", result.Error)
 			}
 
 			backendServiceName := l4NetLB.namer.L4Backend(l4NetLB.Service.Namespace, l4NetLB.Service.Name)
 			key := meta.RegionalKey(backendServiceName, l4NetLB.cloud.Region())
-			bs, err := composite.GetBackendService(l4NetLB.cloud, key, meta.VersionGA, klog.TODO())
+			bs, err := composite.GetBackendService(context.Background(), l4NetLB.cloud, key, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to read BackendService, %v", err)
 			}
@@ -1544,7 +1564,7 @@ func TestWeightedNetLB(t *testing.T) {
 			}
 
 			isWeightedLBPodsPerNode := l4NetLB.isWeightedLBPodsPerNode()
-			if tc.weightedFlagEnabled && tc.addAnnotationForWeighted && tc.externalTrafficPolicy == v1.ServiceExternalTrafficPolicyTypeLocal && !isWeightedLBPodsPerNode {
+			if tc.weightedFlagEnabled && tc.addAnnotationForWeighted && tc.weightedAnnotationValue == annotations.WeightedL4AnnotationPodsPerNode && tc.externalTrafficPolicy == v1.ServiceExternalTrafficPolicyTypeLocal && !isWeightedLBPodsPerNode {
 				t.Errorf("Expected isWeightedLBPodsPerNode() to return true for Service with weighted load balancing enabled")
 			}
 		})
@@ -1833,7 +1853,7 @@ func getAndVerifyNetLBHealthCheck(l4netlb *L4NetLB) (*composite.HealthCheck, err
 	isSharedHC := !servicehelper.RequestsOnlyLocalTraffic(l4netlb.Service)
 	hcName := l4netlb.namer.L4HealthCheck(l4netlb.Service.Namespace, l4netlb.Service.Name, isSharedHC)
 
-	healthcheck, err := composite.GetHealthCheck(l4netlb.cloud, meta.RegionalKey(hcName, l4netlb.cloud.Region()), meta.VersionGA, klog.TODO())
+	healthcheck, err := composite.GetHealthCheck(context.Background(), l4netlb.cloud, meta.RegionalKey(hcName, l4netlb.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch healthcheck %s - err %w", hcName, err)
 	}
@@ -1855,7 +1875,7 @@ func getAndVerifyNetLBHealthCheck(l4netlb *L4NetLB) (*composite.HealthCheck, err
 func getAndVerifyNetLBBackendService(l4netlb *L4NetLB, healthCheck *composite.HealthCheck) (*composite.BackendService, error) {
 	backendServiceName := l4netlb.namer.L4Backend(l4netlb.Service.Namespace, l4netlb.Service.Name)
 	key := meta.RegionalKey(backendServiceName, l4netlb.cloud.Region())
-	bs, err := composite.GetBackendService(l4netlb.cloud, key, meta.VersionGA, klog.TODO())
+	bs, err := composite.GetBackendService(context.Background(), l4netlb.cloud, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch backend service %s - err %w", backendServiceName, err)
 	}
@@ -1893,7 +1913,7 @@ func verifyNetLBIPv6ForwardingRule(l4netlb *L4NetLB, backendServiceLink string,
 }
 
 func verifyNetLBForwardingRule(l4netlb *L4NetLB, frName string, backendServiceLink string, expectedSubnet string) error {
-	fwdRule, err := composite.GetForwardingRule(l4netlb.cloud, meta.RegionalKey(frName, l4netlb.cloud.Region()), meta.VersionGA, klog.TODO())
+	fwdRule, err := composite.GetForwardingRule(context.Background(), l4netlb.cloud, meta.RegionalKey(frName, l4netlb.cloud.Region()), meta.VersionGA, klog.TODO())
 	if err != nil {
 		return fmt.Errorf("failed to fetch forwarding rule %s - err %w", frName, err)
 	}
@@ -2202,7 +2222,7 @@ func TestEnsureL4NetLB_L4LBConfigLogging(t *testing.T) {
 				Protocol:  "TCP",
 				LogConfig: tc.existingGCEConfig,
 			}
-			if err := composite.CreateBackendService(fakeGCE, key, initialBS, klog.TODO()); err != nil {
+			if err := composite.CreateBackendService(context.Background(), fakeGCE, key, initialBS, klog.TODO()); err != nil {
 				t.Errorf("Failed to create fake backend service %s, err %v", bsName, err)
 			}
 
@@ -2223,14 +2243,14 @@ func TestEnsureL4NetLB_L4LBConfigLogging(t *testing.T) {
 
 			// 4. Execution
 			// EnsureFrontend is the NetLB entry point for reconciliation
-			result := l4netlb.EnsureFrontend(nodeNames, svc, time.Now())
+			result := l4netlb.EnsureFrontend(context.Background(), nodeNames, svc, time.Now())
 			if tc.expectError && result.Error != nil {
 				// Some errors are expected in specific safety scenarios (e.g. CRD missing)
 				t.Logf("Caught expected reconciliation error: %v", result.Error)
 			}
 
 			// 5. Verification
-			finalBS, err := composite.GetBackendService(fakeGCE, key, meta.VersionGA, klog.TODO())
+			finalBS, err := composite.GetBackendService(context.Background(), fakeGCE, key, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Fatalf("Failed to retrieve Backend Service from GCE: %v", err)
 			}
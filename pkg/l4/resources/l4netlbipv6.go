@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/l4/forwardingrules"
 	l4utils "k8s.io/ingress-gce/pkg/l4/utils"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
 	"k8s.io/klog/v2"
@@ -44,8 +46,8 @@ const (
 // - IPv6 Forwarding Rule
 // - IPv6 Firewall
 // it also adds IPv6 address to LB status
-func (l4netlb *L4NetLB) ensureIPv6Resources(syncResult *L4NetLBSyncResult, nodeNames []string, bsLink string) {
-	ipv6fr, wasUpdate, err := l4netlb.ensureIPv6ForwardingRule(bsLink)
+func (l4netlb *L4NetLB) ensureIPv6Resources(ctx context.Context, syncResult *L4NetLBSyncResult, nodeNames []string, bsLink string) {
+	ipv6fr, wasUpdate, err := l4netlb.ensureIPv6ForwardingRule(ctx, bsLink)
 	syncResult.GCEResourceUpdate.SetForwardingRule(wasUpdate)
 	if err != nil {
 		l4netlb.svcLogger.Error(err, "ensureIPv6Resources: Failed to create ipv6 forwarding rule")
@@ -81,8 +83,8 @@ func (l4netlb *L4NetLB) ensureIPv6Resources(syncResult *L4NetLBSyncResult, nodeN
 // Checking for annotation saves us from emitting too much error logs "Resource not found".
 // If annotation was deleted, but resource still exists, it will be left till the Service deletion,
 // where we delete all resources, no matter if they exist in annotations.
-func (l4netlb *L4NetLB) deleteIPv6ResourcesOnSync(syncResult *L4NetLBSyncResult) {
-	l4netlb.deleteIPv6ResourcesAnnotationBased(syncResult, false)
+func (l4netlb *L4NetLB) deleteIPv6ResourcesOnSync(ctx context.Context, syncResult *L4NetLBSyncResult) {
+	l4netlb.deleteIPv6ResourcesAnnotationBased(ctx, syncResult, false)
 }
 
 // deleteIPv6ResourcesOnDelete deletes all resources specific to IPv6.
@@ -90,8 +92,8 @@ func (l4netlb *L4NetLB) deleteIPv6ResourcesOnSync(syncResult *L4NetLBSyncResult)
 // During sync, we delete resources only that exist in annotations,
 // so they could be leaked, if annotation was deleted.
 // That's why on service deletion we delete all IPv4 resources, ignoring their existence in annotations
-func (l4netlb *L4NetLB) deleteIPv6ResourcesOnDelete(syncResult *L4NetLBSyncResult) {
-	l4netlb.deleteIPv6ResourcesAnnotationBased(syncResult, true)
+func (l4netlb *L4NetLB) deleteIPv6ResourcesOnDelete(ctx context.Context, syncResult *L4NetLBSyncResult) {
+	l4netlb.deleteIPv6ResourcesAnnotationBased(ctx, syncResult, true)
 }
 
 // deleteIPv6ResourcesAnnotationBased deletes IPv6 only resources with checking,
@@ -101,9 +103,9 @@ func (l4netlb *L4NetLB) deleteIPv6ResourcesOnDelete(syncResult *L4NetLBSyncResul
 // - IPv6 Firewall
 // This function does not delete Backend Service and Health Check, because they are shared between IPv4 and IPv6.
 // IPv6 Firewall Rule for Health Check also will not be deleted here, and will be left till the Service Deletion.
-func (l4netlb *L4NetLB) deleteIPv6ResourcesAnnotationBased(syncResult *L4NetLBSyncResult, shouldIgnoreAnnotations bool) {
+func (l4netlb *L4NetLB) deleteIPv6ResourcesAnnotationBased(ctx context.Context, syncResult *L4NetLBSyncResult, shouldIgnoreAnnotations bool) {
 	if shouldIgnoreAnnotations || l4netlb.hasAnnotation(annotations.TCPForwardingRuleIPv6Key) || l4netlb.hasAnnotation(annotations.UDPForwardingRuleIPv6Key) || l4netlb.hasAnnotation(annotations.L3ForwardingRuleIPv6Key) {
-		l4netlb.deleteIPv6ForwardingRule(syncResult)
+		l4netlb.deleteIPv6ForwardingRule(ctx, syncResult)
 	}
 
 	if shouldIgnoreAnnotations || l4netlb.hasAnnotation(annotations.FirewallRuleIPv6Key) {
@@ -120,6 +122,7 @@ func (l4netlb *L4NetLB) l3FRName() string {
 }
 
 func (l4netlb *L4NetLB) ensureIPv6NodesFirewall(ipRange string, nodeNames []string, syncResult *L4NetLBSyncResult) {
+	defer l4netlb.syncTrace.Track(synctimeline.PhaseFirewalls)()
 	// DisableL4LBFirewall flag disables L4 FW enforcment to remove conflicts with firewall policies
 	if l4netlb.disableNodesFirewallProvisioning {
 		l4netlb.svcLogger.Info("Skipped ensuring IPv6 nodes firewall for L4 NetLB Service to enable compatibility with firewall policies. " +
@@ -197,7 +200,7 @@ func (l4netlb *L4NetLB) ensureDenyIPv6(syncResult *L4NetLBSyncResult, nodeNames
 	syncResult.Annotations[annotations.FirewallRuleDenyIPv6Key] = denyParams.Name
 }
 
-func (l4netlb *L4NetLB) deleteIPv6ForwardingRule(syncResult *L4NetLBSyncResult) {
+func (l4netlb *L4NetLB) deleteIPv6ForwardingRule(ctx context.Context, syncResult *L4NetLBSyncResult) {
 	names := []string{l4netlb.ipv6FRName(), l4netlb.l3FRName()}
 	start := time.Now()
 	l4netlb.svcLogger.V(2).Info("Deleting IPv6 forwarding rule for L4 NetLB Service", "forwardingRuleNames", names)
@@ -211,7 +214,7 @@ func (l4netlb *L4NetLB) deleteIPv6ForwardingRule(syncResult *L4NetLBSyncResult)
 
 	for _, frName := range names {
 		wg.Go(func() {
-			err := l4netlb.forwardingRules.Delete(frName)
+			err := l4netlb.forwardingRules.Delete(ctx, frName)
 			if err != nil {
 				l4netlb.svcLogger.Error(err, "Failed to delete ipv6 forwarding rule for external loadbalancer service", "frName", frName)
 				mu.Lock()
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -37,7 +38,7 @@ func verifyHealthCheckNotExists(cloud *gce.Cloud, hcName string, scope meta.KeyT
 	if scope == meta.Regional {
 		key = meta.RegionalKey(hcName, cloud.Region())
 	}
-	healthCheck, err := composite.GetHealthCheck(cloud, key, meta.VersionGA, logger)
+	healthCheck, err := composite.GetHealthCheck(context.Background(), cloud, key, meta.VersionGA, logger)
 	if !utils.IsNotFoundError(err) || healthCheck != nil {
 		return fmt.Errorf("health check %s exists, expected not", hcName)
 	}
@@ -2,6 +2,7 @@
 package mixedprotocoltest
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -52,7 +53,7 @@ func (r GCEResources) Create(cloud *gce.Cloud) error {
 
 	if r.HealthCheck != nil {
 		provider := healthchecks.NewHealthChecks(cloud, meta.VersionGA, klog.TODO())
-		if err := provider.Create(r.HealthCheck); err != nil {
+		if err := provider.Create(context.Background(), r.HealthCheck); err != nil {
 			return fmt.Errorf("healthCheckProvider.Create() returned error %w", err)
 		}
 	}
@@ -108,7 +109,7 @@ func VerifyResourcesExist(t *testing.T, cloud *gce.Cloud, want GCEResources) {
 
 	if want.HealthCheck != nil {
 		provider := healthchecks.NewHealthChecks(cloud, meta.VersionGA, klog.TODO())
-		hc, err := provider.Get(want.HealthCheck.Name, want.HealthCheck.Scope)
+		hc, err := provider.Get(context.Background(), want.HealthCheck.Name, want.HealthCheck.Scope)
 		if err != nil {
 			t.Errorf("healthCheckProvider.Get(%v) got err: %v", want.HealthCheck.Name, err)
 		}
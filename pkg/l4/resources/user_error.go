@@ -23,6 +23,7 @@ func IsUserError(err error) bool {
 		l4utils.IsConstraintViolationError(err) ||
 		l4utils.IsUnsupportedLoadBalancingSchemeError(err) ||
 		l4utils.IsUnsupportedProtocolError(err) ||
+		l4utils.IsIncompatibleAdoptedResourceError(err) ||
 		errors.As(err, &firewallErr) ||
 		errors.As(err, &userErr)
 }
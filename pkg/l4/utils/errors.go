@@ -230,3 +230,30 @@ func IsUnsupportedProtocolError(err error) bool {
 	var unsupportedProtocolErr *UnsupportedProtocolError
 	return errors.As(err, &unsupportedProtocolErr)
 }
+
+// IncompatibleAdoptedResourceError is an error for user-created resources that
+// can't be adopted by the L4 controllers without being recreated.
+type IncompatibleAdoptedResourceError struct {
+	resource string
+	name     string
+	reason   string
+}
+
+func (e *IncompatibleAdoptedResourceError) Error() string {
+	return fmt.Sprintf("%s %s can't be adopted: %s", e.resource, e.name, e.reason)
+}
+
+// NewIncompatibleAdoptedResourceError creates a new IncompatibleAdoptedResourceError.
+func NewIncompatibleAdoptedResourceError(resource, name, reason string) *IncompatibleAdoptedResourceError {
+	return &IncompatibleAdoptedResourceError{
+		resource: resource,
+		name:     name,
+		reason:   reason,
+	}
+}
+
+// IsIncompatibleAdoptedResourceError checks if wrapped error is an IncompatibleAdoptedResourceError.
+func IsIncompatibleAdoptedResourceError(err error) bool {
+	var adoptedErr *IncompatibleAdoptedResourceError
+	return errors.As(err, &adoptedErr)
+}
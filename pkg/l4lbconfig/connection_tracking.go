@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4lbconfig
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	l4lbconfigv1 "k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/flags"
+)
+
+var (
+	ErrL4LBConfigInvalidTrackingMode          = errors.New("invalid TrackingMode in L4LBConfig for service.")
+	ErrL4LBConfigInvalidConnectionPersistence = errors.New("invalid ConnectionPersistenceOnUnhealthyBackends in L4LBConfig for service.")
+	ErrL4LBConfigInvalidIdleTimeout           = errors.New("invalid IdleTimeoutSec in L4LBConfig for service.")
+	ErrL4LBConfigSessionAffinityMismatch      = errors.New("PER_SESSION connection tracking in L4LBConfig requires ClientIP session affinity on the service.")
+)
+
+const (
+	// ReasonL4LBConfigInvalidConnectionTracking is used when the ConnectionTracking in L4LBConfig is invalid.
+	ReasonL4LBConfigInvalidConnectionTracking = events.ReasonL4LBConfigInvalidConnectionTracking
+
+	// minIdleTimeoutSec is the minimum allowed value for ConnectionTrackingConfig.IdleTimeoutSec.
+	minIdleTimeoutSec = 60
+	// maxIdleTimeoutSec is the maximum allowed value for ConnectionTrackingConfig.IdleTimeoutSec (16 hours).
+	maxIdleTimeoutSec = 57600
+	// defaultIdleTimeoutSec is the idle timeout used by GCE when it's not specified.
+	defaultIdleTimeoutSec = 600
+)
+
+// DetermineL4ConnectionTrackingPolicy resolves the connection tracking policy for L4 services.
+// The returned bool tells whether the connection tracking policy is managed. It is managed whenever
+// the feature is enabled, and the policy is reset to the GCE defaults if the service has no
+// ConnectionTracking in its L4LBConfig, or if the referenced L4LBConfig does not exist.
+// Unset fields are resolved to GCE defaults, so the policy can be compared with the one read from GCE.
+func DetermineL4ConnectionTrackingPolicy(
+	service *corev1.Service,
+	l4lbConfigLister cache.Store,
+) (*composite.BackendServiceConnectionTrackingPolicy, bool, error) {
+
+	// Check Global Gate
+	if !flags.F.ManageL4LBConnectionTracking || l4lbConfigLister == nil {
+		return nil, false, nil
+	}
+
+	serviceL4LBConfig, err := GetL4LBConfigForService(l4lbConfigLister, service)
+	if err != nil {
+		if errors.Is(err, ErrL4LBConfigDoesNotExist) {
+			return DefaultConnectionTrackingPolicy(), true, err
+		}
+		// Leave the policy unchanged until the L4LBConfig can be read.
+		return nil, false, err
+	}
+	if serviceL4LBConfig == nil || serviceL4LBConfig.Spec.ConnectionTracking == nil {
+		return DefaultConnectionTrackingPolicy(), true, nil
+	}
+
+	ctc := serviceL4LBConfig.Spec.ConnectionTracking
+	policy := DefaultConnectionTrackingPolicy()
+	policy.TrackingMode = string(ctc.TrackingMode)
+	policy.ConnectionPersistenceOnUnhealthyBackends = string(ctc.ConnectionPersistenceOnUnhealthyBackends)
+
+	// Validation already occurs at the API level, but this serves as a safeguard against any unexpected values.
+	switch l4lbconfigv1.ConnectionTrackingMode(policy.TrackingMode) {
+	case "":
+		policy.TrackingMode = string(l4lbconfigv1.ConnectionTrackingModePerConnection)
+	case l4lbconfigv1.ConnectionTrackingModePerConnection:
+		// Valid
+	case l4lbconfigv1.ConnectionTrackingModePerSession:
+		if service.Spec.SessionAffinity != corev1.ServiceAffinityClientIP {
+			return nil, false, ErrL4LBConfigSessionAffinityMismatch
+		}
+	default:
+		return nil, false, ErrL4LBConfigInvalidTrackingMode
+	}
+
+	switch l4lbconfigv1.ConnectionPersistenceMode(policy.ConnectionPersistenceOnUnhealthyBackends) {
+	case "":
+		policy.ConnectionPersistenceOnUnhealthyBackends = string(l4lbconfigv1.ConnectionPersistenceModeDefaultForProtocol)
+	case l4lbconfigv1.ConnectionPersistenceModeDefaultForProtocol, l4lbconfigv1.ConnectionPersistenceModeNeverPersist, l4lbconfigv1.ConnectionPersistenceModeAlwaysPersist:
+		// Valid
+	default:
+		return nil, false, ErrL4LBConfigInvalidConnectionPersistence
+	}
+
+	if ctc.IdleTimeoutSec != nil {
+		if *ctc.IdleTimeoutSec < minIdleTimeoutSec || *ctc.IdleTimeoutSec > maxIdleTimeoutSec {
+			return nil, false, ErrL4LBConfigInvalidIdleTimeout
+		}
+		policy.IdleTimeoutSec = int64(*ctc.IdleTimeoutSec)
+	} else if policy.TrackingMode == string(l4lbconfigv1.ConnectionTrackingModePerSession) && clientIPAffinityTimeout(service) != nil {
+		policy.IdleTimeoutSec = int64(min(max(*clientIPAffinityTimeout(service), minIdleTimeoutSec), maxIdleTimeoutSec))
+	}
+
+	return policy, true, nil
+}
+
+// DefaultConnectionTrackingPolicy returns the policy GCE uses for backend services
+// without connection tracking policy.
+func DefaultConnectionTrackingPolicy() *composite.BackendServiceConnectionTrackingPolicy {
+	return &composite.BackendServiceConnectionTrackingPolicy{
+		TrackingMode:                             string(l4lbconfigv1.ConnectionTrackingModePerConnection),
+		ConnectionPersistenceOnUnhealthyBackends: string(l4lbconfigv1.ConnectionPersistenceModeDefaultForProtocol),
+		IdleTimeoutSec:                           defaultIdleTimeoutSec,
+	}
+}
+
+// IsInvalidConnectionTrackingError returns true if the error comes from validation of
+// the ConnectionTracking in L4LBConfig, which has to be fixed by the user.
+func IsInvalidConnectionTrackingError(err error) bool {
+	return errors.Is(err, ErrL4LBConfigInvalidTrackingMode) ||
+		errors.Is(err, ErrL4LBConfigInvalidConnectionPersistence) ||
+		errors.Is(err, ErrL4LBConfigInvalidIdleTimeout) ||
+		errors.Is(err, ErrL4LBConfigSessionAffinityMismatch)
+}
+
+func clientIPAffinityTimeout(service *corev1.Service) *int32 {
+	if service.Spec.SessionAffinityConfig == nil || service.Spec.SessionAffinityConfig.ClientIP == nil {
+		return nil
+	}
+	return service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds
+}
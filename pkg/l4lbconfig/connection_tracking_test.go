@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4lbconfig
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	l4lbconfigv1 "k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/l4/annotations"
+	"k8s.io/utils/ptr"
+)
+
+func TestDetermineL4ConnectionTrackingPolicy(t *testing.T) {
+	oldManageL4LBConnectionTracking := flags.F.ManageL4LBConnectionTracking
+	t.Cleanup(func() { flags.F.ManageL4LBConnectionTracking = oldManageL4LBConnectionTracking })
+
+	testNamespace := "test-ns"
+	configName := "l4-config"
+
+	makeService := func(affinity apiv1.ServiceAffinity, affinityTimeout *int32) *apiv1.Service {
+		svc := &apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc",
+				Namespace: testNamespace,
+				Annotations: map[string]string{
+					annotations.L4LBConfigKey: configName,
+				},
+			},
+			Spec: apiv1.ServiceSpec{SessionAffinity: affinity},
+		}
+		if affinityTimeout != nil {
+			svc.Spec.SessionAffinityConfig = &apiv1.SessionAffinityConfig{
+				ClientIP: &apiv1.ClientIPConfig{TimeoutSeconds: affinityTimeout},
+			}
+		}
+		return svc
+	}
+	makeConfig := func(ctc *l4lbconfigv1.ConnectionTrackingConfig) *l4lbconfigv1.L4LBConfig {
+		return &l4lbconfigv1.L4LBConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: configName, Namespace: testNamespace},
+			Spec:       l4lbconfigv1.L4LBConfigSpec{ConnectionTracking: ctc},
+		}
+	}
+
+	testCases := []struct {
+		desc           string
+		manageFlag     bool
+		svc            *apiv1.Service
+		storeObj       *l4lbconfigv1.L4LBConfig
+		expectErr      error
+		expectManaged  bool
+		expectedPolicy *composite.BackendServiceConnectionTrackingPolicy
+	}{
+		{
+			desc:       "Global Gate, ManageL4LBConnectionTracking flag is OFF",
+			manageFlag: false,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode: l4lbconfigv1.ConnectionTrackingModePerConnection,
+			}),
+			expectedPolicy: nil,
+		},
+		{
+			desc:           "L4LBConfig without ConnectionTracking resets to defaults",
+			manageFlag:     true,
+			svc:            makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj:       makeConfig(nil),
+			expectManaged:  true,
+			expectedPolicy: DefaultConnectionTrackingPolicy(),
+		},
+		{
+			desc:       "Service without L4LBConfig resets to defaults",
+			manageFlag: true,
+			svc: &apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: testNamespace},
+			},
+			expectManaged:  true,
+			expectedPolicy: DefaultConnectionTrackingPolicy(),
+		},
+		{
+			desc:           "Missing L4LBConfig resets to defaults",
+			manageFlag:     true,
+			svc:            makeService(apiv1.ServiceAffinityNone, nil),
+			expectErr:      ErrL4LBConfigDoesNotExist,
+			expectManaged:  true,
+			expectedPolicy: DefaultConnectionTrackingPolicy(),
+		},
+		{
+			desc:          "Empty ConnectionTracking resolves to defaults",
+			manageFlag:    true,
+			svc:           makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj:      makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{}),
+			expectManaged: true,
+			expectedPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+				TrackingMode:                             "PER_CONNECTION",
+				ConnectionPersistenceOnUnhealthyBackends: "DEFAULT_FOR_PROTOCOL",
+				IdleTimeoutSec:                           600,
+			},
+		},
+		{
+			desc:       "All fields set",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode:                             l4lbconfigv1.ConnectionTrackingModePerConnection,
+				ConnectionPersistenceOnUnhealthyBackends: l4lbconfigv1.ConnectionPersistenceModeNeverPersist,
+				IdleTimeoutSec:                           ptr.To[int32](3600),
+			}),
+			expectManaged: true,
+			expectedPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+				TrackingMode:                             "PER_CONNECTION",
+				ConnectionPersistenceOnUnhealthyBackends: "NEVER_PERSIST",
+				IdleTimeoutSec:                           3600,
+			},
+		},
+		{
+			desc:       "PER_SESSION uses ClientIP affinity timeout",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityClientIP, ptr.To[int32](1200)),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode: l4lbconfigv1.ConnectionTrackingModePerSession,
+			}),
+			expectManaged: true,
+			expectedPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+				TrackingMode:                             "PER_SESSION",
+				ConnectionPersistenceOnUnhealthyBackends: "DEFAULT_FOR_PROTOCOL",
+				IdleTimeoutSec:                           1200,
+			},
+		},
+		{
+			desc:       "PER_SESSION clamps ClientIP affinity timeout",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityClientIP, ptr.To[int32](86400)),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode: l4lbconfigv1.ConnectionTrackingModePerSession,
+			}),
+			expectManaged: true,
+			expectedPolicy: &composite.BackendServiceConnectionTrackingPolicy{
+				TrackingMode:                             "PER_SESSION",
+				ConnectionPersistenceOnUnhealthyBackends: "DEFAULT_FOR_PROTOCOL",
+				IdleTimeoutSec:                           57600,
+			},
+		},
+		{
+			desc:       "Invalid, PER_SESSION without ClientIP affinity",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode: l4lbconfigv1.ConnectionTrackingModePerSession,
+			}),
+			expectErr: ErrL4LBConfigSessionAffinityMismatch,
+		},
+		{
+			desc:       "Invalid TrackingMode",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				TrackingMode: "PER_PACKET",
+			}),
+			expectErr: ErrL4LBConfigInvalidTrackingMode,
+		},
+		{
+			desc:       "Invalid ConnectionPersistenceOnUnhealthyBackends",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				ConnectionPersistenceOnUnhealthyBackends: "SOMETIMES_PERSIST",
+			}),
+			expectErr: ErrL4LBConfigInvalidConnectionPersistence,
+		},
+		{
+			desc:       "Invalid IdleTimeoutSec",
+			manageFlag: true,
+			svc:        makeService(apiv1.ServiceAffinityNone, nil),
+			storeObj: makeConfig(&l4lbconfigv1.ConnectionTrackingConfig{
+				IdleTimeoutSec: ptr.To[int32](10),
+			}),
+			expectErr: ErrL4LBConfigInvalidIdleTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			flags.F.ManageL4LBConnectionTracking = tc.manageFlag
+
+			lister := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			if tc.storeObj != nil {
+				lister.Add(tc.storeObj)
+			}
+
+			result, managed, err := DetermineL4ConnectionTrackingPolicy(tc.svc, lister)
+			if !errors.Is(err, tc.expectErr) {
+				t.Errorf("Unexpected error state. Expected error: %v, Got: %v", tc.expectErr, err)
+			}
+			if wantInvalid := err != nil && !errors.Is(err, ErrL4LBConfigDoesNotExist); IsInvalidConnectionTrackingError(err) != wantInvalid {
+				t.Errorf("IsInvalidConnectionTrackingError(%v) = %v, want %v", err, !wantInvalid, wantInvalid)
+			}
+			if managed != tc.expectManaged {
+				t.Errorf("Managed = %v, want %v", managed, tc.expectManaged)
+			}
+			if diff := cmp.Diff(tc.expectedPolicy, result); diff != "" {
+				t.Errorf("Resolved policy mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
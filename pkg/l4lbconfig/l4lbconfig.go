@@ -26,6 +26,7 @@ import (
 	l4lbconfigv1 "k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/crd"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 )
@@ -39,11 +40,11 @@ var (
 
 const (
 	// ReasonL4LBConfigNotFound is used when the annotation exists but the object doesn't.
-	ReasonL4LBConfigNotFound = "L4LBConfigNotFound"
+	ReasonL4LBConfigNotFound = events.ReasonL4LBConfigNotFound
 	// ReasonL4LBConfigFetchFailed is used for API/Client errors.
-	ReasonL4LBConfigFetchFailed = "L4LBConfigFetchFailed"
+	ReasonL4LBConfigFetchFailed = events.ReasonL4LBConfigFetchFailed
 	// ReasonL4LBConfigInvalidMode is used when the OptionalMode in L4LBConfig is invalid.
-	ReasonL4LBConfigInvalidMode = "L4LBConfigInvalidMode"
+	ReasonL4LBConfigInvalidMode = events.ReasonL4LBConfigInvalidMode
 
 	// maxSampleRate is the maximum allowed value for LoggingConfig.SampleRate (100% in millionth).
 	maxSampleRate = 1000000.0
@@ -175,6 +176,8 @@ func GetReasonForError(err error) string {
 		return ReasonL4LBConfigFetchFailed
 	} else if errors.Is(err, ErrL4LBConfigInvalidMode) {
 		return ReasonL4LBConfigInvalidMode
+	} else if IsInvalidConnectionTrackingError(err) {
+		return ReasonL4LBConfigInvalidConnectionTracking
 	}
-	return "L4LBConfigUnknownError"
+	return events.ReasonL4LBConfigUnknownError
 }
@@ -391,6 +391,8 @@ func TestGetReasonForError(t *testing.T) {
 		{err: ErrL4LBConfigDoesNotExist, expectedReason: ReasonL4LBConfigNotFound},
 		{err: ErrL4LBConfigFailedToGet, expectedReason: ReasonL4LBConfigFetchFailed},
 		{err: ErrL4LBConfigInvalidMode, expectedReason: ReasonL4LBConfigInvalidMode},
+		{err: ErrL4LBConfigInvalidIdleTimeout, expectedReason: ReasonL4LBConfigInvalidConnectionTracking},
+		{err: ErrL4LBConfigSessionAffinityMismatch, expectedReason: ReasonL4LBConfigInvalidConnectionTracking},
 		{err: errors.New("generic error"), expectedReason: "L4LBConfigUnknownError"},
 		{err: nil, expectedReason: "L4LBConfigUnknownError"},
 	}
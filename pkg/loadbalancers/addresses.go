@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -28,13 +29,13 @@ import (
 )
 
 // checkStaticIP reserves a regional or global static IP allocated to the Forwarding Rule.
-func (l7 *L7) checkStaticIP() (err error) {
+func (l7 *L7) checkStaticIP(ctx context.Context) (err error) {
 	if l7.fw == nil || l7.fw.IPAddress == "" {
 		return fmt.Errorf("will not create static IP without a forwarding rule")
 	}
 	managedStaticIPName := l7.namer.ForwardingRule(namer.HTTPProtocol)
 	// Don't manage staticIPs if the user has specified an IP.
-	address, manageStaticIP, err := l7.getEffectiveIP()
+	address, manageStaticIP, err := l7.getEffectiveIP(ctx)
 	if err != nil {
 		return err
 	}
@@ -52,12 +53,12 @@ func (l7 *L7) checkStaticIP() (err error) {
 		return err
 	}
 
-	ip, _ := composite.GetAddress(l7.cloud, key, meta.VersionGA, l7.logger)
+	ip, _ := composite.GetAddress(ctx, l7.cloud, key, meta.VersionGA, l7.logger)
 	if ip == nil {
 		l7.logger.V(3).Info("Creating static ip", "ipName", managedStaticIPName)
 		address := l7.newStaticAddress(managedStaticIPName)
 
-		err = composite.CreateAddress(l7.cloud, key, address, l7.logger)
+		err = composite.CreateAddress(ctx, l7.cloud, key, address, l7.logger)
 		if err != nil {
 			if utils.IsHTTPErrorCode(err, http.StatusConflict) ||
 				utils.IsHTTPErrorCode(err, http.StatusBadRequest) {
@@ -67,7 +68,7 @@ func (l7 *L7) checkStaticIP() (err error) {
 			}
 			return err
 		}
-		ip, err = composite.GetAddress(l7.cloud, key, meta.VersionGA, l7.logger)
+		ip, err = composite.GetAddress(ctx, l7.cloud, key, meta.VersionGA, l7.logger)
 		if err != nil {
 			return err
 		}
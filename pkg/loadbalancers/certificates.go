@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -28,7 +29,7 @@ import (
 
 const SslCertificateMissing = "SslCertificateMissing"
 
-func (l7 *L7) checkSSLCert() error {
+func (l7 *L7) checkSSLCert(ctx context.Context) error {
 	isL7ILB := utils.IsGCEL7ILBIngress(l7.runtimeInfo.Ingress)
 	isL7XLBRegional := utils.IsGCEL7XLBRegionalIngress(l7.runtimeInfo.Ingress)
 	tr := translator.NewTranslator(isL7ILB, isL7XLBRegional, l7.namer)
@@ -40,7 +41,7 @@ func (l7 *L7) checkSSLCert() error {
 	errs := []error{}
 
 	// Get updated value of certificate for comparison
-	existingSecretsSslCerts, err := l7.getIngressManagedSslCerts()
+	existingSecretsSslCerts, err := l7.getIngressManagedSslCerts(ctx)
 	if err != nil {
 		errs = append(errs, err)
 		// Do not continue if getIngressManagedSslCerts() failed.
@@ -48,7 +49,7 @@ func (l7 *L7) checkSSLCert() error {
 	}
 
 	l7.oldSSLCerts = existingSecretsSslCerts
-	sslCerts, err := l7.createSslCertificates(existingSecretsSslCerts, translatorCerts)
+	sslCerts, err := l7.createSslCertificates(ctx, existingSecretsSslCerts, translatorCerts)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -60,7 +61,7 @@ func (l7 *L7) checkSSLCert() error {
 }
 
 // createSslCertificates creates SslCertificates based on kubernetes secrets in Ingress configuration.
-func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.SslCertificate) ([]*composite.SslCertificate, error) {
+func (l7 *L7) createSslCertificates(ctx context.Context, existingCerts, translatorCerts []*composite.SslCertificate) ([]*composite.SslCertificate, error) {
 	var result []*composite.SslCertificate
 
 	existingCertsMap := getMapFromCertList(existingCerts)
@@ -103,7 +104,7 @@ func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.
 			l7.logger.Error(err, "l7.CreateKey", "certName", translatorCert.Name)
 			return nil, err
 		}
-		err = composite.CreateSslCertificate(l7.cloud, key, translatorCert, l7.logger)
+		err = composite.CreateSslCertificate(ctx, l7.cloud, key, translatorCert, l7.logger)
 		if err != nil {
 			l7.logger.Error(err, "Failed to create new sslCertificate for LB", "certName", translatorCert.Name, "l7", l7)
 			failedCerts = append(failedCerts, translatorCert.Name+" Error:"+err.Error())
@@ -112,7 +113,7 @@ func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.
 		visitedCertMap[translatorCert.Name] = fmt.Sprintf("secret cert:%q", translatorCert.Certificate)
 
 		// Get SSLCert
-		cert, err := composite.GetSslCertificate(l7.cloud, key, translatorCert.Version, l7.logger)
+		cert, err := composite.GetSslCertificate(ctx, l7.cloud, key, translatorCert.Version, l7.logger)
 		if err != nil {
 			l7.logger.Error(err, "GetSslCertificate", "key", key, "certVersion", translatorCert.Version)
 			return nil, err
@@ -141,7 +142,7 @@ func getMapFromCertList(certs []*composite.SslCertificate) map[string]*composite
 // getIngressManagedSslCerts fetches SslCertificate resources created and managed by this load balancer
 // instance. These SslCertificate resources were created based on kubernetes secrets in Ingress
 // configuration.
-func (l7 *L7) getIngressManagedSslCerts() ([]*composite.SslCertificate, error) {
+func (l7 *L7) getIngressManagedSslCerts(ctx context.Context) ([]*composite.SslCertificate, error) {
 	var result []*composite.SslCertificate
 
 	// Currently we list all certs available in gcloud and filter the ones managed by this loadbalancer instance. This is
@@ -154,7 +155,7 @@ func (l7 *L7) getIngressManagedSslCerts() ([]*composite.SslCertificate, error) {
 		return nil, err
 	}
 	version := l7.Versions().SslCertificate
-	certs, err := composite.ListSslCertificates(l7.cloud, key, version, l7.logger, filter.None)
+	certs, err := composite.ListSslCertificates(ctx, l7.cloud, key, version, l7.logger, filter.None)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +168,7 @@ func (l7 *L7) getIngressManagedSslCerts() ([]*composite.SslCertificate, error) {
 	if len(result) == 0 {
 		// Check for legacy cert since that follows a different naming convention
 		l7.logger.V(4).Info("Looking for legacy ssl certs")
-		expectedCertLinks, err := l7.getSslCertLinkInUse()
+		expectedCertLinks, err := l7.getSslCertLinkInUse(ctx)
 		if err != nil {
 			// Return nil if target proxy doesn't exist.
 			return nil, utils.IgnoreHTTPNotFound(err)
@@ -187,7 +188,7 @@ func (l7 *L7) getIngressManagedSslCerts() ([]*composite.SslCertificate, error) {
 			if err != nil {
 				return nil, err
 			}
-			cert, _ := composite.GetSslCertificate(l7.cloud, key, version, l7.logger)
+			cert, _ := composite.GetSslCertificate(ctx, l7.cloud, key, version, l7.logger)
 			if cert != nil {
 				l7.logger.V(4).Info("Populating legacy ssl cert for l7", "certName", cert.Name, "l7", l7)
 				result = append(result, cert)
@@ -197,7 +198,7 @@ func (l7 *L7) getIngressManagedSslCerts() ([]*composite.SslCertificate, error) {
 	return result, nil
 }
 
-func (l7 *L7) deleteOldSSLCerts() {
+func (l7 *L7) deleteOldSSLCerts(ctx context.Context) {
 	if len(l7.oldSSLCerts) == 0 {
 		return
 	}
@@ -213,7 +214,7 @@ func (l7 *L7) deleteOldSSLCerts() {
 		}
 		l7.logger.V(3).Info("Cleaning up old SSL Certificate", "certName", cert.Name)
 		key, _ := l7.CreateKey(cert.Name)
-		if certErr := utils.IgnoreHTTPNotFound(composite.DeleteSslCertificate(l7.cloud, key, l7.Versions().SslCertificate, l7.logger)); certErr != nil {
+		if certErr := utils.IgnoreHTTPNotFound(composite.DeleteSslCertificate(ctx, l7.cloud, key, l7.Versions().SslCertificate, l7.logger)); certErr != nil {
 			l7.logger.Error(certErr, "Old cert delete failed", "certName", cert.Name)
 		}
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"fmt"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	corev1 "k8s.io/api/core/v1"
@@ -28,16 +29,16 @@ import (
 	"k8s.io/ingress-gce/pkg/utils/namer"
 )
 
-func (l7 *L7) checkHttpForwardingRule() (err error) {
+func (l7 *L7) checkHttpForwardingRule(ctx context.Context) (err error) {
 	if l7.tp == nil {
 		return fmt.Errorf("cannot create forwarding rule without proxy")
 	}
 	name := l7.namer.ForwardingRule(namer.HTTPProtocol)
-	address, _, err := l7.getEffectiveIP()
+	address, _, err := l7.getEffectiveIP(ctx)
 	if err != nil {
 		return err
 	}
-	fw, err := l7.checkForwardingRule(namer.HTTPProtocol, name, l7.tp.SelfLink, address)
+	fw, err := l7.checkForwardingRule(ctx, namer.HTTPProtocol, name, l7.tp.SelfLink, address)
 	if err != nil {
 		return err
 	}
@@ -45,17 +46,17 @@ func (l7 *L7) checkHttpForwardingRule() (err error) {
 	return nil
 }
 
-func (l7 *L7) checkHttpsForwardingRule() (err error) {
+func (l7 *L7) checkHttpsForwardingRule(ctx context.Context) (err error) {
 	if l7.tps == nil {
 		l7.logger.V(3).Info("No https target proxy for l7, not created https forwarding rule", "l7", l7)
 		return nil
 	}
 	name := l7.namer.ForwardingRule(namer.HTTPSProtocol)
-	address, _, err := l7.getEffectiveIP()
+	address, _, err := l7.getEffectiveIP(ctx)
 	if err != nil {
 		return err
 	}
-	fws, err := l7.checkForwardingRule(namer.HTTPSProtocol, name, l7.tps.SelfLink, address)
+	fws, err := l7.checkForwardingRule(ctx, namer.HTTPSProtocol, name, l7.tps.SelfLink, address)
 	if err != nil {
 		return err
 	}
@@ -63,7 +64,7 @@ func (l7 *L7) checkHttpsForwardingRule() (err error) {
 	return nil
 }
 
-func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink, ip string) (existing *composite.ForwardingRule, err error) {
+func (l7 *L7) checkForwardingRule(ctx context.Context, protocol namer.NamerProtocol, name, proxyLink, ip string) (existing *composite.ForwardingRule, err error) {
 	key, err := l7.CreateKey(name)
 	if err != nil {
 		return nil, err
@@ -80,12 +81,12 @@ func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink,
 	env := &translator.Env{VIP: ip, Network: l7.cloud.NetworkURL(), Subnetwork: l7.cloud.SubnetworkURL()}
 	fr := tr.ToCompositeForwardingRule(env, protocol, version, proxyLink, description, l7.runtimeInfo.StaticIPSubnet)
 
-	existing, _ = composite.GetForwardingRule(l7.cloud, key, version, l7.logger)
+	existing, _ = composite.GetForwardingRule(ctx, l7.cloud, key, version, l7.logger)
 	if existing != nil && (fr.IPAddress != "" && existing.IPAddress != fr.IPAddress || existing.PortRange != fr.PortRange) {
 		l7.logger.Info("Recreating forwarding rule %v(%v), so it has %v(%v)",
 			"existingIp", existing.IPAddress, "existingPortRange", existing.PortRange,
 			"targetIp", fr.IPAddress, "targetPortRange", fr.PortRange)
-		if err = utils.IgnoreHTTPNotFound(composite.DeleteForwardingRule(l7.cloud, key, version, l7.logger)); err != nil {
+		if err = utils.IgnoreHTTPNotFound(composite.DeleteForwardingRule(ctx, l7.cloud, key, version, l7.logger)); err != nil {
 			return nil, err
 		}
 		existing = nil
@@ -110,7 +111,7 @@ func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink,
 		}
 		l7.logger.V(3).Info("Creating forwarding rule for proxy and ip", "proxy", proxyLink, "ip", ip, "protocol", protocol)
 
-		if err = composite.CreateForwardingRule(l7.cloud, key, fr, l7.logger); err != nil {
+		if err = composite.CreateForwardingRule(ctx, l7.cloud, key, fr, l7.logger); err != nil {
 			return nil, err
 		}
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "ForwardingRule %q created", key.Name)
@@ -119,7 +120,7 @@ func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink,
 		if err != nil {
 			return nil, err
 		}
-		existing, err = composite.GetForwardingRule(l7.cloud, key, version, l7.logger)
+		existing, err = composite.GetForwardingRule(ctx, l7.cloud, key, version, l7.logger)
 		if err != nil {
 			return nil, err
 		}
@@ -134,7 +135,7 @@ func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink,
 		if err != nil {
 			return nil, err
 		}
-		if err := composite.SetProxyForForwardingRule(l7.cloud, key, existing, proxyLink, l7.logger); err != nil {
+		if err := composite.SetProxyForForwardingRule(ctx, l7.cloud, key, existing, proxyLink, l7.logger); err != nil {
 			return nil, err
 		}
 	}
@@ -144,7 +145,7 @@ func (l7 *L7) checkForwardingRule(protocol namer.NamerProtocol, name, proxyLink,
 // getEffectiveIP returns a string with the IP to use in the HTTP and HTTPS
 // forwarding rules, a boolean indicating if this is an IP the controller
 // should manage or not and an error if the specified IP was not found.
-func (l7 *L7) getEffectiveIP() (string, bool, error) {
+func (l7 *L7) getEffectiveIP(ctx context.Context) (string, bool, error) {
 	// A note on IP management:
 	// User specifies a different IP on startup:
 	//	- We create a forwarding rule with the given IP.
@@ -170,7 +171,7 @@ func (l7 *L7) getEffectiveIP() (string, bool, error) {
 		// Existing static IPs allocated to forwarding rules will get orphaned
 		// till the Ingress is torn down.
 		// TODO(shance): Replace version
-		if ip, err := composite.GetAddress(l7.cloud, key, meta.VersionGA, l7.logger); err != nil || ip == nil {
+		if ip, err := composite.GetAddress(ctx, l7.cloud, key, meta.VersionGA, l7.logger); err != nil || ip == nil {
 			return "", false, fmt.Errorf("the given static IP name %v doesn't translate to an existing static IP.",
 				l7.runtimeInfo.StaticIPName)
 		} else {
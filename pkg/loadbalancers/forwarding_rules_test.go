@@ -1,6 +1,7 @@
 package loadbalancers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -62,14 +63,14 @@ func TestGetEffectiveIP(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				err = composite.CreateAddress(fakeGCE, key, tc.address, klog.TODO())
+				err = composite.CreateAddress(context.Background(), fakeGCE, key, tc.address, klog.TODO())
 				if err != nil {
 					t.Fatal(err)
 				}
 				l7.runtimeInfo.StaticIPName = tc.address.Name
 			}
 
-			ip, managed, err := l7.getEffectiveIP()
+			ip, managed, err := l7.getEffectiveIP(context.Background())
 			if (err != nil) != tc.wantErr {
 				t.Errorf("getEffectiveIP() error = %v, wantErr %v", err, tc.wantErr)
 				return
@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/klog/v2"
@@ -26,17 +27,17 @@ import (
 // with a gce loadbalancer.
 type LoadBalancerPool interface {
 	// Ensure ensures a loadbalancer and its resources given the RuntimeInfo.
-	Ensure(ri *L7RuntimeInfo) (*L7, error)
+	Ensure(ctx context.Context, ri *L7RuntimeInfo) (*L7, error)
 	// GCv2 garbage collects loadbalancer associated with given ingress using v2 naming scheme.
-	GCv2(ing *v1.Ingress, scope meta.KeyType) error
+	GCv2(ctx context.Context, ing *v1.Ingress, scope meta.KeyType) error
 	// GCv1 garbage collects loadbalancers not in the input list using v1 naming scheme.
-	GCv1(names []string) error
+	GCv1(ctx context.Context, names []string) error
 	// FrontendScopeChangeGC checks if GC is needed for an ingress that has changed scopes.
-	FrontendScopeChangeGC(ing *v1.Ingress, ingLogger klog.Logger) (*meta.KeyType, error)
+	FrontendScopeChangeGC(ctx context.Context, ing *v1.Ingress, ingLogger klog.Logger) (*meta.KeyType, error)
 	// DidRegionalClassChange checks if GC is needed for an ingress that has changed regional class name.
-	DidRegionalClassChange(ing *v1.Ingress, ingLogger klog.Logger) (bool, error)
+	DidRegionalClassChange(ctx context.Context, ing *v1.Ingress, ingLogger klog.Logger) (bool, error)
 	// Shutdown deletes all loadbalancers for given list of ingresses.
 	Shutdown(ings []*v1.Ingress) error
 	// HasUrlMap returns true if an URL map exists in GCE for given ingress.
-	HasUrlMap(ing *v1.Ingress) (bool, error)
+	HasUrlMap(ctx context.Context, ing *v1.Ingress) (bool, error)
 }
@@ -17,11 +17,13 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/translator"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/ingress-gce/pkg/backends"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/loadbalancers/features"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
 	"k8s.io/klog/v2"
@@ -65,6 +68,8 @@ type L7RuntimeInfo struct {
 	UrlMap *utils.GCEURLMap
 	// FrontendConfig is the type which encapsulates features for the load balancer.
 	FrontendConfig *frontendconfigv1beta1.FrontendConfig
+	// SyncTrace measures the phases of the sync, it may be nil.
+	SyncTrace *synctimeline.Trace
 }
 
 // L7 represents a single L7 loadbalancer.
@@ -138,7 +143,7 @@ func (l7 *L7) UrlMap() *composite.UrlMap {
 	return l7.um
 }
 
-func (l7 *L7) edgeHop() error {
+func (l7 *L7) edgeHop(ctx context.Context) error {
 	sslConfigured := l7.runtimeInfo.TLS != nil || l7.runtimeInfo.TLSName != ""
 	// Return an error if user configuration species that both HTTP & HTTPS are not to be configured.
 	if !l7.runtimeInfo.AllowHTTP && !sslConfigured {
@@ -147,24 +152,20 @@ func (l7 *L7) edgeHop() error {
 
 	// Check for invalid L7-ILB HTTPS config before attempting sync
 	if utils.IsGCEL7ILBIngress(l7.runtimeInfo.Ingress) && sslConfigured && l7.runtimeInfo.AllowHTTP && l7.runtimeInfo.StaticIPName == "" {
-		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeWarning, "WillNotConfigureFrontend", "gce-internal Ingress class must be configured with a static-ip to use both HTTP and HTTPS served on the same IP. Please configure a static-ip with Purpose=SHARED_LOADBALANCER_VIP and attach it to the ingress with the kubernetes.io/ingress.regional-static-ip-name annotation.")
+		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeWarning, events.ReasonWillNotConfigureFrontend, "gce-internal Ingress class must be configured with a static-ip to use both HTTP and HTTPS served on the same IP. Please configure a static-ip with Purpose=SHARED_LOADBALANCER_VIP and attach it to the ingress with the kubernetes.io/ingress.regional-static-ip-name annotation.")
 		return fmt.Errorf("error invalid internal ingress https config")
 	}
 
-	if err := l7.ensureComputeURLMap(); err != nil {
+	if err := l7.ensureURLMaps(ctx); err != nil {
 		return err
 	}
 
-	if err := l7.ensureRedirectURLMap(); err != nil {
-		return fmt.Errorf("ensureRedirectUrlMap() = %v", err)
-	}
-
 	if l7.runtimeInfo.AllowHTTP {
-		if err := l7.edgeHopHttp(); err != nil {
+		if err := l7.edgeHopHttp(ctx); err != nil {
 			return err
 		}
 	} else if requireDeleteFrontend(l7.ingress, namer.HTTPProtocol, l7.logger) {
-		if err := l7.deleteHttp(features.VersionsFromIngress(&l7.ingress)); err != nil {
+		if err := l7.deleteHttp(ctx, features.VersionsFromIngress(&l7.ingress)); err != nil {
 			return err
 		}
 		l7.logger.V(2).Info("Successfully deleted unused HTTP frontend resources for load-balancer", "l7", l7)
@@ -172,17 +173,20 @@ func (l7 *L7) edgeHop() error {
 	// Defer promoting an ephemeral to a static IP until it's really needed.
 	if l7.runtimeInfo.AllowHTTP && sslConfigured {
 		l7.logger.V(3).Info("checking static ip for load-balancer", "l7", l7)
-		if err := l7.checkStaticIP(); err != nil {
+		endForwardingRules := l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseForwardingRules)
+		err := l7.checkStaticIP(ctx)
+		endForwardingRules()
+		if err != nil {
 			return err
 		}
 	}
 	if sslConfigured {
 		l7.logger.V(3).Info("validating https for load-balancer", "l7", l7)
-		if err := l7.edgeHopHttps(); err != nil {
+		if err := l7.edgeHopHttps(ctx); err != nil {
 			return err
 		}
 	} else if requireDeleteFrontend(l7.ingress, namer.HTTPSProtocol, l7.logger) {
-		if err := l7.deleteHttps(features.VersionsFromIngress(&l7.ingress)); err != nil {
+		if err := l7.deleteHttps(ctx, features.VersionsFromIngress(&l7.ingress)); err != nil {
 			return err
 		}
 		l7.logger.V(2).Info("Successfully deleted unused HTTPS frontend resources for load-balancer", "l7", l7)
@@ -190,26 +194,45 @@ func (l7 *L7) edgeHop() error {
 	return nil
 }
 
-func (l7 *L7) edgeHopHttp() error {
-	if err := l7.checkProxy(); err != nil {
+func (l7 *L7) ensureURLMaps(ctx context.Context) error {
+	defer l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseURLMap)()
+	if err := l7.ensureComputeURLMap(ctx); err != nil {
 		return err
 	}
-	if err := l7.checkHttpForwardingRule(); err != nil {
-		return err
+	if err := l7.ensureRedirectURLMap(ctx); err != nil {
+		return fmt.Errorf("ensureRedirectUrlMap() = %v", err)
 	}
 	return nil
 }
 
-func (l7 *L7) edgeHopHttps() error {
-	defer l7.deleteOldSSLCerts()
-	if err := l7.checkSSLCert(); err != nil {
+func (l7 *L7) edgeHopHttp(ctx context.Context) error {
+	endProxies := l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseProxies)
+	err := l7.checkProxy(ctx)
+	endProxies()
+	if err != nil {
 		return err
 	}
+	defer l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseForwardingRules)()
+	if err := l7.checkHttpForwardingRule(ctx); err != nil {
+		return err
+	}
+	return nil
+}
 
-	if err := l7.checkHttpsProxy(); err != nil {
+func (l7 *L7) edgeHopHttps(ctx context.Context) error {
+	defer l7.deleteOldSSLCerts(ctx)
+	// SSL certificates are only used by the target proxy.
+	endProxies := l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseProxies)
+	err := l7.checkSSLCert(ctx)
+	if err == nil {
+		err = l7.checkHttpsProxy(ctx)
+	}
+	endProxies()
+	if err != nil {
 		return err
 	}
-	return l7.checkHttpsForwardingRule()
+	defer l7.runtimeInfo.SyncTrace.Track(synctimeline.PhaseForwardingRules)()
+	return l7.checkHttpsForwardingRule(ctx)
 }
 
 // requireDeleteFrontend returns true if gce loadbalancer resources needs to deleted for given protocol.
@@ -250,21 +273,21 @@ func (l7 *L7) GetIP() string {
 }
 
 // deleteForwardingRule deletes forwarding rule for given protocol.
-func (l7 *L7) deleteForwardingRule(versions *features.ResourceVersions, protocol namer.NamerProtocol) error {
+func (l7 *L7) deleteForwardingRule(ctx context.Context, versions *features.ResourceVersions, protocol namer.NamerProtocol) error {
 	frName := l7.namer.ForwardingRule(protocol)
 	l7.logger.V(2).Info("Deleting forwarding rule", "forwardingRuleName", frName)
 	key, err := l7.CreateKey(frName)
 	if err != nil {
 		return err
 	}
-	if err := utils.IgnoreHTTPNotFound(composite.DeleteForwardingRule(l7.cloud, key, versions.ForwardingRule, l7.logger)); err != nil {
+	if err := utils.IgnoreHTTPNotFound(composite.DeleteForwardingRule(ctx, l7.cloud, key, versions.ForwardingRule, l7.logger)); err != nil {
 		return err
 	}
 	return nil
 }
 
 // deleteTargetProxy deletes target proxy for given protocol.
-func (l7 *L7) deleteTargetProxy(versions *features.ResourceVersions, protocol namer.NamerProtocol) error {
+func (l7 *L7) deleteTargetProxy(ctx context.Context, versions *features.ResourceVersions, protocol namer.NamerProtocol) error {
 	tpName := l7.namer.TargetProxy(protocol)
 	l7.logger.V(2).Info("Deleting target proxy", "protocol", protocol, "targetProxyName", tpName)
 	key, err := l7.CreateKey(tpName)
@@ -273,11 +296,11 @@ func (l7 *L7) deleteTargetProxy(versions *features.ResourceVersions, protocol na
 	}
 	switch protocol {
 	case namer.HTTPProtocol:
-		if err := utils.IgnoreHTTPNotFound(composite.DeleteTargetHttpProxy(l7.cloud, key, versions.TargetHttpProxy, l7.logger)); err != nil {
+		if err := utils.IgnoreHTTPNotFound(composite.DeleteTargetHttpProxy(ctx, l7.cloud, key, versions.TargetHttpProxy, l7.logger)); err != nil {
 			return err
 		}
 	case namer.HTTPSProtocol:
-		if err := utils.IgnoreHTTPNotFound(composite.DeleteTargetHttpsProxy(l7.cloud, key, versions.TargetHttpsProxy, l7.logger)); err != nil {
+		if err := utils.IgnoreHTTPNotFound(composite.DeleteTargetHttpsProxy(ctx, l7.cloud, key, versions.TargetHttpsProxy, l7.logger)); err != nil {
 			return err
 		}
 	default:
@@ -287,40 +310,40 @@ func (l7 *L7) deleteTargetProxy(versions *features.ResourceVersions, protocol na
 }
 
 // deleteHttp deletes http forwarding rule and target http proxy.
-func (l7 *L7) deleteHttp(versions *features.ResourceVersions) error {
+func (l7 *L7) deleteHttp(ctx context.Context, versions *features.ResourceVersions) error {
 	// Delete http forwarding rule.
-	if err := l7.deleteForwardingRule(versions, namer.HTTPProtocol); err != nil {
+	if err := l7.deleteForwardingRule(ctx, versions, namer.HTTPProtocol); err != nil {
 		return err
 	}
 	// Delete target http proxy.
-	return l7.deleteTargetProxy(versions, namer.HTTPProtocol)
+	return l7.deleteTargetProxy(ctx, versions, namer.HTTPProtocol)
 }
 
 // deleteHttps deletes https forwarding rule, target https proxy and ingress controller
 // managed ssl certificates.
-func (l7 *L7) deleteHttps(versions *features.ResourceVersions) error {
+func (l7 *L7) deleteHttps(ctx context.Context, versions *features.ResourceVersions) error {
 	// Delete https forwarding rule.
-	if err := l7.deleteForwardingRule(versions, namer.HTTPSProtocol); err != nil {
+	if err := l7.deleteForwardingRule(ctx, versions, namer.HTTPSProtocol); err != nil {
 		return err
 	}
 	// Get list of ssl certificates owned by this load-balancer that needs to be deleted.
 	// We are using https target proxy to list legacy certs, so this list needs to be
 	// populated before deleting https target proxy.
-	secretsSslCerts, err := l7.getIngressManagedSslCerts()
+	secretsSslCerts, err := l7.getIngressManagedSslCerts(ctx)
 	if err != nil {
 		return err
 	}
 	// Delete target https proxy.
-	if err := l7.deleteTargetProxy(versions, namer.HTTPSProtocol); err != nil {
+	if err := l7.deleteTargetProxy(ctx, versions, namer.HTTPSProtocol); err != nil {
 		return err
 	}
 	// Delete ingress managed ssl certificates those created from a secret,
 	// not referencing a pre-created GCE cert or managed certificates.
-	return l7.deleteSSLCertificates(secretsSslCerts, versions)
+	return l7.deleteSSLCertificates(ctx, secretsSslCerts, versions)
 }
 
 // deleteSSLCertificates deletes given ssl certificates.
-func (l7 *L7) deleteSSLCertificates(sslCertificates []*composite.SslCertificate, versions *features.ResourceVersions) error {
+func (l7 *L7) deleteSSLCertificates(ctx context.Context, sslCertificates []*composite.SslCertificate, versions *features.ResourceVersions) error {
 	if len(sslCertificates) == 0 {
 		return nil
 	}
@@ -331,7 +354,7 @@ func (l7 *L7) deleteSSLCertificates(sslCertificates []*composite.SslCertificate,
 		if err != nil {
 			return err
 		}
-		if err := utils.IgnoreHTTPNotFound(composite.DeleteSslCertificate(l7.cloud, key, versions.SslCertificate, l7.logger)); err != nil {
+		if err := utils.IgnoreHTTPNotFound(composite.DeleteSslCertificate(ctx, l7.cloud, key, versions.SslCertificate, l7.logger)); err != nil {
 			l7.logger.Error(err, "Old cert delete failed")
 			certErr = err
 		}
@@ -356,10 +379,10 @@ func (l7 *L7) deleteStaticIP() error {
 // Cleanup deletes resources specific to this l7 in the right order.
 // forwarding rule -> target proxy -> url map
 // This leaves backends and health checks, which are shared across loadbalancers.
-func (l7 *L7) Cleanup(versions *features.ResourceVersions) error {
+func (l7 *L7) Cleanup(ctx context.Context, versions *features.ResourceVersions) error {
 	var err error
 	// Delete http frontend resources.
-	if err := l7.deleteHttp(versions); err != nil {
+	if err := l7.deleteHttp(ctx, versions); err != nil {
 		return err
 	}
 	// Delete static ip.
@@ -367,7 +390,7 @@ func (l7 *L7) Cleanup(versions *features.ResourceVersions) error {
 		return err
 	}
 	// Delete https frontend resources.
-	if err := l7.deleteHttps(versions); err != nil {
+	if err := l7.deleteHttps(ctx, versions); err != nil {
 		return err
 	}
 	// Delete URL map.
@@ -377,7 +400,7 @@ func (l7 *L7) Cleanup(versions *features.ResourceVersions) error {
 	if err != nil {
 		return err
 	}
-	if err := utils.IgnoreHTTPNotFound(composite.DeleteUrlMap(l7.cloud, key, versions.UrlMap, l7.logger)); err != nil {
+	if err := utils.IgnoreHTTPNotFound(composite.DeleteUrlMap(ctx, l7.cloud, key, versions.UrlMap, l7.logger)); err != nil {
 		return err
 	}
 
@@ -392,7 +415,7 @@ func (l7 *L7) Cleanup(versions *features.ResourceVersions) error {
 	if err != nil {
 		return err
 	}
-	if err := utils.IgnoreHTTPNotFound(composite.DeleteUrlMap(l7.cloud, key, versions.UrlMap, l7.logger)); err != nil {
+	if err := utils.IgnoreHTTPNotFound(composite.DeleteUrlMap(ctx, l7.cloud, key, versions.UrlMap, l7.logger)); err != nil {
 		return err
 	}
 	return nil
@@ -454,7 +477,7 @@ func (l7 *L7) getFrontendAnnotations(existing map[string]string) map[string]stri
 }
 
 // GetLBAnnotations returns the annotations of an l7. This includes it's current status.
-func GetLBAnnotations(l7 *L7, existing map[string]string, backendSyncer *backends.Syncer, ingLogger klog.Logger) (map[string]string, error) {
+func GetLBAnnotations(ctx context.Context, l7 *L7, existing map[string]string, backendSyncer *backends.Syncer, ingLogger klog.Logger) (map[string]string, error) {
 	backends, err := getBackendNames(l7.um)
 	if err != nil {
 		return nil, err
@@ -462,7 +485,7 @@ func GetLBAnnotations(l7 *L7, existing map[string]string, backendSyncer *backend
 	backendState := map[string]string{}
 	for _, beName := range backends {
 		version := l7.Versions().BackendService
-		state, err := backendSyncer.Status(beName, version, l7.scope, ingLogger)
+		state, err := backendSyncer.Status(ctx, beName, version, l7.scope, ingLogger)
 		// Don't return error here since we want to keep syncing
 		if err != nil {
 			ingLogger.Error(err, "Error syncing backend status", "backendName", beName, "version", version, "scope", l7.scope)
@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -61,7 +62,7 @@ func NewLoadBalancerPool(cloud *gce.Cloud, v1NamerHelper namer_util.V1FrontendNa
 }
 
 // Ensure implements LoadBalancerPool.
-func (l7s *L7s) Ensure(ri *L7RuntimeInfo) (*L7, error) {
+func (l7s *L7s) Ensure(ctx context.Context, ri *L7RuntimeInfo) (*L7, error) {
 	lb := &L7{
 		runtimeInfo: ri,
 		cloud:       l7s.cloud,
@@ -78,14 +79,14 @@ func (l7s *L7s) Ensure(ri *L7RuntimeInfo) (*L7, error) {
 		return nil, err
 	}
 
-	if err := lb.edgeHop(); err != nil {
+	if err := lb.edgeHop(ctx); err != nil {
 		return nil, fmt.Errorf("loadbalancer %v does not exist: %v", lb.String(), err)
 	}
 	return lb, nil
 }
 
 // delete deletes a loadbalancer by frontend namer.
-func (l7s *L7s) delete(namer namer_util.IngressFrontendNamer, versions *features.ResourceVersions, scope meta.KeyType) error {
+func (l7s *L7s) delete(ctx context.Context, namer namer_util.IngressFrontendNamer, versions *features.ResourceVersions, scope meta.KeyType) error {
 	if !namer.IsValidLoadBalancer() {
 		l7s.logger.V(2).Info("Loadbalancer name invalid, skipping GC", "name", namer.LoadBalancer())
 		return nil
@@ -100,16 +101,16 @@ func (l7s *L7s) delete(namer namer_util.IngressFrontendNamer, versions *features
 
 	l7s.logger.V(2).Info("Deleting loadbalancer", "name", lb.String())
 
-	if err := lb.Cleanup(versions); err != nil {
+	if err := lb.Cleanup(ctx, versions); err != nil {
 		return err
 	}
 	return nil
 }
 
 // list returns a list of urlMaps (the top level LB resource) that belong to the cluster.
-func (l7s *L7s) list(key *meta.Key, version meta.Version) ([]*composite.UrlMap, error) {
+func (l7s *L7s) list(ctx context.Context, key *meta.Key, version meta.Version) ([]*composite.UrlMap, error) {
 	var result []*composite.UrlMap
-	urlMaps, err := composite.ListUrlMaps(l7s.cloud, key, version, l7s.logger, filter.None)
+	urlMaps, err := composite.ListUrlMaps(ctx, l7s.cloud, key, version, l7s.logger, filter.None)
 	if err != nil {
 		return nil, err
 	}
@@ -124,10 +125,10 @@ func (l7s *L7s) list(key *meta.Key, version meta.Version) ([]*composite.UrlMap,
 }
 
 // GCv2 implements LoadBalancerPool.
-func (l7s *L7s) GCv2(ing *v1.Ingress, scope meta.KeyType) error {
+func (l7s *L7s) GCv2(ctx context.Context, ing *v1.Ingress, scope meta.KeyType) error {
 	ingKey := common.NamespacedName(ing)
 	l7s.logger.V(2).Info("GCv2", "key", ingKey)
-	if err := l7s.delete(l7s.namerFactory.Namer(ing), features.VersionsFromIngress(ing), scope); err != nil {
+	if err := l7s.delete(ctx, l7s.namerFactory.Namer(ing), features.VersionsFromIngress(ing), scope); err != nil {
 		return err
 	}
 	l7s.logger.V(2).Info("GCv2 ok", "key", ingKey)
@@ -138,7 +139,7 @@ func (l7s *L7s) GCv2(ing *v1.Ingress, scope meta.KeyType) error {
 // (e.g. when a user migrates from ILB to ELB on the same ingress or vice versa.)
 // This only applies to the V2 Naming Scheme
 // TODO(shance): Refactor to avoid calling GCE every sync loop
-func (l7s *L7s) FrontendScopeChangeGC(ing *v1.Ingress, ingLogger klog.Logger) (*meta.KeyType, error) {
+func (l7s *L7s) FrontendScopeChangeGC(ctx context.Context, ing *v1.Ingress, ingLogger klog.Logger) (*meta.KeyType, error) {
 	if ing == nil {
 		return nil, nil
 	}
@@ -155,7 +156,7 @@ func (l7s *L7s) FrontendScopeChangeGC(ing *v1.Ingress, ingLogger klog.Logger) (*
 			}
 
 			// Look for existing LBs with the same name but of a different scope
-			_, err = composite.GetUrlMap(l7s.cloud, key, features.VersionsFromIngress(ing).UrlMap, l7s.logger)
+			_, err = composite.GetUrlMap(ctx, l7s.cloud, key, features.VersionsFromIngress(ing).UrlMap, l7s.logger)
 			if err == nil {
 				l7s.logger.V(2).Info("GC'ing ing for scope", "ing", ing, "scope", scope)
 				return &scope, nil
@@ -171,7 +172,7 @@ func (l7s *L7s) FrontendScopeChangeGC(ing *v1.Ingress, ingLogger klog.Logger) (*
 // DidRegionalClassChange detects if regional ingress changed type between ILB and RXLB.
 // We should garbage collect frontend resources on such change, because RXLB and ILB
 // use the same name, but different LoadBalancingScheme.
-func (l7s *L7s) DidRegionalClassChange(ing *v1.Ingress, ingLogger klog.Logger) (bool, error) {
+func (l7s *L7s) DidRegionalClassChange(ctx context.Context, ing *v1.Ingress, ingLogger klog.Logger) (bool, error) {
 	if ing == nil {
 		return false, nil
 	}
@@ -190,7 +191,7 @@ func (l7s *L7s) DidRegionalClassChange(ing *v1.Ingress, ingLogger klog.Logger) (
 		}
 		ingLogger.Info("Checking for existence of forwarding rule with different LoadBalancingScheme", "frKey", key)
 
-		fr, err := composite.GetForwardingRule(l7s.cloud, key, features.VersionsFromIngress(ing).ForwardingRule, ingLogger)
+		fr, err := composite.GetForwardingRule(ctx, l7s.cloud, key, features.VersionsFromIngress(ing).ForwardingRule, ingLogger)
 		if err == nil && fr.LoadBalancingScheme != currentLBScheme {
 			ingLogger.Info("ingress needs GC for changed lb scheme", "ingress", ing, "schemeToClean", fr.LoadBalancingScheme)
 			return true, nil
@@ -214,7 +215,7 @@ func lbSchemeForIngress(ing *v1.Ingress) string {
 
 // GCv1 implements LoadBalancerPool.
 // TODO(shance): Update to handle regional and global LB with same name
-func (l7s *L7s) GCv1(names []string) error {
+func (l7s *L7s) GCv1(ctx context.Context, names []string) error {
 	l7s.logger.V(2).Info("GCv1", "names", names)
 
 	knownLoadBalancers := make(map[namer_util.LoadBalancerName]bool)
@@ -227,22 +228,22 @@ func (l7s *L7s) GCv1(names []string) error {
 	if err != nil {
 		return fmt.Errorf("error getting regional key: %v", err)
 	}
-	urlMaps, err := l7s.list(key, features.L7ILBVersions().UrlMap)
+	urlMaps, err := l7s.list(ctx, key, features.L7ILBVersions().UrlMap)
 	if err != nil {
 		return fmt.Errorf("error listing regional LBs: %v", err)
 	}
 
-	if err := l7s.gc(urlMaps, knownLoadBalancers, features.L7ILBVersions()); err != nil {
+	if err := l7s.gc(ctx, urlMaps, knownLoadBalancers, features.L7ILBVersions()); err != nil {
 		return fmt.Errorf("error gc-ing regional LBs: %v", err)
 	}
 
 	// TODO(shance): fix list taking a key
-	urlMaps, err = l7s.list(meta.GlobalKey(""), meta.VersionGA)
+	urlMaps, err = l7s.list(ctx, meta.GlobalKey(""), meta.VersionGA)
 	if err != nil {
 		return fmt.Errorf("error listing global LBs: %v", err)
 	}
 
-	if errors := l7s.gc(urlMaps, knownLoadBalancers, features.GAResourceVersions); errors != nil {
+	if errors := l7s.gc(ctx, urlMaps, knownLoadBalancers, features.GAResourceVersions); errors != nil {
 		return fmt.Errorf("error gcing global LBs: %v", errors)
 	}
 
@@ -251,7 +252,7 @@ func (l7s *L7s) GCv1(names []string) error {
 
 // gc is a helper for GCv1.
 // TODO(shance): get versions from description
-func (l7s *L7s) gc(urlMaps []*composite.UrlMap, knownLoadBalancers map[namer_util.LoadBalancerName]bool, versions *features.ResourceVersions) []error {
+func (l7s *L7s) gc(ctx context.Context, urlMaps []*composite.UrlMap, knownLoadBalancers map[namer_util.LoadBalancerName]bool, versions *features.ResourceVersions) []error {
 	var errors []error
 
 	// Delete unknown loadbalancers
@@ -269,7 +270,7 @@ func (l7s *L7s) gc(urlMaps []*composite.UrlMap, knownLoadBalancers map[namer_uti
 			continue
 		}
 
-		if err := l7s.delete(l7s.namerFactory.NamerForLoadBalancer(l7Name), versions, scope); err != nil {
+		if err := l7s.delete(ctx, l7s.namerFactory.NamerForLoadBalancer(l7Name), versions, scope); err != nil {
 			errors = append(errors, fmt.Errorf("error deleting loadbalancer %q: %v", l7Name, err))
 		}
 	}
@@ -279,7 +280,7 @@ func (l7s *L7s) gc(urlMaps []*composite.UrlMap, knownLoadBalancers map[namer_uti
 // Shutdown implements LoadBalancerPool.
 func (l7s *L7s) Shutdown(ings []*v1.Ingress) error {
 	// Delete ingresses that use v1 naming scheme.
-	if err := l7s.GCv1([]string{}); err != nil {
+	if err := l7s.GCv1(context.Background(), []string{}); err != nil {
 		return fmt.Errorf("error deleting load-balancers for v1 naming policy: %v", err)
 	}
 	// Delete ingresses that use v2 naming policy.
@@ -288,7 +289,7 @@ func (l7s *L7s) Shutdown(ings []*v1.Ingress) error {
 		return namer_util.FrontendNamingScheme(ing, l7s.logger) == namer_util.V2NamingScheme
 	}).AsList()
 	for _, ing := range v2Ings {
-		if err := l7s.GCv2(ing, features.ScopeFromIngress(ing)); err != nil {
+		if err := l7s.GCv2(context.Background(), ing, features.ScopeFromIngress(ing)); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -300,13 +301,13 @@ func (l7s *L7s) Shutdown(ings []*v1.Ingress) error {
 }
 
 // HasUrlMap implements LoadBalancerPool.
-func (l7s *L7s) HasUrlMap(ing *v1.Ingress) (bool, error) {
+func (l7s *L7s) HasUrlMap(ctx context.Context, ing *v1.Ingress) (bool, error) {
 	namer := l7s.namerFactory.Namer(ing)
 	key, err := composite.CreateKey(l7s.cloud, namer.UrlMap(), features.ScopeFromIngress(ing))
 	if err != nil {
 		return false, err
 	}
-	if _, err := composite.GetUrlMap(l7s.cloud, key, features.VersionsFromIngress(ing).UrlMap, l7s.logger); err != nil {
+	if _, err := composite.GetUrlMap(ctx, l7s.cloud, key, features.VersionsFromIngress(ing).UrlMap, l7s.logger); err != nil {
 		if utils.IsHTTPErrorCode(err, http.StatusNotFound) {
 			return false, nil
 		}
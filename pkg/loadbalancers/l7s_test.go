@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	context2 "context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -191,7 +192,7 @@ func TestGC(t *testing.T) {
 			createFakeLoadbalancer(cloud, namer, versions, defaultScope)
 		}
 
-		err := l7sPool.GCv1(tc.ingressLBs)
+		err := l7sPool.GCv1(context2.Background(), tc.ingressLBs)
 		if err != nil {
 			t.Errorf("For case %q, do not expectEqual err: %v", tc.desc, err)
 		}
@@ -253,7 +254,7 @@ func TestDoNotGCWantedLB(t *testing.T) {
 	for _, tc := range testCases {
 		namer := l7sPool.namerFactory.NamerForLoadBalancer(l7sPool.v1NamerHelper.LoadBalancer(tc.key))
 		createFakeLoadbalancer(l7sPool.cloud, namer, versions, defaultScope)
-		err := l7sPool.GCv1([]string{tc.key})
+		err := l7sPool.GCv1(context2.Background(), []string{tc.key})
 		if err != nil {
 			t.Errorf("For case %q, do not expectEqual err: %v", tc.desc, err)
 		}
@@ -289,7 +290,7 @@ func TestGCToLeakLB(t *testing.T) {
 	for _, tc := range testCases {
 		namer := l7sPool.namerFactory.NamerForLoadBalancer(l7sPool.v1NamerHelper.LoadBalancer(tc.key))
 		createFakeLoadbalancer(l7sPool.cloud, namer, versions, defaultScope)
-		err := l7sPool.GCv1([]string{})
+		err := l7sPool.GCv1(context2.Background(), []string{})
 		if err != nil {
 			t.Errorf("For case %q, do not expectEqual err: %v", tc.desc, err)
 		}
@@ -527,7 +528,7 @@ func TestV2GC(t *testing.T) {
 				createFakeLoadbalancer(cloud, feNamerFactory.Namer(ing), versions, defaultScope)
 			}
 
-			err := l7sPool.GCv2(tc.ingressToDelete, features.ScopeFromIngress(tc.ingressToDelete))
+			err := l7sPool.GCv2(context2.Background(), tc.ingressToDelete, features.ScopeFromIngress(tc.ingressToDelete))
 			if err != nil {
 				t.Errorf("l7sPool.GC(%q) = %v, want nil for case %q", common.NamespacedName(tc.ingressToDelete), err, tc.desc)
 			}
@@ -606,7 +607,7 @@ func TestDoNotLeakV2LB(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			feNamer := feNamerFactory.Namer(tc.ing)
 			createFakeLoadbalancer(l7sPool.cloud, feNamer, versions, defaultScope)
-			err := l7sPool.GCv2(tc.ing, features.ScopeFromIngress(tc.ing))
+			err := l7sPool.GCv2(context2.Background(), tc.ing, features.ScopeFromIngress(tc.ing))
 			if err != nil {
 				t.Errorf("l7sPool.GC(%q) = %v, want nil for case %q", common.NamespacedName(tc.ing), err, tc.desc)
 			}
@@ -801,14 +802,14 @@ func TestDidRegionalClassChange(t *testing.T) {
 					t.Fatalf("composite.CreateKey(..., %s, %v) returned error %v", fr.Name, meta.Regional, err)
 				}
 
-				err = composite.CreateForwardingRule(cloud, key, fr, klog.TODO())
+				err = composite.CreateForwardingRule(context2.Background(), cloud, key, fr, klog.TODO())
 				if err != nil {
 					t.Fatalf("composite.CreateForwardingRule(..., %v, %v, ...) returned error %v", key, fr, err)
 				}
 				t.Logf("Forwarding rule %+v created. Region: %v", fr, cloud.Region())
 			}
 
-			gotDidClassChange, err := l7sPool.DidRegionalClassChange(tc.ingress, klog.TODO())
+			gotDidClassChange, err := l7sPool.DidRegionalClassChange(context2.Background(), tc.ingress, klog.TODO())
 			if err != nil {
 				t.Fatalf("l7sPool.DidRegionalClassChange(%v, ...) returned error %v", tc.ingress, err)
 			}
@@ -831,13 +832,13 @@ func createFakeLoadbalancer(cloud *gce.Cloud, namer namer_util.IngressFrontendNa
 	key, _ := composite.CreateKey(cloud, "", scope)
 
 	key.Name = namer.ForwardingRule(namer_util.HTTPProtocol)
-	composite.CreateForwardingRule(cloud, key, &composite.ForwardingRule{Name: key.Name, Version: versions.ForwardingRule}, klog.TODO())
+	composite.CreateForwardingRule(context2.Background(), cloud, key, &composite.ForwardingRule{Name: key.Name, Version: versions.ForwardingRule}, klog.TODO())
 
 	key.Name = namer.TargetProxy(namer_util.HTTPProtocol)
-	composite.CreateTargetHttpProxy(cloud, key, &composite.TargetHttpProxy{Name: key.Name, Version: versions.TargetHttpProxy}, klog.TODO())
+	composite.CreateTargetHttpProxy(context2.Background(), cloud, key, &composite.TargetHttpProxy{Name: key.Name, Version: versions.TargetHttpProxy}, klog.TODO())
 
 	key.Name = namer.UrlMap()
-	composite.CreateUrlMap(cloud, key, &composite.UrlMap{Name: key.Name, Version: versions.UrlMap}, klog.TODO())
+	composite.CreateUrlMap(context2.Background(), cloud, key, &composite.UrlMap{Name: key.Name, Version: versions.UrlMap}, klog.TODO())
 
 	cloud.ReserveGlobalAddress(&compute.Address{Name: namer.ForwardingRule(namer_util.HTTPProtocol)})
 
@@ -846,13 +847,13 @@ func createFakeLoadbalancer(cloud *gce.Cloud, namer namer_util.IngressFrontendNa
 func removeFakeLoadBalancer(cloud *gce.Cloud, namer namer_util.IngressFrontendNamer, versions *features.ResourceVersions, scope meta.KeyType) {
 	key, _ := composite.CreateKey(cloud, "", scope)
 	key.Name = namer.ForwardingRule(namer_util.HTTPProtocol)
-	composite.DeleteForwardingRule(cloud, key, versions.ForwardingRule, klog.TODO())
+	composite.DeleteForwardingRule(context2.Background(), cloud, key, versions.ForwardingRule, klog.TODO())
 
 	key.Name = namer.TargetProxy(namer_util.HTTPProtocol)
-	composite.DeleteTargetHttpProxy(cloud, key, versions.TargetHttpProxy, klog.TODO())
+	composite.DeleteTargetHttpProxy(context2.Background(), cloud, key, versions.TargetHttpProxy, klog.TODO())
 
 	key.Name = namer.UrlMap()
-	composite.DeleteUrlMap(cloud, key, versions.UrlMap, klog.TODO())
+	composite.DeleteUrlMap(context2.Background(), cloud, key, versions.UrlMap, klog.TODO())
 
 	cloud.DeleteGlobalAddress(namer.ForwardingRule(namer_util.HTTPProtocol))
 }
@@ -862,7 +863,7 @@ func checkFakeLoadBalancer(cloud *gce.Cloud, namer namer_util.IngressFrontendNam
 		return err
 	}
 	key, _ := composite.CreateKey(cloud, namer.UrlMap(), scope)
-	_, err := composite.GetUrlMap(cloud, key, versions.UrlMap, klog.TODO())
+	_, err := composite.GetUrlMap(context2.Background(), cloud, key, versions.UrlMap, klog.TODO())
 	if expectPresent && err != nil {
 		return err
 	}
@@ -883,7 +884,7 @@ func checkFakeLoadBalancerWithProtocol(cloud *gce.Cloud, namer namer_util.Ingres
 	var err error
 	key, _ := composite.CreateKey(cloud, namer.ForwardingRule(protocol), scope)
 
-	_, err = composite.GetForwardingRule(cloud, key, versions.ForwardingRule, klog.TODO())
+	_, err = composite.GetForwardingRule(context2.Background(), cloud, key, versions.ForwardingRule, klog.TODO())
 	if expectPresent && err != nil {
 		return err
 	}
@@ -893,9 +894,9 @@ func checkFakeLoadBalancerWithProtocol(cloud *gce.Cloud, namer namer_util.Ingres
 
 	key.Name = namer.TargetProxy(protocol)
 	if protocol == namer_util.HTTPProtocol {
-		_, err = composite.GetTargetHttpProxy(cloud, key, versions.TargetHttpProxy, klog.TODO())
+		_, err = composite.GetTargetHttpProxy(context2.Background(), cloud, key, versions.TargetHttpProxy, klog.TODO())
 	} else {
-		_, err = composite.GetTargetHttpsProxy(cloud, key, versions.TargetHttpsProxy, klog.TODO())
+		_, err = composite.GetTargetHttpsProxy(context2.Background(), cloud, key, versions.TargetHttpsProxy, klog.TODO())
 	}
 	if expectPresent && err != nil {
 		return err
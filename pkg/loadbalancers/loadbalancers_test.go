@@ -100,7 +100,7 @@ func newTestJig(t *testing.T) *testJig {
 			if err != nil {
 				return err
 			}
-			_, err = composite.GetSslCertificate(fakeGCE, resID.Key, defaultVersion, klog.TODO())
+			_, err = composite.GetSslCertificate(context.Background(), fakeGCE, resID.Key, defaultVersion, klog.TODO())
 			if err != nil {
 				return err
 			}
@@ -177,7 +177,7 @@ func TestCreateHTTPLoadBalancer(t *testing.T) {
 		Ingress:   newIngress(),
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created, err: %v", err)
 	}
@@ -198,7 +198,7 @@ func TestCreateHTTPILBLoadBalancer(t *testing.T) {
 		Ingress:   newILBIngress(),
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created, err: %v", err)
 	}
@@ -216,7 +216,7 @@ func TestCreateHTTPILBLoadBalancerStaticIp(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = composite.CreateAddress(j.fakeGCE, key, &composite.Address{Name: ipName, Version: meta.VersionGA, Address: ip}, klog.TODO())
+	err = composite.CreateAddress(context.Background(), j.fakeGCE, key, &composite.Address{Name: ipName, Version: meta.VersionGA, Address: ip}, klog.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -231,7 +231,7 @@ func TestCreateHTTPILBLoadBalancerStaticIp(t *testing.T) {
 		StaticIPName: ipName,
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created, err: %v", err)
 	}
@@ -254,7 +254,7 @@ func TestCreateHTTPSILBLoadBalancer(t *testing.T) {
 		Ingress:   newILBIngress(),
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created")
 	}
@@ -276,7 +276,7 @@ func TestCreateHTTPSILBLoadBalancerAllowHTTP(t *testing.T) {
 		Ingress:   newILBIngress(),
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err == nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err == nil {
 		t.Fatalf("j.pool.Ensure(%v) = nil, want err", lbInfo)
 	}
 }
@@ -292,7 +292,7 @@ func TestCreateHTTPSILBLoadBalancerAllowHTTPSharedVIP(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = composite.CreateAddress(j.fakeGCE, key, &composite.Address{Name: ipName, Version: meta.VersionGA, Address: ip}, klog.TODO())
+	err = composite.CreateAddress(context.Background(), j.fakeGCE, key, &composite.Address{Name: ipName, Version: meta.VersionGA, Address: ip}, klog.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -308,7 +308,7 @@ func TestCreateHTTPSILBLoadBalancerAllowHTTPSharedVIP(t *testing.T) {
 		StaticIPName: ipName,
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure(%v) = %v, want nil", lbInfo, err)
 	}
 }
@@ -328,7 +328,7 @@ func TestCreateHTTPSLoadBalancer(t *testing.T) {
 		Ingress:   newIngress(),
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created")
 	}
@@ -343,11 +343,11 @@ func verifyHTTPSForwardingRuleAndProxyLinks(t *testing.T, j *testJig, l7 *L7) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	um, err := composite.GetUrlMap(j.fakeGCE, key, versions.UrlMap, klog.TODO())
+	um, err := composite.GetUrlMap(context.Background(), j.fakeGCE, key, versions.UrlMap, klog.TODO())
 
 	tpsName := l7.namer.TargetProxy(namer_util.HTTPSProtocol)
 	key.Name = tpsName
-	tps, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, versions.TargetHttpsProxy, klog.TODO())
+	tps, err := composite.GetTargetHttpsProxy(context.Background(), j.fakeGCE, key, versions.TargetHttpsProxy, klog.TODO())
 	if err != nil {
 		t.Fatalf("j.fakeGCE.GetTargetHTTPSProxy(%q) = _, %v; want nil", tpsName, err)
 	}
@@ -357,7 +357,7 @@ func verifyHTTPSForwardingRuleAndProxyLinks(t *testing.T, j *testJig, l7 *L7) {
 
 	fwsName := l7.namer.ForwardingRule(namer_util.HTTPSProtocol)
 	key.Name = fwsName
-	fws, err := composite.GetForwardingRule(j.fakeGCE, key, versions.ForwardingRule, klog.TODO())
+	fws, err := composite.GetForwardingRule(context.Background(), j.fakeGCE, key, versions.ForwardingRule, klog.TODO())
 	if err != nil {
 		t.Fatalf("j.fakeGCE.GetGlobalForwardingRule(%q) = _, %v, want nil", fwsName, err)
 	}
@@ -377,10 +377,10 @@ func verifyHTTPForwardingRuleAndProxyLinks(t *testing.T, j *testJig, l7 *L7, ip
 	if err != nil {
 		t.Fatal(err)
 	}
-	um, err := composite.GetUrlMap(j.fakeGCE, key, versions.UrlMap, klog.TODO())
+	um, err := composite.GetUrlMap(context.Background(), j.fakeGCE, key, versions.UrlMap, klog.TODO())
 	tpName := l7.namer.TargetProxy(namer_util.HTTPProtocol)
 	key.Name = tpName
-	tps, err := composite.GetTargetHttpProxy(j.fakeGCE, key, versions.TargetHttpProxy, klog.TODO())
+	tps, err := composite.GetTargetHttpProxy(context.Background(), j.fakeGCE, key, versions.TargetHttpProxy, klog.TODO())
 	if err != nil {
 		t.Fatalf("j.fakeGCE.GetTargetHTTPProxy(%q) = _, %v; want nil", tpName, err)
 	}
@@ -389,7 +389,7 @@ func verifyHTTPForwardingRuleAndProxyLinks(t *testing.T, j *testJig, l7 *L7, ip
 	}
 	fwName := l7.namer.ForwardingRule(namer_util.HTTPProtocol)
 	key.Name = fwName
-	fws, err := composite.GetForwardingRule(j.fakeGCE, key, versions.ForwardingRule, klog.TODO())
+	fws, err := composite.GetForwardingRule(context.Background(), j.fakeGCE, key, versions.ForwardingRule, klog.TODO())
 	if err != nil {
 		t.Fatalf("j.fakeGCE.GetGlobalForwardingRule(%q) = _, %v, want nil", fwName, err)
 	}
@@ -427,7 +427,7 @@ func TestCertUpdate(t *testing.T) {
 	}
 
 	// Sync first cert
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
@@ -438,7 +438,7 @@ func TestCertUpdate(t *testing.T) {
 
 	// Sync with different cert
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key2", "cert2", "name")}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts = map[string]string{certName2: lbInfo.TLS[0].Cert}
@@ -466,7 +466,7 @@ func TestMultipleSecretsWithSameCert(t *testing.T) {
 	}
 
 	// Sync first cert
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	certName := feNamer.SSLCertName(translator.GetCertHash("cert"))
@@ -500,14 +500,14 @@ func TestCertCreationWithCollision(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        certName1,
 		Certificate: "cert",
 		SelfLink:    "existing",
 	}, klog.TODO())
 
 	// Sync first cert
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
@@ -517,7 +517,7 @@ func TestCertCreationWithCollision(t *testing.T) {
 	// Create another cert where the name matches that of another cert, but contents are different - xyz != cert2.
 	// Simulates a hash collision
 	key.Name = certName2
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        certName2,
 		Certificate: "xyz",
 		SelfLink:    "existing",
@@ -525,7 +525,7 @@ func TestCertCreationWithCollision(t *testing.T) {
 
 	// Sync with different cert
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key2", "cert2", "name")}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts = map[string]string{certName2: "xyz"}
@@ -560,24 +560,24 @@ func TestMultipleCertRetentionAfterRestart(t *testing.T) {
 
 	firstPool := j.pool
 
-	firstPool.Ensure(lbInfo)
+	firstPool.Ensure(context.Background(), lbInfo)
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 	// Update config to use 2 certs
 	lbInfo.TLS = []*translator.TLSCerts{cert1, cert2}
 	expectCerts[certName2] = cert2.Cert
-	firstPool.Ensure(lbInfo)
+	firstPool.Ensure(context.Background(), lbInfo)
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 
 	// Restart of controller represented by a new pool
 	secondPool := newFakeLoadBalancerPool(j.fakeGCE, t, j.namer)
-	secondPool.Ensure(lbInfo)
+	secondPool.Ensure(context.Background(), lbInfo)
 	// Verify both certs are still present
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 
 	// Replace the 2 certs with a different, single cert
 	lbInfo.TLS = []*translator.TLSCerts{cert3}
 	expectCerts = map[string]string{certName3: cert3.Cert}
-	secondPool.Ensure(lbInfo)
+	secondPool.Ensure(context.Background(), lbInfo)
 	// Only the new cert should be present
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 }
@@ -608,8 +608,8 @@ func TestUpgradeToNewCertNames(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, sslCert, klog.TODO())
-	sslCert, _ = composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, sslCert, klog.TODO())
+	sslCert, _ = composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	tpName := feNamer.TargetProxy(namer_util.HTTPSProtocol)
 	newProxy := &composite.TargetHttpsProxy{
 		Name:            tpName,
@@ -618,11 +618,11 @@ func TestUpgradeToNewCertNames(t *testing.T) {
 		Version:         defaultVersion,
 	}
 	key.Name = tpName
-	err = composite.CreateTargetHttpsProxy(j.fakeGCE, key, newProxy, klog.TODO())
+	err = composite.CreateTargetHttpsProxy(context.Background(), j.fakeGCE, key, newProxy, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to create Target proxy %v - %v", newProxy, err)
 	}
-	proxyCerts, err := composite.ListSslCertificates(j.fakeGCE, key, defaultVersion, klog.TODO(), filter.None)
+	proxyCerts, err := composite.ListSslCertificates(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO(), filter.None)
 	if err != nil {
 		t.Fatalf("Failed to list certs for load balancer %v - %v", j, err)
 	}
@@ -634,7 +634,7 @@ func TestUpgradeToNewCertNames(t *testing.T) {
 		t.Fatalf("Expected cert with name %s, Got %s", oldCertName, proxyCerts[0].Name)
 	}
 	// Sync should replace this oldCert with one following the new naming scheme
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	// We expectEqual to see only the new cert linked to the proxy and available in the load balancer.
@@ -669,14 +669,14 @@ func TestMaxCertsUpload(t *testing.T) {
 		Ingress:   ing,
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 	failCert := createCert("key100", "cert100", "name100")
 	lbInfo.TLS = append(lbInfo.TLS, failCert)
-	_, err := j.pool.Ensure(lbInfo)
+	_, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err == nil {
 		t.Fatalf("Creating more than %d certs should have errored out", FakeCertQuota)
 	}
@@ -686,7 +686,7 @@ func TestMaxCertsUpload(t *testing.T) {
 	for _, cert := range lbInfo.TLS {
 		expectCertsExtra[feNamer.SSLCertName(cert.CertHash)] = cert.Cert
 	}
-	_, err = j.pool.Ensure(lbInfo)
+	_, err = j.pool.Ensure(context.Background(), lbInfo)
 	if err == nil {
 		t.Fatalf("Assigning more than %d certs should have errored out", TargetProxyCertLimit)
 	}
@@ -694,7 +694,7 @@ func TestMaxCertsUpload(t *testing.T) {
 	verifyCertAndProxyLink(expectCertsExtra, expectCerts, j, t)
 	// Removing the extra cert from ingress spec should delete it
 	lbInfo.TLS = lbInfo.TLS[:TargetProxyCertLimit]
-	_, err = j.pool.Ensure(lbInfo)
+	_, err = j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil {
 		t.Fatalf("Unexpected error %s", err)
 	}
@@ -736,13 +736,13 @@ func TestIdenticalHostnameCerts(t *testing.T) {
 
 	// Sync multiple times to make sure ordering is preserved
 	for i := 0; i < 10; i++ {
-		if _, err := j.pool.Ensure(lbInfo); err != nil {
+		if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 			t.Fatalf("pool.Ensure() = err %v", err)
 		}
 		verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 		// Fetch the target proxy certs and go through in order
 		verifyProxyCertsInOrder(" foo.com", j, t)
-		j.pool.delete(feNamer, features.GAResourceVersions, defaultScope)
+		j.pool.delete(context.Background(), feNamer, features.GAResourceVersions, defaultScope)
 	}
 }
 
@@ -762,28 +762,28 @@ func TestIdenticalHostnameCertsPreShared(t *testing.T) {
 		t.Fatal(err)
 	}
 	// Prepare pre-shared certs.
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert",
 		Certificate: "cert-0 foo.com",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert1, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert1, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	key.Name = "test-pre-shared-cert1"
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert1",
 		Certificate: "cert-1 foo.com",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert2, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert2, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	key.Name = "test-pre-shared-cert2"
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert2",
 		Certificate: "cert2",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert3, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert3, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	expectCerts := map[string]string{preSharedCert1.Name: preSharedCert1.Certificate,
 		preSharedCert2.Name: preSharedCert2.Certificate, preSharedCert3.Name: preSharedCert3.Certificate}
@@ -791,14 +791,14 @@ func TestIdenticalHostnameCertsPreShared(t *testing.T) {
 	lbInfo.TLSName = preSharedCert1.Name + "," + preSharedCert2.Name + "," + preSharedCert3.Name
 	// Sync multiple times to make sure ordering is preserved
 	for i := 0; i < 10; i++ {
-		if _, err := j.pool.Ensure(lbInfo); err != nil {
+		if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 			t.Fatalf("pool.Ensure() = err %v", err)
 		}
 		verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 		// Fetch the target proxy certs and go through in order
 		verifyProxyCertsInOrder(" foo.com", j, t)
 		feNamer := namer_util.NewFrontendNamerFactory(j.namer, "", klog.TODO()).Namer(lbInfo.Ingress)
-		j.pool.delete(feNamer, features.GAResourceVersions, defaultScope)
+		j.pool.delete(context.Background(), feNamer, features.GAResourceVersions, defaultScope)
 	}
 }
 
@@ -826,27 +826,27 @@ func TestPreSharedToSecretBasedCertUpdate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert",
 		Certificate: "abc",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert1, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert1, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	// Prepare pre-shared certs.
 	key.Name = "test-pre-shared-cert2"
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert2",
 		Certificate: "xyz",
 		SelfLink:    "existing",
 		Version:     defaultVersion,
 	}, klog.TODO())
-	preSharedCert2, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert2, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	lbInfo.TLSName = preSharedCert1.Name + "," + preSharedCert2.Name
 
 	// Sync pre-shared certs.
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts := map[string]string{preSharedCert1.Name: preSharedCert1.Certificate,
@@ -856,7 +856,7 @@ func TestPreSharedToSecretBasedCertUpdate(t *testing.T) {
 	// Updates from pre-shared cert to secret based cert.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key", "cert", "name")}
 	lbInfo.TLSName = ""
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts[certName1] = lbInfo.TLS[0].Cert
@@ -866,7 +866,7 @@ func TestPreSharedToSecretBasedCertUpdate(t *testing.T) {
 
 	// Sync a different cert.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key2", "cert2", "name")}
-	j.pool.Ensure(lbInfo)
+	j.pool.Ensure(context.Background(), lbInfo)
 	delete(expectCerts, certName1)
 	expectCerts[certName2] = lbInfo.TLS[0].Cert
 	expectCertsProxy = map[string]string{certName2: lbInfo.TLS[0].Cert}
@@ -874,11 +874,11 @@ func TestPreSharedToSecretBasedCertUpdate(t *testing.T) {
 
 	// Check if pre-shared certs are retained.
 	key.Name = preSharedCert1.Name
-	if cert, err := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO()); err != nil || cert == nil {
+	if cert, err := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO()); err != nil || cert == nil {
 		t.Fatalf("Want pre-shared certificate %v to exist, got none, err: %v", preSharedCert1.Name, err)
 	}
 	key.Name = preSharedCert2.Name
-	if cert, err := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO()); err != nil || cert == nil {
+	if cert, err := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO()); err != nil || cert == nil {
 		t.Fatalf("Want pre-shared certificate %v to exist, got none, err: %v", preSharedCert2.Name, err)
 	}
 }
@@ -892,7 +892,7 @@ func verifyProxyCertsInOrder(hostname string, j *testJig, t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	tps, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, defaultVersion, klog.TODO())
+	tps, err := composite.GetTargetHttpsProxy(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	if err != nil {
 		t.Fatalf("expected https proxy to exist: %v, err: %v", TPName, err)
 	}
@@ -902,7 +902,7 @@ func verifyProxyCertsInOrder(hostname string, j *testJig, t *testing.T) {
 	for _, link := range tps.SslCertificates {
 		certName, _ := utils.KeyName(link)
 		key.Name = certName
-		cert, err := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+		cert, err := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 		if err != nil {
 			t.Fatalf("Failed to fetch certificate from link %s - %v", link, err)
 		}
@@ -931,7 +931,7 @@ func verifyCertAndProxyLink(expectCerts map[string]string, expectCertsProxy map[
 	if err != nil {
 		t.Fatal(err)
 	}
-	allCerts, err := composite.ListSslCertificates(j.fakeGCE, key, defaultVersion, klog.TODO(), filter.None)
+	allCerts, err := composite.ListSslCertificates(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO(), filter.None)
 	if err != nil {
 		t.Fatalf("Failed to list certificates for %v - %v", j, err)
 	}
@@ -941,7 +941,7 @@ func verifyCertAndProxyLink(expectCerts map[string]string, expectCertsProxy map[
 	}
 	for certName, certValue := range expectCerts {
 		key.Name = certName
-		cert, err := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+		cert, err := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 		if err != nil {
 			t.Fatalf("expected ssl certificate to exist: %v, err: %v, all certs: %v", certName, err, toCertNames(allCerts))
 		}
@@ -956,7 +956,7 @@ func verifyCertAndProxyLink(expectCerts map[string]string, expectCertsProxy map[
 	if err != nil {
 		t.Fatal(err)
 	}
-	tps, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, defaultVersion, klog.TODO())
+	tps, err := composite.GetTargetHttpsProxy(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	if err != nil {
 		// Return immediately if expected certs is an empty map.
 		if len(expectCertsProxy) == 0 && err.(*googleapi.Error).Code == http.StatusNotFound {
@@ -1003,13 +1003,13 @@ func TestCreateHTTPSLoadBalancerAnnotationCert(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name: tlsName,
 	}, klog.TODO())
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created")
 	}
@@ -1032,10 +1032,10 @@ func TestCreateBothLoadBalancers(t *testing.T) {
 		Ingress:   newIngress(),
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created")
 	}
@@ -1050,9 +1050,9 @@ func TestCreateBothLoadBalancers(t *testing.T) {
 	}
 
 	key.Name = j.feNamer.ForwardingRule(namer_util.HTTPSProtocol)
-	fws, _ := composite.GetForwardingRule(j.fakeGCE, key, defaultVersion, klog.TODO())
+	fws, _ := composite.GetForwardingRule(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	key.Name = j.feNamer.ForwardingRule(namer_util.HTTPProtocol)
-	fw, _ := composite.GetForwardingRule(j.fakeGCE, key, defaultVersion, klog.TODO())
+	fw, _ := composite.GetForwardingRule(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	ip, err := j.fakeGCE.GetGlobalAddress(j.feNamer.ForwardingRule(namer_util.HTTPProtocol))
 	if err != nil {
 		t.Fatalf("%v", err)
@@ -1081,7 +1081,7 @@ func TestStaticIP(t *testing.T) {
 		StaticIPName: "teststaticip",
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err == nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err == nil {
 		t.Fatalf("expected error ensuring ingress with non-existent static ip")
 	}
 	// Create static IP
@@ -1089,7 +1089,7 @@ func TestStaticIP(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ip address reservation failed - %v", err)
 	}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("unexpected error %v", err)
 	}
 }
@@ -1109,13 +1109,13 @@ func TestFrontendConfigSslPolicy(t *testing.T) {
 		FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{SslPolicy: ptr.To("test-policy")}},
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil {
 		t.Fatalf("j.pool.Ensure(%v) = %v, want nil", lbInfo, err)
 	}
 
 	tpsName := l7.tps.Name
-	tps, _ := composite.GetTargetHttpsProxy(j.fakeGCE, meta.GlobalKey(tpsName), meta.VersionGA, klog.TODO())
+	tps, _ := composite.GetTargetHttpsProxy(context.Background(), j.fakeGCE, meta.GlobalKey(tpsName), meta.VersionGA, klog.TODO())
 
 	resourceID, err := cloud.ParseResourceURL(tps.SslPolicy)
 	if err != nil {
@@ -1148,7 +1148,7 @@ func TestFrontendConfigRedirects(t *testing.T) {
 		FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{RedirectToHttps: &frontendconfigv1beta1.HttpsRedirectConfig{Enabled: true}}},
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil {
 		t.Fatalf("j.pool.Ensure(%v) = %v, want nil", lbInfo, err)
 	}
@@ -1161,7 +1161,7 @@ func TestFrontendConfigRedirects(t *testing.T) {
 	}
 
 	tpName := l7.tp.Name
-	tp, err := composite.GetTargetHttpProxy(j.fakeGCE, meta.GlobalKey(tpName), meta.VersionGA, klog.TODO())
+	tp, err := composite.GetTargetHttpProxy(context.Background(), j.fakeGCE, meta.GlobalKey(tpName), meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1233,17 +1233,17 @@ func TestEnsureSslPolicy(t *testing.T) {
 
 	for _, tc := range testCases {
 		key := meta.GlobalKey(tc.proxy.Name)
-		if err := composite.CreateTargetHttpsProxy(j.fakeGCE, key, tc.proxy, klog.TODO()); err != nil {
+		if err := composite.CreateTargetHttpsProxy(context.Background(), j.fakeGCE, key, tc.proxy, klog.TODO()); err != nil {
 			t.Error(err)
 		}
 		l7 := L7{runtimeInfo: &L7RuntimeInfo{FrontendConfig: tc.fc}, cloud: j.fakeGCE, scope: meta.Global}
 		env := &translator.Env{FrontendConfig: tc.fc}
 
-		if err := l7.ensureSslPolicy(env, tc.proxy, tc.policyLink); err != nil {
+		if err := l7.ensureSslPolicy(context.Background(), env, tc.proxy, tc.policyLink); err != nil {
 			t.Errorf("desc: %q, l7.ensureSslPolicy() = %v, want nil", tc.desc, err)
 		}
 
-		result, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, meta.VersionGA, klog.TODO())
+		result, err := composite.GetTargetHttpsProxy(context.Background(), j.fakeGCE, key, meta.VersionGA, klog.TODO())
 		if err != nil {
 			t.Error(err)
 		}
@@ -1263,7 +1263,7 @@ func verifyURLMap(t *testing.T, j *testJig, feNamer namer_util.IngressFrontendNa
 	if err != nil {
 		t.Fatal(err)
 	}
-	um, err := composite.GetUrlMap(j.fakeGCE, key, defaultVersion, klog.TODO())
+	um, err := composite.GetUrlMap(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	if err != nil || um == nil {
 		t.Errorf("j.fakeGCE.GetUrlMap(%q) = %v, %v; want _, nil", name, um, err)
 	}
@@ -1291,11 +1291,11 @@ func TestUrlMapChange(t *testing.T) {
 
 	lbInfo := &L7RuntimeInfo{AllowHTTP: true, UrlMap: um1, Ingress: newIngress()}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil {
 		t.Fatalf("%v", err)
 	}
@@ -1303,10 +1303,10 @@ func TestUrlMapChange(t *testing.T) {
 
 	// Change url map.
 	lbInfo.UrlMap = um2
-	if _, err = j.pool.Ensure(lbInfo); err != nil {
+	if _, err = j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
-	l7, err = j.pool.Ensure(lbInfo)
+	l7, err = j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil {
 		t.Fatalf("%v", err)
 	}
@@ -1344,12 +1344,12 @@ func TestPoolSyncNoChanges(t *testing.T) {
 	um2.DefaultBackend = &utils.ServicePort{NodePort: 30003, BackendNamer: j.namer}
 
 	lbInfo := &L7RuntimeInfo{AllowHTTP: true, UrlMap: um1, Ingress: newIngress()}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
 	lbInfo.UrlMap = um2
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 
@@ -1389,10 +1389,10 @@ func TestClusterNameChange(t *testing.T) {
 		UrlMap:    gceUrlMap,
 		Ingress:   newIngress(),
 	}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure() = err %v", err)
 	}
-	l7, err := j.pool.Ensure(lbInfo)
+	l7, err := j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || l7 == nil {
 		t.Fatalf("Expected l7 not created")
 	}
@@ -1403,7 +1403,7 @@ func TestClusterNameChange(t *testing.T) {
 	j.namer.SetUID(newName)
 
 	// Now the components should get renamed with the next suffix.
-	l7, err = j.pool.Ensure(lbInfo)
+	l7, err = j.pool.Ensure(context.Background(), lbInfo)
 	if err != nil || j.namer.ParseName(l7.namer.LoadBalancer().String()).PrincipalEntityName != newName {
 		t.Fatalf("Expected L7 name to change.")
 	}
@@ -1460,14 +1460,14 @@ func TestList(t *testing.T) {
 	}
 	for _, name := range names {
 		key.Name = name
-		composite.CreateUrlMap(j.fakeGCE, key, &composite.UrlMap{Name: name}, klog.TODO())
+		composite.CreateUrlMap(context.Background(), j.fakeGCE, key, &composite.UrlMap{Name: name}, klog.TODO())
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure() = %v; want nil", err)
 	}
 
-	urlMaps, err := j.pool.list(key, defaultVersion)
+	urlMaps, err := j.pool.list(context.Background(), key, defaultVersion)
 	if err != nil {
 		t.Fatalf("j.pool.List(%q, %q) = %v, want nil", key, defaultVersion, err)
 	}
@@ -1510,20 +1510,20 @@ func TestSecretBasedAndPreSharedCerts(t *testing.T) {
 		t.Fatal(err)
 	}
 	key.Name = "test-pre-shared-cert"
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert",
 		Certificate: "abc",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert1, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert1, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	key.Name = "test-pre-shared-cert2"
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert2",
 		Certificate: "xyz",
 		SelfLink:    "existing2",
 	}, klog.TODO())
-	preSharedCert2, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert2, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	lbInfo.TLSName = preSharedCert1.Name + "," + preSharedCert2.Name
 
 	// Secret based certs.
@@ -1540,7 +1540,7 @@ func TestSecretBasedAndPreSharedCerts(t *testing.T) {
 	}
 
 	// Verify that both secret-based and pre-shared certs are used.
-	j.pool.Ensure(lbInfo)
+	j.pool.Ensure(context.Background(), lbInfo)
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 }
 
@@ -1576,7 +1576,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 		Ingress:   ing,
 	}
 
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure() = err %v", err)
 	}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
@@ -1591,7 +1591,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 	for ix := TargetProxyCertLimit; ix < FakeCertQuota; ix++ {
 		str := strconv.Itoa(ix)
 		key.Name = "test-pre-shared-cert-" + str
-		err := composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+		err := composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 			Name:        "test-pre-shared-cert-" + str,
 			Certificate: "abc-" + str,
 			SelfLink:    "existing-" + str,
@@ -1599,7 +1599,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 		if err != nil {
 			t.Fatalf("j.fakeGCE.CreateSslCertificate() = err %v", err)
 		}
-		cert, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+		cert, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 		preSharedCerts = append(preSharedCerts, cert)
 		tlsNames = append(tlsNames, cert.Name)
 		expectCertsExtra[cert.Name] = cert.Certificate
@@ -1607,7 +1607,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 	lbInfo.TLSName = strings.Join(tlsNames, ",")
 
 	key.Name = "test-pre-shared-cert-100"
-	err = composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	err = composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert-100",
 		Certificate: "abc-100",
 		SelfLink:    "existing-100",
@@ -1619,7 +1619,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 	// Trying to use more than TargetProxyCertLimit certs should fail.
 	// Verify that secret-based certs are still used,
 	// and the loadbalancer also contains pre-shared certs.
-	if _, err := j.pool.Ensure(lbInfo); err == nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err == nil {
 		t.Fatalf("Trying to use more than %d certs should have errored out", TargetProxyCertLimit)
 	}
 	verifyCertAndProxyLink(expectCertsExtra, expectCerts, j, t)
@@ -1634,7 +1634,7 @@ func TestMaxSecretBasedAndPreSharedCerts(t *testing.T) {
 		expectCerts[cert.Name] = cert.Certificate
 	}
 
-	if _, err = j.pool.Ensure(lbInfo); err != nil {
+	if _, err = j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure() = err %v", err)
 	}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
@@ -1662,7 +1662,7 @@ func TestSecretBasedToPreSharedCertUpdate(t *testing.T) {
 	// Sync secret based cert.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key", "cert", "name")}
 	lbInfo.TLSName = ""
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts := map[string]string{certName1: lbInfo.TLS[0].Cert}
@@ -1673,16 +1673,16 @@ func TestSecretBasedToPreSharedCertUpdate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert",
 		Certificate: "abc",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert1, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert1, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 	lbInfo.TLSName = preSharedCert1.Name
 
 	// Sync certs.
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("j.pool.Ensure() = err %v", err)
 	}
 	expectCerts[preSharedCert1.Name] = preSharedCert1.Certificate
@@ -1690,7 +1690,7 @@ func TestSecretBasedToPreSharedCertUpdate(t *testing.T) {
 
 	// Delete the secret.
 	lbInfo.TLS = []*translator.TLSCerts{}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	delete(expectCerts, certName1)
@@ -1718,7 +1718,7 @@ func TestSecretBasedToPreSharedCertUpdateWithErrors(t *testing.T) {
 	// Sync secret based cert.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key", "cert", "name")}
 	lbInfo.TLSName = ""
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts := map[string]string{certName1: lbInfo.TLS[0].Cert}
@@ -1729,16 +1729,16 @@ func TestSecretBasedToPreSharedCertUpdateWithErrors(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	composite.CreateSslCertificate(j.fakeGCE, key, &composite.SslCertificate{
+	composite.CreateSslCertificate(context.Background(), j.fakeGCE, key, &composite.SslCertificate{
 		Name:        "test-pre-shared-cert",
 		Certificate: "abc",
 		SelfLink:    "existing",
 	}, klog.TODO())
-	preSharedCert1, _ := composite.GetSslCertificate(j.fakeGCE, key, defaultVersion, klog.TODO())
+	preSharedCert1, _ := composite.GetSslCertificate(context.Background(), j.fakeGCE, key, defaultVersion, klog.TODO())
 
 	// Typo in the cert name.
 	lbInfo.TLSName = preSharedCert1.Name + "typo"
-	if _, err := j.pool.Ensure(lbInfo); err == nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err == nil {
 		t.Fatalf("pool.Ensure() should have errored out because of the wrong cert name")
 	}
 	expectCertsProxy := map[string]string{certName1: lbInfo.TLS[0].Cert}
@@ -1748,7 +1748,7 @@ func TestSecretBasedToPreSharedCertUpdateWithErrors(t *testing.T) {
 
 	// Fix the typo.
 	lbInfo.TLSName = preSharedCert1.Name
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	if _, err := j.pool.Ensure(context.Background(), lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
@@ -1786,7 +1786,7 @@ func TestResourceDeletionWithProtocol(t *testing.T) {
 				UrlMap:  gceUrlMap,
 				Ingress: ing,
 			}
-			lb, err := j.pool.Ensure(lbInfo)
+			lb, err := j.pool.Ensure(context.Background(), lbInfo)
 			if err != nil {
 				t.Fatalf("pool.Ensure(%+v) = %v, want nil", lbInfo, err)
 			}
@@ -1811,7 +1811,7 @@ func TestResourceDeletionWithProtocol(t *testing.T) {
 				delete(expectCerts, certName1)
 			}
 
-			lb, err = j.pool.Ensure(lbInfo)
+			lb, err = j.pool.Ensure(context.Background(), lbInfo)
 			if tc.disableHTTP && tc.disableHTTPS {
 				// we expectEqual an invalid ingress configuration error here.
 				errMsg := errAllProtocolsDisabled.Error()
@@ -1891,31 +1891,31 @@ func TestResourceDeletionWithScopeChange(t *testing.T) {
 			}
 
 			tc.ing.Annotations[annotations.IngressClassKey] = tc.beforeClass
-			l7, err := j.pool.Ensure(lbInfo)
+			l7, err := j.pool.Ensure(context.Background(), lbInfo)
 			if err != nil || l7 == nil {
 				t.Fatalf("Expected l7 not created, err: %v", err)
 			}
 			verifyHTTPForwardingRuleAndProxyLinks(t, j, l7, "")
 
 			tc.ing.Annotations[annotations.IngressClassKey] = tc.afterClass
-			l7, err = j.pool.Ensure(lbInfo)
+			l7, err = j.pool.Ensure(context.Background(), lbInfo)
 			if err != nil || l7 == nil {
 				t.Fatalf("Expected l7 not created, err: %v", err)
 			}
 			verifyHTTPForwardingRuleAndProxyLinks(t, j, l7, "")
 
 			// Check to make sure that there is something to GC
-			scope, err := j.pool.FrontendScopeChangeGC(tc.ing, klog.TODO())
+			scope, err := j.pool.FrontendScopeChangeGC(context.Background(), tc.ing, klog.TODO())
 			if scope == nil || *scope != tc.gcScope || err != nil {
 				t.Errorf("FrontendScopeChangeGC(%v) = (%v, %v), want (%q, nil)", tc.ing, scope, err, tc.gcScope)
 			}
 
-			if err := j.pool.GCv2(tc.ing, tc.gcScope); err != nil {
+			if err := j.pool.GCv2(context.Background(), tc.ing, tc.gcScope); err != nil {
 				t.Errorf("GCv2(%v, %q) = %v, want nil", tc.ing, tc.gcScope, err)
 			}
 
 			// Check to make sure that there is nothing to GC
-			scope, err = j.pool.FrontendScopeChangeGC(tc.ing, klog.TODO())
+			scope, err = j.pool.FrontendScopeChangeGC(context.Background(), tc.ing, klog.TODO())
 			if scope != nil || err != nil {
 				t.Errorf("FrontendScopeChangeGC(%v) = (%v, %v), want (nil, nil)", tc.ing, scope, err)
 			}
@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/cloud-provider-gcp/providers/gce"
@@ -34,7 +35,7 @@ const (
 )
 
 // checkProxy ensures the correct TargetHttpProxy for a loadbalancer
-func (l7 *L7) checkProxy() (err error) {
+func (l7 *L7) checkProxy(ctx context.Context) (err error) {
 	// Get UrlMap Name, could be the url map or the redirect url map
 	// TODO(shance): move to translator
 	var umName string
@@ -69,17 +70,17 @@ func (l7 *L7) checkProxy() (err error) {
 		return err
 	}
 
-	currentProxy, _ := composite.GetTargetHttpProxy(l7.cloud, key, version, l7.logger)
+	currentProxy, _ := composite.GetTargetHttpProxy(ctx, l7.cloud, key, version, l7.logger)
 	if currentProxy == nil {
 		l7.logger.V(3).Info("Creating new http proxy for urlmap", "urlMapName", l7.um.Name)
 		key, err := l7.CreateKey(proxy.Name)
 		if err != nil {
 			return err
 		}
-		if err = composite.CreateTargetHttpProxy(l7.cloud, key, proxy, l7.logger); err != nil {
+		if err = composite.CreateTargetHttpProxy(ctx, l7.cloud, key, proxy, l7.logger); err != nil {
 			return err
 		}
-		currentProxy, err = composite.GetTargetHttpProxy(l7.cloud, key, version, l7.logger)
+		currentProxy, err = composite.GetTargetHttpProxy(ctx, l7.cloud, key, version, l7.logger)
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q created", key.Name)
 		if err != nil {
 			return err
@@ -94,7 +95,7 @@ func (l7 *L7) checkProxy() (err error) {
 		if err != nil {
 			return err
 		}
-		if err := composite.SetUrlMapForTargetHttpProxy(l7.cloud, key, currentProxy, proxy.UrlMap, l7.logger); err != nil {
+		if err := composite.SetUrlMapForTargetHttpProxy(ctx, l7.cloud, key, currentProxy, proxy.UrlMap, l7.logger); err != nil {
 			return err
 		}
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q updated", key.Name)
@@ -103,7 +104,7 @@ func (l7 *L7) checkProxy() (err error) {
 	return nil
 }
 
-func (l7 *L7) checkHttpsProxy() (err error) {
+func (l7 *L7) checkHttpsProxy(ctx context.Context) (err error) {
 	isL7ILB := utils.IsGCEL7ILBIngress(l7.runtimeInfo.Ingress)
 	isL7XLBRegional := utils.IsGCEL7XLBRegionalIngress(l7.runtimeInfo.Ingress)
 	tr := translator.NewTranslator(isL7ILB, isL7XLBRegional, l7.namer)
@@ -130,7 +131,7 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		return err
 	}
 
-	currentProxy, _ := composite.GetTargetHttpsProxy(l7.cloud, key, version, l7.logger)
+	currentProxy, _ := composite.GetTargetHttpsProxy(ctx, l7.cloud, key, version, l7.logger)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 	if currentProxy == nil {
 		l7.logger.V(3).Info("Creating new https Proxy for urlmap", "urlMapName", l7.um.Name)
 
-		if err = composite.CreateTargetHttpsProxy(l7.cloud, key, proxy, l7.logger); err != nil {
+		if err = composite.CreateTargetHttpsProxy(ctx, l7.cloud, key, proxy, l7.logger); err != nil {
 			return err
 		}
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q created", key.Name)
@@ -147,7 +148,7 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		if err != nil {
 			return err
 		}
-		currentProxy, err = composite.GetTargetHttpsProxy(l7.cloud, key, version, l7.logger)
+		currentProxy, err = composite.GetTargetHttpsProxy(ctx, l7.cloud, key, version, l7.logger)
 		if err != nil {
 			return err
 		}
@@ -162,7 +163,7 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		if err != nil {
 			return err
 		}
-		if err := composite.SetUrlMapForTargetHttpsProxy(l7.cloud, key, currentProxy, proxy.UrlMap, l7.logger); err != nil {
+		if err := composite.SetUrlMapForTargetHttpsProxy(ctx, l7.cloud, key, currentProxy, proxy.UrlMap, l7.logger); err != nil {
 			return err
 		}
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q updated", key.Name)
@@ -179,14 +180,14 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		if err != nil {
 			return err
 		}
-		if err := composite.SetSslCertificateForTargetHttpsProxy(l7.cloud, key, currentProxy, sslCertURLs, l7.logger); err != nil {
+		if err := composite.SetSslCertificateForTargetHttpsProxy(ctx, l7.cloud, key, currentProxy, sslCertURLs, l7.logger); err != nil {
 			return err
 		}
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q certs updated", key.Name)
 	}
 
 	if sslPolicySet {
-		if err := l7.ensureSslPolicy(env, currentProxy, proxy.SslPolicy); err != nil {
+		if err := l7.ensureSslPolicy(ctx, env, currentProxy, proxy.SslPolicy); err != nil {
 			return err
 		}
 	}
@@ -195,13 +196,13 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 	return nil
 }
 
-func (l7 *L7) getSslCertLinkInUse() ([]string, error) {
+func (l7 *L7) getSslCertLinkInUse(ctx context.Context) ([]string, error) {
 	proxyName := l7.namer.TargetProxy(namer.HTTPSProtocol)
 	key, err := l7.CreateKey(proxyName)
 	if err != nil {
 		return nil, err
 	}
-	proxy, err := composite.GetTargetHttpsProxy(l7.cloud, key, l7.Versions().TargetHttpsProxy, l7.logger)
+	proxy, err := composite.GetTargetHttpsProxy(ctx, l7.cloud, key, l7.Versions().TargetHttpsProxy, l7.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +212,7 @@ func (l7 *L7) getSslCertLinkInUse() ([]string, error) {
 
 // ensureSslPolicy ensures that the SslPolicy described in the frontendconfig is
 // properly applied to the proxy.
-func (l7 *L7) ensureSslPolicy(env *translator.Env, currentProxy *composite.TargetHttpsProxy, policyLink string) error {
+func (l7 *L7) ensureSslPolicy(ctx context.Context, env *translator.Env, currentProxy *composite.TargetHttpsProxy, policyLink string) error {
 	if !utils.EqualResourcePaths(policyLink, currentProxy.SslPolicy) {
 		l7.logger.Info("ensureSslPolicy", "newPolicyLink", policyLink, "currentPolicyLink", currentProxy.SslPolicy)
 		key, err := l7.CreateKey(currentProxy.Name)
@@ -219,12 +220,12 @@ func (l7 *L7) ensureSslPolicy(env *translator.Env, currentProxy *composite.Targe
 			return err
 		}
 		if l7.scope == meta.Regional {
-			if err := ensureRegionalSslPolicy(l7.cloud, key, currentProxy, policyLink, l7.logger); err != nil {
+			if err := ensureRegionalSslPolicy(ctx, l7.cloud, key, currentProxy, policyLink, l7.logger); err != nil {
 				l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "Regional TargetHttpsProxy %q SSLPolicy updated", key.Name)
 				return err
 			}
 		} else {
-			if err := composite.SetSslPolicyForTargetHttpsProxy(l7.cloud, key, currentProxy, policyLink, l7.logger); err != nil {
+			if err := composite.SetSslPolicyForTargetHttpsProxy(ctx, l7.cloud, key, currentProxy, policyLink, l7.logger); err != nil {
 				l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q SSLPolicy updated", key.Name)
 				return err
 			}
@@ -236,7 +237,7 @@ func (l7 *L7) ensureSslPolicy(env *translator.Env, currentProxy *composite.Targe
 // ensureRegionalSslPolicy updates sslPolicy for regional HTTPs Proxy.
 // Regional HTTPs Proxies do not support setSslPolicy, and require using patch
 // method.
-func ensureRegionalSslPolicy(cloud *gce.Cloud, key *meta.Key, currentProxy *composite.TargetHttpsProxy, policyLink string, ingLogger klog.Logger) error {
+func ensureRegionalSslPolicy(ctx context.Context, cloud *gce.Cloud, key *meta.Key, currentProxy *composite.TargetHttpsProxy, policyLink string, ingLogger klog.Logger) error {
 	ingLogger.Info("Ensuring regional ssl policy", "policyLink", policyLink)
 	patchProxy := &composite.TargetHttpsProxy{
 		Fingerprint: currentProxy.Fingerprint,
@@ -245,5 +246,5 @@ func ensureRegionalSslPolicy(cloud *gce.Cloud, key *meta.Key, currentProxy *comp
 	if policyLink == "" {
 		patchProxy.NullFields = []string{"SslPolicy"}
 	}
-	return composite.PatchRegionalTargetHttpsProxy(cloud, key, patchProxy, ingLogger)
+	return composite.PatchRegionalTargetHttpsProxy(ctx, cloud, key, patchProxy, ingLogger)
 }
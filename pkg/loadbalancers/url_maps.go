@@ -17,6 +17,7 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"context"
 	"fmt"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -30,7 +31,7 @@ import (
 
 // ensureComputeURLMap retrieves the current URLMap and overwrites it if incorrect. If the resource
 // does not exist, the map is created.
-func (l7 *L7) ensureComputeURLMap() error {
+func (l7 *L7) ensureComputeURLMap(ctx context.Context) error {
 	if l7.runtimeInfo.UrlMap == nil {
 		return fmt.Errorf("cannot create urlmap without internal representation")
 	}
@@ -46,7 +47,7 @@ func (l7 *L7) ensureComputeURLMap() error {
 	key.Name = expectedMap.Name
 
 	expectedMap.Version = l7.Versions().UrlMap
-	currentMap, err := composite.GetUrlMap(l7.cloud, key, expectedMap.Version, l7.logger)
+	currentMap, err := composite.GetUrlMap(ctx, l7.cloud, key, expectedMap.Version, l7.logger)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		return err
 	}
@@ -55,7 +56,7 @@ func (l7 *L7) ensureComputeURLMap() error {
 		// Check for transitions between elb and ilb
 
 		l7.logger.V(2).Info("Creating URLMap", "urlMapName", expectedMap.Name)
-		if err := composite.CreateUrlMap(l7.cloud, key, expectedMap, l7.logger); err != nil {
+		if err := composite.CreateUrlMap(ctx, l7.cloud, key, expectedMap, l7.logger); err != nil {
 			return fmt.Errorf("CreateUrlMap: %v", err)
 		}
 		l7.recorder.Eventf(&l7.ingress, apiv1.EventTypeNormal, events.SyncIngress, "UrlMap %q created", key.Name)
@@ -72,7 +73,7 @@ func (l7 *L7) ensureComputeURLMap() error {
 
 	l7.logger.V(2).Info("Updating URLMap for load-balancer", "l7", l7)
 	expectedMap.Fingerprint = currentMap.Fingerprint
-	if err := composite.UpdateUrlMap(l7.cloud, key, expectedMap, l7.logger); err != nil {
+	if err := composite.UpdateUrlMap(ctx, l7.cloud, key, expectedMap, l7.logger); err != nil {
 		return fmt.Errorf("UpdateURLMap: %v", err)
 	}
 
@@ -82,7 +83,7 @@ func (l7 *L7) ensureComputeURLMap() error {
 	return nil
 }
 
-func (l7 *L7) ensureRedirectURLMap() error {
+func (l7 *L7) ensureRedirectURLMap(ctx context.Context) error {
 	feConfig := l7.runtimeInfo.FrontendConfig
 	isL7ILB := utils.IsGCEL7ILBIngress(&l7.ingress)
 	isL7XLBRegional := utils.IsGCEL7XLBRegionalIngress(&l7.ingress)
@@ -118,7 +119,7 @@ func (l7 *L7) ensureRedirectURLMap() error {
 		if !ok || status == "" {
 			return nil
 		} else {
-			if err := composite.DeleteUrlMap(l7.cloud, key, l7.Versions().UrlMap, l7.logger); err != nil {
+			if err := composite.DeleteUrlMap(ctx, l7.cloud, key, l7.Versions().UrlMap, l7.logger); err != nil {
 				// Do not block LB sync if this fails
 				l7.logger.Error(err, "DeleteUrlMap", "key", key)
 				// Signal to the rest of the controller that the UrlMap still exists
@@ -128,18 +129,18 @@ func (l7 *L7) ensureRedirectURLMap() error {
 		return nil
 	}
 
-	currentMap, err := composite.GetUrlMap(l7.cloud, key, l7.Versions().UrlMap, l7.logger)
+	currentMap, err := composite.GetUrlMap(ctx, l7.cloud, key, l7.Versions().UrlMap, l7.logger)
 	if utils.IgnoreHTTPNotFound(err) != nil {
 		return err
 	}
 
 	if currentMap == nil {
-		if err := composite.CreateUrlMap(l7.cloud, key, expectedMap, l7.logger); err != nil {
+		if err := composite.CreateUrlMap(ctx, l7.cloud, key, expectedMap, l7.logger); err != nil {
 			return err
 		}
 	} else if compareRedirectUrlMaps(expectedMap, currentMap) {
 		expectedMap.Fingerprint = currentMap.Fingerprint
-		if err := composite.UpdateUrlMap(l7.cloud, key, expectedMap, l7.logger); err != nil {
+		if err := composite.UpdateUrlMap(ctx, l7.cloud, key, expectedMap, l7.logger); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package synctimeline measures how long controllers take to propagate
+// changes of the objects they sync to GCE, broken down by the phases of a
+// sync.
+package synctimeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// controllerName is the name of a controller whose syncs are measured.
+type controllerName string
+
+const (
+	IngressController controllerName = "Ingress"
+	L4ILBController   controllerName = "L4ILB"
+	L4NetLBController controllerName = "L4NetLB"
+	PSCController     controllerName = "PSC"
+)
+
+// Phase is a step of a sync whose duration is measured separately.
+type Phase string
+
+const (
+	PhaseTranslate         Phase = "translate"
+	PhaseInstanceGroups    Phase = "instance_groups"
+	PhaseBackends          Phase = "backends"
+	PhaseHealthChecks      Phase = "health_checks"
+	PhaseURLMap            Phase = "url_map"
+	PhaseProxies           Phase = "proxies"
+	PhaseForwardingRules   Phase = "forwarding_rules"
+	PhaseFirewalls         Phase = "firewalls"
+	PhaseServiceAttachment Phase = "service_attachment"
+)
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+var (
+	syncDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "controller_sync_duration_seconds",
+			Help:    "Duration of a single sync of an object by the controller",
+			Buckets: prometheus.ExponentialBuckets(0.25, 2, 15),
+		},
+		[]string{"controller", "result"},
+	)
+	syncPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "controller_sync_phase_duration_seconds",
+			Help:    "Time spent in a phase of a single sync of an object by the controller",
+			Buckets: prometheus.ExponentialBuckets(0.125, 2, 15),
+		},
+		[]string{"controller", "phase", "result"},
+	)
+	propagationLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "controller_sync_propagation_latency_seconds",
+			Help: "Time from observing a change of an object to the end of the first successful sync after it, including queueing and retries",
+			// [0.5s .. ~2.3h]
+			Buckets: prometheus.ExponentialBuckets(0.5, 2, 15),
+		},
+		[]string{"controller"},
+	)
+	secondsSinceLastSuccessfulSyncDesc = prometheus.NewDesc(
+		"controller_seconds_since_last_successful_sync",
+		"Seconds since the last successful sync of an object, or since the object was first observed if it was never synced successfully",
+		[]string{"controller", "key"},
+		nil,
+	)
+
+	// Ingress, L4ILB, L4NetLB and PSC are the timelines of the controllers.
+	Ingress = newTimeline(IngressController)
+	L4ILB   = newTimeline(L4ILBController)
+	L4NetLB = newTimeline(L4NetLBController)
+	PSC     = newTimeline(PSCController)
+)
+
+func init() {
+	prometheus.MustRegister(syncDuration, syncPhaseDuration, propagationLatency)
+	prometheus.MustRegister(&timelineCollector{timelines: []*Timeline{Ingress, L4ILB, L4NetLB, PSC}})
+}
+
+// Timeline tracks, per object of a controller, when a change was observed
+// that has not been synced yet and when the object was last synced
+// successfully. Objects are identified by the controller's queue key.
+type Timeline struct {
+	controller controllerName
+
+	lock sync.Mutex
+	// pendingSince is the time at which the oldest change that has not been
+	// synced yet was observed.
+	pendingSince map[string]time.Time
+	// lastSuccess is the end of the last successful sync.
+	lastSuccess map[string]time.Time
+	// firstSeen is used in place of lastSuccess for objects which were
+	// never synced successfully.
+	firstSeen map[string]time.Time
+	now       func() time.Time
+}
+
+func newTimeline(controller controllerName) *Timeline {
+	return &Timeline{
+		controller:   controller,
+		pendingSince: make(map[string]time.Time),
+		lastSuccess:  make(map[string]time.Time),
+		firstSeen:    make(map[string]time.Time),
+		now:          time.Now,
+	}
+}
+
+// ObserveChange records that the object with the given key changed and needs
+// to be synced. Only the first change before a successful sync is recorded,
+// so the propagation latency covers the oldest unsynced change.
+func (t *Timeline) ObserveChange(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	if _, ok := t.pendingSince[key]; !ok {
+		t.pendingSince[key] = now
+	}
+	if _, ok := t.firstSeen[key]; !ok {
+		t.firstSeen[key] = now
+	}
+}
+
+// Forget drops everything recorded for the object with the given key. It
+// should be called once the object is deleted.
+func (t *Timeline) Forget(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.pendingSince, key)
+	delete(t.lastSuccess, key)
+	delete(t.firstSeen, key)
+}
+
+// Pending returns whether a change of the object with the given key was
+// observed which was not synced successfully yet.
+func (t *Timeline) Pending(key string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	_, ok := t.pendingSince[key]
+	return ok
+}
+
+// StartSync starts measuring a sync of the object with the given key.
+func (t *Timeline) StartSync(key string) *Trace {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	if _, ok := t.firstSeen[key]; !ok {
+		t.firstSeen[key] = now
+	}
+	return &Trace{timeline: t, key: key, start: now, phases: make(map[Phase]time.Duration)}
+}
+
+// finish records the outcome of the sync measured by the trace.
+func (t *Timeline) finish(tr *Trace, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	syncDuration.WithLabelValues(string(t.controller), result).Observe(now.Sub(tr.start).Seconds())
+	for phase, d := range tr.phases {
+		syncPhaseDuration.WithLabelValues(string(t.controller), string(phase), result).Observe(d.Seconds())
+	}
+	// Objects forgotten during the sync, e.g. because it deleted them, are not
+	// tracked anymore.
+	if _, ok := t.firstSeen[tr.key]; err != nil || !ok {
+		return
+	}
+	t.lastSuccess[tr.key] = now
+	if since, ok := t.pendingSince[tr.key]; ok {
+		propagationLatency.WithLabelValues(string(t.controller)).Observe(now.Sub(since).Seconds())
+		delete(t.pendingSince, tr.key)
+	}
+}
+
+// Trace measures the phases of a single sync. The methods of a nil Trace
+// are no-ops, so code shared with callers which do not measure syncs can
+// accept an optional Trace. A Trace must not be used concurrently.
+type Trace struct {
+	timeline *Timeline
+	key      string
+	start    time.Time
+	phases   map[Phase]time.Duration
+}
+
+// Track starts measuring the given phase and returns the function ending it.
+// Time spent in a phase which is entered multiple times in a sync, e.g. the
+// target proxies of both HTTP and HTTPS, is summed:
+//
+//	defer trace.Track(synctimeline.PhaseURLMap)()
+func (tr *Trace) Track(phase Phase) func() {
+	if tr == nil {
+		return func() {}
+	}
+	start := tr.timeline.now()
+	return func() {
+		tr.phases[phase] += tr.timeline.now().Sub(start)
+	}
+}
+
+// Done records the outcome of the sync. A successful sync ends the
+// propagation of all changes observed before it started.
+func (tr *Trace) Done(err error) {
+	if tr == nil {
+		return
+	}
+	tr.timeline.finish(tr, err)
+}
+
+// timelineCollector exports the time since the last successful sync of
+// every object tracked by the timelines. The value is computed at scrape
+// time, so it keeps growing while the syncs of an object fail.
+type timelineCollector struct {
+	timelines []*Timeline
+}
+
+// Describe implements prometheus.Collector.
+func (c *timelineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- secondsSinceLastSuccessfulSyncDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *timelineCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, t := range c.timelines {
+		for key, since := range t.sinceLastSuccess() {
+			ch <- prometheus.MustNewConstMetric(secondsSinceLastSuccessfulSyncDesc, prometheus.GaugeValue, since.Seconds(), string(t.controller), key)
+		}
+	}
+}
+
+// sinceLastSuccess returns the time since the last successful sync of every
+// tracked object.
+func (t *Timeline) sinceLastSuccess() map[string]time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	res := make(map[string]time.Duration, len(t.firstSeen))
+	for key, seen := range t.firstSeen {
+		last, ok := t.lastSuccess[key]
+		if !ok {
+			last = seen
+		}
+		res[key] = now.Sub(last)
+	}
+	return res
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synctimeline
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) step(d time.Duration) { c.t = c.t.Add(d) }
+
+func TestTimeline(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	timeline := newTimeline("Test")
+	timeline.now = clock.now
+	const key = "default/foo"
+
+	// The first change starts the propagation clock, later ones do not.
+	timeline.ObserveChange(key)
+	clock.step(time.Second)
+	timeline.ObserveChange(key)
+	clock.step(time.Second)
+
+	// A failed sync keeps the change pending.
+	trace := timeline.StartSync(key)
+	endPhase := trace.Track(PhaseURLMap)
+	clock.step(2 * time.Second)
+	endPhase()
+	trace.Track(PhaseURLMap)()
+	trace.Done(errors.New("sync failed"))
+	if got := trace.phases[PhaseURLMap]; got != 2*time.Second {
+		t.Errorf("trace.phases[%s] = %v, want 2s", PhaseURLMap, got)
+	}
+	if got := timeline.sinceLastSuccess()[key]; got != 4*time.Second {
+		t.Errorf("sinceLastSuccess()[%q] = %v, want 4s since the object was first seen", key, got)
+	}
+	if got := propagationSampleCount(t, "Test"); got != 0 {
+		t.Errorf("propagation latency sample count = %d after a failed sync, want 0", got)
+	}
+	if !timeline.Pending(key) {
+		t.Errorf("change of %q is not pending after a failed sync", key)
+	}
+
+	clock.step(time.Second)
+	timeline.StartSync(key).Done(nil)
+	if got := propagationSampleCount(t, "Test"); got != 1 {
+		t.Errorf("propagation latency sample count = %d after a successful sync, want 1", got)
+	}
+	if timeline.Pending(key) {
+		t.Errorf("change of %q is still pending after a successful sync", key)
+	}
+	clock.step(3 * time.Second)
+	if got := timeline.sinceLastSuccess()[key]; got != 3*time.Second {
+		t.Errorf("sinceLastSuccess()[%q] = %v, want 3s", key, got)
+	}
+
+	// Syncs without a pending change, e.g. periodic resyncs, are not
+	// propagations.
+	timeline.StartSync(key).Done(nil)
+	if got := propagationSampleCount(t, "Test"); got != 1 {
+		t.Errorf("propagation latency sample count = %d after a resync, want 1", got)
+	}
+
+	timeline.Forget(key)
+	if got := timeline.sinceLastSuccess(); len(got) != 0 {
+		t.Errorf("sinceLastSuccess() = %v after Forget(), want empty", got)
+	}
+
+	// Objects forgotten during a sync are not tracked again when it ends.
+	trace = timeline.StartSync(key)
+	timeline.Forget(key)
+	trace.Done(nil)
+	if _, ok := timeline.lastSuccess[key]; ok {
+		t.Errorf("%q is tracked again after a sync which forgot it", key)
+	}
+}
+
+func TestNilTrace(t *testing.T) {
+	var trace *Trace
+	trace.Track(PhaseBackends)()
+	trace.Done(nil)
+}
+
+func TestTimelineCollector(t *testing.T) {
+	timeline := newTimeline("Test")
+	timeline.ObserveChange("default/foo")
+	timeline.StartSync("default/bar").Done(nil)
+
+	ch := make(chan prometheus.Metric, 10)
+	(&timelineCollector{timelines: []*Timeline{timeline}}).Collect(ch)
+	close(ch)
+	if got := len(ch); got != 2 {
+		t.Errorf("Collect() returned %d metrics, want 2", got)
+	}
+}
+
+func propagationSampleCount(t *testing.T, controller string) uint64 {
+	t.Helper()
+	m := &dto.Metric{}
+	if err := propagationLatency.WithLabelValues(controller).(prometheus.Metric).Write(m); err != nil {
+		t.Fatalf("Write() = %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
@@ -63,6 +63,11 @@ func (g *GCEFake) GCEForProviderConfig(providerConfig *v1.ProviderConfig, logger
 	return fakeCloud, nil
 }
 
+// ReleaseProviderConfig implements GCECreator.
+// The fake clients are kept, so that tests can inspect the resources of a
+// ProviderConfig after it is deleted.
+func (g *GCEFake) ReleaseProviderConfig(providerConfig *v1.ProviderConfig) {}
+
 func createAndInsertNodes(cloud *cloudgce.Cloud, nodeNames []string, zone string) error {
 	if _, err := test.CreateAndInsertNodes(cloud, nodeNames, zone); err != nil {
 		return err
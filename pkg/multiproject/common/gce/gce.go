@@ -5,14 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"gopkg.in/ini.v1"
 	cloudgce "k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/cmd/glbc/app"
 	v1 "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
 	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/ratelimit"
 	"k8s.io/klog/v2"
 )
 
@@ -26,14 +31,34 @@ func init() {
 const (
 	// maxAttemptRetry defines the maximum number of retry attempts for GCE client operations
 	maxAttemptRetry = 5
+	// impersonationScope is the OAuth scope of the impersonated access tokens.
+	impersonationScope = "https://www.googleapis.com/auth/cloud-platform"
 )
 
+// iamCredentialsBaseURL is the base URL of the IAM Service Account Credentials API,
+// used to impersonate the service account of a ProviderConfig.
+var iamCredentialsBaseURL = "https://iamcredentials.googleapis.com/v1"
+
 type GCECreator interface {
 	GCEForProviderConfig(providerConfig *v1.ProviderConfig, logger klog.Logger) (*cloudgce.Cloud, error)
+	// ReleaseProviderConfig releases the state kept for the given ProviderConfig
+	// once it is deleted.
+	ReleaseProviderConfig(providerConfig *v1.ProviderConfig)
 }
 
 type DefaultGCECreator struct {
 	defaultConfigFileString string
+
+	mu sync.Mutex
+	// rateLimiters holds the rate limiter of each ProviderConfig, so that all the
+	// GCE clients of a ProviderConfig share its Compute API quota.
+	rateLimiters map[string]*providerConfigRateLimiter
+}
+
+// providerConfigRateLimiter is a rate limiter and the specs it was configured with.
+type providerConfigRateLimiter struct {
+	specs       []string
+	rateLimiter cloud.RateLimiter
 }
 
 func NewDefaultGCECreator(logger klog.Logger) (*DefaultGCECreator, error) {
@@ -49,20 +74,74 @@ func NewDefaultGCECreator(logger klog.Logger) (*DefaultGCECreator, error) {
 // GCEForProviderConfig returns a new GCE client for the given project.
 // If providerConfig is nil, it returns the default cloud associated with the cluster's project.
 // It modifies the default configuration when a providerConfig is provided.
+// The clients of a ProviderConfig share a rate limiter configured by its GCERateLimits.
 func (g *DefaultGCECreator) GCEForProviderConfig(providerConfig *v1.ProviderConfig, logger klog.Logger) (*cloudgce.Cloud, error) {
 	modifiedConfigContent, err := generateConfigForProviderConfig(g.defaultConfigFileString, providerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to modify config content: %v", err)
 	}
 
+	rl, err := g.rateLimiterForProviderConfig(providerConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return a new GCE client using the modified configuration content
 	return app.GCEClientForConfigReaderMT(
 		func() io.Reader { return strings.NewReader(modifiedConfigContent) },
+		rl,
 		logger,
 		maxAttemptRetry,
 	)
 }
 
+// rateLimiterForProviderConfig returns the rate limiter of the given ProviderConfig.
+// The specs of the ProviderConfig override the --gce-ratelimit specs of the same operation.
+// The rate limiter is created once per ProviderConfig and recreated when its specs change.
+func (g *DefaultGCECreator) rateLimiterForProviderConfig(providerConfig *v1.ProviderConfig, logger klog.Logger) (cloud.RateLimiter, error) {
+	if providerConfig == nil {
+		return newRateLimiter(flags.F.GCERateLimit.Values(), logger)
+	}
+
+	specs, err := ratelimit.MergeSpecs(flags.F.GCERateLimit.Values(), providerConfig.Spec.GCERateLimits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limits for provider config %s: %w", providerConfig.Name, err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if cached, ok := g.rateLimiters[providerConfig.Name]; ok && slices.Equal(cached.specs, specs) {
+		return cached.rateLimiter, nil
+	}
+	rl, err := newRateLimiter(specs, logger)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limits for provider config %s: %w", providerConfig.Name, err)
+	}
+	if g.rateLimiters == nil {
+		g.rateLimiters = make(map[string]*providerConfigRateLimiter)
+	}
+	g.rateLimiters[providerConfig.Name] = &providerConfigRateLimiter{specs: specs, rateLimiter: rl}
+	return rl, nil
+}
+
+// ReleaseProviderConfig implements GCECreator.
+// It drops the rate limiter of the given ProviderConfig.
+func (g *DefaultGCECreator) ReleaseProviderConfig(providerConfig *v1.ProviderConfig) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.rateLimiters, providerConfig.Name)
+}
+
+// newRateLimiter returns a rate limiter for the given specs, or nil if there are no specs.
+func newRateLimiter(specs []string, logger klog.Logger) (cloud.RateLimiter, error) {
+	rl, err := ratelimit.NewGCERateLimiter(specs, flags.F.GCEOperationPollInterval, logger)
+	if err != nil || rl == nil {
+		// Avoid returning a nil *GCERateLimiter as a non-nil cloud.RateLimiter.
+		return nil, err
+	}
+	return rl, nil
+}
+
 func generateConfigForProviderConfig(defaultConfigContent string, providerConfig *v1.ProviderConfig) (string, error) {
 	if providerConfig == nil {
 		return defaultConfigContent, nil
@@ -104,6 +183,13 @@ func generateConfigForProviderConfig(defaultConfigContent string, providerConfig
 	}
 	globalSection.Key(tokenBodyKey).SetValue(newTokenBody)
 
+	// Impersonate the service account of the principal, if any. The token source of the
+	// GCE client posts token-body to token-url and accepts the generateAccessToken response.
+	if principal := providerConfig.Spec.PrincipalInfo; principal != nil && principal.ServiceAccount != "" {
+		globalSection.Key(tokenURLKey).SetValue(impersonationTokenURL(principal.ServiceAccount))
+		globalSection.Key(tokenBodyKey).SetValue(impersonationTokenBody())
+	}
+
 	// Update NetworkName and SubnetworkName
 	networkNameKey := "network-name"
 	// Network name is the last part of the network path
@@ -155,6 +241,16 @@ func tokenURLForProviderConfig(existingTokenURL string, providerConfig *v1.Provi
 	return tokenURL, nil
 }
 
+// impersonationTokenURL returns the URL generating access tokens of the given service account.
+func impersonationTokenURL(serviceAccount string) string {
+	return fmt.Sprintf("%s/projects/-/serviceAccounts/%s:generateAccessToken", iamCredentialsBaseURL, url.PathEscape(sanitizeINIValue(serviceAccount)))
+}
+
+// impersonationTokenBody returns the quoted body of the generateAccessToken request.
+func impersonationTokenBody() string {
+	return strconv.Quote(fmt.Sprintf(`{"scope":[%q]}`, impersonationScope))
+}
+
 func updateTokenProjectNumber(tokenBody string, projectNumber int) (string, error) {
 	// Check if the token body is a quoted JSON string
 	isQuoted := len(tokenBody) > 0 && tokenBody[0] == '"' && tokenBody[len(tokenBody)-1] == '"'
@@ -2,13 +2,20 @@ package gce
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
 	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/klog/v2/ktesting"
 )
 
 func TestUpdateTokenBodyField(t *testing.T) {
@@ -392,6 +399,47 @@ network-name = providerconfig-network-url
 subnetwork-name = providerconfig-subnetwork-url
 alpha-features = ILBSubsets
 alpha-features = ILBCustomSubnet
+`,
+			expectError: false,
+		},
+		{
+			name: "Service account of the principal is impersonated",
+			defaultConfigContent: `
+[global]
+project-id = default-project-id
+token-url = https://gkeauth.googleapis.com/v1/projects/12345/locations/us-central1/clusters/example-cluster:generateToken
+token-body = {"projectNumber":12345,"clusterId":"example-cluster"}
+network-name = default-network
+subnetwork-name = default-subnetwork
+`,
+			providerConfig: &v1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "example-provider-config",
+					Labels: map[string]string{
+						flags.F.MultiProjectOwnerLabelKey: "example-owner",
+					},
+				},
+				Spec: v1.ProviderConfigSpec{
+					ProjectID:     "providerconfig-project-id",
+					ProjectNumber: 654321,
+					NetworkConfig: v1.ProviderNetworkConfig{
+						Network: "providerconfig-network-url",
+						SubnetInfo: v1.ProviderConfigSubnetInfo{
+							Subnetwork: "providerconfig-subnetwork-url",
+						},
+					},
+					PrincipalInfo: &v1.PrincipalInfo{
+						ID:             "tenant-id",
+						ServiceAccount: "tenant-sa@providerconfig-project-id.iam.gserviceaccount.com",
+					},
+				},
+			},
+			expectedConfig: `[global]
+project-id = providerconfig-project-id
+token-url = https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/tenant-sa@providerconfig-project-id.iam.gserviceaccount.com:generateAccessToken
+token-body = "{\"scope\":[\"https://www.googleapis.com/auth/cloud-platform\"]}"
+network-name = providerconfig-network-url
+subnetwork-name = providerconfig-subnetwork-url
 `,
 			expectError: false,
 		},
@@ -551,3 +599,151 @@ func TestSanitizeINIValue(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimiterForProviderConfig(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	creator := &DefaultGCECreator{}
+	newPC := func(name string, rateLimits ...string) *v1.ProviderConfig {
+		return &v1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1.ProviderConfigSpec{GCERateLimits: rateLimits},
+		}
+	}
+
+	rl1, err := creator.rateLimiterForProviderConfig(newPC("pc-1", "ga.Operations.Get,qps,10,100"), logger)
+	if err != nil || rl1 == nil {
+		t.Fatalf("rateLimiterForProviderConfig(pc-1) = %v, %v, want a rate limiter", rl1, err)
+	}
+
+	// Clients of the same ProviderConfig share the rate limiter.
+	if rl, err := creator.rateLimiterForProviderConfig(newPC("pc-1", "ga.Operations.Get,qps,10,100"), logger); err != nil || rl != rl1 {
+		t.Errorf("rateLimiterForProviderConfig(pc-1) = %v, %v, want the shared rate limiter %v", rl, err, rl1)
+	}
+
+	// Other ProviderConfigs get their own rate limiter.
+	if rl, err := creator.rateLimiterForProviderConfig(newPC("pc-2", "ga.Operations.Get,qps,10,100"), logger); err != nil || rl == rl1 {
+		t.Errorf("rateLimiterForProviderConfig(pc-2) = %v, %v, want a rate limiter other than %v", rl, err, rl1)
+	}
+
+	// A change of the specs recreates the rate limiter.
+	rl2, err := creator.rateLimiterForProviderConfig(newPC("pc-1", "ga.Operations.Get,qps,5,50"), logger)
+	if err != nil || rl2 == nil || rl2 == rl1 {
+		t.Errorf("rateLimiterForProviderConfig(pc-1) after spec change = %v, %v, want a new rate limiter", rl2, err)
+	}
+
+	// Invalid specs are reported without replacing the rate limiter.
+	if _, err := creator.rateLimiterForProviderConfig(newPC("pc-1", "Operations.Get,qps,5,50"), logger); err == nil {
+		t.Errorf("rateLimiterForProviderConfig(pc-1) with invalid specs returned nil error")
+	}
+	if rl, err := creator.rateLimiterForProviderConfig(newPC("pc-1", "ga.Operations.Get,qps,5,50"), logger); err != nil || rl != rl2 {
+		t.Errorf("rateLimiterForProviderConfig(pc-1) = %v, %v, want %v", rl, err, rl2)
+	}
+
+	// The rate limiter of a deleted ProviderConfig is dropped.
+	creator.ReleaseProviderConfig(newPC("pc-1"))
+	if _, ok := creator.rateLimiters["pc-1"]; ok {
+		t.Errorf("rate limiter of pc-1 was kept after ReleaseProviderConfig(pc-1)")
+	}
+	if _, ok := creator.rateLimiters["pc-2"]; !ok {
+		t.Errorf("rate limiter of pc-2 was dropped after ReleaseProviderConfig(pc-1)")
+	}
+}
+
+// TestGCEForProviderConfigImpersonation verifies that the GCE client of a ProviderConfig
+// with a service account calls the Compute API with the access token of the service account.
+func TestGCEForProviderConfigImpersonation(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	const (
+		serviceAccount = "tenant-sa@providerconfig-project-id.iam.gserviceaccount.com"
+		nodeToken      = "node-token"
+		tenantToken    = "tenant-token"
+	)
+
+	var (
+		mu             sync.Mutex
+		tokenRequests  []string
+		computeAuth    []string
+		unexpectedPath []string
+	)
+	mux := http.NewServeMux()
+	// The token of the cluster, used to impersonate the service account.
+	mux.HandleFunc("/computeMetadata/v1/instance/service-accounts/default/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, nodeToken)
+	})
+	mux.HandleFunc("/iam/projects/-/serviceAccounts/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		tokenRequests = append(tokenRequests, fmt.Sprintf("%s %s %s %s", r.Method, r.URL.Path, r.Header.Get("Authorization"), body))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"accessToken":%q,"expireTime":%q}`, tenantToken, time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/compute/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		computeAuth = append(computeAuth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items":[]}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		unexpectedPath = append(unexpectedPath, r.URL.Path)
+		mu.Unlock()
+		http.NotFound(w, r)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Setenv("GCE_METADATA_HOST", strings.TrimPrefix(server.URL, "http://"))
+	oldBaseURL := iamCredentialsBaseURL
+	iamCredentialsBaseURL = server.URL + "/iam"
+	defer func() { iamCredentialsBaseURL = oldBaseURL }()
+
+	creator := &DefaultGCECreator{
+		defaultConfigFileString: fmt.Sprintf(`
+[global]
+project-id = default-project-id
+local-zone = us-central1-b
+api-endpoint = %s/compute/v1/
+token-url = %s/cluster:generateToken
+token-body = {"projectNumber":12345,"clusterId":"example-cluster"}
+network-name = default-network
+subnetwork-name = default-subnetwork
+`, server.URL, server.URL),
+	}
+	pc := &v1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-provider-config"},
+		Spec: v1.ProviderConfigSpec{
+			ProjectID:     "providerconfig-project-id",
+			ProjectNumber: 654321,
+			NetworkConfig: v1.ProviderNetworkConfig{
+				Network:    "providerconfig-network",
+				SubnetInfo: v1.ProviderConfigSubnetInfo{Subnetwork: "providerconfig-subnetwork"},
+			},
+			PrincipalInfo: &v1.PrincipalInfo{ID: "tenant-id", ServiceAccount: serviceAccount},
+		},
+	}
+
+	if _, err := creator.GCEForProviderConfig(pc, logger); err != nil {
+		t.Fatalf("GCEForProviderConfig() = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	wantTokenRequest := fmt.Sprintf(`POST /iam/projects/-/serviceAccounts/%s:generateAccessToken Bearer %s {"scope":["https://www.googleapis.com/auth/cloud-platform"]}`, serviceAccount, nodeToken)
+	if len(tokenRequests) == 0 || tokenRequests[0] != wantTokenRequest {
+		t.Errorf("Token requests = %q, want [%q]", tokenRequests, wantTokenRequest)
+	}
+	if len(computeAuth) == 0 {
+		t.Fatalf("GCEForProviderConfig() made no Compute API call")
+	}
+	for _, auth := range computeAuth {
+		if auth != "Bearer "+tenantToken {
+			t.Errorf("Compute API call with Authorization %q, want the token of the service account %q", auth, "Bearer "+tenantToken)
+		}
+	}
+	if len(unexpectedPath) != 0 {
+		t.Errorf("Unexpected requests to %v", unexpectedPath)
+	}
+}
@@ -26,6 +26,26 @@ type ControllerStarter interface {
 	StartController(pc *providerconfig.ProviderConfig) (chan<- struct{}, error)
 }
 
+// ControllerStatusReporter is optionally implemented by a ControllerStarter to report
+// the state of its controllers in the ProviderConfig status.
+type ControllerStatusReporter interface {
+	// ControllerStatuses returns the controllers started for the given ProviderConfig
+	// and whether their informer caches are synced. Running and LastSyncTime are
+	// set by the framework.
+	ControllerStatuses(pc *providerconfig.ProviderConfig) []providerconfig.ProviderConfigControllerStatus
+	// ManagedResources returns the counts of resources managed for the given
+	// ProviderConfig, or nil if the starter does not track them.
+	ManagedResources(pc *providerconfig.ProviderConfig) *providerconfig.ProviderConfigManagedResources
+}
+
+// ProviderConfigReleaser is optionally implemented by a ControllerStarter to release
+// the state it keeps for a ProviderConfig once the ProviderConfig is deleted.
+type ProviderConfigReleaser interface {
+	// ReleaseProviderConfig is called after the controllers of the given
+	// ProviderConfig are stopped for its deletion.
+	ReleaseProviderConfig(pc *providerconfig.ProviderConfig)
+}
+
 const (
 	providerConfigControllerName = "provider-config-controller"
 	workersNum                   = 5
@@ -25,6 +25,7 @@ type manager struct {
 	providerConfigClient providerconfigclient.Interface
 	finalizerName        string
 	controllerStarter    ControllerStarter
+	now                  func() metav1.Time
 }
 
 // newManager constructs a new generic ProviderConfig controller manager.
@@ -41,6 +42,7 @@ func newManager(
 		providerConfigClient: providerConfigClient,
 		finalizerName:        finalizerName,
 		controllerStarter:    controllerStarter,
+		now:                  metav1.Now,
 	}
 }
 
@@ -50,16 +52,19 @@ func providerConfigKey(pc *providerconfig.ProviderConfig) string {
 }
 
 // rollbackFinalizerOnStartFailure removes the finalizer after a start failure
-// so that ProviderConfig deletion is not blocked.
-func (m *manager) rollbackFinalizerOnStartFailure(pc *providerconfig.ProviderConfig, logger klog.Logger, cause error) {
+// so that ProviderConfig deletion is not blocked. It returns the latest ProviderConfig
+// and whether the finalizer was removed.
+func (m *manager) rollbackFinalizerOnStartFailure(pc *providerconfig.ProviderConfig, logger klog.Logger, cause error) (*providerconfig.ProviderConfig, bool) {
 	pcLatest, err := m.providerConfigClient.CloudV1().ProviderConfigs().Get(context.Background(), pc.Name, metav1.GetOptions{})
 	if err != nil {
 		logger.Error(err, "failed to get latest ProviderConfig for finalizer rollback", "originalError", cause)
-		return
+		return pc, false
 	}
 	if err := finalizer.DeleteProviderConfigFinalizer(pcLatest, m.finalizerName, m.providerConfigClient, logger); err != nil {
 		logger.Error(err, "failed to clean up finalizer after start failure", "originalError", cause)
+		return pcLatest, false
 	}
+	return pcLatest, true
 }
 
 // StartControllersForProviderConfig ensures finalizers are present and starts
@@ -79,6 +84,7 @@ func (m *manager) StartControllersForProviderConfig(pc *providerconfig.ProviderC
 	cs, existed := m.controllers.GetOrCreate(pcKey)
 	if existed && cs.stopCh != nil {
 		logger.Info("Controllers for provider config already exist, skipping start")
+		m.updateStatus(pc, true, ReasonRunning, "Controllers are running", logger)
 		return nil
 	}
 
@@ -91,7 +97,9 @@ func (m *manager) StartControllersForProviderConfig(pc *providerconfig.ProviderC
 		if !existed {
 			m.controllers.Delete(pcKey)
 		}
-		return fmt.Errorf("failed to ensure finalizer %s for provider config %s: %w", m.finalizerName, pcKey, err)
+		err = fmt.Errorf("failed to ensure finalizer %s for provider config %s: %w", m.finalizerName, pcKey, err)
+		m.updateStatus(pc, false, ReasonStartFailed, err.Error(), logger)
+		return err
 	}
 
 	controllerStopCh, err := m.controllerStarter.StartController(pc)
@@ -99,13 +107,21 @@ func (m *manager) StartControllersForProviderConfig(pc *providerconfig.ProviderC
 		if !existed {
 			m.controllers.Delete(pcKey)
 		}
+		err = fmt.Errorf("failed to start controller for provider config %s: %w", pcKey, err)
+		reason, statusPC := ReasonStartFailed, pc
 		if !hadFinalizer {
-			m.rollbackFinalizerOnStartFailure(pc, logger, err)
+			var rolledBack bool
+			statusPC, rolledBack = m.rollbackFinalizerOnStartFailure(pc, logger, err)
+			if rolledBack {
+				reason = ReasonFinalizerRolledBack
+			}
 		}
-		return fmt.Errorf("failed to start controller for provider config %s: %w", pcKey, err)
+		m.updateStatus(statusPC, false, reason, err.Error(), logger)
+		return err
 	}
 
 	cs.stopCh = controllerStopCh
+	m.updateStatus(pc, true, ReasonRunning, "Controllers are running", logger)
 
 	logger.Info("Started controllers for provider config")
 	return nil
@@ -131,6 +147,9 @@ func (m *manager) StopControllersForProviderConfig(pc *providerconfig.ProviderCo
 	} else {
 		logger.Info("Controllers for provider config do not exist")
 	}
+	if releaser, ok := m.controllerStarter.(ProviderConfigReleaser); ok {
+		releaser.ReleaseProviderConfig(pc)
+	}
 
 	// Fetch the latest ProviderConfig to ensure we have current finalizer state.
 	latestPC, err := m.providerConfigClient.CloudV1().ProviderConfigs().Get(context.Background(), pc.Name, metav1.GetOptions{})
@@ -144,7 +163,9 @@ func (m *manager) StopControllersForProviderConfig(pc *providerconfig.ProviderCo
 
 	err = finalizer.DeleteProviderConfigFinalizer(latestPC, m.finalizerName, m.providerConfigClient, logger)
 	if err != nil {
-		return fmt.Errorf("failed to delete finalizer %s for provider config %s: %w", m.finalizerName, pcKey, err)
+		err = fmt.Errorf("failed to delete finalizer %s for provider config %s: %w", m.finalizerName, pcKey, err)
+		m.updateStatus(latestPC, false, ReasonStopFailed, err.Error(), logger)
+		return err
 	}
 	logger.Info("Stopped controllers for provider config")
 	return nil
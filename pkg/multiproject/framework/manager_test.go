@@ -20,6 +20,7 @@ type mockControllerStarter struct {
 	shouldFailStart    bool
 	startedControllers map[string]chan<- struct{}
 	startCounts        map[string]int
+	released           []string
 }
 
 func newMockControllerStarter() *mockControllerStarter {
@@ -45,6 +46,12 @@ func (m *mockControllerStarter) StartController(pc *providerconfig.ProviderConfi
 	return stopCh, nil
 }
 
+func (m *mockControllerStarter) ReleaseProviderConfig(pc *providerconfig.ProviderConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.released = append(m.released, pc.Name)
+}
+
 func (m *mockControllerStarter) getStartCallCount() int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -277,6 +284,11 @@ func TestManagerStopRemovesFinalizer(t *testing.T) {
 	if finalizer.HasGivenFinalizer(finalPC.ObjectMeta, finalizerName) {
 		t.Errorf("Finalizer %s was not removed after stop", finalizerName)
 	}
+
+	// Verify the state of the ProviderConfig was released
+	if len(mockStarter.released) != 1 || mockStarter.released[0] != pc.Name {
+		t.Errorf("Released provider configs = %v, want [%s]", mockStarter.released, pc.Name)
+	}
 }
 
 // TestManagerStopIdempotent verifies that stopping a non-existent controller is safe.
@@ -0,0 +1,77 @@
+package framework
+
+import (
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+)
+
+// multiControllerStarter starts several ControllerStarters as a single controller,
+// so that they share one finalizer on the ProviderConfig.
+type multiControllerStarter struct {
+	starters []ControllerStarter
+}
+
+// NewMultiControllerStarter returns a ControllerStarter that starts all the given starters
+// for a ProviderConfig. If any of them fails, the ones already started are stopped.
+// Closing the returned stop channel stops all of them.
+func NewMultiControllerStarter(starters ...ControllerStarter) ControllerStarter {
+	return &multiControllerStarter{starters: starters}
+}
+
+// StartController implements ControllerStarter.
+func (m *multiControllerStarter) StartController(pc *providerconfig.ProviderConfig) (chan<- struct{}, error) {
+	var stopChs []chan<- struct{}
+	for _, starter := range m.starters {
+		stopCh, err := starter.StartController(pc)
+		if err != nil {
+			for _, ch := range stopChs {
+				close(ch)
+			}
+			return nil, err
+		}
+		stopChs = append(stopChs, stopCh)
+	}
+
+	stopCh := make(chan struct{})
+	go func() {
+		<-stopCh
+		for _, ch := range stopChs {
+			close(ch)
+		}
+	}()
+	return stopCh, nil
+}
+
+// ControllerStatuses implements ControllerStatusReporter.
+// It returns the statuses reported by all the starters.
+func (m *multiControllerStarter) ControllerStatuses(pc *providerconfig.ProviderConfig) []providerconfig.ProviderConfigControllerStatus {
+	var statuses []providerconfig.ProviderConfigControllerStatus
+	for _, starter := range m.starters {
+		if reporter, ok := starter.(ControllerStatusReporter); ok {
+			statuses = append(statuses, reporter.ControllerStatuses(pc)...)
+		}
+	}
+	return statuses
+}
+
+// ManagedResources implements ControllerStatusReporter.
+// It returns the counts of the first starter that tracks them.
+func (m *multiControllerStarter) ManagedResources(pc *providerconfig.ProviderConfig) *providerconfig.ProviderConfigManagedResources {
+	for _, starter := range m.starters {
+		if reporter, ok := starter.(ControllerStatusReporter); ok {
+			if resources := reporter.ManagedResources(pc); resources != nil {
+				return resources
+			}
+		}
+	}
+	return nil
+}
+
+// ReleaseProviderConfig implements ProviderConfigReleaser.
+// It releases the ProviderConfig in all the starters that keep state for it.
+func (m *multiControllerStarter) ReleaseProviderConfig(pc *providerconfig.ProviderConfig) {
+	for _, starter := range m.starters {
+		if releaser, ok := starter.(ProviderConfigReleaser); ok {
+			releaser.ReleaseProviderConfig(pc)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package framework
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+)
+
+// recordingStarter keeps the stop channel it returned, so tests can check that it was closed.
+type recordingStarter struct {
+	fail   bool
+	stopCh chan struct{}
+}
+
+func (r *recordingStarter) StartController(pc *providerconfig.ProviderConfig) (chan<- struct{}, error) {
+	if r.fail {
+		return nil, fmt.Errorf("start failure")
+	}
+	r.stopCh = make(chan struct{})
+	return r.stopCh, nil
+}
+
+func waitClosed(t *testing.T, name string, ch chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Errorf("stop channel of %s was not closed", name)
+	}
+}
+
+func TestMultiControllerStarter(t *testing.T) {
+	pc := createTestProviderConfig("test-pc")
+
+	t.Run("stops all starters", func(t *testing.T) {
+		first, second := &recordingStarter{}, &recordingStarter{}
+		stopCh, err := NewMultiControllerStarter(first, second).StartController(pc)
+		if err != nil {
+			t.Fatalf("StartController() returned error: %v", err)
+		}
+		select {
+		case <-first.stopCh:
+			t.Fatalf("stop channel closed before the returned channel")
+		default:
+		}
+
+		close(stopCh)
+		waitClosed(t, "first starter", first.stopCh)
+		waitClosed(t, "second starter", second.stopCh)
+	})
+
+	t.Run("stops started starters on failure", func(t *testing.T) {
+		first, failing, last := &recordingStarter{}, &recordingStarter{fail: true}, &recordingStarter{}
+		stopCh, err := NewMultiControllerStarter(first, failing, last).StartController(pc)
+		if err == nil {
+			t.Fatalf("StartController() returned nil error, want error")
+		}
+		if stopCh != nil {
+			t.Errorf("StartController() returned non-nil stop channel on error")
+		}
+		waitClosed(t, "first starter", first.stopCh)
+		if last.stopCh != nil {
+			t.Errorf("starter after the failing one was started")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package framework
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+	"k8s.io/ingress-gce/pkg/utils/patch"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ControllersRunningCondition is the type of the ProviderConfig condition
+	// reporting whether the per-project controllers are running.
+	ControllersRunningCondition = "ControllersRunning"
+
+	// ReasonRunning is used when the controllers were started successfully.
+	ReasonRunning = "Running"
+	// ReasonStartFailed is used when the controllers failed to start.
+	ReasonStartFailed = "StartFailed"
+	// ReasonFinalizerRolledBack is used when the controllers failed to start
+	// and the finalizer added for them was removed again.
+	ReasonFinalizerRolledBack = "FinalizerRolledBack"
+	// ReasonStopFailed is used when the controllers were stopped but the
+	// finalizer could not be removed.
+	ReasonStopFailed = "StopFailed"
+
+	// lastSyncRefreshInterval is the minimum interval between updates of the
+	// LastSyncTime of a controller. Updating it on every sync would trigger a
+	// new sync through the ProviderConfig informer.
+	lastSyncRefreshInterval = time.Minute
+)
+
+// updateStatus patches the ProviderConfig status with the ControllersRunning condition and
+// the state reported by the controller starter. The patch is skipped if nothing changed.
+// Errors are logged only, status reporting never fails the sync.
+func (m *manager) updateStatus(pc *providerconfig.ProviderConfig, running bool, reason, message string, logger klog.Logger) {
+	newStatus := pc.Status.DeepCopy()
+
+	conditionStatus := metav1.ConditionFalse
+	if running {
+		conditionStatus = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{
+		Type:               ControllersRunningCondition,
+		Status:             conditionStatus,
+		ObservedGeneration: pc.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+
+	if reporter, ok := m.controllerStarter.(ControllerStatusReporter); ok {
+		newStatus.Controllers = m.controllerStatuses(reporter, pc, running, newStatus.Controllers)
+		if running {
+			newStatus.ManagedResources = reporter.ManagedResources(pc)
+		}
+	}
+
+	if equality.Semantic.DeepEqual(&pc.Status, newStatus) {
+		return
+	}
+	if err := patch.PatchProviderConfigStatus(m.providerConfigClient, pc, *newStatus); err != nil {
+		logger.Error(err, "Failed to update ProviderConfig status")
+		return
+	}
+	logger.V(3).Info("Updated ProviderConfig status", "reason", reason)
+}
+
+// controllerStatuses returns the reported controller statuses with Running and LastSyncTime set.
+// LastSyncTime is carried over from the previous statuses unless it is older than
+// lastSyncRefreshInterval and the controller is running with synced informers.
+func (m *manager) controllerStatuses(reporter ControllerStatusReporter, pc *providerconfig.ProviderConfig, running bool, previous []providerconfig.ProviderConfigControllerStatus) []providerconfig.ProviderConfigControllerStatus {
+	lastSyncTimes := make(map[string]*metav1.Time, len(previous))
+	for _, status := range previous {
+		lastSyncTimes[status.Name] = status.LastSyncTime
+	}
+
+	now := m.now()
+	var statuses []providerconfig.ProviderConfigControllerStatus
+	for _, status := range reporter.ControllerStatuses(pc) {
+		status.Running = running
+		status.LastSyncTime = lastSyncTimes[status.Name]
+		if running && status.InformersSynced && (status.LastSyncTime == nil || now.Sub(status.LastSyncTime.Time) >= lastSyncRefreshInterval) {
+			status.LastSyncTime = &now
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
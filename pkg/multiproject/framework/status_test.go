@@ -0,0 +1,219 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+	providerconfigclient "k8s.io/ingress-gce/pkg/providerconfig/client/clientset/versioned/fake"
+	"k8s.io/klog/v2/ktesting"
+)
+
+// reportingControllerStarter is a mockControllerStarter that implements ControllerStatusReporter.
+type reportingControllerStarter struct {
+	*mockControllerStarter
+	informersSynced bool
+	resources       *providerconfig.ProviderConfigManagedResources
+}
+
+func (r *reportingControllerStarter) ControllerStatuses(pc *providerconfig.ProviderConfig) []providerconfig.ProviderConfigControllerStatus {
+	return []providerconfig.ProviderConfigControllerStatus{{Name: "test-controller", InformersSynced: r.informersSynced}}
+}
+
+func (r *reportingControllerStarter) ManagedResources(pc *providerconfig.ProviderConfig) *providerconfig.ProviderConfigManagedResources {
+	return r.resources
+}
+
+// countStatusPatches returns the number of patches of the ProviderConfig status.
+func countStatusPatches(client *providerconfigclient.Clientset) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if patch, ok := action.(clienttesting.PatchAction); ok && strings.Contains(string(patch.GetPatch()), `"status"`) {
+			count++
+		}
+	}
+	return count
+}
+
+// TestManagerStatusOnStart verifies that a successful start reports the running controllers,
+// their last sync time and the managed resources, and that the last sync time is only
+// refreshed after lastSyncRefreshInterval.
+func TestManagerStatusOnStart(t *testing.T) {
+	ctx := context.TODO()
+	logger, _ := ktesting.NewTestContext(t)
+	client := providerconfigclient.NewSimpleClientset()
+	starter := &reportingControllerStarter{
+		mockControllerStarter: newMockControllerStarter(),
+		informersSynced:       true,
+		resources:             &providerconfig.ProviderConfigManagedResources{NEGs: 3, Services: 2},
+	}
+	manager := newManager(client, "test-finalizer", starter, logger)
+	// Serialized times have a second precision.
+	now := metav1.NewTime(time.Unix(1700000000, 0))
+	manager.now = func() metav1.Time { return now }
+
+	pc := createTestProviderConfig("test-pc")
+	if _, err := client.CloudV1().ProviderConfigs().Create(ctx, pc, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create test ProviderConfig: %v", err)
+	}
+	if err := manager.StartControllersForProviderConfig(pc); err != nil {
+		t.Fatalf("StartControllersForProviderConfig() returned error: %v", err)
+	}
+
+	pc, err := client.CloudV1().ProviderConfigs().Get(ctx, pc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ProviderConfig: %v", err)
+	}
+	condition := meta.FindStatusCondition(pc.Status.Conditions, ControllersRunningCondition)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != ReasonRunning {
+		t.Errorf("ControllersRunning condition = %+v, want status True with reason %s", condition, ReasonRunning)
+	}
+	if len(pc.Status.Controllers) != 1 {
+		t.Fatalf("Status.Controllers = %+v, want one controller", pc.Status.Controllers)
+	}
+	if got := pc.Status.Controllers[0]; got.Name != "test-controller" || !got.Running || !got.InformersSynced || got.LastSyncTime == nil || !got.LastSyncTime.Equal(&now) {
+		t.Errorf("Status.Controllers[0] = %+v, want running test-controller with synced informers and LastSyncTime %v", got, now)
+	}
+	if got := pc.Status.ManagedResources; got == nil || *got != *starter.resources {
+		t.Errorf("Status.ManagedResources = %+v, want %+v", got, starter.resources)
+	}
+
+	// A resync before lastSyncRefreshInterval must not patch the status again.
+	patches := countStatusPatches(client)
+	now = metav1.NewTime(now.Add(lastSyncRefreshInterval / 2))
+	if err := manager.StartControllersForProviderConfig(pc); err != nil {
+		t.Fatalf("StartControllersForProviderConfig() returned error: %v", err)
+	}
+	if got := countStatusPatches(client); got != patches {
+		t.Errorf("Status patches after early resync = %d, want %d", got, patches)
+	}
+
+	// A resync after lastSyncRefreshInterval refreshes the last sync time.
+	now = metav1.NewTime(now.Add(lastSyncRefreshInterval))
+	if err := manager.StartControllersForProviderConfig(pc); err != nil {
+		t.Fatalf("StartControllersForProviderConfig() returned error: %v", err)
+	}
+	pc, err = client.CloudV1().ProviderConfigs().Get(ctx, pc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ProviderConfig: %v", err)
+	}
+	if got := pc.Status.Controllers[0].LastSyncTime; got == nil || !got.Equal(&now) {
+		t.Errorf("LastSyncTime after resync = %v, want %v", got, now)
+	}
+}
+
+// TestManagerStatusOnStartFailure verifies that start failures are reported as conditions,
+// including the rollback of the finalizer.
+func TestManagerStatusOnStartFailure(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		finalizers     []string
+		expectedReason string
+	}{
+		{
+			desc:           "finalizer added by the manager is rolled back",
+			expectedReason: ReasonFinalizerRolledBack,
+		},
+		{
+			desc:           "pre-existing finalizer is preserved",
+			finalizers:     []string{"test-finalizer"},
+			expectedReason: ReasonStartFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := context.TODO()
+			logger, _ := ktesting.NewTestContext(t)
+			client := providerconfigclient.NewSimpleClientset()
+			starter := &reportingControllerStarter{mockControllerStarter: newMockControllerStarter()}
+			starter.shouldFailStart = true
+			manager := newManager(client, "test-finalizer", starter, logger)
+
+			pc := createTestProviderConfig("test-pc")
+			pc.Finalizers = tc.finalizers
+			if _, err := client.CloudV1().ProviderConfigs().Create(ctx, pc, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Failed to create test ProviderConfig: %v", err)
+			}
+			if err := manager.StartControllersForProviderConfig(pc); err == nil {
+				t.Fatal("Expected start to fail, but it succeeded")
+			}
+
+			pc, err := client.CloudV1().ProviderConfigs().Get(ctx, pc.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Failed to get ProviderConfig: %v", err)
+			}
+			condition := meta.FindStatusCondition(pc.Status.Conditions, ControllersRunningCondition)
+			if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != tc.expectedReason {
+				t.Fatalf("ControllersRunning condition = %+v, want status False with reason %s", condition, tc.expectedReason)
+			}
+			if !strings.Contains(condition.Message, "mock start failure") {
+				t.Errorf("Condition message = %q, want it to contain the start error", condition.Message)
+			}
+			if len(pc.Status.Controllers) != 1 || pc.Status.Controllers[0].Running {
+				t.Errorf("Status.Controllers = %+v, want one controller that is not running", pc.Status.Controllers)
+			}
+		})
+	}
+}
+
+// TestManagerStatusOnStopFailure verifies that a failure to remove the finalizer on stop
+// is reported as a condition.
+func TestManagerStatusOnStopFailure(t *testing.T) {
+	ctx := context.TODO()
+	logger, _ := ktesting.NewTestContext(t)
+	client := providerconfigclient.NewSimpleClientset()
+	manager := newManager(client, "test-finalizer", newMockControllerStarter(), logger)
+
+	pc := createTestProviderConfig("test-pc")
+	if _, err := client.CloudV1().ProviderConfigs().Create(ctx, pc, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create test ProviderConfig: %v", err)
+	}
+	if err := manager.StartControllersForProviderConfig(pc); err != nil {
+		t.Fatalf("StartControllersForProviderConfig() returned error: %v", err)
+	}
+
+	client.PrependReactor("patch", "providerconfigs", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if strings.Contains(string(action.(clienttesting.PatchAction).GetPatch()), "finalizers") {
+			return true, nil, fmt.Errorf("finalizer patch failure")
+		}
+		return false, nil, nil
+	})
+	if err := manager.StopControllersForProviderConfig(pc); err == nil {
+		t.Fatal("Expected stop to fail, but it succeeded")
+	}
+
+	pc, err := client.CloudV1().ProviderConfigs().Get(ctx, pc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ProviderConfig: %v", err)
+	}
+	condition := meta.FindStatusCondition(pc.Status.Conditions, ControllersRunningCondition)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != ReasonStopFailed {
+		t.Errorf("ControllersRunning condition = %+v, want status False with reason %s", condition, ReasonStopFailed)
+	}
+}
+
+// TestMultiControllerStarterStatus verifies that the multi starter merges the reports of its starters.
+func TestMultiControllerStarterStatus(t *testing.T) {
+	pc := createTestProviderConfig("test-pc")
+	resources := &providerconfig.ProviderConfigManagedResources{NEGs: 1, Services: 1}
+	starter := NewMultiControllerStarter(
+		&recordingStarter{},
+		&reportingControllerStarter{mockControllerStarter: newMockControllerStarter()},
+		&reportingControllerStarter{mockControllerStarter: newMockControllerStarter(), informersSynced: true, resources: resources},
+	).(ControllerStatusReporter)
+
+	if got := starter.ControllerStatuses(pc); len(got) != 2 || got[0].InformersSynced || !got[1].InformersSynced {
+		t.Errorf("ControllerStatuses() = %+v, want the statuses of both reporting starters in order", got)
+	}
+	if got := starter.ManagedResources(pc); got != resources {
+		t.Errorf("ManagedResources() = %+v, want %+v", got, resources)
+	}
+}
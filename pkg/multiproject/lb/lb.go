@@ -0,0 +1,153 @@
+package lb
+
+import (
+	"fmt"
+
+	nodetopologyclient "github.com/GoogleCloudPlatform/gke-networking-api/client/nodetopology/clientset/versioned"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/controller"
+	"k8s.io/ingress-gce/pkg/firewalls"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/l4/controllers"
+	l4lbconfigclient "k8s.io/ingress-gce/pkg/l4lbconfig/client/clientset/versioned"
+	multiprojectinformers "k8s.io/ingress-gce/pkg/multiproject/neg/informerset"
+	"k8s.io/ingress-gce/pkg/psc"
+	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
+	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+// StartLBControllers creates and runs the Ingress, L4 ILB, L4 NetLB and PSC controllers
+// enabled by flags for the specified ProviderConfig. The returned channel is closed by
+// StopControllersForProviderConfig to signal a shutdown specific to this ProviderConfig's
+// controllers.
+//
+// The controllers share a ControllerContext built on the informers filtered by the
+// ProviderConfig and on the GCE client of the ProviderConfig project. All of its
+// informers are shared by the ProviderConfigs and already run with globalStopCh, so
+// the context is not started: starting it would run them again, as well as usage
+// metrics of the ProviderConfig overwriting the process-wide ones. Firewall CRs are
+// not supported, since the informers of the ProviderConfig do not include them.
+func StartLBControllers(
+	informers *multiprojectinformers.InformerSet,
+	kubeClient kubernetes.Interface,
+	eventRecorderClient kubernetes.Interface,
+	svcNegClient svcnegclient.Interface,
+	saClient serviceattachmentclient.Interface,
+	l4LBConfigClient l4lbconfigclient.Interface,
+	nodeTopologyClient nodetopologyclient.Interface,
+	kubeSystemUID types.UID,
+	clusterNamer *namer.Namer,
+	l4Namer *namer.L4Namer,
+	ctxConfig ingctx.ControllerContextConfig,
+	cloud *gce.Cloud,
+	globalStopCh <-chan struct{},
+	logger klog.Logger,
+	providerConfig *providerconfig.ProviderConfig,
+) (chan<- struct{}, error) {
+	providerConfigName := providerConfig.Name
+	logger.V(2).Info("Initializing load balancer controllers", "providerConfig", providerConfigName)
+
+	if flags.F.RunIngressController && flags.F.EnableFirewallCR {
+		return nil, fmt.Errorf("firewall CRs are not supported by the load balancer controllers of provider config %s", providerConfigName)
+	}
+
+	ctx, err := newControllerContext(informers.FilterByProviderConfig(providerConfigName), kubeClient, eventRecorderClient, svcNegClient, saClient, l4LBConfigClient, nodeTopologyClient, kubeSystemUID, clusterNamer, l4Namer, ctxConfig, cloud, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ProviderConfig-specific stop channel. We close this in StopControllersForProviderConfig.
+	providerConfigStopCh := make(chan struct{})
+
+	// joinedStopCh will close when either the globalStopCh or providerConfigStopCh is closed.
+	joinedStopCh := make(chan struct{})
+	go func() {
+		defer func() {
+			close(joinedStopCh)
+			logger.V(2).Info("Load balancer controllers stop channel closed")
+		}()
+		select {
+		case <-globalStopCh:
+			logger.V(2).Info("Global stop channel triggered load balancer controllers shutdown")
+		case <-providerConfigStopCh:
+			logger.V(2).Info("Provider config stop channel triggered load balancer controllers shutdown")
+		}
+	}()
+
+	if flags.F.RunIngressController {
+		fwc, err := firewalls.NewFirewallController(ctx, flags.F.NodePortRanges.Values(), false, false, ctx.EnableIngressRegionalExternal, joinedStopCh, logger)
+		if err != nil {
+			close(providerConfigStopCh)
+			return nil, fmt.Errorf("failed to create firewall controller: %w", err)
+		}
+		lbc := controller.NewLoadBalancerController(ctx, joinedStopCh, logger)
+		go lbc.Run()
+		go fwc.Run()
+		logger.V(2).Info("Started ingress controller", "providerConfig", providerConfigName)
+	}
+	if flags.F.RunL4Controller {
+		go controllers.NewILBController(ctx, joinedStopCh, logger).Run()
+		logger.V(2).Info("Started L4 ILB controller", "providerConfig", providerConfigName)
+	}
+	if flags.F.RunL4NetLBController {
+		go controllers.NewL4NetLBController(ctx, joinedStopCh, logger).Run()
+		logger.V(2).Info("Started L4 NetLB controller", "providerConfig", providerConfigName)
+	}
+	if flags.F.EnablePSC && ctx.SAInformer != nil {
+		go psc.NewController(ctx, joinedStopCh, logger).Run()
+		logger.V(2).Info("Started PSC controller", "providerConfig", providerConfigName)
+	}
+
+	return providerConfigStopCh, nil
+}
+
+// newControllerContext returns the ControllerContext of the controllers of a ProviderConfig.
+// The L4 namer is shared with the NEG controller, so that both controllers agree on the
+// names of L4 NEGs.
+func newControllerContext(
+	filteredInformers *multiprojectinformers.InformerSet,
+	kubeClient kubernetes.Interface,
+	eventRecorderClient kubernetes.Interface,
+	svcNegClient svcnegclient.Interface,
+	saClient serviceattachmentclient.Interface,
+	l4LBConfigClient l4lbconfigclient.Interface,
+	nodeTopologyClient nodetopologyclient.Interface,
+	kubeSystemUID types.UID,
+	clusterNamer *namer.Namer,
+	l4Namer *namer.L4Namer,
+	ctxConfig ingctx.ControllerContextConfig,
+	cloud *gce.Cloud,
+	logger klog.Logger,
+) (*ingctx.ControllerContext, error) {
+	if filteredInformers.SvcNeg == nil {
+		return nil, fmt.Errorf("ServiceNetworkEndpointGroup informer is required by the load balancer controllers")
+	}
+
+	informers := ingctx.ControllerInformers{
+		Ingress:          filteredInformers.Ingress,
+		Service:          filteredInformers.Service,
+		BackendConfig:    filteredInformers.BackendConfig,
+		FrontendConfig:   filteredInformers.FrontendConfig,
+		Pod:              filteredInformers.Pod,
+		Node:             filteredInformers.Node,
+		EndpointSlice:    filteredInformers.EndpointSlice,
+		SvcNeg:           filteredInformers.SvcNeg,
+		SA:               filteredInformers.ServiceAttachment,
+		Network:          filteredInformers.Network,
+		GKENetworkParams: filteredInformers.GkeNetworkParams,
+		NodeTopology:     filteredInformers.NodeTopology,
+		L4LBConfig:       filteredInformers.L4LBConfig,
+	}
+	ctx, err := ingctx.NewControllerContextWithInformers(kubeClient, nil, svcNegClient, saClient, nodeTopologyClient, l4LBConfigClient, eventRecorderClient, cloud, clusterNamer, kubeSystemUID, ctxConfig, informers, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller context: %w", err)
+	}
+	ctx.L4Namer = l4Namer
+	return ctx, nil
+}
@@ -0,0 +1,192 @@
+package lb
+
+import (
+	"testing"
+
+	networkclient "github.com/GoogleCloudPlatform/gke-networking-api/client/network/clientset/versioned"
+	nodetopologyclient "github.com/GoogleCloudPlatform/gke-networking-api/client/nodetopology/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	l4lbconfigv1 "k8s.io/ingress-gce/pkg/apis/l4lbconfig/v1"
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+	sav1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1"
+	svcnegv1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
+	backendconfigfake "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
+	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/flags"
+	frontendconfigfake "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned/fake"
+	l4lbconfigfake "k8s.io/ingress-gce/pkg/l4lbconfig/client/clientset/versioned/fake"
+	multiprojectgce "k8s.io/ingress-gce/pkg/multiproject/common/gce"
+	multiprojectinformers "k8s.io/ingress-gce/pkg/multiproject/neg/informerset"
+	serviceattachmentfake "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned/fake"
+	svcnegfake "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned/fake"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2/ktesting"
+)
+
+func testProviderConfig(name string) *providerconfig.ProviderConfig {
+	return &providerconfig.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: providerconfig.ProviderConfigSpec{
+			ProjectID:     "test-project",
+			ProjectNumber: 123,
+			NetworkConfig: providerconfig.ProviderNetworkConfig{
+				Network:    "net-1",
+				SubnetInfo: providerconfig.ProviderConfigSubnetInfo{Subnetwork: "sub-1"},
+			},
+		},
+	}
+}
+
+func testService(name, providerConfigName string) *v1.Service {
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	if providerConfigName != "" {
+		svc.Labels = map[string]string{flags.F.ProviderConfigNameLabelKey: providerConfigName}
+	}
+	return svc
+}
+
+// prependKubeBookmarkReactors makes the watches of the kube resources used by the
+// InformerSet send the initial events bookmark.
+func prependKubeBookmarkReactors(kubeClient *k8sfake.Clientset) {
+	resources := []struct {
+		res string
+		obj runtime.Object
+	}{
+		{"ingresses", &networkingv1.Ingress{ObjectMeta: test.DefaultBookmarkObjectMeta}},
+		{"services", &v1.Service{ObjectMeta: test.DefaultBookmarkObjectMeta}},
+		{"pods", &v1.Pod{ObjectMeta: test.DefaultBookmarkObjectMeta}},
+		{"nodes", &v1.Node{ObjectMeta: test.DefaultBookmarkObjectMeta}},
+		{"endpointslices", &discovery.EndpointSlice{ObjectMeta: test.DefaultBookmarkObjectMeta}},
+	}
+	for _, r := range resources {
+		test.PrependBookmarkReactor(&kubeClient.Fake, kubeClient.Tracker(), r.res, r.obj)
+	}
+}
+
+// TestNewControllerContext_FiltersByProviderConfig verifies that the controller context of a
+// ProviderConfig only exposes objects of that ProviderConfig and uses the shared L4 namer.
+func TestNewControllerContext_FiltersByProviderConfig(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	kubeClient := k8sfake.NewSimpleClientset(
+		testService("svc-pc-1", "pc-1"),
+		testService("svc-pc-2", "pc-2"),
+		testService("svc-unlabeled", ""),
+	)
+	svcNegClient := svcnegfake.NewSimpleClientset()
+	saClient := serviceattachmentfake.NewSimpleClientset()
+	l4LBConfigClient := l4lbconfigfake.NewSimpleClientset()
+	backendConfigClient := backendconfigfake.NewSimpleClientset()
+	frontendConfigClient := frontendconfigfake.NewSimpleClientset()
+	prependKubeBookmarkReactors(kubeClient)
+	test.PrependBookmarkReactor(&svcNegClient.Fake, svcNegClient.Tracker(), "*", &svcnegv1.ServiceNetworkEndpointGroup{ObjectMeta: test.DefaultBookmarkObjectMeta})
+	test.PrependBookmarkReactor(&saClient.Fake, saClient.Tracker(), "*", &sav1.ServiceAttachment{ObjectMeta: test.DefaultBookmarkObjectMeta})
+	test.PrependBookmarkReactor(&l4LBConfigClient.Fake, l4LBConfigClient.Tracker(), "*", &l4lbconfigv1.L4LBConfig{ObjectMeta: test.DefaultBookmarkObjectMeta})
+	test.PrependBookmarkReactor(&backendConfigClient.Fake, backendConfigClient.Tracker(), "*", &backendconfigv1.BackendConfig{ObjectMeta: test.DefaultBookmarkObjectMeta})
+	test.PrependBookmarkReactor(&frontendConfigClient.Fake, frontendConfigClient.Tracker(), "*", &frontendconfigv1beta1.FrontendConfig{ObjectMeta: test.DefaultBookmarkObjectMeta})
+
+	informers := multiprojectinformers.NewInformerSet(kubeClient, svcNegClient, networkclient.Interface(nil), nodetopologyclient.Interface(nil), metav1.Duration{})
+	informers.AddLoadBalancerInformers(backendConfigClient, frontendConfigClient, saClient, l4LBConfigClient, metav1.Duration{})
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	if err := informers.Start(stopCh, logger); err != nil {
+		t.Fatalf("start informers: %v", err)
+	}
+
+	pc := testProviderConfig("pc-1")
+	cloud, err := multiprojectgce.NewGCEFake().GCEForProviderConfig(pc, logger)
+	if err != nil {
+		t.Fatalf("create fake cloud: %v", err)
+	}
+	kubeSystemUID := types.UID("uid")
+	rootNamer := namer.NewNamer("clusteruid", "", logger)
+	l4Namer := namer.NewL4Namer(string(kubeSystemUID), rootNamer)
+
+	ctx, err := newControllerContext(informers.FilterByProviderConfig(pc.Name), kubeClient, kubeClient, svcNegClient, saClient, l4LBConfigClient, nil, kubeSystemUID, rootNamer, l4Namer, ingctx.ControllerContextConfig{}, cloud, logger)
+	if err != nil {
+		t.Fatalf("newControllerContext() returned error: %v", err)
+	}
+	if ctx.L4Namer != l4Namer {
+		t.Errorf("ctx.L4Namer = %v, want the shared L4 namer", ctx.L4Namer)
+	}
+	if ctx.SAInformer == nil || ctx.BackendConfigInformer == nil || ctx.FrontendConfigInformer == nil || ctx.L4LBConfigInformer == nil {
+		t.Errorf("load balancer informers are not set in the controller context")
+	}
+	if !cache.WaitForCacheSync(stopCh, ctx.ServiceInformer.HasSynced) {
+		t.Fatalf("failed to sync service informer")
+	}
+
+	var got []string
+	for _, obj := range ctx.ServiceInformer.GetIndexer().List() {
+		got = append(got, obj.(*v1.Service).Name)
+	}
+	if len(got) != 1 || got[0] != "svc-pc-1" {
+		t.Errorf("services in the controller context = %v, want [svc-pc-1]", got)
+	}
+}
+
+// TestStartLBControllers_NilSvcNegInformerErrors verifies StartLBControllers returns an error
+// when the svcneg informer is missing.
+func TestStartLBControllers_NilSvcNegInformerErrors(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	kubeClient := k8sfake.NewSimpleClientset()
+	prependKubeBookmarkReactors(kubeClient)
+	informers := multiprojectinformers.NewInformerSet(kubeClient, nil, networkclient.Interface(nil), nodetopologyclient.Interface(nil), metav1.Duration{})
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	if err := informers.Start(stopCh, logger); err != nil {
+		t.Fatalf("start informers: %v", err)
+	}
+
+	pc := testProviderConfig("pc-err")
+	cloud, err := multiprojectgce.NewGCEFake().GCEForProviderConfig(pc, logger)
+	if err != nil {
+		t.Fatalf("create fake cloud: %v", err)
+	}
+	kubeSystemUID := types.UID("uid")
+	rootNamer := namer.NewNamer("clusteruid", "", logger)
+
+	ch, err := StartLBControllers(informers, kubeClient, kubeClient, nil, nil, nil, nil, kubeSystemUID, rootNamer, namer.NewL4Namer(string(kubeSystemUID), rootNamer), ingctx.ControllerContextConfig{}, cloud, stopCh, logger, pc)
+	if err == nil {
+		t.Fatalf("expected error from StartLBControllers when svcneg informer is missing, got nil and channel=%v", ch)
+	}
+}
+
+// TestStartLBControllers_FirewallCRErrors verifies StartLBControllers fails fast when
+// firewall CRs are enabled, since the informers of a ProviderConfig do not include them.
+func TestStartLBControllers_FirewallCRErrors(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	flags.F.RunIngressController = true
+	flags.F.EnableFirewallCR = true
+	t.Cleanup(func() {
+		flags.F.RunIngressController = false
+		flags.F.EnableFirewallCR = false
+	})
+	kubeClient := k8sfake.NewSimpleClientset()
+	svcNegClient := svcnegfake.NewSimpleClientset()
+	informers := multiprojectinformers.NewInformerSet(kubeClient, svcNegClient, networkclient.Interface(nil), nodetopologyclient.Interface(nil), metav1.Duration{})
+
+	pc := testProviderConfig("pc-fw")
+	cloud, err := multiprojectgce.NewGCEFake().GCEForProviderConfig(pc, logger)
+	if err != nil {
+		t.Fatalf("create fake cloud: %v", err)
+	}
+	kubeSystemUID := types.UID("uid")
+	rootNamer := namer.NewNamer("clusteruid", "", logger)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+
+	ch, err := StartLBControllers(informers, kubeClient, kubeClient, svcNegClient, nil, nil, nil, kubeSystemUID, rootNamer, namer.NewL4Namer(string(kubeSystemUID), rootNamer), ingctx.ControllerContextConfig{}, cloud, stopCh, logger, pc)
+	if err == nil {
+		t.Fatalf("expected error from StartLBControllers with firewall CRs enabled, got nil and channel=%v", ch)
+	}
+}
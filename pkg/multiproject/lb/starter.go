@@ -0,0 +1,152 @@
+package lb
+
+import (
+	"fmt"
+
+	nodetopologyclient "github.com/GoogleCloudPlatform/gke-networking-api/client/nodetopology/clientset/versioned"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	providerconfig "k8s.io/ingress-gce/pkg/apis/providerconfig/v1"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
+	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/flags"
+	frontendconfigclient "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned"
+	l4lbconfigclient "k8s.io/ingress-gce/pkg/l4lbconfig/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/multiproject/common/gce"
+	multiprojectinformers "k8s.io/ingress-gce/pkg/multiproject/neg/informerset"
+	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
+	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+// Names of the load balancer controllers in the ProviderConfig status.
+const (
+	ingressControllerName = "ingress-controller"
+	l4ILBControllerName   = "l4-ilb-controller"
+	l4NetLBControllerName = "l4-netlb-controller"
+	pscControllerName     = "psc-controller"
+)
+
+// Config holds the clients and the controller context configuration used by the
+// load balancer controllers of every ProviderConfig.
+type Config struct {
+	BackendConfigClient     backendconfigclient.Interface
+	FrontendConfigClient    frontendconfigclient.Interface
+	SAClient                serviceattachmentclient.Interface
+	L4LBConfigClient        l4lbconfigclient.Interface
+	ControllerContextConfig ingctx.ControllerContextConfig
+}
+
+// LBControllerStarter implements framework.ControllerStarter for the Ingress, L4 and PSC
+// controllers. It encapsulates all load balancer specific dependencies and startup logic.
+type LBControllerStarter struct {
+	informers           *multiprojectinformers.InformerSet
+	kubeClient          kubernetes.Interface
+	svcNegClient        svcnegclient.Interface
+	nodeTopologyClient  nodetopologyclient.Interface
+	eventRecorderClient kubernetes.Interface
+	kubeSystemUID       types.UID
+	clusterNamer        *namer.Namer
+	l4Namer             *namer.L4Namer
+	config              Config
+	gceCreator          gce.GCECreator
+	globalStopCh        <-chan struct{}
+	logger              klog.Logger
+}
+
+// NewLBControllerStarter creates a new load balancer controller starter with the given dependencies.
+func NewLBControllerStarter(
+	informers *multiprojectinformers.InformerSet,
+	kubeClient kubernetes.Interface,
+	svcNegClient svcnegclient.Interface,
+	nodeTopologyClient nodetopologyclient.Interface,
+	eventRecorderClient kubernetes.Interface,
+	kubeSystemUID types.UID,
+	clusterNamer *namer.Namer,
+	l4Namer *namer.L4Namer,
+	config Config,
+	gceCreator gce.GCECreator,
+	globalStopCh <-chan struct{},
+	logger klog.Logger,
+) *LBControllerStarter {
+	return &LBControllerStarter{
+		informers:           informers,
+		kubeClient:          kubeClient,
+		svcNegClient:        svcNegClient,
+		nodeTopologyClient:  nodeTopologyClient,
+		eventRecorderClient: eventRecorderClient,
+		kubeSystemUID:       kubeSystemUID,
+		clusterNamer:        clusterNamer,
+		l4Namer:             l4Namer,
+		config:              config,
+		gceCreator:          gceCreator,
+		globalStopCh:        globalStopCh,
+		logger:              logger,
+	}
+}
+
+// StartController implements framework.ControllerStarter.
+// It creates a GCE client for the ProviderConfig and starts the load balancer controllers.
+func (s *LBControllerStarter) StartController(pc *providerconfig.ProviderConfig) (chan<- struct{}, error) {
+	logger := s.logger.WithValues("providerConfigId", pc.Name)
+
+	cloud, err := s.gceCreator.GCEForProviderConfig(pc, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCE client for provider config %+v: %w", pc, err)
+	}
+
+	tenantNamer := namer.NewMTNamer(pc.Spec.PrincipalInfo.ID, s.clusterNamer.Firewall(), logger)
+
+	lbControllersStopCh, err := StartLBControllers(
+		s.informers,
+		s.kubeClient,
+		s.eventRecorderClient,
+		s.svcNegClient,
+		s.config.SAClient,
+		s.config.L4LBConfigClient,
+		s.nodeTopologyClient,
+		s.kubeSystemUID,
+		tenantNamer,
+		s.l4Namer,
+		s.config.ControllerContextConfig,
+		cloud,
+		s.globalStopCh,
+		logger,
+		pc,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start load balancer controllers: %w", err)
+	}
+
+	return lbControllersStopCh, nil
+}
+
+// ControllerStatuses implements framework.ControllerStatusReporter.
+// It reports the load balancer controllers enabled by flags.
+func (s *LBControllerStarter) ControllerStatuses(pc *providerconfig.ProviderConfig) []providerconfig.ProviderConfigControllerStatus {
+	informers := s.informers.FilterByProviderConfig(pc.Name)
+	synced := informers.CombinedHasSynced()()
+
+	var statuses []providerconfig.ProviderConfigControllerStatus
+	for _, controller := range []struct {
+		name    string
+		enabled bool
+	}{
+		{ingressControllerName, flags.F.RunIngressController},
+		{l4ILBControllerName, flags.F.RunL4Controller},
+		{l4NetLBControllerName, flags.F.RunL4NetLBController},
+		{pscControllerName, flags.F.EnablePSC && informers.ServiceAttachment != nil},
+	} {
+		if controller.enabled {
+			statuses = append(statuses, providerconfig.ProviderConfigControllerStatus{Name: controller.name, InformersSynced: synced})
+		}
+	}
+	return statuses
+}
+
+// ManagedResources implements framework.ControllerStatusReporter.
+// Resource counts are reported by the NEG controller starter.
+func (s *LBControllerStarter) ManagedResources(pc *providerconfig.ProviderConfig) *providerconfig.ProviderConfigManagedResources {
+	return nil
+}
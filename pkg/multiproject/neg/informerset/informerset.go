@@ -11,7 +11,15 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
+	backendconfiginformers "k8s.io/ingress-gce/pkg/backendconfig/client/informers/externalversions"
+	frontendconfigclient "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned"
+	frontendconfiginformers "k8s.io/ingress-gce/pkg/frontendconfig/client/informers/externalversions"
+	l4lbconfigclient "k8s.io/ingress-gce/pkg/l4lbconfig/client/clientset/versioned"
+	l4lbconfiginformers "k8s.io/ingress-gce/pkg/l4lbconfig/client/informers/externalversions"
 	"k8s.io/ingress-gce/pkg/multiproject/common/filteredinformer"
+	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
+	serviceattachmentinformers "k8s.io/ingress-gce/pkg/serviceattachment/client/informers/externalversions"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 	svcneginformers "k8s.io/ingress-gce/pkg/svcneg/client/informers/externalversions"
 	"k8s.io/ingress-gce/pkg/utils/endpointslices"
@@ -26,6 +34,12 @@ type InformerSet struct {
 	networkFactory      networkinformers.SharedInformerFactory
 	nodetopologyFactory nodetopologyinformers.SharedInformerFactory
 
+	// Load balancer CRD factories, set by AddLoadBalancerInformers
+	backendConfigFactory     backendconfiginformers.SharedInformerFactory
+	frontendConfigFactory    frontendconfiginformers.SharedInformerFactory
+	serviceAttachmentFactory serviceattachmentinformers.SharedInformerFactory
+	l4LBConfigFactory        l4lbconfiginformers.SharedInformerFactory
+
 	// Core Kubernetes informers (always present)
 	Ingress       cache.SharedIndexInformer
 	Service       cache.SharedIndexInformer
@@ -39,6 +53,12 @@ type InformerSet struct {
 	GkeNetworkParams cache.SharedIndexInformer // GKENetworkParamSets CRD
 	NodeTopology     cache.SharedIndexInformer // NodeTopology CRD
 
+	// Load balancer CRD informers (may be nil)
+	BackendConfig     cache.SharedIndexInformer // BackendConfig CRD
+	FrontendConfig    cache.SharedIndexInformer // FrontendConfig CRD
+	ServiceAttachment cache.SharedIndexInformer // ServiceAttachment CRD
+	L4LBConfig        cache.SharedIndexInformer // L4LBConfig CRD
+
 	// State tracking
 	started bool
 }
@@ -97,6 +117,35 @@ func NewInformerSet(
 	return infSet
 }
 
+// AddLoadBalancerInformers creates the informers of the CRDs used by the Ingress, L4
+// and PSC controllers. Informers are created only for the provided clients. It must
+// be called before Start.
+func (i *InformerSet) AddLoadBalancerInformers(
+	backendConfigClient backendconfigclient.Interface,
+	frontendConfigClient frontendconfigclient.Interface,
+	saClient serviceattachmentclient.Interface,
+	l4LBConfigClient l4lbconfigclient.Interface,
+	resyncPeriod metav1.Duration,
+) *InformerSet {
+	if backendConfigClient != nil {
+		i.backendConfigFactory = backendconfiginformers.NewSharedInformerFactory(backendConfigClient, resyncPeriod.Duration)
+		i.BackendConfig = i.backendConfigFactory.Cloud().V1().BackendConfigs().Informer()
+	}
+	if frontendConfigClient != nil {
+		i.frontendConfigFactory = frontendconfiginformers.NewSharedInformerFactory(frontendConfigClient, resyncPeriod.Duration)
+		i.FrontendConfig = i.frontendConfigFactory.Networking().V1beta1().FrontendConfigs().Informer()
+	}
+	if saClient != nil {
+		i.serviceAttachmentFactory = serviceattachmentinformers.NewSharedInformerFactory(saClient, resyncPeriod.Duration)
+		i.ServiceAttachment = i.serviceAttachmentFactory.Networking().V1().ServiceAttachments().Informer()
+	}
+	if l4LBConfigClient != nil {
+		i.l4LBConfigFactory = l4lbconfiginformers.NewSharedInformerFactory(l4LBConfigClient, resyncPeriod.Duration)
+		i.L4LBConfig = i.l4LBConfigFactory.Networking().V1().L4LBConfigs().Informer()
+	}
+	return i
+}
+
 // Start starts all informers and waits for their caches to sync.
 // It is idempotent: repeated calls return nil once informers have started.
 // If the provided stop channel is already closed, it returns an error after
@@ -130,6 +179,18 @@ func (i *InformerSet) Start(stopCh <-chan struct{}, logger klog.Logger) error {
 	if i.nodetopologyFactory != nil {
 		i.nodetopologyFactory.Start(stopCh)
 	}
+	if i.backendConfigFactory != nil {
+		i.backendConfigFactory.Start(stopCh)
+	}
+	if i.frontendConfigFactory != nil {
+		i.frontendConfigFactory.Start(stopCh)
+	}
+	if i.serviceAttachmentFactory != nil {
+		i.serviceAttachmentFactory.Start(stopCh)
+	}
+	if i.l4LBConfigFactory != nil {
+		i.l4LBConfigFactory.Start(stopCh)
+	}
 
 	i.started = true
 
@@ -182,6 +243,18 @@ func (i *InformerSet) FilterByProviderConfig(providerConfigName string) *Informe
 	if i.NodeTopology != nil {
 		filteredInformers.NodeTopology = newProviderConfigFilteredInformer(i.NodeTopology, providerConfigName)
 	}
+	if i.BackendConfig != nil {
+		filteredInformers.BackendConfig = newProviderConfigFilteredInformer(i.BackendConfig, providerConfigName)
+	}
+	if i.FrontendConfig != nil {
+		filteredInformers.FrontendConfig = newProviderConfigFilteredInformer(i.FrontendConfig, providerConfigName)
+	}
+	if i.ServiceAttachment != nil {
+		filteredInformers.ServiceAttachment = newProviderConfigFilteredInformer(i.ServiceAttachment, providerConfigName)
+	}
+	if i.L4LBConfig != nil {
+		filteredInformers.L4LBConfig = newProviderConfigFilteredInformer(i.L4LBConfig, providerConfigName)
+	}
 
 	return filteredInformers
 }
@@ -239,6 +312,18 @@ func (i *InformerSet) hasSyncedFuncs() []func() bool {
 	if i.NodeTopology != nil {
 		funcs = append(funcs, i.NodeTopology.HasSynced)
 	}
+	if i.BackendConfig != nil {
+		funcs = append(funcs, i.BackendConfig.HasSynced)
+	}
+	if i.FrontendConfig != nil {
+		funcs = append(funcs, i.FrontendConfig.HasSynced)
+	}
+	if i.ServiceAttachment != nil {
+		funcs = append(funcs, i.ServiceAttachment.HasSynced)
+	}
+	if i.L4LBConfig != nil {
+		funcs = append(funcs, i.L4LBConfig.HasSynced)
+	}
 
 	return funcs
 }
@@ -194,6 +194,7 @@ func createNEGController(
 		flags.F.ReadOnlyMode,
 		flags.F.EnableNEGsForIngress,
 		flags.F.EnableL4NEGLocalIncludeDrainNodes,
+		negtypes.NoSharding{},
 		stopCh,
 		logger,
 		negMetrics,
@@ -17,6 +17,9 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// negControllerName is the name of the NEG controller in the ProviderConfig status.
+const negControllerName = "neg-controller"
+
 // NEGControllerStarter implements framework.ControllerStarter for NEG controllers.
 // It encapsulates all NEG-specific dependencies and startup logic.
 type NEGControllerStarter struct {
@@ -106,3 +109,34 @@ func (s *NEGControllerStarter) StartController(pc *providerconfig.ProviderConfig
 
 	return negControllerStopCh, nil
 }
+
+// ReleaseProviderConfig implements framework.ProviderConfigReleaser.
+// It releases the GCE client state of the ProviderConfig, such as its rate limiter,
+// which is shared with the load balancer controllers.
+func (s *NEGControllerStarter) ReleaseProviderConfig(pc *providerconfig.ProviderConfig) {
+	s.gceCreator.ReleaseProviderConfig(pc)
+}
+
+// ControllerStatuses implements framework.ControllerStatusReporter.
+func (s *NEGControllerStarter) ControllerStatuses(pc *providerconfig.ProviderConfig) []providerconfig.ProviderConfigControllerStatus {
+	return []providerconfig.ProviderConfigControllerStatus{
+		{
+			Name:            negControllerName,
+			InformersSynced: s.informers.FilterByProviderConfig(pc.Name).CombinedHasSynced()(),
+		},
+	}
+}
+
+// ManagedResources implements framework.ControllerStatusReporter.
+// It counts the ServiceNetworkEndpointGroups and Services labeled with the ProviderConfig.
+func (s *NEGControllerStarter) ManagedResources(pc *providerconfig.ProviderConfig) *providerconfig.ProviderConfigManagedResources {
+	informers := s.informers.FilterByProviderConfig(pc.Name)
+	resources := &providerconfig.ProviderConfigManagedResources{}
+	if informers.SvcNeg != nil {
+		resources.NEGs = int32(len(informers.SvcNeg.GetIndexer().ListKeys()))
+	}
+	if informers.Service != nil {
+		resources.Services = int32(len(informers.Service.GetIndexer().ListKeys()))
+	}
+	return resources
+}
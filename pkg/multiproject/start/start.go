@@ -20,6 +20,7 @@ import (
 	"k8s.io/ingress-gce/pkg/multiproject/common/finalizer"
 	"k8s.io/ingress-gce/pkg/multiproject/common/gce"
 	"k8s.io/ingress-gce/pkg/multiproject/framework"
+	"k8s.io/ingress-gce/pkg/multiproject/lb"
 	"k8s.io/ingress-gce/pkg/multiproject/neg"
 	multiprojectinformers "k8s.io/ingress-gce/pkg/multiproject/neg/informerset"
 	syncMetrics "k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
@@ -52,12 +53,13 @@ func StartWithLeaderElection(
 	rootNamer *namer.Namer,
 	stopCh <-chan struct{},
 	syncerMetrics *syncMetrics.SyncerMetrics,
+	lbConfig *lb.Config,
 ) error {
 	logger.V(1).Info("Starting multi-project controller with leader election", "host", hostname)
 
 	recordersManager := recorders.NewManager(eventRecorderKubeClient, logger)
 
-	leConfig, err := makeLeaderElectionConfig(leaderElectKubeClient, hostname, recordersManager, logger, kubeClient, svcNegClient, networkClient, nodeTopologyClient, kubeSystemUID, eventRecorderKubeClient, providerConfigClient, gceCreator, rootNamer, syncerMetrics)
+	leConfig, err := makeLeaderElectionConfig(leaderElectKubeClient, hostname, recordersManager, logger, kubeClient, svcNegClient, networkClient, nodeTopologyClient, kubeSystemUID, eventRecorderKubeClient, providerConfigClient, gceCreator, rootNamer, syncerMetrics, lbConfig)
 	if err != nil {
 		return err
 	}
@@ -90,6 +92,7 @@ func makeLeaderElectionConfig(
 	gceCreator gce.GCECreator,
 	rootNamer *namer.Namer,
 	syncerMetrics *syncMetrics.SyncerMetrics,
+	lbConfig *lb.Config,
 ) (*leaderelection.LeaderElectionConfig, error) {
 	recorder := recordersManager.Recorder(flags.F.LeaderElection.LockObjectNamespace)
 	// add a uniquifier so that two processes on the same host don't accidentally both become active
@@ -118,7 +121,7 @@ func makeLeaderElectionConfig(
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				logger.Info("Became leader, starting multi-project controller")
-				Start(logger, kubeClient, svcNegClient, networkClient, nodeTopologyClient, kubeSystemUID, eventRecorderKubeClient, providerConfigClient, gceCreator, rootNamer, ctx.Done(), syncerMetrics)
+				Start(logger, kubeClient, svcNegClient, networkClient, nodeTopologyClient, kubeSystemUID, eventRecorderKubeClient, providerConfigClient, gceCreator, rootNamer, ctx.Done(), syncerMetrics, lbConfig)
 			},
 			OnStoppedLeading: func() {
 				logger.Info("Stop running multi-project leader election")
@@ -131,6 +134,8 @@ func makeLeaderElectionConfig(
 
 // Start starts the ProviderConfig controller.
 // It creates SharedIndexInformers directly and starts the controller.
+// When lbConfig is not nil, the Ingress, L4 and PSC controllers are started for each
+// ProviderConfig alongside the NEG controller.
 func Start(
 	logger klog.Logger,
 	kubeClient kubernetes.Interface,
@@ -144,6 +149,7 @@ func Start(
 	rootNamer *namer.Namer,
 	stopCh <-chan struct{},
 	syncerMetrics *syncMetrics.SyncerMetrics,
+	lbConfig *lb.Config,
 ) {
 	logger.V(1).Info("Starting ProviderConfig controller")
 	lpConfig := labels.PodLabelPropagationConfig{}
@@ -162,6 +168,15 @@ func Start(
 		nodeTopologyClient,
 		metav1.Duration{Duration: flags.F.ResyncPeriod},
 	)
+	if lbConfig != nil {
+		informers.AddLoadBalancerInformers(
+			lbConfig.BackendConfigClient,
+			lbConfig.FrontendConfigClient,
+			lbConfig.SAClient,
+			lbConfig.L4LBConfigClient,
+			metav1.Duration{Duration: flags.F.ResyncPeriod},
+		)
+	}
 
 	// Start all informers
 	err := informers.Start(stopCh, logger)
@@ -170,7 +185,8 @@ func Start(
 		return
 	}
 
-	negStarter := neg.NewNEGControllerStarter(
+	l4Namer := namer.NewL4Namer(string(kubeSystemUID), rootNamer)
+	var starter framework.ControllerStarter = neg.NewNEGControllerStarter(
 		informers,
 		kubeClient,
 		svcNegClient,
@@ -179,13 +195,32 @@ func Start(
 		eventRecorderKubeClient,
 		kubeSystemUID,
 		rootNamer,
-		namer.NewL4Namer(string(kubeSystemUID), rootNamer),
+		l4Namer,
 		lpConfig,
 		gceCreator,
 		stopCh,
 		logger,
 		syncerMetrics,
 	)
+	if lbConfig != nil {
+		// The load balancer controllers share the NEG controller finalizer, so that
+		// a single controller owns the finalizer of a ProviderConfig.
+		lbStarter := lb.NewLBControllerStarter(
+			informers,
+			kubeClient,
+			svcNegClient,
+			nodeTopologyClient,
+			eventRecorderKubeClient,
+			kubeSystemUID,
+			rootNamer,
+			l4Namer,
+			*lbConfig,
+			gceCreator,
+			stopCh,
+			logger,
+		)
+		starter = framework.NewMultiControllerStarter(starter, lbStarter)
+	}
 
 	// Create ProviderConfig informer
 	providerConfigInformer := providerconfiginformers.NewSharedInformerFactory(providerConfigClient, flags.F.ResyncPeriod).Cloud().V1().ProviderConfigs().Informer()
@@ -205,7 +240,7 @@ func Start(
 		providerConfigClient,
 		providerConfigInformer,
 		finalizer.ProviderConfigNEGCleanupFinalizer,
-		negStarter,
+		starter,
 		stopCh,
 		logger,
 	)
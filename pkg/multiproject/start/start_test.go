@@ -285,6 +285,7 @@ func TestStartProviderConfigIntegration(t *testing.T) {
 					rootNamer,
 					stopCh,
 					syncMetrics.FakeSyncerMetrics(),
+					nil,
 				)
 			}()
 
@@ -427,6 +428,7 @@ func TestSharedInformers_PC1Stops_PC2AndPC3KeepWorking(t *testing.T) {
 		logger, kubeClient, svcNegClient, networkClient, nodeTopoClient,
 		kubeSystemUID, kubeClient, pcClient,
 		gceCreator, rootNamer, globalStop, syncMetrics.FakeSyncerMetrics(),
+		nil,
 	)
 
 	// Without the time.Sleep: the main test goroutine would create resources
@@ -1103,6 +1105,7 @@ func TestProviderConfigErrorCases(t *testing.T) {
 					rootNamer,
 					stopCh,
 					syncMetrics.FakeSyncerMetrics(),
+					nil,
 				)
 			}()
 
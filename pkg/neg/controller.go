@@ -38,6 +38,8 @@ import (
 	"k8s.io/ingress-gce/pkg/annotations"
 	svcnegv1beta1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
 	"k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/debug"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	l4annotations "k8s.io/ingress-gce/pkg/l4/annotations"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
@@ -45,11 +47,13 @@ import (
 	"k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
 	syncMetrics "k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
 	"k8s.io/ingress-gce/pkg/neg/readiness"
+	negsyncer "k8s.io/ingress-gce/pkg/neg/syncers"
 	"k8s.io/ingress-gce/pkg/neg/syncers/labels"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/negannotation"
 	"k8s.io/ingress-gce/pkg/network"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/systemhealth"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/endpointslices"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -58,6 +62,10 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// negDegradedSyncAge is the time without processed updates after which the
+// controller reports itself degraded.
+const negDegradedSyncAge = 30 * time.Minute
+
 // Controller is network endpoint group controller.
 // It determines whether NEG for a service port is needed, then signals NegSyncerManager to sync it.
 type Controller struct {
@@ -90,6 +98,9 @@ type Controller struct {
 	syncTracker utils.TimeTracker
 	// nodeSyncTracker tracks the latest time that node changes are processed
 	nodeSyncTracker utils.TimeTracker
+	// debugTracker records the outcome of the last processing of each service
+	// for the debug endpoints.
+	debugTracker *debug.SyncTracker
 
 	// reflector handles NEG readiness gate and conditions for pods in NEG.
 	reflector readiness.Reflector
@@ -127,6 +138,10 @@ type Controller struct {
 	// source of truth and does not rebuild the set on every event.
 	nodeMembershipFilters []zonegetter.Filter
 
+	// shardOwner tells which services are processed by this replica when the
+	// NEG controller is sharded across replicas.
+	shardOwner negtypes.ShardOwner
+
 	stopCh <-chan struct{}
 	logger klog.Logger
 
@@ -202,6 +217,7 @@ func NewController(
 	readOnlyMode bool,
 	enableNEGsForIngress bool,
 	includeDrainNodesL4Local bool,
+	shardOwner negtypes.ShardOwner,
 	stopCh <-chan struct{},
 	logger klog.Logger,
 	negMetrics *metrics.NegMetrics,
@@ -228,8 +244,8 @@ func NewController(
 		logger.Error(err, "Errored adding NEG CRD scheme to event recorder")
 		negMetrics.PublishNegControllerErrorCountMetrics(err, true)
 	}
-	recorder := eventBroadcaster.NewRecorder(negScheme,
-		apiv1.EventSource{Component: "neg-controller"})
+	recorder := events.NewFilteringRecorder(eventBroadcaster.NewRecorder(negScheme,
+		apiv1.EventSource{Component: "neg-controller"}), events.FilterOptionsFromFlags())
 
 	manager := newSyncerManager(
 		namer,
@@ -252,7 +268,12 @@ func NewController(
 		logger,
 		negMetrics,
 		includeDrainNodesL4Local,
+		shardOwner,
 	)
+	// Shards are handed over to other replicas once their syncers stopped.
+	if releaser, ok := shardOwner.(negtypes.ShardReleaser); ok {
+		releaser.SetSyncerTracker(manager)
+	}
 
 	var reflector readiness.Reflector
 	if enableReadinessReflector {
@@ -272,6 +293,9 @@ func NewController(
 		reflector = &readiness.NoopReflector{}
 	}
 	manager.reflector = reflector
+	if flags.F.PublishL4EndpointWeights {
+		manager.weightPublisher = negsyncer.NewNodeWeightPublisher(kubeClient, nodeInformer.GetIndexer(), logger)
+	}
 
 	var networkIndexer cache.Indexer
 	if networkInformer != nil {
@@ -298,6 +322,7 @@ func NewController(
 		serviceLister:                  serviceInformer.GetIndexer(),
 		networkResolver:                network.NewNetworksResolver(networkIndexer, gkeNetworkParamSetIndexer, cloud, enableMultiNetworking, logger),
 		serviceQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "neg_service_queue"),
+		debugTracker:                   debug.NewSyncTracker(),
 		endpointQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "neg_endpoint_queue"),
 		nodeQueue:                      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "neg_node_queue"),
 		syncTracker:                    utils.NewTimeTracker(),
@@ -311,6 +336,7 @@ func NewController(
 		enableNEGsForIngress:           enableNEGsForIngress,
 		includeDrainNodesL4Local:       includeDrainNodesL4Local,
 		nodeMembershipFilters:          buildNodeMembershipFilters(includeDrainNodesL4Local),
+		shardOwner:                     shardOwner,
 		stopCh:                         stopCh,
 		logger:                         logger,
 		negMetrics:                     negMetrics,
@@ -458,9 +484,31 @@ func (c *Controller) Run() {
 		wait.Until(c.gc, c.gcPeriod, c.stopCh)
 	}()
 	go c.reflector.Run(c.stopCh)
+	go c.resyncOnShardChange()
 	<-c.stopCh
 }
 
+// resyncOnShardChange enqueues all services whenever the shards owned by this
+// replica change, so that the services of acquired shards are synced and the
+// syncers of the services of released shards are stopped.
+func (c *Controller) resyncOnShardChange() {
+	changed := c.shardOwner.Changed()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case _, ok := <-changed:
+			if !ok {
+				return
+			}
+			c.logger.V(2).Info("Owned NEG shards changed, resyncing all services")
+			for _, key := range c.serviceLister.ListKeys() {
+				c.serviceQueue.Add(key)
+			}
+		}
+	}
+}
+
 func (c *Controller) IsHealthy() error {
 	// log the last node sync
 	c.logger.V(5).Info("Last node sync time", "time", c.nodeSyncTracker.Get())
@@ -475,6 +523,20 @@ func (c *Controller) IsHealthy() error {
 	return nil
 }
 
+// HealthStatus returns the detailed health of the controller. It is degraded
+// once it has not processed any update for half the time after which
+// IsHealthy fails.
+func (c *Controller) HealthStatus() systemhealth.Status {
+	status := systemhealth.StatusFromError(c.IsHealthy()).
+		WithCacheSynced(c.hasSynced()).
+		WithLastSync(c.syncTracker.Get()).
+		WithQueueDepth(c.serviceQueue.Len() + c.endpointQueue.Len())
+	if c.syncTracker.Get().Before(time.Now().Add(-negDegradedSyncAge)) {
+		status = status.Degraded(fmt.Sprintf("no service and endpoint updates processed since %v", c.syncTracker.Get()))
+	}
+	return status
+}
+
 func (c *Controller) stop() {
 	c.serviceQueue.ShutDown()
 	c.endpointQueue.ShutDown()
@@ -557,6 +619,7 @@ func (c *Controller) serviceWorker() {
 			}
 			defer c.serviceQueue.Done(key)
 			err := c.processService(key.(string))
+			c.recordSyncStatus(key.(string), err)
 			c.handleErr(err, key)
 			c.negMetrics.PublishNegControllerErrorCountMetrics(err, false)
 		}()
@@ -583,6 +646,15 @@ func (c *Controller) processService(key string) error {
 		return nil
 	}
 
+	// The NEGs of the service are left as they are for the replica owning
+	// it.
+	if !c.shardOwner.Owns(namespace, name) {
+		c.syncerMetrics.DeleteNegService(key)
+		c.manager.StopSyncer(namespace, name)
+		c.logger.V(3).Info("Skipping syncing service since it is owned by another shard", "service", key)
+		return nil
+	}
+
 	obj, exists, err := c.serviceLister.GetByKey(key)
 	if err != nil {
 		return err
@@ -759,7 +831,7 @@ func (c *Controller) mergeVmIpNEGsPortInfo(service *apiv1.Service, name types.Na
 	if needsNEGForILB && !utils.HasL4ILBFinalizerV2(service) {
 		msg := fmt.Sprintf("Ignoring ILB Service %s, namespace %s as it does not have the v2 finalizer", service.Name, service.Namespace)
 		c.logger.Info(msg)
-		c.recorder.Event(service, apiv1.EventTypeWarning, "ProcessServiceSkipped", msg)
+		c.recorder.Event(service, apiv1.EventTypeWarning, events.ReasonProcessServiceSkipped, msg)
 		return nil
 	}
 
@@ -787,7 +859,26 @@ func (c *Controller) mergeVmIpNEGsPortInfo(service *apiv1.Service, name types.Na
 		l4LBType = negtypes.L4ExternalLB
 	}
 
-	return portInfoMap.Merge(negtypes.NewPortInfoMapForVMIPNEG(name.Namespace, name.Name, c.l4Namer, onlyLocal, networkInfo, l4LBType))
+	vmIPPortInfoMap := negtypes.NewPortInfoMapForVMIPNEG(name.Namespace, name.Name, c.l4Namer, onlyLocal, networkInfo, l4LBType)
+	if wantsWeightedEndpoints(service, l4LBType) {
+		for key, portInfo := range vmIPPortInfoMap {
+			portInfo.WeightedEndpoints = true
+			vmIPPortInfoMap[key] = portInfo
+		}
+	}
+	return portInfoMap.Merge(vmIPPortInfoMap)
+}
+
+// wantsWeightedEndpoints determines if the GCE_VM_IP NEG endpoints of the service should be weighted
+// by EndpointSlice hints and node capacity. It mirrors the flags that enable Weighted load balancing in the L4 controllers.
+func wantsWeightedEndpoints(service *apiv1.Service, l4LBType negtypes.L4LBType) bool {
+	if !l4annotations.HasWeightedLBEndpointHintsAnnotation(service) {
+		return false
+	}
+	if l4LBType == negtypes.L4InternalLB {
+		return flags.F.EnableWeightedL4ILB
+	}
+	return flags.F.EnableWeightedL4NetLB
 }
 
 // netLBServiceNeedsNEG determines if NEGs need to be created for L4 NetLB.
@@ -915,7 +1006,7 @@ func (c *Controller) syncNegStatusAnnotation(namespace, name string, portMap neg
 		if preprovErr != nil {
 			msg := "Ignore zone pre-provisioning annotation"
 			c.logger.Error(preprovErr, msg, "service", klog.KRef(namespace, name))
-			c.recorder.Event(service, apiv1.EventTypeWarning, "IgnoreZonePreprovisioningAnnotation", fmt.Sprintf("%s err: %v", msg, preprovErr))
+			c.recorder.Event(service, apiv1.EventTypeWarning, events.ReasonIgnoreZonePreprovisioningAnnotation, fmt.Sprintf("%s err: %v", msg, preprovErr))
 		}
 
 		// Merge zones with nodes and pre-provisioning zones
@@ -941,6 +1032,39 @@ func (c *Controller) syncNegStatusAnnotation(namespace, name string, portMap neg
 	return patch.PatchServiceObjectMetadata(c.client.CoreV1(), service, *newSvcObjectMeta)
 }
 
+// recordSyncStatus records the outcome of processing a service for the debug
+// endpoints. Deleted services are forgotten.
+func (c *Controller) recordSyncStatus(key string, err error) {
+	if _, exists, _ := c.serviceLister.GetByKey(key); !exists {
+		c.debugTracker.Forget(key)
+		return
+	}
+	c.debugTracker.Record(key, err)
+}
+
+// serviceDebugState is the state of a service served on /debug/neg.
+type serviceDebugState struct {
+	debug.SyncStatus
+	Requeues int                        `json:"requeues"`
+	Syncers  []negtypes.SyncerDebugInfo `json:"syncers"`
+}
+
+// DebugInfo returns the outcome of the last processing of the service and
+// the state of its NEG syncers. It implements debug.Provider.
+func (c *Controller) DebugInfo(namespace, name string) (interface{}, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}.String()
+	status, ok := c.debugTracker.Get(key)
+	syncers := c.manager.DebugInfo(namespace, name)
+	if !ok && len(syncers) == 0 {
+		return nil, debug.ErrNotFound
+	}
+	return serviceDebugState{
+		SyncStatus: status,
+		Requeues:   c.serviceQueue.NumRequeues(key),
+		Syncers:    syncers,
+	}, nil
+}
+
 func (c *Controller) handleErr(err error, key interface{}) {
 	if err == nil {
 		c.serviceQueue.Forget(key)
@@ -953,7 +1077,7 @@ func (c *Controller) handleErr(err error, key interface{}) {
 		c.logger.Error(err, "Failed to retrieve service from store", "service", key.(string))
 		c.negMetrics.PublishNegControllerErrorCountMetrics(err, true)
 	} else if exists {
-		c.recorder.Event(service.(*apiv1.Service), apiv1.EventTypeWarning, "ProcessServiceFailed", msg)
+		c.recorder.Event(service.(*apiv1.Service), apiv1.EventTypeWarning, events.ReasonProcessServiceFailed, msg)
 	}
 	c.serviceQueue.AddRateLimited(key)
 }
@@ -19,6 +19,7 @@ package neg
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -42,6 +43,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	"k8s.io/ingress-gce/pkg/debug"
 	"k8s.io/ingress-gce/pkg/flags"
 	l4annotations "k8s.io/ingress-gce/pkg/l4/annotations"
 	"k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
@@ -50,6 +52,7 @@ import (
 	"k8s.io/ingress-gce/pkg/negannotation"
 	"k8s.io/ingress-gce/pkg/network"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/systemhealth"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
 	"k8s.io/ingress-gce/pkg/utils/zonegetter"
@@ -165,6 +168,7 @@ func newTestControllerWithParamsAndContext(kubeClient kubernetes.Interface, test
 		readOnlyMode,
 		true,  // enableNEGsForIngress
 		false, // includeDrainNodesL4Local
+		negtypes.NoSharding{},
 		make(<-chan struct{}),
 		klog.TODO(),
 		testContext.NegMetrics,
@@ -214,11 +218,23 @@ func TestIsHealthy(t *testing.T) {
 				t.Errorf("Expect controller to NOT be healthy")
 			}
 
+			if state := controller.HealthStatus().State; state != systemhealth.StateUnhealthy {
+				t.Errorf("HealthStatus().State = %q, want %q", state, systemhealth.StateUnhealthy)
+			}
+
+			controller.syncTracker.Set(time.Now().Add(-31 * time.Minute))
+			if state := controller.HealthStatus().State; state != systemhealth.StateDegraded {
+				t.Errorf("HealthStatus().State = %q, want %q", state, systemhealth.StateDegraded)
+			}
+
 			controller.syncTracker.Track()
 			err = controller.IsHealthy()
 			if err != nil {
 				t.Errorf("Expect controller to be healthy: %v", err)
 			}
+			if state := controller.HealthStatus().State; state != systemhealth.StateHealthy {
+				t.Errorf("HealthStatus().State = %q, want %q", state, systemhealth.StateHealthy)
+			}
 		})
 	}
 }
@@ -241,6 +257,39 @@ func TestNewNonNEGService(t *testing.T) {
 	validateSyncers(t, controller, 0, true)
 }
 
+func TestDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	controller, err := newTestController(fake.NewSimpleClientset())
+	if err != nil {
+		t.Fatalf("failed to create test controller %s", err)
+	}
+	defer controller.stop()
+	if _, err := controller.DebugInfo(testServiceNamespace, testServiceName); !errors.Is(err, debug.ErrNotFound) {
+		t.Fatalf("DebugInfo() for an unknown service returned %v, want %v", err, debug.ErrNotFound)
+	}
+
+	controller.serviceLister.Add(newTestService(controller, false, []int32{80}))
+	key := utils.ServiceKeyFunc(testServiceNamespace, testServiceName)
+	err = controller.processService(key)
+	controller.recordSyncStatus(key, err)
+	if err != nil {
+		t.Fatalf("Failed to process service: %v", err)
+	}
+
+	got, err := controller.DebugInfo(testServiceNamespace, testServiceName)
+	if err != nil {
+		t.Fatalf("DebugInfo() = %v, want nil", err)
+	}
+	state := got.(serviceDebugState)
+	if state.LastError != "" || state.LastSyncTime.IsZero() {
+		t.Errorf("DebugInfo() = %+v, want a successful sync to be recorded", state)
+	}
+	if len(state.Syncers) != 1 {
+		t.Errorf("DebugInfo() returned %d syncers, want 1", len(state.Syncers))
+	}
+}
+
 func TestNewNEGService(t *testing.T) {
 	t.Parallel()
 
@@ -407,6 +456,37 @@ func TestNewNEGService(t *testing.T) {
 	}
 }
 
+// otherShard owns no service.
+type otherShard struct {
+	negtypes.NoSharding
+}
+
+func (otherShard) Owns(string, string) bool      { return false }
+func (otherShard) OwnsForGC(string, string) bool { return false }
+
+func TestProcessServiceOfOtherShard(t *testing.T) {
+	t.Parallel()
+
+	controller, err := newTestController(fake.NewSimpleClientset())
+	if err != nil {
+		t.Fatalf("failed to create test controller %s", err)
+	}
+	defer controller.stop()
+	controller.serviceLister.Add(newTestService(controller, false, []int32{80}))
+	svcKey := utils.ServiceKeyFunc(testServiceNamespace, testServiceName)
+	if err := controller.processService(svcKey); err != nil {
+		t.Fatalf("Failed to process service: %v", err)
+	}
+	validateSyncers(t, controller, 1, false)
+
+	// The syncers are stopped once the shard of the service is released.
+	controller.shardOwner = otherShard{}
+	if err := controller.processService(svcKey); err != nil {
+		t.Fatalf("Failed to process service: %v", err)
+	}
+	validateSyncers(t, controller, 1, true)
+}
+
 func TestEnableNEGServiceWithIngress(t *testing.T) {
 	t.Parallel()
 
@@ -2563,6 +2643,7 @@ func TestNodeInformerFilterWithIncludeDrainNodesL4Local(t *testing.T) {
 		false, // readOnlyMode
 		true,  // enableNEGsForIngress
 		true,  // includeDrainNodesL4Local = true
+		negtypes.NoSharding{},
 		make(<-chan struct{}),
 		klog.TODO(),
 		testContext.NegMetrics,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	negv1beta1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	"k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
@@ -46,6 +48,7 @@ import (
 	"k8s.io/ingress-gce/pkg/neg/syncers/negstatushandler"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/negannotation"
+	"k8s.io/ingress-gce/pkg/ratelimit"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
@@ -96,6 +99,8 @@ type syncerManager struct {
 
 	// reflector handles NEG readiness gate and conditions for pods in NEG.
 	reflector readiness.Reflector
+	// weightPublisher publishes the node weights of weighted GCE_VM_IP NEGs.
+	weightPublisher negtypes.EndpointWeightPublisher
 	//svcNegClient handles lifecycle operations for NEG CRs
 	svcNegClient svcnegclient.Interface
 
@@ -127,6 +132,10 @@ type syncerManager struct {
 	// includeDrainNodesL4Local indicates whether to include draining nodes for L4 local mode NEGs
 	includeDrainNodesL4Local bool
 
+	// shardOwner tells which services the NEGs are garbage collected and pod
+	// readiness is reflected of.
+	shardOwner negtypes.ShardOwner
+
 	negMetrics *metrics.NegMetrics
 }
 
@@ -149,7 +158,8 @@ func newSyncerManager(namer negtypes.NetworkEndpointGroupNamer,
 	lpConfig podlabels.PodLabelPropagationConfig,
 	logger klog.Logger,
 	negMetrics *metrics.NegMetrics,
-	includeDrainNodesL4Local bool) *syncerManager {
+	includeDrainNodesL4Local bool,
+	shardOwner negtypes.ShardOwner) *syncerManager {
 
 	var vmIpPortZoneMap map[string]struct{}
 	updateZoneMap(&vmIpPortZoneMap, negtypes.NodeFilterForNetworkEndpointType(negtypes.VmIpPortEndpointType), zoneGetter, logger, negMetrics)
@@ -178,7 +188,9 @@ func newSyncerManager(namer negtypes.NetworkEndpointGroupNamer,
 		vmIpPortZoneMap:            vmIpPortZoneMap,
 		lpConfig:                   lpConfig,
 		includeDrainNodesL4Local:   includeDrainNodesL4Local,
+		shardOwner:                 shardOwner,
 		negMetrics:                 negMetrics,
+		weightPublisher:            negsyncer.NewNoopWeightPublisher(),
 	}
 }
 
@@ -306,6 +318,7 @@ func (manager *syncerManager) EnsureSyncers(namespace, name string, newPorts neg
 					portInfo.NetworkInfo,
 					nonDefaultSubnetNEGNamer,
 					manager.negMetrics,
+					manager.weightPublisher,
 				)
 				manager.syncerMap[syncerKey] = syncer
 			}
@@ -438,6 +451,25 @@ func (manager *syncerManager) SyncNodes() {
 	}
 }
 
+// DebugInfo returns the debug snapshots of all syncers related to the
+// service, ordered by syncer key.
+func (manager *syncerManager) DebugInfo(namespace, name string) []negtypes.SyncerDebugInfo {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	var infos []negtypes.SyncerDebugInfo
+	for key, syncer := range manager.syncerMap {
+		if key.Namespace != namespace || key.Name != name {
+			continue
+		}
+		if d, ok := syncer.(negtypes.NegSyncerDebugger); ok {
+			infos = append(infos, d.DebugInfo())
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].SyncerKey < infos[j].SyncerKey })
+	return infos
+}
+
 // SyncAllSyncers signals all syncers to sync.
 func (manager *syncerManager) SyncAllSyncers() {
 	manager.mu.Lock()
@@ -489,12 +521,14 @@ func (manager *syncerManager) GC() error {
 	manager.logger.V(2).Info("Start NEG garbage collection.")
 	defer manager.logger.V(2).Info("NEG garbage collection finished.")
 	start := time.Now()
+	// Garbage collection waits behind the syncs for the GCE API budget.
+	ctx := ratelimit.WithPriority(context.Background(), ratelimit.PriorityLow)
 	// Garbage collect Syncers
 	manager.garbageCollectSyncer()
 
 	// Garbage collect NEGs
 	var err error
-	err = manager.garbageCollectNEGWithCRD()
+	err = manager.garbageCollectNEGWithCRD(ctx)
 	if err != nil {
 		err = fmt.Errorf("failed to garbage collect negs: %w", err)
 	}
@@ -538,6 +572,45 @@ func (manager *syncerManager) ReadinessGateEnabledNegs(namespace string, podLabe
 	return ret.List()
 }
 
+// ReadinessOwned returns true if this replica syncs a NEG with readiness gate
+// of a service selecting pods with the namespace and labels, or owns all the
+// services selecting them. Otherwise a replica could mark a pod ready for not
+// belonging to any NEG while another replica syncs its NEGs.
+func (manager *syncerManager) ReadinessOwned(namespace string, podLabels map[string]string) bool {
+	if len(manager.ReadinessGateEnabledNegs(namespace, podLabels)) > 0 {
+		return true
+	}
+	owned := true
+	err := cache.ListAllByNamespace(manager.serviceLister, namespace, labels.Everything(), func(obj interface{}) {
+		service := obj.(*v1.Service)
+		if service.Spec.Selector == nil {
+			return
+		}
+		selector := labels.Set(service.Spec.Selector).AsSelectorPreValidated()
+		if selector.Matches(labels.Set(podLabels)) && !manager.shardOwner.Owns(service.Namespace, service.Name) {
+			owned = false
+		}
+	})
+	if err != nil {
+		manager.logger.Error(err, "Failed to list services", "namespace", namespace)
+		return false
+	}
+	return owned
+}
+
+// ServicesWithRunningSyncers implements negtypes.SyncerTracker.
+func (manager *syncerManager) ServicesWithRunningSyncers() []types.NamespacedName {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	services := sets.New[types.NamespacedName]()
+	for key, syncer := range manager.syncerMap {
+		if !syncer.IsStopped() || syncer.IsShuttingDown() {
+			services.Insert(types.NamespacedName{Namespace: key.Namespace, Name: key.Name})
+		}
+	}
+	return services.UnsortedList()
+}
+
 // ReadinessGateEnabled returns true if the NEG requires readiness feedback
 func (manager *syncerManager) ReadinessGateEnabled(syncerKey negtypes.NegSyncerKey) bool {
 	manager.mu.Lock()
@@ -575,14 +648,32 @@ func (manager *syncerManager) ensureDeleteSvcNegCR(namespace, negName string) er
 	return nil
 }
 
-// garbageCollectSyncer removes stopped syncer from syncerMap
+// garbageCollectSyncer removes stopped syncer from syncerMap and clears
+// the node weights published by removed weighted syncers.
 func (manager *syncerManager) garbageCollectSyncer() {
 	manager.mu.Lock()
-	defer manager.mu.Unlock()
+	removedWeighted := sets.New[string]()
 	for key, syncer := range manager.syncerMap {
 		if syncer.IsStopped() && !syncer.IsShuttingDown() {
 			delete(manager.syncerMap, key)
 			manager.syncerMetrics.DeleteSyncer(key)
+			if key.WeightedEndpoints {
+				removedWeighted.Insert(key.NegName)
+			}
+		}
+	}
+	// The weights of a NEG are still published by its new syncer if the
+	// syncer was replaced, e.g. after a change of the traffic policy.
+	for key := range manager.syncerMap {
+		if key.WeightedEndpoints {
+			removedWeighted.Delete(key.NegName)
+		}
+	}
+	manager.mu.Unlock()
+
+	for negName := range removedWeighted {
+		if err := manager.weightPublisher.PublishWeights(negName, nil); err != nil {
+			manager.logger.Error(err, "Failed to clear endpoint weights", "negName", negName)
 		}
 	}
 }
@@ -597,12 +688,17 @@ type deletionCandidate struct {
 // need to be garbage collected. Neg CRs that do not have a configuration in the svcPortMap will deleted
 // along with all corresponding NEGs in the CR's list of NetworkEndpointGroups. If NEG deletion fails in
 // the cloud, the corresponding Neg CR will not be deleted
-func (manager *syncerManager) garbageCollectNEGWithCRD() error {
+func (manager *syncerManager) garbageCollectNEGWithCRD(ctx context.Context) error {
 
 	deletionCandidates := map[string]deletionCandidate{}
 	negCRs := manager.svcNegLister.List()
 	for _, obj := range negCRs {
 		neg := obj.(*negv1beta1.ServiceNetworkEndpointGroup)
+		// The NEGs of the services of other shards are not in svcPortMap,
+		// they are collected by the replica owning them.
+		if !manager.shardOwner.OwnsForGC(neg.Namespace, neg.GetLabels()[negtypes.NegCRServiceNameKey]) {
+			continue
+		}
 		deletionCandidates[neg.Name] = deletionCandidate{neg: neg, tbdOnly: false}
 	}
 
@@ -657,7 +753,7 @@ func (manager *syncerManager) garbageCollectNEGWithCRD() error {
 	for i := 0; i < manager.numGCWorkers; i++ {
 		go func() {
 			for svcNegCR := range deletionCandidatesChan {
-				errs := manager.processNEGDeletionCandidate(svcNegCR, zones)
+				errs := manager.processNEGDeletionCandidate(ctx, svcNegCR, zones)
 
 				errListMutex.Lock()
 				errList = append(errList, errs...)
@@ -688,7 +784,7 @@ func containsTBDNeg(negCR *negv1beta1.ServiceNetworkEndpointGroup) bool {
 // tbdObly flag set to true, then only the NEGs in the candidate that have the
 // state TO_BE_DELETED will be deleted. At the end, the SvcNeg resource is updated
 // with the current state of NEGs that the neg controller is aware about.
-func (manager *syncerManager) processNEGDeletionCandidate(candidate deletionCandidate, zones []string) []error {
+func (manager *syncerManager) processNEGDeletionCandidate(ctx context.Context, candidate deletionCandidate, zones []string) []error {
 	svcNegCR := candidate.neg
 	manager.logger.Info("Count of NEGs referenced by SvcNegCR", "svcneg", klog.KObj(svcNegCR), "count", len(svcNegCR.Status.NetworkEndpointGroups), "tbdOnly", candidate.tbdOnly)
 	var errList []error
@@ -712,13 +808,13 @@ func (manager *syncerManager) processNEGDeletionCandidate(candidate deletionCand
 				// svcneg resource. We do not have a good way of detecting what is wrong and believe user
 				// intervention is safer than accidentally deleting a NEG that is being used.
 				eventMsg := fmt.Sprintf("Detected TO_BE_DELETED NEGs, but unable to parse selflink %s. Please manually delete the NEG and remove the corresponding reference from the svcneg resource", negRef.SelfLink)
-				manager.recorder.Event(svcNegCR, v1.EventTypeWarning, negtypes.NegGCError, eventMsg)
+				manager.recorder.Event(svcNegCR, v1.EventTypeWarning, events.ReasonNegCRError, eventMsg)
 				continue
 			}
 			deleteByZone = true
 			continue
 		}
-		negDeleted := manager.deleteNegOrReportErr(resourceID.Key.Name, resourceID.Key.Zone, svcNegCR, &errList)
+		negDeleted := manager.deleteNegOrReportErr(ctx, resourceID.Key.Name, resourceID.Key.Zone, svcNegCR, &errList)
 		if negDeleted {
 			deletedNegs[negtypes.NegInfo{Name: resourceID.Key.Name, Zone: resourceID.Key.Zone}] = struct{}{}
 		}
@@ -728,7 +824,7 @@ func (manager *syncerManager) processNEGDeletionCandidate(candidate deletionCand
 	if deleteByZone {
 		manager.logger.V(2).Info("Deletion candidate has 0 NEG reference", "svcneg", klog.KObj(svcNegCR), "svcNegCR", svcNegCR)
 		for _, zone := range zones {
-			negDeleted := manager.deleteNegOrReportErr(svcNegCR.Name, zone, svcNegCR, &errList)
+			negDeleted := manager.deleteNegOrReportErr(ctx, svcNegCR.Name, zone, svcNegCR, &errList)
 			if negDeleted {
 				deletedNegs[negtypes.NegInfo{Name: svcNegCR.Name, Zone: zone}] = struct{}{}
 			}
@@ -785,16 +881,16 @@ func (manager *syncerManager) processNEGDeletionCandidate(candidate deletionCand
 // cloud. Successful deletion is indicated by returning `true` and a failure
 // would return `false`. In addition, if the deletion failed, the error will be
 // reported as an event on the given CR and added to the passed `errList`.
-func (manager *syncerManager) deleteNegOrReportErr(name, zone string, svcNegCR *negv1beta1.ServiceNetworkEndpointGroup, errList *[]error) bool {
+func (manager *syncerManager) deleteNegOrReportErr(ctx context.Context, name, zone string, svcNegCR *negv1beta1.ServiceNetworkEndpointGroup, errList *[]error) bool {
 	expectedDesc := &utils.NegDescription{
 		ClusterUID:  string(manager.kubeSystemUID),
 		Namespace:   svcNegCR.Namespace,
 		ServiceName: svcNegCR.GetLabels()[negtypes.NegCRServiceNameKey],
 		Port:        svcNegCR.GetLabels()[negtypes.NegCRServicePortKey],
 	}
-	if err := manager.ensureDeleteNetworkEndpointGroup(name, zone, expectedDesc); err != nil {
+	if err := manager.ensureDeleteNetworkEndpointGroup(ctx, name, zone, expectedDesc); err != nil {
 		err = fmt.Errorf("failed to delete NEG %s in %s: %s", name, zone, err)
-		manager.recorder.Event(svcNegCR, v1.EventTypeWarning, negtypes.NegGCError, err.Error())
+		manager.recorder.Event(svcNegCR, v1.EventTypeWarning, events.ReasonNegCRError, err.Error())
 		*errList = append(*errList, err)
 
 		return false
@@ -823,8 +919,8 @@ func ensureExistingNegRef(neg *negv1beta1.ServiceNetworkEndpointGroup, deletedNe
 }
 
 // ensureDeleteNetworkEndpointGroup ensures neg is delete from zone
-func (manager *syncerManager) ensureDeleteNetworkEndpointGroup(name, zone string, expectedDesc *utils.NegDescription) error {
-	neg, err := manager.cloud.GetNetworkEndpointGroup(name, zone, meta.VersionGA, manager.logger)
+func (manager *syncerManager) ensureDeleteNetworkEndpointGroup(ctx context.Context, name, zone string, expectedDesc *utils.NegDescription) error {
+	neg, err := manager.cloud.GetNetworkEndpointGroup(ctx, name, zone, meta.VersionGA, manager.logger)
 	if err != nil {
 		if utils.IsNotFoundError(err) || utils.IsHTTPErrorCode(err, http.StatusBadRequest) {
 			manager.logger.V(2).Info("Ignoring error when querying for neg during GC", "negName", name, "zone", zone, "err", err)
@@ -848,7 +944,7 @@ func (manager *syncerManager) ensureDeleteNetworkEndpointGroup(name, zone string
 	}
 
 	manager.logger.V(2).Info("Deleting NEG", "negName", name, "zone", zone)
-	return manager.cloud.DeleteNetworkEndpointGroup(name, zone, meta.VersionGA, manager.logger)
+	return manager.cloud.DeleteNetworkEndpointGroup(ctx, name, zone, meta.VersionGA, manager.logger)
 }
 
 // ensureSvcNegCR ensures that if neg crd is enabled, a Neg CR exists for every
@@ -1021,6 +1117,7 @@ func (manager *syncerManager) getSyncerKey(namespace, name string, servicePortKe
 		EpCalculatorMode:         calculatorMode,
 		L4LBType:                 portInfo.L4LBType,
 		IncludeDrainNodesL4Local: manager.includeDrainNodesL4Local,
+		WeightedEndpoints:        portInfo.WeightedEndpoints,
 	}
 }
 
@@ -120,6 +120,7 @@ func NewTestSyncerManager(kubeClient kubernetes.Interface) (*syncerManager, *gce
 		klog.TODO(),
 		metrics.NewNegMetrics(),
 		false,
+		negtypes.NoSharding{},
 	)
 	return manager, testContext.Cloud, testContext, nil
 }
@@ -399,6 +400,38 @@ func TestGarbageCollectionSyncer(t *testing.T) {
 	}
 }
 
+func TestGarbageCollectionSyncerClearsEndpointWeights(t *testing.T) {
+	t.Parallel()
+
+	manager, _, _, err := NewTestSyncerManager(fake.NewSimpleClientset())
+	if err != nil {
+		t.Fatalf("failed to create test syncer manager: %v", err)
+	}
+	publisher := &fakeWeightPublisher{}
+	manager.weightPublisher = publisher
+
+	weightedKey := func(negName string, mode negtypes.EndpointsCalculatorMode) negtypes.NegSyncerKey {
+		return negtypes.NegSyncerKey{Namespace: namespace1, Name: name1, NegName: negName, NegType: negtypes.VmIpEndpointType, EpCalculatorMode: mode, WeightedEndpoints: true}
+	}
+	// neg1 is replaced by a syncer of another mode, neg2 is removed and
+	// neg3 is not weighted.
+	manager.syncerMap = map[negtypes.NegSyncerKey]negtypes.NegSyncer{
+		weightedKey("neg1", negtypes.L4LocalMode):                                                 &fakeSyncer{isStopped: true},
+		weightedKey("neg1", negtypes.L4ClusterMode):                                               &fakeSyncer{isStopped: false},
+		weightedKey("neg2", negtypes.L4LocalMode):                                                 &fakeSyncer{isStopped: true},
+		{Namespace: namespace1, Name: name1, NegName: "neg3", NegType: negtypes.VmIpEndpointType}: &fakeSyncer{isStopped: true},
+	}
+
+	manager.garbageCollectSyncer()
+
+	if diff := cmp.Diff([]string{"neg2"}, publisher.cleared); diff != "" {
+		t.Errorf("cleared endpoint weights mismatch (-want +got):\n%s", diff)
+	}
+	if len(manager.syncerMap) != 1 {
+		t.Errorf("Expect 1 syncer left, but got %v", len(manager.syncerMap))
+	}
+}
+
 func TestEnsureSyncersWithMissingSyncer(t *testing.T) {
 	manager, _, _, err := NewTestSyncerManager(fake.NewSimpleClientset())
 	if err != nil {
@@ -459,12 +492,12 @@ func TestGarbageCollectionNEG(t *testing.T) {
 			version = meta.VersionAlpha
 		}
 		negName := manager.namer.NEG("test", "test", 80)
-		manager.cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+		manager.cloud.CreateNetworkEndpointGroup(context2.Background(), &composite.NetworkEndpointGroup{
 			Version:             version,
 			Name:                negName,
 			NetworkEndpointType: string(networkEndpointType),
 		}, negtypes.TestZone1, klog.TODO())
-		manager.cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+		manager.cloud.CreateNetworkEndpointGroup(context2.Background(), &composite.NetworkEndpointGroup{
 			Version:             version,
 			Name:                negName,
 			NetworkEndpointType: string(networkEndpointType),
@@ -482,7 +515,7 @@ func TestGarbageCollectionNEG(t *testing.T) {
 			t.Fatalf("Failed to GC: %v", err)
 		}
 		for _, zone := range []string{negtypes.TestZone1, negtypes.TestZone2} {
-			negs, _ := manager.cloud.ListNetworkEndpointGroup(zone, version, klog.TODO())
+			negs, _ := manager.cloud.ListNetworkEndpointGroup(context2.Background(), zone, version, klog.TODO())
 			for _, neg := range negs {
 				if neg.Name == negName {
 					t.Errorf("Expect NEG %q in zone %q to be GCed.", negName, zone)
@@ -582,6 +615,85 @@ func TestReadinessGateEnabledNegs(t *testing.T) {
 	}
 }
 
+// ownedServices owns the services with the given keys.
+type ownedServices struct {
+	negtypes.NoSharding
+	keys sets.String
+}
+
+func (o ownedServices) Owns(namespace, name string) bool {
+	return o.keys.Has(namespace + "/" + name)
+}
+
+func TestReadinessOwned(t *testing.T) {
+	t.Parallel()
+
+	kubeClient := fake.NewSimpleClientset()
+	manager, _, _, err := NewTestSyncerManager(kubeClient)
+	if err != nil {
+		t.Fatalf("failed to create test syncer manager: %v", err)
+	}
+	populateSyncerManager(manager, kubeClient)
+	// This replica owns none of the services of namespace2, yet syncs the
+	// NEGs populated before.
+	manager.shardOwner = ownedServices{keys: sets.NewString(namespace1 + "/" + name1)}
+
+	for _, tc := range []struct {
+		desc      string
+		namespace string
+		labels    map[string]string
+		expect    bool
+	}{
+		{
+			desc:      "no service selects the pod",
+			namespace: namespace2,
+			labels:    map[string]string{labelKey1: "not-matching"},
+			expect:    true,
+		},
+		{
+			desc:      "selected by a service with readiness gate NEGs synced by this replica",
+			namespace: namespace1,
+			labels:    map[string]string{labelKey1: labelValue1},
+			expect:    true,
+		},
+		{
+			desc:      "selected by a service of another replica without readiness gate NEGs",
+			namespace: namespace2,
+			labels:    map[string]string{labelKey1: labelValue1},
+			expect:    false,
+		},
+	} {
+		if got := manager.ReadinessOwned(tc.namespace, tc.labels); got != tc.expect {
+			t.Errorf("For case %q, ReadinessOwned() = %v, want %v", tc.desc, got, tc.expect)
+		}
+	}
+}
+
+func TestServicesWithRunningSyncers(t *testing.T) {
+	t.Parallel()
+
+	manager, _, testContext, err := NewTestSyncerManager(fake.NewSimpleClientset())
+	if err != nil {
+		t.Fatalf("failed to create test syncer manager: %v", err)
+	}
+	testContext.ServiceInformer.GetIndexer().Add(&v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace1, Name: name1}})
+	portInfoMap := negtypes.NewPortInfoMap(namespace1, name1, types.NewSvcPortTupleSet(negtypes.SvcPortTuple{Port: port1, TargetPort: targetPort1}), manager.namer, false, nil, defaultNetwork)
+	if _, _, err := manager.EnsureSyncers(namespace1, name1, portInfoMap); err != nil {
+		t.Fatalf("Failed to ensure syncer %s/%s: %v", namespace1, name1, err)
+	}
+	want := []apitypes.NamespacedName{{Namespace: namespace1, Name: name1}}
+	if diff := cmp.Diff(want, manager.ServicesWithRunningSyncers()); diff != "" {
+		t.Errorf("ServicesWithRunningSyncers() mismatch (-want +got):\n%s", diff)
+	}
+
+	manager.StopSyncer(namespace1, name1)
+	if err := wait.PollUntilContextTimeout(context2.Background(), 10*time.Millisecond, 5*time.Second, true, func(context2.Context) (bool, error) {
+		return len(manager.ServicesWithRunningSyncers()) == 0, nil
+	}); err != nil {
+		t.Errorf("ServicesWithRunningSyncers() = %v after the syncers were stopped, want none", manager.ServicesWithRunningSyncers())
+	}
+}
+
 func TestReadinessGateEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -1442,7 +1554,7 @@ func TestGarbageCollectionNegCrdEnabled(t *testing.T) {
 					}
 
 					for _, zone := range zones {
-						fakeNegCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+						fakeNegCloud.CreateNetworkEndpointGroup(context2.Background(), &composite.NetworkEndpointGroup{
 							Version:             version,
 							Name:                negName,
 							NetworkEndpointType: string(networkEndpointType),
@@ -1483,7 +1595,7 @@ func TestGarbageCollectionNegCrdEnabled(t *testing.T) {
 
 					if !tc.negsExist {
 						for _, zone := range []string{negtypes.TestZone1, negtypes.TestZone2} {
-							fakeNegCloud.DeleteNetworkEndpointGroup(negName, zone, version, klog.TODO())
+							fakeNegCloud.DeleteNetworkEndpointGroup(context2.Background(), negName, zone, version, klog.TODO())
 						}
 					}
 
@@ -1504,7 +1616,7 @@ func TestGarbageCollectionNegCrdEnabled(t *testing.T) {
 						t.Errorf("expected GC to error")
 					}
 
-					negs, err := fakeNegCloud.AggregatedListNetworkEndpointGroup(version, klog.TODO())
+					negs, err := fakeNegCloud.AggregatedListNetworkEndpointGroup(context2.Background(), version, klog.TODO())
 					if err != nil {
 						t.Errorf("failed getting negs from cloud: %s", err)
 					}
@@ -1630,14 +1742,14 @@ func TestGarbageCollectTBDNegs(t *testing.T) {
 
 			var negRefs []negv1beta1.NegObjectReference
 			for _, negRes := range tc.activeNegs {
-				err := fakeNegCloud.CreateNetworkEndpointGroup(negRes, negRes.Zone, klog.TODO())
+				err := fakeNegCloud.CreateNetworkEndpointGroup(context2.Background(), negRes, negRes.Zone, klog.TODO())
 				if err != nil {
 					t.Fatalf("failed to create neg: %s", err)
 				}
 				negRefs = append(negRefs, getNegObjectRefsWithState(t, fakeNegCloud, []string{negRes.Zone}, negRes.Name, meta.VersionGA, negv1beta1.ActiveState)...)
 			}
 			for _, negRes := range tc.tbdNegs {
-				err := fakeNegCloud.CreateNetworkEndpointGroup(negRes, negRes.Zone, klog.TODO())
+				err := fakeNegCloud.CreateNetworkEndpointGroup(context2.Background(), negRes, negRes.Zone, klog.TODO())
 				if err != nil {
 					t.Fatalf("failed to create neg: %s", err)
 				}
@@ -1677,7 +1789,7 @@ func TestGarbageCollectTBDNegs(t *testing.T) {
 				t.Errorf("expected GC to error")
 			}
 
-			negs, err := fakeNegCloud.AggregatedListNetworkEndpointGroup(meta.VersionGA, klog.TODO())
+			negs, err := fakeNegCloud.AggregatedListNetworkEndpointGroup(context2.Background(), meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Errorf("failed getting negs from cloud: %s", err)
 			}
@@ -1993,6 +2105,18 @@ func (s *fakeSyncer) Sync() bool           { return s.syncFunc() }
 func (s *fakeSyncer) IsStopped() bool      { return s.isStopped }
 func (s *fakeSyncer) IsShuttingDown() bool { return false }
 
+// fakeWeightPublisher records the NEGs whose endpoint weights are cleared.
+type fakeWeightPublisher struct {
+	cleared []string
+}
+
+func (p *fakeWeightPublisher) PublishWeights(negName string, weights map[string]int64) error {
+	if weights == nil {
+		p.cleared = append(p.cleared, negName)
+	}
+	return nil
+}
+
 // getNegObjectRefs generates the NegObjectReference list of all negs with the specified negName in the specified zones
 func getNegObjectRefs(t *testing.T, cloud negtypes.NetworkEndpointGroupCloud, zones []string, negName string, version meta.Version) []negv1beta1.NegObjectReference {
 	return getNegObjectRefsWithState(t, cloud, zones, negName, version, negv1beta1.ActiveState)
@@ -2002,7 +2126,7 @@ func getNegObjectRefsWithState(t *testing.T, cloud negtypes.NetworkEndpointGroup
 	t.Helper()
 	var negRefs []negv1beta1.NegObjectReference
 	for _, zone := range zones {
-		neg, err := cloud.GetNetworkEndpointGroup(negName, zone, version, klog.TODO())
+		neg, err := cloud.GetNetworkEndpointGroup(context2.Background(), negName, zone, version, klog.TODO())
 		if err != nil {
 			t.Errorf("failed to get neg %s, from zone %s", negName, zone)
 			continue
@@ -2334,6 +2458,7 @@ func TestGetSyncerKeyIncludeDrainNodesL4Local(t *testing.T) {
 			klog.TODO(),
 			metrics.NewNegMetrics(),
 			wantDrain,
+			negtypes.NoSharding{},
 		)
 
 		key := manager.getSyncerKey("ns", "svc", portKey, portInfo)
@@ -135,6 +135,15 @@ var (
 		},
 	)
 
+	// OwnedShards is the number of NEG controller shards owned by the replica.
+	OwnedShards = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: negControllerSubsystem,
+			Name:      "owned_shards",
+			Help:      "The number of NEG controller shards owned by this replica when the NEG controller is sharded.",
+		},
+	)
+
 	// SyncerStaleness tracks for every syncer, how long since the syncer last syncs
 	SyncerStaleness = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
@@ -265,6 +274,7 @@ func RegisterMetrics() {
 		prometheus.MustRegister(ManagerProcessLatency)
 		prometheus.MustRegister(SyncerSyncLatency)
 		prometheus.MustRegister(LastSyncTimestamp)
+		prometheus.MustRegister(OwnedShards)
 		prometheus.MustRegister(InitializationLatency)
 		prometheus.MustRegister(SyncerStaleness)
 		prometheus.MustRegister(EPSStaleness)
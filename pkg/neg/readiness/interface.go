@@ -42,6 +42,9 @@ type NegLookup interface {
 	ReadinessGateEnabledNegs(namespace string, labels map[string]string) []string
 	// ReadinessGateEnabled returns true if the NEG requires readiness feedback
 	ReadinessGateEnabled(syncerKey negtypes.NegSyncerKey) bool
+	// ReadinessOwned returns true if this replica reflects the readiness of
+	// pods with the namespace and labels.
+	ReadinessOwned(namespace string, labels map[string]string) bool
 }
 
 type NoopReflector struct{}
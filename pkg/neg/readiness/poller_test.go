@@ -440,7 +440,7 @@ func TestPoll(t *testing.T) {
 
 	step = "NEG exists, but with no endpoint"
 	// create NEG, but with no endpoint
-	negCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
+	negCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
 	pollAndValidate(step, false, true, 0, true, true)
 	pollAndValidate(step, false, true, 0, true, true)
 
@@ -451,7 +451,7 @@ func TestPoll(t *testing.T) {
 		Instance:  instance,
 	}
 
-	negCloud.AttachNetworkEndpoints(negName, zone, []*composite.NetworkEndpoint{ne}, meta.VersionGA, klog.TODO())
+	negCloud.AttachNetworkEndpoints(context.Background(), negName, zone, []*composite.NetworkEndpoint{ne}, meta.VersionGA, klog.TODO())
 	// add NE with empty healthy status
 	negtypes.GetNetworkEndpointStore(negCloud).AddNetworkEndpointHealthStatus(*meta.ZonalKey(negName, zone), []negtypes.NetworkEndpointEntry{
 		{
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/neg/types/shared"
@@ -42,13 +43,13 @@ import (
 const (
 	maxRetries = 15
 	// negReadyReason is the pod condition reason when pod becomes Healthy in NEG or pod no longer belongs to any NEG
-	negReadyReason = "LoadBalancerNegReady"
+	negReadyReason = events.ReasonLoadBalancerNegReady
 	// negReadyTimedOutReason is the pod condition reason when timeout is reached but pod is still not healthy in NEG
-	negReadyTimedOutReason = "LoadBalancerNegTimeout"
+	negReadyTimedOutReason = events.ReasonLoadBalancerNegTimeout
 	// negReadyUnhealthCheckedReason is the pod condition reason when pod is in a NEG without associated health checking
-	negReadyUnhealthCheckedReason = "LoadBalancerNegWithoutHealthCheck"
+	negReadyUnhealthCheckedReason = events.ReasonLoadBalancerNegWithoutHealthCheck
 	// negNotReadyReason is the pod condition reason when pod is not healthy in NEG
-	negNotReadyReason = "LoadBalancerNegNotReady"
+	negNotReadyReason = events.ReasonLoadBalancerNegNotReady
 	// unreadyTimeout is the timeout for health status feedback for pod readiness. If load balancer health
 	// check is still not showing as Healthy for long than the time out since the pod is created. Skip waiting and mark
 	// the pod as load balancer ready.
@@ -91,7 +92,7 @@ func NewReadinessReflector(kubeClient, eventRecorderClient kubernetes.Interface,
 	broadcaster.StartRecordingToSink(&unversionedcore.EventSinkImpl{
 		Interface: eventRecorderClient.CoreV1().Events(""),
 	})
-	recorder := broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "neg-readiness-reflector"})
+	recorder := events.NewFilteringRecorder(broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "neg-readiness-reflector"}), events.FilterOptionsFromFlags())
 	logger = logger.WithName("ReadinessReflector")
 	reflector := &readinessReflector{
 		client:                        kubeClient,
@@ -263,6 +264,12 @@ func (r *readinessReflector) SyncPod(pod *v1.Pod) {
 		return
 	}
 
+	// Only the replicas syncing the NEGs of the pod know them.
+	if !r.lookup.ReadinessOwned(pod.Namespace, pod.Labels) {
+		r.logger.V(4).Info("Skip processing pod of services owned by other shards", "pod", key)
+		return
+	}
+
 	if !needToProcess(pod) {
 		r.logger.V(3).Info("Skip processing pod", "pod", key)
 	}
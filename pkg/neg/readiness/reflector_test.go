@@ -59,6 +59,10 @@ func (f *fakeLookUp) ReadinessGateEnabled(syncerKey negtypes.NegSyncerKey) bool
 	return f.readinessGateEnabled
 }
 
+func (f *fakeLookUp) ReadinessOwned(namespace string, labels map[string]string) bool {
+	return true
+}
+
 func newTestReadinessReflector(testContext *negtypes.TestContext, markNonDefaultSubnetPodsReady bool) (*readinessReflector, error) {
 	fakeZoneGetter, err := zonegetter.NewFakeZoneGetter(testContext.NodeInformer, testContext.NodeTopologyInformer, defaultTestSubnetURL, markNonDefaultSubnetPodsReady)
 	if err != nil {
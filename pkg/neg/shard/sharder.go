@@ -0,0 +1,521 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard splits the Services processed by the NEG controller across
+// the glbc replicas.
+//
+// The Services are assigned to a fixed number of shards by the hash of their
+// namespace and name. Each shard is owned by the replica holding its Lease.
+// Replicas also keep a member Lease renewed, from which each of them derives
+// how many shards it should hold, so that the shards are rebalanced when
+// replicas come and go. The Lease of a shard given up by a replica is released
+// once the NEG syncers of its Services stopped.
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/ingress-gce/pkg/neg/metrics"
+	negtypes "k8s.io/ingress-gce/pkg/neg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// memberLabel is set on the member Leases, its value is the lease name
+// prefix of the sharded controller.
+const memberLabel = "networking.gke.io/neg-shard-member"
+
+// Config configures a Sharder.
+type Config struct {
+	// Shards is the number of shards the Services are split into. It must not
+	// change while replicas are running.
+	Shards int
+	// Namespace is the namespace of the Leases.
+	Namespace string
+	// LeaseNamePrefix is the prefix of the names of the Leases.
+	LeaseNamePrefix string
+	// Identity uniquely identifies the replica.
+	Identity string
+	// LeaseDuration, RenewDeadline and RetryPeriod configure the leader
+	// election of each shard, see leaderelection.LeaderElectionConfig.
+	// RetryPeriod is also the period at which shards are rebalanced.
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+	// GCDelay is how long a shard must be owned before the NEGs of its
+	// Services are garbage collected, so that all its Services are processed
+	// first.
+	GCDelay time.Duration
+}
+
+// Sharder acquires and releases the Leases of the shards owned by this
+// replica. It implements negtypes.ShardOwner and negtypes.ShardReleaser.
+type Sharder struct {
+	client kubernetes.Interface
+	config Config
+	clock  clock.Clock
+	logger klog.Logger
+
+	lock sync.Mutex
+	// shards holds the shards this replica owns or is trying to acquire.
+	shards map[int]*shardState
+	// changed is notified when shards are acquired or released.
+	changed chan struct{}
+	// candidates tracks the leader election goroutines of the shards.
+	candidates sync.WaitGroup
+	// tracker tells the Services whose syncers still run, nil until the NEG
+	// controller is created.
+	tracker negtypes.SyncerTracker
+}
+
+type shardState struct {
+	cancel    context.CancelFunc
+	held      bool
+	heldSince time.Time
+	// draining is set once the shard is given up, until its Lease is
+	// released.
+	draining      bool
+	drainingSince time.Time
+}
+
+var _ negtypes.ShardOwner = &Sharder{}
+var _ negtypes.ShardReleaser = &Sharder{}
+
+// NewSharder returns a Sharder for the replica identified by
+// config.Identity. Shards are acquired once Run is called.
+func NewSharder(client kubernetes.Interface, config Config, logger klog.Logger) (*Sharder, error) {
+	if config.Shards < 1 {
+		return nil, fmt.Errorf("invalid number of shards %d", config.Shards)
+	}
+	if config.Identity == "" {
+		return nil, fmt.Errorf("identity must not be empty")
+	}
+	return &Sharder{
+		client:  client,
+		config:  config,
+		clock:   clock.RealClock{},
+		logger:  logger.WithName("Sharder"),
+		shards:  make(map[int]*shardState),
+		changed: make(chan struct{}, 1),
+	}, nil
+}
+
+// Owns returns true if the Service belongs to a shard held by this replica.
+func (s *Sharder) Owns(namespace, name string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	state, ok := s.shards[shardOf(namespace, name, s.config.Shards)]
+	return ok && state.held
+}
+
+// OwnsForGC returns true if the shard of the Service has been held for at
+// least GCDelay.
+func (s *Sharder) OwnsForGC(namespace, name string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	state, ok := s.shards[shardOf(namespace, name, s.config.Shards)]
+	return ok && state.held && s.clock.Since(state.heldSince) >= s.config.GCDelay
+}
+
+// SetSyncerTracker sets the tracker of the NEG syncers which must stop before
+// the Lease of a shard is released.
+func (s *Sharder) SetSyncerTracker(tracker negtypes.SyncerTracker) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.tracker = tracker
+}
+
+// Changed is notified when shards are acquired or released.
+func (s *Sharder) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// Run keeps the member Lease of the replica renewed and rebalances the shards
+// until ctx is done, then releases all shards once their syncers stopped.
+func (s *Sharder) Run(ctx context.Context) {
+	s.logger.Info("Starting sharder", "shards", s.config.Shards, "identity", s.config.Identity)
+	defer func() {
+		s.lock.Lock()
+		for _, state := range s.shards {
+			if state.held {
+				s.drainLocked(state)
+			}
+		}
+		s.notifyLocked()
+		s.lock.Unlock()
+		// The shards still draining after LeaseDuration are released anyway.
+		_ = wait.PollUntilContextTimeout(context.Background(), s.config.RetryPeriod, s.config.LeaseDuration, true, func(context.Context) (bool, error) {
+			return s.releaseDrained() == 0, nil
+		})
+		s.lock.Lock()
+		for _, state := range s.shards {
+			state.cancel()
+		}
+		s.lock.Unlock()
+		s.candidates.Wait()
+
+		deleteCtx, cancel := context.WithTimeout(context.Background(), s.config.RenewDeadline)
+		defer cancel()
+		err := s.client.CoordinationV1().Leases(s.config.Namespace).Delete(deleteCtx, s.memberLeaseName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			s.logger.Error(err, "Failed to delete member lease")
+		}
+		s.logger.Info("Sharder stopped")
+	}()
+	wait.UntilWithContext(ctx, s.rebalance, s.config.RetryPeriod)
+}
+
+// rebalance renews the member Lease of the replica and acquires or releases
+// shards until the replica holds its share of them.
+func (s *Sharder) rebalance(ctx context.Context) {
+	if err := s.renewMemberLease(ctx); err != nil {
+		s.logger.Error(err, "Failed to renew member lease")
+		return
+	}
+	members, err := s.liveMembers(ctx)
+	if err != nil {
+		s.logger.Error(err, "Failed to list member leases")
+		return
+	}
+	target := targetShards(s.config.Shards, members, s.config.Identity)
+	s.releaseDrained()
+
+	if s.balanceHeld(target, len(members)) {
+		return
+	}
+
+	// Campaign only for the shards still missing, on shards whose Lease is
+	// free, so that the shards left are acquired by the other replicas.
+	free, err := s.freeShards(ctx)
+	if err != nil {
+		s.logger.Error(err, "Failed to list shard leases")
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	held, pending := s.candidateShardsLocked()
+	missing := target - len(held)
+	for _, shard := range pending {
+		if free[shard] {
+			missing--
+			continue
+		}
+		// The shard is held by another replica, which keeps it.
+		s.shards[shard].cancel()
+	}
+	for shard := 0; shard < s.config.Shards && missing > 0; shard++ {
+		if _, ok := s.shards[shard]; ok || !free[shard] {
+			continue
+		}
+		s.startCandidateLocked(ctx, shard)
+		missing--
+	}
+}
+
+// balanceHeld releases the shards held beyond target and stops the pending
+// candidacies once target is reached. It returns false if the replica holds
+// fewer than target shards.
+func (s *Sharder) balanceHeld(target, members int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	held, pending := s.candidateShardsLocked()
+	if len(held) < target {
+		return false
+	}
+	if len(held) > target {
+		// Release the highest shards, so that replicas converge on the same
+		// assignment.
+		sort.Sort(sort.Reverse(sort.IntSlice(held)))
+		for _, shard := range held[:len(held)-target] {
+			s.logger.Info("Releasing shard", "shard", shard, "members", members, "target", target)
+			s.drainLocked(s.shards[shard])
+		}
+		s.notifyLocked()
+	}
+	for _, shard := range pending {
+		s.shards[shard].cancel()
+	}
+	return true
+}
+
+// candidateShardsLocked returns the shards held by the replica and the shards
+// it is trying to acquire.
+func (s *Sharder) candidateShardsLocked() (held, pending []int) {
+	for shard, state := range s.shards {
+		switch {
+		case state.held:
+			held = append(held, shard)
+		case !state.draining:
+			pending = append(pending, shard)
+		}
+	}
+	return held, pending
+}
+
+// drainLocked stops owning the shard of state. Its Lease is released by
+// releaseDrained once the syncers of its Services stopped.
+func (s *Sharder) drainLocked(state *shardState) {
+	state.held = false
+	state.draining = true
+	state.drainingSince = s.clock.Now()
+}
+
+// releaseDrained releases the Leases of the drained shards whose Services
+// have no running syncer, or which have been draining for LeaseDuration. It
+// returns the number of shards still draining.
+func (s *Sharder) releaseDrained() int {
+	s.lock.Lock()
+	tracker := s.tracker
+	draining := 0
+	for _, state := range s.shards {
+		if state.draining {
+			draining++
+		}
+	}
+	s.lock.Unlock()
+	if draining == 0 {
+		return 0
+	}
+
+	// The tracker is called without holding the lock, as it may call Owns.
+	running := map[int]bool{}
+	if tracker != nil {
+		for _, svc := range tracker.ServicesWithRunningSyncers() {
+			running[shardOf(svc.Namespace, svc.Name, s.config.Shards)] = true
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	draining = 0
+	for shard, state := range s.shards {
+		if !state.draining {
+			continue
+		}
+		if running[shard] {
+			if s.clock.Since(state.drainingSince) < s.config.LeaseDuration {
+				s.logger.V(2).Info("Waiting for the NEG syncers of shard to stop before releasing it", "shard", shard)
+				draining++
+				continue
+			}
+			s.logger.Info("Releasing shard whose NEG syncers did not stop in time", "shard", shard, "timeout", s.config.LeaseDuration)
+		}
+		state.draining = false
+		state.cancel()
+	}
+	return draining
+}
+
+// startCandidateLocked runs the leader election of the Lease of shard until
+// its state is canceled or leadership is lost. The election is not bound to
+// ctx, so that the Lease outlives Run until the syncers of the shard stopped.
+func (s *Sharder) startCandidateLocked(ctx context.Context, shard int) {
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock,
+		s.config.Namespace,
+		s.shardLeaseName(shard),
+		s.client.CoreV1(),
+		s.client.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: s.config.Identity})
+	if err != nil {
+		s.logger.Error(err, "Failed to create resource lock", "shard", shard)
+		return
+	}
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	state := &shardState{cancel: cancel}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   s.config.LeaseDuration,
+		RenewDeadline:   s.config.RenewDeadline,
+		RetryPeriod:     s.config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            s.shardLeaseName(shard),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				// The candidacy may have been canceled or lost while this
+				// callback was scheduled.
+				if s.shards[shard] != state || leaderCtx.Err() != nil {
+					return
+				}
+				s.logger.Info("Acquired shard", "shard", shard)
+				state.held = true
+				state.heldSince = s.clock.Now()
+				s.notifyLocked()
+			},
+			OnStoppedLeading: func() {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				if s.shards[shard] != state {
+					return
+				}
+				delete(s.shards, shard)
+				if state.held {
+					s.logger.Info("Lost shard", "shard", shard)
+					s.notifyLocked()
+				}
+			},
+		},
+	})
+	if err != nil {
+		cancel()
+		s.logger.Error(err, "Failed to create leader elector", "shard", shard)
+		return
+	}
+	s.shards[shard] = state
+	s.candidates.Add(1)
+	go func() {
+		defer s.candidates.Done()
+		defer cancel()
+		elector.Run(ctx)
+	}()
+}
+
+// notifyLocked notifies Changed without blocking, a pending notification
+// covers all the changes made since.
+func (s *Sharder) notifyLocked() {
+	held := 0
+	for _, state := range s.shards {
+		if state.held {
+			held++
+		}
+	}
+	metrics.OwnedShards.Set(float64(held))
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Sharder) renewMemberLease(ctx context.Context) error {
+	leases := s.client.CoordinationV1().Leases(s.config.Namespace)
+	now := metav1.NewMicroTime(s.clock.Now())
+	lease, err := leases.Get(ctx, s.memberLeaseName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		durationSeconds := int32(s.config.LeaseDuration.Seconds())
+		_, err = leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   s.memberLeaseName(),
+				Labels: map[string]string{memberLabel: s.config.LeaseNamePrefix},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.config.Identity,
+				LeaseDurationSeconds: &durationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	lease.Spec.HolderIdentity = &s.config.Identity
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// liveMembers returns the sorted identities of the replicas whose member
+// Lease was renewed within LeaseDuration.
+func (s *Sharder) liveMembers(ctx context.Context) ([]string, error) {
+	leases, err := s.client.CoordinationV1().Leases(s.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", memberLabel, s.config.LeaseNamePrefix),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var members []string
+	for _, lease := range leases.Items {
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil {
+			continue
+		}
+		if s.clock.Since(lease.Spec.RenewTime.Time) > s.config.LeaseDuration {
+			continue
+		}
+		members = append(members, *lease.Spec.HolderIdentity)
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+// freeShards returns the shards whose Lease is not held by another replica:
+// it does not exist, was released, was not renewed within LeaseDuration or is
+// held by this replica.
+func (s *Sharder) freeShards(ctx context.Context) (map[int]bool, error) {
+	leases, err := s.client.CoordinationV1().Leases(s.config.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*coordinationv1.Lease, len(leases.Items))
+	for i := range leases.Items {
+		byName[leases.Items[i].Name] = &leases.Items[i]
+	}
+	free := make(map[int]bool)
+	for shard := 0; shard < s.config.Shards; shard++ {
+		lease, ok := byName[s.shardLeaseName(shard)]
+		free[shard] = !ok || lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" ||
+			*lease.Spec.HolderIdentity == s.config.Identity || lease.Spec.RenewTime == nil || s.clock.Since(lease.Spec.RenewTime.Time) > s.config.LeaseDuration
+	}
+	return free, nil
+}
+
+func (s *Sharder) shardLeaseName(shard int) string {
+	return fmt.Sprintf("%s-%d", s.config.LeaseNamePrefix, shard)
+}
+
+func (s *Sharder) memberLeaseName() string {
+	return fmt.Sprintf("%s-member-%08x", s.config.LeaseNamePrefix, hash(s.config.Identity))
+}
+
+// targetShards returns how many of the shards the replica identity should
+// hold, the shards being split as evenly as possible across members.
+func targetShards(shards int, members []string, identity string) int {
+	index := sort.SearchStrings(members, identity)
+	if index == len(members) || members[index] != identity {
+		// The replica does not see its own lease yet, hold nothing.
+		return 0
+	}
+	target := shards / len(members)
+	if index < shards%len(members) {
+		target++
+	}
+	return target
+}
+
+// shardOf returns the shard of the Service. The hash space is split into
+// contiguous ranges, one per shard.
+func shardOf(namespace, name string, shards int) int {
+	return int(uint64(hash(namespace+"/"+name)) * uint64(shards) >> 32)
+}
+
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
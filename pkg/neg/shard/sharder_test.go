@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2"
+)
+
+const testShards = 4
+
+func newTestSharder(t *testing.T, client kubernetes.Interface, identity string) *Sharder {
+	t.Helper()
+	s, err := NewSharder(client, Config{
+		Shards:          testShards,
+		Namespace:       "kube-system",
+		LeaseNamePrefix: "ingress-gce-neg-shard",
+		Identity:        identity,
+		// Lease durations are stored in whole seconds.
+		LeaseDuration: 2 * time.Second,
+		RenewDeadline: time.Second,
+		RetryPeriod:   100 * time.Millisecond,
+	}, klog.TODO())
+	if err != nil {
+		t.Fatalf("NewSharder() = %v, want nil", err)
+	}
+	return s
+}
+
+// runSharder runs s until the returned function is called.
+func runSharder(s *Sharder) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func heldShards(s *Sharder) []int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var held []int
+	for shard, state := range s.shards {
+		if state.held {
+			held = append(held, shard)
+		}
+	}
+	sort.Ints(held)
+	return held
+}
+
+// waitForShards waits until each sharder holds the given number of shards and
+// each shard is held by exactly one of them.
+func waitForShards(t *testing.T, desc string, sharders []*Sharder, want []int) {
+	t.Helper()
+	var got [][]int
+	err := wait.PollUntilContextTimeout(context.Background(), 50*time.Millisecond, 30*time.Second, true, func(context.Context) (bool, error) {
+		got = nil
+		owners := map[int]int{}
+		for i, s := range sharders {
+			held := heldShards(s)
+			got = append(got, held)
+			if len(held) != want[i] {
+				return false, nil
+			}
+			for _, shard := range held {
+				owners[shard]++
+			}
+		}
+		for shard := 0; shard < testShards; shard++ {
+			if owners[shard] != 1 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("%s: got shards %v, want %v shards per replica with each shard held once", desc, got, want)
+	}
+}
+
+func TestSharderRebalance(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	first := newTestSharder(t, client, "replica-a")
+	second := newTestSharder(t, client, "replica-b")
+
+	stopFirst := runSharder(first)
+	defer stopFirst()
+	waitForShards(t, "single replica", []*Sharder{first}, []int{testShards})
+	select {
+	case <-first.Changed():
+	default:
+		t.Errorf("Changed() was not notified after shards were acquired")
+	}
+
+	stopSecond := runSharder(second)
+	waitForShards(t, "two replicas", []*Sharder{first, second}, []int{testShards / 2, testShards / 2})
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("service-%d", i)
+		if first.Owns("default", name) == second.Owns("default", name) {
+			t.Errorf("Owns(default, %q) = %v for both replicas, want exactly one owner", name, first.Owns("default", name))
+		}
+	}
+
+	stopSecond()
+	waitForShards(t, "replica left", []*Sharder{first}, []int{testShards})
+	if _, err := client.CoordinationV1().Leases("kube-system").Get(context.Background(), second.memberLeaseName(), metav1.GetOptions{}); err == nil {
+		t.Errorf("member lease of the stopped replica still exists")
+	}
+}
+
+func TestRebalanceCampaignsForFreeShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	s := newTestSharder(t, client, "replica-a")
+	other, now := "replica-b", metav1.NewMicroTime(time.Now())
+	if _, err := client.CoordinationV1().Leases("kube-system").Create(context.Background(), &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: s.shardLeaseName(0)},
+		Spec:       coordinationv1.LeaseSpec{HolderIdentity: &other, RenewTime: &now},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() = %v, want nil", err)
+	}
+	canceled := false
+	s.shards[0] = &shardState{cancel: func() { canceled = true }}
+
+	s.rebalance(context.Background())
+	s.lock.Lock()
+	var candidates []int
+	for shard := range s.shards {
+		candidates = append(candidates, shard)
+	}
+	for shard, state := range s.shards {
+		if shard != 0 {
+			state.cancel()
+		}
+	}
+	s.lock.Unlock()
+	s.candidates.Wait()
+
+	sort.Ints(candidates)
+	if want := []int{0, 1, 2, 3}; !reflect.DeepEqual(candidates, want) {
+		t.Errorf("rebalance() campaigned for shards %v, want %v", candidates, want)
+	}
+	if !canceled {
+		t.Errorf("rebalance() kept campaigning for shard 0 held by %s, want canceled", other)
+	}
+}
+
+func TestOwnsForGC(t *testing.T) {
+	s := newTestSharder(t, fake.NewSimpleClientset(), "replica-a")
+	s.config.GCDelay = time.Hour
+	namespace, name := "test-namespace", "test-service"
+	s.shards[shardOf(namespace, name, testShards)] = &shardState{held: true, heldSince: time.Now()}
+
+	if !s.Owns(namespace, name) {
+		t.Errorf("Owns(%q, %q) = false, want true", namespace, name)
+	}
+	if s.OwnsForGC(namespace, name) {
+		t.Errorf("OwnsForGC(%q, %q) = true for a shard acquired within GCDelay, want false", namespace, name)
+	}
+	s.shards[shardOf(namespace, name, testShards)].heldSince = time.Now().Add(-2 * time.Hour)
+	if !s.OwnsForGC(namespace, name) {
+		t.Errorf("OwnsForGC(%q, %q) = false for a shard acquired before GCDelay, want true", namespace, name)
+	}
+}
+
+// fakeTracker reports the syncers of services as running.
+type fakeTracker struct {
+	running []types.NamespacedName
+}
+
+func (f *fakeTracker) ServicesWithRunningSyncers() []types.NamespacedName {
+	return f.running
+}
+
+func TestReleaseDrained(t *testing.T) {
+	s := newTestSharder(t, fake.NewSimpleClientset(), "replica-a")
+	svc := types.NamespacedName{Namespace: "test-namespace", Name: "test-service"}
+	shard := shardOf(svc.Namespace, svc.Name, testShards)
+	released := false
+	s.shards[shard] = &shardState{held: true, cancel: func() { released = true }}
+	tracker := &fakeTracker{running: []types.NamespacedName{svc}}
+	s.SetSyncerTracker(tracker)
+
+	s.lock.Lock()
+	s.drainLocked(s.shards[shard])
+	s.lock.Unlock()
+	if s.Owns(svc.Namespace, svc.Name) {
+		t.Errorf("Owns(%v) = true for a draining shard, want false", svc)
+	}
+	if got := s.releaseDrained(); got != 1 || released {
+		t.Errorf("releaseDrained() = %d, released = %v while the syncers of the shard run, want 1, false", got, released)
+	}
+
+	tracker.running = nil
+	if got := s.releaseDrained(); got != 0 || !released {
+		t.Errorf("releaseDrained() = %d, released = %v once the syncers of the shard stopped, want 0, true", got, released)
+	}
+
+	// Shards whose syncers do not stop are released after LeaseDuration.
+	released = false
+	tracker.running = []types.NamespacedName{svc}
+	s.shards[shard] = &shardState{draining: true, drainingSince: time.Now().Add(-s.config.LeaseDuration), cancel: func() { released = true }}
+	if got := s.releaseDrained(); got != 0 || !released {
+		t.Errorf("releaseDrained() = %d, released = %v after LeaseDuration, want 0, true", got, released)
+	}
+}
+
+func TestTargetShards(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	for _, tc := range []struct {
+		identity string
+		want     int
+	}{
+		{identity: "a", want: 4},
+		{identity: "b", want: 3},
+		{identity: "c", want: 3},
+		{identity: "d", want: 0},
+	} {
+		if got := targetShards(10, members, tc.identity); got != tc.want {
+			t.Errorf("targetShards(10, %v, %q) = %d, want %d", members, tc.identity, got, tc.want)
+		}
+	}
+}
+
+func TestShardOf(t *testing.T) {
+	counts := make([]int, testShards)
+	for i := 0; i < 1000; i++ {
+		shard := shardOf("default", fmt.Sprintf("service-%d", i), testShards)
+		if shard < 0 || shard >= testShards {
+			t.Fatalf("shardOf() = %d, want a shard in [0, %d)", shard, testShards)
+		}
+		counts[shard]++
+	}
+	for shard, count := range counts {
+		if count == 0 {
+			t.Errorf("no service was assigned to shard %d", shard)
+		}
+	}
+}
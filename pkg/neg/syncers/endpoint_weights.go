@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	l4annotations "k8s.io/ingress-gce/pkg/l4/annotations"
+	negtypes "k8s.io/ingress-gce/pkg/neg/types"
+	"k8s.io/klog/v2"
+)
+
+// clusterModeWeightScale is used to keep precision when the endpoints of a zone
+// are split across the nodes of that zone in L4ClusterMode.
+const clusterModeWeightScale = 100
+
+// calculateEndpointWeights computes the weights of the nodes of the GCE_VM_IP endpoints
+// in targetMap, keyed by node name.
+//
+// In L4LocalMode a node only forwards traffic to the pods running on it, so its weight
+// is the number of ready endpoints on the node multiplied by the node capacity.
+//
+// In L4ClusterMode kube-proxy forwards traffic to endpoints hinted for the zone of the node
+// (or to all endpoints, if the zone has no hinted endpoints). The count of such endpoints
+// is split across the nodes of the zone, proportionally to their capacity.
+func calculateEndpointWeights(eds []negtypes.EndpointsData, targetMap map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, mode negtypes.EndpointsCalculatorMode, nodeLister listers.NodeLister, logger klog.Logger) map[string]int64 {
+	readyPerNode := make(map[string]int64)
+	readyPerZone := make(map[string]int64)
+	var readyUnhinted, readyTotal int64
+	for _, ed := range eds {
+		for _, addr := range ed.Addresses {
+			if !addr.Ready {
+				continue
+			}
+			readyTotal++
+			if addr.NodeName != nil {
+				readyPerNode[*addr.NodeName]++
+			}
+			if len(addr.ZoneHints) == 0 {
+				readyUnhinted++
+			}
+			for _, zone := range slices.Compact(slices.Sorted(slices.Values(addr.ZoneHints))) {
+				readyPerZone[zone]++
+			}
+		}
+	}
+
+	capacities := make(map[string]int64)
+	capacityPerZone := make(map[string]int64)
+	for location, endpointSet := range targetMap {
+		for endpoint := range endpointSet {
+			capacity := nodeCapacity(endpoint.Node, nodeLister, logger)
+			capacities[endpoint.Node] = capacity
+			capacityPerZone[location.Zone] += capacity
+		}
+	}
+
+	weights := make(map[string]int64)
+	for location, endpointSet := range targetMap {
+		zoneEndpoints := readyPerZone[location.Zone] + readyUnhinted
+		if zoneEndpoints == 0 {
+			// Without endpoints hinted for the zone kube-proxy falls back to all endpoints.
+			zoneEndpoints = readyTotal
+		}
+		for endpoint := range endpointSet {
+			capacity := capacities[endpoint.Node]
+			var weight int64
+			if mode == negtypes.L4LocalMode {
+				weight = readyPerNode[endpoint.Node] * capacity
+			} else if capacityPerZone[location.Zone] > 0 {
+				weight = zoneEndpoints * capacity * clusterModeWeightScale / capacityPerZone[location.Zone]
+				if weight == 0 && zoneEndpoints > 0 {
+					weight = 1
+				}
+			}
+			weights[endpoint.Node] = weight
+		}
+	}
+	return weights
+}
+
+// nodeCapacity returns the capacity of the node from its capacity annotation.
+// Nodes without a valid annotation have capacity 1.
+func nodeCapacity(nodeName string, nodeLister listers.NodeLister, logger klog.Logger) int64 {
+	node, err := nodeLister.Get(nodeName)
+	if err != nil {
+		logger.V(2).Info("Unable to get node for capacity, using default", "nodeName", nodeName, "err", err)
+		return 1
+	}
+	value, ok := node.Annotations[l4annotations.NodeCapacityAnnotationKey]
+	if !ok {
+		return 1
+	}
+	capacity, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || capacity < 1 {
+		logger.Info("Ignoring invalid node capacity annotation", "nodeName", nodeName, "annotation", l4annotations.NodeCapacityAnnotationKey, "value", value)
+		return 1
+	}
+	return capacity
+}
+
+// nodeWeightPublisher publishes the weights of the nodes of a NEG as Node
+// annotations, which are read by the health check responder of each node.
+// GCE Weighted load balancing takes the weight of an endpoint from the
+// X-Load-Balancing-Endpoint-Weight header of its health check response.
+type nodeWeightPublisher struct {
+	kubeClient kubernetes.Interface
+	nodeLister cache.Indexer
+	logger     klog.Logger
+
+	// lock protects patched.
+	lock sync.Mutex
+	// patched holds the annotations patched on the nodes, keyed by annotation
+	// and node name, until the node lister catches up with the patch.
+	patched map[string]map[string]patchedWeight
+}
+
+// patchedWeight is the value of a weight annotation patched on a node whose
+// resource version was fromVersion.
+type patchedWeight struct {
+	fromVersion string
+	value       *string
+}
+
+// NewNodeWeightPublisher returns an EndpointWeightPublisher which stores the
+// weights in Node annotations.
+func NewNodeWeightPublisher(kubeClient kubernetes.Interface, nodeLister cache.Indexer, logger klog.Logger) negtypes.EndpointWeightPublisher {
+	return &nodeWeightPublisher{
+		kubeClient: kubeClient,
+		nodeLister: nodeLister,
+		logger:     logger.WithName("NodeWeightPublisher"),
+		patched:    make(map[string]map[string]patchedWeight),
+	}
+}
+
+// PublishWeights only patches the nodes whose annotation differs from weights.
+func (p *nodeWeightPublisher) PublishWeights(negName string, weights map[string]int64) error {
+	key := l4annotations.EndpointWeightAnnotationPrefix + negName
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	patched := p.patched[key]
+	p.patched[key] = make(map[string]patchedWeight)
+	var errs []error
+	for _, obj := range p.nodeLister.List() {
+		node := obj.(*v1.Node)
+		current, hasCurrent := node.Annotations[key]
+		// The lister may not have seen the last patch of the node yet.
+		if last, ok := patched[node.Name]; ok && last.fromVersion == node.ResourceVersion {
+			p.patched[key][node.Name] = last
+			current, hasCurrent = "", last.value != nil
+			if hasCurrent {
+				current = *last.value
+			}
+		}
+		weight, hasWeight := weights[node.Name]
+		// A nil value removes the annotation in a merge patch.
+		var value *string
+		if hasWeight {
+			desired := strconv.FormatInt(weight, 10)
+			if hasCurrent && current == desired {
+				continue
+			}
+			value = &desired
+		} else if !hasCurrent {
+			continue
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]*string{key: value},
+			},
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := p.kubeClient.CoreV1().Nodes().Patch(context.Background(), node.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch endpoint weight of node %s: %w", node.Name, err))
+			continue
+		}
+		p.patched[key][node.Name] = patchedWeight{fromVersion: node.ResourceVersion, value: value}
+		p.logger.V(3).Info("Published endpoint weight", "node", node.Name, "negName", negName, "weight", value)
+	}
+	if len(p.patched[key]) == 0 {
+		delete(p.patched, key)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// noopWeightPublisher is used when no publisher is configured.
+type noopWeightPublisher struct{}
+
+// NewNoopWeightPublisher returns an EndpointWeightPublisher which does nothing.
+func NewNoopWeightPublisher() negtypes.EndpointWeightPublisher {
+	return noopWeightPublisher{}
+}
+
+func (noopWeightPublisher) PublishWeights(string, map[string]int64) error { return nil }
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	l4annotations "k8s.io/ingress-gce/pkg/l4/annotations"
+	negtypes "k8s.io/ingress-gce/pkg/neg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+func TestCalculateEndpointWeights(t *testing.T) {
+	t.Parallel()
+
+	zoneA := negtypes.NEGLocation{Zone: "zone-a", Subnet: defaultTestSubnet}
+	zoneB := negtypes.NEGLocation{Zone: "zone-b", Subnet: defaultTestSubnet}
+	targetMap := map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
+		zoneA: negtypes.NewNetworkEndpointSet(
+			negtypes.NetworkEndpoint{IP: "10.0.0.1", Node: "node-a1"},
+			negtypes.NetworkEndpoint{IP: "10.0.0.2", Node: "node-a2"},
+		),
+		zoneB: negtypes.NewNetworkEndpointSet(negtypes.NetworkEndpoint{IP: "10.0.0.3", Node: "node-b1"}),
+	}
+	capacities := map[string]string{
+		"node-a1": "3",
+		"node-b1": "invalid",
+	}
+
+	endpoint := func(node string, ready bool, zones ...string) negtypes.AddressData {
+		return negtypes.AddressData{NodeName: ptr.To(node), Ready: ready, ZoneHints: zones}
+	}
+
+	testCases := []struct {
+		desc      string
+		addresses []negtypes.AddressData
+		mode      negtypes.EndpointsCalculatorMode
+		want      map[string]int64
+	}{
+		{
+			desc: "local mode uses ready endpoints on the node and node capacity",
+			addresses: []negtypes.AddressData{
+				endpoint("node-a1", true),
+				endpoint("node-a1", true),
+				endpoint("node-a2", true),
+				endpoint("node-a2", false),
+				endpoint("node-b1", true),
+			},
+			mode: negtypes.L4LocalMode,
+			want: map[string]int64{"node-a1": 6, "node-a2": 1, "node-b1": 1},
+		},
+		{
+			desc: "cluster mode splits hinted endpoints of a zone by node capacity",
+			addresses: []negtypes.AddressData{
+				endpoint("node-a1", true, "zone-a"),
+				endpoint("node-a1", true, "zone-a"),
+				endpoint("node-b1", true, "zone-b"),
+				endpoint("node-b1", true, "zone-a", "zone-b"),
+			},
+			mode: negtypes.L4ClusterMode,
+			want: map[string]int64{"node-a1": 225, "node-a2": 75, "node-b1": 200},
+		},
+		{
+			desc: "cluster mode without hints weights nodes by capacity only",
+			addresses: []negtypes.AddressData{
+				endpoint("node-a1", true),
+				endpoint("node-b1", true),
+			},
+			mode: negtypes.L4ClusterMode,
+			want: map[string]int64{"node-a1": 150, "node-a2": 50, "node-b1": 200},
+		},
+		{
+			desc: "cluster mode falls back to all endpoints for a zone without hints",
+			addresses: []negtypes.AddressData{
+				endpoint("node-a1", true, "zone-a"),
+				endpoint("node-a2", true, "zone-a"),
+			},
+			mode: negtypes.L4ClusterMode,
+			want: map[string]int64{"node-a1": 150, "node-a2": 50, "node-b1": 200},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, name := range []string{"node-a1", "node-a2", "node-b1"} {
+				node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{}}}
+				if capacity, ok := capacities[name]; ok {
+					node.Annotations[l4annotations.NodeCapacityAnnotationKey] = capacity
+				}
+				if err := nodeIndexer.Add(node); err != nil {
+					t.Fatalf("nodeIndexer.Add(%s) returned error %v", name, err)
+				}
+			}
+			eds := []negtypes.EndpointsData{{Meta: &metav1.ObjectMeta{Name: "svc"}, Addresses: tc.addresses}}
+
+			got := calculateEndpointWeights(eds, targetMap, tc.mode, listers.NewNodeLister(nodeIndexer), klog.TODO())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("calculateEndpointWeights() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeWeightPublisher(t *testing.T) {
+	t.Parallel()
+
+	const negName = "k8s2-neg"
+	key := l4annotations.EndpointWeightAnnotationPrefix + negName
+	otherKey := l4annotations.EndpointWeightAnnotationPrefix + "k8s2-other"
+
+	testCases := []struct {
+		desc        string
+		annotations map[string]map[string]string
+		weights     map[string]int64
+		want        map[string]map[string]string
+		wantPatches int
+	}{
+		{
+			desc:        "sets weights of nodes",
+			annotations: map[string]map[string]string{"node-1": nil, "node-2": nil},
+			weights:     map[string]int64{"node-1": 3, "node-2": 1},
+			want:        map[string]map[string]string{"node-1": {key: "3"}, "node-2": {key: "1"}},
+			wantPatches: 2,
+		},
+		{
+			desc:        "only patches changed weights",
+			annotations: map[string]map[string]string{"node-1": {key: "3"}, "node-2": {key: "1"}},
+			weights:     map[string]int64{"node-1": 3, "node-2": 2},
+			want:        map[string]map[string]string{"node-1": {key: "3"}, "node-2": {key: "2"}},
+			wantPatches: 1,
+		},
+		{
+			desc:        "removes weights of nodes not in the NEG",
+			annotations: map[string]map[string]string{"node-1": {key: "3", otherKey: "5"}, "node-2": {key: "1"}},
+			weights:     map[string]int64{"node-2": 1},
+			want:        map[string]map[string]string{"node-1": {otherKey: "5"}, "node-2": {key: "1"}},
+			wantPatches: 1,
+		},
+		{
+			desc:        "nil weights clear the NEG",
+			annotations: map[string]map[string]string{"node-1": {key: "3"}, "node-2": nil},
+			want:        map[string]map[string]string{"node-1": nil, "node-2": nil},
+			wantPatches: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for name, annotations := range tc.annotations {
+				node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+				if _, err := kubeClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Create(%s) returned error %v", name, err)
+				}
+				if err := nodeIndexer.Add(node); err != nil {
+					t.Fatalf("nodeIndexer.Add(%s) returned error %v", name, err)
+				}
+			}
+			kubeClient.ClearActions()
+
+			publisher := NewNodeWeightPublisher(kubeClient, nodeIndexer, klog.TODO())
+			if err := publisher.PublishWeights(negName, tc.weights); err != nil {
+				t.Fatalf("PublishWeights() returned error %v", err)
+			}
+
+			if got := len(kubeClient.Actions()); got != tc.wantPatches {
+				t.Errorf("PublishWeights() made %d requests, want %d: %v", got, tc.wantPatches, kubeClient.Actions())
+			}
+			for name, want := range tc.want {
+				node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("Get(%s) returned error %v", name, err)
+				}
+				if len(want) == 0 && len(node.Annotations) == 0 {
+					continue
+				}
+				if diff := cmp.Diff(want, node.Annotations); diff != "" {
+					t.Errorf("annotations of %s mismatch (-want +got):\n%s", name, diff)
+				}
+			}
+
+			// The node lister has not seen the patches yet, the weights must
+			// not be patched again.
+			kubeClient.ClearActions()
+			if err := publisher.PublishWeights(negName, tc.weights); err != nil {
+				t.Fatalf("PublishWeights() returned error %v", err)
+			}
+			if got := len(kubeClient.Actions()); got != 0 {
+				t.Errorf("PublishWeights() of unchanged weights made %d requests, want 0: %v", got, kubeClient.Actions())
+			}
+		})
+	}
+}
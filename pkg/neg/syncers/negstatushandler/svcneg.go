@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	negv1beta1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
@@ -304,7 +305,7 @@ func (h *SvcNegStatusHandler) getSyncedCondition(err error) negv1beta1.Condition
 		return negv1beta1.Condition{
 			Type:               negv1beta1.Synced,
 			Status:             v1.ConditionFalse,
-			Reason:             negtypes.NegSyncFailed,
+			Reason:             events.ReasonNegSyncFailed,
 			LastTransitionTime: metav1.Now(),
 			Message:            err.Error(),
 		}
@@ -313,7 +314,7 @@ func (h *SvcNegStatusHandler) getSyncedCondition(err error) negv1beta1.Condition
 	return negv1beta1.Condition{
 		Type:               negv1beta1.Synced,
 		Status:             v1.ConditionTrue,
-		Reason:             negtypes.NegSyncSuccessful,
+		Reason:             events.ReasonNegSyncSuccessful,
 		LastTransitionTime: metav1.Now(),
 	}
 }
@@ -324,7 +325,7 @@ func (h *SvcNegStatusHandler) getInitializedCondition(err error) negv1beta1.Cond
 		return negv1beta1.Condition{
 			Type:               negv1beta1.Initialized,
 			Status:             v1.ConditionFalse,
-			Reason:             negtypes.NegInitializationFailed,
+			Reason:             events.ReasonNegInitializationFailed,
 			LastTransitionTime: metav1.Now(),
 			Message:            err.Error(),
 		}
@@ -333,7 +334,7 @@ func (h *SvcNegStatusHandler) getInitializedCondition(err error) negv1beta1.Cond
 	return negv1beta1.Condition{
 		Type:               negv1beta1.Initialized,
 		Status:             v1.ConditionTrue,
-		Reason:             negtypes.NegInitializationSuccessful,
+		Reason:             events.ReasonNegInitializationSuccessful,
 		LastTransitionTime: metav1.Now(),
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/ingress-gce/pkg/backoff"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/klog/v2"
@@ -109,7 +110,7 @@ func (s *syncer) Start() error {
 				s.logger.Error(err, "Sync failed", "syncerKey", s.NegSyncerKey.String(), "retry", retryMsg)
 
 				if svc := getService(s.serviceLister, s.Namespace, s.Name, s.logger, s.negMetrics); svc != nil {
-					s.recorder.Eventf(svc, apiv1.EventTypeWarning, "SyncNetworkEndpointGroupFailed", "Failed to sync NEG %q %s: %v", s.NegSyncerKey.NegName, retryMsg, err)
+					s.recorder.Eventf(svc, apiv1.EventTypeWarning, events.ReasonSyncNetworkEndpointGroupFailed, "Failed to sync NEG %q %s: %v", s.NegSyncerKey.NegName, retryMsg, err)
 				}
 			} else {
 				s.backoff.ResetDelay()
@@ -163,6 +164,14 @@ func (s *syncer) Sync() bool {
 	}
 }
 
+// DebugInfo returns the debug snapshot of the syncer core, if it provides one.
+func (s *syncer) DebugInfo() negtypes.SyncerDebugInfo {
+	if d, ok := s.core.(negtypes.NegSyncerDebugger); ok {
+		return d.DebugInfo()
+	}
+	return negtypes.SyncerDebugInfo{SyncerKey: s.NegSyncerKey.String(), NegName: s.NegSyncerKey.NegName}
+}
+
 func (s *syncer) IsStopped() bool {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
@@ -17,6 +17,7 @@ limitations under the License.
 package syncers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -26,12 +27,15 @@ import (
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/api/googleapi"
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/network"
 	"k8s.io/ingress-gce/pkg/nodetopology"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/endpointslices"
 	"k8s.io/ingress-gce/pkg/utils/namer"
@@ -138,6 +142,18 @@ type transactionSyncer struct {
 
 	// negMetrics is used to collect metrics per NEG instance
 	negMetrics *metrics.NegMetrics
+
+	// weightPublisher publishes the node weights of weighted GCE_VM_IP NEGs.
+	weightPublisher negtypes.EndpointWeightPublisher
+
+	// debugLock protects the snapshot served by DebugInfo. It is separate
+	// from syncLock so that debug requests do not wait for an ongoing sync.
+	debugLock        sync.Mutex
+	lastSyncTime     time.Time
+	lastSyncErr      error
+	lastInErrorState bool
+	lastTargetMap    map[negtypes.NEGLocation]negtypes.NetworkEndpointSet
+	lastCommittedMap map[negtypes.NEGLocation]negtypes.NetworkEndpointSet
 }
 
 func NewTransactionSyncer(
@@ -162,6 +178,7 @@ func NewTransactionSyncer(
 	networkInfo network.NetworkInfo,
 	namer namer.NonDefaultSubnetNEGNamer,
 	negMetrics *metrics.NegMetrics,
+	weightPublisher negtypes.EndpointWeightPublisher,
 ) negtypes.NegSyncer {
 
 	logger := log.WithName("Syncer").WithValues("service", klog.KRef(negSyncerKey.Namespace, negSyncerKey.Name), "primaryNEGName", negSyncerKey.NegName)
@@ -195,6 +212,7 @@ func NewTransactionSyncer(
 		networkInfo:               networkInfo,
 		namer:                     namer,
 		negMetrics:                negMetrics,
+		weightPublisher:           weightPublisher,
 	}
 	// Syncer implements life cycle logic
 	syncer := newSyncer(negSyncerKey, serviceLister, recorder, ts, logger, negMetrics)
@@ -258,13 +276,15 @@ func (s *transactionSyncer) syncInternal() error {
 	s.syncLock.Lock()
 	defer s.syncLock.Unlock()
 
+	ctx, logger, span := tracing.StartSync(context.Background(), s.logger, "transactionSyncer.syncInternal", attribute.String("negSyncerKey", s.NegSyncerKey.String()))
 	start := time.Now()
-	err := s.syncInternalImpl()
+	err := s.syncInternalImpl(ctx, logger)
+	tracing.End(span, err)
 	if err != nil {
 		if syncErr := negtypes.ClassifyError(err); syncErr.IsErrorState {
 			s.logger.Info("Enter degraded mode", "reason", syncErr.Reason)
 			if s.enableDegradedMode {
-				s.recordEvent(v1.EventTypeWarning, "EnterDegradedMode", fmt.Sprintf("Entering degraded mode for NEG %s due to sync err: %v", s.NegSyncerKey.String(), syncErr))
+				s.recordEvent(v1.EventTypeWarning, events.ReasonEnterDegradedMode, fmt.Sprintf("Entering degraded mode for NEG %s due to sync err: %v", s.NegSyncerKey.String(), syncErr))
 			}
 			s.setErrorState()
 		}
@@ -278,14 +298,20 @@ func (s *transactionSyncer) syncInternal() error {
 	}
 	s.negMetrics.PublishNegSyncMetrics(string(s.NegSyncerKey.NegType), string(s.endpointsCalculator.Mode()), err, start)
 	s.syncMetricsCollector.UpdateSyncerStatusInMetrics(s.NegSyncerKey, err, s.inErrorState())
+
+	s.debugLock.Lock()
+	s.lastSyncTime = start
+	s.lastSyncErr = err
+	s.lastInErrorState = s.inErrorState()
+	s.debugLock.Unlock()
 	return err
 }
 
-func (s *transactionSyncer) syncInternalImpl() error {
+func (s *transactionSyncer) syncInternalImpl(ctx context.Context, logger klog.Logger) error {
 	isStopped := s.syncer.IsStopped()
 	isShuttingDown := s.syncer.IsShuttingDown()
 	if isStopped || isShuttingDown {
-		s.logger.Info("Skip syncing NEG", "negSyncerKey", s.NegSyncerKey.String(), "syncerStopped", isStopped, "syncerShuttingDown", isShuttingDown)
+		logger.Info("Skip syncing NEG", "negSyncerKey", s.NegSyncerKey.String(), "syncerStopped", isStopped, "syncerShuttingDown", isShuttingDown)
 		return nil
 	}
 	topologyChange := s.isTopologyChange()
@@ -296,10 +322,10 @@ func (s *transactionSyncer) syncInternalImpl() error {
 	var ensureErr error
 	var ensuredSubnetZones map[string]sets.Set[string]
 	if s.needInit || topologyChange {
-		s.logger.Info("Need to ensure network endpoint groups", "needInit", s.needInit, "topologyChange", topologyChange)
+		logger.Info("Need to ensure network endpoint groups", "needInit", s.needInit, "topologyChange", topologyChange)
 
 		// Passing ensured NEGs forward from ensureNetworkEndpointGroups() if called during this sync as reading from statusHandler in the same sync might result in reading stale data.
-		ensuredSubnetZones, ensureErr = s.ensureNetworkEndpointGroups()
+		ensuredSubnetZones, ensureErr = s.ensureNetworkEndpointGroups(ctx)
 		if ensureErr == nil {
 			s.needInit = false
 		} else {
@@ -319,16 +345,16 @@ func (s *transactionSyncer) syncInternalImpl() error {
 			return fmt.Errorf("failed to get subnet to zones map from status handler: %w", err)
 		}
 	}
-	s.logger.V(2).Info("Sync NEG", "negSyncerKey", s.NegSyncerKey.String(), "endpointsCalculatorMode", s.endpointsCalculator.Mode())
+	logger.V(2).Info("Sync NEG", "negSyncerKey", s.NegSyncerKey.String(), "endpointsCalculatorMode", s.endpointsCalculator.Mode())
 
-	subnetConfigs := s.topologyProvider.ListSubnetsInDefaultNetwork(s.logger)
+	subnetConfigs := s.topologyProvider.ListSubnetsInDefaultNetwork(logger)
 	subnetToNegMapping, err := s.generateSubnetToNegNameMap(subnetConfigs)
 	if err != nil {
-		s.logger.Error(err, "failed to generate subnet to neg name mapping")
+		logger.Error(err, "failed to generate subnet to neg name mapping")
 		return err
 	}
 
-	currentMap, currentPodLabelMap, drainingEndpoints, err := retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, s.topologyProvider, ensuredSubnetZones, s.cloud, s.NegSyncerKey.GetAPIVersion(), s.enableDualStackNEG, s.networkInfo, s.logger, s.negMetrics, needInitDrainStatus)
+	currentMap, currentPodLabelMap, drainingEndpoints, err := retrieveExistingZoneNetworkEndpointMap(ctx, subnetToNegMapping, s.topologyProvider, ensuredSubnetZones, s.cloud, s.NegSyncerKey.GetAPIVersion(), s.enableDualStackNEG, s.networkInfo, logger, s.negMetrics, needInitDrainStatus)
 	if err != nil {
 		return fmt.Errorf("%w: %w", negtypes.ErrCurrentNegEPNotFound, err)
 	}
@@ -336,7 +362,7 @@ func (s *transactionSyncer) syncInternalImpl() error {
 
 	// Merge the current state from cloud with the transaction table together
 	// The combined state represents the eventual result when all transactions completed
-	mergeTransactionIntoZoneEndpointMap(currentMap, s.transactions, s.logger)
+	mergeTransactionIntoZoneEndpointMap(currentMap, s.transactions, logger)
 	s.logStats(currentMap, "after in-progress operations have completed, NEG endpoints")
 
 	var targetMap map[negtypes.NEGLocation]negtypes.NetworkEndpointSet
@@ -346,7 +372,7 @@ func (s *transactionSyncer) syncInternalImpl() error {
 		return fmt.Errorf("%w: %v", negtypes.ErrEPSNotFound, err)
 	}
 	if len(slices) < 1 {
-		s.logger.Error(nil, "Endpoint slices for the service doesn't exist. Skipping NEG sync")
+		logger.Error(nil, "Endpoint slices for the service doesn't exist. Skipping NEG sync")
 		return nil
 	}
 	endpointSlices := convertUntypedToEPS(slices)
@@ -365,7 +391,7 @@ func (s *transactionSyncer) syncInternalImpl() error {
 			s.logStats(degradedTargetMap, "degraded mode desired NEG endpoints")
 			notInDegraded, onlyInDegraded = calculateNetworkEndpointDifference(targetMap, degradedTargetMap)
 			if err == nil {
-				computeDegradedModeCorrectness(notInDegraded, onlyInDegraded, string(s.NegSyncerKey.NegType), s.logger, s.negMetrics)
+				computeDegradedModeCorrectness(notInDegraded, onlyInDegraded, string(s.NegSyncerKey.NegType), logger, s.negMetrics)
 			}
 		}
 	}
@@ -374,7 +400,7 @@ func (s *transactionSyncer) syncInternalImpl() error {
 		if err != nil {
 			return err
 		}
-		s.logger.Info("Using normal mode endpoint calculation")
+		logger.Info("Using normal mode endpoint calculation")
 	} else {
 		if !s.inErrorState() && err != nil {
 			return err // if we encounter an error, we will return and run the next sync in degraded mode
@@ -383,11 +409,11 @@ func (s *transactionSyncer) syncInternalImpl() error {
 			return degradedModeErr
 		}
 		if s.inErrorState() {
-			s.logger.Info("Using degraded mode endpoint calculation")
+			logger.Info("Using degraded mode endpoint calculation")
 			targetMap = degradedTargetMap
 			endpointPodMap = degradedPodMap
 		} else {
-			s.logger.Info("Using normal mode endpoint calculation")
+			logger.Info("Using normal mode endpoint calculation")
 		}
 	}
 
@@ -399,9 +425,9 @@ func (s *transactionSyncer) syncInternalImpl() error {
 	// notInDegraded and onlyInDegraded are not populated when the flags are
 	// not enabled, so they would always be empty and reset error state.
 	if len(notInDegraded) == 0 && len(onlyInDegraded) == 0 {
-		s.logger.Info("Exit degraded mode")
+		logger.Info("Exit degraded mode")
 		if s.enableDegradedMode && s.inErrorState() {
-			s.recordEvent(v1.EventTypeNormal, "ExitDegradedMode", fmt.Sprintf("NEG %s is no longer in degraded mode", s.NegSyncerKey.String()))
+			s.recordEvent(v1.EventTypeNormal, events.ReasonExitDegradedMode, fmt.Sprintf("NEG %s is no longer in degraded mode", s.NegSyncerKey.String()))
 		}
 		s.resetErrorState()
 	}
@@ -422,41 +448,56 @@ func (s *transactionSyncer) syncInternalImpl() error {
 	// e.g. endpoint A is in the process of adding to NEG N, and the new desire state is not to have A in N.
 	// This ensures the endpoint that requires reconciliation to wait till the existing transaction to complete.
 	if s.enableL4NEGDetachCancel && s.endpointsCalculator.Mode() == negtypes.L4LocalMode {
-		filterEndpointByTransactionExclDetach(addEndpoints, s.transactions, s.logger)
+		filterEndpointByTransactionExclDetach(addEndpoints, s.transactions, logger)
 		if len(drainingEndpoints) > 0 {
-			reAddDrainingEndpointsThatAreInTargetMap(addEndpoints, targetMap, drainingEndpoints, s.logger)
+			reAddDrainingEndpointsThatAreInTargetMap(addEndpoints, targetMap, drainingEndpoints, logger)
 		}
 	} else {
-		filterEndpointByTransaction(addEndpoints, s.transactions, s.logger)
+		filterEndpointByTransaction(addEndpoints, s.transactions, logger)
 	}
-	filterEndpointByTransaction(removeEndpoints, s.transactions, s.logger)
+	filterEndpointByTransaction(removeEndpoints, s.transactions, logger)
 	// filter out the endpoints that are in transaction
-	filterEndpointByTransaction(committedEndpoints, s.transactions, s.logger)
+	filterEndpointByTransaction(committedEndpoints, s.transactions, logger)
+
+	s.debugLock.Lock()
+	s.lastTargetMap = targetMap
+	s.lastCommittedMap = committedEndpoints
+	s.debugLock.Unlock()
 
 	var endpointPodLabelMap labels.EndpointPodLabelMap
 	// Only fetch label from pod for L7 endpoints
 	if flags.F.EnableNEGLabelPropagation && s.NegType == negtypes.VmIpPortEndpointType {
-		endpointPodLabelMap = getEndpointPodLabelMap(addEndpoints, endpointPodMap, s.podLister, s.podLabelPropagationConfig, s.recorder, s.logger, s.negMetrics)
+		endpointPodLabelMap = getEndpointPodLabelMap(addEndpoints, endpointPodMap, s.podLister, s.podLabelPropagationConfig, s.recorder, logger, s.negMetrics)
 		publishAnnotationSizeMetrics(addEndpoints, endpointPodLabelMap)
 	}
 
 	s.syncMetricsCollector.SetLabelPropagationStats(s.NegSyncerKey, collectLabelStats(currentPodLabelMap, endpointPodLabelMap, targetMap))
 
+	// Weights are published on every sync, independently of endpoint changes,
+	// so that the weights of attached endpoints follow the EndpointSlices.
+	var publishErr error
+	if s.WeightedEndpoints && s.NegType == negtypes.VmIpEndpointType {
+		weights := calculateEndpointWeights(endpointsData, targetMap, s.endpointsCalculator.Mode(), listers.NewNodeLister(s.nodeLister), logger)
+		if publishErr = s.weightPublisher.PublishWeights(s.NegSyncerKey.NegName, weights); publishErr != nil {
+			logger.Error(publishErr, "Failed to publish endpoint weights")
+		}
+	}
+
 	if s.needCommit() {
 		s.commitPods(committedEndpoints, endpointPodMap)
 	}
 
 	if len(addEndpoints) == 0 && len(removeEndpoints) == 0 {
-		s.logger.V(3).Info("No endpoint change. Skip syncing NEG.", s.Namespace, s.Name)
-		return ensureErr
+		logger.V(3).Info("No endpoint change. Skip syncing NEG.", s.Namespace, s.Name)
+		return utilerrors.NewAggregate([]error{ensureErr, publishErr})
 	}
 
 	s.logEndpoints(addEndpoints, "adding endpoint")
 	s.logEndpoints(removeEndpoints, "removing endpoint")
-	if syncErr := s.syncNetworkEndpoints(addEndpoints, removeEndpoints, endpointPodLabelMap, migrationZone); syncErr != nil {
-		return utilerrors.NewAggregate([]error{ensureErr, syncErr})
+	if syncErr := s.syncNetworkEndpoints(ctx, addEndpoints, removeEndpoints, endpointPodLabelMap, migrationZone); syncErr != nil {
+		return utilerrors.NewAggregate([]error{ensureErr, publishErr, syncErr})
 	}
-	return ensureErr
+	return utilerrors.NewAggregate([]error{ensureErr, publishErr})
 }
 
 // reAddDrainingEndpointsThatAreInTargetMap will make sure that endpoints that are draining
@@ -535,6 +576,42 @@ func (s *transactionSyncer) inErrorState() bool {
 	return s.errorState
 }
 
+// DebugInfo returns a snapshot of the endpoints computed in the last sync
+// and of the transactions currently in flight.
+func (s *transactionSyncer) DebugInfo() negtypes.SyncerDebugInfo {
+	s.debugLock.Lock()
+	info := negtypes.SyncerDebugInfo{
+		SyncerKey:          s.NegSyncerKey.String(),
+		NegName:            s.NegSyncerKey.NegName,
+		LastSyncTime:       s.lastSyncTime,
+		InErrorState:       s.lastInErrorState,
+		TargetEndpoints:    negtypes.LocationEndpointsFromMap(s.lastTargetMap),
+		CommittedEndpoints: negtypes.LocationEndpointsFromMap(s.lastCommittedMap),
+	}
+	if s.lastSyncErr != nil {
+		info.LastError = s.lastSyncErr.Error()
+	}
+	s.debugLock.Unlock()
+
+	endpoints := s.transactions.Keys()
+	negtypes.SortNetworkEndpoints(endpoints)
+	info.Transactions = make([]negtypes.TransactionDebugInfo, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		// The entry may have completed since Keys() was called.
+		entry, ok := s.transactions.Get(endpoint)
+		if !ok {
+			continue
+		}
+		info.Transactions = append(info.Transactions, negtypes.TransactionDebugInfo{
+			Endpoint:  endpoint,
+			Operation: entry.Operation.String(),
+			Zone:      entry.Zone,
+			Subnet:    entry.Subnet,
+		})
+	}
+	return info
+}
+
 // InErrorState is a wrapper for exporting inErrorState().
 func (s *transactionSyncer) InErrorState() bool {
 	return s.inErrorState()
@@ -575,7 +652,7 @@ func (s *transactionSyncer) listTargetZonesPerSubnet() (shared.ZonesPerSubnetMap
 	if preprovErr != nil {
 		msg := "Ignore zone pre-provisioning annotation"
 		s.logger.Error(preprovErr, msg)
-		s.recordEvent(v1.EventTypeWarning, "IgnoreZonePreprovisioningAnnotation", fmt.Sprintf("%s err: %v", msg, preprovErr))
+		s.recordEvent(v1.EventTypeWarning, events.ReasonIgnoreZonePreprovisioningAnnotation, fmt.Sprintf("%s err: %v", msg, preprovErr))
 	}
 
 	// Merge workload zones with pre-provisioning zones.
@@ -587,7 +664,7 @@ func (s *transactionSyncer) listTargetZonesPerSubnet() (shared.ZonesPerSubnetMap
 }
 
 // ensureNetworkEndpointGroups ensures NEGs are created and configured correctly in the corresponding zones.
-func (s *transactionSyncer) ensureNetworkEndpointGroups() (shared.ZonesPerSubnetMap, error) {
+func (s *transactionSyncer) ensureNetworkEndpointGroups(ctx context.Context) (shared.ZonesPerSubnetMap, error) {
 
 	zonesPerSubnet, err := s.listTargetZonesPerSubnet()
 	if err != nil {
@@ -661,6 +738,7 @@ func (s *transactionSyncer) ensureNetworkEndpointGroups() (shared.ZonesPerSubnet
 		for zone := range zones {
 			var negObj *composite.NetworkEndpointGroup
 			negObj, err = ensureNetworkEndpointGroup(
+				ctx,
 				s.Namespace,
 				s.Name,
 				negName,
@@ -711,7 +789,7 @@ func (s *transactionSyncer) ensureNetworkEndpointGroups() (shared.ZonesPerSubnet
 }
 
 // syncNetworkEndpoints spins off go routines to execute NEG operations
-func (s *transactionSyncer) syncNetworkEndpoints(addEndpoints, removeEndpoints map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, endpointPodLabelMap labels.EndpointPodLabelMap, migrationZone negtypes.NEGLocation) error {
+func (s *transactionSyncer) syncNetworkEndpoints(ctx context.Context, addEndpoints, removeEndpoints map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, endpointPodLabelMap labels.EndpointPodLabelMap, migrationZone negtypes.NEGLocation) error {
 	syncFunc := func(endpointMap map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, operation transactionOp) error {
 		for negLocation, endpointSet := range endpointMap {
 			zone := negLocation.Zone
@@ -738,7 +816,7 @@ func (s *transactionSyncer) syncNetworkEndpoints(addEndpoints, removeEndpoints m
 			}
 
 			if operation == attachOp {
-				go s.attachNetworkEndpoints(negLocation, batch)
+				go s.attachNetworkEndpoints(ctx, negLocation, batch)
 			}
 			if operation == detachOp {
 				if zone == migrationZone.Zone && subnet == migrationZone.Subnet {
@@ -746,7 +824,7 @@ func (s *transactionSyncer) syncNetworkEndpoints(addEndpoints, removeEndpoints m
 					// is already in progress.
 					s.dsMigrator.Pause()
 				}
-				go s.detachNetworkEndpoints(negLocation, batch, zone == migrationZone.Zone && subnet == migrationZone.Subnet)
+				go s.detachNetworkEndpoints(ctx, negLocation, batch, zone == migrationZone.Zone && subnet == migrationZone.Subnet)
 			}
 		}
 		return nil
@@ -763,18 +841,18 @@ func (s *transactionSyncer) syncNetworkEndpoints(addEndpoints, removeEndpoints m
 }
 
 // attachNetworkEndpoints runs operation for attaching network endpoints.
-func (s *transactionSyncer) attachNetworkEndpoints(negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint) {
+func (s *transactionSyncer) attachNetworkEndpoints(ctx context.Context, negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint) {
 	s.logger.V(2).Info("Attaching endpoints to NEG.", "countOfEndpointsBeingAttached", len(networkEndpointMap), "negSyncerKey", s.NegSyncerKey.String(), "zone", negLocation.Zone, "subnet", negLocation.Subnet)
-	err := s.operationInternal(attachOp, negLocation, networkEndpointMap, s.logger)
+	err := s.operationInternal(ctx, attachOp, negLocation, networkEndpointMap, s.logger)
 
 	// WARNING: commitTransaction must be called at last for analyzing the operation result
 	s.commitTransaction(attachOp, err, networkEndpointMap)
 }
 
 // detachNetworkEndpoints runs operation for detaching network endpoints.
-func (s *transactionSyncer) detachNetworkEndpoints(negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint, hasMigrationDetachments bool) {
+func (s *transactionSyncer) detachNetworkEndpoints(ctx context.Context, negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint, hasMigrationDetachments bool) {
 	s.logger.V(2).Info("Detaching endpoints from NEG.", "countOfEndpointsBeingDetached", len(networkEndpointMap), "negSyncerKey", s.NegSyncerKey.String(), "zone", negLocation.Zone, "subnet", negLocation.Subnet)
-	err := s.operationInternal(detachOp, negLocation, networkEndpointMap, s.logger)
+	err := s.operationInternal(ctx, detachOp, negLocation, networkEndpointMap, s.logger)
 
 	if hasMigrationDetachments {
 		// Unpause the migration since the ongoing migration-detachments have
@@ -789,7 +867,7 @@ func (s *transactionSyncer) detachNetworkEndpoints(negLocation negtypes.NEGLocat
 // operationInternal executes NEG API call and commits the transactions
 // It will record events when operations are completed
 // If error occurs or any transaction entry requires reconciliation, it will trigger resync
-func (s *transactionSyncer) operationInternal(operation transactionOp, negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint, logger klog.Logger) error {
+func (s *transactionSyncer) operationInternal(ctx context.Context, operation transactionOp, negLocation negtypes.NEGLocation, networkEndpointMap map[negtypes.NetworkEndpoint]*composite.NetworkEndpoint, logger klog.Logger) error {
 	var err error
 	start := time.Now()
 	networkEndpoints := []*composite.NetworkEndpoint{}
@@ -819,10 +897,10 @@ func (s *transactionSyncer) operationInternal(operation transactionOp, negLocati
 		}
 	}
 	if operation == attachOp {
-		err = s.cloud.AttachNetworkEndpoints(negName, zone, networkEndpoints, s.NegSyncerKey.GetAPIVersion(), logger)
+		err = s.cloud.AttachNetworkEndpoints(ctx, negName, zone, networkEndpoints, s.NegSyncerKey.GetAPIVersion(), logger)
 	}
 	if operation == detachOp {
-		err = s.cloud.DetachNetworkEndpoints(negName, zone, networkEndpoints, s.NegSyncerKey.GetAPIVersion(), logger)
+		err = s.cloud.DetachNetworkEndpoints(ctx, negName, zone, networkEndpoints, s.NegSyncerKey.GetAPIVersion(), logger)
 	}
 
 	if err == nil {
@@ -840,7 +918,7 @@ func (s *transactionSyncer) operationInternal(operation transactionOp, negLocati
 			s.syncLock.Lock()
 			s.logger.Info("Enter degraded mode", "reason", syncErr.Reason)
 			if s.enableDegradedMode {
-				s.recordEvent(v1.EventTypeWarning, "EnterDegradedMode", fmt.Sprintf("Entering degraded mode for NEG %s due to sync err: %v", s.NegSyncerKey.String(), syncErr))
+				s.recordEvent(v1.EventTypeWarning, events.ReasonEnterDegradedMode, fmt.Sprintf("Entering degraded mode for NEG %s due to sync err: %v", s.NegSyncerKey.String(), syncErr))
 			}
 			s.setErrorState()
 			s.syncLock.Unlock()
@@ -919,7 +997,7 @@ func (s *transactionSyncer) commitTransaction(op transactionOp, err error, netwo
 			s.syncer.Sync()
 		} else {
 			if retryErr := s.retry.Retry(); retryErr != nil {
-				s.recordEvent(v1.EventTypeWarning, "RetryFailed", fmt.Sprintf("Failed to retry NEG sync for %q: %v", s.NegSyncerKey.String(), retryErr))
+				s.recordEvent(v1.EventTypeWarning, events.ReasonRetryFailed, fmt.Sprintf("Failed to retry NEG sync for %q: %v", s.NegSyncerKey.String(), retryErr))
 				s.negMetrics.PublishNegControllerErrorCountMetrics(retryErr, false)
 			}
 		}
@@ -1157,7 +1235,7 @@ func getEndpointPodLabelMap(endpoints map[negtypes.NEGLocation]negtypes.NetworkE
 			}
 			labelMap, err := labels.GetPodLabelMap(pod, lpConfig)
 			if err != nil {
-				recorder.Eventf(pod, v1.EventTypeWarning, "LabelsExceededLimit", "Label Propagation Error: %v", err)
+				recorder.Eventf(pod, v1.EventTypeWarning, events.ReasonLabelsExceededLimit, "Label Propagation Error: %v", err)
 				m.PublishNegControllerErrorCountMetrics(err, true)
 			}
 			endpointPodLabelMap[endpoint] = labelMap
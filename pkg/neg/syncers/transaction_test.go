@@ -45,6 +45,7 @@ import (
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	negv1beta1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/neg/metrics/metricscollector"
 	"k8s.io/ingress-gce/pkg/neg/readiness"
@@ -102,7 +103,7 @@ func TestTransactionSyncNetworkEndpoints(t *testing.T) {
 
 		createAndAddMockSvcNEG(t, transactionSyncer)
 
-		if _, err := transactionSyncer.ensureNetworkEndpointGroups(); err != nil {
+		if _, err := transactionSyncer.ensureNetworkEndpointGroups(context.Background()); err != nil {
 			t.Errorf("Expect error == nil, but got %v", err)
 		}
 		var targetPort string
@@ -111,7 +112,7 @@ func TestTransactionSyncNetworkEndpoints(t *testing.T) {
 		}
 
 		// Verify the NEGs are created as expected
-		ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(context.Background(), transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 		// Though the test cases below only add instances in zone1 and zone2, NEGs will be created in zone3 or zone4 as well since fakeZoneGetter includes those zones.
 		var expectZones []string
 		if testNegType == negtypes.VmIpEndpointType {
@@ -231,7 +232,7 @@ func TestTransactionSyncNetworkEndpoints(t *testing.T) {
 			// TODO(gauravkghildiyal): When the DualStack Migrator is fully
 			// implemented, check if we need to cover scenarios where `migrationZone`
 			// is not empty.
-			err := transactionSyncer.syncNetworkEndpoints(tc.addEndpoints, tc.removeEndpoints, labels.EndpointPodLabelMap{}, negtypes.NEGLocation{})
+			err := transactionSyncer.syncNetworkEndpoints(context.Background(), tc.addEndpoints, tc.removeEndpoints, labels.EndpointPodLabelMap{}, negtypes.NEGLocation{})
 			if err != nil {
 				t.Errorf("For case %q, syncNetworkEndpoints() got %v, want nil", tc.desc, err)
 			}
@@ -241,7 +242,7 @@ func TestTransactionSyncNetworkEndpoints(t *testing.T) {
 			}
 
 			for negLocation, endpoints := range tc.expectEndpoints {
-				list, err := fakeCloud.ListNetworkEndpoints(transactionSyncer.NegSyncerKey.NegName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+				list, err := fakeCloud.ListNetworkEndpoints(context.Background(), transactionSyncer.NegSyncerKey.NegName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 				if err != nil {
 					t.Errorf("For case %q, ListNetworkEndpoints() got %v, want nil", tc.desc, err)
 				}
@@ -260,10 +261,10 @@ func TestTransactionSyncNetworkEndpoints(t *testing.T) {
 				}
 			}
 		}
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	}
 }
 
@@ -335,7 +336,7 @@ func TestTransactionSyncNetworkEndpointsMSC(t *testing.T) {
 			t.Fatalf("Failed to get non-default subnet NEG name: %v", err)
 		}
 
-		if _, err := transactionSyncer.ensureNetworkEndpointGroups(); err != nil {
+		if _, err := transactionSyncer.ensureNetworkEndpointGroups(context.Background()); err != nil {
 			t.Errorf("Expect error == nil, but got %v", err)
 		}
 		var targetPort string
@@ -344,7 +345,7 @@ func TestTransactionSyncNetworkEndpointsMSC(t *testing.T) {
 		}
 
 		// Verify the NEGs are created as expected
-		ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(context.Background(), transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 
 		retZones := sets.NewString()
 
@@ -481,7 +482,7 @@ func TestTransactionSyncNetworkEndpointsMSC(t *testing.T) {
 			// TODO(gauravkghildiyal): When the DualStack Migrator is fully
 			// implemented, check if we need to cover scenarios where `migrationZone`
 			// is not empty.
-			err := transactionSyncer.syncNetworkEndpoints(tc.addEndpoints, tc.removeEndpoints, labels.EndpointPodLabelMap{}, negtypes.NEGLocation{})
+			err := transactionSyncer.syncNetworkEndpoints(context.Background(), tc.addEndpoints, tc.removeEndpoints, labels.EndpointPodLabelMap{}, negtypes.NEGLocation{})
 			if err != nil {
 				t.Errorf("For case %q, syncNetworkEndpoints() got %v, want nil", tc.desc, err)
 			}
@@ -495,7 +496,7 @@ func TestTransactionSyncNetworkEndpointsMSC(t *testing.T) {
 				if negLocation.Subnet != defaultTestSubnet {
 					negName = nonDefaultNegName
 				}
-				list, err := fakeCloud.ListNetworkEndpoints(negName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+				list, err := fakeCloud.ListNetworkEndpoints(context.Background(), negName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 				if err != nil {
 					t.Errorf("For case %q, ListNetworkEndpoints() got %v, want nil", tc.desc, err)
 				}
@@ -514,14 +515,14 @@ func TestTransactionSyncNetworkEndpointsMSC(t *testing.T) {
 				}
 			}
 		}
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(transactionSyncer.NegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(nonDefaultNegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(nonDefaultNegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(nonDefaultNegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		transactionSyncer.cloud.DeleteNetworkEndpointGroup(nonDefaultNegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), transactionSyncer.NegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultNegName, negtypes.TestZone1, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultNegName, negtypes.TestZone2, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultNegName, negtypes.TestZone3, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		transactionSyncer.cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultNegName, negtypes.TestZone4, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	}
 }
 
@@ -581,12 +582,12 @@ func TestNegNameMultiNetworking(t *testing.T) {
 
 	// Start syncer without starting syncer goroutine
 	(transactionSyncer.syncer.(*syncer)).stopped = false
-	if _, err := transactionSyncer.ensureNetworkEndpointGroups(); err != nil {
+	if _, err := transactionSyncer.ensureNetworkEndpointGroups(context.Background()); err != nil {
 		t.Errorf("Expect error == nil, but got %v", err)
 	}
 
 	// Verify the NEGs are created as expected
-	ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+	ret, _ := transactionSyncer.cloud.AggregatedListNetworkEndpointGroup(context.Background(), transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	// Though the test cases below only add instances in zone1 and zone2, NEGs will be created in zone3 or zone4 as well since fakeZoneGetter includes those zones.
 	expectZones := []string{negtypes.TestZone1, negtypes.TestZone2, negtypes.TestZone3}
 	retZones := sets.NewString()
@@ -628,14 +629,14 @@ func TestNegNameMultiNetworking(t *testing.T) {
 	addEndpoints := map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
 		{Zone: negtypes.TestZone1, Subnet: subnetInDefaultNetwork}: generateEndpointSet(net.ParseIP("1.1.1.1"), wantEndpointsCount, "instance-name", "8080"),
 	}
-	err = transactionSyncer.syncNetworkEndpoints(addEndpoints, nil, nil, negtypes.NEGLocation{})
+	err = transactionSyncer.syncNetworkEndpoints(context.Background(), addEndpoints, nil, nil, negtypes.NEGLocation{})
 	if err != nil {
 		t.Errorf("syncNetworkEndpoints(...) returned unexpected error: %v", err)
 	}
 	if err := waitForTransactions(transactionSyncer); err != nil {
 		t.Fatalf("Errored while waiting for the syncNetworkEndpoint transactions to complete: %v", err)
 	}
-	gotEndpoints, err := transactionSyncer.cloud.ListNetworkEndpoints(transactionSyncer.NegName, negtypes.TestZone1, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+	gotEndpoints, err := transactionSyncer.cloud.ListNetworkEndpoints(context.Background(), transactionSyncer.NegName, negtypes.TestZone1, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	if err != nil {
 		t.Fatalf("transactionSyncer.cloud.ListNetworkEndpoints(%v, %v, ...) returned unexpected error: %v", transactionSyncer.NegName, negtypes.TestZone1, err)
 	}
@@ -789,10 +790,10 @@ func TestSyncNetworkEndpointLabel(t *testing.T) {
 
 		createAndAddMockSvcNEG(t, transactionSyncer)
 
-		if _, err := transactionSyncer.ensureNetworkEndpointGroups(); err != nil {
+		if _, err := transactionSyncer.ensureNetworkEndpointGroups(context.Background()); err != nil {
 			t.Errorf("Expect error == nil, but got %v", err)
 		}
-		err = transactionSyncer.syncNetworkEndpoints(tc.addEndpoints, map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{}, tc.endpointPodLabelMap, negtypes.NEGLocation{})
+		err = transactionSyncer.syncNetworkEndpoints(context.Background(), tc.addEndpoints, map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{}, tc.endpointPodLabelMap, negtypes.NEGLocation{})
 		if err != nil {
 			t.Errorf("For case %q, syncNetworkEndpoints() got %v, want nil", tc.desc, err)
 		}
@@ -801,7 +802,7 @@ func TestSyncNetworkEndpointLabel(t *testing.T) {
 		}
 
 		for negLocation := range tc.addEndpoints {
-			list, err := fakeCloud.ListNetworkEndpoints(transactionSyncer.NegSyncerKey.NegName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+			list, err := fakeCloud.ListNetworkEndpoints(context.Background(), transactionSyncer.NegSyncerKey.NegName, negLocation.Zone, false, transactionSyncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 			if err != nil {
 				t.Errorf("For case %q, ListNetworkEndpoints() got %v, want nil", tc.desc, err)
 			}
@@ -1319,6 +1320,57 @@ func TestFilterEndpointByTransactionExclDetach(t *testing.T) {
 	}
 }
 
+func TestTransactionSyncerDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	fakeGCE := gce.NewFakeGCECloud(test.DefaultTestClusterValues())
+	negtypes.MockNetworkEndpointAPIs(fakeGCE)
+	fakeCloud := negtypes.NewAdapter(fakeGCE, negtypes.NewTestContext().NegMetrics)
+	syncer, transactionSyncer, err := newTestTransactionSyncer(fakeCloud, negtypes.VmIpPortEndpointType, "")
+	if err != nil {
+		t.Fatalf("failed to initialize transaction syncer: %v", err)
+	}
+
+	endpoint1 := negtypes.NetworkEndpoint{IP: "10.100.1.1", Port: "80", Node: "instance1"}
+	endpoint2 := negtypes.NetworkEndpoint{IP: "10.100.1.2", Port: "80", Node: "instance1"}
+	endpoint3 := negtypes.NetworkEndpoint{IP: "10.100.2.1", Port: "80", Node: "instance3"}
+	zone1 := negtypes.NEGLocation{Zone: testZone1, Subnet: defaultTestSubnet}
+	zone2 := negtypes.NEGLocation{Zone: testZone2, Subnet: defaultTestSubnet}
+	transactionSyncer.lastTargetMap = map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
+		zone2: negtypes.NewNetworkEndpointSet(endpoint3),
+		zone1: negtypes.NewNetworkEndpointSet(endpoint2, endpoint1),
+	}
+	transactionSyncer.lastCommittedMap = map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
+		zone1: negtypes.NewNetworkEndpointSet(endpoint1),
+	}
+	transactionSyncer.lastSyncErr = fmt.Errorf("sync failed")
+	transactionSyncer.transactions.Put(endpoint2, transactionEntry{Operation: attachOp, Zone: testZone1, Subnet: defaultTestSubnet})
+
+	debugger, ok := syncer.(negtypes.NegSyncerDebugger)
+	if !ok {
+		t.Fatalf("syncer %T does not implement NegSyncerDebugger", syncer)
+	}
+	got := debugger.DebugInfo()
+	want := negtypes.SyncerDebugInfo{
+		SyncerKey: transactionSyncer.NegSyncerKey.String(),
+		NegName:   transactionSyncer.NegSyncerKey.NegName,
+		LastError: "sync failed",
+		TargetEndpoints: []negtypes.LocationEndpoints{
+			{Zone: testZone1, Subnet: defaultTestSubnet, Endpoints: []negtypes.NetworkEndpoint{endpoint1, endpoint2}},
+			{Zone: testZone2, Subnet: defaultTestSubnet, Endpoints: []negtypes.NetworkEndpoint{endpoint3}},
+		},
+		CommittedEndpoints: []negtypes.LocationEndpoints{
+			{Zone: testZone1, Subnet: defaultTestSubnet, Endpoints: []negtypes.NetworkEndpoint{endpoint1}},
+		},
+		Transactions: []negtypes.TransactionDebugInfo{
+			{Endpoint: endpoint2, Operation: "Attach", Zone: testZone1, Subnet: defaultTestSubnet},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DebugInfo() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
 func TestCommitPods(t *testing.T) {
 	vals := gce.DefaultTestClusterValues()
 	vals.SubnetworkURL = defaultTestSubnetURL
@@ -1878,7 +1930,7 @@ func TestTransactionSyncerWithNegCR(t *testing.T) {
 			var err error
 			if tc.negExists {
 				for zone := range expectZones {
-					fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+					fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 						Version:             syncer.NegSyncerKey.GetAPIVersion(),
 						Name:                testNegName,
 						NetworkEndpointType: string(syncer.NegSyncerKey.NegType),
@@ -1909,7 +1961,7 @@ func TestTransactionSyncerWithNegCR(t *testing.T) {
 			}
 			syncer.statusHandler.(*negstatushandler.TestSvcNegStatusHandler).SvcNEGLister().Add(neg)
 
-			_, err = syncer.ensureNetworkEndpointGroups()
+			_, err = syncer.ensureNetworkEndpointGroups(context.Background())
 			if !tc.expectErr && err != nil {
 				t.Errorf("Expected no error, but got: %v", err)
 			}
@@ -1975,7 +2027,7 @@ func TestTransactionSyncerWithNegCR(t *testing.T) {
 			// Verify the NEGs are created as expected
 			retZones := sets.NewString()
 
-			ret, _ := fakeCloud.AggregatedListNetworkEndpointGroup(syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+			ret, _ := fakeCloud.AggregatedListNetworkEndpointGroup(context.Background(), syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 			for key, neg := range ret {
 				retZones.Insert(key.Zone)
 				if neg.Name != testNegName {
@@ -1992,10 +2044,10 @@ func TestTransactionSyncerWithNegCR(t *testing.T) {
 
 		negClient.NetworkingV1beta1().ServiceNetworkEndpointGroups(testServiceNamespace).Delete(context.TODO(), testNegName, metav1.DeleteOptions{})
 
-		syncer.cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone1, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		syncer.cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone2, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		syncer.cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone3, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
-		syncer.cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone4, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		syncer.cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone1, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		syncer.cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone2, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		syncer.cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone3, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+		syncer.cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone4, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 
 	}
 }
@@ -2115,7 +2167,7 @@ func TestEnsureNetworkEndpointGroupsMSC(t *testing.T) {
 			negName := syncer.NegSyncerKey.NegName
 
 			for _, zone := range zones {
-				err := fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+				err := fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 					Version:             syncer.NegSyncerKey.GetAPIVersion(),
 					Name:                negName,
 					NetworkEndpointType: string(syncer.NegSyncerKey.NegType),
@@ -2161,7 +2213,7 @@ func TestEnsureNetworkEndpointGroupsMSC(t *testing.T) {
 				}
 			}
 
-			_, err = syncer.ensureNetworkEndpointGroups()
+			_, err = syncer.ensureNetworkEndpointGroups(context.Background())
 
 			if tc.expectError && err == nil {
 				t.Errorf("Got no errors after ensureNetworkEndpointGroupsFromNodeTopology(), expected errors")
@@ -2576,7 +2628,7 @@ func createNEGs(t *testing.T, ts *transactionSyncer, cloud negtypes.NetworkEndpo
 	var negs []*composite.NetworkEndpointGroup
 
 	for zone := range zones {
-		err := cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+		err := cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 			Version:             ts.NegSyncerKey.GetAPIVersion(),
 			Name:                negName,
 			NetworkEndpointType: string(ts.NegSyncerKey.NegType),
@@ -2587,7 +2639,7 @@ func createNEGs(t *testing.T, ts *transactionSyncer, cloud negtypes.NetworkEndpo
 		if err != nil {
 			t.Fatalf("Failed to create NEG %s in zone %s: %v", negName, zone, err)
 		}
-		neg, err := cloud.GetNetworkEndpointGroup(negName, zone, meta.VersionGA, klog.TODO())
+		neg, err := cloud.GetNetworkEndpointGroup(context.Background(), negName, zone, meta.VersionGA, klog.TODO())
 		if err != nil {
 			t.Fatalf("Failed to get NEG %s in zone %s: %v", negName, zone, err)
 		}
@@ -2676,7 +2728,7 @@ func TestUpdateStatus(t *testing.T) {
 				svcNegClient := syncer.statusHandler.(*negstatushandler.TestSvcNegStatusHandler).SvcNEGClient()
 				syncer.needInit = false
 				if len(tc.negRefs) == 0 {
-					err := fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+					err := fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 						Version:             syncer.NegSyncerKey.GetAPIVersion(),
 						Name:                testNegName,
 						NetworkEndpointType: string(syncer.NegSyncerKey.NegType),
@@ -2688,7 +2740,7 @@ func TestUpdateStatus(t *testing.T) {
 						t.Errorf("failed to create test NEG: %s", err)
 					}
 
-					_, err = fakeCloud.GetNetworkEndpointGroup(testNegName, testZone1, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
+					_, err = fakeCloud.GetNetworkEndpointGroup(context.Background(), testNegName, testZone1, syncer.NegSyncerKey.GetAPIVersion(), klog.TODO())
 					if err != nil {
 						t.Errorf("failed to get neg from cloud: %s ", err)
 					}
@@ -3151,9 +3203,9 @@ func TestUnknownNodes(t *testing.T) {
 	// Create initial NetworkEndpointGroups in cloud
 	var objRefs []negv1beta1.NegObjectReference
 	for negLocation, endpoint := range testEndpointMap {
-		fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negLocation.Zone, klog.TODO())
-		fakeCloud.AttachNetworkEndpoints(testNegName, negLocation.Zone, []*composite.NetworkEndpoint{endpoint}, meta.VersionGA, klog.TODO())
-		neg, err := fakeCloud.GetNetworkEndpointGroup(testNegName, negLocation.Zone, meta.VersionGA, klog.TODO())
+		fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negLocation.Zone, klog.TODO())
+		fakeCloud.AttachNetworkEndpoints(context.Background(), testNegName, negLocation.Zone, []*composite.NetworkEndpoint{endpoint}, meta.VersionGA, klog.TODO())
+		neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), testNegName, negLocation.Zone, meta.VersionGA, klog.TODO())
 		if err != nil {
 			t.Fatalf("failed to get neg from fake cloud: %s", err)
 		}
@@ -3193,7 +3245,7 @@ func TestUnknownNodes(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to get subnet to zones map: %v", err)
 	}
-	out, _, _, err := retrieveExistingZoneNetworkEndpointMap(map[string]string{defaultTestSubnet: testNegName}, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
+	out, _, _, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), map[string]string{defaultTestSubnet: testNegName}, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
 	if err != nil {
 		t.Errorf("errored retrieving existing network endpoints")
 	}
@@ -3440,9 +3492,9 @@ func TestEnableDegradedMode(t *testing.T) {
 			// Create initial NetworkEndpointGroups in cloud
 			var objRefs []negv1beta1.NegObjectReference
 			for zone, endpoint := range testEndpointMap {
-				fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA}, zone, klog.TODO())
-				fakeCloud.AttachNetworkEndpoints(tc.negName, zone, []*composite.NetworkEndpoint{endpoint}, meta.VersionGA, klog.TODO())
-				neg, err := fakeCloud.GetNetworkEndpointGroup(tc.negName, zone, meta.VersionGA, klog.TODO())
+				fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA}, zone, klog.TODO())
+				fakeCloud.AttachNetworkEndpoints(context.Background(), tc.negName, zone, []*composite.NetworkEndpoint{endpoint}, meta.VersionGA, klog.TODO())
+				neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), tc.negName, zone, meta.VersionGA, klog.TODO())
 				if err != nil {
 					t.Fatalf("failed to get neg from fake cloud: %s", err)
 				}
@@ -3499,7 +3551,7 @@ func TestEnableDegradedMode(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to get subnet to zones map: %v", err)
 			}
-			out, _, _, err := retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
+			out, _, _, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
 			if err != nil {
 				t.Errorf("errored retrieving existing network endpoints")
 			}
@@ -3516,7 +3568,7 @@ func TestEnableDegradedMode(t *testing.T) {
 				if statusErr != nil {
 					return false, nil
 				}
-				out, _, _, err = retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
+				out, _, _, err = retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
 				if err != nil {
 					return false, nil
 				}
@@ -4182,11 +4234,11 @@ func TestSyncL4NEGs(t *testing.T) {
 			// Create initial NetworkEndpointGroups in cloud
 			var objRefs []negv1beta1.NegObjectReference
 			for negLocation, endpoints := range testEndpointMap {
-				fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: testL4NegName, Version: meta.VersionGA, NetworkEndpointType: "GCE_VM_IP"}, negLocation.Zone, klog.TODO())
+				fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: testL4NegName, Version: meta.VersionGA, NetworkEndpointType: "GCE_VM_IP"}, negLocation.Zone, klog.TODO())
 				if len(endpoints) > 0 {
-					fakeCloud.AttachNetworkEndpoints(testL4NegName, negLocation.Zone, endpoints, meta.VersionGA, klog.TODO())
+					fakeCloud.AttachNetworkEndpoints(context.Background(), testL4NegName, negLocation.Zone, endpoints, meta.VersionGA, klog.TODO())
 				}
-				neg, err := fakeCloud.GetNetworkEndpointGroup(testL4NegName, negLocation.Zone, meta.VersionGA, klog.TODO())
+				neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), testL4NegName, negLocation.Zone, meta.VersionGA, klog.TODO())
 				if err != nil {
 					t.Fatalf("failed to get neg from fake cloud: %s", err)
 				}
@@ -4244,7 +4296,7 @@ func TestSyncL4NEGs(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to get subnet to zones map: %v", err)
 			}
-			out, _, _, err := retrieveExistingZoneNetworkEndpointMap(map[string]string{defaultTestSubnet: testL4NegName}, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
+			out, _, _, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), map[string]string{defaultTestSubnet: testL4NegName}, zoneGetter, ensuredZones, fakeCloud, meta.VersionGA, false, s.networkInfo, klog.TODO(), s.negMetrics, false)
 			if err != nil {
 				t.Errorf("errored retrieving existing network endpoints: %v", err)
 			}
@@ -4257,6 +4309,70 @@ func TestSyncL4NEGs(t *testing.T) {
 
 }
 
+// TestSyncL4NEGsPublishesEndpointWeights verifies that weights are published
+// on every sync, including when the attached endpoints do not change.
+func TestSyncL4NEGsPublishesEndpointWeights(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(test.DefaultTestClusterValues())
+	negtypes.MockNetworkEndpointAPIs(fakeGCE)
+	fakeCloud := negtypes.NewAdapter(fakeGCE, negtypes.NewTestContext().NegMetrics)
+	nodeInformer := zonegetter.FakeNodeInformer()
+	zonegetter.PopulateFakeNodeInformer(nodeInformer, false)
+	testContext := negtypes.NewTestContext()
+	testContext.NodeInformer = nodeInformer
+	_, s, err := newTestTransactionSyncerWithCustomContext(fakeCloud, negtypes.VmIpEndpointType, "", testContext)
+	if err != nil {
+		t.Fatalf("failed to initialize transaction syncer: %v", err)
+	}
+	publisher := &testWeightPublisher{}
+	s.weightPublisher = publisher
+	s.WeightedEndpoints = true
+	s.needInit = true
+	neg := &negv1beta1.ServiceNetworkEndpointGroup{ObjectMeta: metav1.ObjectMeta{Name: testL4NegName, Namespace: testServiceNamespace}}
+	testStatusHandler := s.statusHandler.(*negstatushandler.TestSvcNegStatusHandler)
+	if _, err := testStatusHandler.SvcNEGClient().NetworkingV1beta1().ServiceNetworkEndpointGroups(testServiceNamespace).Create(context.Background(), neg, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create SvcCRD: %v", err)
+	}
+	if err := testStatusHandler.SvcNEGLister().Add(neg); err != nil {
+		t.Fatalf("Failed to add SvcCRD to lister: %v", err)
+	}
+	// mark syncer as started without starting the syncer routine
+	(s.syncer.(*syncer)).stopped = false
+
+	endpointSlices := getDefaultEndpointSlices()
+	for _, eps := range endpointSlices {
+		s.endpointSliceLister.Add(eps)
+	}
+	if err := s.syncInternal(); err != nil {
+		t.Fatalf("syncInternal() returned error: %v", err)
+	}
+	if err := waitForTransactions(s); err != nil {
+		t.Fatalf("waitForTransactions() returned error: %v", err)
+	}
+	if len(publisher.published) != 1 {
+		t.Fatalf("got %d published weights after the first sync, want 1", len(publisher.published))
+	}
+	initialWeight := publisher.published[0][negtypes.TestInstance1]
+
+	// A not ready pod of instance1 becomes ready, instance1 stays in the NEG.
+	ready := true
+	endpointSlices[0].Endpoints[4].Conditions.Ready = &ready
+	if err := s.endpointSliceLister.Update(endpointSlices[0]); err != nil {
+		t.Fatalf("failed to update endpoint slice: %v", err)
+	}
+	if err := s.syncInternal(); err != nil {
+		t.Fatalf("syncInternal() returned error: %v", err)
+	}
+	if keys := s.transactions.Keys(); len(keys) != 0 {
+		t.Errorf("syncInternal() started transactions for %v, want none", keys)
+	}
+	if len(publisher.published) != 2 {
+		t.Fatalf("got %d published weights after the second sync, want 2", len(publisher.published))
+	}
+	if got, want := publisher.published[1][negtypes.TestInstance1], initialWeight+1; got != want {
+		t.Errorf("published weight of %s = %d, want %d", negtypes.TestInstance1, got, want)
+	}
+}
+
 func getUpgradingEndpointSlices(name, namespace string) []*discovery.EndpointSlice {
 	upgradeInstance1 := "upgrade-instance1"
 	port80 := int32(80)
@@ -4524,6 +4640,7 @@ func newCustomTestTransactionSyncer(fakeGCE negtypes.NetworkEndpointGroupCloud,
 		netInfo,
 		negNamer,
 		testContext.NegMetrics,
+		NewNoopWeightPublisher(),
 	)
 	transactionSyncer := negsyncer.(*syncer).core.(*transactionSyncer)
 	indexers := map[string]cache.IndexFunc{
@@ -4608,6 +4725,16 @@ func (r *testRetryHandler) Retry() error {
 func (r *testRetryHandler) Reset() {
 }
 
+// testWeightPublisher records the published weights.
+type testWeightPublisher struct {
+	published []map[string]int64
+}
+
+func (p *testWeightPublisher) PublishWeights(_ string, weights map[string]int64) error {
+	p.published = append(p.published, weights)
+	return nil
+}
+
 // negMeta references a GCE NEG resource
 type negMeta struct {
 	SyncerKey negtypes.NegSyncerKey
@@ -4680,7 +4807,7 @@ func waitForTransactions(syncer *transactionSyncer) error {
 func negObjectReferences(cloud negtypes.NetworkEndpointGroupCloud, state negv1beta1.NegState, zones sets.String, negName string) (map[string]negv1beta1.NegObjectReference, error) {
 	negObjs := make(map[string]negv1beta1.NegObjectReference)
 	for zone := range zones {
-		neg, err := cloud.GetNetworkEndpointGroup(negName, zone, meta.VersionGA, klog.TODO())
+		neg, err := cloud.GetNetworkEndpointGroup(context.Background(), negName, zone, meta.VersionGA, klog.TODO())
 		if err != nil {
 			return nil, err
 		}
@@ -4789,7 +4916,7 @@ func createNegCR(testNegName string, creationTS metav1.Time, populateInitialized
 			Type:               negv1beta1.Initialized,
 			Status:             corev1.ConditionTrue,
 			LastTransitionTime: creationTS,
-			Reason:             negtypes.NegInitializationSuccessful,
+			Reason:             events.ReasonNegInitializationSuccessful,
 		})
 	}
 	if populateSynced {
@@ -4797,7 +4924,7 @@ func createNegCR(testNegName string, creationTS metav1.Time, populateInitialized
 			Type:               negv1beta1.Synced,
 			Status:             corev1.ConditionTrue,
 			LastTransitionTime: creationTS,
-			Reason:             negtypes.NegInitializationSuccessful,
+			Reason:             events.ReasonNegInitializationSuccessful,
 		})
 	}
 
@@ -5055,12 +5182,12 @@ func TestNEGPreprovisioningTransition(t *testing.T) {
 	svcNegLister.Add(svcNeg)
 
 	// Step 1: Sync Usual Annotation (should only create NEG in zone1)
-	_, err = ts.ensureNetworkEndpointGroups()
+	_, err = ts.ensureNetworkEndpointGroups(context.Background())
 	if err != nil {
 		t.Fatalf("ensureNetworkEndpointGroups (usual) failed: %v", err)
 	}
 
-	ret, _ := ts.cloud.AggregatedListNetworkEndpointGroup(ts.NegSyncerKey.GetAPIVersion(), klog.TODO())
+	ret, _ := ts.cloud.AggregatedListNetworkEndpointGroup(context.Background(), ts.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	actualZones := sets.NewString()
 	for key := range ret {
 		actualZones.Insert(key.Zone)
@@ -5070,7 +5197,7 @@ func TestNEGPreprovisioningTransition(t *testing.T) {
 	}
 
 	// Update NEG CR status
-	neg1, err := ts.cloud.GetNetworkEndpointGroup(ts.NegSyncerKey.NegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
+	neg1, err := ts.cloud.GetNetworkEndpointGroup(context.Background(), ts.NegSyncerKey.NegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to get NEG in zone1: %v", err)
 	}
@@ -5089,12 +5216,12 @@ func TestNEGPreprovisioningTransition(t *testing.T) {
 	svc.Annotations[negannotation.NEGAnnotationKey] = `{"exposed_ports":{"80":{}},"zones":["zone2"]}`
 	ts.serviceLister.Update(svc)
 
-	_, err = ts.ensureNetworkEndpointGroups()
+	_, err = ts.ensureNetworkEndpointGroups(context.Background())
 	if err != nil {
 		t.Fatalf("ensureNetworkEndpointGroups (pre-provisioning) failed: %v", err)
 	}
 
-	ret, _ = ts.cloud.AggregatedListNetworkEndpointGroup(ts.NegSyncerKey.GetAPIVersion(), klog.TODO())
+	ret, _ = ts.cloud.AggregatedListNetworkEndpointGroup(context.Background(), ts.NegSyncerKey.GetAPIVersion(), klog.TODO())
 	actualZones = sets.NewString()
 	for key := range ret {
 		actualZones.Insert(key.Zone)
@@ -5104,7 +5231,7 @@ func TestNEGPreprovisioningTransition(t *testing.T) {
 	}
 
 	// Update NEG CR status
-	neg2, err := ts.cloud.GetNetworkEndpointGroup(ts.NegSyncerKey.NegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
+	neg2, err := ts.cloud.GetNetworkEndpointGroup(context.Background(), ts.NegSyncerKey.NegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Fatalf("Failed to get NEG in zone2: %v", err)
 	}
@@ -5125,7 +5252,7 @@ func TestNEGPreprovisioningTransition(t *testing.T) {
 	svc.Annotations[negannotation.NEGAnnotationKey] = `{"exposed_ports":{"80":{}}}`
 	ts.serviceLister.Update(svc)
 
-	_, err = ts.ensureNetworkEndpointGroups()
+	_, err = ts.ensureNetworkEndpointGroups(context.Background())
 	if err != nil {
 		t.Fatalf("ensureNetworkEndpointGroups (transition back) failed: %v", err)
 	}
@@ -5200,16 +5327,16 @@ func TestSyncNEGsPartialFailure(t *testing.T) {
 			fakeCloud := negtypes.NewAdapter(fakeGCE, negtypes.NewTestContext().NegMetrics)
 
 			// Create initial NEGs in GCE (both Zone1 and Zone2)
-			fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA, NetworkEndpointType: tc.gceEpType}, negtypes.TestZone1, klog.TODO())
-			fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA, NetworkEndpointType: tc.gceEpType}, negtypes.TestZone2, klog.TODO())
+			fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA, NetworkEndpointType: tc.gceEpType}, negtypes.TestZone1, klog.TODO())
+			fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: tc.negName, Version: meta.VersionGA, NetworkEndpointType: tc.gceEpType}, negtypes.TestZone2, klog.TODO())
 
 			// Build SvcNEG CR
 			crEpType := negv1beta1.VmIpPortEndpointType
 			if tc.negType == negtypes.VmIpEndpointType {
 				crEpType = negv1beta1.VmIpEndpointType
 			}
-			neg1, _ := fakeCloud.GetNetworkEndpointGroup(tc.negName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
-			neg2, _ := fakeCloud.GetNetworkEndpointGroup(tc.negName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
+			neg1, _ := fakeCloud.GetNetworkEndpointGroup(context.Background(), tc.negName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
+			neg2, _ := fakeCloud.GetNetworkEndpointGroup(context.Background(), tc.negName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
 			objRefs := []negv1beta1.NegObjectReference{
 				{SelfLink: neg1.SelfLink, SubnetURL: defaultTestSubnetURL, NetworkEndpointType: crEpType},
 				{SelfLink: neg2.SelfLink, SubnetURL: defaultTestSubnetURL, NetworkEndpointType: crEpType},
@@ -5291,7 +5418,7 @@ func TestSyncNEGsPartialFailure(t *testing.T) {
 			}
 
 			// Verify that endpoints were attached in Zone1.
-			endpoints, err := s.cloud.ListNetworkEndpoints(tc.negName, negtypes.TestZone1, false, meta.VersionGA, klog.TODO())
+			endpoints, err := s.cloud.ListNetworkEndpoints(context.Background(), tc.negName, negtypes.TestZone1, false, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to list endpoints in zone1: %v", err)
 			}
@@ -5310,7 +5437,7 @@ func TestSyncNEGsPartialFailure(t *testing.T) {
 			// Verify that endpoints in Zone2 remain empty.
 			// Disable hook to allow ListNetworkEndpoints to succeed (it calls Get internally in mock)
 			(fakeGCE.Compute().(*cloud.MockGCE)).MockNetworkEndpointGroups.GetHook = nil
-			endpointsZone2, err := s.cloud.ListNetworkEndpoints(tc.negName, negtypes.TestZone2, false, meta.VersionGA, klog.TODO())
+			endpointsZone2, err := s.cloud.ListNetworkEndpoints(context.Background(), tc.negName, negtypes.TestZone2, false, meta.VersionGA, klog.TODO())
 			if err != nil {
 				t.Fatalf("failed to list endpoints in zone2: %v", err)
 			}
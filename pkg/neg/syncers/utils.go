@@ -17,6 +17,7 @@ limitations under the License.
 package syncers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/neg/metrics"
 	"k8s.io/ingress-gce/pkg/neg/syncers/labels"
@@ -124,9 +126,9 @@ func getService(serviceLister cache.Indexer, namespace, name string, logger klog
 }
 
 // ensureNetworkEndpointGroup ensures corresponding NEG is configured correctly in the specified zone.
-func ensureNetworkEndpointGroup(svcNamespace, svcName, negName, zone, negServicePortName, kubeSystemUID, port string, networkEndpointType negtypes.NetworkEndpointType, cloud negtypes.NetworkEndpointGroupCloud, serviceLister cache.Indexer, recorder record.EventRecorder, version meta.Version, customName, manageLifecycle bool, networkInfo network.NetworkInfo, logger klog.Logger, negMetrics *metrics.NegMetrics) (*composite.NetworkEndpointGroup, error) {
+func ensureNetworkEndpointGroup(ctx context.Context, svcNamespace, svcName, negName, zone, negServicePortName, kubeSystemUID, port string, networkEndpointType negtypes.NetworkEndpointType, cloud negtypes.NetworkEndpointGroupCloud, serviceLister cache.Indexer, recorder record.EventRecorder, version meta.Version, customName, manageLifecycle bool, networkInfo network.NetworkInfo, logger klog.Logger, negMetrics *metrics.NegMetrics) (*composite.NetworkEndpointGroup, error) {
 	negLogger := logger.WithValues("negName", negName, "zone", zone)
-	neg, err := cloud.GetNetworkEndpointGroup(negName, zone, version, logger)
+	neg, err := cloud.GetNetworkEndpointGroup(ctx, negName, zone, version, logger)
 	if err != nil {
 		if !manageLifecycle || !utils.IsNotFoundError(err) {
 			negLogger.Error(err, "Failed to get Neg")
@@ -176,13 +178,13 @@ func ensureNetworkEndpointGroup(svcNamespace, svcName, negName, zone, negService
 
 			needToCreate = true
 			negLogger.Info("NEG does not match network and subnetwork of the cluster. Deleting NEG")
-			err = cloud.DeleteNetworkEndpointGroup(negName, zone, version, logger)
+			err = cloud.DeleteNetworkEndpointGroup(ctx, negName, zone, version, logger)
 			if err != nil {
 				return nil, err
 			}
 			if recorder != nil && serviceLister != nil {
 				if svc := getService(serviceLister, svcNamespace, svcName, logger, negMetrics); svc != nil {
-					recorder.Eventf(svc, apiv1.EventTypeNormal, "Delete", "Deleted NEG %q for %s in %q.", negName, negServicePortName, zone)
+					recorder.Eventf(svc, apiv1.EventTypeNormal, events.ReasonDelete, "Deleted NEG %q for %s in %q.", negName, negServicePortName, zone)
 				}
 			}
 		}
@@ -206,7 +208,7 @@ func ensureNetworkEndpointGroup(svcNamespace, svcName, negName, zone, negService
 		}
 		desc = negDesc.String()
 
-		err = cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+		err = cloud.CreateNetworkEndpointGroup(ctx, &composite.NetworkEndpointGroup{
 			Version:             version,
 			Name:                negName,
 			NetworkEndpointType: string(networkEndpointType),
@@ -219,14 +221,14 @@ func ensureNetworkEndpointGroup(svcNamespace, svcName, negName, zone, negService
 		}
 		if recorder != nil && serviceLister != nil {
 			if svc := getService(serviceLister, svcNamespace, svcName, logger, negMetrics); svc != nil {
-				recorder.Eventf(svc, apiv1.EventTypeNormal, "Create", "Created NEG %q for %s in %q.", negName, negServicePortName, zone)
+				recorder.Eventf(svc, apiv1.EventTypeNormal, events.ReasonCreate, "Created NEG %q for %s in %q.", negName, negServicePortName, zone)
 			}
 		}
 	}
 
 	if neg == nil {
 		var err error
-		neg, err = cloud.GetNetworkEndpointGroup(negName, zone, version, logger)
+		neg, err = cloud.GetNetworkEndpointGroup(ctx, negName, zone, version, logger)
 		if err != nil {
 			negLogger.Error(err, "Error while retrieving NEG after initialization")
 			return nil, err
@@ -699,7 +701,7 @@ func podBelongsToService(pod *apiv1.Pod, service *apiv1.Service) error {
 }
 
 // retrieveExistingZoneNetworkEndpointMap lists existing network endpoints in the neg and return the zone and endpoints map.
-func retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping map[string]string, topologyProvider negtypes.TopologyProvider, ensuredZonesPerSubnet map[string]sets.Set[string], cloud negtypes.NetworkEndpointGroupCloud, version meta.Version, enableDualStackNEG bool, networkInfo network.NetworkInfo, logger klog.Logger, negMetrics *metrics.NegMetrics, retrieveDrainStatus bool) (map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, labels.EndpointPodLabelMap, map[negtypes.NetworkEndpoint]string, error) {
+func retrieveExistingZoneNetworkEndpointMap(ctx context.Context, subnetToNegMapping map[string]string, topologyProvider negtypes.TopologyProvider, ensuredZonesPerSubnet map[string]sets.Set[string], cloud negtypes.NetworkEndpointGroupCloud, version meta.Version, enableDualStackNEG bool, networkInfo network.NetworkInfo, logger klog.Logger, negMetrics *metrics.NegMetrics, retrieveDrainStatus bool) (map[negtypes.NEGLocation]negtypes.NetworkEndpointSet, labels.EndpointPodLabelMap, map[negtypes.NetworkEndpoint]string, error) {
 	zoneNetworkEndpointMap := map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{}
 	endpointPodLabelMap := labels.EndpointPodLabelMap{}
 	drainingEndpoints := make(map[negtypes.NetworkEndpoint]string)
@@ -717,7 +719,7 @@ func retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping map[string]string
 
 		for zone := range allZones {
 			loc := negtypes.NEGLocation{Subnet: subnet, Zone: zone}
-			networkEndpointsWithHealthStatus, err := cloud.ListNetworkEndpoints(negName, zone, retrieveDrainStatus, version, logger)
+			networkEndpointsWithHealthStatus, err := cloud.ListNetworkEndpoints(ctx, negName, zone, retrieveDrainStatus, version, logger)
 			if err != nil {
 				// It is possible for a NEG to be missing in a zone without candidate nodes. Log and ignore this error.
 				// NEG not found in a candidate zone is an error. ensuredZones in fact contains only candidate zones,
@@ -17,6 +17,7 @@ limitations under the License.
 package syncers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -429,6 +430,7 @@ func TestEnsureNetworkEndpointGroup(t *testing.T) {
 				tc.networkInfo.SubnetworkURL = fakeCloud.SubnetworkURL()
 			}
 			_, err := ensureNetworkEndpointGroup(
+				context.Background(),
 				testServiceNameSpace,
 				testServiceName,
 				tc.negName,
@@ -451,7 +453,7 @@ func TestEnsureNetworkEndpointGroup(t *testing.T) {
 				t.Errorf("unexpected error: %s", err)
 			}
 
-			neg, err := fakeCloud.GetNetworkEndpointGroup(tc.negName, testZone, tc.apiVersion, klog.TODO())
+			neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), tc.negName, testZone, tc.apiVersion, klog.TODO())
 			if err != nil {
 				t.Errorf("Failed to retrieve NEG %q: %v", tc.negName, err)
 			}
@@ -489,6 +491,7 @@ func TestEnsureNetworkEndpointGroup(t *testing.T) {
 
 			// Call ensureNetworkEndpointGroup with the same NEG.
 			_, err = ensureNetworkEndpointGroup(
+				context.Background(),
 				testServiceNameSpace,
 				testServiceName,
 				tc.negName,
@@ -874,7 +877,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "neg only exists in one of the zone",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone1, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone1, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			expectErr:          true,
@@ -882,7 +885,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "neg only exists in one of the zone plus irrelevant negs",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: irrelevantNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: irrelevantNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			expectErr:          true,
@@ -890,8 +893,8 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "empty negs exists in all 3 zones",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: testNegName, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			expect: map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
@@ -905,7 +908,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "one empty and two non-empty negs",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(testNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), testNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance1,
 						IpAddress: testIP1,
@@ -932,7 +935,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "one neg with multiple endpoints",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(testNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), testNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance2,
 						IpAddress: testIP2,
@@ -965,7 +968,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "2 negs with multiple endpoints",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(testNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), testNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance3,
 						IpAddress: testIP3,
@@ -1015,7 +1018,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "one neg with uncompressed IPv6 endpoint",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints("ipv6-neg-1", negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), "ipv6-neg-1", negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:    negtypes.TestInstance1,
 						IpAddress:   testIP1,
@@ -1023,8 +1026,8 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 						Port:        testPort,
 					},
 				}, meta.VersionGA, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-1", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-1", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-1", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-1", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 			},
 			subnetToNegMapping: map[string]string{defaultTestSubnet: "ipv6-neg-1"},
 			enableDualStackNEG: true,
@@ -1046,7 +1049,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "one neg with mixed case IPv6 endpoint",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints("ipv6-neg-2", negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), "ipv6-neg-2", negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:    negtypes.TestInstance1,
 						IpAddress:   testIP1,
@@ -1054,8 +1057,8 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 						Port:        testPort,
 					},
 				}, meta.VersionGA, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-2", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-2", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-2", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-2", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 			},
 			subnetToNegMapping: map[string]string{defaultTestSubnet: "ipv6-neg-2"},
 			enableDualStackNEG: true,
@@ -1077,7 +1080,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "one neg with invalid IPv6 endpoint",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints("ipv6-neg-3", negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), "ipv6-neg-3", negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:    negtypes.TestInstance1,
 						IpAddress:   testIP1,
@@ -1085,8 +1088,8 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 						Port:        testPort,
 					},
 				}, meta.VersionGA, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-3", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: "ipv6-neg-3", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-3", Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: "ipv6-neg-3", Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 			},
 			subnetToNegMapping: map[string]string{defaultTestSubnet: "ipv6-neg-3"},
 			enableDualStackNEG: true,
@@ -1108,9 +1111,9 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "no changes to negs in the default subnet, negs in non-default subnet are newly created without any endpoints",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone1, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
-				cloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone1, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone2, klog.TODO())
+				cloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: nonDefaultSubnetNegName, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithAdditionalSubnet,
 			expect: map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
@@ -1146,7 +1149,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "no changes to negs in the default subnet, negs in non-default subnet have newly added endpoints",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(nonDefaultSubnetNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), nonDefaultSubnetNegName, negtypes.TestZone1, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance5,
 						IpAddress: testIP8,
@@ -1156,7 +1159,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 						},
 					},
 				}, meta.VersionGA, klog.TODO())
-				cloud.AttachNetworkEndpoints(nonDefaultSubnetNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), nonDefaultSubnetNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance6,
 						IpAddress: testIP9,
@@ -1211,9 +1214,9 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "negs in the addtional subnet are deleted, and 2 negs in the default subnet with multiple endpoints",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.DeleteNetworkEndpointGroup(nonDefaultSubnetNegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
-				cloud.DeleteNetworkEndpointGroup(nonDefaultSubnetNegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
-				cloud.DeleteNetworkEndpointGroup(nonDefaultSubnetNegName, negtypes.TestZone4, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultSubnetNegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultSubnetNegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), nonDefaultSubnetNegName, negtypes.TestZone4, meta.VersionGA, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			expect: map[negtypes.NEGLocation]negtypes.NetworkEndpointSet{
@@ -1246,7 +1249,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "all 3 negs with multiple endpoints, endpoint6 and endpoint7 with no pod label",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(testNegName, negtypes.TestZone4, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), testNegName, negtypes.TestZone4, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestUpgradeInstance1,
 						IpAddress: testIP6,
@@ -1295,7 +1298,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "irrelevant neg",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.AttachNetworkEndpoints(irrelevantNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), irrelevantNegName, negtypes.TestZone2, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestInstance3,
 						IpAddress: testIP4,
@@ -1340,7 +1343,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 			desc: "non-empty negs in 4 zones, zone3 has no ready nodes, zone4 has upgrading nodes, but all NEGs are returned",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
 				// attach also creates the NEG in the fake implementation.
-				cloud.AttachNetworkEndpoints(testNegName, negtypes.TestZone3, []*composite.NetworkEndpoint{
+				cloud.AttachNetworkEndpoints(context.Background(), testNegName, negtypes.TestZone3, []*composite.NetworkEndpoint{
 					{
 						Instance:  negtypes.TestUnreadyInstance1,
 						IpAddress: testIP5,
@@ -1391,7 +1394,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "NEG does not exist in a zone where endpoints exist(mimics user deleting NEG manually)",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone2, meta.VersionGA, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			ensuredSubnetZones: map[string]sets.Set[string]{
@@ -1402,8 +1405,8 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "limit locations to zone1 only, ignore missing NEG in zone2",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone3, meta.VersionGA, klog.TODO())
-				cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone4, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone3, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone4, meta.VersionGA, klog.TODO())
 			},
 			subnetToNegMapping: mappingWithDefaultSubnetOnly,
 			ensuredSubnetZones: map[string]sets.Set[string]{
@@ -1428,7 +1431,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 		{
 			desc: "empty non-nil locations returns empty map",
 			mutate: func(cloud negtypes.NetworkEndpointGroupCloud) {
-				cloud.DeleteNetworkEndpointGroup(testNegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
+				cloud.DeleteNetworkEndpointGroup(context.Background(), testNegName, negtypes.TestZone1, meta.VersionGA, klog.TODO())
 			},
 			subnetToNegMapping:  mappingWithDefaultSubnetOnly,
 			ensuredSubnetZones:  map[string]sets.Set[string]{},
@@ -1452,7 +1455,7 @@ func TestRetrieveExistingZoneNetworkEndpointMap(t *testing.T) {
 				t.Fatalf("failed to list zones for test case %q: %v", tc.desc, err)
 			}
 		}
-		endpointSets, annotationMap, _, err := retrieveExistingZoneNetworkEndpointMap(tc.subnetToNegMapping, zoneGetter, ensuredSubnetZones, negCloud, meta.VersionGA, tc.enableDualStackNEG, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
+		endpointSets, annotationMap, _, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), tc.subnetToNegMapping, zoneGetter, ensuredSubnetZones, negCloud, meta.VersionGA, tc.enableDualStackNEG, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
 
 		if tc.expectErr {
 			if err == nil {
@@ -1599,7 +1602,7 @@ func TestRetrieveExistingZoneNetworkEndpointMapHealth(t *testing.T) {
 				t.Fatalf("zoneGetter.ListZones() %v", err)
 			}
 			for _, zone := range candidateNodeZones {
-				fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
+				fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
 			}
 
 			// attach endpoints and set their health status
@@ -1610,7 +1613,7 @@ func TestRetrieveExistingZoneNetworkEndpointMapHealth(t *testing.T) {
 						IpAddress: e.ip,
 						Instance:  e.instance,
 					}
-					fakeCloud.AttachNetworkEndpoints(negName, zone, []*composite.NetworkEndpoint{ne}, meta.VersionGA, klog.TODO())
+					fakeCloud.AttachNetworkEndpoints(context.Background(), negName, zone, []*composite.NetworkEndpoint{ne}, meta.VersionGA, klog.TODO())
 					entriesWithHealth = append(entriesWithHealth, negtypes.NetworkEndpointEntry{
 						NetworkEndpoint: ne,
 						Healths: []*composite.HealthStatusForNetworkEndpoint{
@@ -1630,7 +1633,7 @@ func TestRetrieveExistingZoneNetworkEndpointMapHealth(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to list zones: %v", err)
 			}
-			endpointSets, _, drainingEndpoints, err := retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZonesPerSubnet, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), tc.useHealthStatus)
+			endpointSets, _, drainingEndpoints, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZonesPerSubnet, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), tc.useHealthStatus)
 			if err != nil {
 				t.Fatalf("retrieveExistingZoneNetworkEndpointMap: %v", err)
 			}
@@ -1703,7 +1706,7 @@ func TestRetrieveExistingZoneNetworkEndpointMapWithDrainNodes(t *testing.T) {
 
 	// Create NEGs only in zones 1-3 (NOT zone4) to test NotFound suppression.
 	for _, zone := range zonesNoDrain {
-		fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
+		fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: negName, Zone: zone, Version: meta.VersionGA}, zone, klog.TODO())
 	}
 
 	defaultNetInfo := network.NetworkInfo{IsDefault: true, SubnetworkURL: defaultTestSubnetURL}
@@ -1713,7 +1716,7 @@ func TestRetrieveExistingZoneNetworkEndpointMapWithDrainNodes(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to list zones: %v", err)
 	}
-	_, _, _, err = retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZonesNoDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
+	_, _, _, err = retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZonesNoDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
 	if err != nil {
 		t.Errorf("expected no error with includeDrainNodesL4Local=false and missing zone4 NEG, got: %v", err)
 	}
@@ -1726,17 +1729,17 @@ func TestRetrieveExistingZoneNetworkEndpointMapWithDrainNodes(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to list zones: %v", err)
 	}
-	_, _, _, err = retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZonesWithDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
+	_, _, _, err = retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZonesWithDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
 	if err == nil {
 		t.Errorf("expected error with includeDrainNodesL4Local=true and missing zone4 NEG, got nil")
 	}
 
 	// Now create the zone4 NEG and attach an endpoint — the call must succeed.
-	fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{Name: negName, Zone: negtypes.TestZone4, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
+	fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{Name: negName, Zone: negtypes.TestZone4, Version: meta.VersionGA}, negtypes.TestZone4, klog.TODO())
 	drainEndpoint := &composite.NetworkEndpoint{IpAddress: "10.0.4.1", Instance: "upgrade-instance1"}
-	fakeCloud.AttachNetworkEndpoints(negName, negtypes.TestZone4, []*composite.NetworkEndpoint{drainEndpoint}, meta.VersionGA, klog.TODO())
+	fakeCloud.AttachNetworkEndpoints(context.Background(), negName, negtypes.TestZone4, []*composite.NetworkEndpoint{drainEndpoint}, meta.VersionGA, klog.TODO())
 
-	endpointSets, _, _, err := retrieveExistingZoneNetworkEndpointMap(subnetToNegMapping, zoneGetter, ensuredZonesWithDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
+	endpointSets, _, _, err := retrieveExistingZoneNetworkEndpointMap(context.Background(), subnetToNegMapping, zoneGetter, ensuredZonesWithDrain, fakeCloud, meta.VersionGA, false, defaultNetInfo, klog.TODO(), metrics.NewNegMetrics(), false)
 	if err != nil {
 		t.Fatalf("retrieveExistingZoneNetworkEndpointMap(drain=true, NEG exists): %v", err)
 	}
@@ -1880,6 +1883,7 @@ func TestNameUniqueness(t *testing.T) {
 	)
 	fakeCloud := negtypes.NewFakeNetworkEndpointGroupCloud(testSubnetwork, testNetwork)
 	_, err := ensureNetworkEndpointGroup(
+		context.Background(),
 		testServiceNameSpace,
 		testServiceName,
 		negName,
@@ -1902,7 +1906,7 @@ func TestNameUniqueness(t *testing.T) {
 		t.Errorf("Errored while ensuring network endpoint groups: %s", err)
 	}
 
-	neg, err := fakeCloud.GetNetworkEndpointGroup(negName, testZone, apiVersion, klog.TODO())
+	neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), negName, testZone, apiVersion, klog.TODO())
 	if err != nil {
 		t.Errorf("Failed to retrieve NEG %q: %v", negName, err)
 	}
@@ -1913,6 +1917,7 @@ func TestNameUniqueness(t *testing.T) {
 
 	// Call ensureNetworkEndpointGroup with the same NEG name and different service name
 	_, err = ensureNetworkEndpointGroup(
+		context.Background(),
 		testServiceNameSpace,
 		testServiceName2,
 		negName,
@@ -1962,6 +1967,7 @@ func TestNegObjectCrd(t *testing.T) {
 	} {
 		fakeCloud := negtypes.NewFakeNetworkEndpointGroupCloud(testSubnetwork, testNetwork)
 		negObj, err := ensureNetworkEndpointGroup(
+			context.Background(),
 			testServiceNameSpace,
 			testServiceName,
 			negName,
@@ -1983,7 +1989,7 @@ func TestNegObjectCrd(t *testing.T) {
 			t.Errorf("Errored while ensuring network endpoint groups: %s", err)
 		}
 
-		neg, err := fakeCloud.GetNetworkEndpointGroup(negName, testZone, apiVersion, klog.TODO())
+		neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), negName, testZone, apiVersion, klog.TODO())
 		if err != nil {
 			t.Errorf("Failed to retrieve NEG %q: %v", negName, err)
 		}
@@ -1998,6 +2004,7 @@ func TestNegObjectCrd(t *testing.T) {
 
 		// Call ensureNetworkEndpointGroup with the same NEG name and different service name
 		negObj, err = ensureNetworkEndpointGroup(
+			context.Background(),
 			testServiceNameSpace,
 			testServiceName,
 			negName,
@@ -2160,7 +2167,7 @@ func TestNEGRecreate(t *testing.T) {
 		fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
 		negtypes.MockNetworkEndpointAPIs(fakeGCE)
 		fakeCloud := negtypes.NewAdapterWithNetwork(fakeGCE, testNetwork, testSubnetwork, metrics.NewNegMetrics())
-		fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+		fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 			Version:             apiVersion,
 			Name:                negName,
 			NetworkEndpointType: string(tc.negType),
@@ -2171,6 +2178,7 @@ func TestNEGRecreate(t *testing.T) {
 
 		// Ensure with the correct network and subnet
 		_, err := ensureNetworkEndpointGroup(
+			context.Background(),
 			testServiceNameSpace,
 			testServiceName,
 			negName,
@@ -2195,7 +2203,7 @@ func TestNEGRecreate(t *testing.T) {
 			t.Errorf("TestCase: %s, Expected error when ensure network endpoint groups", tc.desc)
 		}
 
-		neg, err := fakeCloud.GetNetworkEndpointGroup(negName, testZone, apiVersion, klog.TODO())
+		neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), negName, testZone, apiVersion, klog.TODO())
 		if err != nil {
 			t.Errorf("TestCase: %s, Failed to retrieve NEG %q: %v", tc.desc, negName, err)
 		}
@@ -2246,6 +2254,7 @@ func TestEnsureNetworkEndpointGroupManageLifecycle(t *testing.T) {
 			// If there is no NEG in location it is expected to be for manageLifecycle=true it should create it
 			// and complete without any errors. Otherwise should return not found error.
 			_, err := ensureNetworkEndpointGroup(
+				context.Background(),
 				testServiceNameSpace,
 				testServiceName,
 				negName,
@@ -2335,7 +2344,7 @@ func TestEnsureNetworkEndpointGroupManageLifecycleNetSubnetMismatch(t *testing.T
 			fakeCloud := negtypes.NewAdapterWithNetwork(fakeGCE, testNetwork, testSubnetwork, metrics.NewNegMetrics())
 
 			// Pre-create NEG with different network/subnetwork
-			fakeCloud.CreateNetworkEndpointGroup(&composite.NetworkEndpointGroup{
+			fakeCloud.CreateNetworkEndpointGroup(context.Background(), &composite.NetworkEndpointGroup{
 				Version:             apiVersion,
 				Name:                negName,
 				NetworkEndpointType: string(negtypes.VmIpPortEndpointType),
@@ -2346,6 +2355,7 @@ func TestEnsureNetworkEndpointGroupManageLifecycleNetSubnetMismatch(t *testing.T
 
 			// Call ensureNetworkEndpointGroup with the correct networkInfo (testNetwork/testSubnetwork)
 			_, err := ensureNetworkEndpointGroup(
+				context.Background(),
 				testServiceNameSpace,
 				testServiceName,
 				negName,
@@ -2381,7 +2391,7 @@ func TestEnsureNetworkEndpointGroupManageLifecycleNetSubnetMismatch(t *testing.T
 			}
 
 			// Retrieve the NEG to check its state
-			neg, err := fakeCloud.GetNetworkEndpointGroup(negName, testZone, apiVersion, klog.TODO())
+			neg, err := fakeCloud.GetNetworkEndpointGroup(context.Background(), negName, testZone, apiVersion, klog.TODO())
 			if err != nil {
 				t.Fatalf("Failed to retrieve NEG: %v", err)
 			}
@@ -17,6 +17,7 @@ limitations under the License.
 package types
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -85,52 +86,52 @@ type cloudProviderAdapter struct {
 }
 
 // GetNetworkEndpointGroup implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) GetNetworkEndpointGroup(name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error) {
+func (a *cloudProviderAdapter) GetNetworkEndpointGroup(ctx context.Context, name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error) {
 	start := time.Now()
-	neg, err := composite.GetNetworkEndpointGroup(a.c, meta.ZonalKey(name, zone), version, logger)
+	neg, err := composite.GetNetworkEndpointGroup(ctx, a.c, meta.ZonalKey(name, zone), version, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.GetRequest, err)
 	return neg, err
 
 }
 
 // ListNetworkEndpointGroup implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) ListNetworkEndpointGroup(zone string, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointGroup, error) {
+func (a *cloudProviderAdapter) ListNetworkEndpointGroup(ctx context.Context, zone string, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointGroup, error) {
 	start := time.Now()
-	negs, err := composite.ListNetworkEndpointGroups(a.c, meta.ZonalKey("", zone), version, logger, filter.None)
+	negs, err := composite.ListNetworkEndpointGroups(ctx, a.c, meta.ZonalKey("", zone), version, logger, filter.None)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.ListRequest, err)
 	return negs, err
 }
 
 // AggregatedListNetworkEndpointGroup returns a map of zone -> endpoint group.
-func (a *cloudProviderAdapter) AggregatedListNetworkEndpointGroup(version meta.Version, logger klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error) {
+func (a *cloudProviderAdapter) AggregatedListNetworkEndpointGroup(ctx context.Context, version meta.Version, logger klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error) {
 	start := time.Now()
 	// TODO: filter for the region the cluster is in.
-	negs, err := composite.AggregatedListNetworkEndpointGroup(a.c, version, logger)
+	negs, err := composite.AggregatedListNetworkEndpointGroup(ctx, a.c, version, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.AggregatedListRequest, err)
 	return negs, err
 }
 
 // CreateNetworkEndpointGroup implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) CreateNetworkEndpointGroup(neg *composite.NetworkEndpointGroup, zone string, logger klog.Logger) error {
+func (a *cloudProviderAdapter) CreateNetworkEndpointGroup(ctx context.Context, neg *composite.NetworkEndpointGroup, zone string, logger klog.Logger) error {
 	start := time.Now()
-	err := composite.CreateNetworkEndpointGroup(a.c, meta.ZonalKey(neg.Name, zone), neg, logger)
+	err := composite.CreateNetworkEndpointGroup(ctx, a.c, meta.ZonalKey(neg.Name, zone), neg, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.CreateRequest, err)
 	return err
 }
 
 // DeleteNetworkEndpointGroup implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) DeleteNetworkEndpointGroup(name string, zone string, version meta.Version, logger klog.Logger) error {
+func (a *cloudProviderAdapter) DeleteNetworkEndpointGroup(ctx context.Context, name string, zone string, version meta.Version, logger klog.Logger) error {
 	start := time.Now()
-	err := composite.DeleteNetworkEndpointGroup(a.c, meta.ZonalKey(name, zone), version, logger)
+	err := composite.DeleteNetworkEndpointGroup(ctx, a.c, meta.ZonalKey(name, zone), version, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.DeleteRequest, err)
 	return err
 }
 
 // AttachNetworkEndpoints implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) AttachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error {
+func (a *cloudProviderAdapter) AttachNetworkEndpoints(ctx context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error {
 	req := &composite.NetworkEndpointGroupsAttachEndpointsRequest{NetworkEndpoints: endpoints}
 	start := time.Now()
-	err := composite.AttachNetworkEndpoints(a.c, meta.ZonalKey(name, zone), version, req, logger)
+	err := composite.AttachNetworkEndpoints(ctx, a.c, meta.ZonalKey(name, zone), version, req, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.AttachNERequest, err)
 	_, strategyUsed := a.strategyKeys[fmt.Sprintf("%s.%s.%s", version, negServiceName, attachNetworkEndpoints)]
 	if utils.IsQuotaExceededError(err) && strategyUsed {
@@ -140,10 +141,10 @@ func (a *cloudProviderAdapter) AttachNetworkEndpoints(name, zone string, endpoin
 }
 
 // DetachNetworkEndpoints implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) DetachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error {
+func (a *cloudProviderAdapter) DetachNetworkEndpoints(ctx context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error {
 	req := &composite.NetworkEndpointGroupsDetachEndpointsRequest{NetworkEndpoints: endpoints}
 	start := time.Now()
-	err := composite.DetachNetworkEndpoints(a.c, meta.ZonalKey(name, zone), version, req, logger)
+	err := composite.DetachNetworkEndpoints(ctx, a.c, meta.ZonalKey(name, zone), version, req, logger)
 	a.negMetrics.PublishGCERequestCountMetrics(start, metrics.DetachNERequest, err)
 	_, strategyUsed := a.strategyKeys[fmt.Sprintf("%s.%s.%s", version, negServiceName, detachNetworkEndpoints)]
 	if utils.IsQuotaExceededError(err) && strategyUsed {
@@ -153,7 +154,7 @@ func (a *cloudProviderAdapter) DetachNetworkEndpoints(name, zone string, endpoin
 }
 
 // ListNetworkEndpoints implements NetworkEndpointGroupCloud.
-func (a *cloudProviderAdapter) ListNetworkEndpoints(name, zone string, showHealthStatus bool, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error) {
+func (a *cloudProviderAdapter) ListNetworkEndpoints(ctx context.Context, name, zone string, showHealthStatus bool, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error) {
 	healthStatus := "SKIP"
 	metricLabel := metrics.ListNERequest
 	if showHealthStatus {
@@ -162,7 +163,7 @@ func (a *cloudProviderAdapter) ListNetworkEndpoints(name, zone string, showHealt
 	}
 	req := &composite.NetworkEndpointGroupsListEndpointsRequest{HealthStatus: healthStatus}
 	start := time.Now()
-	networkEndpoints, err := composite.ListNetworkEndpoints(a.c, meta.ZonalKey(name, zone), version, req, logger)
+	networkEndpoints, err := composite.ListNetworkEndpoints(ctx, a.c, meta.ZonalKey(name, zone), version, req, logger)
 	_, strategyUsed := a.strategyKeys[fmt.Sprintf("%s.%s.%s", version, negServiceName, listNetworkEndpoints)]
 	if utils.IsQuotaExceededError(err) && strategyUsed {
 		err = &StrategyQuotaError{Err: err}
@@ -16,6 +16,7 @@ limitations under the License.
 package types
 
 import (
+	"context"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -46,7 +47,7 @@ func TestAggregatedListNetworkEndpointGroup(t *testing.T) {
 
 	neg := &composite.NetworkEndpointGroup{Name: neg1, Version: meta.VersionGA}
 	zone := zone1
-	if err := fakeCloud.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+	if err := fakeCloud.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 		t.Fatalf("Got CreateNetworkEndpointGroup(%v, %v) = %v, want nil", neg, zone, err)
 	}
 
@@ -54,7 +55,7 @@ func TestAggregatedListNetworkEndpointGroup(t *testing.T) {
 
 	neg = &composite.NetworkEndpointGroup{Name: neg2, Version: meta.VersionGA}
 	zone = zone2
-	if err := fakeCloud.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+	if err := fakeCloud.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 		t.Fatalf("Got CreateNetworkEndpointGroup(%v, %v) = %v, want nil", neg, zone, err)
 	}
 
@@ -62,13 +63,13 @@ func TestAggregatedListNetworkEndpointGroup(t *testing.T) {
 
 	neg = &composite.NetworkEndpointGroup{Name: neg1, Version: meta.VersionGA}
 	zone = zone2
-	if err := fakeCloud.CreateNetworkEndpointGroup(neg, zone, klog.TODO()); err != nil {
+	if err := fakeCloud.CreateNetworkEndpointGroup(context.Background(), neg, zone, klog.TODO()); err != nil {
 		t.Fatalf("Got CreateNetworkEndpointGroup(%v, %v) = %v, want nil", neg, zone, err)
 	}
 
 	validateAggregatedList(t, fakeCloud, 2, map[string][]string{zone1: {neg1}, zone2: {neg1, neg2}})
 
-	if err := fakeCloud.DeleteNetworkEndpointGroup(neg1, zone1, meta.VersionGA, klog.TODO()); err != nil {
+	if err := fakeCloud.DeleteNetworkEndpointGroup(context.Background(), neg1, zone1, meta.VersionGA, klog.TODO()); err != nil {
 		t.Fatalf("Got DeleteNetworkEndpointGroup(%v, %v) = %v, want nil", neg1, zone1, err)
 	}
 
@@ -76,7 +77,7 @@ func TestAggregatedListNetworkEndpointGroup(t *testing.T) {
 }
 
 func validateAggregatedList(t *testing.T, adapter NetworkEndpointGroupCloud, expectZoneNum int, expectZoneNegs map[string][]string) {
-	ret, err := adapter.AggregatedListNetworkEndpointGroup(meta.VersionGA, klog.TODO())
+	ret, err := adapter.AggregatedListNetworkEndpointGroup(context.Background(), meta.VersionGA, klog.TODO())
 	if err != nil {
 		t.Errorf("Expect AggregatedListNetworkEndpointGroup to return nil error, but got %v", err)
 	}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// SyncerDebugInfo is a snapshot of the state of a NEG syncer as of its last
+// sync. It is served by the glbc debug endpoints.
+type SyncerDebugInfo struct {
+	SyncerKey    string    `json:"syncerKey"`
+	NegName      string    `json:"negName"`
+	LastSyncTime time.Time `json:"lastSyncTime"`
+	LastError    string    `json:"lastError,omitempty"`
+	InErrorState bool      `json:"inErrorState"`
+	// TargetEndpoints are the endpoints the syncer wants in its NEGs.
+	TargetEndpoints []LocationEndpoints `json:"targetEndpoints"`
+	// CommittedEndpoints are the target endpoints already attached to the
+	// NEGs and without an ongoing transaction.
+	CommittedEndpoints []LocationEndpoints `json:"committedEndpoints"`
+	// Transactions are the attach and detach operations in flight.
+	Transactions []TransactionDebugInfo `json:"transactions"`
+}
+
+// LocationEndpoints is the set of network endpoints of a single NEGLocation.
+type LocationEndpoints struct {
+	Zone      string            `json:"zone"`
+	Subnet    string            `json:"subnet"`
+	Endpoints []NetworkEndpoint `json:"endpoints"`
+}
+
+// TransactionDebugInfo describes a single in-flight NEG API operation.
+type TransactionDebugInfo struct {
+	Endpoint  NetworkEndpoint `json:"endpoint"`
+	Operation string          `json:"operation"`
+	Zone      string          `json:"zone"`
+	Subnet    string          `json:"subnet"`
+}
+
+// LocationEndpointsFromMap flattens an endpoint map into a list ordered by
+// location and endpoint, suitable for serialization.
+func LocationEndpointsFromMap(endpointMap map[NEGLocation]NetworkEndpointSet) []LocationEndpoints {
+	res := make([]LocationEndpoints, 0, len(endpointMap))
+	for location, endpoints := range endpointMap {
+		list := endpoints.List()
+		SortNetworkEndpoints(list)
+		res = append(res, LocationEndpoints{Zone: location.Zone, Subnet: location.Subnet, Endpoints: list})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Zone != res[j].Zone {
+			return res[i].Zone < res[j].Zone
+		}
+		return res[i].Subnet < res[j].Subnet
+	})
+	return res
+}
+
+// SortNetworkEndpoints sorts endpoints by node, IP, IPv6 address and port.
+func SortNetworkEndpoints(endpoints []NetworkEndpoint) {
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.Node != b.Node {
+			return a.Node < b.Node
+		}
+		if a.IP != b.IP {
+			return a.IP < b.IP
+		}
+		if a.IPv6 != b.IPv6 {
+			return a.IPv6 < b.IPv6
+		}
+		return a.Port < b.Port
+	})
+}
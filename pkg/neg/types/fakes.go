@@ -17,6 +17,7 @@ limitations under the License.
 package types
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -81,7 +82,7 @@ func NewFakeNetworkEndpointGroupCloud(subnetwork, network string) NetworkEndpoin
 	}
 }
 
-func (f *FakeNetworkEndpointGroupCloud) GetNetworkEndpointGroup(name string, zone string, version meta.Version, _ klog.Logger) (*composite.NetworkEndpointGroup, error) {
+func (f *FakeNetworkEndpointGroupCloud) GetNetworkEndpointGroup(_ context.Context, name string, zone string, version meta.Version, _ klog.Logger) (*composite.NetworkEndpointGroup, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	negs, ok := f.NetworkEndpointGroups[zone]
@@ -99,13 +100,13 @@ func networkEndpointKey(name, zone string) string {
 	return fmt.Sprintf("%s-%s", zone, name)
 }
 
-func (f *FakeNetworkEndpointGroupCloud) ListNetworkEndpointGroup(zone string, version meta.Version, _ klog.Logger) ([]*composite.NetworkEndpointGroup, error) {
+func (f *FakeNetworkEndpointGroupCloud) ListNetworkEndpointGroup(_ context.Context, zone string, version meta.Version, _ klog.Logger) ([]*composite.NetworkEndpointGroup, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	return f.NetworkEndpointGroups[zone], nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) AggregatedListNetworkEndpointGroup(version meta.Version, _ klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error) {
+func (f *FakeNetworkEndpointGroupCloud) AggregatedListNetworkEndpointGroup(_ context.Context, version meta.Version, _ klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	result := make(map[*meta.Key]*composite.NetworkEndpointGroup)
@@ -117,7 +118,7 @@ func (f *FakeNetworkEndpointGroupCloud) AggregatedListNetworkEndpointGroup(versi
 	return result, nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) CreateNetworkEndpointGroup(neg *composite.NetworkEndpointGroup, zone string, _ klog.Logger) error {
+func (f *FakeNetworkEndpointGroupCloud) CreateNetworkEndpointGroup(_ context.Context, neg *composite.NetworkEndpointGroup, zone string, _ klog.Logger) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	neg.SelfLink = cloud.NewNetworkEndpointGroupsResourceID("mock-project", zone, neg.Name).SelfLink(meta.VersionAlpha)
@@ -129,7 +130,7 @@ func (f *FakeNetworkEndpointGroupCloud) CreateNetworkEndpointGroup(neg *composit
 	return nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) DeleteNetworkEndpointGroup(name string, zone string, version meta.Version, _ klog.Logger) error {
+func (f *FakeNetworkEndpointGroupCloud) DeleteNetworkEndpointGroup(_ context.Context, name string, zone string, version meta.Version, _ klog.Logger) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	delete(f.NetworkEndpoints, networkEndpointKey(name, zone))
@@ -150,14 +151,14 @@ func (f *FakeNetworkEndpointGroupCloud) DeleteNetworkEndpointGroup(name string,
 	return nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) AttachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, _ klog.Logger) error {
+func (f *FakeNetworkEndpointGroupCloud) AttachNetworkEndpoints(_ context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, _ klog.Logger) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	f.NetworkEndpoints[networkEndpointKey(name, zone)] = append(f.NetworkEndpoints[networkEndpointKey(name, zone)], endpoints...)
 	return nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) DetachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, _ klog.Logger) error {
+func (f *FakeNetworkEndpointGroupCloud) DetachNetworkEndpoints(_ context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, _ klog.Logger) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	newList := []*composite.NetworkEndpoint{}
@@ -178,7 +179,7 @@ func (f *FakeNetworkEndpointGroupCloud) DetachNetworkEndpoints(name, zone string
 	return nil
 }
 
-func (f *FakeNetworkEndpointGroupCloud) ListNetworkEndpoints(name, zone string, showHealthStatus bool, version meta.Version, _ klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error) {
+func (f *FakeNetworkEndpointGroupCloud) ListNetworkEndpoints(_ context.Context, name, zone string, showHealthStatus bool, version meta.Version, _ klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	ret := []*composite.NetworkEndpointWithHealthStatus{}
@@ -17,6 +17,7 @@ limitations under the License.
 package types
 
 import (
+	"context"
 	"time"
 
 	nodetopologyv1 "github.com/GoogleCloudPlatform/gke-networking-api/apis/nodetopology/v1"
@@ -24,6 +25,7 @@ import (
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	compute "google.golang.org/api/compute/v1"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/neg/types/shared"
 	"k8s.io/ingress-gce/pkg/network"
@@ -34,14 +36,14 @@ import (
 
 // NetworkEndpointGroupCloud is an interface for managing gce network endpoint group.
 type NetworkEndpointGroupCloud interface {
-	GetNetworkEndpointGroup(name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error)
-	ListNetworkEndpointGroup(zone string, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointGroup, error)
-	AggregatedListNetworkEndpointGroup(version meta.Version, logger klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error)
-	CreateNetworkEndpointGroup(neg *composite.NetworkEndpointGroup, zone string, logger klog.Logger) error
-	DeleteNetworkEndpointGroup(name string, zone string, version meta.Version, logger klog.Logger) error
-	AttachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error
-	DetachNetworkEndpoints(name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error
-	ListNetworkEndpoints(name, zone string, showHealthStatus bool, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error)
+	GetNetworkEndpointGroup(ctx context.Context, name string, zone string, version meta.Version, logger klog.Logger) (*composite.NetworkEndpointGroup, error)
+	ListNetworkEndpointGroup(ctx context.Context, zone string, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointGroup, error)
+	AggregatedListNetworkEndpointGroup(ctx context.Context, version meta.Version, logger klog.Logger) (map[*meta.Key]*composite.NetworkEndpointGroup, error)
+	CreateNetworkEndpointGroup(ctx context.Context, neg *composite.NetworkEndpointGroup, zone string, logger klog.Logger) error
+	DeleteNetworkEndpointGroup(ctx context.Context, name string, zone string, version meta.Version, logger klog.Logger) error
+	AttachNetworkEndpoints(ctx context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error
+	DetachNetworkEndpoints(ctx context.Context, name, zone string, endpoints []*composite.NetworkEndpoint, version meta.Version, logger klog.Logger) error
+	ListNetworkEndpoints(ctx context.Context, name, zone string, showHealthStatus bool, version meta.Version, logger klog.Logger) ([]*composite.NetworkEndpointWithHealthStatus, error)
 	NetworkURL() string
 	SubnetworkURL() string
 	NetworkProjectID() string
@@ -89,6 +91,15 @@ type NegSyncerManager interface {
 	ShutDown()
 	// SyncAllSyncer signals all syncers to sync. This call is asynchronous.
 	SyncAllSyncers()
+	// DebugInfo returns the debug snapshots of all syncers related to the service.
+	DebugInfo(namespace, name string) []SyncerDebugInfo
+}
+
+// NegSyncerDebugger is implemented by NEG syncers which can report their
+// state on the debug endpoints.
+type NegSyncerDebugger interface {
+	// DebugInfo returns a snapshot of the syncer state. It must not block on an ongoing sync.
+	DebugInfo() SyncerDebugInfo
 }
 
 type NetworkEndpointsCalculator interface {
@@ -126,3 +137,53 @@ type NEGStatusHandler interface {
 	// LastSyncTime returns the last time the NEG syncer synced associated NEGs.
 	LastSyncTime() (time.Time, error)
 }
+
+// EndpointWeightPublisher publishes the weights of the nodes of a GCE_VM_IP NEG
+// to the health check responders of the nodes, which report them to GCE
+// Weighted load balancing.
+type EndpointWeightPublisher interface {
+	// PublishWeights sets the weights of the nodes in weights for the NEG and
+	// clears the weight of all other nodes. A nil map clears all weights.
+	PublishWeights(negName string, weights map[string]int64) error
+}
+
+// ShardOwner tells which Services the NEG controller of this replica is
+// responsible for when the NEG controller is sharded across replicas.
+// Services are assigned to shards by namespace and name.
+type ShardOwner interface {
+	// Owns returns whether this replica syncs the NEGs of the Service.
+	Owns(namespace, name string) bool
+	// OwnsForGC returns whether this replica has owned the Service long
+	// enough for its NEGs to be synced, so that the NEGs of the Service it
+	// does not sync can be garbage collected.
+	OwnsForGC(namespace, name string) bool
+	// Changed receives a value when the Services owned by this replica
+	// change.
+	Changed() <-chan struct{}
+}
+
+// SyncerTracker tells which Services have NEG syncers running.
+type SyncerTracker interface {
+	// ServicesWithRunningSyncers returns the Services with NEG syncers which
+	// are running or still shutting down.
+	ServicesWithRunningSyncers() []types.NamespacedName
+}
+
+// ShardReleaser is implemented by ShardOwners which hand shards over to other
+// replicas. The Lease of a shard is released once the syncers of its Services
+// reported by the SyncerTracker stopped, so that no two replicas sync the
+// same NEGs.
+type ShardReleaser interface {
+	SetSyncerTracker(tracker SyncerTracker)
+}
+
+// NoSharding is the ShardOwner of a NEG controller which is not sharded and
+// owns all the Services.
+type NoSharding struct{}
+
+func (NoSharding) Owns(string, string) bool { return true }
+
+func (NoSharding) OwnsForGC(string, string) bool { return true }
+
+// Changed returns nil as ownership never changes.
+func (NoSharding) Changed() <-chan struct{} { return nil }
@@ -57,15 +57,6 @@ const (
 	// NegCRControllerValue is used as the value for the managed-by label on NEG CRs when enabled.
 	NegCRControllerValue = "neg-controller"
 
-	// NEG CR Condition Reasons
-	NegSyncSuccessful           = "NegSyncSuccessful"
-	NegSyncFailed               = "NegSyncFailed"
-	NegInitializationSuccessful = "NegInitializationSuccessful"
-	NegInitializationFailed     = "NegInitializationFailed"
-
-	// NEG CRD Enabled Garbage Collection Event Reasons
-	NegGCError = "NegCRError"
-
 	// L4LBTypes are used to mark what type of LB the calculator is determinig endpoints for.
 	L4InternalLB = L4LBType("INTERNAL")
 	L4ExternalLB = L4LBType("EXTERNAL")
@@ -140,6 +131,9 @@ type PortInfo struct {
 	NetworkInfo network.NetworkInfo
 	// The type of the L4 LB. For L7 this should be left empty.
 	L4LBType L4LBType
+	// WeightedEndpoints indicates that weights of GCE_VM_IP NEG endpoints should be computed
+	// from EndpointSlice hints and node capacity. Only applicable to L4 NEGs.
+	WeightedEndpoints bool
 }
 
 // PortInfoMapKey is the Key of PortInfoMap
@@ -223,6 +217,7 @@ func (p1 PortInfoMap) Merge(p2 PortInfoMap) error {
 		mergedInfo.EpCalculatorMode = portInfo.EpCalculatorMode
 		mergedInfo.NetworkInfo = portInfo.NetworkInfo
 		mergedInfo.L4LBType = portInfo.L4LBType
+		mergedInfo.WeightedEndpoints = portInfo.WeightedEndpoints
 
 		p1[mapKey] = mergedInfo
 	}
@@ -306,6 +301,10 @@ type NegSyncerKey struct {
 	// flag access — see the GetAPIVersion comment below for the trade-off we
 	// chose not to take.
 	IncludeDrainNodesL4Local bool
+
+	// WeightedEndpoints indicates that the syncer computes weights of GCE_VM_IP endpoints
+	// from EndpointSlice hints and node capacity.
+	WeightedEndpoints bool
 }
 
 func (key NegSyncerKey) String() string {
@@ -313,6 +312,9 @@ func (key NegSyncerKey) String() string {
 	if key.IncludeDrainNodesL4Local {
 		s += "-includeDrainNodesL4Local=true"
 	}
+	if key.WeightedEndpoints {
+		s += "-weightedEndpoints=true"
+	}
 	return s
 }
 
@@ -363,6 +365,9 @@ type AddressData struct {
 	Addresses   []string
 	Ready       bool
 	AddressType discovery.AddressType
+	// ZoneHints lists the zones from the EndpointSlice topology hints of the endpoint.
+	// It is empty when the endpoint has no hints.
+	ZoneHints []string
 }
 
 // Converts API EndpointSlice list to the EndpointsData abstraction.
@@ -390,7 +395,13 @@ func EndpointsDataFromEndpointSlices(slices []*discovery.EndpointSlice) []Endpoi
 				nodeNameFromTopology := ep.DeprecatedTopology[apiv1.LabelHostname]
 				nodeName = &nodeNameFromTopology
 			}
-			addresses = append(addresses, AddressData{TargetRef: ep.TargetRef, NodeName: nodeName, Addresses: ep.Addresses, Ready: ready, AddressType: slice.AddressType})
+			var zoneHints []string
+			if ep.Hints != nil {
+				for _, zone := range ep.Hints.ForZones {
+					zoneHints = append(zoneHints, zone.Name)
+				}
+			}
+			addresses = append(addresses, AddressData{TargetRef: ep.TargetRef, NodeName: nodeName, Addresses: ep.Addresses, Ready: ready, AddressType: slice.AddressType, ZoneHints: zoneHints})
 		}
 		result = append(result, EndpointsData{Meta: &slice.ObjectMeta, Ports: ports, Addresses: addresses})
 	}
@@ -20,16 +20,23 @@ import (
 	context2 "context"
 	"errors"
 	"fmt"
+	"math/big"
+	"net"
 	"net/http"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"go.opentelemetry.io/otel/attribute"
 	ga "google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -38,16 +45,22 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	ingannotations "k8s.io/ingress-gce/pkg/annotations"
 	sav1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	activecontrollermetrics "k8s.io/ingress-gce/pkg/metrics/activecontroller"
+	"k8s.io/ingress-gce/pkg/metrics/synctimeline"
 	"k8s.io/ingress-gce/pkg/psc/metrics"
 	"k8s.io/ingress-gce/pkg/psc/metrics/metricscollector"
+	"k8s.io/ingress-gce/pkg/ratelimit"
 	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/fairqueue"
 	"k8s.io/ingress-gce/pkg/utils/namer"
 	"k8s.io/ingress-gce/pkg/utils/patch"
 	sautils "k8s.io/ingress-gce/pkg/utils/serviceattachment"
@@ -63,10 +76,17 @@ func init() {
 }
 
 const (
-	svcKind = "service"
+	svcKind     = "service"
+	ingressKind = "ingress"
+
+	// acceptManualPreference is the connection preference requiring consumer
+	// connections to be approved
+	acceptManualPreference = "ACCEPT_MANUAL"
+	// pendingConsumerStatus is the status of a consumer endpoint awaiting approval
+	pendingConsumerStatus = "PENDING"
 
 	// SvcAttachmentGCError is the service attachment GC error event reason
-	SvcAttachmentGCError = "ServiceAttachmentGCError"
+	SvcAttachmentGCError = events.ReasonServiceAttachmentGCError
 	// ServiceAttachmentFinalizer used by the psc controller to ensure Service Attachment CRs
 	// are deleted after the corresponding Service Attachments are deleted
 	ServiceAttachmentFinalizerKey = "networking.gke.io/service-attachment-finalizer"
@@ -80,14 +100,16 @@ const (
 
 var (
 	ServiceNotFoundError = errors.New("service not in store")
+	IngressNotFoundError = errors.New("ingress not in store")
 	MismatchedILBIPError = errors.New("Mismatched ILB IP")
 	nonProcessFailures   = []error{
 		ServiceNotFoundError,
+		IngressNotFoundError,
 		MismatchedILBIPError,
 	}
 	// optionalFields lists the fields that require explicit synchronization via ForceSendFields
 	// to ensure that zero values (e.g. false, empty) are correctly propagated to GCE.
-	optionalFields = []string{"ReconcileConnections"}
+	optionalFields = []string{"ReconcileConnections", "DomainNames", "PropagatedConnectionLimit"}
 )
 
 // Controller is a private service connect (psc) controller
@@ -101,8 +123,14 @@ type Controller struct {
 	saNamer             namer.ServiceAttachmentNamer
 	svcAttachmentLister cache.Indexer
 	serviceLister       cache.Indexer
+	ingressLister       cache.Indexer
 	recorder            func(string) record.EventRecorder
 	collector           *metricscollector.PSCMetricsCollector
+	// syncTimeline measures the sync latency of service attachments.
+	syncTimeline *synctimeline.Timeline
+	// ignoredApprovals tracks the ConsumerApprovalIgnored warnings of the
+	// service attachments so that they are emitted only when they change.
+	ignoredApprovals *warningTracker
 
 	hasSynced func() bool
 
@@ -134,11 +162,14 @@ func NewController(ctx *context.ControllerContext, stopCh <-chan struct{}, logge
 		saClient:                      ctx.SAClient,
 		saNamer:                       saNamer,
 		svcAttachmentLister:           ctx.SAInformer.GetIndexer(),
-		svcAttachmentQueue:            workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		svcAttachmentQueue:            newServiceAttachmentQueue(),
 		serviceLister:                 ctx.ServiceInformer.GetIndexer(),
+		ingressLister:                 ctx.IngressInformer.GetIndexer(),
 		hasSynced:                     ctx.HasSynced,
 		recorder:                      ctx.Recorder,
 		collector:                     metricsCollector,
+		syncTimeline:                  synctimeline.PSC,
+		ignoredApprovals:              newWarningTracker(),
 		clusterName:                   flags.F.GKEClusterName,
 		regionalCluster:               ctx.RegionalCluster,
 		readOnlyMode:                  ctx.ReadOnlyMode,
@@ -153,7 +184,10 @@ func NewController(ctx *context.ControllerContext, stopCh <-chan struct{}, logge
 	}
 
 	ctx.SAInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: controller.enqueueServiceAttachment,
+		AddFunc: func(obj interface{}) {
+			controller.observeChange(obj)
+			controller.enqueueServiceAttachment(obj)
+		},
 		UpdateFunc: func(old, cur interface{}) {
 			curSA := cur.(*sav1.ServiceAttachment)
 			oldSA := old.(*sav1.ServiceAttachment)
@@ -161,6 +195,12 @@ func NewController(ctx *context.ControllerContext, stopCh <-chan struct{}, logge
 			if !shouldProcess(oldSA, curSA, logger) {
 				return
 			}
+			if oldSA.ResourceVersion == curSA.ResourceVersion {
+				// Informer resync of an unchanged service attachment.
+				controller.enqueueServiceAttachmentWithClass(cur, fairqueue.ClassResync)
+				return
+			}
+			controller.observeChange(cur)
 			controller.enqueueServiceAttachment(cur)
 		},
 	})
@@ -173,6 +213,22 @@ func NewController(ctx *context.ControllerContext, stopCh <-chan struct{}, logge
 			controller.deleteServiceFromMetrics(service)
 		},
 	})
+
+	// Service Attachments of L7 ILB Ingresses follow the lifecycle of the Ingress.
+	ctx.IngressInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueIngressServiceAttachments,
+		UpdateFunc: func(old, cur interface{}) {
+			oldIng := old.(*networkingv1.Ingress)
+			curIng := cur.(*networkingv1.Ingress)
+			if reflect.DeepEqual(oldIng.Annotations, curIng.Annotations) &&
+				reflect.DeepEqual(oldIng.Status, curIng.Status) &&
+				oldIng.DeletionTimestamp.Equal(curIng.DeletionTimestamp) {
+				return
+			}
+			controller.enqueueIngressServiceAttachments(cur)
+		},
+		DeleteFunc: controller.enqueueIngressServiceAttachments,
+	})
 	return controller
 }
 
@@ -240,19 +296,65 @@ func (c *Controller) handleErr(err error, key interface{}) {
 		c.logger.Info("failed to retrieve service attachment from the store", "serviceKey", key.(string), "err", err)
 	} else if exists {
 		svcAttachment := obj.(*sav1.ServiceAttachment)
-		c.recorder(svcAttachment.Namespace).Event(svcAttachment, v1.EventTypeWarning, "ProcessServiceAttachmentFailed", eventMsg)
+		c.recorder(svcAttachment.Namespace).Event(svcAttachment, v1.EventTypeWarning, events.ReasonProcessServiceAttachmentFailed, eventMsg)
 	}
 	c.svcAttachmentQueue.AddRateLimited(key)
 }
 
 // enqueueServiceAttachment adds the service attachment object to the queue
 func (c *Controller) enqueueServiceAttachment(obj interface{}) {
+	c.enqueueServiceAttachmentWithClass(obj, fairqueue.ClassUser)
+}
+
+// enqueueServiceAttachmentWithClass adds the service attachment object to the
+// queue, the class is used when fair queuing is enabled
+func (c *Controller) enqueueServiceAttachmentWithClass(obj interface{}, class fairqueue.Class) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		c.logger.Error(err, "Failed to generate service attachment key")
 		return
 	}
-	c.svcAttachmentQueue.Add(key)
+	fairqueue.AddWithClass(c.svcAttachmentQueue, key, fairqueue.Tenant(obj), class)
+}
+
+// newServiceAttachmentQueue returns the queue of service attachment keys, a
+// fair queue when --enable-fair-queuing is set
+func newServiceAttachmentQueue() workqueue.RateLimitingInterface {
+	if flags.F.EnableFairQueuing {
+		return fairqueue.New("psc", workqueue.DefaultControllerRateLimiter())
+	}
+	return workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+}
+
+// observeChange records a change of the service attachment object for the
+// sync latency metrics
+func (c *Controller) observeChange(obj interface{}) {
+	if key, err := cache.MetaNamespaceKeyFunc(obj); err == nil {
+		c.syncTimeline.ObserveChange(key)
+	}
+}
+
+// enqueueIngressServiceAttachments adds the service attachments referencing the
+// Ingress to the queue
+func (c *Controller) enqueueIngressServiceAttachments(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	ing, ok := obj.(*networkingv1.Ingress)
+	if !ok {
+		return
+	}
+	saObjs, err := c.svcAttachmentLister.ByIndex(cache.NamespaceIndex, ing.Namespace)
+	if err != nil {
+		c.logger.Error(err, "Failed to list service attachments", "namespace", ing.Namespace)
+		return
+	}
+	for _, obj := range saObjs {
+		sa := obj.(*sav1.ServiceAttachment)
+		if strings.ToLower(sa.Spec.ResourceRef.Kind) == ingressKind && sa.Spec.ResourceRef.Name == ing.Name {
+			c.enqueueServiceAttachment(sa)
+		}
+	}
 }
 
 // addServiceToMetrics adds the metrics collector
@@ -285,9 +387,11 @@ func (c *Controller) processServiceAttachment(key string) error {
 	// Please reuse and set err before returning
 	var err error
 	var unsyncedFieldsVal *string
-	var forceSendFields []string
+	priorityCtx := ratelimit.WithPriority(context2.Background(), ratelimit.SyncPriority(c.syncTimeline.Pending(key)))
+	ctx, span := tracing.Start(priorityCtx, "PSCController.processServiceAttachment", attribute.String("key", key))
 
 	defer func() {
+		tracing.End(span, err)
 		metrics.PublishPSCProcessMetrics(metrics.SyncProcess, filterError(err), start)
 		metrics.PublishLastProcessTimestampMetrics(metrics.SyncProcess)
 		c.collector.SetServiceAttachment(key, metrics.PSCState{InSuccess: err == nil, WithUnsyncedFields: unsyncedFieldsVal != nil && *unsyncedFieldsVal != ""})
@@ -309,8 +413,12 @@ func (c *Controller) processServiceAttachment(key string) error {
 	if !exists {
 		// Allow Garbage Collection to Delete Service Attachment
 		c.logger.V(2).Info("Service attachment does not exist in store. Will be cleaned up by GC", "serviceKey", klog.KRef(namespace, name))
+		c.syncTimeline.Forget(key)
+		c.ignoredApprovals.changed(key, nil)
 		return nil
 	}
+	trace := c.syncTimeline.StartSync(key)
+	defer func() { trace.Done(err) }()
 	c.logger.V(2).Info("Processing Service attachment", "serviceKey", klog.KRef(namespace, name))
 	defer c.logger.V(4).Info("Finished processing service attachment", "serviceKey", klog.KRef(namespace, name))
 
@@ -329,28 +437,114 @@ func (c *Controller) processServiceAttachment(key string) error {
 		return err
 	}
 
-	var frURL string
-	frURL, err = c.getForwardingRule(namespace, updatedCR.Spec.ResourceRef.Name)
-	if err != nil {
-		return fmt.Errorf("failed to find forwarding rule: %w", err)
+	if strings.ToLower(updatedCR.Spec.ResourceRef.Kind) == ingressKind {
+		var released bool
+		if released, err = c.releaseIfIngressDeleted(updatedCR); released || err != nil {
+			return err
+		}
+	}
+
+	var ipFamilies []v1.IPFamily
+	if ipFamilies, err = serviceAttachmentIPFamilies(updatedCR.Spec); err != nil {
+		return err
+	}
+	if err = validateConsumerApprovals(updatedCR.Spec); err != nil {
+		return err
 	}
 
 	var subnetURLs []string
-	subnetURLs, err = c.getSubnetURLs(updatedCR.Spec.NATSubnets)
+	subnetURLs, err = c.getSubnetURLs(updatedCR.Spec.NATSubnets, ipFamilies)
 	if err != nil {
 		return fmt.Errorf("failed to find nat subnets: %w", err)
 	}
 
-	saName := c.saNamer.ServiceAttachment(namespace, name, string(updatedCR.UID))
-	var gceSAKey *meta.Key
-	gceSAKey, err = composite.CreateKey(c.cloud, saName, meta.Regional)
+	if warnings := consumerApprovalWarnings(updatedCR.Spec); c.ignoredApprovals.changed(key, warnings) {
+		for _, warning := range warnings {
+			c.recorder(updatedCR.Namespace).Event(updatedCR, v1.EventTypeWarning, events.ReasonConsumerApprovalIgnored, warning)
+		}
+	}
+
+	if c.EnablePSCReconcileConnections {
+		unsyncedFieldsVal = ptr.To("")
+	}
+
+	gceSAKeys := make(map[v1.IPFamily]*meta.Key)
+	var createdFamilies []v1.IPFamily
+	var unsyncedFields []string
+	for _, ipFamily := range ipFamilies {
+		var frURL string
+		endForwardingRules := trace.Track(synctimeline.PhaseForwardingRules)
+		frURL, err = c.getForwardingRule(namespace, updatedCR.Spec.ResourceRef, ipFamily)
+		endForwardingRules()
+		if err != nil {
+			return fmt.Errorf("failed to find %s forwarding rule: %w", ipFamily, err)
+		}
+
+		saName := c.serviceAttachmentName(updatedCR, ipFamily)
+		var gceSAKey *meta.Key
+		gceSAKey, err = composite.CreateKey(c.cloud, saName, meta.Regional)
+		if err != nil {
+			return fmt.Errorf("failed to create key for GCE Service Attachment: %w", err)
+		}
+		var created bool
+		var saUnsyncedFields []string
+		endServiceAttachment := trace.Track(synctimeline.PhaseServiceAttachment)
+		created, saUnsyncedFields, err = c.ensureGCEServiceAttachment(ctx, updatedCR, gceSAKey, frURL, subnetURLs)
+		endServiceAttachment()
+		if err != nil {
+			return err
+		}
+		gceSAKeys[ipFamily] = gceSAKey
+		if created {
+			createdFamilies = append(createdFamilies, ipFamily)
+		}
+		for _, field := range saUnsyncedFields {
+			if !slice.ContainsString(unsyncedFields, field, nil) {
+				unsyncedFields = append(unsyncedFields, field)
+			}
+		}
+	}
+
+	if c.EnablePSCReconcileConnections {
+		unsyncedFieldsStr := strings.Join(unsyncedFields, ",")
+		unsyncedFieldsVal = &unsyncedFieldsStr
+		for _, field := range unsyncedFields {
+			c.recorder(updatedCR.Namespace).Eventf(updatedCR, v1.EventTypeWarning, events.ReasonUnsyncedField,
+				"Field %q is not specified in the ServiceAttachment CR but has a value in GCE. The controller will not overwrite this field until it is explicitly set in the CR.", field)
+		}
+	}
+
+	endServiceAttachment := trace.Track(synctimeline.PhaseServiceAttachment)
+	err = c.deleteUnusedServiceAttachments(updatedCR, ipFamilies)
+	endServiceAttachment()
 	if err != nil {
-		return fmt.Errorf("failed to create key for GCE Service Attachment: %w", err)
+		return err
+	}
+
+	updatedCR, err = c.updateServiceAttachmentStatus(updatedCR, gceSAKeys, unsyncedFieldsVal)
+	c.logger.V(2).Info("Updated Service Attachment status", "attachmentKey", klog.KRef(updatedCR.Namespace, updatedCR.Name))
+
+	if err == nil {
+		for _, ipFamily := range createdFamilies {
+			saURL := updatedCR.Status.ServiceAttachmentURL
+			if ipFamily == v1.IPv6Protocol {
+				saURL = updatedCR.Status.IPv6ServiceAttachmentURL
+			}
+			c.recorder(svcAttachment.Namespace).Eventf(svcAttachment, v1.EventTypeNormal, events.ReasonServiceAttachmentCreated,
+				"Service Attachment %s was successfully created.", saURL)
+		}
 	}
-	var existingSA *ga.ServiceAttachment
-	existingSA, err = c.cloud.Compute().ServiceAttachments().Get(context2.Background(), gceSAKey)
+
+	return err
+}
+
+// ensureGCEServiceAttachment creates or updates the GCE Service Attachment with the given key
+// targeting the forwarding rule. It returns whether the Service Attachment was created and the
+// fields of an existing Service Attachment that are not synced with the CR.
+func (c *Controller) ensureGCEServiceAttachment(ctx context2.Context, cr *sav1.ServiceAttachment, gceSAKey *meta.Key, frURL string, subnetURLs []string) (bool, []string, error) {
+	existingSA, err := c.cloud.Compute().ServiceAttachments().Get(ctx, gceSAKey)
 	if err != nil && !utils.IsHTTPErrorCode(err, http.StatusNotFound) {
-		return fmt.Errorf("failed querying for GCE Service Attachment: %w", err)
+		return false, nil, fmt.Errorf("failed querying for GCE Service Attachment: %w", err)
 	}
 
 	gceSvcAttachment := &ga.ServiceAttachment{}
@@ -359,80 +553,102 @@ func (c *Controller) processServiceAttachment(key string) error {
 		*gceSvcAttachment = *existingSA
 	}
 
-	desc := sautils.NewServiceAttachmentDesc(updatedCR.Namespace, updatedCR.Name, c.clusterName, c.clusterLoc, c.regionalCluster)
-	gceSvcAttachment.ConnectionPreference = svcAttachment.Spec.ConnectionPreference
-	gceSvcAttachment.Name = saName
+	desc := sautils.NewServiceAttachmentDesc(cr.Namespace, cr.Name, c.clusterName, c.clusterLoc, c.regionalCluster)
+	gceSvcAttachment.ConnectionPreference = cr.Spec.ConnectionPreference
+	gceSvcAttachment.Name = gceSAKey.Name
 	gceSvcAttachment.NatSubnets = subnetURLs
 	gceSvcAttachment.TargetService = frURL
 	gceSvcAttachment.Region = c.cloud.Region()
 	gceSvcAttachment.Description = desc.String()
-	gceSvcAttachment.EnableProxyProtocol = updatedCR.Spec.ProxyProtocol
-	gceSvcAttachment.ConsumerAcceptLists = convertAllowList(updatedCR.Spec)
-	gceSvcAttachment.ConsumerRejectLists = updatedCR.Spec.ConsumerRejectList
+	gceSvcAttachment.EnableProxyProtocol = cr.Spec.ProxyProtocol
+	gceSvcAttachment.ConsumerAcceptLists, gceSvcAttachment.ConsumerRejectLists, _ = convertConsumerLists(cr.Spec)
+	if cr.Spec.DomainNames != nil {
+		gceSvcAttachment.DomainNames = *cr.Spec.DomainNames
+	}
+	if cr.Spec.PropagatedConnectionLimit != nil {
+		gceSvcAttachment.PropagatedConnectionLimit = *cr.Spec.PropagatedConnectionLimit
+	}
 
 	if c.EnablePSCReconcileConnections {
-		gceSvcAttachment.ReconcileConnections = updatedCR.Spec.ReconcileConnections != nil && *updatedCR.Spec.ReconcileConnections
-		unsyncedFieldsVal = ptr.To("")
+		gceSvcAttachment.ReconcileConnections = cr.Spec.ReconcileConnections != nil && *cr.Spec.ReconcileConnections
 	}
 
-	if existingSA != nil {
-		// Most of the validation is left to the GCE Service Attachment API. needsUpdate only checks
-		// to see if the spec has changed and whether an update is necessary.
-		shouldUpdate, err := needsUpdate(existingSA, gceSvcAttachment)
-		if err != nil {
-			return fmt.Errorf("unable to process Service Attachment Update: %w", err)
-		}
-
-		if c.EnablePSCReconcileConnections {
-			unsyncedFields := detectUnsyncedFields(existingSA, updatedCR)
-			unsyncedFieldsStr := strings.Join(unsyncedFields, ",")
-			unsyncedFieldsVal = &unsyncedFieldsStr
-			for _, field := range unsyncedFields {
-				c.recorder(updatedCR.Namespace).Eventf(updatedCR, v1.EventTypeWarning, "UnsyncedField",
-					"Field %q is not specified in the ServiceAttachment CR but has a value in GCE. The controller will not overwrite this field until it is explicitly set in the CR.", field)
-			}
-			forceSendFields = findForceSendFields(unsyncedFields)
-		}
-
-		if shouldUpdate {
-			// In order for the update to be successful, the self link in the target service (same resource
-			// as the forwarding rule) must be exactly the same. needsUpdate throws an error in situations
-			// the forwarding rule/targetservice was changed on the spec. GCE API only accepts updates where the
-			// target service/forwarding rule is the same so to ensure the target service is not changed,
-			// set the target service to match the existing. Otherwise, a mismatch between the target services
-			// is possible because the PSC controller generates the GA version of the selflink, while the GCE API
-			// may use a different version causing the selflink to differ even if the resource is the same.
-			gceSvcAttachment.TargetService = existingSA.TargetService
-
-			// add only synced optional fields to enable sync of empty values to GCE side and avoid
-			// unexpected reset (due to unsync problem)
-			gceSvcAttachment.ForceSendFields = forceSendFields
-
-			c.logger.V(2).Info("Service Attachment CR was updated, it requires an update", "attachmentKey", klog.KRef(updatedCR.Namespace, updatedCR.Name), "attachmentName", saName)
-			if err = c.cloud.Compute().ServiceAttachments().Patch(context2.Background(), gceSAKey, gceSvcAttachment); err != nil {
-				return fmt.Errorf("failed to update GCE Service Attachment: %w", err)
-			}
+	if existingSA == nil {
+		c.logger.V(2).Info("Creating service attachment", "attachmentName", gceSAKey.Name)
+		if err = c.cloud.Compute().ServiceAttachments().Insert(ctx, gceSAKey, gceSvcAttachment); err != nil {
+			return false, nil, fmt.Errorf("failed to create GCE Service Attachment: %w", err)
 		}
+		c.logger.V(2).Info("Created service attachment", "attachmentName", gceSAKey.Name)
+		return true, nil, nil
+	}
 
-		_, err = c.updateServiceAttachmentStatus(updatedCR, gceSAKey, unsyncedFieldsVal)
-		return err
+	// Most of the validation is left to the GCE Service Attachment API. needsUpdate only checks
+	// to see if the spec has changed and whether an update is necessary.
+	shouldUpdate, err := needsUpdate(existingSA, gceSvcAttachment)
+	if err != nil {
+		return false, nil, fmt.Errorf("unable to process Service Attachment Update: %w", err)
 	}
 
-	c.logger.V(2).Info("Creating service attachment", "attachmentName", saName)
-	if err = c.cloud.Compute().ServiceAttachments().Insert(context2.Background(), gceSAKey, gceSvcAttachment); err != nil {
-		return fmt.Errorf("failed to create GCE Service Attachment: %w", err)
+	var unsyncedFields, forceSendFields []string
+	if c.EnablePSCReconcileConnections {
+		unsyncedFields = detectUnsyncedFields(existingSA, cr)
+		forceSendFields = findForceSendFields(unsyncedFields)
+	}
+	// Empty values are omitted from the patch unless they are forced.
+	for _, field := range clearedFields(cr.Spec) {
+		if !slices.Contains(forceSendFields, field) {
+			forceSendFields = append(forceSendFields, field)
+		}
 	}
-	c.logger.V(2).Info("Created service attachment", "attachmentName", saName)
 
-	updatedCR, err = c.updateServiceAttachmentStatus(updatedCR, gceSAKey, unsyncedFieldsVal)
-	c.logger.V(2).Info("Updated Service Attachment status", "attachmentKey", klog.KRef(updatedCR.Namespace, updatedCR.Name))
+	if shouldUpdate {
+		// In order for the update to be successful, the self link in the target service (same resource
+		// as the forwarding rule) must be exactly the same. needsUpdate throws an error in situations
+		// the forwarding rule/targetservice was changed on the spec. GCE API only accepts updates where the
+		// target service/forwarding rule is the same so to ensure the target service is not changed,
+		// set the target service to match the existing. Otherwise, a mismatch between the target services
+		// is possible because the PSC controller generates the GA version of the selflink, while the GCE API
+		// may use a different version causing the selflink to differ even if the resource is the same.
+		gceSvcAttachment.TargetService = existingSA.TargetService
 
-	if err == nil {
-		c.recorder(svcAttachment.Namespace).Eventf(svcAttachment, v1.EventTypeNormal, "ServiceAttachmentCreated",
-			"Service Attachment %s was successfully created.", updatedCR.Status.ServiceAttachmentURL)
+		// add only synced optional fields to enable sync of empty values to GCE side and avoid
+		// unexpected reset (due to unsync problem)
+		gceSvcAttachment.ForceSendFields = forceSendFields
+
+		c.logger.V(2).Info("Service Attachment CR was updated, it requires an update", "attachmentKey", klog.KRef(cr.Namespace, cr.Name), "attachmentName", gceSAKey.Name)
+		if err = c.cloud.Compute().ServiceAttachments().Patch(ctx, gceSAKey, gceSvcAttachment); err != nil {
+			return false, nil, fmt.Errorf("failed to update GCE Service Attachment: %w", err)
+		}
+	}
+	return false, unsyncedFields, nil
+}
+
+// serviceAttachmentName returns the name of the GCE Service Attachment of the CR for the IP family
+func (c *Controller) serviceAttachmentName(cr *sav1.ServiceAttachment, ipFamily v1.IPFamily) string {
+	if ipFamily == v1.IPv6Protocol {
+		return c.saNamer.IPv6ServiceAttachment(cr.Namespace, cr.Name, string(cr.UID))
 	}
+	return c.saNamer.ServiceAttachment(cr.Namespace, cr.Name, string(cr.UID))
+}
 
-	return err
+// deleteUnusedServiceAttachments deletes the GCE Service Attachments recorded in the status
+// of the CR for IP families that are no longer published
+func (c *Controller) deleteUnusedServiceAttachments(cr *sav1.ServiceAttachment, ipFamilies []v1.IPFamily) error {
+	statusURLs := map[v1.IPFamily]string{
+		v1.IPv4Protocol: cr.Status.ServiceAttachmentURL,
+		v1.IPv6Protocol: cr.Status.IPv6ServiceAttachmentURL,
+	}
+	for _, ipFamily := range []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol} {
+		if statusURLs[ipFamily] == "" || slices.Contains(ipFamilies, ipFamily) {
+			continue
+		}
+		saName := c.serviceAttachmentName(cr, ipFamily)
+		c.logger.V(2).Info("Deleting Service Attachment of IP family that is no longer published", "attachmentName", saName, "ipFamily", ipFamily)
+		if err := c.ensureDeleteGCEServiceAttachment(saName); err != nil {
+			return fmt.Errorf("failed to delete %s GCE Service Attachment: %w", ipFamily, err)
+		}
+	}
+	return nil
 }
 
 // garbageCollectServiceAttachments queries for all Service Attachments CR that have been marked
@@ -496,6 +712,18 @@ func (c *Controller) deleteServiceAttachment(sa *sav1.ServiceAttachment) {
 	}
 	c.logger.V(2).Info("Deleted Service Attachment", "attachmentName", gceName)
 
+	if sa.Status.IPv6ServiceAttachmentURL != "" || slices.Contains(sa.Spec.IPFamilies, v1.IPv6Protocol) {
+		ipv6Name := c.saNamer.IPv6ServiceAttachment(sa.Namespace, sa.Name, string(sa.UID))
+		c.logger.V(2).Info("Deleting IPv6 Service Attachment", "attachmentName", ipv6Name)
+		if err = c.ensureDeleteGCEServiceAttachment(ipv6Name); err != nil {
+			eventMsg := fmt.Sprintf("Failed to Garbage Collect IPv6 Service Attachment %s/%s: %q", sa.Namespace, sa.Name, err)
+			c.logger.Error(err, eventMsg)
+			c.recorder(sa.Namespace).Event(sa, v1.EventTypeWarning, SvcAttachmentGCError, eventMsg)
+			return
+		}
+		c.logger.V(2).Info("Deleted IPv6 Service Attachment", "attachmentName", ipv6Name)
+	}
+
 	c.logger.V(2).Info("Removing finalizer on Service Attachment", "attachmentName", klog.KRef(sa.Namespace, sa.Name))
 	if err = c.ensureSAFinalizerRemoved(sa); err != nil {
 		eventMsg := fmt.Sprintf("Failed to remove finalizer on ServiceAttachment %s/%s: %q", sa.Namespace, sa.Name, err)
@@ -506,10 +734,25 @@ func (c *Controller) deleteServiceAttachment(sa *sav1.ServiceAttachment) {
 	c.logger.V(2).Info("Removed finalizer on Service Attachment", "attachmentName", klog.KRef(sa.Namespace, sa.Name))
 }
 
-// getForwardingRule returns the URL of the forwarding rule based by using the service resource
+// getForwardingRule returns the URL of the forwarding rule of the given IP family of the
+// Service or Ingress referenced by the service attachment.
+func (c *Controller) getForwardingRule(namespace string, ref v1.TypedLocalObjectReference, ipFamily v1.IPFamily) (string, error) {
+	if strings.ToLower(ref.Kind) == ingressKind {
+		if ipFamily != v1.IPv4Protocol {
+			return "", fmt.Errorf("IP family %s is not supported for Ingress %s/%s", ipFamily, namespace, ref.Name)
+		}
+		return c.getIngressForwardingRule(namespace, ref.Name)
+	}
+	if ipFamily == v1.IPv6Protocol {
+		return c.getServiceIPv6ForwardingRule(namespace, ref.Name)
+	}
+	return c.getServiceForwardingRule(namespace, ref.Name)
+}
+
+// getServiceForwardingRule returns the URL of the forwarding rule based by using the service resource
 // and querying GCE. On ILB subsetting services, the forwarding rule annotation is used to find
 // the forwarding rule name. Otherwise the name is generated based on the service resource.
-func (c *Controller) getForwardingRule(namespace, svcName string) (string, error) {
+func (c *Controller) getServiceForwardingRule(namespace, svcName string) (string, error) {
 
 	svcKey := fmt.Sprintf("%s/%s", namespace, svcName)
 	obj, exists, err := c.serviceLister.GetByKey(svcKey)
@@ -555,8 +798,128 @@ func (c *Controller) getForwardingRule(namespace, svcName string) (string, error
 	return "", fmt.Errorf("forwarding rule does not have matching IPAddr to specified service: %w", MismatchedILBIPError)
 }
 
-// getSubnetURLs will query GCE and gather all the URLs of the provided subnet names
-func (c *Controller) getSubnetURLs(subnets []string) ([]string, error) {
+// getServiceIPv6ForwardingRule returns the URL of the IPv6 forwarding rule of a dual-stack or
+// IPv6 service. The forwarding rule name is taken from the IPv6 forwarding rule annotations,
+// which exist on every L4 ILB service with an IPv6 forwarding rule.
+func (c *Controller) getServiceIPv6ForwardingRule(namespace, svcName string) (string, error) {
+	obj, exists, err := c.serviceLister.GetByKey(fmt.Sprintf("%s/%s", namespace, svcName))
+	if err != nil {
+		return "", fmt.Errorf("errored getting service %s/%s: %w", namespace, svcName, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("failed to get Service %s/%s: %w", namespace, svcName, ServiceNotFoundError)
+	}
+	svc := obj.(*v1.Service)
+
+	frName, ok := svc.Annotations[annotations.TCPForwardingRuleIPv6Key]
+	if !ok {
+		if frName, ok = svc.Annotations[annotations.UDPForwardingRuleIPv6Key]; !ok {
+			return "", fmt.Errorf("Service %s/%s has no IPv6 forwarding rule", namespace, svcName)
+		}
+	}
+	fwdRule, err := c.cloud.Compute().ForwardingRules().Get(context2.Background(), meta.RegionalKey(frName, c.cloud.Region()))
+	if err != nil {
+		return "", fmt.Errorf("failed to get Forwarding Rule %s: %w", frName, err)
+	}
+
+	// IPv6 forwarding rules hold an address range, e.g. fd20::/96, while Service.Status
+	// holds the first address of the range.
+	frIP := net.ParseIP(strings.Split(fwdRule.IPAddress, "/")[0])
+	for _, ing := range svc.Status.LoadBalancer.Ingress {
+		if frIP != nil && frIP.Equal(net.ParseIP(ing.IP)) {
+			c.logger.V(2).Info("verified IPv6 forwarding rule has matching ip to service", "forwardingRuleName", frName, "serviceKey", klog.KRef(svc.Namespace, svc.Name))
+			return fwdRule.SelfLink, nil
+		}
+	}
+	return "", fmt.Errorf("IPv6 forwarding rule does not have matching IPAddr to specified service: %w", MismatchedILBIPError)
+}
+
+// getIngressForwardingRule returns the URL of the L7 ILB forwarding rule of the Ingress.
+// The HTTPS forwarding rule recorded in the Ingress status annotations is preferred over
+// the HTTP one.
+func (c *Controller) getIngressForwardingRule(namespace, ingName string) (string, error) {
+	ing, exists, err := c.getIngress(namespace, ingName)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("failed to get Ingress %s/%s: %w", namespace, ingName, IngressNotFoundError)
+	}
+	if !utils.IsGCEL7ILBIngress(ing) {
+		return "", fmt.Errorf("Ingress %s/%s is not an internal Ingress of class %q", namespace, ingName, ingannotations.GceL7ILBIngressClass)
+	}
+
+	frName, ok := ing.Annotations[ingannotations.HttpsForwardingRuleKey]
+	if !ok {
+		if frName, ok = ing.Annotations[ingannotations.HttpForwardingRuleKey]; !ok {
+			return "", fmt.Errorf("Ingress %s/%s has no forwarding rule yet", namespace, ingName)
+		}
+	}
+	fwdRule, err := c.cloud.Compute().ForwardingRules().Get(context2.Background(), meta.RegionalKey(frName, c.cloud.Region()))
+	if err != nil {
+		return "", fmt.Errorf("failed to get Forwarding Rule %s: %w", frName, err)
+	}
+
+	// Verify that the forwarding rule found has the IP expected in Ingress.Status
+	for _, lbIng := range ing.Status.LoadBalancer.Ingress {
+		if lbIng.IP == fwdRule.IPAddress {
+			c.logger.V(2).Info("verified forwarding rule has matching ip to ingress", "forwardingRuleName", frName, "ingressKey", klog.KRef(ing.Namespace, ing.Name))
+			return fwdRule.SelfLink, nil
+		}
+	}
+	return "", fmt.Errorf("forwarding rule does not have matching IPAddr to specified ingress: %w", MismatchedILBIPError)
+}
+
+// getIngress returns the Ingress from the store.
+func (c *Controller) getIngress(namespace, ingName string) (*networkingv1.Ingress, bool, error) {
+	obj, exists, err := c.ingressLister.GetByKey(fmt.Sprintf("%s/%s", namespace, ingName))
+	if err != nil {
+		return nil, false, fmt.Errorf("errored getting ingress %s/%s: %w", namespace, ingName, err)
+	}
+	if !exists {
+		return nil, false, nil
+	}
+	return obj.(*networkingv1.Ingress), true, nil
+}
+
+// releaseIfIngressDeleted deletes the GCE Service Attachment of a CR referencing an
+// Ingress that was deleted or is being deleted, so that the Ingress forwarding rule
+// can be removed. The status of the CR is cleared and true is returned if the Service
+// Attachment was released. The CR is kept, so the Service Attachment is recreated
+// once the Ingress is recreated.
+func (c *Controller) releaseIfIngressDeleted(cr *sav1.ServiceAttachment) (bool, error) {
+	ing, exists, err := c.getIngress(cr.Namespace, cr.Spec.ResourceRef.Name)
+	if err != nil {
+		return false, err
+	}
+	if exists && ing.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	if cr.Status.ServiceAttachmentURL == "" {
+		return false, fmt.Errorf("failed to get Ingress %s/%s: %w", cr.Namespace, cr.Spec.ResourceRef.Name, IngressNotFoundError)
+	}
+
+	for _, ipFamily := range []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol} {
+		gceName := c.serviceAttachmentName(cr, ipFamily)
+		c.logger.V(2).Info("Ingress was deleted, deleting Service Attachment", "ingressKey", klog.KRef(cr.Namespace, cr.Spec.ResourceRef.Name), "attachmentName", gceName)
+		if err := c.ensureDeleteGCEServiceAttachment(gceName); err != nil {
+			return false, fmt.Errorf("failed to delete GCE Service Attachment of deleted Ingress: %w", err)
+		}
+	}
+
+	updatedCR := cr.DeepCopy()
+	updatedCR.Status = sav1.ServiceAttachmentStatus{LastModifiedTimestamp: metav1.Now()}
+	if _, err := c.patchServiceAttachment(cr, updatedCR); err != nil {
+		return false, err
+	}
+	c.recorder(cr.Namespace).Eventf(cr, v1.EventTypeNormal, events.ReasonServiceAttachmentReleased,
+		"Service Attachment %s was deleted because Ingress %s/%s was deleted.", cr.Status.ServiceAttachmentURL, cr.Namespace, cr.Spec.ResourceRef.Name)
+	return true, nil
+}
+
+// getSubnetURLs will query GCE and gather all the URLs of the provided subnet names. Subnets
+// are validated to support every IP family of the service attachment.
+func (c *Controller) getSubnetURLs(subnets []string, ipFamilies []v1.IPFamily) ([]string, error) {
 	var subnetURLs []string
 	for _, subnetName := range subnets {
 		// For shared vpc cases, users must specify full resource path of the subnet
@@ -569,33 +932,64 @@ func (c *Controller) getSubnetURLs(subnets []string) ([]string, error) {
 		if err != nil {
 			return subnetURLs, fmt.Errorf("failed to find Subnetwork %s/%s: %w", c.cloud.Region(), subnetName, err)
 		}
+		if err = validateSubnetIPFamilies(subnet, ipFamilies); err != nil {
+			return subnetURLs, err
+		}
 		subnetURLs = append(subnetURLs, subnet.SelfLink)
 
 	}
 	return subnetURLs, nil
 }
 
-// updateServiceAttachmentStatus updates the CR's annotation and status with the GCE Service Attachment URL
-// and the producer forwarding rule
-func (c *Controller) updateServiceAttachmentStatus(cr *sav1.ServiceAttachment, gceSAKey *meta.Key, unsyncedFieldsVal *string) (*sav1.ServiceAttachment, error) {
-	gceSA, err := c.cloud.Compute().ServiceAttachments().Get(context2.Background(), gceSAKey)
-	if err != nil {
-		return cr, fmt.Errorf("failed to query GCE Service Attachment for key %+v: %w", gceSAKey, err)
-	}
-
+// updateServiceAttachmentStatus updates the CR's annotation and status with the GCE Service Attachment URLs
+// and the producer forwarding rules of every published IP family
+func (c *Controller) updateServiceAttachmentStatus(cr *sav1.ServiceAttachment, gceSAKeys map[v1.IPFamily]*meta.Key, unsyncedFieldsVal *string) (*sav1.ServiceAttachment, error) {
 	updatedSA := cr.DeepCopy()
-	updatedSA.Status.ServiceAttachmentURL = gceSA.SelfLink
-	updatedSA.Status.ForwardingRuleURL = gceSA.TargetService
+	updatedSA.Status.ServiceAttachmentURL = ""
+	updatedSA.Status.ForwardingRuleURL = ""
+	updatedSA.Status.IPv6ServiceAttachmentURL = ""
+	updatedSA.Status.IPv6ForwardingRuleURL = ""
+	updatedSA.Status.PSCServiceAttachmentID = ""
+	updatedSA.Status.IPv6PSCServiceAttachmentID = ""
 
 	var consumers []sav1.ConsumerForwardingRule
-	for _, c := range gceSA.ConnectedEndpoints {
-		consumers = append(consumers, sav1.ConsumerForwardingRule{
-			ForwardingRuleURL: c.Endpoint,
-			Status:            c.Status,
-		})
+	for _, ipFamily := range []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol} {
+		gceSAKey, ok := gceSAKeys[ipFamily]
+		if !ok {
+			continue
+		}
+		gceSA, err := c.cloud.Compute().ServiceAttachments().Get(context2.Background(), gceSAKey)
+		if err != nil {
+			return cr, fmt.Errorf("failed to query GCE Service Attachment for key %+v: %w", gceSAKey, err)
+		}
+
+		if ipFamily == v1.IPv6Protocol {
+			updatedSA.Status.IPv6ServiceAttachmentURL = gceSA.SelfLink
+			updatedSA.Status.IPv6ForwardingRuleURL = gceSA.TargetService
+			updatedSA.Status.IPv6PSCServiceAttachmentID = pscServiceAttachmentID(gceSA)
+		} else {
+			updatedSA.Status.ServiceAttachmentURL = gceSA.SelfLink
+			updatedSA.Status.ForwardingRuleURL = gceSA.TargetService
+			updatedSA.Status.PSCServiceAttachmentID = pscServiceAttachmentID(gceSA)
+		}
+
+		for _, endpoint := range gceSA.ConnectedEndpoints {
+			consumer := sav1.ConsumerForwardingRule{
+				ForwardingRuleURL: endpoint.Endpoint,
+				Status:            endpoint.Status,
+			}
+			if resourceID, err := cloud.ParseResourceURL(endpoint.Endpoint); err == nil {
+				consumer.Project = resourceID.ProjectID
+			}
+			if endpoint.PscConnectionId != 0 {
+				consumer.PSCConnectionID = strconv.FormatUint(endpoint.PscConnectionId, 10)
+			}
+			consumers = append(consumers, consumer)
+		}
 	}
 
 	updatedSA.Status.ConsumerForwardingRules = consumers
+	c.recordPendingConsumers(cr, consumers)
 
 	if unsyncedFieldsVal != nil {
 		// init annotation if missing
@@ -621,6 +1015,24 @@ func (c *Controller) updateServiceAttachmentStatus(cr *sav1.ServiceAttachment, g
 	return c.patchServiceAttachment(cr, updatedSA)
 }
 
+// recordPendingConsumers raises an event for every consumer forwarding rule that
+// started waiting for approval since the last status update
+func (c *Controller) recordPendingConsumers(cr *sav1.ServiceAttachment, consumers []sav1.ConsumerForwardingRule) {
+	wasPending := make(map[string]bool)
+	for _, consumer := range cr.Status.ConsumerForwardingRules {
+		if consumer.Status == pendingConsumerStatus {
+			wasPending[consumer.ForwardingRuleURL] = true
+		}
+	}
+	for _, consumer := range consumers {
+		if consumer.Status != pendingConsumerStatus || wasPending[consumer.ForwardingRuleURL] {
+			continue
+		}
+		c.recorder(cr.Namespace).Eventf(cr, v1.EventTypeNormal, events.ReasonConsumerConnectionPending,
+			"Consumer forwarding rule %s of project %q (PSC connection id %s) is pending approval.", consumer.ForwardingRuleURL, consumer.Project, consumer.PSCConnectionID)
+	}
+}
+
 // patchServiceAttachment patches the originalSA CR to the desired updatedSA CR
 func (c *Controller) patchServiceAttachment(originalSA, updatedSA *sav1.ServiceAttachment) (*sav1.ServiceAttachment, error) {
 	patchBytes, err := patch.MergePatchBytes(originalSA, updatedSA)
@@ -638,7 +1050,9 @@ func (c *Controller) ensureDeleteGCEServiceAttachment(name string) error {
 	if err != nil {
 		return fmt.Errorf("failed to create key for service attachment %q", name)
 	}
-	_, err = c.cloud.Compute().ServiceAttachments().Get(context2.Background(), saKey)
+	// Service attachments are garbage collected once users delete them.
+	ctx := ratelimit.WithPriority(context2.Background(), ratelimit.PriorityHigh)
+	_, err = c.cloud.Compute().ServiceAttachments().Get(ctx, saKey)
 	if err != nil {
 		if utils.IsHTTPErrorCode(err, http.StatusNotFound) || utils.IsHTTPErrorCode(err, http.StatusBadRequest) {
 			return nil
@@ -646,7 +1060,7 @@ func (c *Controller) ensureDeleteGCEServiceAttachment(name string) error {
 		return fmt.Errorf("failed querying for service attachment %q: %w", name, err)
 	}
 
-	return c.cloud.Compute().ServiceAttachments().Delete(context2.Background(), saKey)
+	return c.cloud.Compute().ServiceAttachments().Delete(ctx, saKey)
 }
 
 // ensureSAFinalizer ensures that the Service Attachment finalizer exists on the provided
@@ -679,14 +1093,21 @@ func (c *Controller) ensureSAFinalizerRemoved(cr *sav1.ServiceAttachment) error
 }
 
 // validateResourceReference will validate that the provided resource reference is
-// for a K8s Service
+// for a K8s Service or Ingress
 func validateResourceReference(ref v1.TypedLocalObjectReference) error {
+	if strings.ToLower(ref.Kind) == ingressKind {
+		if ref.APIGroup != nil && *ref.APIGroup != "" && *ref.APIGroup != networkingv1.GroupName {
+			return fmt.Errorf("invalid resource reference: %s, apiGroup must be empty, nil or %q", *ref.APIGroup, networkingv1.GroupName)
+		}
+		return nil
+	}
+
 	if ref.APIGroup != nil && *ref.APIGroup != "" {
 		return fmt.Errorf("invalid resource reference: %s, apiGroup must be empty or nil", *ref.APIGroup)
 	}
 
 	if strings.ToLower(ref.Kind) != svcKind {
-		return fmt.Errorf("invalid resource reference %s, kind must be %q", ref.Kind, svcKind)
+		return fmt.Errorf("invalid resource reference %s, kind must be %q or %q", ref.Kind, svcKind, ingressKind)
 	}
 	return nil
 }
@@ -756,6 +1177,10 @@ func needsUpdate(existingSA, desiredSA *ga.ServiceAttachment) (bool, error) {
 	if len(desiredCopy.ConsumerRejectLists) == 0 {
 		desiredCopy.ConsumerRejectLists = nil
 	}
+	// Cleared domain names are an empty list, which is the same as no domain names
+	if len(desiredCopy.DomainNames) == 0 && len(existingSA.DomainNames) == 0 {
+		desiredCopy.DomainNames = existingSA.DomainNames
+	}
 	return !reflect.DeepEqual(desiredCopy, existingSA), nil
 }
 
@@ -801,6 +1226,142 @@ func convertAllowList(spec sav1.ServiceAttachmentSpec) []*ga.ServiceAttachmentCo
 	return acceptList
 }
 
+// validateConsumerApprovals validates the consumer approvals of the spec
+func validateConsumerApprovals(spec sav1.ServiceAttachmentSpec) error {
+	for _, approval := range spec.ConsumerApprovals {
+		if approval.Project == "" {
+			return fmt.Errorf("invalid consumer approval: project must be set")
+		}
+		if approval.Decision != sav1.ConsumerApprovalAccept && approval.Decision != sav1.ConsumerApprovalReject {
+			return fmt.Errorf("invalid consumer approval for project %q: decision must be %q or %q", approval.Project, sav1.ConsumerApprovalAccept, sav1.ConsumerApprovalReject)
+		}
+	}
+	return nil
+}
+
+// consumerApprovalWarnings returns the warnings about the consumer approvals of the spec
+// which are not applied.
+func consumerApprovalWarnings(spec sav1.ServiceAttachmentSpec) []string {
+	var warnings []string
+	_, _, ignoredApprovals := convertConsumerLists(spec)
+	for _, project := range ignoredApprovals {
+		warnings = append(warnings, fmt.Sprintf("Approval for consumer project %q is ignored because the project is already in the consumerAllowList or consumerRejectList.", project))
+	}
+	if len(spec.ConsumerApprovals) > 0 && spec.ConnectionPreference != acceptManualPreference {
+		warnings = append(warnings, fmt.Sprintf("Consumer approvals are only applied with connection preference %s.", acceptManualPreference))
+	}
+	return warnings
+}
+
+// warningTracker records the last warnings of each service attachment.
+type warningTracker struct {
+	lock     sync.Mutex
+	warnings map[string][]string
+}
+
+func newWarningTracker() *warningTracker {
+	return &warningTracker{warnings: make(map[string][]string)}
+}
+
+// changed records the warnings of the service attachment and returns true if
+// they differ from the previously recorded ones. No warnings forget the key.
+func (wt *warningTracker) changed(key string, warnings []string) bool {
+	wt.lock.Lock()
+	defer wt.lock.Unlock()
+	if slices.Equal(wt.warnings[key], warnings) {
+		return false
+	}
+	if len(warnings) == 0 {
+		delete(wt.warnings, key)
+	} else {
+		wt.warnings[key] = warnings
+	}
+	return true
+}
+
+// convertConsumerLists returns the consumer accept and reject lists of the spec.
+// With the ACCEPT_MANUAL connection preference, the consumer approvals are appended
+// to the lists. Approvals of projects already listed are ignored and returned.
+func convertConsumerLists(spec sav1.ServiceAttachmentSpec) ([]*ga.ServiceAttachmentConsumerProjectLimit, []string, []string) {
+	acceptList := convertAllowList(spec)
+	rejectList := spec.ConsumerRejectList
+	if spec.ConnectionPreference != acceptManualPreference || len(spec.ConsumerApprovals) == 0 {
+		return acceptList, rejectList, nil
+	}
+
+	listed := make(map[string]bool)
+	for _, consumer := range spec.ConsumerAllowList {
+		listed[consumer.Project] = true
+	}
+	for _, project := range spec.ConsumerRejectList {
+		listed[project] = true
+	}
+	// Copy the reject list to not modify the spec of the CR.
+	rejectList = append([]string(nil), rejectList...)
+	var ignored []string
+	for _, approval := range spec.ConsumerApprovals {
+		if listed[approval.Project] {
+			ignored = append(ignored, approval.Project)
+			continue
+		}
+		listed[approval.Project] = true
+		if approval.Decision == sav1.ConsumerApprovalAccept {
+			acceptList = append(acceptList, &ga.ServiceAttachmentConsumerProjectLimit{
+				ConnectionLimit: approval.ConnectionLimit,
+				ProjectIdOrNum:  approval.Project,
+			})
+		} else {
+			rejectList = append(rejectList, approval.Project)
+		}
+	}
+	return acceptList, rejectList, ignored
+}
+
+// serviceAttachmentIPFamilies returns the IP families of the forwarding rules published by
+// the service attachment. Only IPv4 is published if no IP family is specified.
+func serviceAttachmentIPFamilies(spec sav1.ServiceAttachmentSpec) ([]v1.IPFamily, error) {
+	if len(spec.IPFamilies) == 0 {
+		return []v1.IPFamily{v1.IPv4Protocol}, nil
+	}
+	var ipFamilies []v1.IPFamily
+	for _, ipFamily := range spec.IPFamilies {
+		if ipFamily != v1.IPv4Protocol && ipFamily != v1.IPv6Protocol {
+			return nil, fmt.Errorf("invalid ipFamilies: %q is not one of %q or %q", ipFamily, v1.IPv4Protocol, v1.IPv6Protocol)
+		}
+		if slices.Contains(ipFamilies, ipFamily) {
+			return nil, fmt.Errorf("invalid ipFamilies: %q is specified more than once", ipFamily)
+		}
+		ipFamilies = append(ipFamilies, ipFamily)
+	}
+	return ipFamilies, nil
+}
+
+// validateSubnetIPFamilies verifies that the NAT subnet has address ranges of every IP family
+// published by the service attachment
+func validateSubnetIPFamilies(subnet *ga.Subnetwork, ipFamilies []v1.IPFamily) error {
+	for _, ipFamily := range ipFamilies {
+		switch {
+		case ipFamily == v1.IPv6Protocol && subnet.StackType != "IPV4_IPV6" && subnet.StackType != "IPV6_ONLY":
+			return fmt.Errorf("subnet %s with stack type %q cannot be used for IPv6 service attachments, a dual-stack or IPv6 subnet is required", subnet.Name, subnet.StackType)
+		case ipFamily == v1.IPv4Protocol && subnet.StackType == "IPV6_ONLY":
+			return fmt.Errorf("subnet %s with stack type %q cannot be used for IPv4 service attachments", subnet.Name, subnet.StackType)
+		}
+	}
+	return nil
+}
+
+// pscServiceAttachmentID returns the 128-bit id of the GCE Service Attachment as a decimal
+// string, or an empty string if GCE has not assigned an id
+func pscServiceAttachmentID(gceSA *ga.ServiceAttachment) string {
+	if gceSA.PscServiceAttachmentId == nil {
+		return ""
+	}
+	id := new(big.Int).SetUint64(gceSA.PscServiceAttachmentId.High)
+	id.Lsh(id, 64)
+	id.Or(id, new(big.Int).SetUint64(gceSA.PscServiceAttachmentId.Low))
+	return id.String()
+}
+
 // SvcAttachmentKeyFunc provides the service attachment key used
 // by the svcAttachmentLister
 func SvcAttachmentKeyFunc(namespace, name string) string {
@@ -860,17 +1421,39 @@ func detectUnsyncedFields(existingSA *ga.ServiceAttachment, updatedCR *sav1.Serv
 	// check if fields are unsynced indeed
 	for _, field := range knownUnsyncedFields {
 
-		if field == "ReconcileConnections" {
+		switch field {
+		case "ReconcileConnections":
 			// new field is unsynced when it is missing in manifest but has non-empty value in GCE
 			if updatedCR.Spec.ReconcileConnections == nil && existingSA.ReconcileConnections == true {
 				unsyncedFields = append(unsyncedFields, field)
 			}
+		case "DomainNames":
+			if updatedCR.Spec.DomainNames == nil && len(existingSA.DomainNames) != 0 {
+				unsyncedFields = append(unsyncedFields, field)
+			}
+		case "PropagatedConnectionLimit":
+			if updatedCR.Spec.PropagatedConnectionLimit == nil && existingSA.PropagatedConnectionLimit != 0 {
+				unsyncedFields = append(unsyncedFields, field)
+			}
 		}
 	}
 
 	return unsyncedFields
 }
 
+// clearedFields returns the optional fields explicitly set to their empty value in the spec,
+// which must be sent to GCE to clear the value of an existing Service Attachment.
+func clearedFields(spec sav1.ServiceAttachmentSpec) []string {
+	var fields []string
+	if spec.DomainNames != nil && len(*spec.DomainNames) == 0 {
+		fields = append(fields, "DomainNames")
+	}
+	if spec.PropagatedConnectionLimit != nil && *spec.PropagatedConnectionLimit == 0 {
+		fields = append(fields, "PropagatedConnectionLimit")
+	}
+	return fields
+}
+
 // findForceSendFields allow sync of empty values of synced fields only.
 // By default, forceSendFields should contain all optional fields which could have
 // empty values so that they correctly propagated to the GCE. But now, due to existing "unsync"
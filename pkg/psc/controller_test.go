@@ -17,10 +17,13 @@ package psc
 
 import (
 	context2 "context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,15 +32,19 @@ import (
 	ga "google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	ingannotations "k8s.io/ingress-gce/pkg/annotations"
 	sav1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/l4/annotations"
 	safake "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned/fake"
@@ -498,6 +505,287 @@ func TestServiceAttachmentCreation(t *testing.T) {
 	}
 }
 
+func TestServiceAttachmentIngress(t *testing.T) {
+	saName := "my-sa"
+	ingName := "my-ingress"
+	frIPAddr := "1.2.3.4"
+
+	testCases := []struct {
+		desc          string
+		ingressClass  string
+		annotationKey string
+		ipAddr        string
+		apiGroup      *string
+		expectErr     bool
+	}{
+		{
+			desc:          "https forwarding rule of internal ingress",
+			ingressClass:  ingannotations.GceL7ILBIngressClass,
+			annotationKey: ingannotations.HttpsForwardingRuleKey,
+			ipAddr:        frIPAddr,
+		},
+		{
+			desc:          "http forwarding rule of internal ingress with networking api group",
+			ingressClass:  ingannotations.GceL7ILBIngressClass,
+			annotationKey: ingannotations.HttpForwardingRuleKey,
+			ipAddr:        frIPAddr,
+			apiGroup:      ptr.To("networking.k8s.io"),
+		},
+		{
+			desc:          "external ingress",
+			ingressClass:  ingannotations.GceIngressClass,
+			annotationKey: ingannotations.HttpForwardingRuleKey,
+			ipAddr:        frIPAddr,
+			expectErr:     true,
+		},
+		{
+			desc:          "forwarding rule has wrong IP",
+			ingressClass:  ingannotations.GceL7ILBIngressClass,
+			annotationKey: ingannotations.HttpForwardingRuleKey,
+			ipAddr:        "5.6.7.8",
+			expectErr:     true,
+		},
+		{
+			desc:         "ingress has no forwarding rule",
+			ingressClass: ingannotations.GceL7ILBIngressClass,
+			ipAddr:       frIPAddr,
+			expectErr:    true,
+		},
+		{
+			desc:          "invalid api group",
+			ingressClass:  ingannotations.GceL7ILBIngressClass,
+			annotationKey: ingannotations.HttpForwardingRuleKey,
+			ipAddr:        frIPAddr,
+			apiGroup:      ptr.To("apps"),
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			controller, err := newTestController("ZONAL", false)
+			if err != nil {
+				t.Fatalf("failed to initialize the controller: %v", err)
+			}
+			fakeCloud := controller.cloud
+
+			ing, frName, err := createIngress(controller, ingName, tc.ingressClass, tc.ipAddr, tc.annotationKey)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			rule, err := createForwardingRule(fakeCloud, frName, frIPAddr)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			if _, err := createNatSubnet(fakeCloud, "my-subnet"); err != nil {
+				t.Fatalf("%s", err)
+			}
+
+			saCR := testServiceAttachmentCR(saName, ingName, "service-attachment-uid", []string{"my-subnet"}, false, false, nil)
+			saCR.Spec.ResourceRef = v1.TypedLocalObjectReference{APIGroup: tc.apiGroup, Kind: "Ingress", Name: ingName}
+			controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{})
+			syncServiceAttachmentLister(controller)
+
+			err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName))
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("expected an error when process service attachment")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error processing Service Attachment: %s", err)
+			}
+
+			gceSAName := controller.saNamer.ServiceAttachment(testNamespace, saName, string(saCR.UID))
+			sa, err := getServiceAttachment(fakeCloud, gceSAName)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			if sa.TargetService != rule.SelfLink {
+				t.Errorf("Service Attachment target service is %q, want %q", sa.TargetService, rule.SelfLink)
+			}
+
+			// Deleting the Ingress releases the GCE Service Attachment, but keeps the CR.
+			if err := controller.ingressLister.Delete(ing); err != nil {
+				t.Fatalf("%s", err)
+			}
+			syncServiceAttachmentLister(controller)
+			if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+				t.Fatalf("unexpected error processing Service Attachment of deleted Ingress: %s", err)
+			}
+			if _, err := getServiceAttachment(fakeCloud, gceSAName); err == nil {
+				t.Errorf("GCE Service Attachment %s should be deleted after the Ingress was deleted", gceSAName)
+			}
+			updatedCR, err := controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error while querying for service attachment %s: %q", saName, err)
+			}
+			if updatedCR.Status.ServiceAttachmentURL != "" || updatedCR.Status.ForwardingRuleURL != "" {
+				t.Errorf("ServiceAttachment CR status was not cleared, got %+v", updatedCR.Status)
+			}
+
+			syncServiceAttachmentLister(controller)
+			err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName))
+			if !errors.Is(err, IngressNotFoundError) {
+				t.Errorf("processServiceAttachment() = %v, want %v", err, IngressNotFoundError)
+			}
+		})
+	}
+}
+
+func TestServiceAttachmentDualStack(t *testing.T) {
+	saName := "my-sa"
+	svcName := "my-service"
+	ipv4Addr := "1.2.3.4"
+	ipv6Addr := "fd20:0:0:1::"
+
+	controller, err := newTestController("ZONAL", false)
+	if err != nil {
+		t.Fatalf("failed to initialize the controller: %v", err)
+	}
+	fakeCloud := controller.cloud
+
+	svc, ipv4FRName, err := createSvc(controller, svcName, "svc-uid", ipv4Addr, annotations.TCPForwardingRuleKey)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	ipv6FRName := svcName + "-fr-ipv6"
+	svc.Annotations[annotations.TCPForwardingRuleIPv6Key] = ipv6FRName
+	svc.Status.LoadBalancer.Ingress = append(svc.Status.LoadBalancer.Ingress, v1.LoadBalancerIngress{IP: ipv6Addr})
+	if err := controller.serviceLister.Update(svc); err != nil {
+		t.Fatalf("%s", err)
+	}
+	ipv4Rule, err := createForwardingRule(fakeCloud, ipv4FRName, ipv4Addr)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	ipv6Rule, err := createForwardingRule(fakeCloud, ipv6FRName, ipv6Addr+"/96")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if _, err := createNatSubnetWithStackType(fakeCloud, "my-subnet", "IPV4_IPV6"); err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	saCR := testServiceAttachmentCR(saName, svcName, "service-attachment-uid", []string{"my-subnet"}, false, false, nil)
+	saCR.Spec.IPFamilies = []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol}
+	controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{})
+	syncServiceAttachmentLister(controller)
+
+	if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+		t.Fatalf("unexpected error processing Service Attachment: %s", err)
+	}
+
+	ipv4SAName := controller.saNamer.ServiceAttachment(testNamespace, saName, string(saCR.UID))
+	ipv6SAName := controller.saNamer.IPv6ServiceAttachment(testNamespace, saName, string(saCR.UID))
+	ipv4SA, err := getServiceAttachment(fakeCloud, ipv4SAName)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	ipv6SA, err := getServiceAttachment(fakeCloud, ipv6SAName)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if ipv4SA.TargetService != ipv4Rule.SelfLink {
+		t.Errorf("IPv4 Service Attachment target service is %q, want %q", ipv4SA.TargetService, ipv4Rule.SelfLink)
+	}
+	if ipv6SA.TargetService != ipv6Rule.SelfLink {
+		t.Errorf("IPv6 Service Attachment target service is %q, want %q", ipv6SA.TargetService, ipv6Rule.SelfLink)
+	}
+
+	updatedCR, err := controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error while querying for service attachment %s: %q", saName, err)
+	}
+	wantStatus := sav1.ServiceAttachmentStatus{
+		ServiceAttachmentURL:     ipv4SA.SelfLink,
+		ForwardingRuleURL:        ipv4Rule.SelfLink,
+		IPv6ServiceAttachmentURL: ipv6SA.SelfLink,
+		IPv6ForwardingRuleURL:    ipv6Rule.SelfLink,
+	}
+	updatedCR.Status.LastModifiedTimestamp = metav1.Time{}
+	if !reflect.DeepEqual(wantStatus, updatedCR.Status) {
+		t.Errorf("Unexpected ServiceAttachment status %+v, want %+v", updatedCR.Status, wantStatus)
+	}
+
+	// Publishing only the IPv6 forwarding rule deletes the IPv4 Service Attachment.
+	updatedCR.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+	controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Update(context2.TODO(), updatedCR, metav1.UpdateOptions{})
+	syncServiceAttachmentLister(controller)
+	if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+		t.Fatalf("unexpected error processing Service Attachment: %s", err)
+	}
+	if _, err := getServiceAttachment(fakeCloud, ipv4SAName); err == nil {
+		t.Errorf("IPv4 Service Attachment %s should be deleted", ipv4SAName)
+	}
+	updatedCR, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error while querying for service attachment %s: %q", saName, err)
+	}
+	if updatedCR.Status.ServiceAttachmentURL != "" || updatedCR.Status.IPv6ServiceAttachmentURL != ipv6SA.SelfLink {
+		t.Errorf("Unexpected ServiceAttachment status %+v, want only the IPv6 Service Attachment", updatedCR.Status)
+	}
+}
+
+func TestServiceAttachmentIPv6Validation(t *testing.T) {
+	saName := "my-sa"
+	svcName := "my-service"
+
+	testCases := []struct {
+		desc       string
+		ipFamilies []v1.IPFamily
+		stackType  string
+	}{
+		{
+			desc:       "service has no IPv6 forwarding rule",
+			ipFamilies: []v1.IPFamily{v1.IPv6Protocol},
+			stackType:  "IPV4_IPV6",
+		},
+		{
+			desc:       "nat subnet is not dual-stack",
+			ipFamilies: []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol},
+			stackType:  "IPV4_ONLY",
+		},
+		{
+			desc:       "duplicate ip family",
+			ipFamilies: []v1.IPFamily{v1.IPv4Protocol, v1.IPv4Protocol},
+		},
+		{
+			desc:       "unknown ip family",
+			ipFamilies: []v1.IPFamily{"IPv5"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			controller, err := newTestController("ZONAL", false)
+			if err != nil {
+				t.Fatalf("failed to initialize the controller: %v", err)
+			}
+			_, frName, err := createSvc(controller, svcName, "svc-uid", "1.2.3.4", annotations.TCPForwardingRuleKey)
+			if err != nil {
+				t.Fatalf("%s", err)
+			}
+			if _, err := createForwardingRule(controller.cloud, frName, "1.2.3.4"); err != nil {
+				t.Fatalf("%s", err)
+			}
+			if _, err := createNatSubnetWithStackType(controller.cloud, "my-subnet", tc.stackType); err != nil {
+				t.Fatalf("%s", err)
+			}
+
+			saCR := testServiceAttachmentCR(saName, svcName, "service-attachment-uid", []string{"my-subnet"}, false, false, nil)
+			saCR.Spec.IPFamilies = tc.ipFamilies
+			controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{})
+			syncServiceAttachmentLister(controller)
+
+			if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err == nil {
+				t.Errorf("expected an error when process service attachment")
+			}
+		})
+	}
+}
+
 func TestServiceAttachmentConsumers(t *testing.T) {
 
 	saName := "my-sa"
@@ -583,6 +871,331 @@ func TestServiceAttachmentConsumers(t *testing.T) {
 	}
 }
 
+func TestServiceAttachmentManualApproval(t *testing.T) {
+	saName := "my-sa"
+	svcName := "my-service"
+	saUID := "service-attachment-uid"
+	frIPAddr := "1.2.3.4"
+	controller, err := newTestController("ZONAL", false)
+	if err != nil {
+		t.Fatalf("failed to initialize the controller: %v", err)
+	}
+	gceSAName := controller.saNamer.ServiceAttachment(testNamespace, saName, saUID)
+	_, frName, err := createSvc(controller, svcName, "svc-uid", frIPAddr, annotations.TCPForwardingRuleKey)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	rule, err := createForwardingRule(controller.cloud, frName, frIPAddr)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	subnet, err := createNatSubnet(controller.cloud, "my-subnet")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	saCR := testServiceAttachmentCR(saName, svcName, saUID, []string{"my-subnet"}, false, false, nil)
+	saCR.Spec.ConnectionPreference = "ACCEPT_MANUAL"
+	saCR.Spec.ConsumerApprovals = []sav1.ConsumerApproval{
+		{Project: "consumer-project-1", Decision: sav1.ConsumerApprovalAccept, ConnectionLimit: 10, Reason: "ticket-1"},
+		{Project: "consumer-project-2", Decision: sav1.ConsumerApprovalReject},
+	}
+	if _, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create service attachment cr: %q", err)
+	}
+	syncServiceAttachmentLister(controller)
+
+	consumerURL := func(project, name string) string {
+		return cloud.SelfLink(meta.VersionGA, project, "forwardingRules", meta.RegionalKey(name, controller.cloud.Region()))
+	}
+	desc := sautils.NewServiceAttachmentDesc(saCR.Namespace, saCR.Name, ClusterName, controller.cloud.LocalZone(), false)
+	if err = insertServiceAttachment(controller.cloud, &ga.ServiceAttachment{
+		ConnectionPreference: saCR.Spec.ConnectionPreference,
+		Description:          desc.String(),
+		Name:                 gceSAName,
+		NatSubnets:           []string{subnet.SelfLink},
+		TargetService:        rule.SelfLink,
+		Region:               controller.cloud.Region(),
+		ConnectedEndpoints: []*ga.ServiceAttachmentConnectedEndpoint{
+			{Endpoint: consumerURL("consumer-project-1", "fr-1"), Status: "PENDING", PscConnectionId: 111},
+			{Endpoint: consumerURL("consumer-project-3", "fr-3"), Status: "PENDING", PscConnectionId: 333},
+		},
+	}); err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+		t.Fatalf("unexpected error processing service attachment: %q", err)
+	}
+
+	sa, err := getServiceAttachment(controller.cloud, gceSAName)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	wantAccept := []*ga.ServiceAttachmentConsumerProjectLimit{{ProjectIdOrNum: "consumer-project-1", ConnectionLimit: 10}}
+	if !reflect.DeepEqual(sa.ConsumerAcceptLists, wantAccept) {
+		t.Errorf("GCE Service Attachment accept list is %+v, want %+v", sa.ConsumerAcceptLists, wantAccept)
+	}
+	if wantReject := []string{"consumer-project-2"}; !reflect.DeepEqual(sa.ConsumerRejectLists, wantReject) {
+		t.Errorf("GCE Service Attachment reject list is %v, want %v", sa.ConsumerRejectLists, wantReject)
+	}
+
+	updatedCR, err := controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error while querying for service attachment %s: %q", saName, err)
+	}
+	wantConsumers := []sav1.ConsumerForwardingRule{
+		{ForwardingRuleURL: consumerURL("consumer-project-1", "fr-1"), Status: "PENDING", Project: "consumer-project-1", PSCConnectionID: "111"},
+		{ForwardingRuleURL: consumerURL("consumer-project-3", "fr-3"), Status: "PENDING", Project: "consumer-project-3", PSCConnectionID: "333"},
+	}
+	if !reflect.DeepEqual(updatedCR.Status.ConsumerForwardingRules, wantConsumers) {
+		t.Errorf("ServiceAttachment CR consumers are %+v, want %+v", updatedCR.Status.ConsumerForwardingRules, wantConsumers)
+	}
+}
+
+func TestServiceAttachmentConsumerApprovalIgnoredEvents(t *testing.T) {
+	saName := "my-sa"
+	svcName := "my-service"
+	frIPAddr := "1.2.3.4"
+	controller, err := newTestController("ZONAL", false)
+	if err != nil {
+		t.Fatalf("failed to initialize the controller: %v", err)
+	}
+	recorder := record.NewFakeRecorder(10)
+	controller.recorder = func(string) record.EventRecorder { return recorder }
+	_, frName, err := createSvc(controller, svcName, "svc-uid", frIPAddr, annotations.TCPForwardingRuleKey)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if _, err = createForwardingRule(controller.cloud, frName, frIPAddr); err != nil {
+		t.Fatalf("%s", err)
+	}
+	if _, err = createNatSubnet(controller.cloud, "my-subnet"); err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	// Approvals are ignored with the ACCEPT_AUTOMATIC connection preference.
+	saCR := testServiceAttachmentCR(saName, svcName, "service-attachment-uid", []string{"my-subnet"}, false, false, nil)
+	saCR.Spec.ConsumerApprovals = []sav1.ConsumerApproval{{Project: "consumer-project-1", Decision: sav1.ConsumerApprovalAccept}}
+	if _, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create service attachment cr: %q", err)
+	}
+	key := SvcAttachmentKeyFunc(testNamespace, saName)
+
+	ignoredEvents := func() int {
+		count := 0
+		for {
+			select {
+			case event := <-recorder.Events:
+				if strings.Contains(event, events.ReasonConsumerApprovalIgnored) {
+					count++
+				}
+			default:
+				return count
+			}
+		}
+	}
+	for _, tc := range []struct {
+		desc       string
+		approvals  []sav1.ConsumerApproval
+		wantEvents int
+	}{
+		{desc: "first sync", approvals: saCR.Spec.ConsumerApprovals, wantEvents: 1},
+		{desc: "resync", approvals: saCR.Spec.ConsumerApprovals, wantEvents: 0},
+		{desc: "approvals removed", approvals: nil, wantEvents: 0},
+		{desc: "approvals added again", approvals: saCR.Spec.ConsumerApprovals, wantEvents: 1},
+	} {
+		cr, err := controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error while querying for service attachment %s: %q", tc.desc, saName, err)
+		}
+		cr.Spec.ConsumerApprovals = tc.approvals
+		if _, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Update(context2.TODO(), cr, metav1.UpdateOptions{}); err != nil {
+			t.Fatalf("%s: failed to update service attachment cr: %q", tc.desc, err)
+		}
+		syncServiceAttachmentLister(controller)
+		if err = controller.processServiceAttachment(key); err != nil {
+			t.Fatalf("%s: unexpected error processing service attachment: %q", tc.desc, err)
+		}
+		if got := ignoredEvents(); got != tc.wantEvents {
+			t.Errorf("%s: got %d %s events, want %d", tc.desc, got, events.ReasonConsumerApprovalIgnored, tc.wantEvents)
+		}
+	}
+}
+
+func TestServiceAttachmentDomainNamesAndPropagation(t *testing.T) {
+	saName := "my-sa"
+	svcName := "my-service"
+	saUID := "service-attachment-uid"
+	frIPAddr := "1.2.3.4"
+	controller, err := newTestController("ZONAL", false)
+	if err != nil {
+		t.Fatalf("failed to initialize the controller: %v", err)
+	}
+	gceSAName := controller.saNamer.ServiceAttachment(testNamespace, saName, saUID)
+	_, frName, err := createSvc(controller, svcName, "svc-uid", frIPAddr, annotations.TCPForwardingRuleKey)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	rule, err := createForwardingRule(controller.cloud, frName, frIPAddr)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	subnet, err := createNatSubnet(controller.cloud, "my-subnet")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	saCR := testServiceAttachmentCR(saName, svcName, saUID, []string{"my-subnet"}, false, false, nil)
+	saCR.Spec.PropagatedConnectionLimit = ptr.To[int64](10)
+	if _, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Create(context2.TODO(), saCR, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create service attachment cr: %q", err)
+	}
+	syncServiceAttachmentLister(controller)
+
+	// The domain names of the existing Service Attachment are kept since they are not set in the CR.
+	desc := sautils.NewServiceAttachmentDesc(saCR.Namespace, saCR.Name, ClusterName, controller.cloud.LocalZone(), false)
+	if err = insertServiceAttachment(controller.cloud, &ga.ServiceAttachment{
+		ConnectionPreference:      saCR.Spec.ConnectionPreference,
+		Description:               desc.String(),
+		Name:                      gceSAName,
+		NatSubnets:                []string{subnet.SelfLink},
+		TargetService:             rule.SelfLink,
+		Region:                    controller.cloud.Region(),
+		DomainNames:               []string{"p.example.com."},
+		PropagatedConnectionLimit: 250,
+		PscServiceAttachmentId:    &ga.Uint128{High: 1, Low: 2},
+	}); err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+		t.Fatalf("unexpected error processing service attachment: %q", err)
+	}
+
+	sa, err := getServiceAttachment(controller.cloud, gceSAName)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if sa.PropagatedConnectionLimit != 10 {
+		t.Errorf("GCE Service Attachment propagated connection limit is %d, want 10", sa.PropagatedConnectionLimit)
+	}
+	if wantDomainNames := []string{"p.example.com."}; !reflect.DeepEqual(sa.DomainNames, wantDomainNames) {
+		t.Errorf("GCE Service Attachment domain names are %v, want %v", sa.DomainNames, wantDomainNames)
+	}
+
+	updatedCR, err := controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Get(context2.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error while querying for service attachment %s: %q", saName, err)
+	}
+	if wantID := "18446744073709551618"; updatedCR.Status.PSCServiceAttachmentID != wantID {
+		t.Errorf("ServiceAttachment CR PSC service attachment id is %q, want %q", updatedCR.Status.PSCServiceAttachmentID, wantID)
+	}
+
+	// An empty list clears the domain names.
+	updatedCR.Spec.DomainNames = ptr.To([]string{})
+	if _, err = controller.saClient.NetworkingV1().ServiceAttachments(testNamespace).Update(context2.TODO(), updatedCR, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update service attachment cr: %q", err)
+	}
+	syncServiceAttachmentLister(controller)
+	if err = controller.processServiceAttachment(SvcAttachmentKeyFunc(testNamespace, saName)); err != nil {
+		t.Fatalf("unexpected error processing service attachment: %q", err)
+	}
+	sa, err = getServiceAttachment(controller.cloud, gceSAName)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if len(sa.DomainNames) != 0 || !slices.Contains(sa.ForceSendFields, "DomainNames") {
+		t.Errorf("GCE Service Attachment domain names are %v with force send fields %v, want cleared domain names sent to GCE", sa.DomainNames, sa.ForceSendFields)
+	}
+}
+
+func TestConvertConsumerLists(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		spec        sav1.ServiceAttachmentSpec
+		wantAccept  []*ga.ServiceAttachmentConsumerProjectLimit
+		wantReject  []string
+		wantIgnored []string
+	}{
+		{
+			desc: "approvals are ignored without manual connection preference",
+			spec: sav1.ServiceAttachmentSpec{
+				ConnectionPreference: "ACCEPT_AUTOMATIC",
+				ConsumerRejectList:   []string{"project-1"},
+				ConsumerApprovals:    []sav1.ConsumerApproval{{Project: "project-2", Decision: sav1.ConsumerApprovalAccept}},
+			},
+			wantReject: []string{"project-1"},
+		},
+		{
+			desc: "approvals are appended to the static lists",
+			spec: sav1.ServiceAttachmentSpec{
+				ConnectionPreference: "ACCEPT_MANUAL",
+				ConsumerAllowList:    []sav1.ConsumerProject{{Project: "project-1", ConnectionLimit: 5}},
+				ConsumerRejectList:   []string{"project-2"},
+				ConsumerApprovals: []sav1.ConsumerApproval{
+					{Project: "project-3", Decision: sav1.ConsumerApprovalAccept, ConnectionLimit: 7},
+					{Project: "project-4", Decision: sav1.ConsumerApprovalReject},
+				},
+			},
+			wantAccept: []*ga.ServiceAttachmentConsumerProjectLimit{
+				{ProjectIdOrNum: "project-1", ConnectionLimit: 5},
+				{ProjectIdOrNum: "project-3", ConnectionLimit: 7},
+			},
+			wantReject: []string{"project-2", "project-4"},
+		},
+		{
+			desc: "static lists take precedence over approvals",
+			spec: sav1.ServiceAttachmentSpec{
+				ConnectionPreference: "ACCEPT_MANUAL",
+				ConsumerAllowList:    []sav1.ConsumerProject{{Project: "project-1"}},
+				ConsumerRejectList:   []string{"project-2"},
+				ConsumerApprovals: []sav1.ConsumerApproval{
+					{Project: "project-1", Decision: sav1.ConsumerApprovalReject},
+					{Project: "project-2", Decision: sav1.ConsumerApprovalAccept},
+					{Project: "project-3", Decision: sav1.ConsumerApprovalReject},
+					{Project: "project-3", Decision: sav1.ConsumerApprovalAccept},
+				},
+			},
+			wantAccept:  []*ga.ServiceAttachmentConsumerProjectLimit{{ProjectIdOrNum: "project-1"}},
+			wantReject:  []string{"project-2", "project-3"},
+			wantIgnored: []string{"project-1", "project-2", "project-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotAccept, gotReject, gotIgnored := convertConsumerLists(tc.spec)
+			if !reflect.DeepEqual(gotAccept, tc.wantAccept) {
+				t.Errorf("convertConsumerLists() accept list = %+v, want %+v", gotAccept, tc.wantAccept)
+			}
+			if !reflect.DeepEqual(gotReject, tc.wantReject) {
+				t.Errorf("convertConsumerLists() reject list = %v, want %v", gotReject, tc.wantReject)
+			}
+			if !reflect.DeepEqual(gotIgnored, tc.wantIgnored) {
+				t.Errorf("convertConsumerLists() ignored = %v, want %v", gotIgnored, tc.wantIgnored)
+			}
+		})
+	}
+}
+
+func TestValidateConsumerApprovals(t *testing.T) {
+	for _, tc := range []struct {
+		approval  sav1.ConsumerApproval
+		expectErr bool
+	}{
+		{approval: sav1.ConsumerApproval{Project: "project", Decision: sav1.ConsumerApprovalAccept}},
+		{approval: sav1.ConsumerApproval{Project: "project", Decision: sav1.ConsumerApprovalReject}},
+		{approval: sav1.ConsumerApproval{Project: "project", Decision: "Maybe"}, expectErr: true},
+		{approval: sav1.ConsumerApproval{Decision: sav1.ConsumerApprovalAccept}, expectErr: true},
+	} {
+		err := validateConsumerApprovals(sav1.ServiceAttachmentSpec{ConsumerApprovals: []sav1.ConsumerApproval{tc.approval}})
+		if gotErr := err != nil; gotErr != tc.expectErr {
+			t.Errorf("validateConsumerApprovals(%+v) = %v, want error: %t", tc.approval, err, tc.expectErr)
+		}
+	}
+}
+
 func TestServiceAttachmentUpdate(t *testing.T) {
 	saName := "my-sa"
 	svcName := "my-service"
@@ -1128,6 +1741,10 @@ func TestNeedsUpdate(t *testing.T) {
 	saNoChange := &ga.ServiceAttachment{}
 	*saNoChange = *originalSA
 
+	saClearedDomainNames := &ga.ServiceAttachment{}
+	*saClearedDomainNames = *originalSA
+	saClearedDomainNames.DomainNames = []string{}
+
 	testcases := []struct {
 		desc         string
 		newSA        *ga.ServiceAttachment
@@ -1164,6 +1781,12 @@ func TestNeedsUpdate(t *testing.T) {
 			expectError:  false,
 			expectUpdate: false,
 		},
+		{
+			desc:         "cleared domain names without domain names",
+			newSA:        saClearedDomainNames,
+			expectError:  false,
+			expectUpdate: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1499,6 +2122,8 @@ func TestGetSubnetURLs(t *testing.T) {
 		desc           string
 		subnet         string
 		createSubnet   bool
+		stackType      string
+		ipFamilies     []v1.IPFamily
 		expectErr      bool
 		expectedSubnet string
 	}{
@@ -1529,6 +2154,39 @@ func TestGetSubnetURLs(t *testing.T) {
 			createSubnet: false,
 			expectErr:    true,
 		},
+		{
+			desc:           "dual-stack subnet for IPv4 and IPv6",
+			subnet:         "my-subnet",
+			createSubnet:   true,
+			stackType:      "IPV4_IPV6",
+			ipFamilies:     []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol},
+			expectErr:      false,
+			expectedSubnet: "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1/subnetworks/my-subnet",
+		},
+		{
+			desc:         "IPv4 subnet for IPv6",
+			subnet:       "my-subnet",
+			createSubnet: true,
+			stackType:    "IPV4_ONLY",
+			ipFamilies:   []v1.IPFamily{v1.IPv6Protocol},
+			expectErr:    true,
+		},
+		{
+			desc:         "IPv6 subnet for IPv4",
+			subnet:       "my-subnet",
+			createSubnet: true,
+			stackType:    "IPV6_ONLY",
+			ipFamilies:   []v1.IPFamily{v1.IPv4Protocol},
+			expectErr:    true,
+		},
+		{
+			desc:           "subnet resource url is not validated",
+			subnet:         "projects/test-project/regions/us-central1/subnetworks/subnet-1",
+			createSubnet:   false,
+			ipFamilies:     []v1.IPFamily{v1.IPv6Protocol},
+			expectErr:      false,
+			expectedSubnet: "projects/test-project/regions/us-central1/subnetworks/subnet-1",
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1538,13 +2196,13 @@ func TestGetSubnetURLs(t *testing.T) {
 			}
 
 			if tc.createSubnet {
-				_, err := createNatSubnet(controller.cloud, tc.subnet)
+				_, err := createNatSubnetWithStackType(controller.cloud, tc.subnet, tc.stackType)
 				if err != nil {
 					t.Errorf("failed to create nat subnet: %s", err)
 				}
 			}
 
-			subnets, err := controller.getSubnetURLs([]string{tc.subnet})
+			subnets, err := controller.getSubnetURLs([]string{tc.subnet}, tc.ipFamilies)
 			if err == nil && tc.expectErr {
 				t.Fatalf("getSubnetURLs() returned no error, but expected an error")
 			} else if err != nil && !tc.expectErr {
@@ -1643,6 +2301,28 @@ func createSvc(controller *Controller, svcName, svcUID, ipAddr, forwardingRuleKe
 	return svc, frName, controller.serviceLister.Add(svc)
 }
 
+// createIngress creates a test Ingress of the given class and adds it to the controller's ingressLister.
+// If forwardingRuleKey is empty, no forwarding rule annotation will be added to the Ingress.
+func createIngress(controller *Controller, ingName, ingressClass, ipAddr, forwardingRuleKey string) (*networkingv1.Ingress, string, error) {
+	frName := ingName + "-fr"
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   testNamespace,
+			Name:        ingName,
+			Annotations: map[string]string{ingannotations.IngressClassKey: ingressClass},
+		},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: ipAddr}},
+			},
+		},
+	}
+	if forwardingRuleKey != "" {
+		ing.Annotations[forwardingRuleKey] = frName
+	}
+	return ing, frName, controller.ingressLister.Add(ing)
+}
+
 // testServiceAttachmentCR creates a test ServiceAttachment CR with the provided name, uid and subnets
 func testServiceAttachmentCR(saName, svcName, svcUID string, subnets []string, withFinalizer, proxyProtocol bool, reconcileConnections *bool) *sav1.ServiceAttachment {
 	cr := &sav1.ServiceAttachment{
@@ -1682,11 +2362,11 @@ func createForwardingRule(c *gce.Cloud, frName, ipAddr string) (*composite.Forwa
 		LoadBalancingScheme: string(cloud.SchemeInternal),
 		IPAddress:           ipAddr,
 	}
-	if err = composite.CreateForwardingRule(c, key, fwdRule, klog.TODO()); err != nil {
+	if err = composite.CreateForwardingRule(context2.Background(), c, key, fwdRule, klog.TODO()); err != nil {
 		return nil, fmt.Errorf("Failed to create fake forwarding rule %s:  %q", frName, err)
 	}
 
-	rule, err := composite.GetForwardingRule(c, key, meta.VersionGA, klog.TODO())
+	rule, err := composite.GetForwardingRule(context2.Background(), c, key, meta.VersionGA, klog.TODO())
 	if err != nil {
 		return rule, fmt.Errorf("Failed to get forwarding rule: %q", err)
 	}
@@ -1695,13 +2375,19 @@ func createForwardingRule(c *gce.Cloud, frName, ipAddr string) (*composite.Forwa
 
 // createNatSubnet will create a subnet with the provided name
 func createNatSubnet(c *gce.Cloud, natSubnet string) (*ga.Subnetwork, error) {
+	return createNatSubnetWithStackType(c, natSubnet, "")
+}
+
+// createNatSubnetWithStackType will create a subnet with the provided name and stack type
+func createNatSubnetWithStackType(c *gce.Cloud, natSubnet, stackType string) (*ga.Subnetwork, error) {
 	key, err := composite.CreateKey(c, natSubnet, meta.Regional)
 	if err != nil {
 		return nil, fmt.Errorf("Unexpected error when creating key: %q", err)
 	}
 	// Create a ForwardingRule that matches
 	subnet := &ga.Subnetwork{
-		Name: natSubnet,
+		Name:      natSubnet,
+		StackType: stackType,
 	}
 	if err = c.Compute().Subnetworks().Insert(context2.TODO(), key, subnet); err != nil {
 		return nil, fmt.Errorf("Failed to create fake subnet %s:  %q", natSubnet, err)
@@ -1874,6 +2560,11 @@ func Test_findForceSendFields(t *testing.T) {
 			unsyncedFields: optionalFields,
 			want:           nil,
 		},
+		{
+			name:           "Some fields are unsynced",
+			unsyncedFields: []string{"DomainNames"},
+			want:           []string{"ReconcileConnections", "PropagatedConnectionLimit"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1982,6 +2673,33 @@ func TestCheckUnsyncedFields(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			desc: "First sync: DomainNames and PropagatedConnectionLimit nil in CR, set in GCE",
+			existingSA: &ga.ServiceAttachment{
+				DomainNames:               []string{"p.example.com."},
+				PropagatedConnectionLimit: 250,
+			},
+			updatedCR: &sav1.ServiceAttachment{},
+			want:      []string{"DomainNames", "PropagatedConnectionLimit"},
+		},
+		{
+			desc: "Subsequent sync: Annotation has PropagatedConnectionLimit, CR set",
+			existingSA: &ga.ServiceAttachment{
+				DomainNames:               []string{"p.example.com."},
+				PropagatedConnectionLimit: 250,
+			},
+			updatedCR: &sav1.ServiceAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						UnsyncedFieldAnnotationKey: "DomainNames,PropagatedConnectionLimit",
+					},
+				},
+				Spec: sav1.ServiceAttachmentSpec{
+					PropagatedConnectionLimit: ptr.To[int64](10),
+				},
+			},
+			want: []string{"DomainNames"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1993,3 +2711,58 @@ func TestCheckUnsyncedFields(t *testing.T) {
 		})
 	}
 }
+
+func TestPSCServiceAttachmentID(t *testing.T) {
+	testCases := []struct {
+		desc string
+		id   *ga.Uint128
+		want string
+	}{
+		{desc: "no id", want: ""},
+		{desc: "low bits only", id: &ga.Uint128{Low: 42}, want: "42"},
+		{desc: "high and low bits", id: &ga.Uint128{High: 1, Low: 1}, want: "18446744073709551617"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := pscServiceAttachmentID(&ga.ServiceAttachment{PscServiceAttachmentId: tc.id}); got != tc.want {
+				t.Errorf("pscServiceAttachmentID() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClearedFieldsFromManifest(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		manifest string
+		want     []string
+	}{
+		{desc: "fields not set", manifest: `{}`},
+		{desc: "domain names set", manifest: `{"domainNames":["p.example.com."]}`},
+		{desc: "domain names cleared", manifest: `{"domainNames":[]}`, want: []string{"DomainNames"}},
+		{desc: "propagated connection limit cleared", manifest: `{"propagatedConnectionLimit":0}`, want: []string{"PropagatedConnectionLimit"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var spec sav1.ServiceAttachmentSpec
+			if err := json.Unmarshal([]byte(tc.manifest), &spec); err != nil {
+				t.Fatalf("json.Unmarshal(%s) returned error: %v", tc.manifest, err)
+			}
+			if got := clearedFields(spec); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("clearedFields() = %v, want %v", got, tc.want)
+			}
+			// The spec written back to the API server must keep the cleared fields.
+			out, err := json.Marshal(spec)
+			if err != nil {
+				t.Fatalf("json.Marshal() returned error: %v", err)
+			}
+			var roundTripped sav1.ServiceAttachmentSpec
+			if err := json.Unmarshal(out, &roundTripped); err != nil {
+				t.Fatalf("json.Unmarshal(%s) returned error: %v", out, err)
+			}
+			if got := clearedFields(roundTripped); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("clearedFields() after a round trip of %s = %v, want %v", out, got, tc.want)
+			}
+		})
+	}
+}
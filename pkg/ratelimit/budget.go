@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"k8s.io/ingress-gce/pkg/ratelimit/metrics"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// qpsDecreaseFactor is applied to the rate of a budget on each quota
+	// error returned by GCE.
+	qpsDecreaseFactor = 0.5
+	// qpsIncreaseFraction of the configured rate is added back to the rate
+	// of a budget on each successful call.
+	qpsIncreaseFraction = 0.01
+	// minQPSFraction of the configured rate is the lowest rate of a budget.
+	minQPSFraction = 0.05
+	// maxRetryAfter caps the pause requested by the Retry-After header of a
+	// quota error.
+	maxRetryAfter = 5 * time.Minute
+)
+
+// projectBudget is a token bucket shared by all the GCE API calls to a
+// project, whichever controller makes them. Tokens are handed to the waiting
+// calls by priority, first come first served within a priority class, so a
+// storm of low priority calls does not delay the high priority ones.
+//
+// The rate adapts to the quota errors returned by GCE: it is cut on each of
+// them and recovers slowly on successful calls. No token is handed out
+// before the time requested by the Retry-After header of a quota error.
+type projectBudget struct {
+	project string
+	clock   clock.Clock
+
+	lock sync.Mutex
+	// maxQPS is the configured rate, qps is the current one.
+	maxQPS float64
+	qps    float64
+	burst  float64
+	tokens float64
+	// refilled is the time at which tokens was last refilled.
+	refilled time.Time
+	// pausedUntil is the time before which no token is handed out.
+	pausedUntil time.Time
+	// waiters are the calls waiting for a token, by priority.
+	waiters [numPriorities][]*budgetWaiter
+	// changed is closed and replaced when a waiter leaves, so that the
+	// others check whether they are next.
+	changed chan struct{}
+}
+
+type budgetWaiter struct {
+	priority Priority
+}
+
+func newProjectBudget(project string, qps float64, burst int, clk clock.Clock) *projectBudget {
+	return &projectBudget{
+		project:  project,
+		clock:    clk,
+		maxQPS:   qps,
+		qps:      qps,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		refilled: clk.Now(),
+		changed:  make(chan struct{}),
+	}
+}
+
+// Accept blocks until a token is handed to the call or ctx is done.
+func (b *projectBudget) Accept(ctx context.Context, priority Priority) error {
+	w := &budgetWaiter{priority: priority}
+	b.lock.Lock()
+	b.waiters[priority] = append(b.waiters[priority], w)
+	for {
+		b.refillLocked()
+		// Only the next waiter waits for the bucket, the others wait for
+		// their turn.
+		var timer <-chan time.Time
+		if b.nextLocked() == w {
+			wait := b.waitLocked()
+			if wait <= 0 {
+				b.tokens--
+				b.removeLocked(w)
+				b.lock.Unlock()
+				return nil
+			}
+			timer = b.clock.After(wait)
+		}
+		changed := b.changed
+		b.lock.Unlock()
+
+		select {
+		case <-timer:
+		case <-changed:
+		case <-ctx.Done():
+			b.lock.Lock()
+			b.removeLocked(w)
+			b.lock.Unlock()
+			return ctx.Err()
+		}
+		b.lock.Lock()
+	}
+}
+
+// Observe adapts the rate of the budget to the outcome of a call.
+func (b *projectBudget) Observe(err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	// Tokens accumulated so far are accounted at the previous rate.
+	b.refillLocked()
+	switch {
+	case err == nil:
+		b.qps = math.Min(b.maxQPS, b.qps+b.maxQPS*qpsIncreaseFraction)
+	case utils.IsQuotaExceededError(err):
+		b.qps = math.Max(b.maxQPS*minQPSFraction, b.qps*qpsDecreaseFactor)
+		if d, ok := retryAfter(err, b.clock.Now()); ok {
+			if until := b.clock.Now().Add(d); until.After(b.pausedUntil) {
+				b.pausedUntil = until
+			}
+		}
+	default:
+		return
+	}
+	b.publishLocked()
+}
+
+// refillLocked adds the tokens accumulated since the last refill.
+func (b *projectBudget) refillLocked() {
+	now := b.clock.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.refilled).Seconds()*b.qps)
+	b.refilled = now
+}
+
+// nextLocked returns the first waiter of the highest priority class.
+func (b *projectBudget) nextLocked() *budgetWaiter {
+	for _, waiters := range b.waiters {
+		if len(waiters) > 0 {
+			return waiters[0]
+		}
+	}
+	return nil
+}
+
+// waitLocked returns the time until a token can be handed out.
+func (b *projectBudget) waitLocked() time.Duration {
+	var wait time.Duration
+	if now := b.clock.Now(); now.Before(b.pausedUntil) {
+		wait = b.pausedUntil.Sub(now)
+	}
+	if b.tokens < 1 {
+		wait = max(wait, time.Duration(math.Ceil((1-b.tokens)/b.qps*float64(time.Second))))
+	}
+	return wait
+}
+
+// removeLocked removes the waiter and wakes up the others.
+func (b *projectBudget) removeLocked(w *budgetWaiter) {
+	for i, other := range b.waiters[w.priority] {
+		if other == w {
+			b.waiters[w.priority] = append(b.waiters[w.priority][:i], b.waiters[w.priority][i+1:]...)
+			break
+		}
+	}
+	close(b.changed)
+	b.changed = make(chan struct{})
+	b.publishLocked()
+}
+
+func (b *projectBudget) publishLocked() {
+	metrics.PublishBudgetMetrics(b.project, b.tokens, b.qps)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of err,
+// given in seconds or as an HTTP date.
+func retryAfter(err error, now time.Time) (time.Duration, bool) {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Header == nil {
+		return 0, false
+	}
+	value := apiErr.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	var d time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		d = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(value); err == nil {
+		d = t.Sub(now)
+	} else {
+		return 0, false
+	}
+	if d <= 0 {
+		return 0, false
+	}
+	return min(d, maxRetryAfter), true
+}
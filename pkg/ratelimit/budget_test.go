@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// startAccept calls Accept in the background and waits until the call is
+// queued. The returned channel receives the result of Accept.
+func startAccept(t *testing.T, ctx context.Context, b *projectBudget, priority Priority) <-chan error {
+	t.Helper()
+	b.lock.Lock()
+	queued := len(b.waiters[priority])
+	b.lock.Unlock()
+
+	done := make(chan error, 1)
+	go func() { done <- b.Accept(ctx, priority) }()
+	err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		return len(b.waiters[priority]) > queued, nil
+	})
+	if err != nil {
+		t.Fatalf("Accept(_, %v) was not queued: %v", priority, err)
+	}
+	return done
+}
+
+// step advances the clock once the given number of timers are set, so that
+// the calls woken up by the previous step had the time to wait again.
+func step(t *testing.T, clock *clocktesting.FakeClock, timers int, d time.Duration) {
+	t.Helper()
+	err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return clock.Waiters() == timers, nil
+	})
+	if err != nil {
+		t.Fatalf("got %d timers, want %d: %v", clock.Waiters(), timers, err)
+	}
+	clock.Step(d)
+}
+
+func verifyAccepted(t *testing.T, desc string, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("%s: Accept() = %v, want nil", desc, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("%s: Accept() is still blocked", desc)
+	}
+}
+
+func verifyWaiting(t *testing.T, desc string, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Errorf("%s: Accept() = %v, want it to be blocked", desc, err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestProjectBudgetPriority(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	b := newProjectBudget("test-project", 1, 1, clock)
+
+	// The burst is consumed right away.
+	if err := b.Accept(context.Background(), PriorityLow); err != nil {
+		t.Fatalf("Accept() = %v, want nil", err)
+	}
+
+	low := startAccept(t, context.Background(), b, PriorityLow)
+	normal := startAccept(t, context.Background(), b, PriorityNormal)
+	high := startAccept(t, context.Background(), b, PriorityHigh)
+
+	// Each call was next when it was queued.
+	step(t, clock, 3, time.Second)
+	verifyAccepted(t, "high priority call after 1s", high)
+	verifyWaiting(t, "normal priority call after 1s", normal)
+	verifyWaiting(t, "low priority call after 1s", low)
+
+	// Only the next call waits for the bucket.
+	step(t, clock, 1, time.Second)
+	verifyAccepted(t, "normal priority call after 2s", normal)
+	verifyWaiting(t, "low priority call after 2s", low)
+
+	step(t, clock, 1, time.Second)
+	verifyAccepted(t, "low priority call after 3s", low)
+}
+
+func TestProjectBudgetQuotaError(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	b := newProjectBudget("test-project", 10, 1, clock)
+
+	b.Observe(&googleapi.Error{
+		Code:   http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"30"}},
+	})
+	if b.qps != 10*qpsDecreaseFactor {
+		t.Errorf("qps = %v after a quota error, want %v", b.qps, 10*qpsDecreaseFactor)
+	}
+
+	// The token in the bucket is not handed out before the time requested
+	// by GCE.
+	done := startAccept(t, context.Background(), b, PriorityHigh)
+	step(t, clock, 1, 29*time.Second)
+	verifyWaiting(t, "call 29s after the quota error", done)
+	step(t, clock, 1, time.Second)
+	verifyAccepted(t, "call 30s after the quota error", done)
+
+	// Other errors do not change the rate, successes recover it.
+	b.Observe(errors.New("internal error"))
+	if b.qps != 10*qpsDecreaseFactor {
+		t.Errorf("qps = %v after a non-quota error, want %v", b.qps, 10*qpsDecreaseFactor)
+	}
+	for i := 0; i < 100; i++ {
+		b.Observe(nil)
+	}
+	if b.qps != 10 {
+		t.Errorf("qps = %v after 100 successful calls, want 10", b.qps)
+	}
+}
+
+func TestProjectBudgetCancel(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	b := newProjectBudget("test-project", 1, 1, clock)
+	if err := b.Accept(context.Background(), PriorityNormal); err != nil {
+		t.Fatalf("Accept() = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := startAccept(t, ctx, b, PriorityHigh)
+	low := startAccept(t, context.Background(), b, PriorityLow)
+	cancel()
+	select {
+	case err := <-canceled:
+		if err != context.Canceled {
+			t.Errorf("Accept() = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Accept() is still blocked after the context was canceled")
+	}
+
+	// The canceled call does not hold up the others. Its timer is still set.
+	step(t, clock, 2, time.Second)
+	verifyAccepted(t, "low priority call after 1s", low)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		desc   string
+		err    error
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			desc: "no googleapi error",
+			err:  errors.New("error"),
+		},
+		{
+			desc: "no header",
+			err:  &googleapi.Error{Code: http.StatusTooManyRequests},
+		},
+		{
+			desc:   "seconds",
+			err:    &googleapi.Error{Header: http.Header{"Retry-After": []string{"12"}}},
+			want:   12 * time.Second,
+			wantOK: true,
+		},
+		{
+			desc:   "HTTP date",
+			err:    &googleapi.Error{Header: http.Header{"Retry-After": []string{now.Add(time.Minute).Format(http.TimeFormat)}}},
+			want:   time.Minute,
+			wantOK: true,
+		},
+		{
+			desc: "HTTP date in the past",
+			err:  &googleapi.Error{Header: http.Header{"Retry-After": []string{now.Add(-time.Minute).Format(http.TimeFormat)}}},
+		},
+		{
+			desc:   "capped",
+			err:    &googleapi.Error{Header: http.Header{"Retry-After": []string{"3600"}}},
+			want:   maxRetryAfter,
+			wantOK: true,
+		},
+		{
+			desc: "invalid",
+			err:  &googleapi.Error{Header: http.Header{"Retry-After": []string{"soon"}}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, ok := retryAfter(tc.err, now)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("retryAfter(%v) = %v, %v, want %v, %v", tc.err, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	if got := PriorityFromContext(context.Background()); got != PriorityNormal {
+		t.Errorf("PriorityFromContext(context.Background()) = %v, want %v", got, PriorityNormal)
+	}
+	if got := PriorityFromContext(WithPriority(context.Background(), PriorityLow)); got != PriorityLow {
+		t.Errorf("PriorityFromContext(WithPriority(_, %v)) = %v, want %v", PriorityLow, got, PriorityLow)
+	}
+}
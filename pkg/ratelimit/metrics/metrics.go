@@ -85,6 +85,35 @@ var (
 		},
 		append(metricsLabels, "error_type"),
 	)
+
+	BudgetRemainingTokens = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: negControllerSubsystem,
+			Name:      "project_budget_remaining_tokens",
+			Help:      "Number of GCE API calls to the project which can be made without waiting",
+		},
+		[]string{"project"},
+	)
+
+	BudgetQPS = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: negControllerSubsystem,
+			Name:      "project_budget_qps",
+			Help:      "Current rate of GCE API calls to the project, adapted to the quota errors returned by GCE",
+		},
+		[]string{"project"},
+	)
+
+	BudgetLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: negControllerSubsystem,
+			Name:      "project_budget_delay_seconds",
+			Help:      "Time GCE API calls waited for the budget of their project",
+			// custom buckets = [0.01s, 0.02s, 0.04s, 0.08s, 0.16s, 0.32s, 0.64s, 1.28s, 2.56s, 5.12s, 10.24s, 20.48s, 40.96s, 81.92s, 163.84s(~3min), +Inf]
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+		},
+		[]string{"priority"},
+	)
 )
 
 func RegisterMetrics() {
@@ -94,6 +123,9 @@ func RegisterMetrics() {
 		prometheus.MustRegister(StrategyUsedDelay)
 		prometheus.MustRegister(StrategyLockLatency)
 		prometheus.MustRegister(ErrorsCounter)
+		prometheus.MustRegister(BudgetRemainingTokens)
+		prometheus.MustRegister(BudgetQPS)
+		prometheus.MustRegister(BudgetLatency)
 	})
 }
 
@@ -119,3 +151,12 @@ func PublishErrorRateLimiterMetrics(key string, err error) {
 	}
 	ErrorsCounter.WithLabelValues(key, errorType).Inc()
 }
+
+func PublishBudgetMetrics(project string, remainingTokens, qps float64) {
+	BudgetRemainingTokens.WithLabelValues(project).Set(remainingTokens)
+	BudgetQPS.WithLabelValues(project).Set(qps)
+}
+
+func PublishBudgetLatencyMetrics(priority string, start time.Time) {
+	BudgetLatency.WithLabelValues(priority).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import "context"
+
+// Priority is the class of a GCE API call waiting for the budget of its
+// project. Calls of a higher class are always let through first.
+type Priority int
+
+const (
+	// PriorityHigh is the class of deletions and of the syncs of objects
+	// which were changed by users.
+	PriorityHigh Priority = iota
+	// PriorityNormal is the class of the calls whose priority is not known.
+	PriorityNormal
+	// PriorityLow is the class of periodic resyncs and garbage collection.
+	PriorityLow
+
+	numPriorities = 3
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityNormal:
+		return "normal"
+	case PriorityLow:
+		return "low"
+	}
+	return "unknown"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns ctx with the priority of the GCE API calls made with
+// it.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority set by WithPriority, or
+// PriorityNormal if none was set.
+func PriorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok && p >= PriorityHigh && p < numPriorities {
+		return p
+	}
+	return PriorityNormal
+}
+
+// SyncPriority returns the priority of a controller sync. Syncs of objects
+// with changes which were not synced yet, including deletions, are of high
+// priority, other syncs are periodic resyncs.
+func SyncPriority(changed bool) Priority {
+	if changed {
+		return PriorityHigh
+	}
+	return PriorityLow
+}
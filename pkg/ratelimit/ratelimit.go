@@ -26,11 +26,13 @@ import (
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/slices"
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/ratelimit/metrics"
 	"k8s.io/ingress-gce/pkg/throttling"
+	"k8s.io/ingress-gce/pkg/tracing"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 )
@@ -43,6 +45,14 @@ type GCERateLimiter struct {
 	// Minimum polling interval for getting operations. Underlying operations rate limiter
 	// may increase the time.
 	operationPollInterval time.Duration
+
+	// Budget shared by all the calls to a project, in addition to the limits of their
+	// operation. Disabled when budgetQPS is 0.
+	budgetQPS   float64
+	budgetBurst int
+	budgetsLock sync.Mutex
+	budgets     map[string]*projectBudget
+	clock       clock.Clock
 }
 
 // strategyRateLimiter implements cloud.RateLimiter and uses underlying throttling.Strategy
@@ -118,19 +128,46 @@ func NewGCERateLimiter(specs []string, operationPollInterval time.Duration, logg
 			logger.Info("Configured rate limiting for API key with scale", "apiKey", key, "scale", flags.F.GCERateLimitScale)
 		}
 	}
-	if len(rateLimitImpls) == 0 && len(strategyRLs) == 0 {
+	if flags.F.GCEProjectQPS > 0 {
+		logger.Info("Configured project budget", "qps", flags.F.GCEProjectQPS, "burst", flags.F.GCEProjectBurst)
+	}
+	if len(rateLimitImpls) == 0 && len(strategyRLs) == 0 && flags.F.GCEProjectQPS <= 0 {
 		return nil, nil
 	}
 	return &GCERateLimiter{
 		rateLimitImpls:        rateLimitImpls,
 		strategyRLs:           strategyRLs,
 		operationPollInterval: operationPollInterval,
+		budgetQPS:             flags.F.GCEProjectQPS,
+		budgetBurst:           flags.F.GCEProjectBurst,
+		budgets:               make(map[string]*projectBudget),
+		clock:                 clock.RealClock{},
 	}, nil
 }
 
+// budget returns the budget of the project, or nil if project budgets are disabled.
+func (grl *GCERateLimiter) budget(project string) *projectBudget {
+	if grl.budgetQPS <= 0 {
+		return nil
+	}
+	grl.budgetsLock.Lock()
+	defer grl.budgetsLock.Unlock()
+	b, ok := grl.budgets[project]
+	if !ok {
+		b = newProjectBudget(project, grl.budgetQPS, grl.budgetBurst, grl.clock)
+		grl.budgets[project] = b
+	}
+	return b
+}
+
 // Accept looks up the associated strategyRateLimiter (if exists) and waits on it.
+// Then it waits on the budget of the project (if configured) with the priority in ctx.
 // Then it looks up the associated flowcontrol.RateLimiter (if exists) and waits on it.
-func (grl *GCERateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey) error {
+// The wait is traced as a child of the span of the API call in ctx.
+func (grl *GCERateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey) (err error) {
+	ctx, span := tracing.Start(ctx, "GCERateLimiter.Accept", attribute.String("key", rateLimitKeyToString(key)))
+	defer func() { tracing.End(span, err) }()
+
 	if strategyRL := grl.strategyRLs[rateLimitKeyWithoutProject(key)]; strategyRL != nil {
 		start := time.Now()
 		err := strategyRL.Accept(ctx, key)
@@ -140,6 +177,16 @@ func (grl *GCERateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey)
 		}
 	}
 
+	if budget := grl.budget(key.ProjectID); budget != nil {
+		start := time.Now()
+		priority := PriorityFromContext(ctx)
+		err := budget.Accept(ctx, priority)
+		metrics.PublishBudgetLatencyMetrics(priority.String(), start)
+		if err != nil {
+			return err
+		}
+	}
+
 	var rl cloud.RateLimiter
 	if impl := grl.rateLimitImpls[rateLimitKeyWithoutProject(key)]; impl != nil {
 		// Wrap the flowcontrol.RateLimiter with a AcceptRateLimiter and handle context.
@@ -163,19 +210,70 @@ func (grl *GCERateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey)
 	}
 
 	start := time.Now()
-	err := rl.Accept(ctx, key)
+	err = rl.Accept(ctx, key)
 	metrics.PublishRateLimiterMetrics(rateLimitKeyToString(key), start)
 	return err
 }
 
 // Observe looks up the associated strategyRateLimiter (if exists) and passes an error there to observe.
+// The budget of the project (if configured) adapts its rate to the error.
 func (grl *GCERateLimiter) Observe(ctx context.Context, err error, key *cloud.RateLimitKey) {
 	metrics.PublishErrorRateLimiterMetrics(rateLimitKeyToString(key), err)
+	if budget := grl.budget(key.ProjectID); budget != nil {
+		budget.Observe(err)
+	}
 	if rl := grl.strategyRLs[rateLimitKeyWithoutProject(key)]; rl != nil {
 		rl.Observe(ctx, err, key)
 	}
 }
 
+// specID identifies the rate limiter configured by a spec. A strategy and a rate limiter
+// can be configured for the same operation.
+type specID struct {
+	key      cloud.RateLimitKey
+	strategy bool
+}
+
+// parseSpecID returns the specID of the given rate limiting spec.
+func parseSpecID(spec string) (specID, error) {
+	params := strings.Split(spec, ",")
+	if len(params) < 2 {
+		return specID{}, fmt.Errorf("must at least specify operation and rate limiter type")
+	}
+	key, err := constructRateLimitKey(params[0])
+	if err != nil {
+		return specID{}, err
+	}
+	return specID{key: key, strategy: params[1] == "strategy"}, nil
+}
+
+// MergeSpecs returns the rate limiting specs in base that are not overridden,
+// followed by the specs in overrides. A spec in overrides replaces the specs in base
+// for the same operation and of the same kind, strategy or rate limiter.
+// Expected format of specs is the one of NewGCERateLimiter.
+func MergeSpecs(base, overrides []string) ([]string, error) {
+	overridden := make(map[specID]bool)
+	for _, spec := range overrides {
+		id, err := parseSpecID(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limiting spec %q: %w", spec, err)
+		}
+		overridden[id] = true
+	}
+
+	var merged []string
+	for _, spec := range base {
+		id, err := parseSpecID(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limiting spec %q: %w", spec, err)
+		}
+		if !overridden[id] {
+			merged = append(merged, spec)
+		}
+	}
+	return append(merged, overrides...), nil
+}
+
 func rateLimitKeyToString(key *cloud.RateLimitKey) string {
 	return fmt.Sprintf("%s.%s.%s", key.Version, key.Service, key.Operation)
 }
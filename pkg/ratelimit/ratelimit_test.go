@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"k8s.io/klog/v2"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -287,3 +288,64 @@ func TestStrategyRateLimiterGroupBlock(t *testing.T) {
 		t.Errorf("Expected 2 finished requests, but got %v", finished)
 	}
 }
+
+func TestMergeSpecs(t *testing.T) {
+	base := []string{
+		"ga.Operations.Get,qps,10,100",
+		"ga.BackendServices.Get,qps,1.8,1",
+		"ga.NetworkEndpointGroups.AttachNetworkEndpoints,strategy,dynamic,5s,30s,1,1,1,10s,20s",
+	}
+	for _, tc := range []struct {
+		desc      string
+		overrides []string
+		want      []string
+		wantErr   bool
+	}{
+		{
+			desc: "no overrides",
+			want: base,
+		},
+		{
+			desc:      "override rate limiter",
+			overrides: []string{"ga.Operations.Get,qps,1,1"},
+			want: []string{
+				"ga.BackendServices.Get,qps,1.8,1",
+				"ga.NetworkEndpointGroups.AttachNetworkEndpoints,strategy,dynamic,5s,30s,1,1,1,10s,20s",
+				"ga.Operations.Get,qps,1,1",
+			},
+		},
+		{
+			desc:      "strategy does not override rate limiter of the same operation",
+			overrides: []string{"ga.BackendServices.Get,strategy,dynamic,1s,10s,1,1,1,10s,20s"},
+			want:      append(append([]string{}, base...), "ga.BackendServices.Get,strategy,dynamic,1s,10s,1,1,1,10s,20s"),
+		},
+		{
+			desc:      "new operation",
+			overrides: []string{"beta.HealthChecks.Get,qps,2,2"},
+			want:      append(append([]string{}, base...), "beta.HealthChecks.Get,qps,2,2"),
+		},
+		{
+			desc:      "invalid key",
+			overrides: []string{"BackendServices.Get,qps,1,1"},
+			wantErr:   true,
+		},
+		{
+			desc:      "missing type",
+			overrides: []string{"ga.BackendServices.Get"},
+			wantErr:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := MergeSpecs(base, tc.overrides)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("MergeSpecs() = %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("MergeSpecs() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
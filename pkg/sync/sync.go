@@ -17,6 +17,7 @@ limitations under the License.
 package sync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -46,19 +47,19 @@ func NewIngressSyncer(controller Controller, logger klog.Logger) Syncer {
 }
 
 // Sync implements Syncer.
-func (s *IngressSyncer) Sync(state interface{}, ingLogger klog.Logger) error {
-	if err := s.controller.SyncBackends(state, ingLogger); err != nil {
+func (s *IngressSyncer) Sync(ctx context.Context, state interface{}, ingLogger klog.Logger) error {
+	if err := s.controller.SyncBackends(ctx, state, ingLogger); err != nil {
 		if err == ErrSkipBackendsSync {
 			return nil
 		}
 		return fmt.Errorf("error running backend syncing routine: %v", err)
 	}
 
-	if err := s.controller.SyncLoadBalancer(state, ingLogger); err != nil {
+	if err := s.controller.SyncLoadBalancer(ctx, state, ingLogger); err != nil {
 		return fmt.Errorf("error running load balancer syncing routine: %v", err)
 	}
 
-	if err := s.controller.PostProcess(state, ingLogger); err != nil {
+	if err := s.controller.PostProcess(ctx, state, ingLogger); err != nil {
 		return fmt.Errorf("error running post-process routine: %v", err)
 	}
 
@@ -66,13 +67,13 @@ func (s *IngressSyncer) Sync(state interface{}, ingLogger klog.Logger) error {
 }
 
 // GC implements Syncer.
-func (s *IngressSyncer) GC(ings []*v1.Ingress, currIng *v1.Ingress, frontendGCAlgorithm utils.FrontendGCAlgorithm, scope meta.KeyType, ingLogger klog.Logger) error {
+func (s *IngressSyncer) GC(ctx context.Context, ings []*v1.Ingress, currIng *v1.Ingress, frontendGCAlgorithm utils.FrontendGCAlgorithm, scope meta.KeyType, ingLogger klog.Logger) error {
 	var lbErr, err error
 	var errs []error
 	switch frontendGCAlgorithm {
 	case utils.CleanupV2FrontendResources:
 		ingLogger.V(3).Info("Using algorithm CleanupV2FrontendResources to GC frontend of ingress")
-		lbErr = s.controller.GCv2LoadBalancer(currIng, scope)
+		lbErr = s.controller.GCv2LoadBalancer(ctx, currIng, scope)
 
 		defer func() {
 			if err != nil {
@@ -82,7 +83,7 @@ func (s *IngressSyncer) GC(ings []*v1.Ingress, currIng *v1.Ingress, frontendGCAl
 		}()
 	case utils.CleanupV2FrontendResourcesScopeChange:
 		ingLogger.V(3).Info("Using algorithm CleanupV2FrontendResourcesScopeChange to GC frontend of ingress")
-		lbErr = s.controller.GCv2LoadBalancer(currIng, scope)
+		lbErr = s.controller.GCv2LoadBalancer(ctx, currIng, scope)
 	case utils.CleanupV1FrontendResources:
 		ingLogger.V(3).Info("Using algorithm CleanupV1FrontendResources to GC frontend of ingress")
 		// Filter GCE ingresses that use v1 naming scheme.
@@ -93,7 +94,7 @@ func (s *IngressSyncer) GC(ings []*v1.Ingress, currIng *v1.Ingress, frontendGCAl
 		toCleanupV1, toKeepV1 := v1Ingresses.Partition(utils.NeedsCleanup)
 		// Note that only GCE ingress associated resources are managed by this controller.
 		toKeepV1Gce := toKeepV1.Filter(utils.IsGCEIngress)
-		lbErr = s.controller.GCv1LoadBalancers(toKeepV1Gce.AsList())
+		lbErr = s.controller.GCv1LoadBalancers(ctx, toKeepV1Gce.AsList())
 
 		defer func() {
 			if err != nil {
@@ -117,7 +118,7 @@ func (s *IngressSyncer) GC(ings []*v1.Ingress, currIng *v1.Ingress, frontendGCAl
 	toKeep := operator.Ingresses(ings).Filter(func(ing *v1.Ingress) bool {
 		return !utils.NeedsCleanup(ing)
 	}).AsList()
-	if beErr := s.controller.GCBackends(toKeep, ingLogger); beErr != nil {
+	if beErr := s.controller.GCBackends(ctx, toKeep, ingLogger); beErr != nil {
 		errs = append(errs, fmt.Errorf("error running backend garbage collection routine: %v", beErr))
 	}
 	if errs != nil {
@@ -2,55 +2,186 @@ package systemhealth
 
 import (
 	"sync"
+	"time"
 
 	"k8s.io/klog/v2"
 )
 
+// State is the health state of a component.
+type State string
+
+const (
+	// StateHealthy is the state of a component working as expected.
+	StateHealthy State = "healthy"
+	// StateDegraded is the state of a component which works but is falling
+	// behind. A degraded component does not fail the health check.
+	StateDegraded State = "degraded"
+	// StateUnhealthy is the state of a component which does not work.
+	StateUnhealthy State = "unhealthy"
+)
+
+// severity orders the states from healthy to unhealthy.
+func (s State) severity() int {
+	switch s {
+	case StateHealthy:
+		return 0
+	case StateDegraded:
+		return 1
+	}
+	return 2
+}
+
+// Status is the health status of a component. The details are only set by
+// the components which have them.
+type Status struct {
+	State   State  `json:"state"`
+	Message string `json:"message,omitempty"`
+	// CacheSynced is whether the informer caches of the component are synced.
+	CacheSynced *bool `json:"cacheSynced,omitempty"`
+	// LastSyncAgeSeconds is the time since the last successful sync.
+	LastSyncAgeSeconds *float64 `json:"lastSyncAgeSeconds,omitempty"`
+	// QueueDepth is the number of items waiting to be synced.
+	QueueDepth *int `json:"queueDepth,omitempty"`
+}
+
+// StatusFromError returns an unhealthy status with the message of err, or a
+// healthy status if err is nil.
+func StatusFromError(err error) Status {
+	if err != nil {
+		return Status{State: StateUnhealthy, Message: err.Error()}
+	}
+	return Status{State: StateHealthy}
+}
+
+// Degraded returns s as degraded with the given message, unless s is
+// already unhealthy.
+func (s Status) Degraded(message string) Status {
+	if s.State == StateUnhealthy {
+		return s
+	}
+	s.State = StateDegraded
+	s.Message = message
+	return s
+}
+
+// WithCacheSynced returns s with the cache sync status set.
+func (s Status) WithCacheSynced(synced bool) Status {
+	s.CacheSynced = &synced
+	return s
+}
+
+// WithLastSync returns s with the age of the last sync at lastSync.
+func (s Status) WithLastSync(lastSync time.Time) Status {
+	age := time.Since(lastSync).Seconds()
+	s.LastSyncAgeSeconds = &age
+	return s
+}
+
+// WithQueueDepth returns s with the queue depth set.
+func (s Status) WithQueueDepth(depth int) Status {
+	s.QueueDepth = &depth
+	return s
+}
+
+// Report is the health of the binary and of each of its components.
+type Report struct {
+	// State is the worst state of the components.
+	State      State             `json:"state"`
+	Components map[string]Status `json:"components"`
+	// Leading maps the leader election locks to whether they are held.
+	Leading map[string]bool `json:"leading,omitempty"`
+}
+
+// Ready returns true if the caches of all the components are synced and
+// none of them is unhealthy.
+func (r Report) Ready() bool {
+	for _, status := range r.Components {
+		if status.State == StateUnhealthy || (status.CacheSynced != nil && !*status.CacheSynced) {
+			return false
+		}
+	}
+	return true
+}
+
+// Live returns false only if all the components are unhealthy, so that a
+// single stuck controller does not get the other ones restarted.
+func (r Report) Live() bool {
+	if len(r.Components) == 0 {
+		return true
+	}
+	for _, status := range r.Components {
+		if status.State != StateUnhealthy {
+			return true
+		}
+	}
+	return false
+}
+
 // SystemHealth is responsible for checking the health of the ingress-gce
 // binary and the controllers running inside this binary.
 type SystemHealth struct {
 	hcLock       sync.Mutex
-	healthChecks map[string]func() error
+	healthChecks map[string]func() Status
+	leading      map[string]bool
 	logger       klog.Logger
 }
 
 // NewSystemHealth creates a new SystemHealth instance.
 func NewSystemHealth(logger klog.Logger) *SystemHealth {
 	return &SystemHealth{
-		healthChecks: make(map[string]func() error),
+		healthChecks: make(map[string]func() Status),
+		leading:      make(map[string]bool),
 		logger:       logger,
 	}
 }
 
-// AddHealthCheck registers a function to be called for health checking.
+// AddHealthCheck registers a function to be called for health checking. The
+// component is unhealthy when the function returns an error.
 func (sh *SystemHealth) AddHealthCheck(id string, hc func() error) {
+	sh.AddStatusCheck(id, func() Status { return StatusFromError(hc()) })
+}
+
+// AddStatusCheck registers a function returning the detailed status of a
+// component.
+func (sh *SystemHealth) AddStatusCheck(id string, sc func() Status) {
 	sh.hcLock.Lock()
 	defer sh.hcLock.Unlock()
 
 	sh.logger.Info("Adding health check", "id", id)
-	sh.healthChecks[id] = hc
+	sh.healthChecks[id] = sc
 }
 
-// HealthCheckResults contains a mapping of component -> health check results.
-type HealthCheckResults map[string]error
+// SetLeading records whether the leader election lock is held.
+func (sh *SystemHealth) SetLeading(lock string, leading bool) {
+	sh.hcLock.Lock()
+	defer sh.hcLock.Unlock()
 
-// HealthCheck runs all registered health check functions.
-func (sh *SystemHealth) HealthCheck() HealthCheckResults {
+	sh.leading[lock] = leading
+}
+
+// Check runs all registered health check functions.
+func (sh *SystemHealth) Check() Report {
 	sh.hcLock.Lock()
 	defer sh.hcLock.Unlock()
 
-	healthChecks := make(map[string]error)
-	anyFailed := false
+	report := Report{
+		State:      StateHealthy,
+		Components: make(map[string]Status),
+		Leading:    make(map[string]bool),
+	}
 	for component, f := range sh.healthChecks {
 		sh.logger.V(5).Info("Running health check", "component", component)
-		result := f()
-		healthChecks[component] = result
-		if result != nil {
-			anyFailed = true
+		status := f()
+		report.Components[component] = status
+		if status.State.severity() > report.State.severity() {
+			report.State = status.State
 		}
 	}
-	if anyFailed {
-		sh.logger.Info("Health check results", "results", healthChecks)
+	for lock, leading := range sh.leading {
+		report.Leading[lock] = leading
+	}
+	if report.State != StateHealthy {
+		sh.logger.Info("Health check results", "state", report.State, "results", report.Components)
 	}
-	return healthChecks
+	return report
 }
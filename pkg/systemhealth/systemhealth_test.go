@@ -0,0 +1,104 @@
+package systemhealth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+func TestCheck(t *testing.T) {
+	sh := NewSystemHealth(klog.TODO())
+	sh.AddHealthCheck("ok", func() error { return nil })
+	sh.AddStatusCheck("behind", func() Status {
+		return StatusFromError(nil).WithQueueDepth(10).WithLastSync(time.Now().Add(-time.Hour)).Degraded("falling behind")
+	})
+	sh.SetLeading("lock", true)
+
+	report := sh.Check()
+	if report.State != StateDegraded {
+		t.Errorf("Check().State = %q, want %q", report.State, StateDegraded)
+	}
+	if got := report.Components["ok"].State; got != StateHealthy {
+		t.Errorf("State of %q = %q, want %q", "ok", got, StateHealthy)
+	}
+	behind := report.Components["behind"]
+	if behind.QueueDepth == nil || *behind.QueueDepth != 10 {
+		t.Errorf("QueueDepth of %q = %v, want 10", "behind", behind.QueueDepth)
+	}
+	if behind.LastSyncAgeSeconds == nil || *behind.LastSyncAgeSeconds < time.Hour.Seconds() {
+		t.Errorf("LastSyncAgeSeconds of %q = %v, want at least an hour", "behind", behind.LastSyncAgeSeconds)
+	}
+	if !report.Leading["lock"] {
+		t.Errorf("Leading[%q] = false, want true", "lock")
+	}
+
+	sh.AddHealthCheck("broken", func() error { return errors.New("broken") })
+	report = sh.Check()
+	if report.State != StateUnhealthy {
+		t.Errorf("Check().State = %q, want %q", report.State, StateUnhealthy)
+	}
+	if got := report.Components["broken"].Message; got != "broken" {
+		t.Errorf("Message of %q = %q, want %q", "broken", got, "broken")
+	}
+}
+
+func TestDegradedKeepsUnhealthy(t *testing.T) {
+	status := StatusFromError(errors.New("broken")).Degraded("falling behind")
+	if status.State != StateUnhealthy || status.Message != "broken" {
+		t.Errorf("Degraded() of an unhealthy status = %+v, want it unchanged", status)
+	}
+}
+
+func TestReadyAndLive(t *testing.T) {
+	healthy := StatusFromError(nil).WithCacheSynced(true)
+	notSynced := StatusFromError(nil).WithCacheSynced(false)
+	unhealthy := StatusFromError(errors.New("stuck"))
+	for _, tc := range []struct {
+		desc       string
+		components map[string]Status
+		wantReady  bool
+		wantLive   bool
+	}{
+		{
+			desc:      "no components",
+			wantReady: true,
+			wantLive:  true,
+		},
+		{
+			desc:       "all healthy",
+			components: map[string]Status{"ingress": healthy, "neg-controller": healthy},
+			wantReady:  true,
+			wantLive:   true,
+		},
+		{
+			desc:       "cache not synced",
+			components: map[string]Status{"ingress": healthy, "neg-controller": notSynced},
+			wantReady:  false,
+			wantLive:   true,
+		},
+		{
+			desc:       "one unhealthy",
+			components: map[string]Status{"ingress": healthy, "neg-controller": unhealthy},
+			wantReady:  false,
+			wantLive:   true,
+		},
+		{
+			desc:       "all unhealthy",
+			components: map[string]Status{"ingress": unhealthy, "neg-controller": unhealthy},
+			wantReady:  false,
+			wantLive:   false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			report := Report{Components: tc.components}
+			if got := report.Ready(); got != tc.wantReady {
+				t.Errorf("Ready() = %v, want %v", got, tc.wantReady)
+			}
+			if got := report.Live(); got != tc.wantLive {
+				t.Errorf("Live() = %v, want %v", got, tc.wantLive)
+			}
+		})
+	}
+}
@@ -570,6 +570,11 @@ func FakeGoogleAPIConflictErr() *googleapi.Error {
 	return &googleapi.Error{Code: http.StatusConflict}
 }
 
+// FakeGoogleAPIPreconditionFailedErr creates a StatusPreconditionFailed error with type googleapi.Error
+func FakeGoogleAPIPreconditionFailedErr() *googleapi.Error {
+	return &googleapi.Error{Code: http.StatusPreconditionFailed}
+}
+
 // FakeGoogleAPIRequestEntityTooLargeError creates a StatusRequestEntityTooLarge error with type googleapi.Error
 func FakeGoogleAPIRequestEntityTooLargeError() *googleapi.Error {
 	return &googleapi.Error{Code: http.StatusRequestEntityTooLarge}
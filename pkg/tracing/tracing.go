@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing exports OpenTelemetry traces of controller syncs and the
+// GCE API calls they make.
+//
+// Tracing is disabled unless Init is called, in which case all spans are
+// no-ops.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/klog/v2"
+)
+
+const (
+	tracerName  = "k8s.io/ingress-gce"
+	serviceName = "glbc"
+)
+
+// Init exports the spans of the process to the OTLP gRPC collector at
+// endpoint, e.g. http://localhost:4317. sampleRatio is the fraction of root
+// spans which are sampled. The returned function flushes pending spans and
+// must be called before the process exits.
+func Init(ctx context.Context, endpoint string, sampleRatio float64, logger klog.Logger) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter for %q: %w", endpoint, err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Error(err, "OpenTelemetry error")
+	}))
+	logger.Info("Exporting traces", "endpoint", endpoint, "sampleRatio", sampleRatio)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span with the given name as a child of the span in ctx, if
+// any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartSync starts the root span of a controller sync as a child of ctx. It
+// returns ctx with the span, to be passed to the GCE calls made during the
+// sync, and logger with the trace ID, so that the log lines of the sync can be
+// correlated with its trace. ctx holds the values of the sync which the GCE
+// calls need, e.g. their rate limiting priority.
+func StartSync(ctx context.Context, logger klog.Logger, name string, attrs ...attribute.KeyValue) (context.Context, klog.Logger, trace.Span) {
+	ctx, span := Start(ctx, name, attrs...)
+	if span.SpanContext().IsValid() {
+		logger = logger.WithValues("traceID", span.SpanContext().TraceID().String())
+	}
+	return ctx, logger, span
+}
+
+// End records err, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"k8s.io/klog/v2/ktesting"
+)
+
+func setupExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return exporter
+}
+
+func TestSpanParenting(t *testing.T) {
+	exporter := setupExporter(t)
+	logger, _ := ktesting.NewTestContext(t)
+
+	syncCtx, _, syncSpan := StartSync(context.Background(), logger, "sync")
+	_, callSpan := Start(syncCtx, "call")
+	End(callSpan, errors.New("googleapi: Error 404"))
+	End(syncSpan, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	call, sync := spans[0], spans[1]
+	if call.Parent.SpanID() != sync.SpanContext.SpanID() {
+		t.Errorf("call span parent = %v, want %v", call.Parent.SpanID(), sync.SpanContext.SpanID())
+	}
+	if call.Status.Code != codes.Error {
+		t.Errorf("call span status = %v, want %v", call.Status.Code, codes.Error)
+	}
+	if sync.Status.Code != codes.Unset {
+		t.Errorf("sync span status = %v, want %v", sync.Status.Code, codes.Unset)
+	}
+}
+
+func TestStartWithoutSpan(t *testing.T) {
+	exporter := setupExporter(t)
+
+	_, span := Start(context.Background(), "call")
+	End(span, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if spans[0].Parent.IsValid() {
+		t.Errorf("span has parent %v, want root span", spans[0].Parent.SpanID())
+	}
+}
+
+type testKey struct{}
+
+func TestStartSyncValues(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	ctx, _, span := StartSync(context.WithValue(context.Background(), testKey{}, "sync"), logger, "sync")
+	defer End(span, nil)
+
+	if got := ctx.Value(testKey{}); got != "sync" {
+		t.Errorf("ctx.Value(testKey{}) = %v, want the value of the parent context %q", got, "sync")
+	}
+}
@@ -103,6 +103,10 @@ type HealthCheck struct {
 	computealpha.HTTPHealthCheck
 	computealpha.HealthCheck
 
+	// GRPCServiceName is the gRPC service name checked by GRPC health checks.
+	// Port settings of GRPC health checks are kept in HTTPHealthCheck too.
+	GRPCServiceName string
+
 	Service         *v1.Service
 	healthcheckInfo healthcheck.HealthcheckInfo
 }
@@ -143,6 +147,16 @@ func NewHealthCheck(hc *computealpha.HealthCheck) (*HealthCheck, error) {
 			return nil, fmt.Errorf(newHealthCheckErrorMessageTemplate, annotations.ProtocolHTTP2, hc.Name)
 		}
 		v.HTTPHealthCheck = computealpha.HTTPHealthCheck(*hc.Http2HealthCheck)
+	case annotations.ProtocolGRPC:
+		if hc.GrpcHealthCheck == nil {
+			return nil, fmt.Errorf(newHealthCheckErrorMessageTemplate, annotations.ProtocolGRPC, hc.Name)
+		}
+		v.HTTPHealthCheck = computealpha.HTTPHealthCheck{
+			Port:              hc.GrpcHealthCheck.Port,
+			PortName:          hc.GrpcHealthCheck.PortName,
+			PortSpecification: hc.GrpcHealthCheck.PortSpecification,
+		}
+		v.GRPCServiceName = hc.GrpcHealthCheck.GrpcServiceName
 	}
 
 	// Users should be modifying HTTP(S) specific settings on the embedded
@@ -150,6 +164,7 @@ func NewHealthCheck(hc *computealpha.HealthCheck) (*HealthCheck, error) {
 	v.HealthCheck.HttpHealthCheck = nil
 	v.HealthCheck.HttpsHealthCheck = nil
 	v.HealthCheck.Http2HealthCheck = nil
+	v.HealthCheck.GrpcHealthCheck = nil
 
 	return v, nil
 }
@@ -196,6 +211,7 @@ func (hc *HealthCheck) merge() error {
 	hc.HealthCheck.Http2HealthCheck = nil
 	hc.HealthCheck.HttpsHealthCheck = nil
 	hc.HealthCheck.HttpHealthCheck = nil
+	hc.HealthCheck.GrpcHealthCheck = nil
 
 	switch hc.Protocol() {
 	case annotations.ProtocolHTTP:
@@ -207,21 +223,28 @@ func (hc *HealthCheck) merge() error {
 	case annotations.ProtocolHTTP2:
 		http2 := computealpha.HTTP2HealthCheck(hc.HTTPHealthCheck)
 		hc.HealthCheck.Http2HealthCheck = &http2
+	case annotations.ProtocolGRPC:
+		hc.HealthCheck.GrpcHealthCheck = &computealpha.GRPCHealthCheck{
+			GrpcServiceName:   hc.GRPCServiceName,
+			Port:              hc.HTTPHealthCheck.Port,
+			PortName:          hc.HTTPHealthCheck.PortName,
+			PortSpecification: hc.HTTPHealthCheck.PortSpecification,
+		}
 	default:
-		return fmt.Errorf("Protocol %q is not valid, must be one of [%q,%q,%q]",
-			hc.Protocol(), annotations.ProtocolHTTP, annotations.ProtocolHTTPS, annotations.ProtocolHTTP2,
+		return fmt.Errorf("Protocol %q is not valid, must be one of [%q,%q,%q,%q]",
+			hc.Protocol(), annotations.ProtocolHTTP, annotations.ProtocolHTTPS, annotations.ProtocolHTTP2, annotations.ProtocolGRPC,
 		)
 	}
 	return nil
 }
 
 // Version returns the appropriate API version to handle the health check
-// Use Beta API for NEG as PORT_SPECIFICATION is required, and HTTP2
+// Use Beta API for NEG as PORT_SPECIFICATION is required, and HTTP2 and GRPC
 func (hc *HealthCheck) Version() meta.Version {
 	if hc.ForILB {
 		return features.L7ILBVersions().HealthCheck
 	}
-	if hc.Protocol() == annotations.ProtocolHTTP2 || hc.ForNEG {
+	if hc.Protocol() == annotations.ProtocolHTTP2 || hc.Protocol() == annotations.ProtocolGRPC || hc.ForNEG {
 		return meta.VersionBeta
 	}
 	return meta.VersionGA
@@ -261,6 +284,9 @@ func (hc *HealthCheck) UpdateFromBackendConfig(c *backendconfigv1.HealthCheckCon
 	if c.RequestPath != nil {
 		hc.RequestPath = *c.RequestPath
 	}
+	if c.GRPCServiceName != nil {
+		hc.GRPCServiceName = *c.GRPCServiceName
+	}
 	if c.Port != nil {
 		hc.Port = *c.Port
 		// This override is necessary regardless of type
@@ -351,11 +377,20 @@ func DefaultILBHealthCheck(protocol annotations.AppProtocol, spLogger klog.Logge
 }
 
 // ApplyProbeSettingsToHC takes the Pod healthcheck settings and applies it
-// to the healthcheck.
+// to the healthcheck. A gRPC probe turns the healthcheck into a GRPC one.
 //
 // TODO: what if the port changes?
-// TODO: does not handle protocol?
 func ApplyProbeSettingsToHC(p *v1.Probe, hc *HealthCheck, spLogger klog.Logger) {
+	if p.ProbeHandler.GRPC != nil {
+		hc.Type = string(annotations.ProtocolGRPC)
+		hc.RequestPath = ""
+		hc.GRPCServiceName = ""
+		if p.ProbeHandler.GRPC.Service != nil {
+			hc.GRPCServiceName = *p.ProbeHandler.GRPC.Service
+		}
+		applyProbeTimingToHC(p, hc, spLogger)
+		return
+	}
 	if p.ProbeHandler.HTTPGet == nil {
 		return
 	}
@@ -376,7 +411,12 @@ func ApplyProbeSettingsToHC(p *v1.Probe, hc *HealthCheck, spLogger klog.Logger)
 		}
 	}
 	hc.Host = host
+	applyProbeTimingToHC(p, hc, spLogger)
+}
 
+// applyProbeTimingToHC applies the timeout and period of the Pod healthcheck
+// settings to the healthcheck.
+func applyProbeTimingToHC(p *v1.Probe, hc *HealthCheck, spLogger klog.Logger) {
 	hc.TimeoutSec = int64(p.TimeoutSeconds)
 	if hc.ForNEG {
 		// For NEG mode, we can support more aggressive healthcheck interval.
@@ -22,7 +22,9 @@ import (
 
 	"github.com/kr/pretty"
 	computealpha "google.golang.org/api/compute/v0.alpha"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/utils/healthcheck"
 	"k8s.io/klog/v2"
 )
@@ -45,6 +47,10 @@ func TestMerge(t *testing.T) {
 			desc: "HTTPS",
 			hc:   &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "HTTPS"}},
 		},
+		{
+			desc: "GRPC",
+			hc:   &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "GRPC"}, GRPCServiceName: "foo.Bar"},
+		},
 		{
 			desc:    "Malformed Protocol",
 			hc:      &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "http"}},
@@ -77,6 +83,10 @@ func TestMerge(t *testing.T) {
 				if tc.hc.HttpHealthCheck != nil || tc.hc.HttpsHealthCheck != nil || tc.hc.Http2HealthCheck == nil {
 					t.Errorf("Invalid HC %v for protocol %q", tc.hc, annotations.ProtocolHTTP2)
 				}
+			case annotations.ProtocolGRPC:
+				if tc.hc.HttpHealthCheck != nil || tc.hc.Http2HealthCheck != nil || tc.hc.GrpcHealthCheck == nil || tc.hc.GrpcHealthCheck.GrpcServiceName != tc.hc.GRPCServiceName {
+					t.Errorf("Invalid HC %v for protocol %q", tc.hc, annotations.ProtocolGRPC)
+				}
 			}
 
 			// Verify port spec
@@ -118,3 +128,65 @@ func TestOverwriteWithTHC(t *testing.T) {
 		t.Fatalf("Translate healthcheck is:\n%s, want:\n%s", pretty.Sprint(hc), pretty.Sprint(wantHC))
 	}
 }
+
+func TestNewHealthCheckGRPC(t *testing.T) {
+	hc, err := NewHealthCheck(&computealpha.HealthCheck{
+		Name: "hc",
+		Type: "GRPC",
+		GrpcHealthCheck: &computealpha.GRPCHealthCheck{
+			GrpcServiceName:   "foo.Bar",
+			PortSpecification: "USE_SERVING_PORT",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewHealthCheck() = %v, want nil", err)
+	}
+	if hc.GRPCServiceName != "foo.Bar" || hc.PortSpecification != "USE_SERVING_PORT" || hc.HealthCheck.GrpcHealthCheck != nil {
+		t.Errorf("NewHealthCheck() = %s, want GRPC settings moved to the wrapper", pretty.Sprint(hc))
+	}
+
+	got, err := hc.ToAlphaComputeHealthCheck()
+	if err != nil {
+		t.Fatalf("ToAlphaComputeHealthCheck() = %v, want nil", err)
+	}
+	want := &computealpha.GRPCHealthCheck{GrpcServiceName: "foo.Bar", PortSpecification: "USE_SERVING_PORT"}
+	if !reflect.DeepEqual(got.GrpcHealthCheck, want) {
+		t.Errorf("ToAlphaComputeHealthCheck().GrpcHealthCheck = %s, want %s", pretty.Sprint(got.GrpcHealthCheck), pretty.Sprint(want))
+	}
+
+	if _, err := NewHealthCheck(&computealpha.HealthCheck{Name: "hc", Type: "GRPC"}); err == nil {
+		t.Errorf("NewHealthCheck() with nil GrpcHealthCheck = nil, want error")
+	}
+}
+
+func TestUpdateFromBackendConfigGRPC(t *testing.T) {
+	hc := DefaultNEGHealthCheck(annotations.ProtocolHTTP, klog.TODO())
+	grpcType, serviceName := "GRPC", "foo.Bar"
+	hc.UpdateFromBackendConfig(&backendconfigv1.HealthCheckConfig{Type: &grpcType, GRPCServiceName: &serviceName}, klog.TODO())
+
+	if hc.Protocol() != annotations.ProtocolGRPC || hc.GRPCServiceName != serviceName {
+		t.Errorf("UpdateFromBackendConfig() = %s, want GRPC health check for service %q", pretty.Sprint(hc), serviceName)
+	}
+}
+
+func TestApplyProbeSettingsToHCGRPC(t *testing.T) {
+	serviceName := "foo.Bar"
+	probe := &v1.Probe{
+		ProbeHandler:   v1.ProbeHandler{GRPC: &v1.GRPCAction{Port: 8080, Service: &serviceName}},
+		TimeoutSeconds: 3,
+		PeriodSeconds:  7,
+	}
+	hc := DefaultNEGHealthCheck(annotations.ProtocolGRPC, klog.TODO())
+	hc.RequestPath = "/"
+	ApplyProbeSettingsToHC(probe, hc, klog.TODO())
+
+	if hc.Protocol() != annotations.ProtocolGRPC || hc.GRPCServiceName != serviceName || hc.RequestPath != "" {
+		t.Errorf("ApplyProbeSettingsToHC() = %s, want GRPC health check for service %q without request path", pretty.Sprint(hc), serviceName)
+	}
+	if hc.TimeoutSec != 3 || hc.CheckIntervalSec != 7 {
+		t.Errorf("ApplyProbeSettingsToHC() timeout, interval = %d, %d, want 3, 7", hc.TimeoutSec, hc.CheckIntervalSec)
+	}
+	if hc.Description != DescriptionForHealthChecksFromReadinessProbe {
+		t.Errorf("ApplyProbeSettingsToHC() description = %q, want %q", hc.Description, DescriptionForHealthChecksFromReadinessProbe)
+	}
+}
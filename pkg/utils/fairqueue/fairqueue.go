@@ -0,0 +1,370 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairqueue implements a rate limited work queue which hands out
+// the changes made by users before periodic resyncs, and serves tenants in
+// turn so that a tenant with many changing objects does not delay the
+// others. Tenants are namespaces, or ProviderConfigs in multi-project mode.
+package fairqueue
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/ingress-gce/pkg/flags"
+)
+
+// Class is the class of a work item. Items of a class are handed out only
+// when no item of a higher class is queued.
+type Class int
+
+const (
+	// ClassUser is the class of the changes made by users.
+	ClassUser Class = iota
+	// ClassResync is the class of periodic resyncs and of the retries of
+	// failed syncs.
+	ClassResync
+
+	numClasses = 2
+)
+
+func (c Class) String() string {
+	switch c {
+	case ClassUser:
+		return "user"
+	case ClassResync:
+		return "resync"
+	}
+	return "unknown"
+}
+
+// Queue is a workqueue.RateLimitingInterface which queues items by class and
+// tenant. Like the client-go work queues, an item is queued at most once and
+// is not handed out again until Done is called for it.
+//
+// Items added through the workqueue interface are of the class ClassUser and
+// of the tenant returned by Tenant, use AddWithClass to set them.
+type Queue struct {
+	name        string
+	rateLimiter workqueue.TypedRateLimiter[any]
+
+	lock sync.Mutex
+	cond *sync.Cond
+	// classes holds the queued items by class.
+	classes [numClasses]tenantQueue
+	// queued are the items waiting to be handed out.
+	queued map[any]*entry
+	// processing are the items handed out for which Done was not called.
+	processing map[any]*entry
+	// requeued are the items added while they were processed, they are
+	// queued again by Done.
+	requeued     map[any]*entry
+	shuttingDown bool
+}
+
+type entry struct {
+	tenant string
+	class  Class
+	// added is the time at which the item was queued.
+	added time.Time
+}
+
+// tenantQueue holds the items of a class by tenant. Tenants are served round
+// robin.
+type tenantQueue struct {
+	// tenants are the tenants with queued items, in the order they are
+	// served.
+	tenants []string
+	items   map[string][]any
+}
+
+var _ workqueue.RateLimitingInterface = &Queue{}
+
+// New returns a fair queue whose requeues are delayed by rateLimiter. name
+// labels the metrics of the queue.
+func New(name string, rateLimiter workqueue.TypedRateLimiter[any]) *Queue {
+	q := &Queue{
+		name:        name,
+		rateLimiter: rateLimiter,
+		queued:      make(map[any]*entry),
+		processing:  make(map[any]*entry),
+		requeued:    make(map[any]*entry),
+	}
+	q.cond = sync.NewCond(&q.lock)
+	for class := range q.classes {
+		q.classes[class].items = make(map[string][]any)
+	}
+	return q
+}
+
+// Tenant returns the tenant of a Kubernetes object: its ProviderConfig in
+// multi-project mode, its namespace otherwise. The tenant of a key is its
+// namespace.
+func Tenant(obj interface{}) string {
+	switch o := obj.(type) {
+	case cache.DeletedFinalStateUnknown:
+		if o.Obj == nil {
+			return keyTenant(o.Key)
+		}
+		obj = o.Obj
+	case cache.ExplicitKey:
+		return keyTenant(string(o))
+	case string:
+		return keyTenant(o)
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	if flags.F.EnableMultiProjectMode {
+		if providerConfig := accessor.GetLabels()[flags.F.ProviderConfigNameLabelKey]; providerConfig != "" {
+			return providerConfig
+		}
+	}
+	return accessor.GetNamespace()
+}
+
+// AddWithClass adds item to q with the given tenant and class if q is a fair
+// queue, and simply adds it otherwise.
+func AddWithClass(q workqueue.RateLimitingInterface, item any, tenant string, class Class) {
+	if fq, ok := q.(*Queue); ok {
+		fq.AddWithClass(item, tenant, class)
+		return
+	}
+	q.Add(item)
+}
+
+// AddWithClass queues item for tenant. An item which is already queued keeps
+// its place unless it is moved to a higher class.
+func (q *Queue) AddWithClass(item any, tenant string, class Class) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.addLocked(item, &entry{tenant: tenant, class: class, added: time.Now()})
+}
+
+func (q *Queue) addLocked(item any, e *entry) {
+	if q.shuttingDown {
+		return
+	}
+	if queued, ok := q.queued[item]; ok {
+		if e.class < queued.class {
+			q.classes[queued.class].remove(item, queued.tenant)
+			queued.class, queued.tenant = e.class, e.tenant
+			q.classes[queued.class].push(item, queued.tenant)
+			q.publishDepthLocked()
+		}
+		return
+	}
+	if _, ok := q.processing[item]; ok {
+		if requeued, ok := q.requeued[item]; !ok || e.class < requeued.class {
+			q.requeued[item] = e
+		}
+		return
+	}
+	q.queued[item] = e
+	q.classes[e.class].push(item, e.tenant)
+	q.publishDepthLocked()
+	q.cond.Signal()
+}
+
+// Add queues item as a change of its tenant, see Tenant.
+func (q *Queue) Add(item any) {
+	q.AddWithClass(item, Tenant(item), ClassUser)
+}
+
+// Len returns the number of queued items.
+func (q *Queue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.queued)
+}
+
+// Get blocks until an item can be handed out, and returns it. It returns
+// true once q is shut down and empty.
+func (q *Queue) Get() (any, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for len(q.queued) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	for class := range q.classes {
+		item, ok := q.classes[class].pop()
+		if !ok {
+			continue
+		}
+		e := q.queued[item]
+		delete(q.queued, item)
+		q.processing[item] = e
+		waitDuration.WithLabelValues(q.name, e.class.String()).Observe(time.Since(e.added).Seconds())
+		q.publishDepthLocked()
+		return item, false
+	}
+	return nil, true
+}
+
+// Done marks item as processed, it is queued again if it was added while it
+// was processed.
+func (q *Queue) Done(item any) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.processing, item)
+	if e, ok := q.requeued[item]; ok {
+		delete(q.requeued, item)
+		q.addLocked(item, e)
+	}
+	if len(q.processing) == 0 {
+		// Unblock ShutDownWithDrain.
+		q.cond.Broadcast()
+	}
+}
+
+// ShutDown makes Get return once the queued items are handed out, and
+// ignores new items.
+func (q *Queue) ShutDown() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain shuts q down and waits until the items handed out are
+// processed.
+func (q *Queue) ShutDownWithDrain() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) > 0 {
+		q.cond.Wait()
+	}
+}
+
+// ShuttingDown returns true once ShutDown was called.
+func (q *Queue) ShuttingDown() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds item after duration.
+func (q *Queue) AddAfter(item any, duration time.Duration) {
+	q.addAfter(item, Tenant(item), ClassUser, duration)
+}
+
+// AddRateLimited adds item once the rate limiter allows it. Items are
+// requeued as resyncs of their tenant, so that the retries of failing items
+// do not delay changes.
+func (q *Queue) AddRateLimited(item any) {
+	tenant := Tenant(item)
+	q.lock.Lock()
+	if e, ok := q.processing[item]; ok {
+		tenant = e.tenant
+	} else if e, ok := q.queued[item]; ok {
+		tenant = e.tenant
+	}
+	q.lock.Unlock()
+	q.addAfter(item, tenant, ClassResync, q.rateLimiter.When(item))
+}
+
+func (q *Queue) addAfter(item any, tenant string, class Class, duration time.Duration) {
+	if q.ShuttingDown() {
+		return
+	}
+	if duration <= 0 {
+		q.AddWithClass(item, tenant, class)
+		return
+	}
+	time.AfterFunc(duration, func() { q.AddWithClass(item, tenant, class) })
+}
+
+// Forget makes the rate limiter forget the requeues of item.
+func (q *Queue) Forget(item any) {
+	q.rateLimiter.Forget(item)
+}
+
+// NumRequeues returns the number of times item was requeued.
+func (q *Queue) NumRequeues(item any) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+func (q *Queue) publishDepthLocked() {
+	for class := range q.classes {
+		depth := 0
+		for _, items := range q.classes[class].items {
+			depth += len(items)
+		}
+		queueDepth.WithLabelValues(q.name, Class(class).String()).Set(float64(depth))
+	}
+}
+
+// keyTenant returns the namespace of a "namespace/name" key.
+func keyTenant(key string) string {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return ""
+	}
+	return namespace
+}
+
+func (t *tenantQueue) push(item any, tenant string) {
+	if len(t.items[tenant]) == 0 {
+		t.tenants = append(t.tenants, tenant)
+	}
+	t.items[tenant] = append(t.items[tenant], item)
+}
+
+// pop returns the first item of the next tenant, and moves the tenant to the
+// end of the line if it has more items.
+func (t *tenantQueue) pop() (any, bool) {
+	if len(t.tenants) == 0 {
+		return nil, false
+	}
+	tenant := t.tenants[0]
+	t.tenants = t.tenants[1:]
+	items := t.items[tenant]
+	item := items[0]
+	if len(items) == 1 {
+		delete(t.items, tenant)
+	} else {
+		t.items[tenant] = items[1:]
+		t.tenants = append(t.tenants, tenant)
+	}
+	return item, true
+}
+
+func (t *tenantQueue) remove(item any, tenant string) {
+	items := t.items[tenant]
+	for i, other := range items {
+		if other == item {
+			items = append(items[:i:i], items[i+1:]...)
+			break
+		}
+	}
+	if len(items) > 0 {
+		t.items[tenant] = items
+		return
+	}
+	delete(t.items, tenant)
+	for i, other := range t.tenants {
+		if other == tenant {
+			t.tenants = append(t.tenants[:i:i], t.tenants[i+1:]...)
+			break
+		}
+	}
+}
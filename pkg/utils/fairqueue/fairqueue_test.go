@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/ingress-gce/pkg/flags"
+)
+
+func newTestQueue() *Queue {
+	return New("test", workqueue.DefaultTypedControllerRateLimiter[any]())
+}
+
+// drain gets all the queued items, marking each done.
+func drain(t *testing.T, q *Queue) []any {
+	t.Helper()
+	var items []any
+	for q.Len() > 0 {
+		item, shutdown := q.Get()
+		if shutdown {
+			t.Fatalf("Get() returned shutdown with %d queued items", q.Len())
+		}
+		items = append(items, item)
+		q.Done(item)
+	}
+	return items
+}
+
+func TestQueueOrder(t *testing.T) {
+	q := newTestQueue()
+	// A namespace with many changes does not delay the others.
+	q.Add("busy/svc-1")
+	q.Add("busy/svc-2")
+	q.Add("busy/svc-3")
+	q.Add("quiet/svc-1")
+	q.AddWithClass("resync/svc-1", "resync", ClassResync)
+	q.Add("other/svc-1")
+	q.Add("quiet/svc-2")
+
+	want := []any{"busy/svc-1", "quiet/svc-1", "other/svc-1", "busy/svc-2", "quiet/svc-2", "busy/svc-3", "resync/svc-1"}
+	if diff := cmp.Diff(want, drain(t, q)); diff != "" {
+		t.Errorf("Unexpected order of items (-want +got):\n%s", diff)
+	}
+}
+
+func TestQueueClassChange(t *testing.T) {
+	q := newTestQueue()
+	q.AddWithClass("ns/svc-1", "ns", ClassResync)
+	q.AddWithClass("ns/svc-2", "ns", ClassResync)
+	// A change of a queued resync moves it ahead.
+	q.Add("ns/svc-2")
+	// A resync of a queued change does not move it back.
+	q.Add("ns/svc-3")
+	q.AddWithClass("ns/svc-3", "ns", ClassResync)
+	if q.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", q.Len())
+	}
+
+	want := []any{"ns/svc-2", "ns/svc-3", "ns/svc-1"}
+	if diff := cmp.Diff(want, drain(t, q)); diff != "" {
+		t.Errorf("Unexpected order of items (-want +got):\n%s", diff)
+	}
+}
+
+func TestQueueProcessing(t *testing.T) {
+	q := newTestQueue()
+	q.Add("ns/svc")
+	item, _ := q.Get()
+
+	// An item added while it is processed is handed out after Done.
+	q.Add("ns/svc")
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d while the item is processed, want 0", q.Len())
+	}
+	q.Done(item)
+	if q.Len() != 1 {
+		t.Errorf("Len() = %d after Done, want 1", q.Len())
+	}
+
+	item, _ = q.Get()
+	q.AddRateLimited(item)
+	q.Done(item)
+	q.ShutDownWithDrain()
+	if _, shutdown := q.Get(); !shutdown {
+		t.Errorf("Get() after ShutDown returned an item, want shutdown")
+	}
+	if got := q.NumRequeues(item); got != 1 {
+		t.Errorf("NumRequeues(%v) = %d, want 1", item, got)
+	}
+}
+
+func TestQueueRateLimitedClass(t *testing.T) {
+	q := New("test", workqueue.NewTypedItemFastSlowRateLimiter[any](0, 0, 0))
+	q.Add("ns/failing")
+	item, _ := q.Get()
+	q.AddRateLimited(item)
+	q.Done(item)
+	q.Add("ns/changed")
+
+	// The retry of the failed item does not delay the change.
+	want := []any{"ns/changed", "ns/failing"}
+	if diff := cmp.Diff(want, drain(t, q)); diff != "" {
+		t.Errorf("Unexpected order of items (-want +got):\n%s", diff)
+	}
+}
+
+func TestQueueGetBlocks(t *testing.T) {
+	q := newTestQueue()
+	got := make(chan any)
+	go func() {
+		item, _ := q.Get()
+		got <- item
+	}()
+	select {
+	case item := <-got:
+		t.Fatalf("Get() = %v on an empty queue, want it to block", item)
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.Add("ns/svc")
+	select {
+	case item := <-got:
+		if item != "ns/svc" {
+			t.Errorf("Get() = %v, want ns/svc", item)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Get() is still blocked after an item was added")
+	}
+}
+
+func TestTenant(t *testing.T) {
+	defer func(enabled bool) { flags.F.EnableMultiProjectMode = enabled }(flags.F.EnableMultiProjectMode)
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "ns",
+		Name:      "svc",
+		Labels:    map[string]string{flags.F.ProviderConfigNameLabelKey: "pc"},
+	}}
+
+	flags.F.EnableMultiProjectMode = false
+	if got := Tenant(svc); got != "ns" {
+		t.Errorf("Tenant() = %q, want the namespace %q", got, "ns")
+	}
+	flags.F.EnableMultiProjectMode = true
+	if got := Tenant(svc); got != "pc" {
+		t.Errorf("Tenant() = %q in multi-project mode, want the ProviderConfig %q", got, "pc")
+	}
+}
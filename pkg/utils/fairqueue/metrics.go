@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairqueue
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	waitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "fair_queue_wait_duration_seconds",
+			Help:    "Time items waited in a fair work queue before being processed, by class",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 12),
+		},
+		[]string{"queue", "class"},
+	)
+	queueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fair_queue_depth",
+			Help: "Number of items waiting in a fair work queue, by class",
+		},
+		[]string{"queue", "class"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(waitDuration, queueDepth)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/ingress-gce/pkg/storage"
+)
+
+// uidConfigMapName is the config map in which glbc persists the cluster UID.
+const uidConfigMapName = "ingress-uid"
+
+// LookupCluster reads the identifiers of the cluster which are not set from
+// the cluster.
+func LookupCluster(ctx context.Context, client kubernetes.Interface, cluster *Cluster) error {
+	if cluster.UID == "" || cluster.FirewallName == "" {
+		// The config map only exists if the L7 controller ever ran.
+		cm, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, uidConfigMapName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to read the cluster UID from %s/%s: %w", metav1.NamespaceSystem, uidConfigMapName, err)
+		}
+		if err != nil {
+			cm = &v1.ConfigMap{}
+		}
+		if cluster.UID == "" {
+			cluster.UID = cm.Data[storage.UIDDataKey]
+		}
+		if cluster.FirewallName == "" {
+			cluster.FirewallName = cm.Data[storage.ProviderDataKey]
+		}
+	}
+	if cluster.KubeSystemUID == "" {
+		ns, err := client.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to read the UID of the %s namespace: %w", metav1.NamespaceSystem, err)
+		}
+		cluster.KubeSystemUID = ns.UID
+	}
+	return nil
+}
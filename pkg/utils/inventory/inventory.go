@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory finds the GCE resources created by the ingress-gce
+// controllers of a cluster and decides which of them are orphaned, i.e. no
+// longer backed by a Kubernetes object.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// Status is the outcome of the ownership check of a resource.
+type Status string
+
+const (
+	// StatusInUse marks resources whose owner exists.
+	StatusInUse Status = "InUse"
+	// StatusOrphaned marks resources whose owner is gone. Only these are
+	// deleted.
+	StatusOrphaned Status = "Orphaned"
+	// StatusUnknown marks resources of the cluster whose owner could not be
+	// determined. They are reported but never deleted.
+	StatusUnknown Status = "Unknown"
+)
+
+// Resource is a GCE resource owned by the cluster.
+type Resource struct {
+	Type ResourceType
+	Key  *meta.Key
+	// Owner is nil if the owner could not be determined.
+	Owner  *Owner
+	Status Status
+	// Reason explains Status.
+	Reason string
+}
+
+// Location returns "global", the region or the zone of the resource.
+func (r *Resource) Location() string {
+	switch r.Key.Type() {
+	case meta.Regional:
+		return r.Key.Region
+	case meta.Zonal:
+		return r.Key.Zone
+	}
+	return "global"
+}
+
+// Options configure an inventory.
+type Options struct {
+	Cluster Cluster
+	// Region enables listing of regional resources in the region.
+	Region string
+	// ClusterDeleted marks every resource of the cluster orphaned without
+	// looking up Kubernetes objects, for clusters which no longer exist.
+	ClusterDeleted bool
+}
+
+// Inventory lists the GCE resources owned by the cluster and checks whether
+// their owners still exist. kubeClient is not used if opts.ClusterDeleted is
+// set. Resources are returned in the order in which they can be deleted.
+func Inventory(ctx context.Context, c cloud.Cloud, kubeClient kubernetes.Interface, opts Options, logger klog.Logger) ([]*Resource, error) {
+	resources, err := List(ctx, c, opts.Cluster, opts.Region, logger)
+	if err != nil {
+		return nil, err
+	}
+	n := newNamers(opts.Cluster, logger)
+	var live *liveObjects
+	if !opts.ClusterDeleted {
+		ings, err := kubeClient.NetworkingV1().Ingresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ingresses: %w", err)
+		}
+		svcs, err := kubeClient.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services: %w", err)
+		}
+		live = newLiveObjects(n, ings.Items, svcs.Items)
+	}
+	for _, r := range resources {
+		classify(r, n, live)
+	}
+	return resources, nil
+}
+
+// List lists the GCE resources owned by the cluster, without checking
+// whether their owners exist. Owner is only set from the descriptions of the
+// resources. Resources are returned in the order in which they can be
+// deleted, so creating them in reverse order satisfies their dependencies.
+func List(ctx context.Context, c cloud.Cloud, cluster Cluster, region string, logger klog.Logger) ([]*Resource, error) {
+	if cluster.UID == "" && cluster.KubeSystemUID == "" {
+		return nil, fmt.Errorf("either the cluster UID or the kube-system UID is required")
+	}
+	n := newNamers(cluster, logger)
+	var resources []*Resource
+	for _, kind := range resourceKinds {
+		objs, err := kind.list(ctx, c, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %ss: %w", kind.typ, err)
+		}
+		var found []*Resource
+		for _, obj := range objs {
+			if !n.owns(cluster, obj.key.Name) {
+				continue
+			}
+			found = append(found, &Resource{Type: kind.typ, Key: obj.key, Owner: ownerFromDescription(obj.description)})
+		}
+		sort.Slice(found, func(i, j int) bool {
+			return found[i].Key.String() < found[j].Key.String()
+		})
+		resources = append(resources, found...)
+	}
+	return resources, nil
+}
+
+// classify sets the owner and status of a resource whose owner was read from
+// its description by List. live is nil if the cluster is deleted.
+func classify(r *Resource, n *namers, live *liveObjects) {
+	name := r.Key.Name
+	if live == nil {
+		r.Status, r.Reason = StatusOrphaned, "cluster is deleted"
+		return
+	}
+	if r.Owner == nil {
+		r.Owner = live.ownerFromName(n, r.Type, name)
+	}
+
+	switch {
+	case r.Owner != nil && live.exists(*r.Owner):
+		r.Status, r.Reason = StatusInUse, fmt.Sprintf("%s exists", r.Owner)
+	case r.Owner != nil:
+		r.Status, r.Reason = StatusOrphaned, fmt.Sprintf("%s not found", r.Owner)
+	case n.isIngressFrontend(name):
+		r.Status, r.Reason = StatusOrphaned, "name is not generated by any ingress"
+	case (r.Type == BackendService || r.Type == HealthCheck) && n.isIGBackend(name):
+		r.Status, r.Reason = StatusOrphaned, "node port is not used by any service"
+	default:
+		r.Status, r.Reason = StatusUnknown, "owner could not be determined"
+	}
+}
+
+// Cleanup deletes the orphaned resources, which must be in the order
+// returned by Inventory. Deletion continues past failures; the returned
+// map holds the error of every resource which could not be deleted.
+func Cleanup(ctx context.Context, c cloud.Cloud, resources []*Resource, logger klog.Logger) map[*Resource]error {
+	kinds := make(map[ResourceType]resourceKind)
+	for _, kind := range resourceKinds {
+		kinds[kind.typ] = kind
+	}
+
+	errs := make(map[*Resource]error)
+	for _, r := range resources {
+		if r.Status != StatusOrphaned {
+			continue
+		}
+		logger.Info("Deleting orphaned resource", "type", r.Type, "key", r.Key, "reason", r.Reason)
+		if err := kinds[r.Type].delete(ctx, c, r.Key); err != nil {
+			logger.Error(err, "Failed to delete orphaned resource", "type", r.Type, "key", r.Key)
+			errs[r] = err
+		}
+	}
+	return errs
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+const (
+	testZone   = "us-central1-a"
+	testRegion = "us-central1"
+)
+
+var testCluster = Cluster{UID: "uid1", KubeSystemUID: "kube-system-uid"}
+
+func TestInventory(t *testing.T) {
+	ctx := context.Background()
+	n := newNamers(testCluster, klog.TODO())
+	otherCluster := newNamers(Cluster{UID: "uid2", KubeSystemUID: "other-kube-system-uid"}, klog.TODO())
+
+	liveIng := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "live"}}
+	goneIng := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gone"}}
+	liveSvc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "live-svc"},
+		Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80, NodePort: 30001}}},
+	}
+	kubeClient := fake.NewSimpleClientset(liveIng, liveSvc)
+
+	goneNEGDesc := utils.NegDescription{ClusterUID: "uid1", Namespace: "default", ServiceName: "gone-svc", Port: "80"}.String()
+	goneL4Desc, err := utils.MakeL4LBFirewallDescription("default/gone-svc", "1.2.3.4", meta.VersionGA, false)
+	if err != nil {
+		t.Fatalf("MakeL4LBFirewallDescription() = %v", err)
+	}
+	sharedL4Desc, err := utils.MakeL4LBServiceDescription("", "", meta.VersionGA, true, utils.ILB)
+	if err != nil {
+		t.Fatalf("MakeL4LBServiceDescription() = %v", err)
+	}
+
+	mockGCE := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "test-project"})
+	urlMaps := map[string]string{
+		n.frontend.Namer(liveIng).UrlMap():            "",
+		n.frontend.Namer(goneIng).UrlMap():            "",
+		otherCluster.frontend.Namer(goneIng).UrlMap(): "",
+		"user-url-map": "",
+	}
+	for name, desc := range urlMaps {
+		mustInsert(t, mockGCE.UrlMaps().Insert(ctx, meta.GlobalKey(name), &compute.UrlMap{Name: name, Description: desc}))
+	}
+	goneFR := n.frontend.Namer(goneIng).ForwardingRule(namer.HTTPProtocol)
+	mustInsert(t, mockGCE.GlobalForwardingRules().Insert(ctx, meta.GlobalKey(goneFR), &compute.ForwardingRule{Name: goneFR, Description: `{"kubernetes.io/ingress-name": "default/gone"}`}))
+	for _, name := range []string{n.v1.IGBackend(30001), n.v1.IGBackend(30002)} {
+		mustInsert(t, mockGCE.BackendServices().Insert(ctx, meta.GlobalKey(name), &compute.BackendService{Name: name}))
+	}
+	liveNEG, goneNEG := n.v1.NEG("default", "live-svc", 80), n.v1.NEG("default", "gone-svc", 80)
+	mustInsert(t, mockGCE.NetworkEndpointGroups().Insert(ctx, meta.ZonalKey(liveNEG, testZone), &compute.NetworkEndpointGroup{Name: liveNEG}))
+	mustInsert(t, mockGCE.NetworkEndpointGroups().Insert(ctx, meta.ZonalKey(goneNEG, testZone), &compute.NetworkEndpointGroup{Name: goneNEG, Description: goneNEGDesc}))
+	firewalls := map[string]string{
+		n.v1.FirewallRule():                            "",
+		n.l4.L4Backend("default", "gone-svc"):          goneL4Desc,
+		n.l4.L4HealthCheckFirewall("", "", true):       "",
+		n.l4.L4Backend("other", "unknown") + "-custom": "",
+	}
+	for name, desc := range firewalls {
+		mustInsert(t, mockGCE.Firewalls().Insert(ctx, meta.GlobalKey(name), &compute.Firewall{Name: name, Description: desc}))
+	}
+	sharedHC := n.l4.L4HealthCheck("", "", true)
+	mustInsert(t, mockGCE.RegionHealthChecks().Insert(ctx, meta.RegionalKey(sharedHC, testRegion), &compute.HealthCheck{Name: sharedHC, Description: sharedL4Desc}))
+
+	resources, err := Inventory(ctx, mockGCE, kubeClient, Options{Cluster: testCluster, Region: testRegion}, klog.TODO())
+	if err != nil {
+		t.Fatalf("Inventory() = %v, want nil", err)
+	}
+	want := []resourceStatus{
+		{ForwardingRule, goneFR, StatusOrphaned},
+		{UrlMap, n.frontend.Namer(goneIng).UrlMap(), StatusOrphaned},
+		{UrlMap, n.frontend.Namer(liveIng).UrlMap(), StatusInUse},
+		{BackendService, n.v1.IGBackend(30001), StatusInUse},
+		{BackendService, n.v1.IGBackend(30002), StatusOrphaned},
+		{HealthCheck, sharedHC, StatusInUse},
+		{NetworkEndpointGroup, goneNEG, StatusOrphaned},
+		{NetworkEndpointGroup, liveNEG, StatusInUse},
+		{Firewall, n.l4.L4Backend("default", "gone-svc"), StatusOrphaned},
+		{Firewall, n.l4.L4HealthCheckFirewall("", "", true), StatusInUse},
+		{Firewall, n.l4.L4Backend("other", "unknown") + "-custom", StatusUnknown},
+		{Firewall, n.v1.FirewallRule(), StatusInUse},
+	}
+	if diff := cmp.Diff(sortedStatuses(want), sortedStatuses(statuses(resources))); diff != "" {
+		t.Errorf("Inventory() returned unexpected resources (-want +got):\n%s", diff)
+	}
+	// Users of a resource are deleted first.
+	if resources[0].Type != ForwardingRule {
+		t.Errorf("Inventory()[0] = %s, want the forwarding rule first", resources[0].Type)
+	}
+
+	if errs := Cleanup(ctx, mockGCE, resources, klog.TODO()); len(errs) != 0 {
+		t.Fatalf("Cleanup() = %v, want no errors", errs)
+	}
+	remaining, err := Inventory(ctx, mockGCE, kubeClient, Options{Cluster: testCluster, Region: testRegion}, klog.TODO())
+	if err != nil {
+		t.Fatalf("Inventory() = %v, want nil", err)
+	}
+	for _, r := range remaining {
+		if r.Status == StatusOrphaned {
+			t.Errorf("%s %s was not deleted by Cleanup()", r.Type, r.Key)
+		}
+	}
+	if len(remaining) != 7 {
+		t.Errorf("Inventory() after Cleanup() returned %d resources, want 7", len(remaining))
+	}
+	// Resources of other clusters and of users are never touched.
+	for _, name := range []string{otherCluster.frontend.Namer(goneIng).UrlMap(), "user-url-map"} {
+		if _, err := mockGCE.UrlMaps().Get(ctx, meta.GlobalKey(name)); err != nil {
+			t.Errorf("UrlMaps().Get(%q) = %v, want the url map to exist", name, err)
+		}
+	}
+}
+
+func TestInventoryClusterDeleted(t *testing.T) {
+	ctx := context.Background()
+	n := newNamers(testCluster, klog.TODO())
+	mockGCE := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "test-project"})
+	for _, name := range []string{n.v1.FirewallRule(), "user-firewall"} {
+		mustInsert(t, mockGCE.Firewalls().Insert(ctx, meta.GlobalKey(name), &compute.Firewall{Name: name}))
+	}
+
+	// The Kubernetes client is not used for deleted clusters.
+	resources, err := Inventory(ctx, mockGCE, nil, Options{Cluster: testCluster, ClusterDeleted: true}, klog.TODO())
+	if err != nil {
+		t.Fatalf("Inventory() = %v, want nil", err)
+	}
+	want := []resourceStatus{{Firewall, n.v1.FirewallRule(), StatusOrphaned}}
+	if diff := cmp.Diff(want, statuses(resources)); diff != "" {
+		t.Errorf("Inventory() returned unexpected resources (-want +got):\n%s", diff)
+	}
+
+	if _, err := Inventory(ctx, mockGCE, nil, Options{ClusterDeleted: true}, klog.TODO()); err == nil {
+		t.Errorf("Inventory() without cluster identifiers = nil, want error")
+	}
+}
+
+func TestOwnerFromDescription(t *testing.T) {
+	l4Desc, err := utils.MakeL4LBServiceDescription("ns/svc", "1.2.3.4", meta.VersionGA, false, utils.XLB)
+	if err != nil {
+		t.Fatalf("MakeL4LBServiceDescription() = %v", err)
+	}
+	for _, tc := range []struct {
+		desc        string
+		description string
+		want        *Owner
+	}{
+		{
+			desc:        "ingress frontend",
+			description: `{"kubernetes.io/ingress-name": "ns/ing"}`,
+			want:        &Owner{Kind: OwnerIngress, Namespace: "ns", Name: "ing"},
+		},
+		{
+			desc:        "backend service",
+			description: utils.Description{ServiceName: "ns/svc", ServicePort: "80"}.String(),
+			want:        &Owner{Kind: OwnerService, Namespace: "ns", Name: "svc"},
+		},
+		{
+			desc:        "L4 resource",
+			description: l4Desc,
+			want:        &Owner{Kind: OwnerService, Namespace: "ns", Name: "svc"},
+		},
+		{
+			desc:        "NEG",
+			description: utils.NegDescription{ClusterUID: "uid", Namespace: "ns", ServiceName: "svc", Port: "80"}.String(),
+			want:        &Owner{Kind: OwnerService, Namespace: "ns", Name: "svc"},
+		},
+		{
+			desc:        "plain text",
+			description: "Default kubernetes L7 Loadbalancing health check.",
+		},
+		{
+			desc:        "malformed ingress name",
+			description: `{"kubernetes.io/ingress-name": "ing"}`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ownerFromDescription(tc.description)); diff != "" {
+				t.Errorf("ownerFromDescription(%q) returned unexpected owner (-want +got):\n%s", tc.description, diff)
+			}
+		})
+	}
+}
+
+type resourceStatus struct {
+	Type   ResourceType
+	Name   string
+	Status Status
+}
+
+func statuses(resources []*Resource) []resourceStatus {
+	var res []resourceStatus
+	for _, r := range resources {
+		res = append(res, resourceStatus{r.Type, r.Key.Name, r.Status})
+	}
+	return res
+}
+
+func sortedStatuses(s []resourceStatus) []resourceStatus {
+	sorted := append([]resourceStatus(nil), s...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+func mustInsert(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("Insert() = %v, want nil", err)
+	}
+}
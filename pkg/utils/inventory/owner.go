@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// v2Prefix is the prefix of resources named with the v2 naming scheme,
+	// used by ingress frontends and L4 load balancers.
+	v2Prefix = "k8s2"
+	// v2ClusterUIDLength is the length of the kube-system UID hash embedded
+	// in v2 resource names.
+	v2ClusterUIDLength = 8
+)
+
+// OwnerKind is the kind of the object owning a GCE resource.
+type OwnerKind string
+
+const (
+	// OwnerIngress marks resources created for an Ingress.
+	OwnerIngress OwnerKind = "Ingress"
+	// OwnerService marks resources created for a Service.
+	OwnerService OwnerKind = "Service"
+	// OwnerCluster marks resources shared by the whole cluster, e.g. the L7
+	// firewall rule or the shared L4 health check.
+	OwnerCluster OwnerKind = "Cluster"
+)
+
+// Owner identifies the Kubernetes object a GCE resource was created for.
+type Owner struct {
+	Kind OwnerKind
+	// Namespace and Name are empty for OwnerCluster.
+	Namespace string
+	Name      string
+}
+
+// String returns the string representation of an Owner.
+func (o Owner) String() string {
+	if o.Kind == OwnerCluster {
+		return string(o.Kind)
+	}
+	return fmt.Sprintf("%s %s/%s", o.Kind, o.Namespace, o.Name)
+}
+
+// Cluster identifies the cluster whose resources are inventoried.
+type Cluster struct {
+	// UID is the cluster UID stored in the ingress-uid config map. It is
+	// embedded in v1 resource names, e.g. k8s-um-ns-name--<uid>.
+	UID string
+	// FirewallName is the name embedded in the L7 firewall rule. It defaults
+	// to UID.
+	FirewallName string
+	// KubeSystemUID is the UID of the kube-system namespace. Its hash is
+	// embedded in v2 resource names, e.g. k8s2-um-<hash>-ns-name-<suffix>.
+	KubeSystemUID types.UID
+}
+
+// namers holds the namers of a cluster.
+type namers struct {
+	v1       *namer.Namer
+	l4       *namer.L4Namer
+	frontend namer.IngressFrontendNamerFactory
+	// v2UID is the kube-system UID hash embedded in v2 resource names.
+	v2UID string
+}
+
+func newNamers(cluster Cluster, logger klog.Logger) *namers {
+	firewallName := cluster.FirewallName
+	if firewallName == "" {
+		firewallName = cluster.UID
+	}
+	v1Namer := namer.NewNamer(cluster.UID, firewallName, logger)
+	n := &namers{
+		v1:       v1Namer,
+		l4:       namer.NewL4Namer(string(cluster.KubeSystemUID), v1Namer),
+		frontend: namer.NewFrontendNamerFactory(v1Namer, cluster.KubeSystemUID, logger),
+	}
+	if cluster.KubeSystemUID != "" {
+		n.v2UID = common.ContentHash(string(cluster.KubeSystemUID), v2ClusterUIDLength)
+	}
+	return n
+}
+
+// owns returns true if the resource name carries the cluster's identity.
+func (n *namers) owns(cluster Cluster, name string) bool {
+	if cluster.UID != "" && n.v1.NameBelongsToEntity(name) {
+		return true
+	}
+	if n.v2UID == "" {
+		return false
+	}
+	// L4 resources and NEGs are named k8s2-{uid}-..., ingress frontends
+	// and L4 forwarding rules k8s2-{resource or protocol}-{uid}-...
+	parts := strings.SplitN(name, "-", 4)
+	if len(parts) < 3 || parts[0] != v2Prefix {
+		return false
+	}
+	return parts[1] == n.v2UID || parts[2] == n.v2UID
+}
+
+// ingressFrontendResources are the resource prefixes of the v1 and v2
+// ingress frontend naming schemes.
+var ingressFrontendResources = map[string]bool{
+	// v1 and v2 url maps, redirect url maps and target http proxies.
+	"um": true, "rm": true, "tp": true,
+	// v1 https target proxy, forwarding rules and ssl certificates.
+	"tps": true, "fw": true, "fws": true, "ssl": true,
+	// v2 https target proxy, forwarding rules and ssl certificates.
+	"ts": true, "fr": true, "fs": true, "cr": true,
+}
+
+// isIngressFrontend returns true if the name follows the naming scheme of
+// ingress frontend resources. Every such resource is created for an Ingress,
+// so it is orphaned when no live Ingress generates its name.
+func (n *namers) isIngressFrontend(name string) bool {
+	parts := strings.SplitN(name, "-", 4)
+	if len(parts) < 3 {
+		return false
+	}
+	switch parts[0] {
+	case "k8s":
+		// The v1 firewall rule k8s-fw-l7--uid shares the forwarding rule prefix.
+		return ingressFrontendResources[parts[1]] && name != n.v1.FirewallRule()
+	case v2Prefix:
+		return ingressFrontendResources[parts[1]] && parts[2] == n.v2UID
+	}
+	return false
+}
+
+// ingressDescription is the description of ingress frontend resources,
+// see loadbalancers.L7.description().
+type ingressDescription struct {
+	IngressName string `json:"kubernetes.io/ingress-name"`
+}
+
+// ownerFromDescription returns the owner recorded in the JSON description of
+// a resource, or nil if the description does not name one.
+func ownerFromDescription(desc string) *Owner {
+	if !strings.HasPrefix(strings.TrimSpace(desc), "{") {
+		return nil
+	}
+	var ing ingressDescription
+	if err := json.Unmarshal([]byte(desc), &ing); err == nil && ing.IngressName != "" {
+		return ownerFromKey(OwnerIngress, ing.IngressName)
+	}
+	var be utils.Description
+	if err := json.Unmarshal([]byte(desc), &be); err == nil && be.ServiceName != "" {
+		return ownerFromKey(OwnerService, be.ServiceName)
+	}
+	var l4 utils.L4LBResourceDescription
+	if err := l4.Unmarshal(desc); err == nil {
+		if l4.ServiceName != "" {
+			return ownerFromKey(OwnerService, l4.ServiceName)
+		}
+		if l4.ResourceDescription != "" {
+			return &Owner{Kind: OwnerCluster}
+		}
+	}
+	neg, err := utils.NegDescriptionFromString(desc)
+	if err == nil && neg.Namespace != "" && neg.ServiceName != "" {
+		return &Owner{Kind: OwnerService, Namespace: neg.Namespace, Name: neg.ServiceName}
+	}
+	return nil
+}
+
+func ownerFromKey(kind OwnerKind, key string) *Owner {
+	namespace, name, ok := strings.Cut(key, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil
+	}
+	return &Owner{Kind: kind, Namespace: namespace, Name: name}
+}
+
+// liveObjects indexes the names the controllers generate for the live
+// Kubernetes objects of a cluster.
+type liveObjects struct {
+	ingresses map[types.NamespacedName]bool
+	services  map[types.NamespacedName]bool
+	// names maps generated resource names to their owner.
+	names map[string]Owner
+	// nodePorts maps node ports to the service using them. Backend services
+	// and health checks of instance group backends are named after them.
+	nodePorts map[int64]Owner
+	// frontendNamers are the namers of live Ingresses, used to match ssl
+	// certificates whose names embed a secret hash.
+	frontendNamers []frontendNamer
+}
+
+type frontendNamer struct {
+	namer.IngressFrontendNamer
+	owner Owner
+}
+
+func newLiveObjects(n *namers, ingresses []networkingv1.Ingress, services []v1.Service) *liveObjects {
+	live := &liveObjects{
+		ingresses: make(map[types.NamespacedName]bool),
+		services:  make(map[types.NamespacedName]bool),
+		names:     make(map[string]Owner),
+		nodePorts: make(map[int64]Owner),
+	}
+	live.names[n.v1.FirewallRule()] = Owner{Kind: OwnerCluster}
+	if n.v2UID != "" {
+		for _, name := range []string{
+			n.l4.L4HealthCheck("", "", true),
+			n.l4.L4HealthCheckFirewall("", "", true),
+			n.l4.L4IPv6HealthCheckFirewall("", "", true),
+		} {
+			live.names[name] = Owner{Kind: OwnerCluster}
+		}
+	}
+
+	for i := range ingresses {
+		ing := &ingresses[i]
+		live.ingresses[types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}] = true
+		owner := Owner{Kind: OwnerIngress, Namespace: ing.Namespace, Name: ing.Name}
+		// The naming scheme is picked by the finalizer, which changes when
+		// an Ingress is upgraded. Names of both schemes are considered live.
+		for _, finalizer := range []string{common.FinalizerKey, common.FinalizerKeyV2} {
+			ing := ing.DeepCopy()
+			ing.Finalizers = []string{finalizer}
+			fe := n.frontend.Namer(ing)
+			if !fe.IsValidLoadBalancer() {
+				continue
+			}
+			live.frontendNamers = append(live.frontendNamers, frontendNamer{fe, owner})
+			live.names[fe.UrlMap()] = owner
+			if rm, ok := fe.RedirectUrlMap(); ok {
+				live.names[rm] = owner
+			}
+			for _, protocol := range []namer.NamerProtocol{namer.HTTPProtocol, namer.HTTPSProtocol} {
+				live.names[fe.ForwardingRule(protocol)] = owner
+				live.names[fe.TargetProxy(protocol)] = owner
+			}
+		}
+	}
+
+	for i := range services {
+		svc := &services[i]
+		live.services[types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}] = true
+		owner := Owner{Kind: OwnerService, Namespace: svc.Namespace, Name: svc.Name}
+		for _, port := range svc.Spec.Ports {
+			live.names[n.v1.NEG(svc.Namespace, svc.Name, port.Port)] = owner
+			live.names[n.v1.RXLBBackendName(svc.Namespace, svc.Name, port.Port)] = owner
+			if port.NodePort != 0 {
+				live.nodePorts[int64(port.NodePort)] = owner
+			}
+		}
+		if n.v2UID != "" {
+			live.names[n.l4.L4Backend(svc.Namespace, svc.Name)] = owner
+			live.names[n.l4.L4HealthCheckFirewall(svc.Namespace, svc.Name, false)] = owner
+			live.names[n.l4.L4IPv6HealthCheckFirewall(svc.Namespace, svc.Name, false)] = owner
+		}
+	}
+	return live
+}
+
+// exists returns true if the owner is a live object.
+func (live *liveObjects) exists(owner Owner) bool {
+	key := types.NamespacedName{Namespace: owner.Namespace, Name: owner.Name}
+	switch owner.Kind {
+	case OwnerIngress:
+		return live.ingresses[key]
+	case OwnerService:
+		return live.services[key]
+	}
+	return true
+}
+
+// ownerFromName returns the live object which generates the resource name,
+// or nil if there is none.
+func (live *liveObjects) ownerFromName(n *namers, typ ResourceType, name string) *Owner {
+	if owner, ok := live.names[name]; ok {
+		return &owner
+	}
+	if typ == SslCertificate {
+		for _, fe := range live.frontendNamers {
+			if fe.IsCertNameForLB(name) || fe.IsLegacySSLCert(name) {
+				return &fe.owner
+			}
+		}
+	}
+	if typ == BackendService || typ == HealthCheck {
+		if port, err := n.v1.IGBackendPort(name); err == nil {
+			p, _ := strconv.ParseInt(port, 10, 64)
+			if owner, ok := live.nodePorts[p]; ok {
+				return &owner
+			}
+		}
+	}
+	return nil
+}
+
+// isIGBackend returns true if the name is the name of a backend service or
+// health check targeting instance groups, i.e. k8s-be-<node port>--<uid>.
+func (n *namers) isIGBackend(name string) bool {
+	_, err := n.v1.IGBackendPort(name)
+	return err == nil
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+)
+
+// ResourceType is the type of an inventoried GCE resource.
+type ResourceType string
+
+const (
+	ForwardingRule       ResourceType = "ForwardingRule"
+	TargetHttpsProxy     ResourceType = "TargetHttpsProxy"
+	TargetHttpProxy      ResourceType = "TargetHttpProxy"
+	UrlMap               ResourceType = "UrlMap"
+	BackendService       ResourceType = "BackendService"
+	HealthCheck          ResourceType = "HealthCheck"
+	NetworkEndpointGroup ResourceType = "NetworkEndpointGroup"
+	SslCertificate       ResourceType = "SslCertificate"
+	Firewall             ResourceType = "Firewall"
+	Address              ResourceType = "Address"
+)
+
+// cloudResource is a GCE resource as returned by a list call.
+type cloudResource struct {
+	key         *meta.Key
+	description string
+}
+
+// resourceKind lists and deletes the GCE resources of one type. Regional
+// resources are only listed if a region is given.
+type resourceKind struct {
+	typ    ResourceType
+	list   func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error)
+	delete func(ctx context.Context, c cloud.Cloud, key *meta.Key) error
+}
+
+// resourceKinds are the inventoried resource types, in the order in which
+// they can be deleted: users of a resource come before the resource.
+var resourceKinds = []resourceKind{
+	{
+		typ: ForwardingRule,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.GlobalForwardingRules().List(ctx, filter.None))(func(o *compute.ForwardingRule) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.ForwardingRules().List(ctx, region, filter.None))(func(o *compute.ForwardingRule) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.GlobalForwardingRules().Delete(ctx, key)
+			}
+			return c.ForwardingRules().Delete(ctx, key)
+		},
+	},
+	{
+		typ: TargetHttpsProxy,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.TargetHttpsProxies().List(ctx, filter.None))(func(o *compute.TargetHttpsProxy) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionTargetHttpsProxies().List(ctx, region, filter.None))(func(o *compute.TargetHttpsProxy) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.TargetHttpsProxies().Delete(ctx, key)
+			}
+			return c.RegionTargetHttpsProxies().Delete(ctx, key)
+		},
+	},
+	{
+		typ: TargetHttpProxy,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.TargetHttpProxies().List(ctx, filter.None))(func(o *compute.TargetHttpProxy) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionTargetHttpProxies().List(ctx, region, filter.None))(func(o *compute.TargetHttpProxy) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.TargetHttpProxies().Delete(ctx, key)
+			}
+			return c.RegionTargetHttpProxies().Delete(ctx, key)
+		},
+	},
+	{
+		typ: UrlMap,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.UrlMaps().List(ctx, filter.None))(func(o *compute.UrlMap) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionUrlMaps().List(ctx, region, filter.None))(func(o *compute.UrlMap) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.UrlMaps().Delete(ctx, key)
+			}
+			return c.RegionUrlMaps().Delete(ctx, key)
+		},
+	},
+	{
+		typ: BackendService,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.BackendServices().List(ctx, filter.None))(func(o *compute.BackendService) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionBackendServices().List(ctx, region, filter.None))(func(o *compute.BackendService) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.BackendServices().Delete(ctx, key)
+			}
+			return c.RegionBackendServices().Delete(ctx, key)
+		},
+	},
+	{
+		typ: HealthCheck,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.HealthChecks().List(ctx, filter.None))(func(o *compute.HealthCheck) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionHealthChecks().List(ctx, region, filter.None))(func(o *compute.HealthCheck) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.HealthChecks().Delete(ctx, key)
+			}
+			return c.RegionHealthChecks().Delete(ctx, key)
+		},
+	},
+	{
+		typ: NetworkEndpointGroup,
+		list: func(ctx context.Context, c cloud.Cloud, _ string) ([]cloudResource, error) {
+			negs, err := c.NetworkEndpointGroups().AggregatedList(ctx, filter.None)
+			if err != nil {
+				return nil, err
+			}
+			var res []cloudResource
+			for location, zoneNEGs := range negs {
+				for _, neg := range zoneNEGs {
+					zone := lastComponent(neg.Zone)
+					if zone == "" {
+						zone = lastComponent(location)
+					}
+					res = append(res, cloudResource{meta.ZonalKey(neg.Name, zone), neg.Description})
+				}
+			}
+			return res, nil
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			return c.NetworkEndpointGroups().Delete(ctx, key)
+		},
+	},
+	{
+		typ: SslCertificate,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.SslCertificates().List(ctx, filter.None))(func(o *compute.SslCertificate) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.RegionSslCertificates().List(ctx, region, filter.None))(func(o *compute.SslCertificate) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.SslCertificates().Delete(ctx, key)
+			}
+			return c.RegionSslCertificates().Delete(ctx, key)
+		},
+	},
+	{
+		typ: Firewall,
+		list: func(ctx context.Context, c cloud.Cloud, _ string) ([]cloudResource, error) {
+			return collect(c.Firewalls().List(ctx, filter.None))(func(o *compute.Firewall) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			return c.Firewalls().Delete(ctx, key)
+		},
+	},
+	{
+		typ: Address,
+		list: func(ctx context.Context, c cloud.Cloud, region string) ([]cloudResource, error) {
+			res, err := collect(c.GlobalAddresses().List(ctx, filter.None))(func(o *compute.Address) cloudResource {
+				return cloudResource{meta.GlobalKey(o.Name), o.Description}
+			})
+			if err != nil || region == "" {
+				return res, err
+			}
+			regional, err := collect(c.Addresses().List(ctx, region, filter.None))(func(o *compute.Address) cloudResource {
+				return cloudResource{meta.RegionalKey(o.Name, region), o.Description}
+			})
+			return append(res, regional...), err
+		},
+		delete: func(ctx context.Context, c cloud.Cloud, key *meta.Key) error {
+			if key.Type() == meta.Global {
+				return c.GlobalAddresses().Delete(ctx, key)
+			}
+			return c.Addresses().Delete(ctx, key)
+		},
+	},
+}
+
+// collect converts the result of a list call with the given function.
+func collect[T any](objs []T, err error) func(func(T) cloudResource) ([]cloudResource, error) {
+	return func(convert func(T) cloudResource) ([]cloudResource, error) {
+		if err != nil {
+			return nil, err
+		}
+		res := make([]cloudResource, 0, len(objs))
+		for _, o := range objs {
+			res = append(res, convert(o))
+		}
+		return res, nil
+	}
+}
+
+// lastComponent returns the last path component of a resource URL, e.g. the
+// zone of https://.../zones/us-central1-a.
+func lastComponent(s string) string {
+	return s[strings.LastIndex(s, "/")+1:]
+}
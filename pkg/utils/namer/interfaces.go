@@ -119,10 +119,15 @@ type L4ResourcesNamer interface {
 	L4IPv6HealthCheckFirewall(namespace, name string, shared bool) string
 	// IsNEG returns if the given name is a VM_IP_NEG name.
 	IsNEG(name string) bool
+	// NameBelongsToCluster returns if the given name follows the L4 naming scheme of the cluster.
+	NameBelongsToCluster(name string) bool
 }
 
 type ServiceAttachmentNamer interface {
 	// ServiceAttachment returns the name of the GCE Service Attachment resource for the given namespace,
 	// name, and Service Attachment CR UID
 	ServiceAttachment(namespace, name, saUID string) string
+	// IPv6ServiceAttachment returns the name of the GCE Service Attachment resource of the IPv6
+	// forwarding rule for the given namespace, name, and Service Attachment CR UID
+	IPv6ServiceAttachment(namespace, name, saUID string) string
 }
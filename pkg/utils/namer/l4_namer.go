@@ -268,6 +268,12 @@ func (namer *L4Namer) IsNEG(name string) bool {
 	return strings.HasPrefix(name, namer.v2Prefix+"-"+namer.v2ClusterUID)
 }
 
+// NameBelongsToCluster indicates if the given name follows the L4 naming convention
+// of this cluster, i.e. starts with k8s2-{uid}-.
+func (namer *L4Namer) NameBelongsToCluster(name string) bool {
+	return strings.HasPrefix(name, namer.v2Prefix+"-"+namer.v2ClusterUID+"-")
+}
+
 // getServiceHash returns hash string of length 8 of a concatenated string generated from
 // kube-system uid, namespace and name. These fields in combination define an l4 load-balancer uniquely.
 func (n *L4Namer) getServiceHash(namespace, name string) string {
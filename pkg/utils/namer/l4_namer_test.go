@@ -414,3 +414,27 @@ func TestIPv6HcFirewallCollisions(t *testing.T) {
 		})
 	}
 }
+
+func TestL4NamerNameBelongsToCluster(t *testing.T) {
+	t.Parallel()
+	namer := NewL4Namer(kubeSystemUID, nil)
+	otherNamer := NewL4Namer("other-uid", nil)
+
+	testCases := []struct {
+		desc string
+		name string
+		want bool
+	}{
+		{desc: "firewall", name: namer.L4Firewall("namespace", "name"), want: true},
+		{desc: "deny firewall", name: namer.L4FirewallDeny("namespace", "name"), want: true},
+		{desc: "shared health check firewall", name: namer.L4HealthCheckFirewall("namespace", "name", true), want: true},
+		{desc: "firewall of other cluster", name: otherNamer.L4Firewall("namespace", "name"), want: false},
+		{desc: "L7 firewall", name: "k8s-fw-l7--uid", want: false},
+		{desc: "user firewall", name: "allow-ssh", want: false},
+	}
+	for _, tc := range testCases {
+		if got := namer.NameBelongsToCluster(tc.name); got != tc.want {
+			t.Errorf("%s: NameBelongsToCluster(%q) = %v, want %v", tc.desc, tc.name, got, tc.want)
+		}
+	}
+}
@@ -66,6 +66,19 @@ func (n *V1ServiceAttachmentNamer) ServiceAttachment(namespace, name, saUID stri
 	return fmt.Sprintf("%s%s-sa-%s-%s-%s-%s", n.prefix, schemaVersionV1, clusterUID, truncFields[0], truncFields[1], hash)
 }
 
+// IPv6ServiceAttachment returns the gce ServiceAttachment name of the IPv6
+// forwarding rule of a dual-stack resource. Naming convention:
+//
+// k8s{naming version}-sa6-{cluster-uid}-{namespace}-{name}-{hash}
+// Output name is at most 63 characters.
+func (n *V1ServiceAttachmentNamer) IPv6ServiceAttachment(namespace, name, saUID string) string {
+	clusterUID := common.ContentHash(n.kubeSystemUID, clusterUIDLength)
+	hash := n.suffix(8, n.kubeSystemUID, namespace, name, saUID, "ipv6")
+	// One character less for the longer service attachment identifier prefix.
+	truncFields := TrimFieldsEvenly(n.maxDescriptiveLabel-1, namespace, name)
+	return fmt.Sprintf("%s%s-sa6-%s-%s-%s-%s", n.prefix, schemaVersionV1, clusterUID, truncFields[0], truncFields[1], hash)
+}
+
 // hash returns an 8 character hash code of the provided fields
 func (n *V1ServiceAttachmentNamer) suffix(numCharacters int, fields ...string) string {
 	concatenatedString := strings.Join(fields, ";")
@@ -94,6 +94,17 @@ func TestNamerServiceAttachment(t *testing.T) {
 			if res != expectedName {
 				t.Errorf("%s: got %q, want %q", tc.desc, res, expectedName)
 			}
+
+			ipv6Res := newNamer.IPv6ServiceAttachment(tc.namespace, tc.name, svcAttachmentUID)
+			if len(ipv6Res) > 63 {
+				t.Errorf("%s: got len(ipv6Res) == %v, want <= 63", tc.desc, len(ipv6Res))
+			}
+			if numHyphens := strings.Count(ipv6Res, "-"); numHyphens != 5 {
+				t.Errorf("Expected to have 5 components to IPv6 name delimited by `-`. Found only %d `-`", numHyphens)
+			}
+			if ipv6Res == res {
+				t.Errorf("%s: IPv6 service attachment name %q should differ from %q", tc.desc, ipv6Res, res)
+			}
 		}
 	}
 }
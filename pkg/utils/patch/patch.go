@@ -111,3 +111,18 @@ func PatchServiceStatus(client coreclient.CoreV1Interface, svc *corev1.Service,
 	_, err := svchelpers.PatchService(client, svc, newSvc)
 	return err
 }
+
+// PatchProviderConfigStatus patches the given ProviderConfig's status based on new status.
+// ProviderConfig has no status subresource, so the status is patched on the object itself.
+func PatchProviderConfigStatus(client providerconfigclient.Interface, pc *providerconfig.ProviderConfig, newStatus providerconfig.ProviderConfigStatus) error {
+	newPC := pc.DeepCopy()
+	newPC.Status = newStatus
+
+	patchBytes, err := MergePatchBytes(pc, newPC)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CloudV1().ProviderConfigs().Patch(context.Background(), newPC.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
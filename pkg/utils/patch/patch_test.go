@@ -17,8 +17,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
@@ -350,6 +352,63 @@ func TestPatchProviderConfigObjectMetadata(t *testing.T) {
 	}
 }
 
+func TestPatchProviderConfigStatus(t *testing.T) {
+	// Serialized times have a second precision.
+	now := metav1.NewTime(time.Unix(1700000000, 0))
+	for _, tc := range []struct {
+		desc      string
+		oldStatus providerconfig.ProviderConfigStatus
+		newStatus providerconfig.ProviderConfigStatus
+	}{
+		{
+			desc: "add status",
+			newStatus: providerconfig.ProviderConfigStatus{
+				Conditions: []metav1.Condition{{Type: "ControllersRunning", Status: metav1.ConditionTrue, Reason: "Running", LastTransitionTime: now}},
+				Controllers: []providerconfig.ProviderConfigControllerStatus{
+					{Name: "neg-controller", Running: true, InformersSynced: true, LastSyncTime: &now},
+				},
+				ManagedResources: &providerconfig.ProviderConfigManagedResources{NEGs: 2, Services: 1},
+			},
+		},
+		{
+			desc: "update status",
+			oldStatus: providerconfig.ProviderConfigStatus{
+				Conditions:       []metav1.Condition{{Type: "ControllersRunning", Status: metav1.ConditionTrue, Reason: "Running", LastTransitionTime: now}},
+				ManagedResources: &providerconfig.ProviderConfigManagedResources{NEGs: 2, Services: 1},
+			},
+			newStatus: providerconfig.ProviderConfigStatus{
+				Conditions:       []metav1.Condition{{Type: "ControllersRunning", Status: metav1.ConditionFalse, Reason: "StartFailed", Message: "error", LastTransitionTime: now}},
+				ManagedResources: &providerconfig.ProviderConfigManagedResources{NEGs: 0, Services: 3},
+			},
+		},
+		{
+			desc: "clear status",
+			oldStatus: providerconfig.ProviderConfigStatus{
+				Controllers: []providerconfig.ProviderConfigControllerStatus{{Name: "neg-controller", Running: true}},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			pc := &providerconfig.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "test-pc"}, Status: tc.oldStatus}
+			pcClient := providerconfigfake.NewSimpleClientset()
+			if _, err := pcClient.CloudV1().ProviderConfigs().Create(context.TODO(), pc, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Create(%s) = %v, want nil", pc.Name, err)
+			}
+			if err := PatchProviderConfigStatus(pcClient, pc, tc.newStatus); err != nil {
+				t.Fatalf("PatchProviderConfigStatus(%s) = %v, want nil", pc.Name, err)
+			}
+
+			gotPC, err := pcClient.CloudV1().ProviderConfigs().Get(context.TODO(), pc.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Get(%s) = %v, want nil", pc.Name, err)
+			}
+			if diff := cmp.Diff(tc.newStatus, gotPC.Status, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Got mismatch for ProviderConfig Status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 const (
 	testAnnotationKey = "test-annotations-key1"
 	testFinalizer     = "test-finalizer"
@@ -70,6 +70,9 @@ type ServicePort struct {
 	THCConfiguration     THCConfiguration
 	BackendConfig        *backendconfigv1.BackendConfig
 	BackendNamer         namer.BackendNamer
+	// AdoptedBackendName is the name of a user-created L4 backend service
+	// which should be used instead of the generated one, if set.
+	AdoptedBackendName string
 	// Traffic policy fields that apply if non-nil.
 	MaxRatePerEndpoint *float64
 	CapacityScaler     *float64
@@ -87,6 +90,8 @@ func (sp *ServicePort) GetDescription() Description {
 func (sp *ServicePort) BackendName() string {
 	if sp.L7XLBRegionalEnabled {
 		return sp.BackendNamer.RXLBBackendName(sp.ID.Service.Namespace, sp.ID.Service.Name, sp.Port)
+	} else if (sp.VMIPNEGEnabled || sp.L4RBSEnabled) && sp.AdoptedBackendName != "" {
+		return sp.AdoptedBackendName
 	} else if sp.NEGEnabled || sp.VMIPNEGEnabled || sp.L4RBSEnabled {
 		// L4 ILB and RBS (with NEGs), Ingress ILB and GXLB are using NEG Name for all backend resources.
 		return sp.NEGName()
@@ -18,8 +18,11 @@ package utils
 
 import (
 	"fmt"
+
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/utils/fairqueue"
 	"k8s.io/klog/v2"
 )
 
@@ -31,6 +34,9 @@ var (
 type TaskQueue interface {
 	Run()
 	Enqueue(objs ...interface{})
+	// EnqueueResync adds keys for periodic resyncs, which may be processed
+	// after the keys added by Enqueue.
+	EnqueueResync(objs ...interface{})
 	Shutdown()
 	Len() int
 	NumRequeues(obj interface{}) int
@@ -39,6 +45,7 @@ type TaskQueue interface {
 // PeriodicTaskQueueWithMultipleWorkers invokes the given sync function for every work item
 // inserted, while running n parallel worker routines. If the sync() function results in an error, the item is put on
 // the work queue after a rate-limit.
+// With --enable-fair-queuing, namespaces are served in turn and resyncs wait for the other items.
 type PeriodicTaskQueueWithMultipleWorkers struct {
 	// resource is used for logging to distinguish the queue being used.
 	resource string
@@ -97,14 +104,23 @@ func (t *PeriodicTaskQueueWithMultipleWorkers) Run() {
 
 // Enqueue adds one or more keys to the work queue.
 func (t *PeriodicTaskQueueWithMultipleWorkers) Enqueue(objs ...interface{}) {
+	t.enqueue(fairqueue.ClassUser, objs...)
+}
+
+// EnqueueResync adds one or more keys to the work queue for a periodic resync.
+func (t *PeriodicTaskQueueWithMultipleWorkers) EnqueueResync(objs ...interface{}) {
+	t.enqueue(fairqueue.ClassResync, objs...)
+}
+
+func (t *PeriodicTaskQueueWithMultipleWorkers) enqueue(class fairqueue.Class, objs ...interface{}) {
 	for _, obj := range objs {
 		key, err := t.keyFunc(obj)
 		if err != nil {
 			t.logger.Error(err, "Couldn't get key for object", "object", fmt.Sprintf("%+v", obj), "objectType", fmt.Sprintf("%T", obj))
 			return
 		}
-		t.logger.V(4).Info("Enqueue key", "key", key, "resource", t.resource)
-		t.queue.Add(key)
+		t.logger.V(4).Info("Enqueue key", "key", key, "resource", t.resource, "class", class)
+		fairqueue.AddWithClass(t.queue, key, fairqueue.Tenant(obj), class)
 	}
 }
 
@@ -127,9 +143,12 @@ func NewPeriodicTaskQueueWithMultipleWorkers(name, resource string, numWorkers i
 	}
 	rl := workqueue.DefaultControllerRateLimiter()
 	var queue workqueue.RateLimitingInterface
-	if name == "" {
+	switch {
+	case flags.F.EnableFairQueuing:
+		queue = fairqueue.New(name, rl)
+	case name == "":
 		queue = workqueue.NewRateLimitingQueue(rl)
-	} else {
+	default:
 		queue = workqueue.NewNamedRateLimitingQueue(rl, name)
 	}
 	taskQueue := &PeriodicTaskQueueWithMultipleWorkers{
@@ -207,6 +226,12 @@ func (t *PeriodicTaskQueue) Enqueue(objs ...interface{}) {
 	}
 }
 
+// EnqueueResync adds one or more keys to the work queue, the queue does not
+// distinguish periodic resyncs.
+func (t *PeriodicTaskQueue) EnqueueResync(objs ...interface{}) {
+	t.Enqueue(objs...)
+}
+
 // Shutdown shuts down the work queue and waits for the worker to ACK
 func (t *PeriodicTaskQueue) Shutdown() {
 	t.logger.V(2).Info("Shutdown")
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/ingress-gce/pkg/flags"
 	"sync"
 	"time"
 )
@@ -145,3 +146,34 @@ func TestPeriodicQueueWithMultipleWorkers(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodicQueueWithMultipleWorkersFairQueuing(t *testing.T) {
+	defer func(enabled bool) { flags.F.EnableFairQueuing = enabled }(flags.F.EnableFairQueuing)
+	flags.F.EnableFairQueuing = true
+
+	syncedCh := make(chan string, 10)
+	sync := func(key string) error {
+		syncedCh <- key
+		return nil
+	}
+	tq := NewPeriodicTaskQueueWithMultipleWorkers("fair", "test", 1, sync, klog.TODO())
+	tq.EnqueueResync(cache.ExplicitKey("resync/svc"))
+	tq.Enqueue(cache.ExplicitKey("busy/svc-1"), cache.ExplicitKey("busy/svc-2"), cache.ExplicitKey("quiet/svc"))
+	tq.Run()
+	defer tq.Shutdown()
+
+	var got []string
+	for i := 0; i < 4; i++ {
+		select {
+		case key := <-syncedCh:
+			got = append(got, key)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Synced %v, want 4 keys", got)
+		}
+	}
+	// Namespaces are served in turn, resyncs come last.
+	want := []string{"busy/svc-1", "quiet/svc", "busy/svc-2", "resync/svc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Synced keys in order %v, want %v", got, want)
+	}
+}